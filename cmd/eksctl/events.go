@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/progress"
+)
+
+// stdoutEvents is the value of --events-file that writes the events to stdout
+const stdoutEvents = "-"
+
+// startEventStream writes the lifecycle events of the tasks to eventsFile as lines of JSON, or to stdout
+// if it is "-". It returns a function that stops writing them
+func startEventStream(eventsFile string) (func(), error) {
+	if eventsFile == "" {
+		return func() {}, nil
+	}
+
+	var w io.WriteCloser
+	if eventsFile == stdoutEvents {
+		w = os.Stdout
+	} else {
+		f, err := os.Create(eventsFile)
+		if err != nil {
+			return nil, fmt.Errorf("creating events file: %w", err)
+		}
+		w = f
+	}
+
+	removeListener := progress.AddListener(progress.NewEventWriter(w))
+	return func() {
+		removeListener()
+		if w != os.Stdout {
+			if err := w.Close(); err != nil {
+				logger.Warning("closing events file: %v", err)
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+// taskErrorRecorder is a progress.Listener recording the error of the first task that failed, as commands
+// running tasks return an error summarising the failures rather than the errors of the tasks
+type taskErrorRecorder struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (r *taskErrorRecorder) TaskStarted(_ string) {}
+
+func (r *taskErrorRecorder) TaskCompleted(_ string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *taskErrorRecorder) StackStatus(_, _ string) {}
+
+func (r *taskErrorRecorder) ResourceCreated(_, _, _ string) {}
+
+// exitCode returns the exit code for the error returned by a command, falling back to the error of the
+// first task that failed when the error of the command does not tell the class of the failure
+func (r *taskErrorRecorder) exitCode(err error) int {
+	code := exitcode.FromError(err)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if code == exitcode.Failure && r.err != nil {
+		return exitcode.FromError(r.err)
+	}
+	return code
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -12,7 +13,8 @@ import (
 func infoCmd(cmd *cmdutils.Cmd) {
 	var output string
 
-	cmd.SetDescription("info", "Output the version of eksctl, kubectl and OS info", "")
+	cmd.SetDescription("info", "Output the version of eksctl, kubectl and OS info", "Also outputs the AWS identity, "+
+		"where the AWS profile, region and configuration files come from, the proxy settings and the health of the current kubeconfig context")
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&output, "output", "o", "", "specifies the output format (valid option: json)")
 	})
@@ -24,6 +26,9 @@ func infoCmd(cmd *cmdutils.Cmd) {
 			fmt.Printf("eksctl version: %s\n", version.EksctlVersion)
 			fmt.Printf("kubectl version: %s\n", version.KubectlVersion)
 			fmt.Printf("OS: %s\n", version.OS)
+			printAWSInfo(version.AWS)
+			printProxyInfo(version.Proxy)
+			printKubeconfigInfo(version.Kubeconfig)
 		case "json":
 			fmt.Printf("%s\n", info.String())
 		default:
@@ -32,3 +37,54 @@ func infoCmd(cmd *cmdutils.Cmd) {
 		return nil
 	}
 }
+
+func printAWSInfo(aws info.AWSInfo) {
+	if aws.Identity != nil {
+		fmt.Printf("AWS identity: %s (account %s)\n", aws.Identity.ARN, aws.Identity.Account)
+	} else {
+		fmt.Printf("AWS identity: error: %s\n", aws.IdentityError)
+	}
+	if aws.CredentialsSource != "" {
+		fmt.Printf("AWS credentials source: %s\n", aws.CredentialsSource)
+	}
+	fmt.Printf("AWS profile: %s (from %s)\n", aws.Profile, aws.ProfileSource)
+	fmt.Printf("AWS region: %s (from %s)\n", aws.Region, aws.RegionSource)
+	fmt.Printf("AWS region resolution order: %s\n", strings.Join(aws.RegionResolutionOrder, ", "))
+	for _, f := range []struct {
+		name string
+		file info.File
+	}{
+		{"AWS config file", aws.ConfigFile},
+		{"AWS credentials file", aws.CredentialsFile},
+	} {
+		exists := ""
+		if !f.file.Exists {
+			exists = ", does not exist"
+		}
+		fmt.Printf("%s: %s (from %s%s)\n", f.name, f.file.Path, f.file.Source, exists)
+	}
+}
+
+func printProxyInfo(proxy info.ProxyInfo) {
+	for _, setting := range []struct{ name, value string }{
+		{"HTTPS proxy", proxy.HTTPSProxy},
+		{"HTTP proxy", proxy.HTTPProxy},
+		{"no proxy", proxy.NoProxy},
+	} {
+		if setting.value != "" {
+			fmt.Printf("%s: %s\n", setting.name, setting.value)
+		}
+	}
+}
+
+func printKubeconfigInfo(kubeconfig info.KubeconfigInfo) {
+	fmt.Printf("kubeconfig: %s\n", strings.Join(kubeconfig.Paths, ", "))
+	if kubeconfig.CurrentContext != "" {
+		fmt.Printf("kubeconfig context: %s (server %s)\n", kubeconfig.CurrentContext, kubeconfig.Server)
+	}
+	if kubeconfig.Healthy {
+		fmt.Printf("kubeconfig context health: ok, Kubernetes %s\n", kubeconfig.ServerVersion)
+	} else {
+		fmt.Printf("kubeconfig context health: error: %s\n", kubeconfig.Error)
+	}
+}
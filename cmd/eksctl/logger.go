@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -11,11 +12,28 @@ import (
 	"github.com/fatih/color"
 	"github.com/kris-nova/logger"
 	lol "github.com/kris-nova/lolgopher"
+	"golang.org/x/term"
+
+	"github.com/weaveworks/eksctl/pkg/progress"
+)
+
+const (
+	textLogFormat = "text"
+	jsonLogFormat = "json"
 )
 
-func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsValue bool) {
+const (
+	autoProgress  = "auto"
+	plainProgress = "plain"
+)
+
+func initLogger(level int, colorValue, logFormat string, logBuffer *bytes.Buffer, dumpLogsValue, logsToStderr bool) {
 	logger.Layout = "2006-01-02 15:04:05"
 
+	if logFormat == jsonLogFormat {
+		colorValue = "false"
+	}
+
 	var bitwiseLevel int
 	switch level {
 	case 4:
@@ -33,14 +51,19 @@ func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsV
 	}
 	logger.BitwiseLevel = bitwiseLevel
 
+	stdout, colorOutput := io.Writer(os.Stdout), color.Output
+	if logsToStderr {
+		stdout, colorOutput = os.Stderr, color.Error
+	}
+
 	if dumpLogsValue {
 		switch colorValue {
 		case "fabulous":
 			logger.Writer = io.MultiWriter(lol.NewLolWriter(), logBuffer)
 		case "true":
-			logger.Writer = io.MultiWriter(color.Output, logBuffer)
+			logger.Writer = io.MultiWriter(colorOutput, logBuffer)
 		default:
-			logger.Writer = io.MultiWriter(os.Stdout, logBuffer)
+			logger.Writer = io.MultiWriter(stdout, logBuffer)
 		}
 
 	} else {
@@ -48,12 +71,17 @@ func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsV
 		case "fabulous":
 			logger.Writer = lol.NewLolWriter()
 		case "true":
-			logger.Writer = color.Output
+			logger.Writer = colorOutput
 		default:
-			logger.Writer = os.Stdout
+			logger.Writer = stdout
 		}
 	}
 
+	if logFormat == jsonLogFormat {
+		logger.Line = jsonLine
+		return
+	}
+
 	logger.Line = func(prefix, format string, a ...interface{}) string {
 		if !strings.Contains(format, "\n") {
 			format = fmt.Sprintf("%s%s", format, "\n")
@@ -96,6 +124,62 @@ func initLogger(level int, colorValue string, logBuffer *bytes.Buffer, dumpLogsV
 	}
 }
 
+// jsonLine formats a log message as a line of JSON with its level and timestamp
+func jsonLine(prefix, format string, a ...interface{}) string {
+	var level string
+	switch prefix {
+	case logger.PreCritical:
+		level = "error"
+	case logger.PreWarning, logger.PreDeprecated:
+		level = "warning"
+	case logger.PreDebug:
+		level = "debug"
+	case logger.PreSuccess:
+		level = "success"
+	default:
+		level = "info"
+	}
+
+	line, err := json.Marshal(struct {
+		Level     string `json:"level"`
+		Timestamp string `json:"timestamp"`
+		Message   string `json:"message"`
+	}{
+		Level:     level,
+		Timestamp: time.Now().Format(time.RFC3339),
+		Message:   strings.TrimRight(fmt.Sprintf(format, a...), "\n"),
+	})
+	if err != nil {
+		return fmt.Sprintf(format, a...)
+	}
+	return string(line) + "\n"
+}
+
+// startProgressView draws the progress of the tasks below the logs, updating it in place, unless the
+// logs are plain text or stdout is not a terminal. It returns a function that stops drawing it
+func startProgressView(progressMode, logFormat string) func() {
+	if progressMode != autoProgress || logFormat != textLogFormat || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return func() {}
+	}
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		width = 0
+	}
+
+	logWriter := logger.Writer
+	view := progress.NewView(color.Output, logWriter, width)
+	logger.Writer = view
+	removeListener := progress.AddListener(view)
+	view.Start()
+	return func() {
+		view.Stop()
+		removeListener()
+		if logger.Writer == view {
+			logger.Writer = logWriter
+		}
+	}
+}
+
 func dumpLogsToDisk(logBuffer *bytes.Buffer, errorString string) error {
 
 	if _, err := os.Stat("logs/"); os.IsNotExist(err) {
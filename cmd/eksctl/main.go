@@ -11,21 +11,33 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/register"
 
 	"github.com/weaveworks/eksctl/pkg/actions/anywhere"
+	"github.com/weaveworks/eksctl/pkg/actions/plugin"
+	"github.com/weaveworks/eksctl/pkg/audit"
 	"github.com/weaveworks/eksctl/pkg/ctl/associate"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/completion"
+	"github.com/weaveworks/eksctl/pkg/ctl/cordon"
 	"github.com/weaveworks/eksctl/pkg/ctl/create"
 	"github.com/weaveworks/eksctl/pkg/ctl/delete"
 	"github.com/weaveworks/eksctl/pkg/ctl/disassociate"
+	"github.com/weaveworks/eksctl/pkg/ctl/doctor"
 	"github.com/weaveworks/eksctl/pkg/ctl/drain"
 	"github.com/weaveworks/eksctl/pkg/ctl/enable"
 	"github.com/weaveworks/eksctl/pkg/ctl/get"
+	"github.com/weaveworks/eksctl/pkg/ctl/operator"
+	"github.com/weaveworks/eksctl/pkg/ctl/preflight"
 	"github.com/weaveworks/eksctl/pkg/ctl/scale"
+	"github.com/weaveworks/eksctl/pkg/ctl/serve"
 	"github.com/weaveworks/eksctl/pkg/ctl/set"
 	"github.com/weaveworks/eksctl/pkg/ctl/unset"
 	"github.com/weaveworks/eksctl/pkg/ctl/update"
 	"github.com/weaveworks/eksctl/pkg/ctl/upgrade"
 	"github.com/weaveworks/eksctl/pkg/ctl/utils"
+	"github.com/weaveworks/eksctl/pkg/ctl/validate"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/progress"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
 )
 
 func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
@@ -40,6 +52,8 @@ func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
 	rootCmd.AddCommand(unset.Command(flagGrouping))
 	rootCmd.AddCommand(scale.Command(flagGrouping))
 	rootCmd.AddCommand(drain.Command(flagGrouping))
+	rootCmd.AddCommand(cordon.Command(flagGrouping))
+	rootCmd.AddCommand(cordon.UncordonCommand(flagGrouping))
 	rootCmd.AddCommand(enable.Command(flagGrouping))
 	rootCmd.AddCommand(register.Command(flagGrouping))
 	rootCmd.AddCommand(deregister.Command(flagGrouping))
@@ -50,6 +64,11 @@ func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
 
 	cmdutils.AddResourceCmd(flagGrouping, rootCmd, infoCmd)
 	cmdutils.AddResourceCmd(flagGrouping, rootCmd, versionCmd)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, validate.Command)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, preflight.Command)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, doctor.Command)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, serve.Command)
+	cmdutils.AddResourceCmd(flagGrouping, rootCmd, operator.Command)
 }
 
 func main() {
@@ -84,22 +103,92 @@ func main() {
 	addCommands(rootCmd, flagGrouping)
 	checkCommand(rootCmd)
 
+	if p := plugin.Find(os.Args[1:], func(command string) bool { return isBuiltinCommand(rootCmd, command) }); p != nil {
+		exitCode, err := plugin.Run(p, os.Stdin, os.Stdout, os.Stderr)
+		if err != nil {
+			fmt.Println(err.Error())
+		}
+		os.Exit(exitCode)
+	}
+
 	rootCmd.PersistentFlags().BoolP("help", "h", false, "help for this command")
 
 	loggerLevel := rootCmd.PersistentFlags().IntP("verbose", "v", 3, "set log level, use 0 to silence, 4 for debugging and 5 for debugging with AWS debug logging")
+	quiet := rootCmd.PersistentFlags().BoolP("quiet", "q", false, "only log errors, overrides --verbose, the class of a failure is told by the exit code")
 	colorValue := rootCmd.PersistentFlags().StringP("color", "C", "true", "toggle colorized logs (valid options: true, false, fabulous)")
 
+	logFormat := rootCmd.PersistentFlags().String("log-format", textLogFormat, "format of the logs (valid options: text, json), json writes one object per line with the level, timestamp and message")
+
+	progressMode := rootCmd.PersistentFlags().String("progress", autoProgress, "how to show the progress of tasks (valid options: auto, plain), auto updates the state of each task in place when attached to a terminal")
+
+	eventsFile := rootCmd.PersistentFlags().String("events-file", "", "write the lifecycle events of the tasks, e.g. started, succeeded and failed, and the ARNs of the resources created to this file as lines of JSON, use - to write them to stdout and the logs to stderr")
+
+	rootCmd.PersistentFlags().BoolVar(&eks.LogAPICalls, "log-aws-api-calls", false, "log each AWS API call with its duration, request ID and number of retries")
+
+	proxyURL := rootCmd.PersistentFlags().String("proxy-url", "", "URL of the proxy of the HTTP(S) requests to AWS, Kubernetes and the OIDC issuer, e.g. http://proxy.example.com:3128, overrides the HTTPS_PROXY and HTTP_PROXY environment variables, while NO_PROXY still applies")
+
 	dumpLogsValue := rootCmd.PersistentFlags().BoolP("dumpLogs", "d", false, "dump logs to disk on failure if set to true")
 
 	logBuffer := new(bytes.Buffer)
 
+	stopProgressView, stopEventStream := func() {}, func() {}
 	cobra.OnInitialize(func() {
-		initLogger(*loggerLevel, *colorValue, logBuffer, *dumpLogsValue)
+		eventsToStdout := *eventsFile == stdoutEvents
+		initLogger(*loggerLevel, *colorValue, *logFormat, logBuffer, *dumpLogsValue, eventsToStdout)
+		if *quiet {
+			logger.BitwiseLevel = logger.LogCritical
+		}
+		// errors are logged as JSON below rather than printed by cobra
+		rootCmd.SilenceErrors = *logFormat == jsonLogFormat
+		if !eventsToStdout && !*quiet {
+			stopProgressView = startProgressView(*progressMode, *logFormat)
+		}
 	})
 
+	telemetry := startTelemetry()
+	stopAudit := func(error, int) {}
+	rootCmd.PersistentPreRunE = func(c *cobra.Command, _ []string) error {
+		if *logFormat != textLogFormat && *logFormat != jsonLogFormat {
+			return exitcode.Wrap(exitcode.Validation, fmt.Errorf("invalid log format %q, valid options: %s, %s", *logFormat, textLogFormat, jsonLogFormat))
+		}
+		if *progressMode != autoProgress && *progressMode != plainProgress {
+			return exitcode.Wrap(exitcode.Validation, fmt.Errorf("invalid progress mode %q, valid options: %s, %s", *progressMode, autoProgress, plainProgress))
+		}
+		if *proxyURL != "" {
+			if err := proxy.Set(*proxyURL); err != nil {
+				return exitcode.Wrap(exitcode.Validation, err)
+			}
+		}
+		stop, err := startEventStream(*eventsFile)
+		if err != nil {
+			return err
+		}
+		stopEventStream = stop
+		telemetry.startCommand(c.CommandPath())
+		if audit.IsMutating(c.CommandPath()) {
+			stopAudit = audit.Start(c.CommandPath(), audit.RedactArgs(os.Args[1:], c.Flags())).Stop
+		}
+		return nil
+	}
+
 	rootCmd.SetUsageFunc(flagGrouping.Usage)
+	rootCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return exitcode.Wrap(exitcode.Validation, err)
+	})
 
-	if err := rootCmd.Execute(); err != nil {
+	taskErrors := &taskErrorRecorder{}
+	progress.AddListener(taskErrors)
+
+	err = rootCmd.Execute()
+	stopProgressView()
+	stopEventStream()
+	exitCode := taskErrors.exitCode(err)
+	telemetry.stop(err, exitCode)
+	stopAudit(err, exitCode)
+	if err != nil {
+		if rootCmd.SilenceErrors {
+			logger.Critical("%s", err.Error())
+		}
 
 		if *dumpLogsValue {
 			if dumpErr := dumpLogsToDisk(logBuffer, err.Error()); dumpErr != nil {
@@ -107,8 +196,22 @@ func main() {
 			}
 		}
 
-		os.Exit(1)
+		os.Exit(exitCode)
+	}
+}
+
+// isBuiltinCommand returns whether command is one of eksctl's, including those cobra adds when it runs
+func isBuiltinCommand(rootCmd *cobra.Command, command string) bool {
+	switch command {
+	case "help", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return true
+	}
+	for _, c := range rootCmd.Commands() {
+		if c.Name() == command || c.HasAlias(command) {
+			return true
+		}
 	}
+	return false
 }
 
 func checkCommand(rootCmd *cobra.Command) {
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/tracing"
+)
+
+// telemetry exports the traces and the metrics of a command, when configured in the environment
+type telemetry struct {
+	shutdownTracing func(context.Context) error
+	pushMetrics     func() error
+	endCommandSpan  func(error)
+	command         string
+	started         time.Time
+}
+
+func startTelemetry() *telemetry {
+	t := &telemetry{
+		shutdownTracing: func(context.Context) error { return nil },
+		pushMetrics:     func() error { return nil },
+		endCommandSpan:  func(error) {},
+		command:         "eksctl",
+		started:         time.Now(),
+	}
+
+	if shutdown, err := tracing.Setup(context.Background()); err != nil {
+		logger.Warning("traces will not be exported: %v", err)
+	} else {
+		t.shutdownTracing = shutdown
+	}
+	if push, err := metrics.Setup(); err != nil {
+		logger.Warning("metrics will not be exported: %v", err)
+	} else {
+		t.pushMetrics = push
+	}
+	return t
+}
+
+// startCommand starts the span of command, which is also the command the duration is recorded for
+func (t *telemetry) startCommand(command string) {
+	t.command = command
+	t.endCommandSpan = tracing.StartCommand(command)
+}
+
+// stop records the result of the command and exports the traces and metrics that have not been yet
+func (t *telemetry) stop(err error, exitCode int) {
+	t.endCommandSpan(err)
+	metrics.ObserveCommand(t.command, time.Since(t.started), exitCode)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := t.shutdownTracing(ctx); err != nil {
+		logger.Warning("exporting traces: %v", err)
+	}
+	if err := t.pushMetrics(); err != nil {
+		logger.Warning("exporting metrics: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// ValidateConfigurationValues validates the configurationValues of addon against the configuration schema
+// published for the version of the addon that would be installed
+func (a *Manager) ValidateConfigurationValues(ctx context.Context, addon *api.Addon) error {
+	if addon.ConfigurationValues == "" {
+		return nil
+	}
+	version, err := a.resolveVersion(ctx, addon)
+	if err != nil {
+		return err
+	}
+	return a.validateConfigurationValues(ctx, addon, version)
+}
+
+// resolveVersion returns the version of addon that would be installed, which is the default version
+// for the cluster version when addon.version is not set
+func (a *Manager) resolveVersion(ctx context.Context, addon *api.Addon) (string, error) {
+	if addon.Version != "" {
+		version, err := a.getLatestMatchingVersion(ctx, addon)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch version %s for addon %s: %w", addon.Version, addon.Name, err)
+		}
+		return version, nil
+	}
+	addonInfos, err := a.describeVersions(ctx, addon)
+	if err != nil {
+		return "", err
+	}
+	for _, addonInfo := range addonInfos.Addons {
+		for _, versionInfo := range addonInfo.AddonVersions {
+			for _, compatibility := range versionInfo.Compatibilities {
+				if compatibility.DefaultVersion {
+					return *versionInfo.AddonVersion, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("no default version found for addon %s", addon.Name)
+}
+
+func (a *Manager) validateConfigurationValues(ctx context.Context, addon *api.Addon, version string) error {
+	if addon.ConfigurationValues == "" {
+		return nil
+	}
+	output, err := a.eksAPI.DescribeAddonConfiguration(ctx, &eks.DescribeAddonConfigurationInput{
+		AddonName:    &addon.Name,
+		AddonVersion: &version,
+	})
+	if err != nil {
+		return fmt.Errorf("describing configuration schema of addon %s@%s: %w", addon.Name, version, err)
+	}
+	if output.ConfigurationSchema == nil || *output.ConfigurationSchema == "" {
+		return fmt.Errorf("addon %s@%s does not support configurationValues", addon.Name, version)
+	}
+
+	// configurationValues can be either JSON or YAML, YAMLToJSON handles both
+	values, err := yaml.YAMLToJSON([]byte(addon.ConfigurationValues))
+	if err != nil {
+		return fmt.Errorf("configurationValues of addon %s: %w", addon.Name, err)
+	}
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(*output.ConfigurationSchema), gojsonschema.NewBytesLoader(values))
+	if err != nil {
+		return fmt.Errorf("validating configurationValues of addon %s@%s: %w", addon.Name, version, err)
+	}
+	if !result.Valid() {
+		var errs []string
+		for _, resultErr := range result.Errors() {
+			errs = append(errs, resultErr.String())
+		}
+		return fmt.Errorf("configurationValues of addon %s do not match the configuration schema of version %s: %s; run 'eksctl utils describe-addon-configuration --name %s --version %s' to view the schema",
+			addon.Name, version, strings.Join(errs, "; "), addon.Name, version)
+	}
+	logger.Debug("configurationValues of addon %s match the configuration schema of version %s", addon.Name, version)
+	return nil
+}
@@ -26,6 +26,10 @@ const (
 )
 
 func (a *Manager) Create(ctx context.Context, addon *api.Addon, waitTimeout time.Duration) error {
+	if err := addon.ValidateForClusterVersion(a.clusterConfig.Metadata.Version); err != nil {
+		return err
+	}
+
 	// First check if the addon is already present as an EKS managed addon
 	// in a state different from CREATE_FAILED, and if so, don't re-create
 	var notFoundErr *ekstypes.ResourceNotFoundException
@@ -53,6 +57,16 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, waitTimeout time
 	}
 	var configurationValues *string
 	if addon.ConfigurationValues != "" {
+		// EKS installs the default version when no version is set, so validate against its schema
+		schemaVersion := version
+		if schemaVersion == "" {
+			if schemaVersion, err = a.resolveVersion(ctx, addon); err != nil {
+				return err
+			}
+		}
+		if err := a.validateConfigurationValues(ctx, addon, schemaVersion); err != nil {
+			return err
+		}
 		configurationValues = &addon.ConfigurationValues
 	}
 	createAddonInput := &eks.CreateAddonInput{
@@ -76,9 +90,7 @@ func (a *Manager) Create(ctx context.Context, addon *api.Addon, waitTimeout time
 	logger.Debug("addon: %v", addon)
 	namespace, serviceAccount := a.getKnownServiceAccountLocation(addon)
 
-	if len(addon.Tags) > 0 {
-		createAddonInput.Tags = addon.Tags
-	}
+	createAddonInput.Tags = a.ownershipTags(addon)
 	if a.withOIDC {
 		if addon.ServiceAccountRoleARN != "" {
 			logger.Info("using provided ServiceAccountRoleARN %q", addon.ServiceAccountRoleARN)
@@ -347,3 +359,12 @@ func makeIPv6VPCCNIPolicyDocument(partition string) map[string]interface{} {
 		},
 	}
 }
+
+// ownershipTags returns the tags of addon along with the tags recording that it was created by eksctl, and by whom
+func (a *Manager) ownershipTags(addon *api.Addon) map[string]string {
+	var owner string
+	if a.stackManager != nil {
+		owner = a.stackManager.Owner()
+	}
+	return api.WithOwnershipTags(addon.Tags, owner)
+}
@@ -113,6 +113,12 @@ var _ = Describe("Create", func() {
 						},
 						{
 							AddonVersion: aws.String("v1.7.5-eksbuild.2"),
+							Compatibilities: []ekstypes.Compatibility{
+								{
+									ClusterVersion: aws.String("1.18"),
+									DefaultVersion: true,
+								},
+							},
 						},
 						{
 							//not sure if all versions come with v prefix or not, so test a mix
@@ -430,17 +436,52 @@ var _ = Describe("Create", func() {
 	})
 
 	When("configurationValues is configured", func() {
-		addon := &api.Addon{
-			Name:                "my-addon",
-			Version:             "latest",
-			ConfigurationValues: "{\"replicaCount\":3}",
-		}
+		var describeAddonConfigurationInput *eks.DescribeAddonConfigurationInput
+
+		JustBeforeEach(func() {
+			mockProvider.MockEKS().On("DescribeAddonConfiguration", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args).To(HaveLen(2))
+				Expect(args[1]).To(BeAssignableToTypeOf(&eks.DescribeAddonConfigurationInput{}))
+				describeAddonConfigurationInput = args[1].(*eks.DescribeAddonConfigurationInput)
+			}).Return(&eks.DescribeAddonConfigurationOutput{
+				ConfigurationSchema: aws.String(`{"type":"object","properties":{"replicaCount":{"type":"integer"}},"additionalProperties":false}`),
+			}, nil)
+		})
+
 		It("sends the value to the AWS EKS API", func() {
+			addon := &api.Addon{
+				Name:                "my-addon",
+				Version:             "latest",
+				ConfigurationValues: "{\"replicaCount\":3}",
+			}
 			err := manager.Create(context.Background(), addon, 0)
 
 			Expect(err).NotTo(HaveOccurred())
+			Expect(*describeAddonConfigurationInput.AddonVersion).To(Equal("v1.7.7-eksbuild.2"))
 			Expect(*createAddonInput.ConfigurationValues).To(Equal(addon.ConfigurationValues))
 		})
+
+		It("validates the value against the schema of the default version when no version is set", func() {
+			err := manager.Create(context.Background(), &api.Addon{
+				Name:                "my-addon",
+				ConfigurationValues: "replicaCount: 3",
+			}, 0)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*describeAddonConfigurationInput.AddonVersion).To(Equal("v1.7.5-eksbuild.2"))
+			Expect(*createAddonInput.AddonVersion).To(BeEmpty())
+		})
+
+		It("does not create the addon when the value does not match the schema", func() {
+			err := manager.Create(context.Background(), &api.Addon{
+				Name:                "my-addon",
+				Version:             "latest",
+				ConfigurationValues: "{\"replicaCont\":3}",
+			}, 0)
+
+			Expect(err).To(MatchError(ContainSubstring("configurationValues of addon my-addon do not match the configuration schema of version v1.7.7-eksbuild.2: (root): Additional property replicaCont is not allowed")))
+			mockProvider.MockEKS().AssertNotCalled(GinkgoT(), "CreateAddon", mock.Anything, mock.Anything)
+		})
 	})
 
 	When("force is true", func() {
@@ -735,4 +776,19 @@ var _ = Describe("Create", func() {
 			Expect(createAddonInput.Tags["fox"]).To(Equal("brown"))
 		})
 	})
+
+	It("tags the addon as created by eksctl and with its owner", func() {
+		fakeStackManager.OwnerReturns("arn:aws:iam::123456789012:user/alice")
+		err := manager.Create(context.Background(), &api.Addon{
+			Name:    "my-addon",
+			Version: "v1.0.0-eksbuild.1",
+			Tags:    map[string]string{"foo": "bar", api.CreatedByTag: "someone-else"},
+		}, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(createAddonInput.Tags).To(Equal(map[string]string{
+			"foo":            "bar",
+			api.CreatedByTag: api.CreatedByTagValue,
+			api.OwnerTag:     "arn:aws:iam::123456789012:user/alice",
+		}))
+	})
 })
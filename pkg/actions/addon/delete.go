@@ -52,6 +52,23 @@ func (a *Manager) Delete(ctx context.Context, addon *api.Addon) error {
 	return nil
 }
 
+// IsOwned returns whether addon was created by eksctl, which tags the addons it creates with api.CreatedByTag.
+// An addon that does not exist is reported as owned, leaving Delete to clean up its IAM stack
+func (a *Manager) IsOwned(ctx context.Context, addon *api.Addon) (bool, error) {
+	output, err := a.eksAPI.DescribeAddon(ctx, &eks.DescribeAddonInput{
+		AddonName:   &addon.Name,
+		ClusterName: &a.clusterConfig.Metadata.Name,
+	})
+	if err != nil {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to describe addon %q: %w", addon.Name, err)
+	}
+	return output.Addon.Tags[api.CreatedByTag] == api.CreatedByTagValue, nil
+}
+
 func (a *Manager) deleteAddon(ctx context.Context, addon *api.Addon, preserve bool) (addonExists bool, err error) {
 	_, err = a.eksAPI.DeleteAddon(ctx, &eks.DeleteAddonInput{
 		AddonName:   &addon.Name,
@@ -180,6 +180,42 @@ var _ = Describe("Delete", func() {
 		})
 	})
 
+	Describe("IsOwned", func() {
+		BeforeEach(func() {
+			mockProvider = mockprovider.NewMockProvider()
+
+			var err error
+			manager, err = addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+				Version: "1.18",
+				Name:    "my-cluster",
+			}}, mockProvider.EKS(), new(fakes.FakeStackManager), false, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		mockDescribeAddon := func(tags map[string]string) {
+			mockProvider.MockEKS().On("DescribeAddon", mock.Anything, &awseks.DescribeAddonInput{
+				AddonName:   aws.String("my-addon"),
+				ClusterName: aws.String("my-cluster"),
+			}).Return(&awseks.DescribeAddonOutput{
+				Addon: &ekstypes.Addon{Tags: tags},
+			}, nil)
+		}
+
+		It("reports an addon tagged as created by eksctl as owned", func() {
+			mockDescribeAddon(map[string]string{api.CreatedByTag: api.CreatedByTagValue})
+			owned, err := manager.IsOwned(context.Background(), &api.Addon{Name: "my-addon"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owned).To(BeTrue())
+		})
+
+		It("reports an addon not tagged as created by eksctl as not owned", func() {
+			mockDescribeAddon(map[string]string{"foo": "bar"})
+			owned, err := manager.IsOwned(context.Background(), &api.Addon{Name: "my-addon"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owned).To(BeFalse())
+		})
+	})
+
 	Describe("DeleteWithPreserve", func() {
 		BeforeEach(func() {
 			withOIDC = false
@@ -8,18 +8,24 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 
 	"github.com/blang/semver"
+	"github.com/hashicorp/go-version"
 
 	"github.com/kris-nova/logger"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 
 	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 )
 
 type Summary struct {
-	Name                string
-	Version             string
-	NewerVersion        string
+	Name         string
+	Version      string
+	NewerVersion string
+	// DefaultVersion is the version installed by default for the Kubernetes version of the cluster
+	DefaultVersion string
+	// LatestVersion is the latest version compatible with the Kubernetes version of the cluster
+	LatestVersion       string
 	IAMRole             string
 	Status              string
 	ConfigurationValues string
@@ -67,10 +73,12 @@ func (a *Manager) Get(ctx context.Context, addon *api.Addon) (Summary, error) {
 		addonWithVersion.Version = *output.Addon.AddonVersion
 	}
 
-	newerVersion, err := a.findNewerVersions(ctx, addonWithVersion)
+	versions, err := a.describeVersions(ctx, addonWithVersion)
 	if err != nil {
 		return Summary{}, err
 	}
+	newerVersion := findNewerVersions(addonWithVersion.Version, versions)
+	defaultVersion, latestVersion := findDefaultAndLatestVersions(versions)
 
 	configurationValues := ""
 	if output.Addon.ConfigurationValues != nil {
@@ -83,6 +91,8 @@ func (a *Manager) Get(ctx context.Context, addon *api.Addon) (Summary, error) {
 		IAMRole:             serviceAccountRoleARN,
 		Status:              string(output.Addon.Status),
 		NewerVersion:        newerVersion,
+		DefaultVersion:      defaultVersion,
+		LatestVersion:       latestVersion,
 		ConfigurationValues: configurationValues,
 		Issues:              issues,
 	}, nil
@@ -108,30 +118,77 @@ func (a *Manager) GetAll(ctx context.Context) ([]Summary, error) {
 	return summaries, nil
 }
 
-func (a *Manager) findNewerVersions(ctx context.Context, addon *api.Addon) (string, error) {
+// AvailableAddon is an addon that is not installed and can be installed on the cluster
+type AvailableAddon struct {
+	Name           string
+	Type           string
+	Owner          string
+	Publisher      string
+	DefaultVersion string
+	LatestVersion  string
+}
+
+// GetAvailable returns the addons compatible with the Kubernetes version of the cluster that are not installed
+func (a *Manager) GetAvailable(ctx context.Context) ([]AvailableAddon, error) {
+	logger.Info("getting addons available for Kubernetes version %q", a.clusterConfig.Metadata.Version)
+	output, err := a.eksAPI.ListAddons(ctx, &eks.ListAddonsInput{
+		ClusterName: &a.clusterConfig.Metadata.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list addons: %v", err)
+	}
+	installed := make(map[string]struct{}, len(output.Addons))
+	for _, name := range output.Addons {
+		installed[name] = struct{}{}
+	}
+
+	var available []AvailableAddon
+	paginator := eks.NewDescribeAddonVersionsPaginator(a.eksAPI, &eks.DescribeAddonVersionsInput{
+		KubernetesVersion: &a.clusterConfig.Metadata.Version,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe addon versions: %v", err)
+		}
+		for _, addonInfo := range page.Addons {
+			name := aws.ToString(addonInfo.AddonName)
+			if _, ok := installed[name]; ok {
+				continue
+			}
+			defaultVersion, latestVersion := defaultAndLatestVersions(addonInfo)
+			available = append(available, AvailableAddon{
+				Name:           name,
+				Type:           aws.ToString(addonInfo.Type),
+				Owner:          aws.ToString(addonInfo.Owner),
+				Publisher:      aws.ToString(addonInfo.Publisher),
+				DefaultVersion: defaultVersion,
+				LatestVersion:  latestVersion,
+			})
+		}
+	}
+	return available, nil
+}
+
+func findNewerVersions(addonVersion string, versions *eks.DescribeAddonVersionsOutput) string {
 	var newerVersions []string
-	currentVersion, err := semver.Parse(strings.TrimPrefix(addon.Version, "v"))
+	currentVersion, err := semver.Parse(strings.TrimPrefix(addonVersion, "v"))
 	if err != nil {
-		logger.Debug("could not parse version %q, skipping finding newer versions: %v", addon.Version, err)
-		return "-", nil
+		logger.Debug("could not parse version %q, skipping finding newer versions: %v", addonVersion, err)
+		return "-"
 	}
 	//trim off anything after x.y.z so its not used in comparison, e.g. 1.7.5-eksbuild.1 > 1.7.5
 	currentVersion.Build = []string{}
 	currentVersion.Pre = []semver.PRVersion{}
 
-	versions, err := a.describeVersions(ctx, addon)
-	if err != nil {
-		return "", err
-	}
-
 	if len(versions.Addons) == 0 {
-		return "-", nil
+		return "-"
 	}
 
 	for _, versionInfo := range versions.Addons[0].AddonVersions {
 		version, err := semver.Parse(strings.TrimPrefix(*versionInfo.AddonVersion, "v"))
 		if err != nil {
-			logger.Debug("could not parse version %q, skipping version comparison: %v", addon.Version, err)
+			logger.Debug("could not parse version %q, skipping version comparison: %v", addonVersion, err)
 		} else {
 			//trim off anything after x.y.z and don't use in comparison, e.g. v1.7.5-eksbuild.1 > v1.7.5
 			version.Build = []string{}
@@ -141,5 +198,39 @@ func (a *Manager) findNewerVersions(ctx context.Context, addon *api.Addon) (stri
 			}
 		}
 	}
-	return strings.Join(newerVersions, ","), nil
+	return strings.Join(newerVersions, ",")
+}
+
+// findDefaultAndLatestVersions returns the default and the latest version of the first addon in versions
+func findDefaultAndLatestVersions(versions *eks.DescribeAddonVersionsOutput) (string, string) {
+	if len(versions.Addons) == 0 {
+		return "", ""
+	}
+	return defaultAndLatestVersions(versions.Addons[0])
+}
+
+func defaultAndLatestVersions(addonInfo ekstypes.AddonInfo) (string, string) {
+	var (
+		defaultVersion string
+		latestVersion  *version.Version
+	)
+	for _, versionInfo := range addonInfo.AddonVersions {
+		for _, compatibility := range versionInfo.Compatibilities {
+			if compatibility.DefaultVersion {
+				defaultVersion = *versionInfo.AddonVersion
+			}
+		}
+		v, err := version.NewVersion(*versionInfo.AddonVersion)
+		if err != nil {
+			logger.Debug("could not parse version %q, skipping version comparison: %v", *versionInfo.AddonVersion, err)
+			continue
+		}
+		if latestVersion == nil || latestVersion.LessThan(v) {
+			latestVersion = v
+		}
+	}
+	if latestVersion == nil {
+		return defaultVersion, ""
+	}
+	return defaultVersion, latestVersion.Original()
 }
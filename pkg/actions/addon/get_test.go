@@ -50,6 +50,11 @@ var _ = Describe("Get", func() {
 							{
 								//not sure if all versions come with v prefix or not, so test a mix
 								AddonVersion: aws.String("v1.1.0"),
+								Compatibilities: []ekstypes.Compatibility{
+									{
+										DefaultVersion: true,
+									},
+								},
 							},
 							{
 								AddonVersion: aws.String("1.2.0"),
@@ -86,11 +91,13 @@ var _ = Describe("Get", func() {
 			})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(summary).To(Equal(addon.Summary{
-				Name:         "my-addon",
-				Version:      "v1.0.0",
-				NewerVersion: "v1.1.0,1.2.0",
-				IAMRole:      "foo",
-				Status:       "created",
+				Name:           "my-addon",
+				Version:        "v1.0.0",
+				NewerVersion:   "v1.1.0,1.2.0",
+				DefaultVersion: "v1.1.0",
+				LatestVersion:  "1.2.0",
+				IAMRole:        "foo",
+				Status:         "created",
 				Issues: []addon.Issue{
 					{
 						Code:        "1",
@@ -178,6 +185,7 @@ var _ = Describe("Get", func() {
 					Name:                "my-addon",
 					Version:             "1.0.0",
 					NewerVersion:        "v1.1.0,1.2.0",
+					LatestVersion:       "1.2.0",
 					IAMRole:             "foo",
 					Status:              "created",
 					ConfigurationValues: "{\"replicaCount\":3}",
@@ -230,4 +238,84 @@ var _ = Describe("Get", func() {
 			})
 		})
 	})
+
+	Describe("GetAvailable", func() {
+		var describeAddonVersionsInput *awseks.DescribeAddonVersionsInput
+		It("returns the addons that are not installed", func() {
+			mockProvider.MockEKS().On("ListAddons", mock.Anything, mock.Anything).Return(&awseks.ListAddonsOutput{
+				Addons: []string{"vpc-cni"},
+			}, nil)
+			mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonVersionsInput{}))
+				describeAddonVersionsInput = args[1].(*awseks.DescribeAddonVersionsInput)
+			}).Return(&awseks.DescribeAddonVersionsOutput{
+				Addons: []ekstypes.AddonInfo{
+					{
+						AddonName: aws.String("vpc-cni"),
+						AddonVersions: []ekstypes.AddonVersionInfo{
+							{
+								AddonVersion: aws.String("v1.12.0-eksbuild.1"),
+							},
+						},
+					},
+					{
+						AddonName: aws.String("coredns"),
+						Type:      aws.String("networking"),
+						Owner:     aws.String("aws"),
+						Publisher: aws.String("eks"),
+						AddonVersions: []ekstypes.AddonVersionInfo{
+							{
+								AddonVersion: aws.String("v1.8.7-eksbuild.3"),
+							},
+							{
+								AddonVersion: aws.String("v1.8.7-eksbuild.2"),
+								Compatibilities: []ekstypes.Compatibility{
+									{
+										DefaultVersion: true,
+									},
+								},
+							},
+							{
+								AddonVersion: aws.String("v1.8.4-eksbuild.1"),
+							},
+						},
+					},
+				},
+			}, nil)
+
+			available, err := manager.GetAvailable(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(available).To(Equal([]addon.AvailableAddon{
+				{
+					Name:           "coredns",
+					Type:           "networking",
+					Owner:          "aws",
+					Publisher:      "eks",
+					DefaultVersion: "v1.8.7-eksbuild.2",
+					LatestVersion:  "v1.8.7-eksbuild.3",
+				},
+			}))
+			Expect(*describeAddonVersionsInput.KubernetesVersion).To(Equal("1.18"))
+			Expect(describeAddonVersionsInput.AddonName).To(BeNil())
+		})
+
+		When("it fails to list addons", func() {
+			It("returns an error", func() {
+				mockProvider.MockEKS().On("ListAddons", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("foo"))
+
+				_, err := manager.GetAvailable(context.Background())
+				Expect(err).To(MatchError(`failed to list addons: foo`))
+			})
+		})
+
+		When("it fails to describe addon versions", func() {
+			It("returns an error", func() {
+				mockProvider.MockEKS().On("ListAddons", mock.Anything, mock.Anything).Return(&awseks.ListAddonsOutput{}, nil)
+				mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("foo"))
+
+				_, err := manager.GetAvailable(context.Background())
+				Expect(err).To(MatchError(`failed to describe addon versions: foo`))
+			})
+		})
+	})
 })
@@ -0,0 +1,72 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kris-nova/logger"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// RestartedAtAnnotation is set on the pod template of a workload to trigger a rollout restart
+const RestartedAtAnnotation = "eksctl.io/restartedAt"
+
+const (
+	deploymentKind = "deployment"
+	daemonSetKind  = "daemonset"
+)
+
+type workload struct {
+	kind string
+	name string
+}
+
+// addonWorkloads lists the workloads in kube-system of the addons whose pods can be restarted
+var addonWorkloads = map[string][]workload{
+	api.VPCCNIAddon:    {{kind: daemonSetKind, name: "aws-node"}},
+	api.KubeProxyAddon: {{kind: daemonSetKind, name: "kube-proxy"}},
+	api.CoreDNSAddon:   {{kind: deploymentKind, name: "coredns"}},
+	api.AWSEBSCSIDriverAddon: {
+		{kind: deploymentKind, name: "ebs-csi-controller"},
+		{kind: daemonSetKind, name: "ebs-csi-node"},
+	},
+	api.AWSEFSCSIDriverAddon: {
+		{kind: deploymentKind, name: "efs-csi-controller"},
+		{kind: daemonSetKind, name: "efs-csi-node"},
+	},
+}
+
+// RestartWorkloads performs a rollout restart of the deployments and daemonsets of addon, so that
+// its pods pick up configuration changes. Addons whose workloads are not known are skipped.
+func (a *Manager) RestartWorkloads(ctx context.Context, addon *api.Addon) error {
+	workloads, ok := addonWorkloads[addon.CanonicalName()]
+	if !ok {
+		logger.Warning("restarting the pods of addon %q is not supported, skipping", addon.Name)
+		return nil
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`, RestartedAtAnnotation, time.Now().Format(time.RFC3339)))
+	for _, w := range workloads {
+		var err error
+		switch w.kind {
+		case deploymentKind:
+			_, err = a.clientSet.AppsV1().Deployments(kubeSystemNamespace).Patch(ctx, w.name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+		case daemonSetKind:
+			_, err = a.clientSet.AppsV1().DaemonSets(kubeSystemNamespace).Patch(ctx, w.name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+		}
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				logger.Debug("%s %q of addon %q not found, skipping restart", w.kind, w.name, addon.Name)
+				continue
+			}
+			return fmt.Errorf("restarting %s %q of addon %q: %w", w.kind, w.name, addon.Name, err)
+		}
+		logger.Info(`%s "%s/%s" restarted`, w.kind, kubeSystemNamespace, w.name)
+	}
+	return nil
+}
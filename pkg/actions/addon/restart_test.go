@@ -0,0 +1,56 @@
+package addon_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("RestartWorkloads", func() {
+	var (
+		manager   *addon.Manager
+		clientSet *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		clientSet = fake.NewSimpleClientset(
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: "kube-system"}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "ebs-csi-controller", Namespace: "kube-system"}},
+		)
+		var err error
+		manager, err = addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+			Version: "1.24",
+			Name:    "my-cluster",
+		}}, mockprovider.NewMockProvider().EKS(), nil, false, nil, clientSet)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("restarts the daemonsets of the addon", func() {
+		Expect(manager.RestartWorkloads(context.Background(), &api.Addon{Name: "vpc-cni"})).To(Succeed())
+
+		ds, err := clientSet.AppsV1().DaemonSets("kube-system").Get(context.Background(), "aws-node", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ds.Spec.Template.Annotations).To(HaveKey(addon.RestartedAtAnnotation))
+	})
+
+	It("skips the workloads of the addon that do not exist", func() {
+		Expect(manager.RestartWorkloads(context.Background(), &api.Addon{Name: "aws-ebs-csi-driver"})).To(Succeed())
+
+		deployment, err := clientSet.AppsV1().Deployments("kube-system").Get(context.Background(), "ebs-csi-controller", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deployment.Spec.Template.Annotations).To(HaveKey(addon.RestartedAtAnnotation))
+	})
+
+	It("skips addons whose workloads are not known", func() {
+		Expect(manager.RestartWorkloads(context.Background(), &api.Addon{Name: "my-addon"})).To(Succeed())
+		Expect(clientSet.Actions()).To(BeEmpty())
+	})
+})
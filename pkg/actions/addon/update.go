@@ -18,6 +18,10 @@ import (
 func (a *Manager) Update(ctx context.Context, addon *api.Addon, waitTimeout time.Duration) error {
 	logger.Debug("addon: %v", addon)
 
+	if err := addon.ValidateForClusterVersion(a.clusterConfig.Metadata.Version); err != nil {
+		return err
+	}
+
 	var configurationValues *string
 	if addon.ConfigurationValues != "" {
 		configurationValues = &addon.ConfigurationValues
@@ -59,6 +63,10 @@ func (a *Manager) Update(ctx context.Context, addon *api.Addon, waitTimeout time
 		updateAddonInput.AddonVersion = &version
 	}
 
+	if err := a.validateConfigurationValues(ctx, addon, *updateAddonInput.AddonVersion); err != nil {
+		return err
+	}
+
 	//check if we have been provided a different set of policies/role
 	if addon.ServiceAccountRoleARN != "" {
 		updateAddonInput.ServiceAccountRoleArn = &addon.ServiceAccountRoleARN
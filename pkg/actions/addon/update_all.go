@@ -0,0 +1,154 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/hashicorp/go-version"
+	"github.com/kris-nova/logger"
+	"golang.org/x/sync/errgroup"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Version policies resolving the version installed addons are updated to
+const (
+	// VersionPolicyLatest updates addons to the latest version compatible with the cluster
+	VersionPolicyLatest = "latest"
+	// VersionPolicyLatestPatch updates addons to the latest version with the same major and minor version
+	VersionPolicyLatestPatch = "latest-patch"
+	// VersionPolicyDefault updates addons to the default version for the cluster
+	VersionPolicyDefault = "default"
+)
+
+// VersionPolicies returns the supported version policies
+func VersionPolicies() []string {
+	return []string{VersionPolicyLatest, VersionPolicyLatestPatch, VersionPolicyDefault}
+}
+
+// PlannedUpdate is a version change of an installed addon
+type PlannedUpdate struct {
+	Name           string
+	CurrentVersion string
+	TargetVersion  string
+}
+
+// PlanUpdates resolves the version of every installed addon according to policy, and returns
+// the addons whose resolved version is newer than the installed one
+func (a *Manager) PlanUpdates(ctx context.Context, policy string) ([]PlannedUpdate, error) {
+	output, err := a.eksAPI.ListAddons(ctx, &eks.ListAddonsInput{
+		ClusterName: &a.clusterConfig.Metadata.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list addons: %v", err)
+	}
+
+	var updates []PlannedUpdate
+	for _, name := range output.Addons {
+		summary, err := a.eksAPI.DescribeAddon(ctx, &eks.DescribeAddonInput{
+			ClusterName: &a.clusterConfig.Metadata.Name,
+			AddonName:   &name,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get addon %q: %v", name, err)
+		}
+		currentVersion := *summary.Addon.AddonVersion
+		targetVersion, err := a.resolvePolicyVersion(ctx, name, currentVersion, policy)
+		if err != nil {
+			return nil, err
+		}
+		if targetVersion == "" {
+			logger.Info("addon %s is up to date at version %s", name, currentVersion)
+			continue
+		}
+		updates = append(updates, PlannedUpdate{
+			Name:           name,
+			CurrentVersion: currentVersion,
+			TargetVersion:  targetVersion,
+		})
+	}
+	return updates, nil
+}
+
+// resolvePolicyVersion returns the version of the addon selected by policy, or an empty string
+// if it is not newer than currentVersion
+func (a *Manager) resolvePolicyVersion(ctx context.Context, name, currentVersion, policy string) (string, error) {
+	current, err := a.parseVersion(currentVersion)
+	if err != nil {
+		return "", err
+	}
+	addonInfos, err := a.describeVersions(ctx, &api.Addon{Name: name})
+	if err != nil {
+		return "", err
+	}
+	if len(addonInfos.Addons) == 0 {
+		return "", fmt.Errorf("no versions available for %q", name)
+	}
+
+	var candidates []*version.Version
+	for _, versionInfo := range addonInfos.Addons[0].AddonVersions {
+		v, err := a.parseVersion(*versionInfo.AddonVersion)
+		if err != nil {
+			return "", err
+		}
+		switch policy {
+		case VersionPolicyLatest:
+			candidates = append(candidates, v)
+		case VersionPolicyLatestPatch:
+			if sameMinorVersion(v, current) {
+				candidates = append(candidates, v)
+			}
+		case VersionPolicyDefault:
+			for _, compatibility := range versionInfo.Compatibilities {
+				if compatibility.DefaultVersion {
+					candidates = append(candidates, v)
+					break
+				}
+			}
+		default:
+			return "", fmt.Errorf("unsupported version policy %q, must be one of %v", policy, VersionPolicies())
+		}
+	}
+	if len(candidates) == 0 {
+		return "", nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[j].LessThan(candidates[i])
+	})
+	if !current.LessThan(candidates[0]) {
+		return "", nil
+	}
+	return candidates[0].Original(), nil
+}
+
+func sameMinorVersion(v1, v2 *version.Version) bool {
+	s1, s2 := v1.Segments(), v2.Segments()
+	return s1[0] == s2[0] && s1[1] == s2[1]
+}
+
+// ApplyUpdates updates addons to their planned versions, running at most parallel updates at a time.
+// The configuration and IAM role of the addons are preserved.
+func (a *Manager) ApplyUpdates(ctx context.Context, updates []PlannedUpdate, parallel int, force bool, waitTimeout time.Duration) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(parallel)
+	for _, update := range updates {
+		update := update
+		g.Go(func() error {
+			logger.Info("updating addon %s from version %s to %s", update.Name, update.CurrentVersion, update.TargetVersion)
+			return a.Update(ctx, &api.Addon{
+				Name:    update.Name,
+				Version: update.TargetVersion,
+				Force:   force,
+			}, waitTimeout)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	logger.Success("updated %d addon(s)", len(updates))
+	return nil
+}
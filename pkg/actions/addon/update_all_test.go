@@ -0,0 +1,124 @@
+package addon_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Update all", func() {
+	var (
+		addonManager *addon.Manager
+		mockProvider *mockprovider.MockProvider
+	)
+
+	addonVersions := map[string][]ekstypes.AddonVersionInfo{
+		"vpc-cni": {
+			{AddonVersion: aws.String("v1.12.6-eksbuild.1")},
+			{AddonVersion: aws.String("v1.12.6-eksbuild.2"), Compatibilities: []ekstypes.Compatibility{{DefaultVersion: true}}},
+			{AddonVersion: aws.String("v1.13.2-eksbuild.1")},
+		},
+		"coredns": {
+			{AddonVersion: aws.String("v1.9.3-eksbuild.3"), Compatibilities: []ekstypes.Compatibility{{DefaultVersion: true}}},
+			{AddonVersion: aws.String("v1.9.3-eksbuild.5")},
+		},
+		"kube-proxy": {
+			{AddonVersion: aws.String("v1.25.9-eksbuild.1"), Compatibilities: []ekstypes.Compatibility{{DefaultVersion: true}}},
+		},
+	}
+	installedVersions := map[string]string{
+		"vpc-cni":    "v1.12.6-eksbuild.1",
+		"coredns":    "v1.9.3-eksbuild.3",
+		"kube-proxy": "v1.25.9-eksbuild.1",
+	}
+
+	BeforeEach(func() {
+		mockProvider = mockprovider.NewMockProvider()
+		mockProvider.MockEKS().On("ListAddons", mock.Anything, mock.Anything).Return(&awseks.ListAddonsOutput{
+			Addons: []string{"vpc-cni", "coredns", "kube-proxy"},
+		}, nil)
+		mockProvider.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Return(func(_ context.Context, input *awseks.DescribeAddonInput, _ ...func(*awseks.Options)) *awseks.DescribeAddonOutput {
+			return &awseks.DescribeAddonOutput{
+				Addon: &ekstypes.Addon{
+					AddonName:    input.AddonName,
+					AddonVersion: aws.String(installedVersions[*input.AddonName]),
+				},
+			}
+		}, nil)
+		mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything, mock.Anything).Return(func(_ context.Context, input *awseks.DescribeAddonVersionsInput, _ ...func(*awseks.Options)) *awseks.DescribeAddonVersionsOutput {
+			Expect(*input.KubernetesVersion).To(Equal("1.25"))
+			return &awseks.DescribeAddonVersionsOutput{
+				Addons: []ekstypes.AddonInfo{
+					{
+						AddonName:     input.AddonName,
+						AddonVersions: addonVersions[*input.AddonName],
+					},
+				},
+			}
+		}, nil)
+
+		var err error
+		addonManager, err = addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+			Version: "1.25",
+			Name:    "my-cluster",
+		}}, mockProvider.EKS(), new(fakes.FakeStackManager), false, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("plans the version changes", func(policy string, expectedUpdates []addon.PlannedUpdate) {
+		updates, err := addonManager.PlanUpdates(context.Background(), policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updates).To(Equal(expectedUpdates))
+	},
+		Entry("latest", addon.VersionPolicyLatest, []addon.PlannedUpdate{
+			{Name: "vpc-cni", CurrentVersion: "v1.12.6-eksbuild.1", TargetVersion: "v1.13.2-eksbuild.1"},
+			{Name: "coredns", CurrentVersion: "v1.9.3-eksbuild.3", TargetVersion: "v1.9.3-eksbuild.5"},
+		}),
+		Entry("latest-patch", addon.VersionPolicyLatestPatch, []addon.PlannedUpdate{
+			{Name: "vpc-cni", CurrentVersion: "v1.12.6-eksbuild.1", TargetVersion: "v1.12.6-eksbuild.2"},
+			{Name: "coredns", CurrentVersion: "v1.9.3-eksbuild.3", TargetVersion: "v1.9.3-eksbuild.5"},
+		}),
+		Entry("default", addon.VersionPolicyDefault, []addon.PlannedUpdate{
+			{Name: "vpc-cni", CurrentVersion: "v1.12.6-eksbuild.1", TargetVersion: "v1.12.6-eksbuild.2"},
+		}),
+	)
+
+	It("rejects unknown version policies", func() {
+		_, err := addonManager.PlanUpdates(context.Background(), "newest")
+		Expect(err).To(MatchError(ContainSubstring(`unsupported version policy "newest"`)))
+	})
+
+	It("applies the planned updates", func() {
+		var (
+			mu      sync.Mutex
+			updated = map[string]string{}
+		)
+		mockProvider.MockEKS().On("UpdateAddon", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			input := args[1].(*awseks.UpdateAddonInput)
+			mu.Lock()
+			defer mu.Unlock()
+			updated[*input.AddonName] = *input.AddonVersion
+		}).Return(&awseks.UpdateAddonOutput{}, nil)
+
+		Expect(addonManager.ApplyUpdates(context.Background(), []addon.PlannedUpdate{
+			{Name: "vpc-cni", CurrentVersion: "v1.12.6-eksbuild.1", TargetVersion: "v1.13.2-eksbuild.1"},
+			{Name: "coredns", CurrentVersion: "v1.9.3-eksbuild.3", TargetVersion: "v1.9.3-eksbuild.5"},
+		}, 2, false, 0)).To(Succeed())
+
+		Expect(updated).To(Equal(map[string]string{
+			"vpc-cni": "v1.13.2-eksbuild.1",
+			"coredns": "v1.9.3-eksbuild.5",
+		}))
+	})
+})
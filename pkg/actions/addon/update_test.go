@@ -425,6 +425,27 @@ var _ = Describe("Update", func() {
 			})
 
 			When("configurationValues is configured", func() {
+				BeforeEach(func() {
+					mockProvider.MockEKS().On("DescribeAddonConfiguration", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+						Expect(args).To(HaveLen(2))
+						Expect(args[1]).To(BeAssignableToTypeOf(&awseks.DescribeAddonConfigurationInput{}))
+						Expect(*args[1].(*awseks.DescribeAddonConfigurationInput).AddonVersion).To(Equal("v1.0.0-eksbuild.2"))
+					}).Return(&awseks.DescribeAddonConfigurationOutput{
+						ConfigurationSchema: aws.String(`{"type":"object","properties":{"replicaCount":{"type":"integer"}},"additionalProperties":false}`),
+					}, nil)
+				})
+
+				It("does not update the addon when the value does not match the schema", func() {
+					err := addonManager.Update(context.Background(), &api.Addon{
+						Name:                "my-addon",
+						Version:             "v1.0.0-eksbuild.2",
+						ConfigurationValues: "replicaCount: three",
+					}, 0)
+
+					Expect(err).To(MatchError(ContainSubstring("configurationValues of addon my-addon do not match the configuration schema of version v1.0.0-eksbuild.2: replicaCount: Invalid type. Expected: integer, given: string")))
+					mockProvider.MockEKS().AssertNotCalled(GinkgoT(), "UpdateAddon", mock.Anything, mock.Anything)
+				})
+
 				It("AWS EKS configuration values matches the value from cluster config", func() {
 					err := addonManager.Update(context.Background(), &api.Addon{
 						Name:                "my-addon",
@@ -0,0 +1,211 @@
+package argocd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/kris-nova/logger"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/utils/file"
+)
+
+const (
+	// DefaultNamespace is the namespace Argo CD is installed into unless gitops.argocd.namespace is set
+	DefaultNamespace = "argocd"
+	// DefaultVersion is the version of the argo-cd Helm chart installed unless gitops.argocd.version is set
+	DefaultVersion = "5.51.6"
+	// RepositorySecretName is the name of the Secret holding the repository of the app-of-apps Application
+	RepositorySecretName = "eksctl-repository"
+	// AppOfAppsName is the name of the Application syncing the Applications from the repository
+	AppOfAppsName = "eksctl-app-of-apps"
+
+	chartRepoURL       = "https://argoproj.github.io/argo-helm"
+	releaseName        = "argo-cd"
+	appsChartName      = "argocd-apps"
+	appsChartVersion   = "1.4.1"
+	appsReleaseName    = "argocd-apps"
+	defaultRevision    = "HEAD"
+	inClusterServer    = "https://kubernetes.default.svc"
+	secretTypeLabelKey = "argocd.argoproj.io/secret-type"
+)
+
+// Installer installs Argo CD and bootstraps it from a Git repository
+type Installer struct {
+	opts          *api.ArgoCD
+	helmInstaller providers.HelmInstaller
+	kubeClient    kubeclient.Interface
+}
+
+// New creates a new Installer. The Helm installer must use the namespace returned by Namespace.
+func New(helmInstaller providers.HelmInstaller, k8sClientSet kubeclient.Interface, opts *api.GitOps) (*Installer, error) {
+	if opts.ArgoCD == nil {
+		return nil, errors.New("expected gitops.argocd in cluster configuration but found nil")
+	}
+	return &Installer{
+		opts:          opts.ArgoCD,
+		helmInstaller: helmInstaller,
+		kubeClient:    k8sClientSet,
+	}, nil
+}
+
+// Namespace returns the namespace Argo CD is installed into
+func Namespace(opts *api.ArgoCD) string {
+	if opts.Namespace != "" {
+		return opts.Namespace
+	}
+	return DefaultNamespace
+}
+
+// Run installs the argo-cd Helm chart, creates the repository Secret and an app-of-apps Application
+// syncing the Applications in the repository path
+func (i *Installer) Run(ctx context.Context) error {
+	namespace := Namespace(i.opts)
+	version := i.opts.Version
+	if version == "" {
+		version = DefaultVersion
+	}
+
+	// read the credentials before installing anything
+	secret, err := i.makeRepositorySecret(namespace)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("installing Argo CD (argo-cd chart %s) in namespace %q", version, namespace)
+	if err := i.helmInstaller.InstallChart(ctx, providers.InstallChartOpts{
+		ChartName:       releaseName,
+		RepoURL:         chartRepoURL,
+		Namespace:       namespace,
+		ReleaseName:     releaseName,
+		Version:         version,
+		CreateNamespace: true,
+	}); err != nil {
+		return fmt.Errorf("installing Argo CD: %w", err)
+	}
+
+	if err := i.applyRepositorySecret(ctx, secret); err != nil {
+		return err
+	}
+
+	logger.Info("creating Application %q syncing %q from %s", AppOfAppsName, i.opts.Repository.Path, i.opts.Repository.URL)
+	if err := i.helmInstaller.InstallChart(ctx, providers.InstallChartOpts{
+		ChartName:   appsChartName,
+		RepoURL:     chartRepoURL,
+		Namespace:   namespace,
+		ReleaseName: appsReleaseName,
+		Version:     appsChartVersion,
+		Values:      i.appOfAppsValues(namespace),
+	}); err != nil {
+		return fmt.Errorf("creating Application %q: %w", AppOfAppsName, err)
+	}
+
+	logger.Success("Argo CD installed successfully")
+	logger.Info("run 'kubectl -n %s get secret argocd-initial-admin-secret -o jsonpath=\"{.data.password}\" | base64 -d' to get the password of the admin user", namespace)
+	return nil
+}
+
+// CheckRepositoryCredentials checks that the credentials of gitops.argocd.repository can be read, so that missing
+// credentials are reported before any resources are created
+func CheckRepositoryCredentials(opts *api.ArgoCD) error {
+	_, err := repositoryCredentials(opts.Repository)
+	return err
+}
+
+func repositoryCredentials(repo *api.ArgoCDRepository) (map[string]string, error) {
+	switch {
+	case repo.SSHPrivateKeyPath != "":
+		key, err := os.ReadFile(file.ExpandPath(repo.SSHPrivateKeyPath))
+		if err != nil {
+			return nil, fmt.Errorf("reading SSH private key for gitops.argocd.repository: %w", err)
+		}
+		return map[string]string{"sshPrivateKey": string(key)}, nil
+	case repo.Username != "":
+		password, ok := os.LookupEnv(repo.PasswordEnvVar)
+		if !ok || password == "" {
+			return nil, fmt.Errorf("environment variable %s must be set to the password of gitops.argocd.repository", repo.PasswordEnvVar)
+		}
+		return map[string]string{
+			"username": repo.Username,
+			"password": password,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (i *Installer) makeRepositorySecret(namespace string) (*corev1.Secret, error) {
+	repo := i.opts.Repository
+	credentials, err := repositoryCredentials(repo)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]string{
+		"type": "git",
+		"url":  repo.URL,
+	}
+	for k, v := range credentials {
+		data[k] = v
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RepositorySecretName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				secretTypeLabelKey: "repository",
+			},
+		},
+		StringData: data,
+	}, nil
+}
+
+func (i *Installer) applyRepositorySecret(ctx context.Context, secret *corev1.Secret) error {
+	secrets := i.kubeClient.CoreV1().Secrets(secret.Namespace)
+	_, err := secrets.Create(ctx, secret, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("creating repository Secret %q: %w", secret.Name, err)
+	}
+	logger.Info("created repository Secret %q", secret.Name)
+	return nil
+}
+
+func (i *Installer) appOfAppsValues(namespace string) map[string]interface{} {
+	revision := i.opts.Repository.TargetRevision
+	if revision == "" {
+		revision = defaultRevision
+	}
+	return map[string]interface{}{
+		"applications": []interface{}{
+			map[string]interface{}{
+				"name":      AppOfAppsName,
+				"namespace": namespace,
+				"project":   "default",
+				"source": map[string]interface{}{
+					"repoURL":        i.opts.Repository.URL,
+					"targetRevision": revision,
+					"path":           i.opts.Repository.Path,
+				},
+				"destination": map[string]interface{}{
+					"server":    inClusterServer,
+					"namespace": namespace,
+				},
+				"syncPolicy": map[string]interface{}{
+					"automated": map[string]interface{}{
+						"prune":    true,
+						"selfHeal": true,
+					},
+				},
+			},
+		},
+	}
+}
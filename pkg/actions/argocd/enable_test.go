@@ -0,0 +1,138 @@
+package argocd_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/argocd"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	providerfakes "github.com/weaveworks/eksctl/pkg/karpenter/providers/fakes"
+)
+
+var _ = Describe("Argo CD", func() {
+	var (
+		opts          *api.ArgoCD
+		helmInstaller *providerfakes.FakeHelmInstaller
+		clientSet     *fake.Clientset
+	)
+
+	run := func() error {
+		installer, err := argocd.New(helmInstaller, clientSet, &api.GitOps{ArgoCD: opts})
+		Expect(err).NotTo(HaveOccurred())
+		return installer.Run(context.Background())
+	}
+
+	getSecret := func(namespace string) map[string]string {
+		secret, err := clientSet.CoreV1().Secrets(namespace).Get(context.Background(), "eksctl-repository", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Labels).To(HaveKeyWithValue("argocd.argoproj.io/secret-type", "repository"))
+		return secret.StringData
+	}
+
+	BeforeEach(func() {
+		opts = &api.ArgoCD{
+			Repository: &api.ArgoCDRepository{
+				URL:  "https://github.com/org/platform.git",
+				Path: "apps",
+			},
+		}
+		helmInstaller = &providerfakes.FakeHelmInstaller{}
+		clientSet = fake.NewSimpleClientset()
+	})
+
+	It("fails without gitops.argocd", func() {
+		_, err := argocd.New(helmInstaller, clientSet, &api.GitOps{})
+		Expect(err).To(MatchError("expected gitops.argocd in cluster configuration but found nil"))
+	})
+
+	It("installs Argo CD, creates the repository Secret and the app-of-apps Application", func() {
+		Expect(run()).To(Succeed())
+
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(2))
+		_, argoCDChart := helmInstaller.InstallChartArgsForCall(0)
+		Expect(argoCDChart.ChartName).To(Equal("argo-cd"))
+		Expect(argoCDChart.RepoURL).To(Equal("https://argoproj.github.io/argo-helm"))
+		Expect(argoCDChart.Version).To(Equal(argocd.DefaultVersion))
+		Expect(argoCDChart.Namespace).To(Equal("argocd"))
+		Expect(argoCDChart.CreateNamespace).To(BeTrue())
+
+		Expect(getSecret("argocd")).To(Equal(map[string]string{
+			"type": "git",
+			"url":  "https://github.com/org/platform.git",
+		}))
+
+		_, appsChart := helmInstaller.InstallChartArgsForCall(1)
+		Expect(appsChart.ChartName).To(Equal("argocd-apps"))
+		Expect(appsChart.Values["applications"]).To(ConsistOf(And(
+			HaveKeyWithValue("name", "eksctl-app-of-apps"),
+			HaveKeyWithValue("namespace", "argocd"),
+			HaveKeyWithValue("source", map[string]interface{}{
+				"repoURL":        "https://github.com/org/platform.git",
+				"targetRevision": "HEAD",
+				"path":           "apps",
+			}),
+		)))
+	})
+
+	It("uses the configured namespace, version and revision and the password from the environment", func() {
+		opts.Namespace = "gitops"
+		opts.Version = "5.46.0"
+		opts.Repository.TargetRevision = "main"
+		opts.Repository.Username = "git"
+		opts.Repository.PasswordEnvVar = "ARGOCD_TEST_TOKEN"
+		GinkgoT().Setenv("ARGOCD_TEST_TOKEN", "token")
+
+		Expect(run()).To(Succeed())
+
+		_, argoCDChart := helmInstaller.InstallChartArgsForCall(0)
+		Expect(argoCDChart.Namespace).To(Equal("gitops"))
+		Expect(argoCDChart.Version).To(Equal("5.46.0"))
+		Expect(getSecret("gitops")).To(And(
+			HaveKeyWithValue("username", "git"),
+			HaveKeyWithValue("password", "token"),
+		))
+		_, appsChart := helmInstaller.InstallChartArgsForCall(1)
+		Expect(appsChart.Values["applications"]).To(ConsistOf(HaveKeyWithValue("source", HaveKeyWithValue("targetRevision", "main"))))
+	})
+
+	It("reads the SSH private key", func() {
+		keyPath := filepath.Join(GinkgoT().TempDir(), "id_ed25519")
+		Expect(os.WriteFile(keyPath, []byte("private-key"), 0600)).To(Succeed())
+		opts.Repository.URL = "git@github.com:org/platform.git"
+		opts.Repository.SSHPrivateKeyPath = keyPath
+
+		Expect(run()).To(Succeed())
+		Expect(getSecret("argocd")).To(HaveKeyWithValue("sshPrivateKey", "private-key"))
+	})
+
+	It("fails before installing anything when the password is not set", func() {
+		opts.Repository.Username = "git"
+		opts.Repository.PasswordEnvVar = "ARGOCD_TEST_UNSET_TOKEN"
+
+		Expect(run()).To(MatchError("environment variable ARGOCD_TEST_UNSET_TOKEN must be set to the password of gitops.argocd.repository"))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(0))
+	})
+
+	It("checks the repository credentials", func() {
+		Expect(argocd.CheckRepositoryCredentials(opts)).To(Succeed())
+
+		opts.Repository.Username = "git"
+		opts.Repository.PasswordEnvVar = "ARGOCD_TEST_UNSET_TOKEN"
+		Expect(argocd.CheckRepositoryCredentials(opts)).To(MatchError(ContainSubstring("ARGOCD_TEST_UNSET_TOKEN must be set")))
+
+		GinkgoT().Setenv("ARGOCD_TEST_UNSET_TOKEN", "token")
+		Expect(argocd.CheckRepositoryCredentials(opts)).To(Succeed())
+
+		opts.Repository.Username = ""
+		opts.Repository.PasswordEnvVar = ""
+		opts.Repository.SSHPrivateKeyPath = filepath.Join(GinkgoT().TempDir(), "missing")
+		Expect(argocd.CheckRepositoryCredentials(opts)).To(MatchError(ContainSubstring("reading SSH private key")))
+	})
+})
@@ -0,0 +1,11 @@
+package argocd_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestArgoCD(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,161 @@
+package auditlogs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/kris-nova/logger"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+const (
+	// DefaultFilterPattern matches the audit events in the log group of the control plane logs, as
+	// the other log types are not JSON documents of kind Event
+	DefaultFilterPattern = `{ $.kind = "Event" }`
+	// DefaultOpenSearchIndexName is the name of the index audit events are written to in OpenSearch
+	DefaultOpenSearchIndexName = "eks-audit-logs"
+
+	auditLogType = "audit"
+)
+
+// LoggingConfigGetter gets the log types enabled on a cluster
+type LoggingConfigGetter interface {
+	GetCurrentClusterConfigForLogging(ctx context.Context, spec *api.ClusterConfig) (sets.String, sets.String, error)
+}
+
+// Exporter sets up the export of the audit logs of a cluster
+type Exporter struct {
+	cfg                 *api.ClusterConfig
+	stackManager        manager.StackManager
+	loggingConfigGetter LoggingConfigGetter
+}
+
+// NewExporter creates a new Exporter
+func NewExporter(cfg *api.ClusterConfig, stackManager manager.StackManager, loggingConfigGetter LoggingConfigGetter) *Exporter {
+	return &Exporter{
+		cfg:                 cfg,
+		stackManager:        stackManager,
+		loggingConfigGetter: loggingConfigGetter,
+	}
+}
+
+// Options defines options for Export
+type Options struct {
+	Destination builder.AuditLogDestination
+	// FilterPattern selects the log events that are exported
+	FilterPattern string
+	// Plan only logs the intended changes
+	Plan bool
+}
+
+// Validate validates the options and sets the defaults
+func (o *Options) Validate() error {
+	d := &o.Destination
+	switch {
+	case d.DeliveryStreamARN != "":
+		if d.S3BucketARN != "" || d.OpenSearchDomainARN != "" {
+			return errors.New("an existing delivery stream cannot be used along with an S3 bucket or an OpenSearch domain")
+		}
+	case d.S3BucketARN == "" && d.OpenSearchDomainARN != "":
+		return errors.New("an S3 bucket is required to back up the audit events that fail to be indexed in OpenSearch")
+	case d.S3BucketARN == "":
+		return errors.New("one of a delivery stream, an S3 bucket or an OpenSearch domain must be specified")
+	}
+
+	for name, value := range map[string]string{
+		"delivery stream":   d.DeliveryStreamARN,
+		"S3 bucket":         d.S3BucketARN,
+		"OpenSearch domain": d.OpenSearchDomainARN,
+	} {
+		if value != "" && !arn.IsARN(value) {
+			return fmt.Errorf("invalid %s ARN %q", name, value)
+		}
+	}
+
+	if d.OpenSearchDomainARN != "" && d.OpenSearchIndexName == "" {
+		d.OpenSearchIndexName = DefaultOpenSearchIndexName
+	}
+	if o.FilterPattern == "" {
+		o.FilterPattern = DefaultFilterPattern
+	}
+	return nil
+}
+
+// MakeStackName returns the name of the audit log export stack of the cluster
+func MakeStackName(clusterName string) string {
+	return fmt.Sprintf("eksctl-%s-audit-log-export", clusterName)
+}
+
+// Export subscribes a Firehose delivery stream to the audit logs of the cluster, creating the delivery stream along with
+// the IAM roles for CloudWatch Logs and Firehose unless an existing one is used. It returns the ARN of the delivery
+// stream, or an empty string in plan mode. Audit logging must be enabled on the cluster.
+func (e *Exporter) Export(ctx context.Context, opts Options) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	enabled, _, err := e.loggingConfigGetter.GetCurrentClusterConfigForLogging(ctx, e.cfg)
+	if err != nil {
+		return "", err
+	}
+	if !enabled.Has(auditLogType) {
+		return "", fmt.Errorf("audit logging is not enabled on cluster %q, try 'eksctl utils update-cluster-logging --enable-types=%s --region=%s --cluster=%s'",
+			e.cfg.Metadata.Name, auditLogType, e.cfg.Metadata.Region, e.cfg.Metadata.Name)
+	}
+
+	stackName := MakeStackName(e.cfg.Metadata.Name)
+	stacks, err := e.stackManager.ListStacksMatching(ctx, fmt.Sprintf("^%s$", regexp.QuoteMeta(stackName)))
+	if err != nil {
+		return "", fmt.Errorf("listing stacks: %w", err)
+	}
+	if len(stacks) > 0 {
+		for _, o := range stacks[0].Outputs {
+			if aws.ToString(o.OutputKey) == outputs.AuditLogDeliveryStreamARN {
+				logger.Info("audit logs are already exported to delivery stream %q by stack %q", aws.ToString(o.OutputValue), stackName)
+				return aws.ToString(o.OutputValue), nil
+			}
+		}
+		return "", fmt.Errorf("stack %q does not have a %s output, it may not have been created successfully", stackName, outputs.AuditLogDeliveryStreamARN)
+	}
+
+	logGroupName := eks.ClusterLogGroupName(e.cfg.Metadata.Name)
+	if opts.Plan {
+		logger.Info("(plan) would export the audit logs in log group %q to %s in stack %q", logGroupName, describeDestination(opts.Destination), stackName)
+		return "", nil
+	}
+
+	resourceSet := builder.NewAuditLogExportResourceSet(logGroupName, opts.FilterPattern, opts.Destination)
+	if err := resourceSet.AddAllResources(); err != nil {
+		return "", err
+	}
+	logger.Info("exporting the audit logs in log group %q to %s in stack %q", logGroupName, describeDestination(opts.Destination), stackName)
+	errCh := make(chan error)
+	if err := e.stackManager.CreateStack(ctx, stackName, resourceSet, nil, nil, errCh); err != nil {
+		return "", err
+	}
+	if err := <-errCh; err != nil {
+		return "", fmt.Errorf("creating audit log export: %w", err)
+	}
+	return resourceSet.DeliveryStreamARN, nil
+}
+
+func describeDestination(d builder.AuditLogDestination) string {
+	switch {
+	case d.DeliveryStreamARN != "":
+		return fmt.Sprintf("delivery stream %q", d.DeliveryStreamARN)
+	case d.OpenSearchDomainARN != "":
+		return fmt.Sprintf("index %q of OpenSearch domain %q through a new delivery stream", d.OpenSearchIndexName, d.OpenSearchDomainARN)
+	default:
+		return fmt.Sprintf("S3 bucket %q through a new delivery stream", d.S3BucketARN)
+	}
+}
@@ -0,0 +1,167 @@
+package auditlogs_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/auditlogs"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+)
+
+type fakeLoggingConfigGetter struct {
+	enabled []string
+}
+
+func (f *fakeLoggingConfigGetter) GetCurrentClusterConfigForLogging(_ context.Context, _ *api.ClusterConfig) (sets.String, sets.String, error) {
+	return sets.NewString(f.enabled...), sets.NewString(), nil
+}
+
+var _ = Describe("Export audit logs", func() {
+	const (
+		bucketARN         = "arn:aws:s3:::audit-logs"
+		deliveryStreamARN = "arn:aws:firehose:us-west-2:123456789012:deliverystream/audit-logs"
+	)
+
+	var (
+		cfg                 *api.ClusterConfig
+		fakeStackManager    *fakes.FakeStackManager
+		loggingConfigGetter *fakeLoggingConfigGetter
+		exporter            *auditlogs.Exporter
+	)
+
+	deliveryStreamOutput := func(arn string) []cfntypes.Output {
+		return []cfntypes.Output{
+			{
+				OutputKey:   aws.String("DeliveryStreamARN"),
+				OutputValue: aws.String(arn),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		fakeStackManager = &fakes.FakeStackManager{}
+		fakeStackManager.CreateStackStub = func(_ context.Context, _ string, rs builder.ResourceSetReader, _, _ map[string]string, errCh chan error) error {
+			go func() {
+				errCh <- rs.GetAllOutputs(cfntypes.Stack{Outputs: deliveryStreamOutput(deliveryStreamARN)})
+			}()
+			return nil
+		}
+		loggingConfigGetter = &fakeLoggingConfigGetter{enabled: []string{"api", "audit"}}
+		exporter = auditlogs.NewExporter(cfg, fakeStackManager, loggingConfigGetter)
+	})
+
+	It("creates a delivery stream to S3 subscribed to the audit events", func() {
+		arn, err := exporter.Export(context.Background(), auditlogs.Options{
+			Destination: builder.AuditLogDestination{S3BucketARN: bucketARN},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arn).To(Equal(deliveryStreamARN))
+
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+		_, stackName, rs, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+		Expect(stackName).To(Equal("eksctl-my-cluster-audit-log-export"))
+		Expect(rs.WithIAM()).To(BeTrue())
+		template, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(template)).To(And(
+			ContainSubstring(`"LogGroupName": "/aws/eks/my-cluster/cluster"`),
+			ContainSubstring(`"FilterPattern": "{ $.kind = \"Event\" }"`),
+			ContainSubstring(`"BucketARN": "arn:aws:s3:::audit-logs"`),
+		))
+	})
+
+	It("reuses an existing export", func() {
+		fakeStackManager.ListStacksMatchingReturns([]*cfntypes.Stack{
+			{
+				StackName: aws.String("eksctl-my-cluster-audit-log-export"),
+				Outputs:   deliveryStreamOutput("arn:aws:firehose:us-west-2:123456789012:deliverystream/existing"),
+			},
+		}, nil)
+
+		arn, err := exporter.Export(context.Background(), auditlogs.Options{
+			Destination: builder.AuditLogDestination{S3BucketARN: bucketARN},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arn).To(Equal("arn:aws:firehose:us-west-2:123456789012:deliverystream/existing"))
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+	})
+
+	It("requires audit logging to be enabled", func() {
+		loggingConfigGetter.enabled = []string{"api"}
+		_, err := exporter.Export(context.Background(), auditlogs.Options{
+			Destination: builder.AuditLogDestination{S3BucketARN: bucketARN},
+		})
+		Expect(err).To(MatchError(ContainSubstring(`audit logging is not enabled on cluster "my-cluster"`)))
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+	})
+
+	It("does not make any changes in plan mode", func() {
+		arn, err := exporter.Export(context.Background(), auditlogs.Options{
+			Destination: builder.AuditLogDestination{DeliveryStreamARN: deliveryStreamARN},
+			Plan:        true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arn).To(BeEmpty())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+	})
+
+	type validateEntry struct {
+		options     auditlogs.Options
+		expectedErr string
+	}
+
+	DescribeTable("validating options", func(e validateEntry) {
+		err := e.options.Validate()
+		if e.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(e.expectedErr)))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.options.FilterPattern).NotTo(BeEmpty())
+	},
+		Entry("S3 bucket", validateEntry{
+			options: auditlogs.Options{Destination: builder.AuditLogDestination{S3BucketARN: bucketARN}},
+		}),
+		Entry("existing delivery stream", validateEntry{
+			options: auditlogs.Options{Destination: builder.AuditLogDestination{DeliveryStreamARN: deliveryStreamARN}},
+		}),
+		Entry("OpenSearch domain with a backup bucket", validateEntry{
+			options: auditlogs.Options{Destination: builder.AuditLogDestination{
+				S3BucketARN:         bucketARN,
+				OpenSearchDomainARN: "arn:aws:es:us-west-2:123456789012:domain/audit",
+			}},
+		}),
+		Entry("no destination", validateEntry{
+			expectedErr: "one of a delivery stream, an S3 bucket or an OpenSearch domain must be specified",
+		}),
+		Entry("OpenSearch domain without a backup bucket", validateEntry{
+			options: auditlogs.Options{Destination: builder.AuditLogDestination{
+				OpenSearchDomainARN: "arn:aws:es:us-west-2:123456789012:domain/audit",
+			}},
+			expectedErr: "an S3 bucket is required to back up",
+		}),
+		Entry("existing delivery stream with a bucket", validateEntry{
+			options: auditlogs.Options{Destination: builder.AuditLogDestination{
+				DeliveryStreamARN: deliveryStreamARN,
+				S3BucketARN:       bucketARN,
+			}},
+			expectedErr: "an existing delivery stream cannot be used along with",
+		}),
+		Entry("invalid ARN", validateEntry{
+			options:     auditlogs.Options{Destination: builder.AuditLogDestination{S3BucketARN: "audit-logs"}},
+			expectedErr: `invalid S3 bucket ARN "audit-logs"`,
+		}),
+	)
+})
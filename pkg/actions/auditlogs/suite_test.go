@@ -0,0 +1,11 @@
+package auditlogs_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestAuditLogs(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
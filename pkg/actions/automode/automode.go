@@ -0,0 +1,221 @@
+package automode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/resource"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+var (
+	// NodeClassGVK is the GroupVersionKind of Auto Mode NodeClasses
+	NodeClassGVK = schema.GroupVersionKind{Group: "eks.amazonaws.com", Version: "v1", Kind: "NodeClass"}
+	// NodePoolGVK is the GroupVersionKind of Auto Mode NodePools
+	NodePoolGVK = schema.GroupVersionKind{Group: "karpenter.sh", Version: "v1", Kind: "NodePool"}
+)
+
+// RawClient creates, reads and deletes Kubernetes resources
+type RawClient interface {
+	NewRawResource(object runtime.Object) (*kubernetes.RawResource, error)
+	NewUnstructuredHelperFor(gvk schema.GroupVersionKind) (*resource.Helper, error)
+}
+
+// Manager manages the NodeClasses and NodePools of an Auto Mode cluster
+type Manager struct {
+	rawClient RawClient
+}
+
+// New creates a new Manager
+func New(rawClient RawClient) *Manager {
+	return &Manager{
+		rawClient: rawClient,
+	}
+}
+
+// NewFromProvider creates a new Manager for the cluster, failing if Auto Mode is not enabled on it
+func NewFromProvider(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider) (*Manager, error) {
+	enabled, err := ctl.IsAutoModeEnabled(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, fmt.Errorf("Auto Mode is not enabled on cluster %q", cfg.Metadata.Name)
+	}
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return New(rawClient), nil
+}
+
+// Resource is a summary of a NodeClass or NodePool in the cluster
+type Resource struct {
+	Kind string
+	Name string
+	// NodeClass is the NodeClass referenced by a NodePool
+	NodeClass string
+	Ready     string
+	// CreatedByEksctl is true for resources created from the cluster config file
+	CreatedByEksctl bool
+}
+
+// NodeClasses renders the NodeClasses in the cluster config file
+func NodeClasses(cfg *api.ClusterConfig) []*unstructured.Unstructured {
+	var objects []*unstructured.Unstructured
+	if cfg.AutoModeConfig == nil {
+		return objects
+	}
+	for _, nodeClass := range cfg.AutoModeConfig.NodeClasses {
+		objects = append(objects, newObject(NodeClassGVK, cfg.Metadata.Name, nodeClass.Name, nodeClass.Spec))
+	}
+	return objects
+}
+
+// NodePools renders the custom NodePools in the cluster config file
+func NodePools(cfg *api.ClusterConfig) []*unstructured.Unstructured {
+	var objects []*unstructured.Unstructured
+	if cfg.AutoModeConfig == nil {
+		return objects
+	}
+	for _, nodePool := range cfg.AutoModeConfig.CustomNodePools {
+		objects = append(objects, newObject(NodePoolGVK, cfg.Metadata.Name, nodePool.Name, nodePool.Spec))
+	}
+	return objects
+}
+
+// NewObject renders a NodeClass or NodePool that is only identified by its name
+func NewObject(gvk schema.GroupVersionKind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	return obj
+}
+
+func newObject(gvk schema.GroupVersionKind, clusterName, name string, spec api.InlineDocument) *unstructured.Unstructured {
+	obj := NewObject(gvk, name)
+	obj.SetLabels(map[string]string{
+		api.ClusterNameLabel: clusterName,
+	})
+	obj.Object["spec"] = runtime.DeepCopyJSONValue(map[string]interface{}(spec))
+	return obj
+}
+
+// CreateOrReplace creates the given resources, replacing them if they already exist
+func (m *Manager) CreateOrReplace(objects []*unstructured.Unstructured, plan bool) error {
+	for _, obj := range objects {
+		rawResource, err := m.rawClient.NewRawResource(obj)
+		if err != nil {
+			return err
+		}
+		status, err := rawResource.CreateOrReplace(plan)
+		if err != nil {
+			return fmt.Errorf("creating %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		logger.Info(status)
+	}
+	return nil
+}
+
+// Delete deletes the given resources, skipping those that do not exist
+func (m *Manager) Delete(objects []*unstructured.Unstructured) error {
+	for _, obj := range objects {
+		rawResource, err := m.rawClient.NewRawResource(obj)
+		if err != nil {
+			return err
+		}
+		status, err := rawResource.DeleteSync()
+		if err != nil {
+			return fmt.Errorf("deleting %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if status == "" {
+			logger.Info("%s %q does not exist", obj.GetKind(), obj.GetName())
+			continue
+		}
+		logger.Info(status)
+	}
+	return nil
+}
+
+// Get returns the resources of the given kind in the cluster, or only the one
+// with the given name when it is set
+func (m *Manager) Get(gvk schema.GroupVersionKind, name string) ([]Resource, error) {
+	helper, err := m.rawClient.NewUnstructuredHelperFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if name != "" {
+		obj, err := helper.Get("", name)
+		if err != nil {
+			return nil, fmt.Errorf("getting %s %q: %w", gvk.Kind, name, err)
+		}
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object of type %T", obj)
+		}
+		return []Resource{toResource(u)}, nil
+	}
+
+	list, err := helper.List("", gvk.GroupVersion().String(), &metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing %ss: %w", gvk.Kind, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	var resources []Resource
+	for _, item := range items {
+		u, ok := item.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object of type %T", item)
+		}
+		resources = append(resources, toResource(u))
+	}
+	return resources, nil
+}
+
+func toResource(obj *unstructured.Unstructured) Resource {
+	r := Resource{
+		Kind:  obj.GetKind(),
+		Name:  obj.GetName(),
+		Ready: "Unknown",
+	}
+	_, r.CreatedByEksctl = obj.GetLabels()[api.ClusterNameLabel]
+	if nodeClass, found, _ := unstructured.NestedString(obj.Object, "spec", "template", "spec", "nodeClassRef", "name"); found {
+		r.NodeClass = nodeClass
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Ready" {
+			continue
+		}
+		if status, ok := condition["status"].(string); ok {
+			r.Ready = status
+		}
+	}
+	return r
+}
+
+// Filter returns the resource of the given kind with the given name, or all of them when name is empty
+func Filter(objects []*unstructured.Unstructured, kind, name string) ([]*unstructured.Unstructured, error) {
+	if name == "" {
+		return objects, nil
+	}
+	for _, obj := range objects {
+		if obj.GetName() == name {
+			return []*unstructured.Unstructured{obj}, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %q not found in the config file", kind, name)
+}
@@ -0,0 +1,13 @@
+package automode_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAutoMode(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Auto Mode Suite")
+}
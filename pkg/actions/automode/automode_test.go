@@ -0,0 +1,132 @@
+package automode_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/weaveworks/eksctl/pkg/actions/automode"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Auto Mode resources", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "auto-mode-cluster"
+		cfg.AutoModeConfig = &api.AutoModeConfig{
+			Enabled: api.Enabled(),
+			NodeClasses: []api.AutoModeNodeClass{
+				{
+					Name: "private",
+					Spec: api.InlineDocument{
+						"role": "auto-mode-nodes",
+						"subnetSelectorTerms": []interface{}{
+							map[string]interface{}{"tags": map[string]interface{}{"kubernetes.io/role/internal-elb": "1"}},
+						},
+					},
+				},
+			},
+			CustomNodePools: []api.AutoModeCustomNodePool{
+				{
+					Name: "gpu",
+					Spec: api.InlineDocument{
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"nodeClassRef": map[string]interface{}{
+									"group": "eks.amazonaws.com",
+									"kind":  "NodeClass",
+									"name":  "private",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "batch",
+					Spec: api.InlineDocument{"weight": float64(10)},
+				},
+			},
+		}
+	})
+
+	It("renders NodeClasses", func() {
+		objects := automode.NodeClasses(cfg)
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0].GetAPIVersion()).To(Equal("eks.amazonaws.com/v1"))
+		Expect(objects[0].GetKind()).To(Equal("NodeClass"))
+		Expect(objects[0].GetName()).To(Equal("private"))
+		Expect(objects[0].GetNamespace()).To(BeEmpty())
+		Expect(objects[0].GetLabels()).To(Equal(map[string]string{api.ClusterNameLabel: "auto-mode-cluster"}))
+		role, _, err := unstructured.NestedString(objects[0].Object, "spec", "role")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(role).To(Equal("auto-mode-nodes"))
+	})
+
+	It("renders NodePools", func() {
+		objects := automode.NodePools(cfg)
+		Expect(objects).To(HaveLen(2))
+		Expect(objects[0].GetAPIVersion()).To(Equal("karpenter.sh/v1"))
+		Expect(objects[0].GetKind()).To(Equal("NodePool"))
+		Expect(objects[0].GetName()).To(Equal("gpu"))
+		Expect(objects[1].GetName()).To(Equal("batch"))
+
+		By("not sharing the spec with the cluster config")
+		Expect(unstructured.SetNestedField(objects[1].Object, float64(20), "spec", "weight")).To(Succeed())
+		Expect(cfg.AutoModeConfig.CustomNodePools[1].Spec["weight"]).To(Equal(float64(10)))
+	})
+
+	It("renders nothing when autoModeConfig is not set", func() {
+		cfg.AutoModeConfig = nil
+		Expect(automode.NodeClasses(cfg)).To(BeEmpty())
+		Expect(automode.NodePools(cfg)).To(BeEmpty())
+	})
+
+	Describe("Filter", func() {
+		It("returns all resources when no name is given", func() {
+			objects, err := automode.Filter(automode.NodePools(cfg), "NodePool", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(2))
+		})
+
+		It("returns the resource with the given name", func() {
+			objects, err := automode.Filter(automode.NodePools(cfg), "NodePool", "batch")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(1))
+			Expect(objects[0].GetName()).To(Equal("batch"))
+		})
+
+		It("fails when no resource has the given name", func() {
+			_, err := automode.Filter(automode.NodePools(cfg), "NodePool", "spot")
+			Expect(err).To(MatchError(`NodePool "spot" not found in the config file`))
+		})
+	})
+
+	Describe("summarising resources", func() {
+		It("reports the NodeClass and readiness of a NodePool", func() {
+			obj := automode.NodePools(cfg)[0]
+			Expect(unstructured.SetNestedSlice(obj.Object, []interface{}{
+				map[string]interface{}{"type": "NodeClassReady", "status": "True"},
+				map[string]interface{}{"type": "Ready", "status": "False"},
+			}, "status", "conditions")).To(Succeed())
+
+			Expect(automode.ToResource(obj)).To(Equal(automode.Resource{
+				Kind:            "NodePool",
+				Name:            "gpu",
+				NodeClass:       "private",
+				Ready:           "False",
+				CreatedByEksctl: true,
+			}))
+		})
+
+		It("reports resources not created by eksctl", func() {
+			obj := automode.NewObject(automode.NodeClassGVK, "default")
+			Expect(automode.ToResource(obj)).To(Equal(automode.Resource{
+				Kind:  "NodeClass",
+				Name:  "default",
+				Ready: "Unknown",
+			}))
+		})
+	})
+})
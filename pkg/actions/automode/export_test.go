@@ -0,0 +1,3 @@
+package automode
+
+var ToResource = toResource
@@ -0,0 +1,74 @@
+package charts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"helm.sh/helm/v3/pkg/registry"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+)
+
+const ociScheme = "oci://"
+
+// HelmInstallerGetter returns a Helm installer for releases in namespace
+type HelmInstallerGetter func(namespace string) (providers.HelmInstaller, error)
+
+// Installer installs the Helm charts of the charts section
+type Installer struct {
+	getHelmInstaller HelmInstallerGetter
+}
+
+// NewInstaller creates a new Installer
+func NewInstaller(getHelmInstaller HelmInstallerGetter) *Installer {
+	return &Installer{
+		getHelmInstaller: getHelmInstaller,
+	}
+}
+
+// Install installs charts in order, waiting for the resources of each release to be ready
+// before installing the next one unless wait is disabled for it
+func (i *Installer) Install(ctx context.Context, charts []*api.HelmChart) error {
+	for _, chart := range charts {
+		if err := i.install(ctx, chart); err != nil {
+			return fmt.Errorf("installing Helm chart %q: %w", chart.Name, err)
+		}
+	}
+	logger.Success("installed %d Helm chart(s)", len(charts))
+	return nil
+}
+
+func (i *Installer) install(ctx context.Context, chart *api.HelmChart) error {
+	namespace := chart.ReleaseNamespace()
+	helmInstaller, err := i.getHelmInstaller(namespace)
+	if err != nil {
+		return err
+	}
+
+	opts := providers.InstallChartOpts{
+		ChartName:       chart.Chart,
+		RepoURL:         chart.Repository,
+		CreateNamespace: true,
+		Namespace:       namespace,
+		ReleaseName:     chart.Name,
+		Values:          chart.Values,
+		Version:         chart.Version,
+		SkipWait:        api.IsDisabled(chart.Wait),
+		Timeout:         chart.InstallTimeout(),
+	}
+	if strings.HasPrefix(chart.Repository, ociScheme) {
+		// OCI charts are referenced by URL instead of being looked up in a repository index
+		opts.ChartName = strings.TrimSuffix(chart.Repository, "/") + "/" + chart.Chart
+		opts.RepoURL = ""
+		opts.RegistryClient, err = registry.NewClient(registry.ClientOptEnableCache(true))
+		if err != nil {
+			return fmt.Errorf("creating registry client: %w", err)
+		}
+	}
+
+	logger.Info("installing Helm chart %s %s as release %q in namespace %q", chart.Chart, chart.Version, chart.Name, namespace)
+	return helmInstaller.InstallChart(ctx, opts)
+}
@@ -0,0 +1,88 @@
+package charts_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/charts"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	providerfakes "github.com/weaveworks/eksctl/pkg/karpenter/providers/fakes"
+)
+
+var _ = Describe("Helm charts", func() {
+	var (
+		helmInstaller *providerfakes.FakeHelmInstaller
+		namespaces    []string
+		installer     *charts.Installer
+		helmCharts    []*api.HelmChart
+	)
+
+	BeforeEach(func() {
+		helmInstaller = &providerfakes.FakeHelmInstaller{}
+		namespaces = nil
+		installer = charts.NewInstaller(func(namespace string) (providers.HelmInstaller, error) {
+			namespaces = append(namespaces, namespace)
+			return helmInstaller, nil
+		})
+		helmCharts = []*api.HelmChart{
+			{
+				Name:       "cert-manager",
+				Repository: "https://charts.jetstack.io",
+				Chart:      "cert-manager",
+				Version:    "v1.13.2",
+				Namespace:  "cert-manager",
+				Values: api.InlineDocument{
+					"installCRDs": true,
+				},
+			},
+			{
+				Name:       "podinfo",
+				Repository: "oci://ghcr.io/stefanprodan/charts/",
+				Chart:      "podinfo",
+				Version:    "6.5.3",
+				Wait:       api.Disabled(),
+				Timeout:    "2m",
+			},
+		}
+	})
+
+	It("installs the charts in order", func() {
+		Expect(installer.Install(context.Background(), helmCharts)).To(Succeed())
+
+		Expect(namespaces).To(Equal([]string{"cert-manager", "default"}))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(2))
+
+		_, opts := helmInstaller.InstallChartArgsForCall(0)
+		Expect(opts).To(Equal(providers.InstallChartOpts{
+			ChartName:       "cert-manager",
+			RepoURL:         "https://charts.jetstack.io",
+			CreateNamespace: true,
+			Namespace:       "cert-manager",
+			ReleaseName:     "cert-manager",
+			Values:          map[string]interface{}{"installCRDs": true},
+			Version:         "v1.13.2",
+			Timeout:         10 * time.Minute,
+		}))
+
+		_, opts = helmInstaller.InstallChartArgsForCall(1)
+		Expect(opts.ChartName).To(Equal("oci://ghcr.io/stefanprodan/charts/podinfo"))
+		Expect(opts.RepoURL).To(BeEmpty())
+		Expect(opts.RegistryClient).NotTo(BeNil())
+		Expect(opts.Namespace).To(Equal("default"))
+		Expect(opts.SkipWait).To(BeTrue())
+		Expect(opts.Timeout).To(Equal(2 * time.Minute))
+	})
+
+	It("stops at the first chart that fails to install", func() {
+		helmInstaller.InstallChartReturnsOnCall(0, errors.New("timed out waiting for the condition"))
+
+		err := installer.Install(context.Background(), helmCharts)
+		Expect(err).To(MatchError(`installing Helm chart "cert-manager": timed out waiting for the condition`))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(1))
+	})
+})
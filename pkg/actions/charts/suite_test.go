@@ -0,0 +1,11 @@
+package charts_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestCharts(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
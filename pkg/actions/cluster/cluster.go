@@ -14,8 +14,9 @@ import (
 )
 
 type Cluster interface {
-	Upgrade(ctx context.Context, dryRun bool) error
-	Delete(ctx context.Context, waitInterval, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction bool, parallel int) error
+	Upgrade(ctx context.Context, dryRun, force bool) error
+	PlanDelete(ctx context.Context, wait, force, cleanupOrphans bool, retain []string) error
+	Delete(ctx context.Context, waitInterval, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction, cleanupOrphans bool, parallel int, retain []string) error
 }
 
 func New(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider) (Cluster, error) {
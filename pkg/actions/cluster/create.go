@@ -0,0 +1,425 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeclient "k8s.io/client-go/kubernetes"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/argocd"
+	"github.com/weaveworks/eksctl/pkg/actions/charts"
+	"github.com/weaveworks/eksctl/pkg/actions/flux"
+	"github.com/weaveworks/eksctl/pkg/actions/hooks"
+	"github.com/weaveworks/eksctl/pkg/actions/karpenter"
+	"github.com/weaveworks/eksctl/pkg/actions/nodeterminationhandler"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/history"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers/helm"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+	"github.com/weaveworks/eksctl/pkg/utils/kubectl"
+	"github.com/weaveworks/eksctl/pkg/utils/nodes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// NewKarpenterInstallerFunc creates the installer of Karpenter
+type NewKarpenterInstallerFunc func(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clientSet kubeclient.Interface, restClientGetter *kubernetes.SimpleRESTClientGetter) (karpenter.InstallerTaskCreator, error)
+
+// CreateOptions are the options of Create
+type CreateOptions struct {
+	// WaitTimeout bounds the time waited for addons and for the nodes of the nodegroups to join the cluster
+	WaitTimeout time.Duration
+	// QuotaCheck is what to do when the vCPU quotas do not allow the instances of the nodegroups to be launched
+	QuotaCheck preflight.QuotaCheckMode
+	// SpotPlacement is what to do with the Spot placement scores of the availability zones of Spot nodegroups
+	SpotPlacement             preflight.SpotPlacementMode
+	InstallNeuronDevicePlugin bool
+	InstallNvidiaDevicePlugin bool
+	// Kubeconfig sets where the kubeconfig of the cluster is written, it is not written when nil
+	Kubeconfig *KubeconfigOptions
+	// AuthenticatorRoleARN is the IAM role the kubeconfig and Karpenter authenticate as
+	AuthenticatorRoleARN string
+	// CheckKubectl checks that the kubectl found can be used with the cluster
+	CheckKubectl bool
+	// ConfigFileProvided is set when cfg was loaded from a config file, rather than from flags
+	ConfigFileProvided bool
+	// Command is the command recorded in the history of the cluster
+	Command string
+	// NewKarpenterInstaller defaults to karpenter.NewInstaller
+	NewKarpenterInstaller NewKarpenterInstallerFunc
+}
+
+// KubeconfigOptions set how the kubeconfig of the cluster is written
+type KubeconfigOptions struct {
+	// Path is the kubeconfig file, kubeconfig.DefaultPath() when empty
+	Path string
+	// ContextName is the template of the name of the context, see kubeconfig.ContextName
+	ContextName string
+	// SetContext makes the context of the cluster the current context
+	SetContext bool
+}
+
+// Create creates the cluster described by cfg with its nodegroups, addons, Karpenter, Helm charts and GitOps
+// configuration, runs its hooks and returns once its nodes have joined. The subnets of cfg must have been set with
+// CreateOrImportVPC, and the instance selector options of its nodegroups expanded by nodeGroupService
+func Create(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, nodeGroupService *eks.NodeGroupService, options CreateOptions) error {
+	meta := cfg.Metadata
+	printer := printers.NewJSONPrinter()
+
+	nodePools := nodes.ToNodePools(cfg)
+	if err := nodeGroupService.Normalize(ctx, nodePools, cfg); err != nil {
+		return err
+	}
+
+	preflight.NewSpotPlacementScorer(ctl.AWSProvider.EC2(), ctl.AWSProvider.Region()).
+		ApplySpotPlacementScores(ctx, cfg, nodePools, options.SpotPlacement)
+
+	// fail before creating any resources if the instances of the nodegroups exceed the vCPU quotas
+	if err := checkNodeGroupQuotas(ctx, ctl, nodePools, options.QuotaCheck); err != nil {
+		return err
+	}
+
+	// fail before creating any resources if addon configurationValues do not match the addon schemas
+	if err := validateAddonConfigurationValues(ctx, ctl, cfg); err != nil {
+		return err
+	}
+
+	// fail before creating any resources if the credentials of the Argo CD repository cannot be read
+	if cfg.HasGitOpsArgoCDConfigured() {
+		if err := argocd.CheckRepositoryCredentials(cfg.GitOps.ArgoCD); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("using Kubernetes version %s", meta.Version)
+	logger.Info("creating %s", cfg.LogString())
+
+	// TODO dry-run mode should provide a way to render config with all defaults set
+	// we should also make a call to resolve the AMI and write the result, similarly
+	// the body of the SSH key can be read
+
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	logStacksToCreate(cfg, options.ConfigFileProvided)
+
+	logger.Info("if you encounter any issues, check CloudFormation console or try 'eksctl utils describe-stacks --region=%s --cluster=%s'", meta.Region, meta.Name)
+
+	eks.LogEnabledFeatures(cfg)
+	postClusterCreationTasks := ctl.CreateExtraClusterConfigTasks(ctx, cfg)
+
+	var preNodegroupAddons, postNodegroupAddons *tasks.TaskTree
+	if len(cfg.Addons) > 0 {
+		preNodegroupAddons, postNodegroupAddons = addon.CreateAddonTasks(ctx, cfg, ctl, true, options.WaitTimeout)
+		postClusterCreationTasks.Append(preNodegroupAddons)
+	}
+
+	taskTree := stackManager.NewTasksToCreateClusterWithNodeGroups(ctx, cfg.NodeGroups, cfg.ManagedNodeGroups, postClusterCreationTasks)
+
+	logger.Info(taskTree.Describe())
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		logger.Warning("%d error(s) occurred and cluster hasn't been created properly, you may wish to check CloudFormation console", len(errs))
+		logger.Info("to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name)
+		for _, err := range errs {
+			ufe := &api.UnsupportedFeatureError{}
+			if errors.As(err, &ufe) {
+				logger.Critical(ufe.Message)
+			}
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to create cluster %q", meta.Name)
+	}
+
+	logger.Info("waiting for the control plane to become ready")
+
+	// obtain cluster credentials, write kubeconfig
+	var kubeconfigPath, kubeconfigContextName string
+	setContext := false
+	if options.Kubeconfig != nil {
+		setContext = options.Kubeconfig.SetContext
+		username := eks.GetUsername(ctl.Status.IAMRoleARN)
+		kubectlConfig := kubeconfig.NewForKubectl(cfg, username, options.AuthenticatorRoleARN, ctl.AWSProvider.Profile().Name)
+		if options.Kubeconfig.ContextName != "" {
+			contextName, err := kubeconfig.ContextName(options.Kubeconfig.ContextName, meta, username)
+			if err != nil {
+				return err
+			}
+			kubeconfig.RenameContext(kubectlConfig, contextName)
+		}
+		kubeconfigContextName = kubectlConfig.CurrentContext
+
+		path, err := kubeconfig.Write(options.Kubeconfig.Path, *kubectlConfig, setContext)
+		if err != nil {
+			logger.Warning("unable to write kubeconfig %s, please retry with 'eksctl utils write-kubeconfig -n %s': %v", options.Kubeconfig.Path, meta.Name, err)
+		} else {
+			kubeconfigPath = path
+			logger.Success("saved kubeconfig as %q", kubeconfigPath)
+		}
+	}
+
+	ngTasks := ctl.ClusterTasksForNodeGroups(cfg, options.InstallNeuronDevicePlugin, options.InstallNvidiaDevicePlugin)
+
+	logger.Info(ngTasks.Describe())
+	if errs := ngTasks.DoAllSync(); len(errs) > 0 {
+		logger.Warning("%d error(s) occurred and post actions have failed, you may wish to check CloudFormation console", len(errs))
+		logger.Info("to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name)
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to create cluster %q", meta.Name)
+	}
+	logger.Success("all EKS cluster resources for %q have been created", meta.Name)
+
+	// create Kubernetes client
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := waitForNodeGroups(ctx, options.WaitTimeout, clientSet, cfg); err != nil {
+		return err
+	}
+	if postNodegroupAddons != nil && postNodegroupAddons.Len() > 0 {
+		if errs := postNodegroupAddons.DoAllSync(); len(errs) > 0 {
+			logger.Warning("%d error(s) occurred while creating addons", len(errs))
+			for _, err := range errs {
+				logger.Critical("%s\n", err.Error())
+			}
+			return errors.New("failed to create addons")
+		}
+	}
+
+	if cfg.Hooks != nil && len(cfg.NodeGroups)+len(cfg.ManagedNodeGroups) > 0 {
+		if err := hooks.RunForCluster(ctl, cfg, hooks.PostNodeGroupCreate, cfg.Hooks.PostNodeGroupCreate); err != nil {
+			return err
+		}
+	}
+
+	// After we have the cluster config and all the nodes are done, we install Karpenter if necessary.
+	if cfg.Karpenter != nil {
+		config := kubeconfig.NewForKubectl(cfg, eks.GetUsername(ctl.Status.IAMRoleARN), options.AuthenticatorRoleARN, ctl.AWSProvider.Profile().Name)
+		kubeConfigBytes, err := runtime.Encode(clientcmdlatest.Codec, config)
+		if err != nil {
+			return fmt.Errorf("generating kubeconfig: %w", err)
+		}
+		newInstaller := options.NewKarpenterInstaller
+		if newInstaller == nil {
+			newInstaller = karpenter.NewInstaller
+		}
+		if err := installKarpenter(ctx, newInstaller, ctl, cfg, stackManager, clientSet, kubernetes.NewRESTClientGetter("karpenter", string(kubeConfigBytes))); err != nil {
+			return err
+		}
+	}
+
+	if cfg.HasCharts() {
+		if err := installCharts(ctx, ctl, cfg); err != nil {
+			return err
+		}
+	}
+
+	if cfg.HasGitOpsArgoCDConfigured() {
+		logger.Info("gitops configuration detected, installing Argo CD")
+		if err := installArgoCD(ctx, ctl, cfg, clientSet); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Hooks != nil {
+		if err := hooks.RunForCluster(ctl, cfg, hooks.PostClusterCreate, cfg.Hooks.PostClusterCreate); err != nil {
+			return err
+		}
+	}
+
+	if cfg.HasGitOpsFluxConfigured() {
+		installer, err := flux.New(clientSet, cfg.GitOps)
+		logger.Info("gitops configuration detected, setting installer to Flux v2")
+		if err != nil {
+			return fmt.Errorf("could not initialise Flux installer: %w", err)
+		}
+
+		if err := installer.Run(); err != nil {
+			return err
+		}
+
+		history.RecordCommand(ctx, ctl.AWSProvider, stackManager, cfg, options.Command, ctl.Status.IAMRoleARN)
+		//TODO why was it returning early before? I want to remove this line :thinking:
+		return nil
+	}
+
+	if options.CheckKubectl {
+		env, err := ctl.GetCredentialsEnv()
+		if err != nil {
+			return err
+		}
+		if err := kubectl.CheckAllCommands(kubeconfigPath, setContext, kubeconfigContextName, env); err != nil {
+			logger.Critical("%s\n", err.Error())
+			logger.Info("cluster should be functional despite missing (or misconfigured) client binaries")
+		}
+	}
+
+	if cfg.IsFullyPrivate() && !cfg.IsControlPlaneOnOutposts() {
+		// disable public access
+		logger.Info("disabling public endpoint access for the cluster")
+		cfg.VPC.ClusterEndpoints.PublicAccess = api.Disabled()
+		if err := ctl.UpdateClusterConfigForEndpoints(ctx, cfg); err != nil {
+			return fmt.Errorf("error disabling public endpoint access for the cluster: %w", err)
+		}
+		logger.Info("fully private cluster %q has been created. For subsequent operations, eksctl must be run from within the cluster's VPC, a peered VPC or some other means like AWS Direct Connect", cfg.Metadata.Name)
+	}
+
+	history.RecordCommand(ctx, ctl.AWSProvider, stackManager, cfg, options.Command, ctl.Status.IAMRoleARN)
+	logger.Success("%s is ready", meta.LogString())
+	nodeterminationhandler.SuggestInstall(cfg)
+
+	return printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg)
+}
+
+func logStacksToCreate(cfg *api.ClusterConfig, configFileProvided bool) {
+	if !configFileProvided {
+		logMsg := func(resource string) {
+			logger.Info("will create 2 separate CloudFormation stacks for cluster itself and the initial %s", resource)
+		}
+		if len(cfg.NodeGroups) == 1 {
+			logMsg("nodegroup")
+		} else if len(cfg.ManagedNodeGroups) == 1 {
+			logMsg("managed nodegroup")
+		}
+		return
+	}
+	logMsg := func(resource string, count int) {
+		logger.Info("will create a CloudFormation stack for cluster itself and %d %s stack(s)", count, resource)
+	}
+	logMsg("nodegroup", len(cfg.NodeGroups))
+	logMsg("managed nodegroup", len(cfg.ManagedNodeGroups))
+}
+
+func validateAddonConfigurationValues(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig) error {
+	addonManager, err := addon.New(cfg, ctl.AWSProvider.EKS(), nil, false, nil, nil)
+	if err != nil {
+		return err
+	}
+	for _, a := range cfg.Addons {
+		if err := addonManager.ValidateConfigurationValues(ctx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkNodeGroupQuotas(ctx context.Context, ctl *eks.ClusterProvider, nodePools []api.NodePool, mode preflight.QuotaCheckMode) error {
+	var requests []preflight.InstanceRequest
+	for _, np := range nodePools {
+		requests = append(requests, preflight.NodeGroupInstanceRequests(np)...)
+	}
+	return preflight.NewQuotaChecker(ctl.AWSProvider.EC2(), ctl.AWSProvider.ServiceQuotas()).EnforceVCPUQuotas(ctx, requests, mode)
+}
+
+// waitForNodeGroups authorises the nodes of the unmanaged nodegroups to join the cluster, and waits for the nodes of
+// all nodegroups to join
+func waitForNodeGroups(ctx context.Context, timeout time.Duration, clientSet kubeclient.Interface, cfg *api.ClusterConfig) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for _, ng := range cfg.NodeGroups {
+		// authorise nodes to join
+		if err := authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
+			return err
+		}
+
+		// wait for nodes to join
+		if err := eks.WaitForNodes(ctx, clientSet, ng); err != nil {
+			return err
+		}
+	}
+
+	for _, ng := range cfg.ManagedNodeGroups {
+		if err := eks.WaitForNodes(ctx, clientSet, ng); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// installKarpenter prepares the environment for Karpenter, by creating the following resources:
+// - iam roles and profiles
+// - service account
+// - identity mapping
+// then proceeds with installing Karpenter using Helm.
+func installKarpenter(ctx context.Context, newInstaller NewKarpenterInstallerFunc, ctl *eks.ClusterProvider, cfg *api.ClusterConfig, stackManager manager.StackManager, clientSet kubeclient.Interface, restClientGetter *kubernetes.SimpleRESTClientGetter) error {
+	installer, err := newInstaller(ctx, cfg, ctl, stackManager, clientSet, restClientGetter)
+	if err != nil {
+		return fmt.Errorf("failed to create installer: %w", err)
+	}
+	if err := installer.Create(ctx); err != nil {
+		return fmt.Errorf("failed to install Karpenter: %w", err)
+	}
+
+	return nil
+}
+
+// installCharts installs the Helm charts in the charts section
+func installCharts(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig) error {
+	installer := charts.NewInstaller(func(namespace string) (providers.HelmInstaller, error) {
+		restClientGetter, err := ctl.NewRESTClientGetter(cfg, namespace)
+		if err != nil {
+			return nil, err
+		}
+		return helm.NewInstaller(helm.Options{
+			Namespace:        namespace,
+			RESTClientGetter: restClientGetter,
+		})
+	})
+	return installer.Install(ctx, cfg.Charts)
+}
+
+// installArgoCD installs Argo CD and bootstraps it from the repository in gitops.argocd
+func installArgoCD(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig, clientSet kubeclient.Interface) error {
+	restClientGetter, err := ctl.NewRESTClientGetter(cfg, argocd.Namespace(cfg.GitOps.ArgoCD))
+	if err != nil {
+		return err
+	}
+	helmInstaller, err := helm.NewInstaller(helm.Options{
+		Namespace:        argocd.Namespace(cfg.GitOps.ArgoCD),
+		RESTClientGetter: restClientGetter,
+	})
+	if err != nil {
+		return err
+	}
+	installer, err := argocd.New(helmInstaller, clientSet, cfg.GitOps)
+	if err != nil {
+		return fmt.Errorf("could not initialise Argo CD installer: %w", err)
+	}
+	return installer.Run(ctx)
+}
+
+// SetVersion resolves the "auto" and "latest" versions of the cluster to create, and sets the default version when
+// none is set
+func SetVersion(cfg *api.ClusterConfig) error {
+	switch cfg.Metadata.Version {
+	case "auto":
+		cfg.Metadata.Version = api.DefaultVersion
+	case "latest":
+		cfg.Metadata.Version = api.LatestVersion
+	}
+
+	if err := api.ValidateClusterVersion(cfg); err != nil {
+		return err
+	}
+	if cfg.Metadata.Version == "" {
+		cfg.Metadata.Version = api.DefaultVersion
+	}
+	return nil
+}
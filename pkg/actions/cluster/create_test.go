@@ -0,0 +1,28 @@
+package cluster_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("SetVersion", func() {
+	DescribeTable("resolves the version", func(version, expected string) {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Version = version
+		Expect(cluster.SetVersion(cfg)).To(Succeed())
+		Expect(cfg.Metadata.Version).To(Equal(expected))
+	},
+		Entry("when not set", "", api.DefaultVersion),
+		Entry("when auto", "auto", api.DefaultVersion),
+		Entry("when latest", "latest", api.LatestVersion),
+	)
+
+	It("rejects an unsupported version", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Version = "1.0"
+		Expect(cluster.SetVersion(cfg)).NotTo(Succeed())
+	})
+})
@@ -14,13 +14,13 @@ import (
 	"github.com/pkg/errors"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/kris-nova/logger"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
-	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/elb"
 	"github.com/weaveworks/eksctl/pkg/fargate"
@@ -28,14 +28,65 @@ import (
 	ssh "github.com/weaveworks/eksctl/pkg/ssh/client"
 	"github.com/weaveworks/eksctl/pkg/utils/apierrors"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
 )
 
+const (
+	// RetainVPC keeps the VPC created by eksctl along with its subnets, gateways and route tables
+	RetainVPC = "vpc"
+	// RetainIAMOIDCProvider keeps the IAM OIDC provider of the cluster
+	RetainIAMOIDCProvider = "iam-oidc-provider"
+	// RetainLogGroups keeps the CloudWatch log groups created by eksctl, such as the one VPC flow logs are sent to
+	RetainLogGroups = "log-groups"
+)
+
+// RetainableResources are the shared resources that can be kept when deleting a cluster
+var RetainableResources = []string{RetainVPC, RetainIAMOIDCProvider, RetainLogGroups}
+
+// retainedStackResourceTypes maps the resources that can be retained to the types of their resources in the cluster stack
+var retainedStackResourceTypes = map[string][]string{
+	RetainVPC: {
+		"AWS::EC2::VPC",
+		"AWS::EC2::VPCCidrBlock",
+		"AWS::EC2::Subnet",
+		"AWS::EC2::InternetGateway",
+		"AWS::EC2::EgressOnlyInternetGateway",
+		"AWS::EC2::VPCGatewayAttachment",
+		"AWS::EC2::NatGateway",
+		"AWS::EC2::EIP",
+		"AWS::EC2::RouteTable",
+		"AWS::EC2::Route",
+		"AWS::EC2::SubnetRouteTableAssociation",
+	},
+	RetainLogGroups: {
+		"AWS::Logs::LogGroup",
+	},
+}
+
+// ValidateRetainedResources validates the resources to retain when deleting a cluster
+func ValidateRetainedResources(retain []string) error {
+	for _, r := range retain {
+		if !sets.NewString(RetainableResources...).Has(r) {
+			return fmt.Errorf("invalid resource %q to retain, must be one of %s", r, strings.Join(RetainableResources, ", "))
+		}
+	}
+	return nil
+}
+
+func stackResourceTypesToRetain(retain []string) []string {
+	var resourceTypes []string
+	for _, r := range retain {
+		resourceTypes = append(resourceTypes, retainedStackResourceTypes[r]...)
+	}
+	return resourceTypes
+}
+
 type NodeGroupDrainer interface {
 	Drain(ctx context.Context, input *nodegroup.DrainInput) error
 }
 type vpcCniDeleter func(clusterConfig *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.Interface)
 
-func deleteSharedResources(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clusterOperable bool, clientSet kubernetes.Interface) error {
+func deleteSharedResources(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clusterOperable bool, clientSet kubernetes.Interface, cleanupOrphans bool) error {
 	if clusterOperable && !cfg.IsControlPlaneOnOutposts() {
 		if err := deleteFargateProfiles(ctx, cfg.Metadata, ctl, stackManager); err != nil {
 			return err
@@ -65,9 +116,59 @@ func deleteSharedResources(ctx context.Context, cfg *api.ClusterConfig, ctl *eks
 			return err
 		}
 	}
+
+	if cleanupOrphans {
+		if err := cleanupOrphanedResources(ctx, cfg, ctl); err != nil {
+			return fmt.Errorf("cleaning up orphaned resources: %w", err)
+		}
+	}
 	return nil
 }
 
+// cleanupOrphanedResources deletes the resources created by controllers running in the cluster that were left behind
+// in the cluster VPC, as they would otherwise make the deletion of the cluster stack fail
+func cleanupOrphanedResources(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider) error {
+	orphans, err := findOrphanedResources(ctx, cfg, ctl)
+	if err != nil || orphans == nil {
+		return err
+	}
+	if orphans.Len() == 0 {
+		logger.Info("no orphaned resources found")
+		return nil
+	}
+
+	logOrphanedResources(false, cfg.Metadata.Name, orphans)
+	if err := elb.DeleteOrphanedResources(ctx, ctl.AWSProvider.EC2(), ctl.AWSProvider.ELB(), ctl.AWSProvider.ELBV2(), orphans); err != nil {
+		return err
+	}
+	logger.Success("deleted %d orphaned resource(s)", orphans.Len())
+	return nil
+}
+
+// findOrphanedResources returns nil when the VPC of the cluster is unknown
+func findOrphanedResources(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider) (*elb.OrphanedResources, error) {
+	var vpcID string
+	if cluster := ctl.Status.ClusterInfo.Cluster; cluster != nil && cluster.ResourcesVpcConfig != nil {
+		vpcID = aws.ToString(cluster.ResourcesVpcConfig.VpcId)
+	} else if cfg.VPC != nil {
+		vpcID = cfg.VPC.ID
+	}
+	if vpcID == "" {
+		logger.Warning("unable to determine the VPC of cluster %q, skipping the cleanup of orphaned resources", cfg.Metadata.Name)
+		return nil, nil
+	}
+
+	logger.Info("looking for orphaned load balancers, network interfaces and security groups in VPC %q", vpcID)
+	return elb.FindOrphanedResources(ctx, ctl.AWSProvider.EC2(), ctl.AWSProvider.ELB(), ctl.AWSProvider.ELBV2(), cfg.Metadata.Name, vpcID)
+}
+
+func logOrphanedResources(plan bool, clusterName string, orphans *elb.OrphanedResources) {
+	utilsplan.LogIntendedAction(plan, "delete %d orphaned resource(s) created by controllers in cluster %q:", orphans.Len(), clusterName)
+	for _, line := range orphans.Describe() {
+		logger.Info("- %s", line)
+	}
+}
+
 func handleErrors(errs []error, subject string) error {
 	logger.Info("%d error(s) occurred while deleting %s", len(errs), subject)
 	for _, err := range errs {
@@ -174,14 +275,18 @@ func drainAllNodeGroups(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.Cl
 	cfg.NodeGroups = []*api.NodeGroup{}
 	for _, s := range allStacks {
 		if s.Type == api.NodeGroupTypeUnmanaged {
-			cmdutils.PopulateUnmanagedNodegroup(s.NodeGroupName, cfg)
+			cfg.NodeGroups = append(cfg.NodeGroups, &api.NodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name: s.NodeGroupName,
+				},
+			})
 		}
 	}
 
 	logger.Info("will drain %d unmanaged nodegroup(s) in cluster %q", len(cfg.NodeGroups), cfg.Metadata.Name)
 
 	drainInput := &nodegroup.DrainInput{
-		NodeGroups:            cmdutils.ToKubeNodeGroups(cfg),
+		NodeGroups:            eks.ToKubeNodeGroups(cfg),
 		MaxGracePeriod:        ctl.AWSProvider.WaitTimeout(),
 		DisableEviction:       disableEviction,
 		PodEvictionWaitPeriod: podEvictionWaitPeriod,
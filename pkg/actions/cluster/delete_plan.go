@@ -0,0 +1,218 @@
+package cluster
+
+import (
+	"context"
+
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/elb"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/apierrors"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// PlanDelete logs the nodegroups to drain, the Fargate profiles and load balancers, and the tasks Delete would run along
+// with the resources of the stacks they delete, without deleting anything
+func (c *OwnedCluster) PlanDelete(ctx context.Context, wait, force, cleanupOrphans bool, retain []string) error {
+	clusterOperable, clientSet, stacks, err := planDeleteSharedResources(ctx, c.cfg, c.ctl, c.stackManager, c.newClientSet, cleanupOrphans, retain)
+	if err != nil {
+		return err
+	}
+
+	nodeGroupStacks, err := c.stackManager.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return err
+	}
+	deleteTasks, err := c.newTasksToDeleteCluster(ctx, clusterOperable, clientSet, nodeGroupStacks, wait, force, retain)
+	if err != nil {
+		return err
+	}
+	logPlannedTasks(deleteTasks)
+	stacks = append(stacks, manager.StacksInTaskTree(deleteTasks)...)
+
+	karpenterStack, err := c.stackManager.GetKarpenterStack(ctx)
+	if err != nil {
+		return err
+	}
+	if karpenterStack != nil {
+		stacks = append(stacks, karpenterStack)
+	}
+
+	if err := manager.LogStacksToDelete(ctx, c.stackManager, stacks, stackResourceTypesToRetain(retain)); err != nil {
+		return err
+	}
+	utilsplan.LogPlanModeWarning(true)
+	return nil
+}
+
+// PlanDelete logs the nodegroups to drain, the Fargate profiles and load balancers, the tasks Delete would run along
+// with the resources of the stacks they delete, the managed nodegroups and the cluster, without deleting anything
+func (c *UnownedCluster) PlanDelete(ctx context.Context, wait, force, cleanupOrphans bool, retain []string) error {
+	clusterName := c.cfg.Metadata.Name
+	if err := c.checkClusterExists(ctx, clusterName); err != nil {
+		return err
+	}
+	warnRetainedStackResources(retain)
+	clusterOperable, clientSet, stacks, err := planDeleteSharedResources(ctx, c.cfg, c.ctl, c.stackManager, c.newClientSet, cleanupOrphans, retain)
+	if err != nil {
+		return err
+	}
+	if !clusterOperable || c.cfg.IsControlPlaneOnOutposts() {
+		// deleteFargateRoleIfExists deletes the Fargate stack when deleteSharedResources does not
+		fargateStack, err := c.stackManager.GetFargateStack(ctx)
+		if err != nil {
+			return err
+		}
+		if fargateStack != nil {
+			stacks = append(stacks, fargateStack)
+		}
+	}
+
+	nodeGroupStacks, err := c.stackManager.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return err
+	}
+	nodeGroupTasks, err := c.stackManager.NewTasksToDeleteNodeGroups(nodeGroupStacks, func(_ string) bool { return true }, true, nil)
+	if err != nil {
+		return err
+	}
+	logPlannedTasks(nodeGroupTasks)
+	stacks = append(stacks, manager.StacksInTaskTree(nodeGroupTasks)...)
+
+	nodeGroups, err := c.ctl.AWSProvider.EKS().ListNodegroups(ctx, &awseks.ListNodegroupsInput{
+		ClusterName: &clusterName,
+	})
+	if err != nil {
+		return err
+	}
+	for _, ng := range nodeGroups.Nodegroups {
+		if !hasNodeGroupStack(nodeGroupStacks, ng) {
+			utilsplan.LogIntendedAction(true, "delete managed nodegroup %q", ng)
+		}
+	}
+
+	iamTasks, err := c.newTasksToDeleteIAMAndOIDC(ctx, wait, clusterOperable, clientSet, force, sets.NewString(retain...).Has(RetainIAMOIDCProvider))
+	if err != nil {
+		return err
+	}
+	logPlannedTasks(iamTasks)
+	stacks = append(stacks, manager.StacksInTaskTree(iamTasks)...)
+
+	if err := manager.LogStacksToDelete(ctx, c.stackManager, stacks, stackResourceTypesToRetain(retain)); err != nil {
+		return err
+	}
+	utilsplan.LogIntendedAction(true, "delete EKS cluster %q", clusterName)
+	utilsplan.LogPlanModeWarning(true)
+	return nil
+}
+
+// planDeleteSharedResources logs the resources deleteSharedResources would remove. It returns whether the cluster is
+// operable, its clientset and the stacks deleted along with the resources
+func planDeleteSharedResources(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, newClientSet func() (kubernetes.Interface, error), cleanupOrphans bool, retain []string) (bool, kubernetes.Interface, []*manager.Stack, error) {
+	clusterOperable, err := ctl.CanOperate(cfg)
+	if err != nil {
+		logger.Debug("failed to check if cluster is operable: %v", err)
+	}
+
+	var (
+		clientSet kubernetes.Interface
+		stacks    []*manager.Stack
+	)
+	if clusterOperable {
+		clientSet, err = newClientSet()
+		if err != nil {
+			return false, nil, nil, err
+		}
+		stacks, err = planDeleteClusterObjects(ctx, cfg, ctl, stackManager, clientSet)
+		if err != nil {
+			return false, nil, nil, err
+		}
+	}
+
+	if cleanupOrphans {
+		orphans, err := findOrphanedResources(ctx, cfg, ctl)
+		if err != nil {
+			return false, nil, nil, err
+		}
+		if orphans != nil && orphans.Len() > 0 {
+			logOrphanedResources(true, cfg.Metadata.Name, orphans)
+		}
+	}
+
+	if sets.NewString(retain...).Has(RetainIAMOIDCProvider) {
+		utilsplan.LogIntendedAction(true, "retain the IAM OIDC provider of cluster %q", cfg.Metadata.Name)
+	}
+	return clusterOperable, clientSet, stacks, nil
+}
+
+// logPlannedTasks logs the tasks of taskTree in plan mode
+func logPlannedTasks(taskTree *tasks.TaskTree) {
+	if taskTree.Len() == 0 {
+		return
+	}
+	taskTree.PlanMode = true
+	logger.Info(taskTree.Describe())
+}
+
+// planDeleteClusterObjects logs the nodegroups that are drained and the resources that are deleted through the
+// Kubernetes and EKS APIs before the stacks are deleted, it returns the Fargate stack deleted along with the profiles
+func planDeleteClusterObjects(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackManager manager.StackManager, clientSet kubernetes.Interface) ([]*manager.Stack, error) {
+	nodeGroupStacks, err := stackManager.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var unmanaged int
+	for _, s := range nodeGroupStacks {
+		if s.Type == api.NodeGroupTypeUnmanaged {
+			unmanaged++
+		}
+	}
+	if unmanaged > 0 {
+		utilsplan.LogIntendedAction(true, "drain %d unmanaged nodegroup(s) in cluster %q", unmanaged, cfg.Metadata.Name)
+	}
+
+	var stacks []*manager.Stack
+	if !cfg.IsControlPlaneOnOutposts() {
+		fargateManager := fargate.NewFromProvider(cfg.Metadata.Name, ctl.AWSProvider, stackManager)
+		profileNames, err := fargateManager.ListProfiles(ctx)
+		if err != nil && !apierrors.IsAccessDeniedError(err) {
+			return nil, err
+		}
+		for _, profileName := range profileNames {
+			utilsplan.LogIntendedAction(true, "delete Fargate profile %q", profileName)
+		}
+		fargateStack, err := stackManager.GetFargateStack(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if fargateStack != nil {
+			stacks = append(stacks, fargateStack)
+		}
+	}
+
+	cfg.Metadata.Version = *ctl.Status.ClusterInfo.Cluster.Version
+	objects, err := elb.DescribeLoadBalancerObjects(clientSet, cfg)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range objects {
+		utilsplan.LogIntendedAction(true, "delete %s along with its AWS load balancer", o)
+	}
+	return stacks, nil
+}
+
+func hasNodeGroupStack(stacks []manager.NodeGroupStack, nodeGroupName string) bool {
+	for _, s := range stacks {
+		if s.NodeGroupName == nodeGroupName {
+			return true
+		}
+	}
+	return false
+}
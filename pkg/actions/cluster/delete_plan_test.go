@@ -0,0 +1,136 @@
+package cluster_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+type stackTask struct {
+	stack *manager.Stack
+	ran   *bool
+}
+
+func (t *stackTask) Describe() string          { return "delete stack " + *t.stack.StackName }
+func (t *stackTask) StackSpec() *manager.Stack { return t.stack }
+func (t *stackTask) Do(errs chan error) error {
+	*t.ran = true
+	close(errs)
+	return nil
+}
+
+var _ = Describe("PlanDelete", func() {
+	const clusterName = "my-cluster"
+
+	var (
+		p                *mockprovider.MockProvider
+		cfg              *api.ClusterConfig
+		ctl              *eks.ClusterProvider
+		fakeStackManager *fakes.FakeStackManager
+		fakeClientSet    *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		ctl = &eks.ClusterProvider{AWSProvider: p, Status: &eks.ProviderStatus{
+			ClusterInfo: &eks.ClusterInfo{
+				Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive),
+			},
+		}}
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeStackManager.ListStackResourcesReturns([]cfntypes.StackResourceSummary{
+			{ResourceType: aws.String("AWS::EKS::Cluster"), LogicalResourceId: aws.String("ControlPlane"), PhysicalResourceId: aws.String(clusterName)},
+		}, nil)
+		fakeStackManager.ListNodeGroupStacksWithStatusesReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged},
+		}, nil)
+
+		p.MockEKS().On("ListFargateProfiles", mock.Anything, mock.Anything).Return(&awseks.ListFargateProfilesOutput{
+			FargateProfileNames: []string{"fp-1"},
+		}, nil)
+
+		fakeClientSet = fake.NewSimpleClientset(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		})
+	})
+
+	It("lists the resources of the stacks in the deletion tasks of an eksctl-owned cluster without deleting anything", func() {
+		var ranDeleteTasks bool
+		fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{
+			Tasks: []tasks.Task{
+				&tasks.TaskTree{
+					Tasks:     []tasks.Task{&stackTask{stack: &manager.Stack{StackName: aws.String("eksctl-my-cluster-efs")}, ran: &ranDeleteTasks}},
+					IsSubTask: true,
+				},
+				&stackTask{stack: &manager.Stack{StackName: aws.String("eksctl-my-cluster-cluster")}, ran: &ranDeleteTasks},
+			},
+		}, nil)
+		fakeStackManager.GetKarpenterStackReturns(&manager.Stack{StackName: aws.String("eksctl-my-cluster-karpenter")}, nil)
+
+		c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager)
+		c.SetNewClientSet(func() (kubernetes.Interface, error) {
+			return fakeClientSet, nil
+		})
+
+		Expect(c.PlanDelete(context.Background(), false, false, false, nil)).To(Succeed())
+
+		Expect(fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCallCount()).To(Equal(1))
+		Expect(ranDeleteTasks).To(BeFalse())
+		var stackNames []string
+		for i := 0; i < fakeStackManager.ListStackResourcesCallCount(); i++ {
+			_, stackName := fakeStackManager.ListStackResourcesArgsForCall(i)
+			stackNames = append(stackNames, stackName)
+		}
+		Expect(stackNames).To(Equal([]string{"eksctl-my-cluster-efs", "eksctl-my-cluster-cluster", "eksctl-my-cluster-karpenter"}))
+		Expect(fakeStackManager.DeleteStackBySpecCallCount()).To(BeZero())
+		Expect(fakeStackManager.DeleteStackSyncCallCount()).To(BeZero())
+
+		services, err := fakeClientSet.CoreV1().Services("default").List(context.Background(), metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services.Items).To(HaveLen(1))
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DeleteFargateProfile", mock.Anything, mock.Anything)
+	})
+
+	It("lists the managed nodegroups without stacks and the cluster of an unowned cluster", func() {
+		p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&awseks.DescribeClusterOutput{
+			Cluster: testutils.NewFakeCluster(clusterName, ekstypes.ClusterStatusActive),
+		}, nil)
+		p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything).Return(&awseks.ListNodegroupsOutput{
+			Nodegroups: []string{"ng-1", "mng-1"},
+		}, nil)
+
+		c := cluster.NewUnownedCluster(cfg, ctl, fakeStackManager)
+		c.SetNewClientSet(func() (kubernetes.Interface, error) {
+			return fakeClientSet, nil
+		})
+
+		Expect(c.PlanDelete(context.Background(), false, false, false, nil)).To(Succeed())
+		Expect(fakeStackManager.NewTasksToDeleteNodeGroupsCallCount()).To(Equal(1))
+		Expect(fakeStackManager.NewTasksToDeleteAuxiliaryStacksCallCount()).To(Equal(1))
+		Expect(fakeStackManager.ListStackResourcesCallCount()).To(BeZero())
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DeleteNodegroup", mock.Anything, mock.Anything)
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DeleteCluster", mock.Anything, mock.Anything)
+	})
+})
@@ -0,0 +1,23 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// CheckDeletionProtection returns an error if deletion protection is enabled on the cluster stack
+func CheckDeletionProtection(ctx context.Context, stackManager manager.StackManager, clusterName string) error {
+	stack, err := stackManager.GetClusterStackIfExists(ctx)
+	if err != nil {
+		return fmt.Errorf("checking deletion protection of cluster %q: %w", clusterName, err)
+	}
+	if stack != nil && aws.ToBool(stack.EnableTerminationProtection) {
+		return fmt.Errorf("cluster %q has deletion protection enabled, disable it with 'eksctl utils set-deletion-protection --cluster=%s --deletion-protection=false' before deleting the cluster",
+			clusterName, clusterName)
+	}
+	return nil
+}
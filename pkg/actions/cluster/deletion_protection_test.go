@@ -0,0 +1,42 @@
+package cluster_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+)
+
+var _ = Describe("CheckDeletionProtection", func() {
+	var fakeStackManager *fakes.FakeStackManager
+
+	BeforeEach(func() {
+		fakeStackManager = &fakes.FakeStackManager{}
+	})
+
+	It("refuses to delete a cluster whose stack has termination protection enabled", func() {
+		fakeStackManager.GetClusterStackIfExistsReturns(&cfntypes.Stack{
+			StackName:                   aws.String("eksctl-my-cluster-cluster"),
+			EnableTerminationProtection: aws.Bool(true),
+		}, nil)
+		err := cluster.CheckDeletionProtection(context.Background(), fakeStackManager, "my-cluster")
+		Expect(err).To(MatchError(ContainSubstring(`cluster "my-cluster" has deletion protection enabled`)))
+	})
+
+	It("allows deleting a cluster whose stack has termination protection disabled", func() {
+		fakeStackManager.GetClusterStackIfExistsReturns(&cfntypes.Stack{
+			StackName:                   aws.String("eksctl-my-cluster-cluster"),
+			EnableTerminationProtection: aws.Bool(false),
+		}, nil)
+		Expect(cluster.CheckDeletionProtection(context.Background(), fakeStackManager, "my-cluster")).To(Succeed())
+	})
+
+	It("allows deleting a cluster not created by eksctl", func() {
+		Expect(cluster.CheckDeletionProtection(context.Background(), fakeStackManager, "my-cluster")).To(Succeed())
+	})
+})
@@ -0,0 +1,287 @@
+package cluster
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/utils"
+	utilsstrings "github.com/weaveworks/eksctl/pkg/utils/strings"
+)
+
+// deprecatedAPIsMetric is set by the API server for every deprecated API that has been requested since it started
+const deprecatedAPIsMetric = "apiserver_requested_deprecated_apis"
+
+// lastAppliedConfigAnnotation records the manifest of an object last applied with kubectl
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+var metricLabelRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// removedAPI is a Kubernetes API that is no longer served from a Kubernetes version
+type removedAPI struct {
+	groupVersion string
+	resource     string
+	kind         string
+	removedIn    string
+	// replacement is the API version objects must be migrated to, empty if the resource is removed altogether
+	replacement string
+	// eksManaged are the names of the objects EKS creates in every cluster and removes when upgrading it
+	eksManaged []string
+}
+
+// removedAPIs are the Kubernetes APIs removed since the oldest version supported by EKS,
+// see https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+var removedAPIs = []removedAPI{
+	{groupVersion: "batch/v1beta1", resource: "cronjobs", kind: "CronJob", removedIn: "1.25", replacement: "batch/v1"},
+	{groupVersion: "discovery.k8s.io/v1beta1", resource: "endpointslices", kind: "EndpointSlice", removedIn: "1.25", replacement: "discovery.k8s.io/v1"},
+	{groupVersion: "events.k8s.io/v1beta1", resource: "events", kind: "Event", removedIn: "1.25", replacement: "events.k8s.io/v1"},
+	{groupVersion: "autoscaling/v2beta1", resource: "horizontalpodautoscalers", kind: "HorizontalPodAutoscaler", removedIn: "1.25", replacement: "autoscaling/v2"},
+	{groupVersion: "policy/v1beta1", resource: "poddisruptionbudgets", kind: "PodDisruptionBudget", removedIn: "1.25", replacement: "policy/v1"},
+	{groupVersion: "policy/v1beta1", resource: "podsecuritypolicies", kind: "PodSecurityPolicy", removedIn: "1.25", eksManaged: []string{"eks.privileged"}},
+	{groupVersion: "node.k8s.io/v1beta1", resource: "runtimeclasses", kind: "RuntimeClass", removedIn: "1.25", replacement: "node.k8s.io/v1"},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta1", resource: "flowschemas", kind: "FlowSchema", removedIn: "1.26", replacement: "flowcontrol.apiserver.k8s.io/v1beta3"},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta1", resource: "prioritylevelconfigurations", kind: "PriorityLevelConfiguration", removedIn: "1.26", replacement: "flowcontrol.apiserver.k8s.io/v1beta3"},
+	{groupVersion: "autoscaling/v2beta2", resource: "horizontalpodautoscalers", kind: "HorizontalPodAutoscaler", removedIn: "1.26", replacement: "autoscaling/v2"},
+	{groupVersion: "storage.k8s.io/v1beta1", resource: "csistoragecapacities", kind: "CSIStorageCapacity", removedIn: "1.27", replacement: "storage.k8s.io/v1"},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta2", resource: "flowschemas", kind: "FlowSchema", removedIn: "1.29", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta2", resource: "prioritylevelconfigurations", kind: "PriorityLevelConfiguration", removedIn: "1.29", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta3", resource: "flowschemas", kind: "FlowSchema", removedIn: "1.32", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+	{groupVersion: "flowcontrol.apiserver.k8s.io/v1beta3", resource: "prioritylevelconfigurations", kind: "PriorityLevelConfiguration", removedIn: "1.32", replacement: "flowcontrol.apiserver.k8s.io/v1"},
+}
+
+// DeprecatedAPIUsage is a deprecated Kubernetes API that has been requested from the API server
+type DeprecatedAPIUsage struct {
+	Group          string
+	Version        string
+	Resource       string
+	RemovedRelease string
+}
+
+// GroupVersion returns the API group and version of the deprecated API
+func (d DeprecatedAPIUsage) GroupVersion() string {
+	if d.Group == "" {
+		return d.Version
+	}
+	return d.Group + "/" + d.Version
+}
+
+// GetDeprecatedAPIUsage returns the deprecated APIs that have been requested since the API server started
+// and are removed in targetVersion or earlier
+func GetDeprecatedAPIUsage(ctx context.Context, restClient rest.Interface, targetVersion string) ([]DeprecatedAPIUsage, error) {
+	metrics, err := restClient.Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching API server metrics: %w", err)
+	}
+
+	var usages []DeprecatedAPIUsage
+	seen := map[string]struct{}{}
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, deprecatedAPIsMetric+"{") {
+			continue
+		}
+		labels := map[string]string{}
+		for _, match := range metricLabelRegexp.FindAllStringSubmatch(line, -1) {
+			labels[match[1]] = match[2]
+		}
+		usage := DeprecatedAPIUsage{
+			Group:          labels["group"],
+			Version:        labels["version"],
+			Resource:       labels["resource"],
+			RemovedRelease: labels["removed_release"],
+		}
+		// APIs that are deprecated but not scheduled for removal keep working after the upgrade
+		if usage.RemovedRelease == "" {
+			continue
+		}
+		// the objects of resources removed altogether are checked instead, as EKS itself requests the API of
+		// the objects it manages
+		if removed, ok := findRemovedAPI(usage.GroupVersion(), usage.Resource); ok && removed.replacement == "" {
+			continue
+		}
+		c, err := utils.CompareVersions(usage.RemovedRelease, targetVersion)
+		if err != nil {
+			return nil, err
+		}
+		// the metric is reported per subresource
+		key := usage.GroupVersion() + "/" + usage.Resource
+		if _, ok := seen[key]; ok || c > 0 {
+			continue
+		}
+		seen[key] = struct{}{}
+		usages = append(usages, usage)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading API server metrics: %w", err)
+	}
+
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].GroupVersion() != usages[j].GroupVersion() {
+			return usages[i].GroupVersion() < usages[j].GroupVersion()
+		}
+		return usages[i].Resource < usages[j].Resource
+	})
+	return usages, nil
+}
+
+// DeprecatedObject is an object in the cluster that is managed with a Kubernetes API removed in the target version
+type DeprecatedObject struct {
+	Kind       string
+	Namespace  string
+	Name       string
+	APIVersion string
+	// ReplacementAPIVersion is empty if the resource is removed altogether
+	ReplacementAPIVersion string
+	RemovedRelease        string
+}
+
+// GetDeprecatedObjects returns the objects whose last applied configuration uses a Kubernetes API that is removed
+// after currentVersion and up to targetVersion, and the objects of resources that are removed altogether
+func GetDeprecatedObjects(ctx context.Context, restClient rest.Interface, currentVersion, targetVersion string) ([]DeprecatedObject, error) {
+	var objects []DeprecatedObject
+	for _, removed := range removedAPIs {
+		removedAfterCurrent, err := utils.CompareVersions(removed.removedIn, currentVersion)
+		if err != nil {
+			return nil, err
+		}
+		removedByTarget, err := utils.CompareVersions(removed.removedIn, targetVersion)
+		if err != nil {
+			return nil, err
+		}
+		if removedAfterCurrent <= 0 || removedByTarget > 0 {
+			continue
+		}
+
+		// the removed API is still served by the current version and lists objects created with any API version
+		data, err := restClient.Get().AbsPath("/apis", removed.groupVersion, removed.resource).DoRaw(ctx)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("listing %s %s: %w", removed.groupVersion, removed.resource, err)
+		}
+		var list struct {
+			Items []struct {
+				metav1.ObjectMeta `json:"metadata"`
+			} `json:"items"`
+		}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, fmt.Errorf("parsing %s %s: %w", removed.groupVersion, removed.resource, err)
+		}
+
+		for _, item := range list.Items {
+			if removed.replacement != "" && lastAppliedAPIVersion(item.ObjectMeta) != removed.groupVersion {
+				continue
+			}
+			if utilsstrings.Contains(removed.eksManaged, item.Name) {
+				continue
+			}
+			objects = append(objects, DeprecatedObject{
+				Kind:                  removed.kind,
+				Namespace:             item.Namespace,
+				Name:                  item.Name,
+				APIVersion:            removed.groupVersion,
+				ReplacementAPIVersion: removed.replacement,
+				RemovedRelease:        removed.removedIn,
+			})
+		}
+	}
+	return objects, nil
+}
+
+func findRemovedAPI(groupVersion, resource string) (removedAPI, bool) {
+	for _, removed := range removedAPIs {
+		if removed.groupVersion == groupVersion && removed.resource == resource {
+			return removed, true
+		}
+	}
+	return removedAPI{}, false
+}
+
+func lastAppliedAPIVersion(meta metav1.ObjectMeta) string {
+	lastApplied, ok := meta.Annotations[lastAppliedConfigAnnotation]
+	if !ok {
+		return ""
+	}
+	var manifest struct {
+		APIVersion string `json:"apiVersion"`
+	}
+	if err := json.Unmarshal([]byte(lastApplied), &manifest); err != nil {
+		return ""
+	}
+	return manifest.APIVersion
+}
+
+// RemovedAPIsReport is the usage of Kubernetes APIs removed by an upgrade of the cluster
+type RemovedAPIsReport struct {
+	CurrentVersion string
+	TargetVersion  string
+	DeprecatedAPIs []DeprecatedAPIUsage
+	Objects        []DeprecatedObject
+}
+
+// Empty returns true if no usage of removed APIs was found
+func (r *RemovedAPIsReport) Empty() bool {
+	return len(r.DeprecatedAPIs) == 0 && len(r.Objects) == 0
+}
+
+// LogWarnings logs a warning for every usage of removed APIs in the report
+func (r *RemovedAPIsReport) LogWarnings() {
+	for _, d := range r.DeprecatedAPIs {
+		logger.Warning("%s %s has been requested from the API server and is removed in version %s", d.GroupVersion(), d.Resource, d.RemovedRelease)
+	}
+	for _, o := range r.Objects {
+		name := o.Name
+		if o.Namespace != "" {
+			name = o.Namespace + "/" + o.Name
+		}
+		if o.ReplacementAPIVersion == "" {
+			logger.Warning("%s %q uses %s, which is removed in version %s without a replacement", o.Kind, name, o.APIVersion, o.RemovedRelease)
+			continue
+		}
+		logger.Warning("%s %q was last applied with %s, which is removed in version %s, migrate it to %s", o.Kind, name, o.APIVersion, o.RemovedRelease, o.ReplacementAPIVersion)
+	}
+}
+
+// GetRemovedAPIsReport returns the usage of Kubernetes APIs that are removed by an upgrade from currentVersion to targetVersion
+func GetRemovedAPIsReport(ctx context.Context, restClient rest.Interface, currentVersion, targetVersion string) (*RemovedAPIsReport, error) {
+	report := &RemovedAPIsReport{
+		CurrentVersion: currentVersion,
+		TargetVersion:  targetVersion,
+	}
+	var err error
+	if report.DeprecatedAPIs, err = GetDeprecatedAPIUsage(ctx, restClient, targetVersion); err != nil {
+		return nil, err
+	}
+	if report.Objects, err = GetDeprecatedObjects(ctx, restClient, currentVersion, targetVersion); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// CheckRemovedAPIs returns the usage of Kubernetes APIs that are removed by an upgrade of the cluster to
+// cfg.Metadata.Version, or to the next Kubernetes version if it is not set
+func CheckRemovedAPIs(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider) (*RemovedAPIsReport, error) {
+	currentVersion := ctl.ControlPlaneVersion()
+	if _, err := requiresVersionUpgrade(cfg.Metadata, currentVersion); err != nil {
+		return nil, err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return GetRemovedAPIsReport(ctx, clientSet.Discovery().RESTClient(), currentVersion, cfg.Metadata.Version)
+}
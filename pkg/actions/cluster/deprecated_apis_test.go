@@ -0,0 +1,143 @@
+package cluster_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+)
+
+const apiServerMetrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="autoscaling",removed_release="1.26",resource="horizontalpodautoscalers",subresource="",version="v2beta2"} 1
+apiserver_requested_deprecated_apis{group="autoscaling",removed_release="1.26",resource="horizontalpodautoscalers",subresource="status",version="v2beta2"} 1
+apiserver_requested_deprecated_apis{group="flowcontrol.apiserver.k8s.io",removed_release="1.29",resource="flowschemas",subresource="",version="v1beta2"} 1
+apiserver_requested_deprecated_apis{group="",removed_release="",resource="componentstatuses",subresource="",version="v1"} 1
+apiserver_request_total{code="200",resource="pods",verb="LIST",version="v1"} 12
+`
+
+var _ = Describe("GetDeprecatedAPIUsage", func() {
+	var restClient *fake.RESTClient
+
+	BeforeEach(func() {
+		restClient = &fake.RESTClient{
+			NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+			Resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(apiServerMetrics)),
+			},
+		}
+	})
+
+	It("returns the requested APIs that are removed in the target version or earlier, except the ones removed without a replacement", func() {
+		usages, err := cluster.GetDeprecatedAPIUsage(context.Background(), restClient, "1.26")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usages).To(Equal([]cluster.DeprecatedAPIUsage{
+			{
+				Group:          "autoscaling",
+				Version:        "v2beta2",
+				Resource:       "horizontalpodautoscalers",
+				RemovedRelease: "1.26",
+			},
+		}))
+		Expect(restClient.Req.URL.Path).To(Equal("/metrics"))
+	})
+
+	It("returns an error if the metrics cannot be fetched", func() {
+		restClient.Resp = nil
+		restClient.Err = io.ErrUnexpectedEOF
+		_, err := cluster.GetDeprecatedAPIUsage(context.Background(), restClient, "1.26")
+		Expect(err).To(MatchError(ContainSubstring("fetching API server metrics")))
+	})
+})
+
+var _ = Describe("GetDeprecatedObjects", func() {
+	var (
+		restClient *fake.RESTClient
+		paths      []string
+	)
+
+	respond := func(statusCode int, body string) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	BeforeEach(func() {
+		paths = nil
+		restClient = &fake.RESTClient{
+			NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+			Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+				paths = append(paths, req.URL.Path)
+				switch req.URL.Path {
+				case "/apis/autoscaling/v2beta2/horizontalpodautoscalers":
+					return respond(http.StatusOK, `{"items": [
+						{"metadata": {"name": "old", "namespace": "default", "annotations": {"kubectl.kubernetes.io/last-applied-configuration": "{\"apiVersion\":\"autoscaling/v2beta2\",\"kind\":\"HorizontalPodAutoscaler\"}"}}},
+						{"metadata": {"name": "migrated", "namespace": "default", "annotations": {"kubectl.kubernetes.io/last-applied-configuration": "{\"apiVersion\":\"autoscaling/v2\",\"kind\":\"HorizontalPodAutoscaler\"}"}}},
+						{"metadata": {"name": "unmanaged", "namespace": "default"}}
+					]}`), nil
+				case "/apis/flowcontrol.apiserver.k8s.io/v1beta1/flowschemas":
+					return respond(http.StatusNotFound, `{"kind": "Status", "code": 404}`), nil
+				default:
+					return respond(http.StatusOK, `{"items": []}`), nil
+				}
+			}),
+		}
+	})
+
+	It("returns the objects last applied with an API removed in the target version", func() {
+		objects, err := cluster.GetDeprecatedObjects(context.Background(), restClient, "1.25", "1.26")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(Equal([]cluster.DeprecatedObject{
+			{
+				Kind:                  "HorizontalPodAutoscaler",
+				Namespace:             "default",
+				Name:                  "old",
+				APIVersion:            "autoscaling/v2beta2",
+				ReplacementAPIVersion: "autoscaling/v2",
+				RemovedRelease:        "1.26",
+			},
+		}))
+		Expect(paths).To(ConsistOf(
+			"/apis/flowcontrol.apiserver.k8s.io/v1beta1/flowschemas",
+			"/apis/flowcontrol.apiserver.k8s.io/v1beta1/prioritylevelconfigurations",
+			"/apis/autoscaling/v2beta2/horizontalpodautoscalers",
+		))
+	})
+
+	It("returns all objects of resources removed without a replacement, except the ones managed by EKS", func() {
+		restClient.Client = fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path == "/apis/policy/v1beta1/podsecuritypolicies" {
+				return respond(http.StatusOK, `{"items": [{"metadata": {"name": "eks.privileged"}}, {"metadata": {"name": "restricted"}}]}`), nil
+			}
+			return respond(http.StatusOK, `{"items": []}`), nil
+		})
+		objects, err := cluster.GetDeprecatedObjects(context.Background(), restClient, "1.24", "1.25")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(Equal([]cluster.DeprecatedObject{
+			{
+				Kind:           "PodSecurityPolicy",
+				Name:           "restricted",
+				APIVersion:     "policy/v1beta1",
+				RemovedRelease: "1.25",
+			},
+		}))
+	})
+
+	It("does not check APIs when the version does not change", func() {
+		objects, err := cluster.GetDeprecatedObjects(context.Background(), restClient, "1.26", "1.26")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+		Expect(paths).To(BeEmpty())
+	})
+})
@@ -3,6 +3,7 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
@@ -37,12 +38,14 @@ var (
 	newStackCollection StackManagerConstructor = manager.NewStackCollection
 )
 
-func GetClusters(ctx context.Context, provider api.ClusterProvider, listAllRegions bool, chunkSize int) ([]Description, error) {
+// GetClusters lists the clusters of the region of provider or, with listAllRegions, of every supported region enabled
+// in the account, limited to regions when it is not empty. Regions are queried concurrently and the clusters are
+// returned in the order of the regions, errors in a region are logged and its clusters are left out
+func GetClusters(ctx context.Context, provider api.ClusterProvider, listAllRegions bool, regions []string, chunkSize int) ([]Description, error) {
 	if !listAllRegions {
-		return listClusters(ctx, provider, int32(chunkSize))
+		return listClusters(ctx, provider, newStackCollection(provider, newListClustersSpec()), int32(chunkSize))
 	}
 
-	var clusters []Description
 	authorizedRegionsList, err := provider.EC2().DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to describe regions: %w", err)
@@ -53,7 +56,21 @@ func GetClusters(ctx context.Context, provider api.ClusterProvider, listAllRegio
 		authorizedRegions[*r.RegionName] = struct{}{}
 	}
 
-	for _, region := range api.SupportedRegions() {
+	if len(regions) == 0 {
+		regions = api.SupportedRegions()
+	} else {
+		for _, region := range regions {
+			if _, authorized := authorizedRegions[region]; !authorized {
+				logger.Warning("skipping region %q as it is not enabled for the account", region)
+			}
+		}
+	}
+
+	var (
+		regionClusters = make([][]Description, len(regions))
+		wg             sync.WaitGroup
+	)
+	for i, region := range regions {
 		if _, authorized := authorizedRegions[region]; !authorized {
 			continue
 		}
@@ -68,24 +85,35 @@ func GetClusters(ctx context.Context, provider api.ClusterProvider, listAllRegio
 			logger.Critical("error creating provider in %q region: %v", region, err)
 			continue
 		}
+		stackManager := newStackCollection(ctl.AWSProvider, newListClustersSpec())
 
-		newClusters, err := listClusters(ctx, ctl.AWSProvider, int32(chunkSize))
-		if err != nil {
-			logger.Critical("error listing clusters in %q region: %v", region, err)
-			continue
-		}
-
-		clusters = append(clusters, newClusters...)
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			clusters, err := listClusters(ctx, ctl.AWSProvider, stackManager, int32(chunkSize))
+			if err != nil {
+				logger.Critical("error listing clusters in %q region: %v", region, err)
+				return
+			}
+			regionClusters[i] = clusters
+		}(i, region)
 	}
+	wg.Wait()
 
+	var clusters []Description
+	for _, c := range regionClusters {
+		clusters = append(clusters, c...)
+	}
 	return clusters, nil
 }
 
-func listClusters(ctx context.Context, provider api.ClusterProvider, chunkSize int32) ([]Description, error) {
+func newListClustersSpec() *api.ClusterConfig {
+	return &api.ClusterConfig{Metadata: &api.ClusterMeta{Name: ""}}
+}
+
+func listClusters(ctx context.Context, provider api.ClusterProvider, stackManager manager.StackManager, chunkSize int32) ([]Description, error) {
 	var allClusters []Description
 
-	spec := &api.ClusterConfig{Metadata: &api.ClusterMeta{Name: ""}}
-	stackManager := newStackCollection(provider, spec)
 	allStacks, err := stackManager.ListClusterStackNames(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list cluster stacks in region %q: %w", provider.Region(), err)
@@ -49,7 +49,7 @@ var _ = Describe("Get", func() {
 				intialProvider.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
 					MaxResults: aws.Int32(100),
 					Include:    []string{"all"},
-				}).Return(&awseks.ListClustersOutput{
+				}, mock.Anything).Return(&awseks.ListClustersOutput{
 					Clusters: []string{"cluster1", "cluster2", "cluster3"},
 				}, nil)
 
@@ -59,7 +59,7 @@ var _ = Describe("Get", func() {
 				stackManager.HasClusterStackFromListReturnsOnCall(2, false, fmt.Errorf("foo"))
 			})
 			It("returns the clusters in that region", func() {
-				clusters, err := cluster.GetClusters(context.Background(), intialProvider, false, 100)
+				clusters, err := cluster.GetClusters(context.Background(), intialProvider, false, nil, 100)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(clusters).To(ConsistOf(
 					cluster.Description{
@@ -100,7 +100,7 @@ var _ = Describe("Get", func() {
 			})
 
 			It("errors", func() {
-				_, err := cluster.GetClusters(context.Background(), intialProvider, false, 100)
+				_, err := cluster.GetClusters(context.Background(), intialProvider, false, nil, 100)
 				Expect(err).To(MatchError(`failed to list cluster stacks in region "us-west-2": foo`))
 			})
 		})
@@ -110,11 +110,11 @@ var _ = Describe("Get", func() {
 				intialProvider.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
 					MaxResults: aws.Int32(100),
 					Include:    []string{"all"},
-				}).Return(nil, fmt.Errorf("foo"))
+				}, mock.Anything).Return(nil, fmt.Errorf("foo"))
 			})
 
 			It("errors", func() {
-				_, err := cluster.GetClusters(context.Background(), intialProvider, false, 100)
+				_, err := cluster.GetClusters(context.Background(), intialProvider, false, nil, 100)
 				Expect(err).To(MatchError(`failed to list clusters in region "us-west-2": foo`))
 			})
 		})
@@ -158,14 +158,14 @@ var _ = Describe("Get", func() {
 				providerRegion1.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
 					MaxResults: aws.Int32(100),
 					Include:    []string{"all"},
-				}).Return(&awseks.ListClustersOutput{
+				}, mock.Anything).Return(&awseks.ListClustersOutput{
 					Clusters: []string{"cluster1"},
 				}, nil)
 
 				providerRegion2.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
 					MaxResults: aws.Int32(100),
 					Include:    []string{"all"},
-				}).Return(&awseks.ListClustersOutput{
+				}, mock.Anything).Return(&awseks.ListClustersOutput{
 					Clusters: []string{"cluster2"},
 				}, nil)
 
@@ -176,7 +176,7 @@ var _ = Describe("Get", func() {
 			})
 
 			It("returns the clusters across all authorised regions", func() {
-				clusters, err := cluster.GetClusters(context.Background(), intialProvider, true, 100)
+				clusters, err := cluster.GetClusters(context.Background(), intialProvider, true, nil, 100)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(clusters).To(ConsistOf(
 					cluster.Description{
@@ -213,13 +213,55 @@ var _ = Describe("Get", func() {
 			})
 		})
 
+		When("a set of regions is given", func() {
+			BeforeEach(func() {
+				awsProvider.ReturnsOnCall(0, &eks.ClusterProvider{AWSProvider: providerRegion2}, nil)
+				intialProvider.MockEC2().On("DescribeRegions", mock.Anything, &ec2.DescribeRegionsInput{}).Return(&ec2.DescribeRegionsOutput{
+					Regions: []ec2types.Region{
+						{
+							RegionName: aws.String("us-west-1"),
+						},
+						{
+							RegionName: aws.String("us-west-2"),
+						},
+					},
+				}, nil)
+
+				providerRegion2.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
+					MaxResults: aws.Int32(100),
+					Include:    []string{"all"},
+				}, mock.Anything).Return(&awseks.ListClustersOutput{
+					Clusters: []string{"cluster2"},
+				}, nil)
+
+				stackManagerRegion1.ListClusterStackNamesReturns(nil, nil)
+				stackManagerRegion1.HasClusterStackFromListReturnsOnCall(0, false, nil)
+			})
+
+			It("only returns the clusters of the given regions that are enabled", func() {
+				clusters, err := cluster.GetClusters(context.Background(), intialProvider, true, []string{"us-west-2", "eu-north-1"}, 100)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusters).To(Equal([]cluster.Description{
+					{
+						Name:   "cluster2",
+						Region: "us-west-2",
+						Owned:  "False",
+					},
+				}))
+
+				Expect(awsProvider.CallCount()).To(Equal(1))
+				_, cfg, _ := awsProvider.ArgsForCall(0)
+				Expect(cfg.Region).To(Equal("us-west-2"))
+			})
+		})
+
 		When("DescribeRegion errors", func() {
 			BeforeEach(func() {
 				intialProvider.MockEC2().On("DescribeRegions", mock.Anything, &ec2.DescribeRegionsInput{}).Return(nil, fmt.Errorf("foo"))
 			})
 
 			It("errors", func() {
-				_, err := cluster.GetClusters(context.Background(), intialProvider, true, 100)
+				_, err := cluster.GetClusters(context.Background(), intialProvider, true, nil, 100)
 				Expect(err).To(MatchError(`failed to describe regions: foo`))
 			})
 		})
@@ -242,7 +284,7 @@ var _ = Describe("Get", func() {
 				providerRegion1.MockEKS().On("ListClusters", mock.Anything, &awseks.ListClustersInput{
 					MaxResults: aws.Int32(100),
 					Include:    []string{"all"},
-				}).Return(&awseks.ListClustersOutput{
+				}, mock.Anything).Return(&awseks.ListClustersOutput{
 					Clusters: []string{"cluster1"},
 				}, nil)
 
@@ -251,7 +293,7 @@ var _ = Describe("Get", func() {
 			})
 
 			It("returns the clusters in the regions it was successful in", func() {
-				clusters, err := cluster.GetClusters(context.Background(), intialProvider, true, 100)
+				clusters, err := cluster.GetClusters(context.Background(), intialProvider, true, nil, 100)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(clusters).To(ConsistOf(
 					cluster.Description{
@@ -0,0 +1,79 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Insight is an upgrade readiness check that EKS runs against a cluster
+type Insight struct {
+	ID                string
+	Name              string
+	KubernetesVersion string
+	Status            ekstypes.InsightStatusValue
+	Reason            string
+	Description       string
+}
+
+// Failing returns true if the insight found an issue that should be addressed before upgrading
+func (i Insight) Failing() bool {
+	return i.Status == ekstypes.InsightStatusValueWarning || i.Status == ekstypes.InsightStatusValueError
+}
+
+// GetUpgradeInsights returns the upgrade readiness insights EKS has collected for the cluster, limited to
+// kubernetesVersion if it is set
+func GetUpgradeInsights(ctx context.Context, eksAPI awsapi.EKS, clusterName, kubernetesVersion string) ([]Insight, error) {
+	filter := &ekstypes.InsightsFilter{
+		Categories: []ekstypes.Category{ekstypes.CategoryUpgradeReadiness},
+	}
+	if kubernetesVersion != "" {
+		filter.KubernetesVersions = []string{kubernetesVersion}
+	}
+
+	var insights []Insight
+	paginator := eks.NewListInsightsPaginator(eksAPI, &eks.ListInsightsInput{
+		ClusterName: aws.String(clusterName),
+		Filter:      filter,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing insights for cluster %q: %w", clusterName, err)
+		}
+		for _, i := range output.Insights {
+			insight := Insight{
+				ID:                aws.ToString(i.Id),
+				Name:              aws.ToString(i.Name),
+				KubernetesVersion: aws.ToString(i.KubernetesVersion),
+				Description:       aws.ToString(i.Description),
+			}
+			if i.InsightStatus != nil {
+				insight.Status = i.InsightStatus.Status
+				insight.Reason = aws.ToString(i.InsightStatus.Reason)
+			}
+			insights = append(insights, insight)
+		}
+	}
+	return insights, nil
+}
+
+// logUpgradeInsights logs a warning for every failing upgrade insight for the target version of the cluster
+func logUpgradeInsights(ctx context.Context, eksAPI awsapi.EKS, clusterName, targetVersion string) {
+	insights, err := GetUpgradeInsights(ctx, eksAPI, clusterName, targetVersion)
+	if err != nil {
+		logger.Warning("unable to get upgrade insights: %v", err)
+		return
+	}
+	for _, i := range insights {
+		if i.Failing() {
+			logger.Warning("upgrade insight %q is %s: %s", i.Name, i.Status, i.Reason)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package cluster_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("GetUpgradeInsights", func() {
+	var p *mockprovider.MockProvider
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+	})
+
+	It("returns the upgrade readiness insights for the version", func() {
+		p.MockEKS().On("ListInsights", mock.Anything, &eks.ListInsightsInput{
+			ClusterName: aws.String("my-cluster"),
+			Filter: &ekstypes.InsightsFilter{
+				Categories:         []ekstypes.Category{ekstypes.CategoryUpgradeReadiness},
+				KubernetesVersions: []string{"1.29"},
+			},
+		}, mock.Anything).Return(&eks.ListInsightsOutput{
+			Insights: []ekstypes.InsightSummary{
+				{
+					Id:                aws.String("id-1"),
+					Name:              aws.String("Deprecated APIs removed in Kubernetes v1.29"),
+					KubernetesVersion: aws.String("1.29"),
+					InsightStatus: &ekstypes.InsightStatus{
+						Status: ekstypes.InsightStatusValueError,
+						Reason: aws.String("Deprecated API usage detected within last 30 days."),
+					},
+				},
+				{
+					Id:                aws.String("id-2"),
+					Name:              aws.String("Kubelet version skew"),
+					KubernetesVersion: aws.String("1.29"),
+					InsightStatus: &ekstypes.InsightStatus{
+						Status: ekstypes.InsightStatusValuePassing,
+					},
+				},
+			},
+		}, nil)
+
+		insights, err := cluster.GetUpgradeInsights(context.Background(), p.MockEKS(), "my-cluster", "1.29")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(insights).To(Equal([]cluster.Insight{
+			{
+				ID:                "id-1",
+				Name:              "Deprecated APIs removed in Kubernetes v1.29",
+				KubernetesVersion: "1.29",
+				Status:            ekstypes.InsightStatusValueError,
+				Reason:            "Deprecated API usage detected within last 30 days.",
+			},
+			{
+				ID:                "id-2",
+				Name:              "Kubelet version skew",
+				KubernetesVersion: "1.29",
+				Status:            ekstypes.InsightStatusValuePassing,
+			},
+		}))
+		Expect(insights[0].Failing()).To(BeTrue())
+		Expect(insights[1].Failing()).To(BeFalse())
+	})
+
+	It("returns an error if the insights cannot be listed", func() {
+		p.MockEKS().On("ListInsights", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+		_, err := cluster.GetUpgradeInsights(context.Background(), p.MockEKS(), "my-cluster", "")
+		Expect(err).To(MatchError(ContainSubstring("listing insights for cluster \"my-cluster\"")))
+	})
+})
@@ -8,14 +8,16 @@ import (
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
 
 	"github.com/kris-nova/logger"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
-	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -43,12 +45,12 @@ func NewOwnedCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clusterSt
 	}
 }
 
-func (c *OwnedCluster) Upgrade(ctx context.Context, dryRun bool) error {
+func (c *OwnedCluster) Upgrade(ctx context.Context, dryRun, force bool) error {
 	if err := vpc.UseFromClusterStack(ctx, c.ctl.AWSProvider, c.clusterStack, c.cfg); err != nil {
 		return fmt.Errorf("getting VPC configuration for cluster %q: %w", c.cfg.Metadata.Name, err)
 	}
 
-	versionUpdateRequired, err := upgrade(ctx, c.cfg, c.ctl, dryRun)
+	versionUpdateRequired, err := upgrade(ctx, c.cfg, c.ctl, dryRun, force)
 	if err != nil {
 		return err
 	}
@@ -62,11 +64,11 @@ func (c *OwnedCluster) Upgrade(ctx context.Context, dryRun bool) error {
 		logger.Critical("failed checking nodegroups", err.Error())
 	}
 
-	cmdutils.LogPlanModeWarning(dryRun && (stackUpdateRequired || versionUpdateRequired))
+	utilsplan.LogPlanModeWarning(dryRun && (stackUpdateRequired || versionUpdateRequired))
 	return nil
 }
 
-func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction bool, parallel int) error {
+func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction, cleanupOrphans bool, parallel int, retain []string) error {
 	clusterOperable, err := c.ctl.CanOperate(c.cfg)
 	if err != nil {
 		logger.Debug("failed to check if cluster is operable: %v", err)
@@ -102,7 +104,7 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 		}
 	}
 
-	if err := deleteSharedResources(ctx, c.cfg, c.ctl, c.stackManager, clusterOperable, clientSet); err != nil {
+	if err := deleteSharedResources(ctx, c.cfg, c.ctl, c.stackManager, clusterOperable, clientSet, cleanupOrphans); err != nil {
 		if err != nil {
 			if force {
 				logger.Warning("error occurred during deletion: %v", err)
@@ -112,37 +114,30 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 		}
 	}
 
-	newOIDCManager := func() (*iamoidc.OpenIDConnectManager, error) {
-		return c.ctl.NewOpenIDConnectManager(ctx, c.cfg)
-	}
-	tasks, err := c.stackManager.NewTasksToDeleteClusterWithNodeGroups(ctx, c.clusterStack, allStacks, clusterOperable, newOIDCManager, c.ctl.Status.ClusterInfo.Cluster, kubernetes.NewCachedClientSet(clientSet), wait, force, func(errs chan error, _ string) error {
-		logger.Info("trying to cleanup dangling network interfaces")
-		stack, err := c.stackManager.DescribeClusterStack(ctx)
+	if resourceTypes := stackResourceTypesToRetain(retain); len(resourceTypes) > 0 {
+		retained, err := c.stackManager.RetainClusterStackResources(ctx, resourceTypes)
 		if err != nil {
-			return fmt.Errorf("error describing cluster stack: %w", err)
+			return fmt.Errorf("retaining resources of the cluster stack: %w", err)
 		}
-		if err := c.ctl.LoadClusterVPC(ctx, c.cfg, stack); err != nil {
-			return fmt.Errorf("getting VPC configuration for cluster %q: %w", c.cfg.Metadata.Name, err)
+		if len(retained) > 0 {
+			logger.Info("retaining resources %v of the cluster stack", retained)
+		} else {
+			logger.Warning("no resources to retain were found in the cluster stack")
 		}
+	}
 
-		go func() {
-			errs <- vpc.CleanupNetworkInterfaces(ctx, c.ctl.AWSProvider.EC2(), c.cfg)
-			close(errs)
-		}()
-		return nil
-	})
-
+	deleteTasks, err := c.newTasksToDeleteCluster(ctx, clusterOperable, clientSet, allStacks, wait, force, retain)
 	if err != nil {
 		return err
 	}
 
-	if tasks.Len() == 0 {
+	if deleteTasks.Len() == 0 {
 		logger.Warning("no cluster resources were found for %q", c.cfg.Metadata.Name)
 		return nil
 	}
 
-	logger.Info(tasks.Describe())
-	if errs := tasks.DoAllSync(); len(errs) > 0 {
+	logger.Info(deleteTasks.Describe())
+	if errs := deleteTasks.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "cluster with nodegroup(s)")
 	}
 
@@ -159,6 +154,31 @@ func (c *OwnedCluster) Delete(ctx context.Context, _, podEvictionWaitPeriod time
 	return nil
 }
 
+// newTasksToDeleteCluster returns the tasks deleting the nodegroups, IAM and OIDC resources, auxiliary stacks and the
+// stack of the cluster, it is shared by Delete and PlanDelete so that the plan matches what is deleted
+func (c *OwnedCluster) newTasksToDeleteCluster(ctx context.Context, clusterOperable bool, clientSet kubernetes.Interface, nodeGroupStacks []manager.NodeGroupStack, wait, force bool, retain []string) (*tasks.TaskTree, error) {
+	newOIDCManager := func() (*iamoidc.OpenIDConnectManager, error) {
+		return c.ctl.NewOpenIDConnectManager(ctx, c.cfg)
+	}
+	retainOIDCProvider := sets.NewString(retain...).Has(RetainIAMOIDCProvider)
+	return c.stackManager.NewTasksToDeleteClusterWithNodeGroups(ctx, c.clusterStack, nodeGroupStacks, clusterOperable, newOIDCManager, c.ctl.Status.ClusterInfo.Cluster, kubernetes.NewCachedClientSet(clientSet), wait, force, retainOIDCProvider, func(errs chan error, _ string) error {
+		logger.Info("trying to cleanup dangling network interfaces")
+		stack, err := c.stackManager.DescribeClusterStack(ctx)
+		if err != nil {
+			return fmt.Errorf("error describing cluster stack: %w", err)
+		}
+		if err := c.ctl.LoadClusterVPC(ctx, c.cfg, stack); err != nil {
+			return fmt.Errorf("getting VPC configuration for cluster %q: %w", c.cfg.Metadata.Name, err)
+		}
+
+		go func() {
+			errs <- vpc.CleanupNetworkInterfaces(ctx, c.ctl.AWSProvider.EC2(), c.cfg)
+			close(errs)
+		}()
+		return nil
+	})
+}
+
 func (c *OwnedCluster) deleteKarpenterStackIfExists(ctx context.Context) error {
 	stack, err := c.stackManager.GetKarpenterStack(ctx)
 	if err != nil {
@@ -124,7 +124,7 @@ var _ = Describe("Delete", func() {
 				return mockedDrainer
 			})
 
-			err := c.Delete(context.Background(), time.Microsecond, 0, false, false, false, 1)
+			err := c.Delete(context.Background(), time.Microsecond, 0, false, false, false, false, 1, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(ranDeleteDeprecatedTasks).To(BeTrue())
@@ -199,7 +199,7 @@ var _ = Describe("Delete", func() {
 					return mockedDrainer
 				})
 
-				err := c.Delete(context.Background(), time.Microsecond, 0, false, true, false, 1)
+				err := c.Delete(context.Background(), time.Microsecond, 0, false, true, false, false, 1, nil)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 				Expect(ranDeleteDeprecatedTasks).To(BeFalse())
@@ -272,7 +272,7 @@ var _ = Describe("Delete", func() {
 					return mockedDrainer
 				})
 
-				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+				err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, false, 1, nil)
 				Expect(err).To(MatchError(errorMessage))
 				Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(0))
 				Expect(ranDeleteDeprecatedTasks).To(BeFalse())
@@ -325,7 +325,7 @@ var _ = Describe("Delete", func() {
 				return fake.NewSimpleClientset(), nil
 			})
 
-			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, 1)
+			err := c.Delete(context.Background(), time.Microsecond, time.Second*0, false, false, false, false, 1, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(ranDeleteDeprecatedTasks).To(BeTrue())
@@ -333,4 +333,75 @@ var _ = Describe("Delete", func() {
 			Expect(ranDeleteClusterTasks).To(BeTrue())
 		})
 	})
+
+	Context("when resources are retained", func() {
+		It("sets the deletion policy of the retained stack resources and keeps the OIDC provider", func() {
+			p.MockEKS().On("ListFargateProfiles", mock.Anything, mock.Anything).Return(&awseks.ListFargateProfilesOutput{}, nil)
+			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{
+				Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
+					return nil
+				}}},
+			}, nil)
+			fakeStackManager.RetainClusterStackResourcesReturns([]string{"VPC", "SubnetPublicUSWEST2A"}, nil)
+			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{}, nil)
+
+			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager)
+			c.SetNewNodeGroupManager(func(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) cluster.NodeGroupDrainer {
+				mockedDrainer := &drainerMockOwned{}
+				mockedDrainer.On("Drain", mock.Anything).Return(nil)
+				return mockedDrainer
+			})
+			c.SetNewClientSet(func() (kubernetes.Interface, error) {
+				return fake.NewSimpleClientset(), nil
+			})
+
+			err := c.Delete(context.Background(), time.Microsecond, 0, false, false, false, false, 1, []string{cluster.RetainVPC, cluster.RetainIAMOIDCProvider})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeStackManager.RetainClusterStackResourcesCallCount()).To(Equal(1))
+			_, resourceTypes := fakeStackManager.RetainClusterStackResourcesArgsForCall(0)
+			Expect(resourceTypes).To(ContainElements("AWS::EC2::VPC", "AWS::EC2::Subnet", "AWS::EC2::NatGateway"))
+			Expect(resourceTypes).NotTo(ContainElement("AWS::Logs::LogGroup"))
+
+			Expect(fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCallCount()).To(Equal(1))
+			_, _, _, _, _, _, _, _, _, retainOIDCProvider, _ := fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsArgsForCall(0)
+			Expect(retainOIDCProvider).To(BeTrue())
+		})
+
+		It("does not update the cluster stack when only the OIDC provider is retained", func() {
+			p.MockEKS().On("ListFargateProfiles", mock.Anything, mock.Anything).Return(&awseks.ListFargateProfilesOutput{}, nil)
+			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{
+				Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
+					return nil
+				}}},
+			}, nil)
+			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsReturns(&tasks.TaskTree{}, nil)
+
+			c := cluster.NewOwnedCluster(cfg, ctl, nil, fakeStackManager)
+			c.SetNewNodeGroupManager(func(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.Interface) cluster.NodeGroupDrainer {
+				return &drainerMockOwned{}
+			})
+			c.SetNewClientSet(func() (kubernetes.Interface, error) {
+				return fake.NewSimpleClientset(), nil
+			})
+
+			err := c.Delete(context.Background(), time.Microsecond, 0, false, false, false, false, 1, []string{cluster.RetainIAMOIDCProvider})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeStackManager.RetainClusterStackResourcesCallCount()).To(BeZero())
+		})
+	})
+
+	DescribeTable("ValidateRetainedResources",
+		func(retain []string, expectedErr string) {
+			err := cluster.ValidateRetainedResources(retain)
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			}
+		},
+		Entry("no resources", nil, ""),
+		Entry("all resources", []string{"vpc", "iam-oidc-provider", "log-groups"}, ""),
+		Entry("unknown resource", []string{"vpc", "subnets"}, `invalid resource "subnets" to retain, must be one of vpc, iam-oidc-provider, log-groups`),
+	)
 })
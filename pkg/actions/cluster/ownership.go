@@ -0,0 +1,67 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// CheckOwned returns an error if the cluster, or any of its nodegroups, was not created by eksctl, as deleting
+// the cluster would delete them too
+func CheckOwned(ctx context.Context, stackManager manager.StackManager, eksAPI awsapi.EKS, clusterName string) error {
+	stack, err := stackManager.GetClusterStackIfExists(ctx)
+	if err != nil {
+		return fmt.Errorf("checking ownership of cluster %q: %w", clusterName, err)
+	}
+	if stack == nil {
+		return fmt.Errorf("cluster %q was not created by eksctl, refusing to delete it with --owned-only", clusterName)
+	}
+
+	nodeGroupStacks, err := stackManager.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return fmt.Errorf("checking ownership of the nodegroups of cluster %q: %w", clusterName, err)
+	}
+	owned := sets.NewString()
+	for _, s := range nodeGroupStacks {
+		owned.Insert(s.NodeGroupName)
+	}
+
+	unowned := sets.NewString()
+	paginator := eks.NewListNodegroupsPaginator(eksAPI, &eks.ListNodegroupsInput{
+		ClusterName: aws.String(clusterName),
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("listing nodegroups of cluster %q: %w", clusterName, err)
+		}
+		for _, name := range out.Nodegroups {
+			if !owned.Has(name) {
+				unowned.Insert(name)
+			}
+		}
+	}
+	if unowned.Len() > 0 {
+		return fmt.Errorf("nodegroup(s) %s of cluster %q were not created by eksctl, refusing to delete the cluster with --owned-only",
+			strings.Join(unowned.List(), ", "), clusterName)
+	}
+	return nil
+}
+
+// FilterOwned returns the clusters created by eksctl
+func FilterOwned(clusters []Description) []Description {
+	owned := []Description{}
+	for _, c := range clusters {
+		if c.Owned == eksctlCreatedTrue {
+			owned = append(owned, c)
+		}
+	}
+	return owned
+}
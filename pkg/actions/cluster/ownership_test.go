@@ -0,0 +1,74 @@
+package cluster_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("CheckOwned", func() {
+	var (
+		fakeStackManager *fakes.FakeStackManager
+		p                *mockprovider.MockProvider
+	)
+
+	BeforeEach(func() {
+		fakeStackManager = &fakes.FakeStackManager{}
+		p = mockprovider.NewMockProvider()
+	})
+
+	It("refuses to delete a cluster not created by eksctl", func() {
+		err := cluster.CheckOwned(context.Background(), fakeStackManager, p.MockEKS(), "my-cluster")
+		Expect(err).To(MatchError(ContainSubstring(`cluster "my-cluster" was not created by eksctl`)))
+	})
+
+	When("the cluster was created by eksctl", func() {
+		BeforeEach(func() {
+			fakeStackManager.GetClusterStackIfExistsReturns(&cfntypes.Stack{
+				StackName: aws.String("eksctl-my-cluster-cluster"),
+			}, nil)
+			fakeStackManager.ListNodeGroupStacksWithStatusesReturns([]manager.NodeGroupStack{
+				{NodeGroupName: "ng-1"},
+				{NodeGroupName: "mng-1"},
+			}, nil)
+		})
+
+		It("allows deleting it when all its nodegroups were created by eksctl", func() {
+			p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListNodegroupsOutput{
+				Nodegroups: []string{"mng-1"},
+			}, nil)
+			Expect(cluster.CheckOwned(context.Background(), fakeStackManager, p.MockEKS(), "my-cluster")).To(Succeed())
+		})
+
+		It("refuses to delete it when a managed nodegroup was not created by eksctl", func() {
+			p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListNodegroupsOutput{
+				Nodegroups: []string{"mng-1", "console-ng"},
+			}, nil)
+			err := cluster.CheckOwned(context.Background(), fakeStackManager, p.MockEKS(), "my-cluster")
+			Expect(err).To(MatchError(ContainSubstring(`nodegroup(s) console-ng of cluster "my-cluster" were not created by eksctl`)))
+		})
+	})
+})
+
+var _ = Describe("FilterOwned", func() {
+	It("returns the clusters created by eksctl", func() {
+		clusters := []cluster.Description{
+			{Name: "owned", Region: "us-west-2", Owned: "True"},
+			{Name: "unowned", Region: "us-west-2", Owned: "False"},
+			{Name: "unknown", Region: "us-west-2", Owned: "Unknown"},
+		}
+		Expect(cluster.FilterOwned(clusters)).To(Equal([]cluster.Description{
+			{Name: "owned", Region: "us-west-2", Owned: "True"},
+		}))
+	})
+})
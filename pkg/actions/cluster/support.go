@@ -0,0 +1,75 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// SupportPeriodStandard is the period of standard support of a Kubernetes version
+	SupportPeriodStandard = "standard"
+	// SupportPeriodExtended is the billed period of extended support following standard support
+	SupportPeriodExtended = "extended"
+	// SupportPeriodEnded is the period after the end of extended support
+	SupportPeriodEnded = "ended"
+)
+
+type supportCalendar struct {
+	endOfStandardSupport time.Time
+	endOfExtendedSupport time.Time
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// supportCalendars are the end dates of the support periods of Kubernetes versions on EKS,
+// see https://docs.aws.amazon.com/eks/latest/userguide/kubernetes-versions.html
+var supportCalendars = map[string]supportCalendar{
+	api.Version1_22: {endOfStandardSupport: date(2023, time.June, 4), endOfExtendedSupport: date(2024, time.September, 1)},
+	api.Version1_23: {endOfStandardSupport: date(2023, time.October, 11), endOfExtendedSupport: date(2024, time.October, 11)},
+	api.Version1_24: {endOfStandardSupport: date(2024, time.January, 31), endOfExtendedSupport: date(2025, time.January, 31)},
+	api.Version1_25: {endOfStandardSupport: date(2024, time.May, 1), endOfExtendedSupport: date(2025, time.May, 1)},
+	api.Version1_26: {endOfStandardSupport: date(2024, time.June, 11), endOfExtendedSupport: date(2025, time.June, 11)},
+	api.Version1_27: {endOfStandardSupport: date(2024, time.July, 24), endOfExtendedSupport: date(2025, time.July, 24)},
+	api.Version1_28: {endOfStandardSupport: date(2024, time.November, 26), endOfExtendedSupport: date(2025, time.November, 26)},
+}
+
+// SupportPeriod is the support period a cluster is in
+type SupportPeriod struct {
+	// SupportType is the support type of the upgrade policy of the cluster
+	SupportType ekstypes.SupportType
+	// Period is empty if the support calendar of the Kubernetes version of the cluster is not known
+	Period string
+	// EndOfSupport is the end of Period
+	EndOfSupport *time.Time
+}
+
+// GetSupportPeriod returns the support period the cluster is in at the time now
+func GetSupportPeriod(cluster *ekstypes.Cluster, now time.Time) SupportPeriod {
+	var period SupportPeriod
+	if cluster.UpgradePolicy != nil {
+		period.SupportType = cluster.UpgradePolicy.SupportType
+	}
+	calendar, ok := supportCalendars[aws.ToString(cluster.Version)]
+	if !ok {
+		return period
+	}
+
+	switch {
+	case now.Before(calendar.endOfStandardSupport):
+		period.Period = SupportPeriodStandard
+		period.EndOfSupport = &calendar.endOfStandardSupport
+	case now.Before(calendar.endOfExtendedSupport):
+		period.Period = SupportPeriodExtended
+		period.EndOfSupport = &calendar.endOfExtendedSupport
+	default:
+		period.Period = SupportPeriodEnded
+		period.EndOfSupport = &calendar.endOfExtendedSupport
+	}
+	return period
+}
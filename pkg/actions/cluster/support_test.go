@@ -0,0 +1,60 @@
+package cluster_test
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+)
+
+var _ = Describe("GetSupportPeriod", func() {
+	type supportPeriodEntry struct {
+		version        string
+		now            time.Time
+		expectedPeriod string
+		expectedEnd    string
+	}
+
+	DescribeTable("returns the support period of the cluster", func(e supportPeriodEntry) {
+		period := cluster.GetSupportPeriod(&ekstypes.Cluster{
+			Version: aws.String(e.version),
+			UpgradePolicy: &ekstypes.UpgradePolicyResponse{
+				SupportType: ekstypes.SupportTypeExtended,
+			},
+		}, e.now)
+		Expect(period.SupportType).To(Equal(ekstypes.SupportTypeExtended))
+		Expect(period.Period).To(Equal(e.expectedPeriod))
+		if e.expectedEnd == "" {
+			Expect(period.EndOfSupport).To(BeNil())
+			return
+		}
+		Expect(period.EndOfSupport.Format(time.DateOnly)).To(Equal(e.expectedEnd))
+	},
+		Entry("standard support", supportPeriodEntry{
+			version:        "1.27",
+			now:            time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expectedPeriod: cluster.SupportPeriodStandard,
+			expectedEnd:    "2024-07-24",
+		}),
+		Entry("extended support", supportPeriodEntry{
+			version:        "1.27",
+			now:            time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC),
+			expectedPeriod: cluster.SupportPeriodExtended,
+			expectedEnd:    "2025-07-24",
+		}),
+		Entry("end of support", supportPeriodEntry{
+			version:        "1.23",
+			now:            time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expectedPeriod: cluster.SupportPeriodEnded,
+			expectedEnd:    "2024-10-11",
+		}),
+		Entry("unknown version", supportPeriodEntry{
+			version: "1.99",
+			now:     time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}),
+	)
+})
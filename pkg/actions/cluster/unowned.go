@@ -10,14 +10,15 @@ import (
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
-	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 )
 
@@ -43,24 +44,26 @@ func NewUnownedCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, stackMa
 	}
 }
 
-func (c *UnownedCluster) Upgrade(ctx context.Context, dryRun bool) error {
-	versionUpdateRequired, err := upgrade(ctx, c.cfg, c.ctl, dryRun)
+func (c *UnownedCluster) Upgrade(ctx context.Context, dryRun, force bool) error {
+	versionUpdateRequired, err := upgrade(ctx, c.cfg, c.ctl, dryRun, force)
 	if err != nil {
 		return err
 	}
 
 	// if no version update is required, don't log asking them to rerun with --approve
-	cmdutils.LogPlanModeWarning(dryRun && versionUpdateRequired)
+	utilsplan.LogPlanModeWarning(dryRun && versionUpdateRequired)
 	return nil
 }
 
-func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction bool, parallel int) error {
+func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWaitPeriod time.Duration, wait, force, disableNodegroupEviction, cleanupOrphans bool, parallel int, retain []string) error {
 	clusterName := c.cfg.Metadata.Name
 
 	if err := c.checkClusterExists(ctx, clusterName); err != nil {
 		return err
 	}
 
+	warnRetainedStackResources(retain)
+
 	clusterOperable, err := c.ctl.CanOperate(c.cfg)
 	if err != nil {
 		logger.Debug("failed to check if cluster is operable: %v", err)
@@ -90,7 +93,7 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 		}
 	}
 
-	if err := deleteSharedResources(ctx, c.cfg, c.ctl, c.stackManager, clusterOperable, clientSet); err != nil {
+	if err := deleteSharedResources(ctx, c.cfg, c.ctl, c.stackManager, clusterOperable, clientSet, cleanupOrphans); err != nil {
 		if err != nil {
 			if force {
 				logger.Warning("error occurred during deletion: %v", err)
@@ -110,7 +113,7 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 		return err
 	}
 
-	if err := c.deleteIAMAndOIDC(ctx, wait, clusterOperable, clientSet, force); err != nil {
+	if err := c.deleteIAMAndOIDC(ctx, wait, clusterOperable, clientSet, force, sets.NewString(retain...).Has(RetainIAMOIDCProvider)); err != nil {
 		if err != nil {
 			if force {
 				logger.Warning("error occurred during deletion: %v", err)
@@ -132,6 +135,15 @@ func (c *UnownedCluster) Delete(ctx context.Context, waitInterval, podEvictionWa
 	return nil
 }
 
+// warnRetainedStackResources warns about the resources to retain that only exist in clusters created by eksctl
+func warnRetainedStackResources(retain []string) {
+	for _, r := range retain {
+		if _, ok := retainedStackResourceTypes[r]; ok {
+			logger.Warning("retaining %s has no effect on a cluster not created by eksctl", r)
+		}
+	}
+}
+
 func (c *UnownedCluster) deleteFargateRoleIfExists(ctx context.Context) error {
 	stack, err := c.stackManager.GetFargateStack(ctx)
 	if err != nil {
@@ -161,7 +173,29 @@ func (c *UnownedCluster) checkClusterExists(ctx context.Context, clusterName str
 	return nil
 }
 
-func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, clusterOperable bool, clientSet kubernetes.Interface, force bool) error {
+func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, clusterOperable bool, clientSet kubernetes.Interface, force, retainOIDCProvider bool) error {
+	tasksTree, err := c.newTasksToDeleteIAMAndOIDC(ctx, wait, clusterOperable, clientSet, force, retainOIDCProvider)
+	if err != nil {
+		return err
+	}
+
+	if tasksTree.Len() == 0 {
+		logger.Warning("no IAM and OIDC resources were found for %q", c.cfg.Metadata.Name)
+		return nil
+	}
+
+	logger.Info(tasksTree.Describe())
+	if errs := tasksTree.DoAllSync(); len(errs) > 0 {
+		return handleErrors(errs, "cluster IAM and OIDC")
+	}
+
+	logger.Info("all IAM and OIDC resources were deleted")
+	return nil
+}
+
+// newTasksToDeleteIAMAndOIDC returns the tasks deleting the IAM and OIDC resources and the auxiliary stacks of the
+// cluster, it is shared by Delete and PlanDelete so that the plan matches what is deleted
+func (c *UnownedCluster) newTasksToDeleteIAMAndOIDC(ctx context.Context, wait bool, clusterOperable bool, clientSet kubernetes.Interface, force, retainOIDCProvider bool) (*tasks.TaskTree, error) {
 	tasksTree := &tasks.TaskTree{Parallel: false}
 
 	if clusterOperable {
@@ -169,9 +203,9 @@ func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, cluste
 		newOIDCManager := func() (*iamoidc.OpenIDConnectManager, error) {
 			return c.ctl.NewOpenIDConnectManager(ctx, c.cfg)
 		}
-		serviceAccountAndOIDCTasks, err := c.stackManager.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx, newOIDCManager, c.ctl.Status.ClusterInfo.Cluster, clientSetGetter, force)
+		serviceAccountAndOIDCTasks, err := c.stackManager.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx, newOIDCManager, c.ctl.Status.ClusterInfo.Cluster, clientSetGetter, force, retainOIDCProvider)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if serviceAccountAndOIDCTasks.Len() > 0 {
@@ -182,7 +216,7 @@ func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, cluste
 
 	deleteAddonIAMTasks, err := c.stackManager.NewTaskToDeleteAddonIAM(ctx, wait)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if deleteAddonIAMTasks.Len() > 0 {
@@ -190,18 +224,17 @@ func (c *UnownedCluster) deleteIAMAndOIDC(ctx context.Context, wait bool, cluste
 		tasksTree.Append(deleteAddonIAMTasks)
 	}
 
-	if tasksTree.Len() == 0 {
-		logger.Warning("no IAM and OIDC resources were found for %q", c.cfg.Metadata.Name)
-		return nil
+	deleteAuxiliaryStacksTasks, err := c.stackManager.NewTasksToDeleteAuxiliaryStacks(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	logger.Info(tasksTree.Describe())
-	if errs := tasksTree.DoAllSync(); len(errs) > 0 {
-		return handleErrors(errs, "cluster IAM and OIDC")
+	if deleteAuxiliaryStacksTasks.Len() > 0 {
+		deleteAuxiliaryStacksTasks.IsSubTask = true
+		tasksTree.Append(deleteAuxiliaryStacksTasks)
 	}
 
-	logger.Info("all IAM and OIDC resources were deleted")
-	return nil
+	return tasksTree, nil
 }
 
 func (c *UnownedCluster) deleteCluster(ctx context.Context, wait bool) error {
@@ -9,12 +9,12 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
-	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/utils"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
 )
 
-func upgrade(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun bool) (bool, error) {
+func upgrade(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, dryRun, force bool) (bool, error) {
 	currentVersion := ctl.ControlPlaneVersion()
 	versionUpdateRequired, err := requiresVersionUpgrade(cfg.Metadata, currentVersion)
 	if err != nil {
@@ -28,7 +28,14 @@ func upgrade(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvid
 
 	if versionUpdateRequired {
 		msgNodeGroupsAndAddons := "you will need to follow the upgrade procedure for all of nodegroups and add-ons"
-		cmdutils.LogIntendedAction(dryRun, "upgrade cluster %q control plane from current version %q to %q", cfg.Metadata.Name, currentVersion, cfg.Metadata.Version)
+		logUpgradeInsights(ctx, ctl.AWSProvider.EKS(), cfg.Metadata.Name, cfg.Metadata.Version)
+		if err := checkRemovedAPIs(ctx, cfg, ctl, currentVersion, dryRun || force); err != nil {
+			return false, err
+		}
+		if err := checkNodeGroupVersionSkew(ctx, cfg, ctl, dryRun || force); err != nil {
+			return false, err
+		}
+		utilsplan.LogIntendedAction(dryRun, "upgrade cluster %q control plane from current version %q to %q", cfg.Metadata.Name, currentVersion, cfg.Metadata.Version)
 		if !dryRun {
 			if err := ctl.UpdateClusterVersionBlocking(ctx, cfg); err != nil {
 				return false, err
@@ -42,6 +49,30 @@ func upgrade(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvid
 	return versionUpdateRequired, nil
 }
 
+// checkRemovedAPIs warns about the usage of Kubernetes APIs removed in the target version and,
+// unless ignoreUsage is set, refuses the upgrade if any is found
+func checkRemovedAPIs(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, currentVersion string, ignoreUsage bool) error {
+	logger.Info("checking usage of Kubernetes APIs removed in version %s", cfg.Metadata.Version)
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		logger.Warning("unable to check usage of removed Kubernetes APIs: %v", err)
+		return nil
+	}
+	report, err := GetRemovedAPIsReport(ctx, clientSet.Discovery().RESTClient(), currentVersion, cfg.Metadata.Version)
+	if err != nil {
+		logger.Warning("unable to check usage of removed Kubernetes APIs: %v", err)
+		return nil
+	}
+	if report.Empty() {
+		return nil
+	}
+	report.LogWarnings()
+	if ignoreUsage {
+		return nil
+	}
+	return fmt.Errorf("found usage of Kubernetes APIs removed in version %s, migrate the resources listed above before upgrading or use --force to upgrade anyway", cfg.Metadata.Version)
+}
+
 func requiresVersionUpgrade(clusterMeta *api.ClusterMeta, currentEKSVersion string) (bool, error) {
 	nextVersion, err := getNextVersion(currentEKSVersion)
 	if err != nil {
@@ -0,0 +1,261 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
+)
+
+const controlPlaneStep = "control-plane"
+
+// addonUpgradeOrder is the order core addons are updated in, other addons are updated after them
+var addonUpgradeOrder = []string{api.KubeProxyAddon, api.CoreDNSAddon, api.VPCCNIAddon}
+
+// UpgradeAllOptions configures an upgrade of the control plane, nodegroups and addons of a cluster
+type UpgradeAllOptions struct {
+	IncludeNodeGroups bool
+	IncludeAddons     bool
+	// CheckpointFile records the completed steps of the upgrade
+	CheckpointFile string
+	DryRun         bool
+	// Force upgrades the control plane even if Kubernetes APIs removed in the target version are in use,
+	// or nodegroups would violate the supported version skew
+	Force bool
+	// AddonWaitTimeout is the time to wait for each addon to become active after it is updated
+	AddonWaitTimeout time.Duration
+}
+
+// DefaultCheckpointFile returns the path of the file the checkpoint of an upgrade of the cluster is written to
+func DefaultCheckpointFile(meta *api.ClusterMeta) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".eksctl", "upgrades", fmt.Sprintf("%s-%s.json", meta.Region, meta.Name)), nil
+}
+
+type upgradeCheckpoint struct {
+	TargetVersion  string   `json:"targetVersion"`
+	CompletedSteps []string `json:"completedSteps"`
+
+	path string
+}
+
+func loadCheckpoint(path string) (*upgradeCheckpoint, error) {
+	checkpoint := &upgradeCheckpoint{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return checkpoint, nil
+		}
+		return nil, fmt.Errorf("reading upgrade checkpoint: %w", err)
+	}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("parsing upgrade checkpoint %s: %w", path, err)
+	}
+	return checkpoint, nil
+}
+
+func (c *upgradeCheckpoint) completed(step string) bool {
+	for _, s := range c.CompletedSteps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *upgradeCheckpoint) complete(step string) error {
+	c.CompletedSteps = append(c.CompletedSteps, step)
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("writing upgrade checkpoint: %w", err)
+	}
+	if err := os.WriteFile(c.path, data, 0600); err != nil {
+		return fmt.Errorf("writing upgrade checkpoint: %w", err)
+	}
+	logger.Debug("recorded completion of step %q in upgrade checkpoint %s", step, c.path)
+	return nil
+}
+
+func (c *upgradeCheckpoint) remove() error {
+	if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing upgrade checkpoint: %w", err)
+	}
+	return nil
+}
+
+// UpgradeAll upgrades the control plane with c and then, as requested, the managed nodegroups and the addons of the
+// cluster. Completed steps are recorded in a checkpoint file, so that an interrupted upgrade is resumed by running it again.
+func UpgradeAll(ctx context.Context, c Cluster, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, options UpgradeAllOptions) error {
+	checkpoint, err := loadCheckpoint(options.CheckpointFile)
+	if err != nil {
+		return err
+	}
+	if checkpoint.TargetVersion != "" {
+		if cfg.Metadata.Version == "" || cfg.Metadata.Version == checkpoint.TargetVersion {
+			logger.Info("resuming upgrade of cluster %q to version %s from checkpoint %s", cfg.Metadata.Name, checkpoint.TargetVersion, options.CheckpointFile)
+			cfg.Metadata.Version = checkpoint.TargetVersion
+		} else {
+			logger.Warning("ignoring checkpoint %s of an upgrade to version %s", options.CheckpointFile, checkpoint.TargetVersion)
+			checkpoint = &upgradeCheckpoint{path: options.CheckpointFile}
+		}
+	}
+
+	if checkpoint.completed(controlPlaneStep) {
+		logger.Info("control plane of cluster %q was already upgraded to version %s", cfg.Metadata.Name, cfg.Metadata.Version)
+	} else {
+		if err := c.Upgrade(ctx, options.DryRun, options.Force); err != nil {
+			return err
+		}
+		// the upgrade sets cfg.Metadata.Version to the target version
+		checkpoint.TargetVersion = cfg.Metadata.Version
+		if !options.DryRun {
+			if err := checkpoint.complete(controlPlaneStep); err != nil {
+				return err
+			}
+		}
+	}
+
+	var pendingChanges bool
+	if options.IncludeNodeGroups {
+		upgraded, err := upgradeNodeGroups(ctx, cfg, ctl, checkpoint, options.DryRun)
+		if err != nil {
+			return err
+		}
+		pendingChanges = pendingChanges || upgraded
+	}
+	if options.IncludeAddons {
+		updated, err := updateAddons(ctx, cfg, ctl, checkpoint, options)
+		if err != nil {
+			return err
+		}
+		pendingChanges = pendingChanges || updated
+	}
+
+	if options.DryRun {
+		utilsplan.LogPlanModeWarning(pendingChanges)
+		return nil
+	}
+	if err := checkpoint.remove(); err != nil {
+		return err
+	}
+	logger.Success("cluster %q has been upgraded to version %s", cfg.Metadata.Name, cfg.Metadata.Version)
+	return nil
+}
+
+func upgradeNodeGroups(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, checkpoint *upgradeCheckpoint, dryRun bool) (bool, error) {
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return false, err
+	}
+	nodeGroupManager := nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session()))
+	gaps, err := nodeGroupManager.GetVersionGaps(ctx, cfg.Metadata.Version)
+	if err != nil {
+		return false, err
+	}
+
+	var upgraded bool
+	for _, gap := range gaps {
+		step := "nodegroup/" + gap.Name
+		if !gap.UpgradeRequired || checkpoint.completed(step) {
+			continue
+		}
+		if gap.NodeGroupType != api.NodeGroupTypeManaged {
+			logger.Warning("nodegroup %q is unmanaged and must be replaced with a nodegroup of version %s", gap.Name, cfg.Metadata.Version)
+			continue
+		}
+		utilsplan.LogIntendedAction(dryRun, "upgrade nodegroup %q from version %s to %s", gap.Name, gap.Version, cfg.Metadata.Version)
+		upgraded = true
+		if dryRun {
+			continue
+		}
+		if err := nodeGroupManager.Upgrade(ctx, nodegroup.UpgradeOptions{
+			NodegroupName:     gap.Name,
+			KubernetesVersion: cfg.Metadata.Version,
+			Wait:              true,
+		}); err != nil {
+			return false, fmt.Errorf("upgrading nodegroup %q: %w", gap.Name, err)
+		}
+		if err := checkpoint.complete(step); err != nil {
+			return false, err
+		}
+	}
+	return upgraded, nil
+}
+
+func updateAddons(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, checkpoint *upgradeCheckpoint, options UpgradeAllOptions) (bool, error) {
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return false, err
+	}
+	oidcProviderExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return false, err
+	}
+	addonManager, err := addon.New(cfg, ctl.AWSProvider.EKS(), ctl.NewStackManager(cfg), oidcProviderExists, oidc, nil)
+	if err != nil {
+		return false, err
+	}
+	// cfg.Metadata.Version is the target version, so addons are updated to its default versions
+	updates, err := addonManager.PlanUpdates(ctx, addon.VersionPolicyDefault)
+	if err != nil {
+		return false, err
+	}
+	sortAddonUpdates(updates)
+
+	var updated bool
+	for _, u := range updates {
+		step := "addon/" + u.Name
+		if checkpoint.completed(step) {
+			continue
+		}
+		utilsplan.LogIntendedAction(options.DryRun, "update addon %q from version %s to %s", u.Name, u.CurrentVersion, u.TargetVersion)
+		updated = true
+		if options.DryRun {
+			continue
+		}
+		if err := addonManager.Update(ctx, &api.Addon{
+			Name:    u.Name,
+			Version: u.TargetVersion,
+		}, options.AddonWaitTimeout); err != nil {
+			return false, err
+		}
+		if err := checkpoint.complete(step); err != nil {
+			return false, err
+		}
+	}
+	return updated, nil
+}
+
+// sortAddonUpdates sorts updates in addonUpgradeOrder, keeping the order of other addons
+func sortAddonUpdates(updates []addon.PlannedUpdate) {
+	rank := func(name string) int {
+		for i, n := range addonUpgradeOrder {
+			if n == name {
+				return i
+			}
+		}
+		return len(addonUpgradeOrder)
+	}
+	sort.SliceStable(updates, func(i, j int) bool {
+		return rank(updates[i].Name) < rank(updates[j].Name)
+	})
+}
@@ -0,0 +1,105 @@
+package cluster
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+type upgradingCluster struct {
+	Cluster
+	cfg           *api.ClusterConfig
+	targetVersion string
+	dryRuns       []bool
+}
+
+func (c *upgradingCluster) Upgrade(_ context.Context, dryRun, _ bool) error {
+	c.dryRuns = append(c.dryRuns, dryRun)
+	c.cfg.Metadata.Version = c.targetVersion
+	return nil
+}
+
+var _ = Describe("upgrade all", func() {
+	var (
+		cfg            *api.ClusterConfig
+		c              *upgradingCluster
+		checkpointFile string
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Version = ""
+		c = &upgradingCluster{cfg: cfg, targetVersion: "1.27"}
+		checkpointFile = filepath.Join(GinkgoT().TempDir(), "upgrade.json")
+	})
+
+	It("upgrades the control plane and removes the checkpoint", func() {
+		Expect(UpgradeAll(context.Background(), c, cfg, nil, UpgradeAllOptions{CheckpointFile: checkpointFile})).To(Succeed())
+		Expect(c.dryRuns).To(Equal([]bool{false}))
+		Expect(checkpointFile).NotTo(BeAnExistingFile())
+	})
+
+	It("does not write a checkpoint in dry run mode", func() {
+		Expect(UpgradeAll(context.Background(), c, cfg, nil, UpgradeAllOptions{CheckpointFile: checkpointFile, DryRun: true})).To(Succeed())
+		Expect(c.dryRuns).To(Equal([]bool{true}))
+		Expect(checkpointFile).NotTo(BeAnExistingFile())
+	})
+
+	When("a checkpoint exists", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(checkpointFile, []byte(`{"targetVersion": "1.26", "completedSteps": ["control-plane"]}`), 0600)).To(Succeed())
+		})
+
+		It("resumes the upgrade to the version of the checkpoint", func() {
+			Expect(UpgradeAll(context.Background(), c, cfg, nil, UpgradeAllOptions{CheckpointFile: checkpointFile})).To(Succeed())
+			Expect(c.dryRuns).To(BeEmpty())
+			Expect(cfg.Metadata.Version).To(Equal("1.26"))
+			Expect(checkpointFile).NotTo(BeAnExistingFile())
+		})
+
+		It("ignores the checkpoint if the version is different", func() {
+			cfg.Metadata.Version = "1.27"
+			Expect(UpgradeAll(context.Background(), c, cfg, nil, UpgradeAllOptions{CheckpointFile: checkpointFile})).To(Succeed())
+			Expect(c.dryRuns).To(Equal([]bool{false}))
+			Expect(cfg.Metadata.Version).To(Equal("1.27"))
+		})
+	})
+
+	It("records completed steps in the checkpoint", func() {
+		checkpoint, err := loadCheckpoint(checkpointFile)
+		Expect(err).NotTo(HaveOccurred())
+		checkpoint.TargetVersion = "1.27"
+		Expect(checkpoint.complete(controlPlaneStep)).To(Succeed())
+		Expect(checkpoint.complete("addon/kube-proxy")).To(Succeed())
+
+		loaded, err := loadCheckpoint(checkpointFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.TargetVersion).To(Equal("1.27"))
+		Expect(loaded.completed(controlPlaneStep)).To(BeTrue())
+		Expect(loaded.completed("addon/kube-proxy")).To(BeTrue())
+		Expect(loaded.completed("addon/coredns")).To(BeFalse())
+	})
+
+	It("updates core addons in dependency order", func() {
+		updates := []addon.PlannedUpdate{
+			{Name: "vpc-cni"},
+			{Name: "aws-ebs-csi-driver"},
+			{Name: "coredns"},
+			{Name: "kube-proxy"},
+			{Name: "adot"},
+		}
+		sortAddonUpdates(updates)
+		var names []string
+		for _, u := range updates {
+			names = append(names, u.Name)
+		}
+		Expect(names).To(Equal([]string{"kube-proxy", "coredns", "vpc-cni", "aws-ebs-csi-driver", "adot"}))
+	})
+})
@@ -0,0 +1,70 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// UpgradePlan is a preview of the changes needed to upgrade a cluster to a Kubernetes version
+type UpgradePlan struct {
+	ClusterName    string
+	CurrentVersion string
+	TargetVersion  string
+	// ControlPlaneUpgradeRequired is false if the control plane is already at TargetVersion
+	ControlPlaneUpgradeRequired bool
+	NodeGroups                  []nodegroup.VersionGap
+	Addons                      []addon.PlannedUpdate
+	DeprecatedAPIs              []DeprecatedAPIUsage
+	DeprecatedObjects           []DeprecatedObject
+}
+
+// PlanUpgrade returns the changes needed to upgrade the cluster to cfg.Metadata.Version, or to the next
+// Kubernetes version if it is not set, without changing anything
+func PlanUpgrade(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider) (*UpgradePlan, error) {
+	currentVersion := ctl.ControlPlaneVersion()
+	upgradeRequired, err := requiresVersionUpgrade(cfg.Metadata, currentVersion)
+	if err != nil {
+		return nil, err
+	}
+	plan := &UpgradePlan{
+		ClusterName:                 cfg.Metadata.Name,
+		CurrentVersion:              currentVersion,
+		TargetVersion:               cfg.Metadata.Version,
+		ControlPlaneUpgradeRequired: upgradeRequired,
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("checking nodegroups of cluster %q", cfg.Metadata.Name)
+	if plan.NodeGroups, err = nodegroup.New(cfg, ctl, clientSet, nil).GetVersionGaps(ctx, plan.TargetVersion); err != nil {
+		return nil, err
+	}
+
+	// cfg.Metadata.Version is now the target version, so addons are planned against its default versions
+	logger.Info("checking addons of cluster %q", cfg.Metadata.Name)
+	addonManager, err := addon.New(cfg, ctl.AWSProvider.EKS(), nil, false, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if plan.Addons, err = addonManager.PlanUpdates(ctx, addon.VersionPolicyDefault); err != nil {
+		return nil, err
+	}
+
+	logger.Info("checking usage of Kubernetes APIs removed in version %s", plan.TargetVersion)
+	report, err := GetRemovedAPIsReport(ctx, clientSet.Discovery().RESTClient(), currentVersion, plan.TargetVersion)
+	if err != nil {
+		return nil, err
+	}
+	plan.DeprecatedAPIs = report.DeprecatedAPIs
+	plan.DeprecatedObjects = report.Objects
+	return plan, nil
+}
@@ -0,0 +1,82 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// maxKubeletVersionSkew returns the number of minor versions kubelets may be older than a control plane of
+// controlPlaneVersion, see https://kubernetes.io/releases/version-skew-policy/#kubelet
+func maxKubeletVersionSkew(controlPlaneVersion *version.Version) int {
+	if controlPlaneVersion.LessThan(version.Must(version.NewVersion(api.Version1_28))) {
+		return 2
+	}
+	return 3
+}
+
+// findVersionSkewViolations returns the nodegroups that are more minor versions older than targetVersion than
+// kubelets are allowed to be
+func findVersionSkewViolations(gaps []nodegroup.VersionGap, targetVersion string) ([]nodegroup.VersionGap, error) {
+	target, err := version.NewVersion(targetVersion)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Kubernetes version %q: %w", targetVersion, err)
+	}
+	maxSkew := maxKubeletVersionSkew(target)
+
+	var violations []nodegroup.VersionGap
+	for _, gap := range gaps {
+		if gap.Version == "" {
+			continue
+		}
+		v, err := version.NewVersion(gap.Version)
+		if err != nil {
+			return nil, fmt.Errorf("parsing Kubernetes version %q of nodegroup %q: %w", gap.Version, gap.Name, err)
+		}
+		if target.Segments()[1]-v.Segments()[1] > maxSkew {
+			violations = append(violations, gap)
+		}
+	}
+	return violations, nil
+}
+
+// checkNodeGroupVersionSkew warns about the nodegroups that would be older than the version skew supported by a control
+// plane of the target version and, unless ignoreViolations is set, refuses the upgrade if there are any
+func checkNodeGroupVersionSkew(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, ignoreViolations bool) error {
+	logger.Info("checking Kubernetes versions of nodegroups")
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		logger.Warning("unable to check version skew of nodegroups: %v", err)
+		return nil
+	}
+	gaps, err := nodegroup.New(cfg, ctl, clientSet, nil).GetVersionGaps(ctx, cfg.Metadata.Version)
+	if err != nil {
+		logger.Warning("unable to check version skew of nodegroups: %v", err)
+		return nil
+	}
+	violations, err := findVersionSkewViolations(gaps, cfg.Metadata.Version)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	var names []string
+	for _, v := range violations {
+		logger.Warning("nodegroup %q of version %s is too old for a control plane of version %s", v.Name, v.Version, cfg.Metadata.Version)
+		names = append(names, v.Name)
+	}
+	if ignoreViolations {
+		return nil
+	}
+	return fmt.Errorf("upgrading the control plane to version %s would violate the supported version skew of nodegroup(s) %s, upgrade them first or use --force to upgrade anyway",
+		cfg.Metadata.Version, strings.Join(names, ", "))
+}
@@ -0,0 +1,43 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+)
+
+var _ = Describe("version skew", func() {
+	gaps := []nodegroup.VersionGap{
+		{Name: "ng-1", Version: "1.23"},
+		{Name: "ng-2", Version: "1.24"},
+		{Name: "ng-3", Version: "1.27"},
+		{Name: "ng-unknown"},
+	}
+
+	names := func(gaps []nodegroup.VersionGap) []string {
+		var names []string
+		for _, g := range gaps {
+			names = append(names, g.Name)
+		}
+		return names
+	}
+
+	It("allows kubelets two minor versions older than control planes before 1.28", func() {
+		violations, err := findVersionSkewViolations(gaps, "1.26")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(violations)).To(Equal([]string{"ng-1"}))
+	})
+
+	It("allows kubelets three minor versions older than control planes from 1.28", func() {
+		violations, err := findVersionSkewViolations(gaps, "1.28")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(violations)).To(Equal([]string{"ng-1", "ng-2"}))
+	})
+
+	It("returns no violations if all nodegroups are within the skew", func() {
+		violations, err := findVersionSkewViolations(gaps, "1.25")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(violations).To(BeEmpty())
+	})
+})
@@ -0,0 +1,103 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// CustomNetworkingNotice is logged when the cluster uses the subnets of an existing VPC
+const CustomNetworkingNotice = "custom VPC/subnets will be used; if resulting cluster doesn't function as expected, make sure to review the configuration of VPC/subnets"
+
+// VPCOptions are the options of CreateOrImportVPC
+type VPCOptions struct {
+	// AvailabilityZones are the availability zones of the VPC eksctl creates, they are picked when neither they nor
+	// availabilityZones are set
+	AvailabilityZones []string
+	// DryRun only picks the availability zones, leaving the subnets of cfg unset
+	DryRun bool
+}
+
+// CreateOrImportVPC sets the subnets of the VPC eksctl creates for cfg, either from its subnet CIDR plan or in its
+// availability zones, or imports the subnets of the existing VPC set in cfg
+func CreateOrImportVPC(ctx context.Context, cfg *api.ClusterConfig, ctl *eks.ClusterProvider, options VPCOptions) error {
+	if cfg.HasSubnetCIDRPlan() {
+		// create a dedicated VPC using the subnet CIDRs from the config file
+		if options.DryRun {
+			return nil
+		}
+		if err := vpc.UseSubnetCIDRPlan(cfg); err != nil {
+			return err
+		}
+		if err := cfg.HasSufficientSubnets(); err != nil {
+			return err
+		}
+		return eks.CheckInstanceAvailability(ctx, cfg, ctl.AWSProvider.EC2())
+	}
+
+	if !cfg.HasAnySubnets() {
+		if !cfg.IsControlPlaneOnOutposts() {
+			userProvidedAZs, err := eks.SetAvailabilityZones(ctx, cfg, options.AvailabilityZones, ctl.AWSProvider.EC2(), ctl.AWSProvider.Region())
+			if err != nil {
+				return err
+			}
+
+			// If the availability zones were provided at random, we already did this check.
+			if userProvidedAZs {
+				if err := eks.CheckInstanceAvailability(ctx, cfg, ctl.AWSProvider.EC2()); err != nil {
+					return err
+				}
+			}
+
+			if len(cfg.LocalZones) > 0 {
+				if err := eks.ValidateLocalZones(ctx, ctl.AWSProvider.EC2(), cfg.LocalZones, ctl.AWSProvider.Region()); err != nil {
+					return err
+				}
+			}
+
+			// Skip setting subnets
+			// The default subnet config set by SetSubnets will fail validation on a subsequent run of `create cluster`
+			// because those fields indicate usage of pre-existing VPC and subnets
+			// default: create dedicated VPC
+			if options.DryRun {
+				return nil
+			}
+		}
+		return vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones, cfg.LocalZones)
+	}
+
+	if options.DryRun {
+		if cfg.VPC.NAT != nil {
+			disableNAT := api.ClusterDisableNAT
+			cfg.VPC.NAT = &api.ClusterNAT{
+				Gateway: &disableNAT,
+			}
+		}
+		return nil
+	}
+
+	if err := vpc.ImportSubnetsFromSpec(ctx, ctl.AWSProvider, cfg); err != nil {
+		return err
+	}
+
+	if err := vpc.ValidateSharedSubnets(cfg); err != nil {
+		return err
+	}
+
+	if err := cfg.HasSufficientSubnets(); err != nil {
+		logger.Critical("unable to use given %s", cfg.SubnetInfo())
+		return err
+	}
+
+	if err := cfg.CanUseForPrivateNodeGroups(); err != nil {
+		return err
+	}
+
+	logger.Success("using existing %s", cfg.SubnetInfo())
+	logger.Warning(CustomNetworkingNotice)
+	return nil
+}
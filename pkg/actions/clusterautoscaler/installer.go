@@ -0,0 +1,205 @@
+package clusterautoscaler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// Namespace is the namespace Cluster Autoscaler is installed into
+	Namespace = "kube-system"
+	// ServiceAccountName is the name of the service account used by Cluster Autoscaler
+	ServiceAccountName = "cluster-autoscaler"
+	// ReleaseName is the name of the Helm release
+	ReleaseName = "cluster-autoscaler"
+
+	chartName    = "cluster-autoscaler"
+	chartRepoURL = "https://kubernetes.github.io/autoscaler"
+	chartVersion = "9.29.1"
+
+	discoveryEnabledTag = "k8s.io/cluster-autoscaler/enabled"
+	discoveryClusterTag = "k8s.io/cluster-autoscaler/%s"
+)
+
+// imageTags maps Kubernetes minor versions to the Cluster Autoscaler release built for them.
+// Cluster Autoscaler only supports the Kubernetes minor version it was released for.
+var imageTags = map[string]string{
+	api.Version1_22: "v1.22.3",
+	api.Version1_23: "v1.23.1",
+	api.Version1_24: "v1.24.3",
+	api.Version1_25: "v1.25.3",
+	api.Version1_26: "v1.26.4",
+	api.Version1_27: "v1.27.3",
+}
+
+// ImageTagForVersion returns the Cluster Autoscaler image tag matching the given Kubernetes version
+func ImageTagForVersion(kubernetesVersion string) (string, error) {
+	tag, ok := imageTags[kubernetesVersion]
+	if !ok {
+		return "", fmt.Errorf("no known Cluster Autoscaler release for Kubernetes version %q, use --version to set the Cluster Autoscaler version", kubernetesVersion)
+	}
+	return tag, nil
+}
+
+// Installer installs Cluster Autoscaler on an existing cluster
+type Installer struct {
+	cfg             *api.ClusterConfig
+	stackManager    manager.StackManager
+	asgAPI          awsapi.ASG
+	helmInstaller   providers.HelmInstaller
+	oidc            *iamoidc.OpenIDConnectManager
+	clientSetGetter kubernetes.ClientSetGetter
+}
+
+// NewInstaller creates a new Installer
+func NewInstaller(cfg *api.ClusterConfig, stackManager manager.StackManager, asgAPI awsapi.ASG, helmInstaller providers.HelmInstaller,
+	oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *Installer {
+	return &Installer{
+		cfg:             cfg,
+		stackManager:    stackManager,
+		asgAPI:          asgAPI,
+		helmInstaller:   helmInstaller,
+		oidc:            oidc,
+		clientSetGetter: clientSetGetter,
+	}
+}
+
+// Options defines options for Install
+type Options struct {
+	// KubernetesVersion is the version of the cluster, used to pick the Cluster Autoscaler version
+	KubernetesVersion string
+	// ImageTag overrides the Cluster Autoscaler version matched to KubernetesVersion
+	ImageTag string
+	// Plan only logs the intended changes
+	Plan bool
+}
+
+// Install tags the Auto Scaling groups of the eksctl-managed nodegroups for auto-discovery, creates the
+// IAM service account for Cluster Autoscaler and installs the Cluster Autoscaler Helm chart
+func (i *Installer) Install(ctx context.Context, opts Options) error {
+	imageTag := opts.ImageTag
+	if imageTag == "" {
+		var err error
+		if imageTag, err = ImageTagForVersion(opts.KubernetesVersion); err != nil {
+			return err
+		}
+	}
+
+	if err := i.tagAutoScalingGroups(ctx, opts.Plan); err != nil {
+		return err
+	}
+	if err := i.createServiceAccount(ctx, opts.Plan); err != nil {
+		return err
+	}
+
+	if opts.Plan {
+		logger.Info("(plan) would install Cluster Autoscaler %s in namespace %q", imageTag, Namespace)
+		return nil
+	}
+	logger.Info("installing Cluster Autoscaler %s", imageTag)
+	if err := i.helmInstaller.InstallChart(ctx, providers.InstallChartOpts{
+		ChartName:   chartName,
+		RepoURL:     chartRepoURL,
+		Namespace:   Namespace,
+		ReleaseName: ReleaseName,
+		Version:     chartVersion,
+		Values:      i.chartValues(imageTag),
+	}); err != nil {
+		return fmt.Errorf("installing Cluster Autoscaler: %w", err)
+	}
+	logger.Success("installed Cluster Autoscaler %s", imageTag)
+	return nil
+}
+
+func (i *Installer) chartValues(imageTag string) map[string]interface{} {
+	return map[string]interface{}{
+		"autoDiscovery": map[string]interface{}{
+			"clusterName": i.cfg.Metadata.Name,
+		},
+		"awsRegion": i.cfg.Metadata.Region,
+		"image": map[string]interface{}{
+			"tag": imageTag,
+		},
+		"rbac": map[string]interface{}{
+			"serviceAccount": map[string]interface{}{
+				"create": false,
+				"name":   ServiceAccountName,
+			},
+		},
+	}
+}
+
+// tagAutoScalingGroups adds the auto-discovery tags to the Auto Scaling groups of all nodegroups.
+// Unmanaged nodegroups created with `iam.withAddonPolicies.autoScaler` are already tagged.
+func (i *Installer) tagAutoScalingGroups(ctx context.Context, plan bool) error {
+	nodeGroupStacks, err := i.stackManager.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return fmt.Errorf("listing nodegroup stacks: %w", err)
+	}
+	clusterTag := fmt.Sprintf(discoveryClusterTag, i.cfg.Metadata.Name)
+	for _, ngStack := range nodeGroupStacks {
+		asgNames, err := i.stackManager.GetAutoScalingGroupName(ctx, ngStack.Stack)
+		if err != nil {
+			return fmt.Errorf("getting Auto Scaling group for nodegroup %q: %w", ngStack.NodeGroupName, err)
+		}
+		if asgNames == "" {
+			logger.Warning("no Auto Scaling group found for nodegroup %q, skipping auto-discovery tags", ngStack.NodeGroupName)
+			continue
+		}
+		for _, asgName := range strings.Split(asgNames, ",") {
+			if plan {
+				logger.Info("(plan) would tag Auto Scaling group %q of nodegroup %q for auto-discovery", asgName, ngStack.NodeGroupName)
+				continue
+			}
+			if _, err := i.asgAPI.CreateOrUpdateTags(ctx, &autoscaling.CreateOrUpdateTagsInput{
+				Tags: []asgtypes.Tag{
+					discoveryTag(asgName, discoveryEnabledTag, "true"),
+					discoveryTag(asgName, clusterTag, "owned"),
+				},
+			}); err != nil {
+				return fmt.Errorf("tagging Auto Scaling group %q of nodegroup %q: %w", asgName, ngStack.NodeGroupName, err)
+			}
+			logger.Info("tagged Auto Scaling group %q of nodegroup %q for auto-discovery", asgName, ngStack.NodeGroupName)
+		}
+	}
+	return nil
+}
+
+func discoveryTag(asgName, key, value string) asgtypes.Tag {
+	return asgtypes.Tag{
+		ResourceId:        aws.String(asgName),
+		ResourceType:      aws.String("auto-scaling-group"),
+		Key:               aws.String(key),
+		Value:             aws.String(value),
+		PropagateAtLaunch: aws.Bool(false),
+	}
+}
+
+func (i *Installer) createServiceAccount(ctx context.Context, plan bool) error {
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      ServiceAccountName,
+			Namespace: Namespace,
+		},
+		WellKnownPolicies: api.WellKnownPolicies{
+			AutoScaler: true,
+		},
+	}
+
+	_, _, err := irsa.EnsureIAMServiceAccount(ctx, i.stackManager, i.oidc, i.clientSetGetter, serviceAccount, plan)
+	return err
+}
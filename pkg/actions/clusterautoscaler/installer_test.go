@@ -0,0 +1,161 @@
+package clusterautoscaler_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/clusterautoscaler"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	providerfakes "github.com/weaveworks/eksctl/pkg/karpenter/providers/fakes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("Cluster Autoscaler", func() {
+	var (
+		cfg              *api.ClusterConfig
+		fakeStackManager *fakes.FakeStackManager
+		asgAPI           *mocksv2.ASG
+		helmInstaller    *providerfakes.FakeHelmInstaller
+		installer        *clusterautoscaler.Installer
+	)
+
+	discoveryTags := func(asgName string) []asgtypes.Tag {
+		tag := func(key, value string) asgtypes.Tag {
+			return asgtypes.Tag{
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String(key),
+				Value:             aws.String(value),
+				PropagateAtLaunch: aws.Bool(false),
+			}
+		}
+		return []asgtypes.Tag{
+			tag("k8s.io/cluster-autoscaler/enabled", "true"),
+			tag("k8s.io/cluster-autoscaler/my-cluster", "owned"),
+		}
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		fakeStackManager = &fakes.FakeStackManager{}
+		fakeStackManager.ListNodeGroupStacksWithStatusesReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged, Stack: &manager.Stack{StackName: aws.String("ng-1")}},
+			{NodeGroupName: "mng-1", Type: api.NodeGroupTypeManaged, Stack: &manager.Stack{StackName: aws.String("mng-1")}},
+		}, nil)
+		fakeStackManager.GetAutoScalingGroupNameReturnsOnCall(0, "asg-ng-1", nil)
+		fakeStackManager.GetAutoScalingGroupNameReturnsOnCall(1, "asg-mng-1a,asg-mng-1b", nil)
+		fakeStackManager.NewTasksToCreateIAMServiceAccountsReturns(&tasks.TaskTree{})
+
+		asgAPI = &mocksv2.ASG{}
+		helmInstaller = &providerfakes.FakeHelmInstaller{}
+		installer = clusterautoscaler.NewInstaller(cfg, fakeStackManager, asgAPI, helmInstaller, nil, nil)
+	})
+
+	It("tags the nodegroups, creates the service account and installs the chart matching the cluster version", func() {
+		for _, asgName := range []string{"asg-ng-1", "asg-mng-1a", "asg-mng-1b"} {
+			asgAPI.On("CreateOrUpdateTags", mock.Anything, &autoscaling.CreateOrUpdateTagsInput{
+				Tags: discoveryTags(asgName),
+			}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil).Once()
+		}
+
+		Expect(installer.Install(context.Background(), clusterautoscaler.Options{KubernetesVersion: api.Version1_27})).To(Succeed())
+		asgAPI.AssertExpectations(GinkgoT())
+
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(1))
+		serviceAccounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+		Expect(serviceAccounts).To(HaveLen(1))
+		Expect(serviceAccounts[0].NameString()).To(Equal("kube-system/cluster-autoscaler"))
+		Expect(serviceAccounts[0].WellKnownPolicies.AutoScaler).To(BeTrue())
+
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(1))
+		_, opts := helmInstaller.InstallChartArgsForCall(0)
+		Expect(opts).To(Equal(providers.InstallChartOpts{
+			ChartName:   "cluster-autoscaler",
+			RepoURL:     "https://kubernetes.github.io/autoscaler",
+			Namespace:   "kube-system",
+			ReleaseName: "cluster-autoscaler",
+			Version:     "9.29.1",
+			Values: map[string]interface{}{
+				"autoDiscovery": map[string]interface{}{
+					"clusterName": "my-cluster",
+				},
+				"awsRegion": "us-west-2",
+				"image": map[string]interface{}{
+					"tag": "v1.27.3",
+				},
+				"rbac": map[string]interface{}{
+					"serviceAccount": map[string]interface{}{
+						"create": false,
+						"name":   "cluster-autoscaler",
+					},
+				},
+			},
+		}))
+	})
+
+	It("does not create the service account when it already exists", func() {
+		asgAPI.On("CreateOrUpdateTags", mock.Anything, mock.Anything).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+		fakeStackManager.GetIAMServiceAccountsReturns([]*api.ClusterIAMServiceAccount{
+			{ClusterIAMMeta: api.ClusterIAMMeta{Name: "cluster-autoscaler", Namespace: "kube-system"}},
+		}, nil)
+
+		Expect(installer.Install(context.Background(), clusterautoscaler.Options{KubernetesVersion: api.Version1_27})).To(Succeed())
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(1))
+	})
+
+	It("uses the supplied version", func() {
+		asgAPI.On("CreateOrUpdateTags", mock.Anything, mock.Anything).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+
+		Expect(installer.Install(context.Background(), clusterautoscaler.Options{KubernetesVersion: "1.99", ImageTag: "v1.99.0"})).To(Succeed())
+		_, opts := helmInstaller.InstallChartArgsForCall(0)
+		Expect(opts.Values["image"]).To(Equal(map[string]interface{}{"tag": "v1.99.0"}))
+	})
+
+	It("returns an error for an unknown Kubernetes version", func() {
+		err := installer.Install(context.Background(), clusterautoscaler.Options{KubernetesVersion: "1.99"})
+		Expect(err).To(MatchError(ContainSubstring(`no known Cluster Autoscaler release for Kubernetes version "1.99"`)))
+		Expect(fakeStackManager.ListNodeGroupStacksWithStatusesCallCount()).To(Equal(0))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(0))
+	})
+
+	It("does not make any changes in plan mode", func() {
+		Expect(installer.Install(context.Background(), clusterautoscaler.Options{KubernetesVersion: api.Version1_26, Plan: true})).To(Succeed())
+		asgAPI.AssertNotCalled(GinkgoT(), "CreateOrUpdateTags", mock.Anything, mock.Anything)
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(0))
+	})
+
+	It("returns an error when tagging fails", func() {
+		asgAPI.On("CreateOrUpdateTags", mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+
+		err := installer.Install(context.Background(), clusterautoscaler.Options{KubernetesVersion: api.Version1_27})
+		Expect(err).To(MatchError(ContainSubstring(`tagging Auto Scaling group "asg-ng-1" of nodegroup "ng-1": access denied`)))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(0))
+	})
+
+	DescribeTable("matches the Cluster Autoscaler version to the Kubernetes version", func(kubernetesVersion, expectedTag string) {
+		tag, err := clusterautoscaler.ImageTagForVersion(kubernetesVersion)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tag).To(Equal(expectedTag))
+	},
+		Entry("1.23", api.Version1_23, "v1.23.1"),
+		Entry("1.25", api.Version1_25, "v1.25.3"),
+		Entry("1.27", api.Version1_27, "v1.27.3"),
+	)
+})
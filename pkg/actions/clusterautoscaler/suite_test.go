@@ -0,0 +1,11 @@
+package clusterautoscaler_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestClusterAutoscaler(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
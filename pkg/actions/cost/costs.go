@@ -0,0 +1,192 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Resources whose costs are not broken down by nodegroup
+const (
+	ResourceControlPlane = "control-plane"
+	ResourceNATGateways  = "nat-gateways"
+)
+
+// Instance is a running instance of a node of a cluster
+type Instance struct {
+	NodeGroup        string
+	InstanceType     string
+	AvailabilityZone string
+	Spot             bool
+}
+
+// ResourceCost is the current cost of the control plane, the NAT gateways or the instances of a type and lifecycle
+// of a nodegroup
+type ResourceCost struct {
+	Resource     string
+	InstanceType string `json:",omitempty"`
+	Lifecycle    string `json:",omitempty"`
+	Count        int
+	HourlyCost   float64
+	MonthlyCost  float64
+}
+
+// ClusterCosts is the current cost of the resources of a running cluster
+type ClusterCosts struct {
+	Currency string
+	Costs    []*ResourceCost
+}
+
+// HourlyTotal returns the current hourly cost of all the resources
+func (c *ClusterCosts) HourlyTotal() float64 {
+	var total float64
+	for _, cost := range c.Costs {
+		total += cost.HourlyCost
+	}
+	return total
+}
+
+// SpotPricer looks up the current prices of Spot Instances
+type SpotPricer struct {
+	ec2API awsapi.EC2
+	prices map[string]float64
+}
+
+// NewSpotPricer returns a SpotPricer using the Spot price history of EC2
+func NewSpotPricer(ec2API awsapi.EC2) *SpotPricer {
+	return &SpotPricer{
+		ec2API: ec2API,
+		prices: map[string]float64{},
+	}
+}
+
+// Hourly returns the current hourly price of a Linux Spot Instance of a type in an availability zone
+func (p *SpotPricer) Hourly(ctx context.Context, instanceType, availabilityZone string) (float64, error) {
+	key := instanceType + "/" + availabilityZone
+	if price, ok := p.prices[key]; ok {
+		return price, nil
+	}
+	now := time.Now()
+	// the history of the current time returns the price in effect
+	output, err := p.ec2API.DescribeSpotPriceHistory(ctx, &ec2.DescribeSpotPriceHistoryInput{
+		InstanceTypes:       []ec2types.InstanceType{ec2types.InstanceType(instanceType)},
+		AvailabilityZone:    aws.String(availabilityZone),
+		ProductDescriptions: []string{"Linux/UNIX"},
+		StartTime:           aws.Time(now),
+		EndTime:             aws.Time(now),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("getting the Spot price of instance type %q in %s: %w", instanceType, availabilityZone, err)
+	}
+	for _, history := range output.SpotPriceHistory {
+		if price, err := strconv.ParseFloat(aws.ToString(history.SpotPrice), 64); err == nil {
+			p.prices[key] = price
+			return price, nil
+		}
+	}
+	return 0, fmt.Errorf("no Spot price of instance type %q was found in %s", instanceType, availabilityZone)
+}
+
+// CountNATGateways returns the number of available NAT gateways in a VPC
+func CountNATGateways(ctx context.Context, ec2API awsapi.EC2, vpcID string) (int, error) {
+	count := 0
+	paginator := ec2.NewDescribeNatGatewaysPaginator(ec2API, &ec2.DescribeNatGatewaysInput{
+		Filter: []ec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("state"), Values: []string{string(ec2types.NatGatewayStateAvailable)}},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("describing the NAT gateways of VPC %q: %w", vpcID, err)
+		}
+		count += len(output.NatGateways)
+	}
+	return count, nil
+}
+
+// GetClusterCosts returns the current cost of the control plane, the NAT gateways and the instances of a running
+// cluster, with on-demand prices and the current Spot prices. Instances are priced as Linux instances.
+func GetClusterCosts(ctx context.Context, pricer *Pricer, spotPricer *SpotPricer, natGateways int, instances []Instance) (*ClusterCosts, error) {
+	costs := &ClusterCosts{
+		Currency: pricer.Currency(),
+	}
+	addCost := func(cost *ResourceCost) {
+		cost.MonthlyCost = cost.HourlyCost * HoursPerMonth
+		costs.Costs = append(costs.Costs, cost)
+	}
+
+	price, err := pricer.ControlPlaneHourly(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addCost(&ResourceCost{Resource: ResourceControlPlane, Count: 1, HourlyCost: price})
+
+	if natGateways > 0 {
+		price, err := pricer.NATGatewayHourly(ctx)
+		if err != nil {
+			return nil, err
+		}
+		addCost(&ResourceCost{Resource: ResourceNATGateways, Count: natGateways, HourlyCost: float64(natGateways) * price})
+	}
+
+	type instanceGroup struct {
+		nodeGroup, instanceType string
+		spot                    bool
+	}
+	instanceCosts := map[instanceGroup]*ResourceCost{}
+	for _, instance := range instances {
+		var price float64
+		if instance.Spot {
+			price, err = spotPricer.Hourly(ctx, instance.InstanceType, instance.AvailabilityZone)
+		} else {
+			price, err = pricer.InstanceHourly(ctx, instance.InstanceType, false)
+		}
+		if err != nil {
+			return nil, err
+		}
+		group := instanceGroup{nodeGroup: instance.NodeGroup, instanceType: instance.InstanceType, spot: instance.Spot}
+		cost, ok := instanceCosts[group]
+		if !ok {
+			cost = &ResourceCost{
+				Resource:     instance.NodeGroup,
+				InstanceType: instance.InstanceType,
+				Lifecycle:    "on-demand",
+			}
+			if instance.Spot {
+				cost.Lifecycle = "spot"
+			}
+			instanceCosts[group] = cost
+		}
+		cost.Count++
+		cost.HourlyCost += price
+	}
+
+	var nodeGroupCosts []*ResourceCost
+	for _, cost := range instanceCosts {
+		nodeGroupCosts = append(nodeGroupCosts, cost)
+	}
+	sort.Slice(nodeGroupCosts, func(i, j int) bool {
+		a, b := nodeGroupCosts[i], nodeGroupCosts[j]
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		if a.InstanceType != b.InstanceType {
+			return a.InstanceType < b.InstanceType
+		}
+		return a.Lifecycle < b.Lifecycle
+	})
+	for _, cost := range nodeGroupCosts {
+		addCost(cost)
+	}
+	return costs, nil
+}
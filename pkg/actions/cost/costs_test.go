@@ -0,0 +1,121 @@
+package cost_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cost"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+)
+
+var _ = Describe("GetClusterCosts", func() {
+	var (
+		pricingAPI *mocksv2.Pricing
+		ec2API     *mocksv2.EC2
+	)
+
+	BeforeEach(func() {
+		pricingAPI = &mocksv2.Pricing{}
+		ec2API = &mocksv2.EC2{}
+
+		pricingAPI.On("GetProducts", mock.Anything, mock.MatchedBy(func(input *pricing.GetProductsInput) bool {
+			return aws.ToString(input.ServiceCode) == "AmazonEKS"
+		}), mock.Anything).Return(&pricing.GetProductsOutput{
+			PriceList: []string{priceList("USE1-AmazonEKS-Hours:perCluster", "0.10")},
+		}, nil)
+		pricingAPI.On("GetProducts", mock.Anything, mock.MatchedBy(func(input *pricing.GetProductsInput) bool {
+			for _, f := range input.Filters {
+				if aws.ToString(f.Field) == "productFamily" {
+					return aws.ToString(f.Value) == "NAT Gateway"
+				}
+			}
+			return false
+		}), mock.Anything).Return(&pricing.GetProductsOutput{
+			PriceList: []string{priceList("NatGateway-Hours", "0.045")},
+		}, nil)
+		pricingAPI.On("GetProducts", mock.Anything, mock.MatchedBy(func(input *pricing.GetProductsInput) bool {
+			for _, f := range input.Filters {
+				if aws.ToString(f.Field) == "instanceType" {
+					return aws.ToString(f.Value) == "m5.large"
+				}
+			}
+			return false
+		}), mock.Anything).Return(&pricing.GetProductsOutput{
+			PriceList: []string{priceList("BoxUsage:m5.large", "0.096")},
+		}, nil)
+
+		for az, price := range map[string]string{"us-east-1a": "0.035", "us-east-1b": "0.041"} {
+			az, price := az, price
+			ec2API.On("DescribeSpotPriceHistory", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeSpotPriceHistoryInput) bool {
+				return aws.ToString(input.AvailabilityZone) == az
+			})).Return(&ec2.DescribeSpotPriceHistoryOutput{
+				SpotPriceHistory: []ec2types.SpotPrice{{SpotPrice: aws.String(price)}},
+			}, nil)
+		}
+	})
+
+	It("aggregates the costs of the instances by nodegroup, instance type and lifecycle", func() {
+		costs, err := cost.GetClusterCosts(context.Background(), cost.NewPricer(pricingAPI, "us-east-1"), cost.NewSpotPricer(ec2API), 2, []cost.Instance{
+			{NodeGroup: "ng-2", InstanceType: "m5.large", AvailabilityZone: "us-east-1a", Spot: true},
+			{NodeGroup: "ng-1", InstanceType: "m5.large", AvailabilityZone: "us-east-1a"},
+			{NodeGroup: "ng-2", InstanceType: "m5.large", AvailabilityZone: "us-east-1b", Spot: true},
+			{NodeGroup: "ng-1", InstanceType: "m5.large", AvailabilityZone: "us-east-1b"},
+			{NodeGroup: "ng-2", InstanceType: "m5.large", AvailabilityZone: "us-east-1a", Spot: true},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(costs.Currency).To(Equal("USD"))
+
+		type row struct {
+			resource, lifecycle string
+			count               int
+		}
+		var rows []row
+		for _, c := range costs.Costs {
+			rows = append(rows, row{c.Resource, c.Lifecycle, c.Count})
+		}
+		Expect(rows).To(Equal([]row{
+			{cost.ResourceControlPlane, "", 1},
+			{cost.ResourceNATGateways, "", 2},
+			{"ng-1", "on-demand", 2},
+			{"ng-2", "spot", 3},
+		}))
+		Expect(costs.Costs[1].HourlyCost).To(BeNumerically("~", 0.09, 1e-9))
+		Expect(costs.Costs[2].HourlyCost).To(BeNumerically("~", 0.192, 1e-9))
+		Expect(costs.Costs[3].HourlyCost).To(BeNumerically("~", 0.111, 1e-9))
+		Expect(costs.Costs[3].MonthlyCost).To(BeNumerically("~", 0.111*730, 1e-9))
+		Expect(costs.HourlyTotal()).To(BeNumerically("~", 0.1+0.09+0.192+0.111, 1e-9))
+
+		// the Spot price of each availability zone is looked up once
+		ec2API.AssertNumberOfCalls(GinkgoT(), "DescribeSpotPriceHistory", 2)
+	})
+
+	It("does not price NAT gateways when the VPC has none", func() {
+		costs, err := cost.GetClusterCosts(context.Background(), cost.NewPricer(pricingAPI, "us-east-1"), cost.NewSpotPricer(ec2API), 0, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(costs.Costs).To(HaveLen(1))
+		Expect(costs.Costs[0].Resource).To(Equal(cost.ResourceControlPlane))
+	})
+})
+
+var _ = Describe("CountNATGateways", func() {
+	It("counts the available NAT gateways of the VPC", func() {
+		ec2API := &mocksv2.EC2{}
+		ec2API.On("DescribeNatGateways", mock.Anything, &ec2.DescribeNatGatewaysInput{
+			Filter: []ec2types.Filter{
+				{Name: aws.String("vpc-id"), Values: []string{"vpc-1234"}},
+				{Name: aws.String("state"), Values: []string{"available"}},
+			},
+		}, mock.Anything).Return(&ec2.DescribeNatGatewaysOutput{
+			NatGateways: []ec2types.NatGateway{{NatGatewayId: aws.String("nat-1")}, {NatGatewayId: aws.String("nat-2")}},
+		}, nil)
+		Expect(cost.CountNATGateways(context.Background(), ec2API, "vpc-1234")).To(Equal(2))
+	})
+})
@@ -0,0 +1,203 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// HoursPerMonth is the number of hours AWS uses to compute monthly prices
+const HoursPerMonth = 730
+
+// Item is the estimated cost of a resource, or of several resources of the same kind
+type Item struct {
+	Description string
+	// Quantity is the number of resources, or the number of GB of EBS volumes
+	Quantity int
+	// UnitPrice is the hourly price of a resource, or the monthly price of a GB of EBS volumes
+	UnitPrice   float64
+	MonthlyCost float64
+}
+
+// Estimate is the estimated monthly cost of the resources of a cluster
+type Estimate struct {
+	Currency string
+	Items    []Item
+	// Notes describe what the estimate does not include
+	Notes []string
+}
+
+// MonthlyTotal returns the estimated monthly cost of all the resources
+func (e *Estimate) MonthlyTotal() float64 {
+	var total float64
+	for _, item := range e.Items {
+		total += item.MonthlyCost
+	}
+	return total
+}
+
+// Log logs the items, the total and the notes of the estimate
+func (e *Estimate) Log(resource string) {
+	for _, item := range e.Items {
+		logger.Info("%s: %.2f %s/month", item.Description, item.MonthlyCost, e.Currency)
+	}
+	logger.Success("estimated monthly cost of %s: %.2f %s", resource, e.MonthlyTotal(), e.Currency)
+	for _, note := range e.Notes {
+		logger.Info("note: %s", note)
+	}
+}
+
+// Estimator estimates the cost of the resources of clusters and nodegroups with on-demand prices
+type Estimator struct {
+	pricer *Pricer
+}
+
+// NewEstimator returns an Estimator using the prices of pricer
+func NewEstimator(pricer *Pricer) *Estimator {
+	return &Estimator{
+		pricer: pricer,
+	}
+}
+
+// EstimateCluster estimates the monthly cost of the control plane, the NAT gateways of a new VPC and the nodegroups
+// of cfg, whose defaults must have been set
+func (e *Estimator) EstimateCluster(ctx context.Context, cfg *api.ClusterConfig, nodePools []api.NodePool) (*Estimate, error) {
+	estimate := e.newEstimate()
+
+	if cfg.IsControlPlaneOnOutposts() {
+		estimate.Notes = append(estimate.Notes, "the control plane runs on the instances of the Outpost and is not included")
+	} else {
+		price, err := e.pricer.ControlPlaneHourly(ctx)
+		if err != nil {
+			return nil, err
+		}
+		estimate.addHourly("EKS control plane", 1, price)
+	}
+
+	if preflight.CreatesVPC(cfg) {
+		if natGateways := preflight.NATGateways(cfg); natGateways > 0 {
+			price, err := e.pricer.NATGatewayHourly(ctx)
+			if err != nil {
+				return nil, err
+			}
+			estimate.addHourly(fmt.Sprintf("%d NAT gateway(s)", natGateways), natGateways, price)
+		}
+	}
+
+	if err := e.addNodePools(ctx, estimate, nodePools); err != nil {
+		return nil, err
+	}
+	if len(cfg.FargateProfiles) > 0 {
+		estimate.Notes = append(estimate.Notes, "pods running on Fargate are not included")
+	}
+	if cfg.IsAutoModeEnabled() {
+		estimate.Notes = append(estimate.Notes, "instances launched by EKS Auto Mode are not included")
+	}
+	return estimate, nil
+}
+
+// EstimateNodeGroups estimates the monthly cost of the instances and EBS volumes of nodePools, whose defaults must
+// have been set
+func (e *Estimator) EstimateNodeGroups(ctx context.Context, nodePools []api.NodePool) (*Estimate, error) {
+	estimate := e.newEstimate()
+	if err := e.addNodePools(ctx, estimate, nodePools); err != nil {
+		return nil, err
+	}
+	return estimate, nil
+}
+
+func (e *Estimator) newEstimate() *Estimate {
+	return &Estimate{
+		Currency: e.pricer.Currency(),
+		Notes:    []string{"data transfer, NAT gateway data processing and provisioned EBS IOPS and throughput are not included"},
+	}
+}
+
+func (e *Estimator) addNodePools(ctx context.Context, estimate *Estimate, nodePools []api.NodePool) error {
+	hasSpot := false
+	for _, np := range nodePools {
+		ng := np.BaseNodeGroup()
+		requests := preflight.NodeGroupInstanceRequests(np)
+		if len(requests) == 0 {
+			estimate.Notes = append(estimate.Notes, fmt.Sprintf("the instance types of nodegroup %q are not known, its instances are not included", ng.Name))
+			continue
+		}
+
+		nodeCount := 0
+		for _, r := range requests {
+			price, err := e.pricer.InstanceHourly(ctx, r.InstanceType, api.IsWindowsImage(ng.AMIFamily))
+			if err != nil {
+				return err
+			}
+			description := fmt.Sprintf("nodegroup %q: %d %s instance(s)", ng.Name, r.Count, r.InstanceType)
+			if r.Spot {
+				description += " (Spot)"
+				hasSpot = true
+			}
+			estimate.addHourly(description, r.Count, price)
+			nodeCount += r.Count
+		}
+
+		volumeSizes := nodeVolumeSizes(ng)
+		for _, volumeType := range sortedVolumeTypes(volumeSizes) {
+			size := volumeSizes[volumeType] * nodeCount
+			price, err := e.pricer.VolumeMonthlyPerGB(ctx, volumeType)
+			if err != nil {
+				return err
+			}
+			estimate.Items = append(estimate.Items, Item{
+				Description: fmt.Sprintf("nodegroup %q: %d GB of %s volumes", ng.Name, size, volumeType),
+				Quantity:    size,
+				UnitPrice:   price,
+				MonthlyCost: float64(size) * price,
+			})
+		}
+	}
+	if hasSpot {
+		estimate.Notes = append(estimate.Notes, "Spot Instances are priced at on-demand prices, they usually cost less")
+	}
+	return nil
+}
+
+func (e *Estimate) addHourly(description string, quantity int, hourlyPrice float64) {
+	e.Items = append(e.Items, Item{
+		Description: description,
+		Quantity:    quantity,
+		UnitPrice:   hourlyPrice,
+		MonthlyCost: float64(quantity) * hourlyPrice * HoursPerMonth,
+	})
+}
+
+// nodeVolumeSizes returns the size in GB of the root and additional volumes of a node, by volume type
+func nodeVolumeSizes(ng *api.NodeGroupBase) map[string]int {
+	sizes := map[string]int{}
+	add := func(size *int, volumeType *string) {
+		if size == nil || *size == 0 {
+			return
+		}
+		t := api.DefaultNodeVolumeType
+		if volumeType != nil && *volumeType != "" {
+			t = *volumeType
+		}
+		sizes[t] += *size
+	}
+	add(ng.VolumeSize, ng.VolumeType)
+	for _, v := range ng.AdditionalVolumes {
+		add(v.VolumeSize, v.VolumeType)
+	}
+	return sizes
+}
+
+func sortedVolumeTypes(sizes map[string]int) []string {
+	var volumeTypes []string
+	for t := range sizes {
+		volumeTypes = append(volumeTypes, t)
+	}
+	sort.Strings(volumeTypes)
+	return volumeTypes
+}
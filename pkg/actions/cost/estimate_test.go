@@ -0,0 +1,138 @@
+package cost_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cost"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+	"github.com/weaveworks/eksctl/pkg/utils/nodes"
+)
+
+// priceList returns a product of the Price List API with a usage type and an on-demand price in USD
+func priceList(usageType, price string) string {
+	return fmt.Sprintf(`{"product": {"attributes": {"usagetype": %q}}, "terms": {"OnDemand": {"SKU.TERM": {"priceDimensions": {"SKU.TERM.DIM": {"pricePerUnit": {"USD": %q}}}}}}}`, usageType, price)
+}
+
+var _ = Describe("Estimator", func() {
+	var (
+		pricingAPI *mocksv2.Pricing
+		estimator  *cost.Estimator
+		cfg        *api.ClusterConfig
+	)
+
+	// mockPrice mocks the products of serviceCode whose filters include field=value
+	mockPrice := func(serviceCode, field, value string, priceLists ...string) {
+		pricingAPI.On("GetProducts", mock.Anything, mock.MatchedBy(func(input *pricing.GetProductsInput) bool {
+			if aws.ToString(input.ServiceCode) != serviceCode {
+				return false
+			}
+			for _, f := range input.Filters {
+				if aws.ToString(f.Field) == field && aws.ToString(f.Value) == value {
+					return true
+				}
+			}
+			return false
+		}), mock.Anything).Return(&pricing.GetProductsOutput{PriceList: priceLists}, nil)
+	}
+
+	BeforeEach(func() {
+		pricingAPI = &mocksv2.Pricing{}
+		estimator = cost.NewEstimator(cost.NewPricer(pricingAPI, "us-west-2"))
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		mockPrice("AmazonEKS", "regionCode", "us-west-2",
+			priceList("USW2-AmazonEKS-Hours:extendedSupport", "0.60"),
+			priceList("USW2-AmazonEKS-Hours:perCluster", "0.10"),
+		)
+		mockPrice("AmazonEC2", "productFamily", "NAT Gateway",
+			priceList("USW2-NatGateway-Bytes", "0.045"),
+			priceList("USW2-NatGateway-Hours", "0.045"),
+		)
+		mockPrice("AmazonEC2", "instanceType", "m5.large", priceList("USW2-BoxUsage:m5.large", "0.096"))
+		mockPrice("AmazonEC2", "volumeApiName", "gp3", priceList("USW2-EBS:VolumeUsage.gp3", "0.08"))
+	})
+
+	It("prices the control plane, the NAT gateways and the instances and volumes of the nodegroups", func() {
+		vpc := api.NewClusterVPC(false)
+		vpc.NAT = &api.ClusterNAT{Gateway: aws.String(api.ClusterHighlyAvailableNAT)}
+		cfg.VPC = vpc
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b", "us-west-2c"}
+
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.large"
+		ng.DesiredCapacity = aws.Int(2)
+		ng.VolumeSize = aws.Int(100)
+		ng.VolumeType = aws.String(api.NodeVolumeTypeGP3)
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng-1"
+		mng.InstanceTypes = []string{"m5.large"}
+		mng.Spot = true
+		mng.DesiredCapacity = aws.Int(1)
+		mng.VolumeSize = aws.Int(80)
+		mng.AdditionalVolumes = []*api.VolumeMapping{{VolumeSize: aws.Int(20), VolumeType: aws.String(api.NodeVolumeTypeGP3)}}
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+
+		estimate, err := estimator.EstimateCluster(context.Background(), cfg, nodes.ToNodePools(cfg))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(estimate.Currency).To(Equal("USD"))
+		var descriptions []string
+		for _, item := range estimate.Items {
+			descriptions = append(descriptions, item.Description)
+		}
+		Expect(descriptions).To(Equal([]string{
+			"EKS control plane",
+			"3 NAT gateway(s)",
+			`nodegroup "ng-1": 2 m5.large instance(s)`,
+			`nodegroup "ng-1": 200 GB of gp3 volumes`,
+			`nodegroup "mng-1": 1 m5.large instance(s) (Spot)`,
+			`nodegroup "mng-1": 100 GB of gp3 volumes`,
+		}))
+		Expect(estimate.Items[0].MonthlyCost).To(BeNumerically("~", 73, 0.001))
+		Expect(estimate.Items[1].MonthlyCost).To(BeNumerically("~", 3*0.045*730, 0.001))
+		Expect(estimate.Items[2].MonthlyCost).To(BeNumerically("~", 2*0.096*730, 0.001))
+		Expect(estimate.Items[3].MonthlyCost).To(BeNumerically("~", 16, 0.001))
+		Expect(estimate.MonthlyTotal()).To(BeNumerically("~", 73+98.55+140.16+16+70.08+8, 0.001))
+		Expect(estimate.Notes).To(ContainElement(ContainSubstring("Spot Instances are priced at on-demand prices")))
+
+		// the prices are looked up once
+		pricingAPI.AssertNumberOfCalls(GinkgoT(), "GetProducts", 4)
+	})
+
+	It("does not price the NAT gateways of an existing VPC", func() {
+		cfg.VPC.ID = "vpc-1234"
+		estimate, err := estimator.EstimateCluster(context.Background(), cfg, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(estimate.Items).To(HaveLen(1))
+		Expect(estimate.MonthlyTotal()).To(BeNumerically("~", 73, 0.001))
+	})
+
+	It("notes the nodegroups whose instance types are not known", func() {
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "selected"
+		estimate, err := estimator.EstimateNodeGroups(context.Background(), []api.NodePool{mng})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(estimate.Items).To(BeEmpty())
+		Expect(estimate.Notes).To(ContainElement(`the instance types of nodegroup "selected" are not known, its instances are not included`))
+	})
+
+	It("fails when no price is found", func() {
+		mockPrice("AmazonEC2", "instanceType", "x9.large")
+		ng := api.NewNodeGroup()
+		ng.InstanceType = "x9.large"
+		_, err := estimator.EstimateNodeGroups(context.Background(), []api.NodePool{ng})
+		Expect(err).To(MatchError(`no on-demand price of instance type "x9.large" was found in region us-west-2`))
+	})
+})
@@ -0,0 +1,174 @@
+package cost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricingtypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Service codes of the Price List API
+const (
+	serviceCodeEC2 = "AmazonEC2"
+	serviceCodeEKS = "AmazonEKS"
+)
+
+// Pricer looks up the on-demand prices of the resources eksctl creates in a region
+type Pricer struct {
+	pricingAPI awsapi.Pricing
+	region     string
+	currency   string
+	prices     map[string]float64
+}
+
+// NewPricer returns a Pricer of the resources in region
+func NewPricer(pricingAPI awsapi.Pricing, region string) *Pricer {
+	currency := "USD"
+	if api.Partition(region) == api.PartitionChina {
+		currency = "CNY"
+	}
+	return &Pricer{
+		pricingAPI: pricingAPI,
+		region:     region,
+		currency:   currency,
+		prices:     map[string]float64{},
+	}
+}
+
+// Currency returns the currency of the prices
+func (p *Pricer) Currency() string {
+	return p.currency
+}
+
+// ControlPlaneHourly returns the hourly price of an EKS cluster
+func (p *Pricer) ControlPlaneHourly(ctx context.Context) (float64, error) {
+	return p.price(ctx, "the EKS control plane", serviceCodeEKS, map[string]string{}, "AmazonEKS-Hours:perCluster")
+}
+
+// NATGatewayHourly returns the hourly price of a NAT gateway, data processing excluded
+func (p *Pricer) NATGatewayHourly(ctx context.Context) (float64, error) {
+	return p.price(ctx, "NAT gateways", serviceCodeEC2, map[string]string{
+		"productFamily": "NAT Gateway",
+	}, "NatGateway-Hours")
+}
+
+// InstanceHourly returns the on-demand hourly price of a shared instance running Linux, or Windows when windows
+// is set
+func (p *Pricer) InstanceHourly(ctx context.Context, instanceType string, windows bool) (float64, error) {
+	operatingSystem, operation := "Linux", "RunInstances"
+	if windows {
+		operatingSystem, operation = "Windows", "RunInstances:0002"
+	}
+	return p.price(ctx, fmt.Sprintf("instance type %q", instanceType), serviceCodeEC2, map[string]string{
+		"instanceType":    instanceType,
+		"operatingSystem": operatingSystem,
+		"operation":       operation,
+		"tenancy":         "Shared",
+		"preInstalledSw":  "NA",
+		"capacitystatus":  "Used",
+	}, "")
+}
+
+// VolumeMonthlyPerGB returns the monthly price of a GB of an EBS volume type
+func (p *Pricer) VolumeMonthlyPerGB(ctx context.Context, volumeType string) (float64, error) {
+	return p.price(ctx, fmt.Sprintf("%s volumes", volumeType), serviceCodeEC2, map[string]string{
+		"productFamily": "Storage",
+		"volumeApiName": volumeType,
+	}, "")
+}
+
+// price returns the on-demand price of the product matching filters in the region, whose usage type ends with
+// usageTypeSuffix when it is set
+func (p *Pricer) price(ctx context.Context, product, serviceCode string, filters map[string]string, usageTypeSuffix string) (float64, error) {
+	filters["regionCode"] = p.region
+	key := priceKey(serviceCode, filters, usageTypeSuffix)
+	if price, ok := p.prices[key]; ok {
+		return price, nil
+	}
+
+	input := &pricing.GetProductsInput{
+		ServiceCode:   aws.String(serviceCode),
+		FormatVersion: aws.String("aws_v1"),
+	}
+	for _, field := range sortedKeys(filters) {
+		input.Filters = append(input.Filters, pricingtypes.Filter{
+			Field: aws.String(field),
+			Type:  pricingtypes.FilterTypeTermMatch,
+			Value: aws.String(filters[field]),
+		})
+	}
+	paginator := pricing.NewGetProductsPaginator(p.pricingAPI, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("getting the price of %s: %w", product, err)
+		}
+		for _, priceList := range output.PriceList {
+			var item priceListItem
+			if err := json.Unmarshal([]byte(priceList), &item); err != nil {
+				return 0, fmt.Errorf("parsing the price of %s: %w", product, err)
+			}
+			if !strings.HasSuffix(item.Product.Attributes["usagetype"], usageTypeSuffix) {
+				continue
+			}
+			if price, ok := item.onDemandPrice(p.currency); ok {
+				p.prices[key] = price
+				return price, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no on-demand price of %s was found in region %s", product, p.region)
+}
+
+// priceListItem is a product of the Price List API and its terms
+type priceListItem struct {
+	Product struct {
+		Attributes map[string]string `json:"attributes"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// onDemandPrice returns the first non-zero on-demand price of the item in currency
+func (i priceListItem) onDemandPrice(currency string) (float64, bool) {
+	for _, term := range i.Terms.OnDemand {
+		for _, dimension := range term.PriceDimensions {
+			price, err := strconv.ParseFloat(dimension.PricePerUnit[currency], 64)
+			if err == nil && price > 0 {
+				return price, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func priceKey(serviceCode string, filters map[string]string, usageTypeSuffix string) string {
+	parts := []string{serviceCode, usageTypeSuffix}
+	for _, field := range sortedKeys(filters) {
+		parts = append(parts, field+"="+filters[field])
+	}
+	return strings.Join(parts, ",")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,11 @@
+package cost_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestCost(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
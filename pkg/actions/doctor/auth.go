@@ -0,0 +1,193 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	utilstrings "github.com/weaveworks/eksctl/pkg/utils/strings"
+)
+
+// stsAudience is the audience of the tokens IAM roles for service accounts exchange with STS
+const stsAudience = "sts.amazonaws.com"
+
+// checkAuth checks the identities mapped in the aws-auth ConfigMap and the access entries of the cluster, and
+// that the roles of the managed nodegroups can join the cluster
+func (d *Doctor) checkAuth(ctx context.Context) []preflight.Check {
+	mode := ekstypes.AuthenticationModeConfigMap
+	if d.cluster.AccessConfig != nil && d.cluster.AccessConfig.AuthenticationMode != "" {
+		mode = d.cluster.AccessConfig.AuthenticationMode
+	}
+
+	var (
+		checks     []preflight.Check
+		principals []string
+		// whether the principals of either the ConfigMap or the access entries could not be read
+		incomplete bool
+	)
+	if mode != ekstypes.AuthenticationModeApi {
+		mapped, configMapChecks := d.checkAuthConfigMap(mode)
+		checks = append(checks, configMapChecks...)
+		principals = append(principals, mapped...)
+		incomplete = mapped == nil
+	}
+	if mode != ekstypes.AuthenticationModeConfigMap {
+		entries, err := d.listAccessEntries(ctx)
+		if err != nil {
+			checks = append(checks, warn(KindAuth, "unable to list the access entries of cluster %q: %v", d.cfg.Metadata.Name, err))
+			incomplete = true
+		} else {
+			checks = append(checks, pass(KindAuth, "cluster %q has %d access entries", d.cfg.Metadata.Name, len(entries)))
+			principals = append(principals, entries...)
+		}
+	}
+	if incomplete && mode != ekstypes.AuthenticationModeApiAndConfigMap {
+		return checks
+	}
+
+	mappedRoles := map[string]bool{}
+	for _, principal := range principals {
+		mappedRoles[roleWithoutPath(principal)] = true
+	}
+	for _, ng := range d.nodeGroups {
+		nodeRole := aws.ToString(ng.NodeRole)
+		if nodeRole == "" || mappedRoles[roleWithoutPath(nodeRole)] {
+			continue
+		}
+		if incomplete {
+			checks = append(checks, warn(KindAuth, "node role %q of nodegroup %q was not found in the identities that could be read", nodeRole, aws.ToString(ng.NodegroupName)))
+			continue
+		}
+		checks = append(checks, fail(KindAuth, "node role %q of nodegroup %q is neither mapped in the aws-auth ConfigMap nor has an access entry, its nodes cannot join the cluster",
+			nodeRole, aws.ToString(ng.NodegroupName)))
+	}
+	return checks
+}
+
+// checkAuthConfigMap checks the identities mapped in the aws-auth ConfigMap and returns their ARNs, which are
+// nil when the ConfigMap cannot be read
+func (d *Doctor) checkAuthConfigMap(mode ekstypes.AuthenticationMode) ([]string, []preflight.Check) {
+	if d.clientSet == nil {
+		return nil, []preflight.Check{warn(KindAuth, "unable to check the aws-auth ConfigMap, the Kubernetes API server cannot be reached")}
+	}
+	client := d.clientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace)
+	cm, err := client.Get(context.TODO(), authconfigmap.ObjectName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		if mode == ekstypes.AuthenticationModeApiAndConfigMap {
+			return []string{}, nil
+		}
+		return []string{}, []preflight.Check{warn(KindAuth, "the aws-auth ConfigMap does not exist")}
+	case err != nil:
+		return nil, []preflight.Check{warn(KindAuth, "unable to get the aws-auth ConfigMap: %v", err)}
+	}
+	d.awsAuth = cm.Data
+
+	identities, err := authconfigmap.New(client, cm).GetIdentities()
+	if err != nil {
+		return nil, []preflight.Check{fail(KindAuth, "the aws-auth ConfigMap is malformed: %v", err)}
+	}
+
+	var (
+		checks []preflight.Check
+		arns   = []string{}
+		seen   = map[string]bool{}
+	)
+	for _, identity := range identities {
+		identityARN := identity.ARN()
+		if identity.Type() == "account" {
+			continue
+		}
+		parsed, err := arn.Parse(identityARN)
+		switch {
+		case err != nil:
+			checks = append(checks, fail(KindAuth, "the aws-auth ConfigMap maps invalid ARN %q", identityARN))
+			continue
+		case parsed.Service == "sts":
+			checks = append(checks, fail(KindAuth, "the aws-auth ConfigMap maps session ARN %q, map the ARN of the role instead", identityARN))
+			continue
+		case strings.Count(parsed.Resource, "/") > 1 && identity.Type() == "role":
+			checks = append(checks, warn(KindAuth, "the aws-auth ConfigMap maps role ARN %q with a path, which never matches, remove the path from the ARN", identityARN))
+		}
+		if seen[identityARN] {
+			checks = append(checks, warn(KindAuth, "the aws-auth ConfigMap maps %q more than once, only the first mapping is used", identityARN))
+			continue
+		}
+		seen[identityARN] = true
+		arns = append(arns, identityARN)
+	}
+	if len(checks) == 0 {
+		checks = append(checks, pass(KindAuth, "the aws-auth ConfigMap maps %d identities", len(arns)))
+	}
+	return arns, checks
+}
+
+func (d *Doctor) listAccessEntries(ctx context.Context) ([]string, error) {
+	var entries []string
+	paginator := eks.NewListAccessEntriesPaginator(d.provider.EKS(), &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(d.cfg.Metadata.Name),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, output.AccessEntries...)
+	}
+	return entries, nil
+}
+
+// roleWithoutPath returns the ARN of a role without its path, which is how aws-auth and EKS match roles
+func roleWithoutPath(roleARN string) string {
+	parsed, err := arn.Parse(roleARN)
+	if err != nil || !strings.HasPrefix(parsed.Resource, "role/") {
+		return roleARN
+	}
+	parsed.Resource = "role/" + parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
+	return parsed.String()
+}
+
+// checkOIDC checks that the IAM OIDC provider of the cluster exists and trusts the tokens of its service accounts
+func (d *Doctor) checkOIDC(ctx context.Context) []preflight.Check {
+	name := d.cfg.Metadata.Name
+	if d.cluster.Identity == nil || d.cluster.Identity.Oidc == nil || aws.ToString(d.cluster.Identity.Oidc.Issuer) == "" {
+		return []preflight.Check{warn(KindOIDC, "cluster %q has no OIDC issuer", name)}
+	}
+	clusterARN, err := arn.Parse(aws.ToString(d.cluster.Arn))
+	if err != nil {
+		return []preflight.Check{warn(KindOIDC, "unable to parse the ARN of cluster %q: %v", name, err)}
+	}
+	issuer := strings.TrimPrefix(aws.ToString(d.cluster.Identity.Oidc.Issuer), "https://")
+	providerARN := fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", clusterARN.Partition, clusterARN.AccountID, issuer)
+
+	output, err := d.provider.IAM().GetOpenIDConnectProvider(ctx, &iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(providerARN),
+	})
+	var notFoundErr *iamtypes.NoSuchEntityException
+	switch {
+	case errors.As(err, &notFoundErr):
+		return []preflight.Check{warn(KindOIDC, "cluster %q has no IAM OIDC provider, IAM roles for service accounts cannot be used; "+
+			"run 'eksctl utils associate-iam-oidc-provider' to create it", name)}
+	case err != nil:
+		return []preflight.Check{warn(KindOIDC, "unable to get the IAM OIDC provider of cluster %q: %v", name, err)}
+	}
+	if !utilstrings.Contains(output.ClientIDList, stsAudience) {
+		return []preflight.Check{fail(KindOIDC, "IAM OIDC provider %q does not list %q as an audience, IAM roles for service accounts cannot be assumed", providerARN, stsAudience)}
+	}
+	if aws.ToString(output.Url) != "" && strings.TrimPrefix(aws.ToString(output.Url), "https://") != issuer {
+		return []preflight.Check{fail(KindOIDC, "IAM OIDC provider %q has URL %q instead of the issuer of the cluster %q", providerARN, aws.ToString(output.Url), issuer)}
+	}
+	return []preflight.Check{pass(KindOIDC, "IAM OIDC provider %q is valid", providerARN)}
+}
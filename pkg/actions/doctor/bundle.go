@@ -0,0 +1,63 @@
+package doctor
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+)
+
+// redactedAccountID replaces the AWS account IDs in the support bundle
+const redactedAccountID = "ACCOUNT_ID"
+
+// accountIDPattern matches AWS account IDs, in ARNs and on their own
+var accountIDPattern = regexp.MustCompile(`\b[0-9]{12}\b`)
+
+// WriteBundle writes a support bundle to w, a gzipped tarball of the result of the checks and of what they found
+// about the cluster, its nodegroups, addons, failed stacks and aws-auth ConfigMap. Account IDs are redacted, and
+// the certificate authority data of the cluster and the resource properties of the stack events are left out.
+func (d *Doctor) WriteBundle(w io.Writer, result *Result) error {
+	cluster := *d.cluster
+	cluster.CertificateAuthority = nil
+
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{"results.json", result},
+		{"cluster.json", cluster},
+		{"nodegroups.json", d.nodeGroups},
+		{"addons.json", d.addons},
+		{"stack-events.json", d.stackEvents},
+		{"aws-auth.json", d.awsAuth},
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	now := time.Now()
+	for _, f := range files {
+		data, err := json.MarshalIndent(f.content, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding %s: %w", f.name, err)
+		}
+		data = accountIDPattern.ReplaceAll(data, []byte(redactedAccountID))
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Name:    f.name,
+			Mode:    0600,
+			Size:    int64(len(data)),
+			ModTime: now,
+		}); err != nil {
+			return fmt.Errorf("writing %s: %w", f.name, err)
+		}
+		if _, err := tarWriter.Write(data); err != nil {
+			return fmt.Errorf("writing %s: %w", f.name, err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
@@ -0,0 +1,247 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// checkControlPlane checks the status and the health issues of the control plane
+func (d *Doctor) checkControlPlane() []preflight.Check {
+	name := d.cfg.Metadata.Name
+	var checks []preflight.Check
+	switch status := d.cluster.Status; status {
+	case ekstypes.ClusterStatusActive:
+		checks = append(checks, pass(KindControlPlane, "cluster %q is %s, running Kubernetes %s", name, status, aws.ToString(d.cluster.Version)))
+	case ekstypes.ClusterStatusFailed:
+		checks = append(checks, fail(KindControlPlane, "cluster %q is %s", name, status))
+	default:
+		checks = append(checks, warn(KindControlPlane, "cluster %q is %s", name, status))
+	}
+	if d.cluster.Health != nil {
+		for _, issue := range d.cluster.Health.Issues {
+			checks = append(checks, fail(KindControlPlane, "cluster %q has a health issue: %s", name,
+				formatIssue(string(issue.Code), issue.Message, issue.ResourceIds)))
+		}
+	}
+	return checks
+}
+
+// checkNodeGroups checks the status and the health issues of the managed nodegroups
+func (d *Doctor) checkNodeGroups(ctx context.Context) []preflight.Check {
+	name := d.cfg.Metadata.Name
+	var checks []preflight.Check
+	paginator := eks.NewListNodegroupsPaginator(d.provider.EKS(), &eks.ListNodegroupsInput{
+		ClusterName: aws.String(name),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return append(checks, warn(KindNodeGroup, "unable to list the managed nodegroups of cluster %q: %v", name, err))
+		}
+		for _, ngName := range output.Nodegroups {
+			ngOutput, err := d.provider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String(name),
+				NodegroupName: aws.String(ngName),
+			})
+			if err != nil {
+				checks = append(checks, warn(KindNodeGroup, "unable to describe nodegroup %q: %v", ngName, err))
+				continue
+			}
+			ng := ngOutput.Nodegroup
+			d.nodeGroups = append(d.nodeGroups, ng)
+
+			var issues []string
+			if ng.Health != nil {
+				for _, issue := range ng.Health.Issues {
+					issues = append(issues, formatIssue(string(issue.Code), issue.Message, issue.ResourceIds))
+				}
+			}
+			switch {
+			case len(issues) > 0:
+				checks = append(checks, fail(KindNodeGroup, "nodegroup %q is %s with health issues: %s", ngName, ng.Status, strings.Join(issues, "; ")))
+			case ng.Status == ekstypes.NodegroupStatusActive:
+				checks = append(checks, pass(KindNodeGroup, "nodegroup %q is %s", ngName, ng.Status))
+			case ng.Status == ekstypes.NodegroupStatusCreateFailed, ng.Status == ekstypes.NodegroupStatusDeleteFailed,
+				ng.Status == ekstypes.NodegroupStatusDegraded:
+				checks = append(checks, fail(KindNodeGroup, "nodegroup %q is %s", ngName, ng.Status))
+			default:
+				checks = append(checks, warn(KindNodeGroup, "nodegroup %q is %s", ngName, ng.Status))
+			}
+		}
+	}
+	return checks
+}
+
+// checkStacks checks that no stack of the cluster failed, and reports the events of the resources that made
+// the failed ones fail
+func (d *Doctor) checkStacks(ctx context.Context) []preflight.Check {
+	name := d.cfg.Metadata.Name
+	prefix := fmt.Sprintf("eksctl-%s-", name)
+	var checks []preflight.Check
+	paginator := cloudformation.NewListStacksPaginator(d.provider.CloudFormation(), &cloudformation.ListStacksInput{
+		StackStatusFilter: failedStackStatuses,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return append(checks, warn(KindStack, "unable to list the stacks of cluster %q: %v", name, err))
+		}
+		for _, stack := range output.StackSummaries {
+			stackName := aws.ToString(stack.StackName)
+			// the prefix of a cluster is also the one of the clusters whose name starts with its name and a dash
+			if !strings.HasPrefix(stackName, prefix) {
+				continue
+			}
+			owned, err := d.isStackOfCluster(ctx, stack)
+			if err != nil {
+				checks = append(checks, warn(KindStack, "unable to describe stack %q: %v", stackName, err))
+				continue
+			}
+			if !owned {
+				continue
+			}
+			check := fail
+			// the update was rolled back, the resources of the stack are as before the update
+			if stack.StackStatus == cfntypes.StackStatusUpdateRollbackComplete {
+				check = warn
+			}
+			reason, err := d.failureReason(ctx, stack)
+			if err != nil {
+				checks = append(checks, check(KindStack, "stack %q is %s, unable to describe its events: %v", stackName, stack.StackStatus, err))
+				continue
+			}
+			checks = append(checks, check(KindStack, "stack %q is %s: %s", stackName, stack.StackStatus, reason))
+		}
+	}
+	if len(checks) == 0 {
+		checks = append(checks, pass(KindStack, "no stack of cluster %q failed", name))
+	}
+	return checks
+}
+
+// isStackOfCluster reports whether the cluster name tag of stack is the name of the cluster
+func (d *Doctor) isStackOfCluster(ctx context.Context, stack cfntypes.StackSummary) (bool, error) {
+	output, err := d.provider.CloudFormation().DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: stack.StackId,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, s := range output.Stacks {
+		for _, tag := range s.Tags {
+			switch aws.ToString(tag.Key) {
+			case api.ClusterNameTag, api.OldClusterNameTag:
+				return aws.ToString(tag.Value) == d.cfg.Metadata.Name, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// failureReason returns the reason of the earliest failed resource event of a stack, which is usually what made
+// the stack fail
+func (d *Doctor) failureReason(ctx context.Context, stack cfntypes.StackSummary) (string, error) {
+	var events []cfntypes.StackEvent
+	paginator := cloudformation.NewDescribeStackEventsPaginator(d.provider.CloudFormation(), &cloudformation.DescribeStackEventsInput{
+		StackName: stack.StackId,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, event := range output.StackEvents {
+			// the properties of the resources may hold secrets, they are left out of the support bundle
+			event.ResourceProperties = nil
+			events = append(events, event)
+		}
+	}
+	d.stackEvents[aws.ToString(stack.StackName)] = events
+
+	reason := aws.ToString(stack.StackStatusReason)
+	// events are returned from the most recent one
+	for _, event := range events {
+		if strings.HasSuffix(string(event.ResourceStatus), "_FAILED") && aws.ToString(event.PhysicalResourceId) != aws.ToString(stack.StackId) {
+			reason = fmt.Sprintf("%s %s: %s", aws.ToString(event.LogicalResourceId), event.ResourceStatus, aws.ToString(event.ResourceStatusReason))
+		}
+	}
+	if reason == "" {
+		reason = "no failed resource was found in its events"
+	}
+	return reason, nil
+}
+
+// failedStackStatuses are the statuses of the stacks whose creation, update or deletion failed
+var failedStackStatuses = []cfntypes.StackStatus{
+	cfntypes.StackStatusCreateFailed,
+	cfntypes.StackStatusRollbackFailed,
+	cfntypes.StackStatusRollbackComplete,
+	cfntypes.StackStatusDeleteFailed,
+	cfntypes.StackStatusUpdateRollbackFailed,
+	cfntypes.StackStatusUpdateRollbackComplete,
+}
+
+// checkAddons checks the status and the health issues of the addons
+func (d *Doctor) checkAddons(ctx context.Context) []preflight.Check {
+	name := d.cfg.Metadata.Name
+	var checks []preflight.Check
+	paginator := eks.NewListAddonsPaginator(d.provider.EKS(), &eks.ListAddonsInput{
+		ClusterName: aws.String(name),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return append(checks, warn(KindAddon, "unable to list the addons of cluster %q: %v", name, err))
+		}
+		for _, addonName := range output.Addons {
+			addonOutput, err := d.provider.EKS().DescribeAddon(ctx, &eks.DescribeAddonInput{
+				ClusterName: aws.String(name),
+				AddonName:   aws.String(addonName),
+			})
+			if err != nil {
+				checks = append(checks, warn(KindAddon, "unable to describe addon %q: %v", addonName, err))
+				continue
+			}
+			addon := addonOutput.Addon
+			d.addons = append(d.addons, addon)
+
+			var issues []string
+			if addon.Health != nil {
+				for _, issue := range addon.Health.Issues {
+					issues = append(issues, formatIssue(string(issue.Code), issue.Message, issue.ResourceIds))
+				}
+			}
+			switch addon.Status {
+			case ekstypes.AddonStatusActive:
+				checks = append(checks, pass(KindAddon, "addon %q %s is %s", addonName, aws.ToString(addon.AddonVersion), addon.Status))
+			case ekstypes.AddonStatusDegraded, ekstypes.AddonStatusCreateFailed, ekstypes.AddonStatusUpdateFailed, ekstypes.AddonStatusDeleteFailed:
+				if len(issues) == 0 {
+					checks = append(checks, fail(KindAddon, "addon %q is %s", addonName, addon.Status))
+				} else {
+					checks = append(checks, fail(KindAddon, "addon %q is %s with health issues: %s", addonName, addon.Status, strings.Join(issues, "; ")))
+				}
+			default:
+				checks = append(checks, warn(KindAddon, "addon %q is %s", addonName, addon.Status))
+			}
+		}
+	}
+	return checks
+}
+
+func formatIssue(code string, message *string, resourceIDs []string) string {
+	issue := fmt.Sprintf("%s: %s", code, aws.ToString(message))
+	if len(resourceIDs) > 0 {
+		issue += fmt.Sprintf(" (%s)", strings.Join(resourceIDs, ", "))
+	}
+	return issue
+}
@@ -0,0 +1,100 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Kinds of checks
+const (
+	KindControlPlane = "control-plane"
+	KindNodeGroup    = "nodegroup"
+	KindStack        = "stack"
+	KindAuth         = "auth"
+	KindOIDC         = "oidc"
+	KindAddon        = "addon"
+)
+
+// Result holds the outcome of the checks of a cluster
+type Result struct {
+	Cluster string
+	Healthy bool
+	Checks  []preflight.Check
+}
+
+// Doctor diagnoses the health of an existing cluster, its nodegroups, stacks, authentication and addons
+type Doctor struct {
+	cfg       *api.ClusterConfig
+	provider  api.ClusterProvider
+	cluster   *ekstypes.Cluster
+	clientSet kubernetes.Interface
+
+	// what the checks found, written to the support bundle
+	nodeGroups  []*ekstypes.Nodegroup
+	addons      []*ekstypes.Addon
+	stackEvents map[string][]cfntypes.StackEvent
+	awsAuth     map[string]string
+}
+
+// NewDoctor returns a Doctor of cluster, the described cluster of cfg. clientSet may be nil when the API server
+// cannot be reached, in which case the checks that need it are skipped with a warning.
+func NewDoctor(cfg *api.ClusterConfig, provider api.ClusterProvider, cluster *ekstypes.Cluster, clientSet kubernetes.Interface) *Doctor {
+	return &Doctor{
+		cfg:         cfg,
+		provider:    provider,
+		cluster:     cluster,
+		clientSet:   clientSet,
+		stackEvents: map[string][]cfntypes.StackEvent{},
+	}
+}
+
+// Run runs all the checks, the cluster is not healthy if any of them failed
+func (d *Doctor) Run(ctx context.Context) *Result {
+	result := &Result{
+		Cluster: d.cfg.Metadata.Name,
+		Healthy: true,
+	}
+	for _, checks := range [][]preflight.Check{
+		d.checkControlPlane(),
+		d.checkNodeGroups(ctx),
+		d.checkStacks(ctx),
+		d.checkAuth(ctx),
+		d.checkOIDC(ctx),
+		d.checkAddons(ctx),
+	} {
+		result.Checks = append(result.Checks, checks...)
+	}
+	for _, check := range result.Checks {
+		if check.Status == preflight.StatusFail {
+			result.Healthy = false
+		}
+	}
+	return result
+}
+
+func newCheck(kind string, status preflight.Status, format string, args ...interface{}) preflight.Check {
+	return preflight.Check{
+		Kind:    kind,
+		Status:  status,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func pass(kind, format string, args ...interface{}) preflight.Check {
+	return newCheck(kind, preflight.StatusPass, format, args...)
+}
+
+func warn(kind, format string, args ...interface{}) preflight.Check {
+	return newCheck(kind, preflight.StatusWarn, format, args...)
+}
+
+func fail(kind, format string, args ...interface{}) preflight.Check {
+	return newCheck(kind, preflight.StatusFail, format, args...)
+}
@@ -0,0 +1,363 @@
+package doctor_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/doctor"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Doctor", func() {
+	const (
+		clusterName = "doctor"
+		accountID   = "111122223333"
+		nodeRole    = "arn:aws:iam::111122223333:role/eksctl-doctor-nodegroup-workers-NodeInstanceRole"
+		issuer      = "https://oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE"
+		providerARN = "arn:aws:iam::111122223333:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE"
+	)
+
+	var (
+		p         *mockprovider.MockProvider
+		cfg       *api.ClusterConfig
+		cluster   *ekstypes.Cluster
+		awsAuth   *corev1.ConfigMap
+		nodegroup *ekstypes.Nodegroup
+		addon     *ekstypes.Addon
+		stacks    []cfntypes.StackSummary
+	)
+
+	checksOfKind := func(result *doctor.Result, kind string) []preflight.Check {
+		var checks []preflight.Check
+		for _, check := range result.Checks {
+			if check.Kind == kind {
+				checks = append(checks, check)
+			}
+		}
+		return checks
+	}
+
+	run := func() (*doctor.Doctor, *doctor.Result) {
+		p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListNodegroupsOutput{
+			Nodegroups: []string{aws.ToString(nodegroup.NodegroupName)},
+		}, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: nodegroup,
+		}, nil)
+		p.MockEKS().On("ListAddons", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListAddonsOutput{
+			Addons: []string{aws.ToString(addon.AddonName)},
+		}, nil)
+		p.MockEKS().On("DescribeAddon", mock.Anything, mock.Anything).Return(&eks.DescribeAddonOutput{
+			Addon: addon,
+		}, nil)
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStacksOutput{
+			StackSummaries: stacks,
+		}, nil)
+
+		var d *doctor.Doctor
+		if awsAuth != nil {
+			d = doctor.NewDoctor(cfg, p, cluster, fake.NewSimpleClientset(awsAuth))
+		} else {
+			d = doctor.NewDoctor(cfg, p, cluster, fake.NewSimpleClientset())
+		}
+		return d, d.Run(context.Background())
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+
+		cluster = &ekstypes.Cluster{
+			Name:    aws.String(clusterName),
+			Arn:     aws.String("arn:aws:eks:us-west-2:111122223333:cluster/doctor"),
+			Status:  ekstypes.ClusterStatusActive,
+			Version: aws.String("1.30"),
+			AccessConfig: &ekstypes.AccessConfigResponse{
+				AuthenticationMode: ekstypes.AuthenticationModeConfigMap,
+			},
+			Identity: &ekstypes.Identity{
+				Oidc: &ekstypes.OIDC{Issuer: aws.String(issuer)},
+			},
+			CertificateAuthority: &ekstypes.Certificate{Data: aws.String("Q0VSVElGSUNBVEU=")},
+		}
+		awsAuth = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-auth", Namespace: "kube-system"},
+			Data: map[string]string{
+				"mapRoles": "- rolearn: " + nodeRole + "\n  username: system:node:{{EC2PrivateDNSName}}\n  groups:\n  - system:nodes\n",
+			},
+		}
+		nodegroup = &ekstypes.Nodegroup{
+			NodegroupName: aws.String("workers"),
+			Status:        ekstypes.NodegroupStatusActive,
+			NodeRole:      aws.String(nodeRole),
+		}
+		addon = &ekstypes.Addon{
+			AddonName:    aws.String("vpc-cni"),
+			AddonVersion: aws.String("v1.18.0-eksbuild.1"),
+			Status:       ekstypes.AddonStatusActive,
+		}
+		stacks = []cfntypes.StackSummary{
+			{StackName: aws.String("eksctl-other-nodegroup-workers"), StackStatus: cfntypes.StackStatusRollbackComplete},
+		}
+
+		p.MockIAM().On("GetOpenIDConnectProvider", mock.Anything, &iam.GetOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerARN),
+		}).Return(&iam.GetOpenIDConnectProviderOutput{
+			Url:          aws.String("oidc.eks.us-west-2.amazonaws.com/id/EXAMPLE"),
+			ClientIDList: []string{"sts.amazonaws.com"},
+		}, nil)
+	})
+
+	It("reports a healthy cluster", func() {
+		_, result := run()
+		Expect(result.Healthy).To(BeTrue())
+		for _, check := range result.Checks {
+			Expect(check.Status).To(Equal(preflight.StatusPass), check.Message)
+		}
+		Expect(checksOfKind(result, doctor.KindStack)).To(ConsistOf(preflight.Check{
+			Kind:    doctor.KindStack,
+			Status:  preflight.StatusPass,
+			Message: `no stack of cluster "doctor" failed`,
+		}))
+	})
+
+	It("fails on the health issues of the control plane, nodegroups and addons", func() {
+		cluster.Health = &ekstypes.ClusterHealth{
+			Issues: []ekstypes.ClusterIssue{{Code: ekstypes.ClusterIssueCodeVpcNotFound, Message: aws.String("VPC was deleted"), ResourceIds: []string{"vpc-1"}}},
+		}
+		nodegroup.Status = ekstypes.NodegroupStatusDegraded
+		nodegroup.Health = &ekstypes.NodegroupHealth{
+			Issues: []ekstypes.Issue{{Code: ekstypes.NodegroupIssueCodeAsgInstanceLaunchFailures, Message: aws.String("no capacity")}},
+		}
+		addon.Status = ekstypes.AddonStatusDegraded
+		addon.Health = &ekstypes.AddonHealth{
+			Issues: []ekstypes.AddonIssue{{Code: ekstypes.AddonIssueCodeInsufficientNumberOfReplicas, Message: aws.String("pods are pending")}},
+		}
+
+		_, result := run()
+		Expect(result.Healthy).To(BeFalse())
+		Expect(checksOfKind(result, doctor.KindControlPlane)).To(ContainElement(preflight.Check{
+			Kind:    doctor.KindControlPlane,
+			Status:  preflight.StatusFail,
+			Message: `cluster "doctor" has a health issue: VpcNotFound: VPC was deleted (vpc-1)`,
+		}))
+		Expect(checksOfKind(result, doctor.KindNodeGroup)).To(ConsistOf(preflight.Check{
+			Kind:    doctor.KindNodeGroup,
+			Status:  preflight.StatusFail,
+			Message: `nodegroup "workers" is DEGRADED with health issues: AsgInstanceLaunchFailures: no capacity`,
+		}))
+		Expect(checksOfKind(result, doctor.KindAddon)).To(ConsistOf(preflight.Check{
+			Kind:    doctor.KindAddon,
+			Status:  preflight.StatusFail,
+			Message: `addon "vpc-cni" is DEGRADED with health issues: InsufficientNumberOfReplicas: pods are pending`,
+		}))
+	})
+
+	It("reports the earliest failed resource of the failed stacks", func() {
+		stacks = append(stacks, cfntypes.StackSummary{
+			StackName:   aws.String("eksctl-doctor-nodegroup-workers"),
+			StackId:     aws.String("stack-id"),
+			StackStatus: cfntypes.StackStatusRollbackComplete,
+		}, cfntypes.StackSummary{
+			StackName:   aws.String("eksctl-doctor-2-cluster"),
+			StackId:     aws.String("other-stack-id"),
+			StackStatus: cfntypes.StackStatusRollbackComplete,
+		})
+		mockStackTags := func(stackID, clusterName string) {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything, &cloudformation.DescribeStacksInput{
+				StackName: aws.String(stackID),
+			}).Return(&cloudformation.DescribeStacksOutput{
+				Stacks: []cfntypes.Stack{{
+					StackId: aws.String(stackID),
+					Tags:    []cfntypes.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)}},
+				}},
+			}, nil)
+		}
+		mockStackTags("stack-id", clusterName)
+		// the failed stack of cluster doctor-2 is not reported
+		mockStackTags("other-stack-id", "doctor-2")
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, &cloudformation.DescribeStackEventsInput{
+			StackName: aws.String("stack-id"),
+		}).Return(&cloudformation.DescribeStackEventsOutput{
+			StackEvents: []cfntypes.StackEvent{
+				{LogicalResourceId: aws.String("eksctl-doctor-nodegroup-workers"), PhysicalResourceId: aws.String("stack-id"), ResourceStatus: cfntypes.ResourceStatusRollbackComplete},
+				{LogicalResourceId: aws.String("NodeGroup"), ResourceStatus: cfntypes.ResourceStatusCreateFailed, ResourceStatusReason: aws.String("Resource creation cancelled")},
+			},
+			NextToken: aws.String("page-2"),
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackEvents", mock.Anything, &cloudformation.DescribeStackEventsInput{
+			StackName: aws.String("stack-id"),
+			NextToken: aws.String("page-2"),
+		}).Return(&cloudformation.DescribeStackEventsOutput{
+			StackEvents: []cfntypes.StackEvent{
+				{
+					LogicalResourceId:    aws.String("NodeInstanceRole"),
+					ResourceStatus:       cfntypes.ResourceStatusCreateFailed,
+					ResourceStatusReason: aws.String("not authorized to perform iam:CreateRole"),
+					ResourceProperties:   aws.String(`{"Password":"hunter2"}`),
+				},
+			},
+		}, nil)
+
+		d, result := run()
+		Expect(result.Healthy).To(BeFalse())
+		Expect(checksOfKind(result, doctor.KindStack)).To(ConsistOf(preflight.Check{
+			Kind:    doctor.KindStack,
+			Status:  preflight.StatusFail,
+			Message: `stack "eksctl-doctor-nodegroup-workers" is ROLLBACK_COMPLETE: NodeInstanceRole CREATE_FAILED: not authorized to perform iam:CreateRole`,
+		}))
+
+		var buf bytes.Buffer
+		Expect(d.WriteBundle(&buf, result)).To(Succeed())
+		Expect(bundleFiles(&buf)["stack-events.json"]).NotTo(ContainSubstring("hunter2"))
+	})
+
+	It("fails on malformed and session ARNs in the aws-auth ConfigMap and unmapped node roles", func() {
+		awsAuth.Data["mapRoles"] = "- rolearn: arn:aws:sts::111122223333:assumed-role/admin/session\n  username: admin\n"
+
+		_, result := run()
+		Expect(result.Healthy).To(BeFalse())
+		Expect(checksOfKind(result, doctor.KindAuth)).To(ConsistOf(
+			preflight.Check{
+				Kind:    doctor.KindAuth,
+				Status:  preflight.StatusFail,
+				Message: `the aws-auth ConfigMap maps session ARN "arn:aws:sts::111122223333:assumed-role/admin/session", map the ARN of the role instead`,
+			},
+			preflight.Check{
+				Kind:   doctor.KindAuth,
+				Status: preflight.StatusFail,
+				Message: `node role "` + nodeRole + `" of nodegroup "workers" is neither mapped in the aws-auth ConfigMap nor has an access entry, ` +
+					`its nodes cannot join the cluster`,
+			},
+		))
+	})
+
+	It("fails when the aws-auth ConfigMap cannot be parsed", func() {
+		awsAuth.Data["mapRoles"] = "rolearn: ["
+
+		_, result := run()
+		auth := checksOfKind(result, doctor.KindAuth)
+		Expect(auth).To(HaveLen(1))
+		Expect(auth[0].Status).To(Equal(preflight.StatusFail))
+		Expect(auth[0].Message).To(HavePrefix("the aws-auth ConfigMap is malformed"))
+	})
+
+	It("finds the node roles in the access entries", func() {
+		cluster.AccessConfig.AuthenticationMode = ekstypes.AuthenticationModeApi
+		p.MockEKS().On("ListAccessEntries", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListAccessEntriesOutput{
+			AccessEntries: []string{nodeRole},
+		}, nil)
+
+		_, result := run()
+		Expect(result.Healthy).To(BeTrue())
+		Expect(checksOfKind(result, doctor.KindAuth)).To(ConsistOf(preflight.Check{
+			Kind:    doctor.KindAuth,
+			Status:  preflight.StatusPass,
+			Message: `cluster "doctor" has 1 access entries`,
+		}))
+	})
+
+	It("warns when the API server cannot be reached", func() {
+		d := doctor.NewDoctor(cfg, p, cluster, nil)
+		p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListNodegroupsOutput{}, nil)
+		p.MockEKS().On("ListAddons", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListAddonsOutput{}, nil)
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStacksOutput{}, nil)
+
+		result := d.Run(context.Background())
+		Expect(result.Healthy).To(BeTrue())
+		Expect(checksOfKind(result, doctor.KindAuth)).To(ConsistOf(preflight.Check{
+			Kind:    doctor.KindAuth,
+			Status:  preflight.StatusWarn,
+			Message: "unable to check the aws-auth ConfigMap, the Kubernetes API server cannot be reached",
+		}))
+	})
+
+	It("warns when the OIDC provider does not exist and fails when it does not trust STS", func() {
+		p.MockIAM().ExpectedCalls = nil
+		p.MockIAM().On("GetOpenIDConnectProvider", mock.Anything, mock.Anything).Return(nil, &iamtypes.NoSuchEntityException{}).Once()
+		_, result := run()
+		oidc := checksOfKind(result, doctor.KindOIDC)
+		Expect(oidc).To(HaveLen(1))
+		Expect(oidc[0].Status).To(Equal(preflight.StatusWarn))
+
+		p.MockIAM().On("GetOpenIDConnectProvider", mock.Anything, mock.Anything).Return(&iam.GetOpenIDConnectProviderOutput{
+			ClientIDList: []string{"other"},
+		}, nil)
+		result = doctor.NewDoctor(cfg, p, cluster, fake.NewSimpleClientset(awsAuth)).Run(context.Background())
+		Expect(result.Healthy).To(BeFalse())
+		Expect(checksOfKind(result, doctor.KindOIDC)).To(ConsistOf(preflight.Check{
+			Kind:    doctor.KindOIDC,
+			Status:  preflight.StatusFail,
+			Message: `IAM OIDC provider "` + providerARN + `" does not list "sts.amazonaws.com" as an audience, IAM roles for service accounts cannot be assumed`,
+		}))
+	})
+
+	It("warns when the AWS APIs fail", func() {
+		p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("throttled"))
+		_, result := run()
+		Expect(checksOfKind(result, doctor.KindNodeGroup)).To(ConsistOf(preflight.Check{
+			Kind:    doctor.KindNodeGroup,
+			Status:  preflight.StatusWarn,
+			Message: `unable to list the managed nodegroups of cluster "doctor": throttled`,
+		}))
+	})
+
+	It("writes a redacted support bundle", func() {
+		d, result := run()
+		var buf bytes.Buffer
+		Expect(d.WriteBundle(&buf, result)).To(Succeed())
+		files := bundleFiles(&buf)
+
+		Expect(files).To(HaveKey("results.json"))
+		Expect(files).To(HaveKey("nodegroups.json"))
+		Expect(files).To(HaveKey("addons.json"))
+		Expect(files).To(HaveKey("stack-events.json"))
+		Expect(files["cluster.json"]).To(ContainSubstring("arn:aws:eks:us-west-2:ACCOUNT_ID:cluster/doctor"))
+		Expect(files["cluster.json"]).NotTo(ContainSubstring("Q0VSVElGSUNBVEU="))
+		Expect(files["aws-auth.json"]).To(ContainSubstring("arn:aws:iam::ACCOUNT_ID:role/"))
+		for name, content := range files {
+			Expect(content).NotTo(ContainSubstring(accountID), name)
+		}
+	})
+})
+
+// bundleFiles returns the content of the files of a support bundle by name
+func bundleFiles(r io.Reader) map[string]string {
+	gzipReader, err := gzip.NewReader(r)
+	Expect(err).NotTo(HaveOccurred())
+	tarReader := tar.NewReader(gzipReader)
+	files := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).NotTo(HaveOccurred())
+		data, err := io.ReadAll(tarReader)
+		Expect(err).NotTo(HaveOccurred())
+		files[header.Name] = string(data)
+	}
+	return files
+}
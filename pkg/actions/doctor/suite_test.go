@@ -0,0 +1,11 @@
+package doctor_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestDoctor(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,345 @@
+package ebscsi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/kris-nova/logger"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// Namespace is the namespace of the EBS CSI controller
+	Namespace = "kube-system"
+	// ServiceAccountName is the name of the service account used by the EBS CSI controller
+	ServiceAccountName = "ebs-csi-controller-sa"
+	// GP3StorageClassName is the name of the gp3 StorageClass
+	GP3StorageClassName = "gp3"
+
+	provisioner            = "ebs.csi.aws.com"
+	defaultClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+// AddonCreator creates EKS addons
+type AddonCreator interface {
+	Create(ctx context.Context, addon *api.Addon, waitTimeout time.Duration) error
+}
+
+// Enabler installs the EBS CSI driver addon on an existing cluster
+type Enabler struct {
+	cfg          *api.ClusterConfig
+	stackManager manager.StackManager
+	kmsAPI       awsapi.KMS
+	addonCreator AddonCreator
+	oidc         *iamoidc.OpenIDConnectManager
+	clientSet    kubeclient.Interface
+}
+
+// NewEnabler creates a new Enabler
+func NewEnabler(cfg *api.ClusterConfig, stackManager manager.StackManager, kmsAPI awsapi.KMS, addonCreator AddonCreator,
+	oidc *iamoidc.OpenIDConnectManager, clientSet kubeclient.Interface) *Enabler {
+	return &Enabler{
+		cfg:          cfg,
+		stackManager: stackManager,
+		kmsAPI:       kmsAPI,
+		addonCreator: addonCreator,
+		oidc:         oidc,
+		clientSet:    clientSet,
+	}
+}
+
+// Options defines options for Enable
+type Options struct {
+	// AccountID is the ID of the account owning the cluster
+	AccountID string
+	// KMSKeyID is the ID, ARN or alias of the KMS key used to encrypt volumes
+	KMSKeyID string
+	// DefaultStorageClassGP3 replaces the default StorageClass with a gp3 StorageClass
+	DefaultStorageClassGP3 bool
+	// WaitTimeout is the time to wait for the addon to become active
+	WaitTimeout time.Duration
+	// Plan only logs the intended changes
+	Plan bool
+}
+
+// Enable creates the IAM role for the EBS CSI controller, installs the aws-ebs-csi-driver addon and
+// optionally makes gp3 the default StorageClass. When a KMS key is supplied, the key is validated
+// and the role is allowed to use it for encrypted volumes.
+func (e *Enabler) Enable(ctx context.Context, opts Options) error {
+	var keyARN string
+	if opts.KMSKeyID != "" {
+		var err error
+		if keyARN, err = e.validateKMSKey(ctx, opts.KMSKeyID, opts.AccountID); err != nil {
+			return err
+		}
+	}
+
+	roleARN, err := e.createRole(ctx, keyARN, opts.Plan)
+	if err != nil {
+		return err
+	}
+
+	if opts.Plan {
+		logger.Info("(plan) would create addon %q", api.AWSEBSCSIDriverAddon)
+	} else if err := e.addonCreator.Create(ctx, &api.Addon{
+		Name:                  api.AWSEBSCSIDriverAddon,
+		ServiceAccountRoleARN: roleARN,
+	}, opts.WaitTimeout); err != nil {
+		return err
+	}
+
+	if opts.DefaultStorageClassGP3 {
+		return e.makeGP3Default(ctx, keyARN, opts.Plan)
+	}
+	return nil
+}
+
+// validateKMSKey checks that the key can be used to encrypt EBS volumes, and that its key policy lets IAM
+// policies in the cluster account grant access to it, and returns the key ARN.
+func (e *Enabler) validateKMSKey(ctx context.Context, keyID, accountID string) (string, error) {
+	output, err := e.kmsAPI.DescribeKey(ctx, &kms.DescribeKeyInput{
+		KeyId: aws.String(keyID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describing KMS key %q: %w", keyID, err)
+	}
+	key := output.KeyMetadata
+	keyARN := aws.ToString(key.Arn)
+	if !key.Enabled {
+		return "", fmt.Errorf("KMS key %q is not enabled (state: %s)", keyARN, key.KeyState)
+	}
+	if key.KeyUsage != kmstypes.KeyUsageTypeEncryptDecrypt || key.KeySpec != kmstypes.KeySpecSymmetricDefault {
+		return "", fmt.Errorf("KMS key %q must be a symmetric encryption key to encrypt EBS volumes", keyARN)
+	}
+
+	if aws.ToString(key.AWSAccountId) != accountID {
+		logger.Warning("KMS key %q is owned by account %s, make sure its key policy allows account %s to use it", keyARN, aws.ToString(key.AWSAccountId), accountID)
+		return keyARN, nil
+	}
+	policy, err := e.kmsAPI.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{
+		KeyId:      aws.String(keyARN),
+		PolicyName: aws.String("default"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("getting key policy of KMS key %q: %w", keyARN, err)
+	}
+	parsedARN, err := arn.Parse(keyARN)
+	if err != nil {
+		return "", fmt.Errorf("unexpected invalid KMS key ARN %q: %w", keyARN, err)
+	}
+	allowed, err := keyPolicyAllowsIAMPolicies(aws.ToString(policy.Policy), parsedARN.Partition, accountID)
+	if err != nil {
+		return "", fmt.Errorf("parsing key policy of KMS key %q: %w", keyARN, err)
+	}
+	if !allowed {
+		return "", fmt.Errorf("the key policy of KMS key %q does not allow IAM policies in account %s to grant access to the key, "+
+			"see https://docs.aws.amazon.com/kms/latest/developerguide/key-policy-default.html#key-policy-default-allow-root-enable-iam", keyARN, accountID)
+	}
+	return keyARN, nil
+}
+
+type keyPolicyStatement struct {
+	Effect    string
+	Principal interface{}
+	Action    interface{}
+}
+
+// keyPolicyAllowsIAMPolicies reports whether the key policy allows the account to delegate access to the key to IAM policies.
+func keyPolicyAllowsIAMPolicies(policy, partition, accountID string) (bool, error) {
+	var document struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return false, err
+	}
+	var statements []keyPolicyStatement
+	if err := json.Unmarshal(document.Statement, &statements); err != nil {
+		var statement keyPolicyStatement
+		if err := json.Unmarshal(document.Statement, &statement); err != nil {
+			return false, err
+		}
+		statements = []keyPolicyStatement{statement}
+	}
+
+	accountRoot := fmt.Sprintf("arn:%s:iam::%s:root", partition, accountID)
+	for _, s := range statements {
+		if s.Effect != "Allow" {
+			continue
+		}
+		principal, ok := s.Principal.(map[string]interface{})
+		if !ok || !containsAny(principal["AWS"], accountRoot, accountID) {
+			continue
+		}
+		if containsAny(s.Action, "kms:*", "*", "kms:CreateGrant") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func containsAny(value interface{}, candidates ...string) bool {
+	var values []interface{}
+	switch v := value.(type) {
+	case string:
+		values = []interface{}{v}
+	case []interface{}:
+		values = v
+	}
+	for _, v := range values {
+		for _, c := range candidates {
+			if v == c {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e *Enabler) createRole(ctx context.Context, keyARN string, plan bool) (string, error) {
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      ServiceAccountName,
+			Namespace: Namespace,
+		},
+		WellKnownPolicies: api.WellKnownPolicies{
+			EBSCSIController: true,
+		},
+		// the service account is created by the addon
+		RoleOnly: api.Enabled(),
+	}
+	if keyARN != "" {
+		serviceAccount.AttachPolicy = kmsKeyPolicy(keyARN)
+	}
+
+	clientSetGetter := &kubernetes.CallbackClientSet{
+		Callback: func() (kubernetes.Interface, error) {
+			return e.clientSet, nil
+		},
+	}
+	roleARN, existing, err := irsa.EnsureIAMServiceAccount(ctx, e.stackManager, e.oidc, clientSetGetter, serviceAccount, plan)
+	if existing && keyARN != "" {
+		logger.Warning("IAM role for %q already exists, make sure it is allowed to use KMS key %q", serviceAccount.NameString(), keyARN)
+	}
+	return roleARN, err
+}
+
+// kmsKeyPolicy allows the EBS CSI controller to use the key for encrypted volumes,
+// see https://docs.aws.amazon.com/eks/latest/userguide/csi-iam-role.html
+func kmsKeyPolicy(keyARN string) api.InlineDocument {
+	return api.InlineDocument{
+		"Version": "2012-10-17",
+		"Statement": []interface{}{
+			map[string]interface{}{
+				"Effect": "Allow",
+				"Action": []string{
+					"kms:CreateGrant",
+					"kms:ListGrants",
+					"kms:RevokeGrant",
+				},
+				"Resource": keyARN,
+				"Condition": map[string]interface{}{
+					"Bool": map[string]interface{}{
+						"kms:GrantIsForAWSResource": "true",
+					},
+				},
+			},
+			map[string]interface{}{
+				"Effect": "Allow",
+				"Action": []string{
+					"kms:Encrypt",
+					"kms:Decrypt",
+					"kms:ReEncrypt*",
+					"kms:GenerateDataKey*",
+					"kms:DescribeKey",
+				},
+				"Resource": keyARN,
+			},
+		},
+	}
+}
+
+// makeGP3Default creates a gp3 StorageClass and makes it the default one instead of the current default StorageClass
+func (e *Enabler) makeGP3Default(ctx context.Context, keyARN string, plan bool) error {
+	storageClasses, err := e.clientSet.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing StorageClasses: %w", err)
+	}
+	for _, sc := range storageClasses.Items {
+		if sc.Name == GP3StorageClassName || sc.Annotations[defaultClassAnnotation] != "true" {
+			continue
+		}
+		if plan {
+			logger.Info("(plan) would unset StorageClass %q as the default StorageClass", sc.Name)
+			continue
+		}
+		sc := sc
+		sc.Annotations[defaultClassAnnotation] = "false"
+		if _, err := e.clientSet.StorageV1().StorageClasses().Update(ctx, &sc, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("unsetting StorageClass %q as the default StorageClass: %w", sc.Name, err)
+		}
+		logger.Info("unset StorageClass %q as the default StorageClass", sc.Name)
+	}
+
+	if plan {
+		logger.Info("(plan) would make StorageClass %q the default StorageClass", GP3StorageClassName)
+		return nil
+	}
+	gp3, err := e.clientSet.StorageV1().StorageClasses().Get(ctx, GP3StorageClassName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		if _, err := e.clientSet.StorageV1().StorageClasses().Create(ctx, newGP3StorageClass(keyARN), metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("creating StorageClass %q: %w", GP3StorageClassName, err)
+		}
+	case err != nil:
+		return fmt.Errorf("getting StorageClass %q: %w", GP3StorageClassName, err)
+	default:
+		if gp3.Annotations == nil {
+			gp3.Annotations = map[string]string{}
+		}
+		gp3.Annotations[defaultClassAnnotation] = "true"
+		if _, err := e.clientSet.StorageV1().StorageClasses().Update(ctx, gp3, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("setting StorageClass %q as the default StorageClass: %w", GP3StorageClassName, err)
+		}
+	}
+	logger.Info("StorageClass %q is the default StorageClass", GP3StorageClassName)
+	return nil
+}
+
+func newGP3StorageClass(keyARN string) *storagev1.StorageClass {
+	bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+	parameters := map[string]string{
+		"type": "gp3",
+	}
+	if keyARN != "" {
+		parameters["encrypted"] = "true"
+		parameters["kmsKeyId"] = keyARN
+	}
+	return &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: GP3StorageClassName,
+			Annotations: map[string]string{
+				defaultClassAnnotation: "true",
+			},
+		},
+		Provisioner:          provisioner,
+		Parameters:           parameters,
+		VolumeBindingMode:    &bindingMode,
+		AllowVolumeExpansion: aws.Bool(true),
+	}
+}
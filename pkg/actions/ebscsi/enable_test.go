@@ -0,0 +1,230 @@
+package ebscsi_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/stretchr/testify/mock"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/ebscsi"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+type fakeAddonCreator struct {
+	addons []*api.Addon
+}
+
+func (f *fakeAddonCreator) Create(_ context.Context, addon *api.Addon, _ time.Duration) error {
+	f.addons = append(f.addons, addon)
+	return nil
+}
+
+var _ = Describe("Enable EBS CSI", func() {
+	const (
+		accountID = "123456789012"
+		roleARN   = "arn:aws:iam::123456789012:role/ebs-csi"
+		keyARN    = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	)
+
+	var (
+		fakeStackManager *fakes.FakeStackManager
+		kmsAPI           *mocksv2.KMS
+		addonCreator     *fakeAddonCreator
+		clientSet        *fake.Clientset
+		enabler          *ebscsi.Enabler
+	)
+
+	mockKey := func(metadata kmstypes.KeyMetadata, keyPolicy string) {
+		kmsAPI.On("DescribeKey", mock.Anything, &kms.DescribeKeyInput{
+			KeyId: aws.String("alias/ebs"),
+		}).Return(&kms.DescribeKeyOutput{KeyMetadata: &metadata}, nil)
+		kmsAPI.On("GetKeyPolicy", mock.Anything, &kms.GetKeyPolicyInput{
+			KeyId:      aws.String(keyARN),
+			PolicyName: aws.String("default"),
+		}).Return(&kms.GetKeyPolicyOutput{Policy: aws.String(keyPolicy)}, nil)
+	}
+
+	enabledKey := kmstypes.KeyMetadata{
+		Arn:          aws.String(keyARN),
+		AWSAccountId: aws.String(accountID),
+		Enabled:      true,
+		KeyState:     kmstypes.KeyStateEnabled,
+		KeyUsage:     kmstypes.KeyUsageTypeEncryptDecrypt,
+		KeySpec:      kmstypes.KeySpecSymmetricDefault,
+	}
+
+	const rootKeyPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "Enable IAM User Permissions",
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+    "Action": "kms:*",
+    "Resource": "*"
+  }]
+}`
+
+	storageClass := func(name, isDefault string) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Annotations: map[string]string{
+					"storageclass.kubernetes.io/is-default-class": isDefault,
+				},
+			},
+			Provisioner: "kubernetes.io/aws-ebs",
+		}
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		fakeStackManager = &fakes.FakeStackManager{}
+		fakeStackManager.NewTasksToCreateIAMServiceAccountsStub = func(serviceAccounts []*api.ClusterIAMServiceAccount, _ *iamoidc.OpenIDConnectManager, _ kubernetes.ClientSetGetter) *tasks.TaskTree {
+			serviceAccounts[0].Status = &api.ClusterIAMServiceAccountStatus{RoleARN: aws.String(roleARN)}
+			return &tasks.TaskTree{}
+		}
+		kmsAPI = &mocksv2.KMS{}
+		addonCreator = &fakeAddonCreator{}
+		clientSet = fake.NewSimpleClientset(storageClass("gp2", "true"))
+		enabler = ebscsi.NewEnabler(cfg, fakeStackManager, kmsAPI, addonCreator, nil, clientSet)
+	})
+
+	It("creates the IAM role and the addon", func() {
+		Expect(enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID})).To(Succeed())
+
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(1))
+		serviceAccounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+		Expect(serviceAccounts[0].NameString()).To(Equal("kube-system/ebs-csi-controller-sa"))
+		Expect(serviceAccounts[0].WellKnownPolicies.EBSCSIController).To(BeTrue())
+		Expect(*serviceAccounts[0].RoleOnly).To(BeTrue())
+		Expect(serviceAccounts[0].AttachPolicy).To(BeNil())
+
+		Expect(addonCreator.addons).To(ConsistOf(&api.Addon{
+			Name:                  "aws-ebs-csi-driver",
+			ServiceAccountRoleARN: roleARN,
+		}))
+
+		gp2, err := clientSet.StorageV1().StorageClasses().Get(context.Background(), "gp2", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gp2.Annotations).To(HaveKeyWithValue("storageclass.kubernetes.io/is-default-class", "true"))
+	})
+
+	It("reuses an existing IAM role", func() {
+		fakeStackManager.GetIAMServiceAccountsReturns([]*api.ClusterIAMServiceAccount{
+			{
+				ClusterIAMMeta: api.ClusterIAMMeta{Name: "ebs-csi-controller-sa", Namespace: "kube-system"},
+				Status:         &api.ClusterIAMServiceAccountStatus{RoleARN: aws.String("arn:aws:iam::123456789012:role/existing")},
+			},
+		}, nil)
+
+		Expect(enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID})).To(Succeed())
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+		Expect(addonCreator.addons[0].ServiceAccountRoleARN).To(Equal("arn:aws:iam::123456789012:role/existing"))
+	})
+
+	It("makes gp3 the default StorageClass", func() {
+		Expect(enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID, DefaultStorageClassGP3: true})).To(Succeed())
+
+		gp2, err := clientSet.StorageV1().StorageClasses().Get(context.Background(), "gp2", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gp2.Annotations).To(HaveKeyWithValue("storageclass.kubernetes.io/is-default-class", "false"))
+
+		gp3, err := clientSet.StorageV1().StorageClasses().Get(context.Background(), "gp3", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gp3.Annotations).To(HaveKeyWithValue("storageclass.kubernetes.io/is-default-class", "true"))
+		Expect(gp3.Provisioner).To(Equal("ebs.csi.aws.com"))
+		Expect(gp3.Parameters).To(Equal(map[string]string{"type": "gp3"}))
+	})
+
+	It("makes an existing gp3 StorageClass the default StorageClass", func() {
+		_, err := clientSet.StorageV1().StorageClasses().Create(context.Background(), storageClass("gp3", "false"), metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID, DefaultStorageClassGP3: true})).To(Succeed())
+		gp3, err := clientSet.StorageV1().StorageClasses().Get(context.Background(), "gp3", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gp3.Annotations).To(HaveKeyWithValue("storageclass.kubernetes.io/is-default-class", "true"))
+	})
+
+	It("allows the role to use a valid KMS key and encrypts gp3 volumes with it", func() {
+		mockKey(enabledKey, rootKeyPolicy)
+
+		Expect(enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID, KMSKeyID: "alias/ebs", DefaultStorageClassGP3: true})).To(Succeed())
+
+		serviceAccounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+		Expect(serviceAccounts[0].AttachPolicy).To(HaveKeyWithValue("Statement", HaveLen(2)))
+		Expect(serviceAccounts[0].AttachPolicy["Statement"].([]interface{})[0]).To(HaveKeyWithValue("Resource", keyARN))
+
+		gp3, err := clientSet.StorageV1().StorageClasses().Get(context.Background(), "gp3", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gp3.Parameters).To(Equal(map[string]string{
+			"type":      "gp3",
+			"encrypted": "true",
+			"kmsKeyId":  keyARN,
+		}))
+	})
+
+	It("rejects a disabled KMS key", func() {
+		disabledKey := enabledKey
+		disabledKey.Enabled = false
+		disabledKey.KeyState = kmstypes.KeyStateDisabled
+		mockKey(disabledKey, rootKeyPolicy)
+
+		err := enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID, KMSKeyID: "alias/ebs"})
+		Expect(err).To(MatchError(ContainSubstring("is not enabled (state: Disabled)")))
+		Expect(addonCreator.addons).To(BeEmpty())
+	})
+
+	It("rejects an asymmetric KMS key", func() {
+		asymmetricKey := enabledKey
+		asymmetricKey.KeySpec = kmstypes.KeySpecRsa2048
+		mockKey(asymmetricKey, rootKeyPolicy)
+
+		err := enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID, KMSKeyID: "alias/ebs"})
+		Expect(err).To(MatchError(ContainSubstring("must be a symmetric encryption key")))
+	})
+
+	It("rejects a KMS key whose key policy does not allow IAM policies", func() {
+		mockKey(enabledKey, `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Principal": {"AWS": ["arn:aws:iam::123456789012:role/admin"]},
+    "Action": "kms:*",
+    "Resource": "*"
+  }
+}`)
+
+		err := enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID, KMSKeyID: "alias/ebs"})
+		Expect(err).To(MatchError(ContainSubstring("does not allow IAM policies in account 123456789012")))
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+	})
+
+	It("does not make any changes in plan mode", func() {
+		Expect(enabler.Enable(context.Background(), ebscsi.Options{AccountID: accountID, DefaultStorageClassGP3: true, Plan: true})).To(Succeed())
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+		Expect(addonCreator.addons).To(BeEmpty())
+
+		storageClasses, err := clientSet.StorageV1().StorageClasses().List(context.Background(), metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(storageClasses.Items).To(HaveLen(1))
+		Expect(storageClasses.Items[0].Annotations).To(HaveKeyWithValue("storageclass.kubernetes.io/is-default-class", "true"))
+	})
+})
@@ -0,0 +1,11 @@
+package ebscsi_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestEBSCSI(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
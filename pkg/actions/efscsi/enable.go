@@ -0,0 +1,217 @@
+package efscsi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/kris-nova/logger"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// Namespace is the namespace of the EFS CSI controller
+	Namespace = "kube-system"
+	// ServiceAccountName is the name of the service account used by the EFS CSI controller
+	ServiceAccountName = "efs-csi-controller-sa"
+	// StorageClassName is the name of the StorageClass using the file system
+	StorageClassName = "efs-sc"
+
+	provisioner = "efs.csi.aws.com"
+)
+
+// AddonCreator creates EKS addons
+type AddonCreator interface {
+	Create(ctx context.Context, addon *api.Addon, waitTimeout time.Duration) error
+}
+
+// Enabler creates an EFS file system for the cluster and installs the EFS CSI driver addon
+type Enabler struct {
+	cfg          *api.ClusterConfig
+	stackManager manager.StackManager
+	addonCreator AddonCreator
+	oidc         *iamoidc.OpenIDConnectManager
+	clientSet    kubeclient.Interface
+}
+
+// NewEnabler creates a new Enabler. The VPC and subnets of the cluster must be loaded into cfg.
+func NewEnabler(cfg *api.ClusterConfig, stackManager manager.StackManager, addonCreator AddonCreator, oidc *iamoidc.OpenIDConnectManager, clientSet kubeclient.Interface) *Enabler {
+	return &Enabler{
+		cfg:          cfg,
+		stackManager: stackManager,
+		addonCreator: addonCreator,
+		oidc:         oidc,
+		clientSet:    clientSet,
+	}
+}
+
+// Options defines options for Enable
+type Options struct {
+	// NodeSecurityGroupIDs are the security groups of the nodes that mount the file system
+	NodeSecurityGroupIDs []string
+	// KMSKeyID is the KMS key used to encrypt the file system, defaults to the AWS managed key
+	KMSKeyID string
+	// WaitTimeout is the time to wait for the addon to become active
+	WaitTimeout time.Duration
+	// Plan only logs the intended changes
+	Plan bool
+}
+
+// MakeStackName returns the name of the EFS stack of the cluster
+func MakeStackName(clusterName string) string {
+	return fmt.Sprintf("eksctl-%s-efs", clusterName)
+}
+
+// Enable creates an EFS file system with a mount target in each availability zone of the cluster, unless it already
+// exists, creates the IAM role for the EFS CSI controller and installs the aws-efs-csi-driver addon. It returns a
+// StorageClass that dynamically provisions volumes on the file system, or nil in plan mode.
+func (e *Enabler) Enable(ctx context.Context, opts Options) (*storagev1.StorageClass, error) {
+	fileSystemID, err := e.createFileSystem(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	roleARN, err := e.createRole(ctx, opts.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Plan {
+		logger.Info("(plan) would create addon %q", api.AWSEFSCSIDriverAddon)
+		return nil, nil
+	}
+	if err := e.addonCreator.Create(ctx, &api.Addon{
+		Name:                  api.AWSEFSCSIDriverAddon,
+		ServiceAccountRoleARN: roleARN,
+	}, opts.WaitTimeout); err != nil {
+		return nil, err
+	}
+	return NewStorageClass(fileSystemID), nil
+}
+
+func (e *Enabler) createFileSystem(ctx context.Context, opts Options) (string, error) {
+	stackName := MakeStackName(e.cfg.Metadata.Name)
+	stacks, err := e.stackManager.ListStacksMatching(ctx, fmt.Sprintf("^%s$", regexp.QuoteMeta(stackName)))
+	if err != nil {
+		return "", fmt.Errorf("listing stacks: %w", err)
+	}
+	if len(stacks) > 0 {
+		for _, o := range stacks[0].Outputs {
+			if aws.ToString(o.OutputKey) == outputs.EFSFileSystemID {
+				logger.Info("using existing EFS file system %q from stack %q", aws.ToString(o.OutputValue), stackName)
+				return aws.ToString(o.OutputValue), nil
+			}
+		}
+		return "", fmt.Errorf("stack %q does not have a %s output, it may not have been created successfully", stackName, outputs.EFSFileSystemID)
+	}
+
+	subnetIDs, err := e.selectSubnets()
+	if err != nil {
+		return "", err
+	}
+	if len(opts.NodeSecurityGroupIDs) == 0 {
+		return "", errors.New("no node security groups to allow NFS traffic from")
+	}
+	if opts.Plan {
+		logger.Info("(plan) would create an EFS file system with mount targets in subnets %v in stack %q", subnetIDs, stackName)
+		return "", nil
+	}
+
+	resourceSet := builder.NewEFSResourceSet(e.cfg.VPC.ID, subnetIDs, opts.NodeSecurityGroupIDs, opts.KMSKeyID)
+	if err := resourceSet.AddAllResources(); err != nil {
+		return "", err
+	}
+	logger.Info("creating EFS file system with mount targets in subnets %v in stack %q", subnetIDs, stackName)
+	errCh := make(chan error)
+	if err := e.stackManager.CreateStack(ctx, stackName, resourceSet, nil, nil, errCh); err != nil {
+		return "", err
+	}
+	if err := <-errCh; err != nil {
+		return "", fmt.Errorf("creating EFS file system: %w", err)
+	}
+	logger.Info("created EFS file system %q", resourceSet.FileSystemID)
+	return resourceSet.FileSystemID, nil
+}
+
+// selectSubnets returns a subnet in each availability zone of the cluster, as EFS supports a single
+// mount target per availability zone. Private subnets are preferred.
+func (e *Enabler) selectSubnets() ([]string, error) {
+	subnets := e.cfg.VPC.Subnets.Private
+	if len(subnets) == 0 {
+		subnets = e.cfg.VPC.Subnets.Public
+	}
+	subnetsByAZ := map[string]string{}
+	for _, s := range subnets {
+		if existing, ok := subnetsByAZ[s.AZ]; !ok || s.ID < existing {
+			subnetsByAZ[s.AZ] = s.ID
+		}
+	}
+	if len(subnetsByAZ) == 0 {
+		return nil, errors.New("no subnets found for the cluster")
+	}
+	var azs []string
+	for az := range subnetsByAZ {
+		azs = append(azs, az)
+	}
+	sort.Strings(azs)
+	var subnetIDs []string
+	for _, az := range azs {
+		subnetIDs = append(subnetIDs, subnetsByAZ[az])
+	}
+	return subnetIDs, nil
+}
+
+func (e *Enabler) createRole(ctx context.Context, plan bool) (string, error) {
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      ServiceAccountName,
+			Namespace: Namespace,
+		},
+		WellKnownPolicies: api.WellKnownPolicies{
+			EFSCSIController: true,
+		},
+		// the service account is created by the addon
+		RoleOnly: api.Enabled(),
+	}
+
+	clientSetGetter := &kubernetes.CallbackClientSet{
+		Callback: func() (kubernetes.Interface, error) {
+			return e.clientSet, nil
+		},
+	}
+	roleARN, _, err := irsa.EnsureIAMServiceAccount(ctx, e.stackManager, e.oidc, clientSetGetter, serviceAccount, plan)
+	return roleARN, err
+}
+
+// NewStorageClass returns a StorageClass that dynamically provisions volumes as access points on the file system
+func NewStorageClass(fileSystemID string) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "storage.k8s.io/v1",
+			Kind:       "StorageClass",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: StorageClassName,
+		},
+		Provisioner: provisioner,
+		Parameters: map[string]string{
+			"provisioningMode": "efs-ap",
+			"fileSystemId":     fileSystemID,
+			"directoryPerms":   "700",
+		},
+	}
+}
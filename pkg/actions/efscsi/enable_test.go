@@ -0,0 +1,157 @@
+package efscsi_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/efscsi"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+type fakeAddonCreator struct {
+	addons []*api.Addon
+}
+
+func (f *fakeAddonCreator) Create(_ context.Context, addon *api.Addon, _ time.Duration) error {
+	f.addons = append(f.addons, addon)
+	return nil
+}
+
+var _ = Describe("Enable EFS CSI", func() {
+	const roleARN = "arn:aws:iam::123456789012:role/efs-csi"
+
+	var (
+		cfg              *api.ClusterConfig
+		fakeStackManager *fakes.FakeStackManager
+		addonCreator     *fakeAddonCreator
+		enabler          *efscsi.Enabler
+		options          efscsi.Options
+	)
+
+	fileSystemOutput := func(fileSystemID string) []cfntypes.Output {
+		return []cfntypes.Output{
+			{
+				OutputKey:   aws.String("FileSystemID"),
+				OutputValue: aws.String(fileSystemID),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMapping{
+				"private-a1": api.AZSubnetSpec{ID: "subnet-a2", AZ: "us-west-2a"},
+				"private-a2": api.AZSubnetSpec{ID: "subnet-a1", AZ: "us-west-2a"},
+				"private-b":  api.AZSubnetSpec{ID: "subnet-b", AZ: "us-west-2b"},
+			},
+			Public: api.AZSubnetMapping{
+				"public-a": api.AZSubnetSpec{ID: "subnet-public-a", AZ: "us-west-2a"},
+			},
+		}
+
+		fakeStackManager = &fakes.FakeStackManager{}
+		fakeStackManager.CreateStackStub = func(_ context.Context, _ string, rs builder.ResourceSetReader, _, _ map[string]string, errCh chan error) error {
+			go func() {
+				errCh <- rs.GetAllOutputs(cfntypes.Stack{Outputs: fileSystemOutput("fs-1234")})
+			}()
+			return nil
+		}
+		fakeStackManager.NewTasksToCreateIAMServiceAccountsStub = func(serviceAccounts []*api.ClusterIAMServiceAccount, _ *iamoidc.OpenIDConnectManager, _ kubernetes.ClientSetGetter) *tasks.TaskTree {
+			serviceAccounts[0].Status = &api.ClusterIAMServiceAccountStatus{RoleARN: aws.String(roleARN)}
+			return &tasks.TaskTree{}
+		}
+		addonCreator = &fakeAddonCreator{}
+		enabler = efscsi.NewEnabler(cfg, fakeStackManager, addonCreator, nil, fake.NewSimpleClientset())
+		options = efscsi.Options{NodeSecurityGroupIDs: []string{"sg-nodes"}}
+	})
+
+	It("creates the file system, the IAM role and the addon", func() {
+		storageClass, err := enabler.Enable(context.Background(), options)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+		_, stackName, rs, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+		Expect(stackName).To(Equal("eksctl-my-cluster-efs"))
+		template, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(template)).To(And(
+			ContainSubstring(`"SubnetId": "subnet-a1"`),
+			ContainSubstring(`"SubnetId": "subnet-b"`),
+			Not(ContainSubstring("subnet-a2")),
+			Not(ContainSubstring("subnet-public-a")),
+			ContainSubstring(`"SourceSecurityGroupId": "sg-nodes"`),
+		))
+
+		serviceAccounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+		Expect(serviceAccounts[0].NameString()).To(Equal("kube-system/efs-csi-controller-sa"))
+		Expect(serviceAccounts[0].WellKnownPolicies.EFSCSIController).To(BeTrue())
+		Expect(*serviceAccounts[0].RoleOnly).To(BeTrue())
+
+		Expect(addonCreator.addons).To(ConsistOf(&api.Addon{
+			Name:                  "aws-efs-csi-driver",
+			ServiceAccountRoleARN: roleARN,
+		}))
+
+		Expect(storageClass.Name).To(Equal("efs-sc"))
+		Expect(storageClass.Provisioner).To(Equal("efs.csi.aws.com"))
+		Expect(storageClass.Parameters).To(HaveKeyWithValue("fileSystemId", "fs-1234"))
+		Expect(storageClass.Parameters).To(HaveKeyWithValue("provisioningMode", "efs-ap"))
+	})
+
+	It("uses public subnets when the cluster has no private subnets", func() {
+		cfg.VPC.Subnets.Private = nil
+		_, err := enabler.Enable(context.Background(), options)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, _, rs, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+		template, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(template)).To(ContainSubstring(`"SubnetId": "subnet-public-a"`))
+	})
+
+	It("reuses an existing file system", func() {
+		fakeStackManager.ListStacksMatchingReturns([]*cfntypes.Stack{
+			{
+				StackName: aws.String("eksctl-my-cluster-efs"),
+				Outputs:   fileSystemOutput("fs-existing"),
+			},
+		}, nil)
+
+		storageClass, err := enabler.Enable(context.Background(), options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+		Expect(storageClass.Parameters).To(HaveKeyWithValue("fileSystemId", "fs-existing"))
+	})
+
+	It("requires node security groups", func() {
+		_, err := enabler.Enable(context.Background(), efscsi.Options{})
+		Expect(err).To(MatchError("no node security groups to allow NFS traffic from"))
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+	})
+
+	It("does not make any changes in plan mode", func() {
+		options.Plan = true
+		storageClass, err := enabler.Enable(context.Background(), options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(storageClass).To(BeNil())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+		Expect(addonCreator.addons).To(BeEmpty())
+	})
+})
@@ -0,0 +1,11 @@
+package efscsi_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestEFSCSI(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
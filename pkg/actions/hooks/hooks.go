@@ -0,0 +1,136 @@
+package hooks
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/executor"
+	"github.com/weaveworks/eksctl/pkg/utils/file"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
+)
+
+// Lifecycle points hooks run at, matching the fields of the hooks section
+const (
+	PostClusterCreate   = "postClusterCreate"
+	PostNodeGroupCreate = "postNodegroupCreate"
+	PreDelete           = "preDelete"
+)
+
+// Environment variables set for hook commands
+const (
+	ClusterNameEnvVar = "EKSCTL_CLUSTER_NAME"
+	RegionEnvVar      = "EKSCTL_REGION"
+	kubeconfigEnvVar  = "KUBECONFIG"
+)
+
+// manifestFetchTimeout bounds fetching the manifest of a hook over HTTP(S)
+const manifestFetchTimeout = 45 * time.Second
+
+// ManifestApplier creates or replaces the resources of a Kubernetes manifest
+type ManifestApplier interface {
+	CreateOrReplace(manifest []byte, plan bool) error
+}
+
+// Runner runs the hooks declared in a ClusterConfig
+type Runner struct {
+	executor executor.Executor
+	applier  ManifestApplier
+}
+
+// NewRunner creates a new Runner. Commands are run with executor and manifests are applied with applier.
+func NewRunner(executor executor.Executor, applier ManifestApplier) *Runner {
+	return &Runner{
+		executor: executor,
+		applier:  applier,
+	}
+}
+
+// Run runs hooks in order, stopping at the first one that fails
+func (r *Runner) Run(point string, hooks []api.Hook) error {
+	for i, hook := range hooks {
+		name := hook.Name
+		if name == "" {
+			name = fmt.Sprintf("%s[%d]", point, i)
+		}
+		if err := r.runHook(hook, name); err != nil {
+			return fmt.Errorf("running %s hook %q: %w", point, name, err)
+		}
+		logger.Success("ran %s hook %q", point, name)
+	}
+	return nil
+}
+
+func (r *Runner) runHook(hook api.Hook, name string) error {
+	if hook.Command != "" {
+		logger.Info("running command of hook %q", name)
+		return r.executor.Exec("sh", "-c", hook.Command)
+	}
+	logger.Info("applying manifest %s of hook %q", hook.Manifest, name)
+	manifest, err := readManifest(hook.Manifest)
+	if err != nil {
+		return err
+	}
+	return r.applier.CreateOrReplace(manifest, false)
+}
+
+func readManifest(location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		return os.ReadFile(file.ExpandPath(location))
+	}
+	client := &http.Client{
+		Transport: proxy.NewTransport(),
+		Timeout:   manifestFetchTimeout,
+	}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: unexpected status %s", location, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// RunForCluster runs hooks against the cluster, with KUBECONFIG pointing to a temporary kubeconfig for it
+func RunForCluster(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, point string, hooks []api.Hook) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+	logger.Info("running %d %s hook(s)", len(hooks), point)
+
+	kubeconfigFile, err := os.CreateTemp("", cfg.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(kubeconfigFile.Name()); err != nil {
+			logger.Critical("failed to remove temporary kubeconfig", kubeconfigFile.Name())
+		}
+	}()
+	logger.Debug("writing temporary kubeconfig to %s", kubeconfigFile.Name())
+	kubectlConfig := kubeconfig.NewForKubectl(cfg, eks.GetUsername(ctl.Status.IAMRoleARN), "", ctl.AWSProvider.Profile().Name)
+	if _, err := kubeconfig.Write(kubeconfigFile.Name(), *kubectlConfig, true); err != nil {
+		return err
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	shellExecutor := executor.NewShellExecutor(executor.EnvVars{
+		kubeconfigEnvVar:  kubeconfigFile.Name(),
+		ClusterNameEnvVar: cfg.Metadata.Name,
+		RegionEnvVar:      cfg.Metadata.Region,
+	})
+	return NewRunner(shellExecutor, rawClient).Run(point, hooks)
+}
@@ -0,0 +1,107 @@
+package hooks_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/hooks"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/executor/fakes"
+)
+
+const manifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: team-a
+`
+
+type fakeApplier struct {
+	manifests [][]byte
+	err       error
+}
+
+func (f *fakeApplier) CreateOrReplace(manifest []byte, plan bool) error {
+	f.manifests = append(f.manifests, manifest)
+	return f.err
+}
+
+var _ = Describe("Hooks", func() {
+	var (
+		fakeExecutor *fakes.FakeExecutor
+		applier      *fakeApplier
+		runner       *hooks.Runner
+	)
+
+	BeforeEach(func() {
+		fakeExecutor = new(fakes.FakeExecutor)
+		applier = &fakeApplier{}
+		runner = hooks.NewRunner(fakeExecutor, applier)
+	})
+
+	It("runs commands with sh", func() {
+		Expect(runner.Run(hooks.PostClusterCreate, []api.Hook{
+			{Command: "kubectl get nodes"},
+			{Command: "echo done"},
+		})).To(Succeed())
+
+		Expect(fakeExecutor.ExecCallCount()).To(Equal(2))
+		command, args := fakeExecutor.ExecArgsForCall(0)
+		Expect(command).To(Equal("sh"))
+		Expect(args).To(Equal([]string{"-c", "kubectl get nodes"}))
+		_, args = fakeExecutor.ExecArgsForCall(1)
+		Expect(args).To(Equal([]string{"-c", "echo done"}))
+	})
+
+	It("applies manifests from files", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "namespace.yaml")
+		Expect(os.WriteFile(path, []byte(manifest), 0600)).To(Succeed())
+
+		Expect(runner.Run(hooks.PostNodeGroupCreate, []api.Hook{{Manifest: path}})).To(Succeed())
+		Expect(applier.manifests).To(Equal([][]byte{[]byte(manifest)}))
+		Expect(fakeExecutor.ExecCallCount()).To(BeZero())
+	})
+
+	It("applies manifests from URLs", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/namespace.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, manifest)
+		}))
+		defer server.Close()
+
+		Expect(runner.Run(hooks.PostClusterCreate, []api.Hook{{Manifest: server.URL + "/namespace.yaml"}})).To(Succeed())
+		Expect(applier.manifests).To(Equal([][]byte{[]byte(manifest)}))
+
+		err := runner.Run(hooks.PostClusterCreate, []api.Hook{{Name: "missing", Manifest: server.URL + "/missing.yaml"}})
+		Expect(err).To(MatchError(ContainSubstring(`running postClusterCreate hook "missing": fetching %s/missing.yaml: unexpected status 404 Not Found`, server.URL)))
+	})
+
+	It("stops at the first failing hook", func() {
+		fakeExecutor.ExecReturnsOnCall(0, errors.New("exit status 1"))
+
+		err := runner.Run(hooks.PreDelete, []api.Hook{
+			{Command: "false"},
+			{Command: "echo unreachable"},
+		})
+		Expect(err).To(MatchError(`running preDelete hook "preDelete[0]": exit status 1`))
+		Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+	})
+
+	It("returns errors applying manifests", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "namespace.yaml")
+		Expect(os.WriteFile(path, []byte(manifest), 0600)).To(Succeed())
+		applier.err = errors.New("forbidden")
+
+		err := runner.Run(hooks.PostClusterCreate, []api.Hook{{Name: "namespaces", Manifest: path}})
+		Expect(err).To(MatchError(`running postClusterCreate hook "namespaces": forbidden`))
+	})
+})
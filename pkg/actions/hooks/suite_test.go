@@ -0,0 +1,11 @@
+package hooks_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestHooks(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
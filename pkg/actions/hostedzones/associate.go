@@ -0,0 +1,95 @@
+package hostedzones
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Associate associates the private hosted zones with the VPC. Hosted zones owned by another account are
+// authorized for the association using their authorizationRoleARN first. Hosted zones that are already
+// associated with the VPC are skipped.
+func (m *Manager) Associate(ctx context.Context, vpcID string, associations []api.PrivateHostedZoneAssociation, plan bool) error {
+	for _, association := range associations {
+		if err := m.associate(ctx, vpcID, association, plan); err != nil {
+			return fmt.Errorf("associating private hosted zone %q with VPC %q: %w", association.HostedZoneID, vpcID, err)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) associate(ctx context.Context, vpcID string, association api.PrivateHostedZoneAssociation, plan bool) error {
+	ownerAPI := m.route53API
+	crossAccount := association.AuthorizationRoleARN != ""
+	if crossAccount {
+		ownerAPI = m.newRoute53ForRole(association.AuthorizationRoleARN)
+	}
+
+	hostedZone, err := ownerAPI.GetHostedZone(ctx, &route53.GetHostedZoneInput{
+		Id: aws.String(association.HostedZoneID),
+	})
+	if err != nil {
+		if !crossAccount {
+			return fmt.Errorf("describing hosted zone (authorizationRoleARN must be set for hosted zones owned by another account): %w", err)
+		}
+		return fmt.Errorf("describing hosted zone: %w", err)
+	}
+	if hostedZone.HostedZone.Config == nil || !hostedZone.HostedZone.Config.PrivateZone {
+		return errors.New("only private hosted zones can be associated with a VPC")
+	}
+	zoneName := aws.ToString(hostedZone.HostedZone.Name)
+	for _, vpc := range hostedZone.VPCs {
+		if aws.ToString(vpc.VPCId) == vpcID {
+			logger.Info("private hosted zone %q (%s) is already associated with VPC %q", association.HostedZoneID, zoneName, vpcID)
+			return nil
+		}
+	}
+
+	if plan {
+		logger.Info("(plan) would associate private hosted zone %q (%s) with VPC %q", association.HostedZoneID, zoneName, vpcID)
+		return nil
+	}
+
+	vpc := &route53types.VPC{
+		VPCId:     aws.String(vpcID),
+		VPCRegion: route53types.VPCRegion(m.region),
+	}
+	if crossAccount {
+		if _, err := ownerAPI.CreateVPCAssociationAuthorization(ctx, &route53.CreateVPCAssociationAuthorizationInput{
+			HostedZoneId: aws.String(association.HostedZoneID),
+			VPC:          vpc,
+		}); err != nil {
+			return fmt.Errorf("authorizing the association in the account owning the hosted zone: %w", err)
+		}
+		defer deleteAssociationAuthorization(ctx, ownerAPI, association.HostedZoneID, vpc)
+	}
+
+	if _, err := m.route53API.AssociateVPCWithHostedZone(ctx, &route53.AssociateVPCWithHostedZoneInput{
+		HostedZoneId: aws.String(association.HostedZoneID),
+		VPC:          vpc,
+		Comment:      aws.String("associated by eksctl"),
+	}); err != nil {
+		return err
+	}
+	logger.Info("associated private hosted zone %q (%s) with VPC %q", association.HostedZoneID, zoneName, vpcID)
+	return nil
+}
+
+// deleteAssociationAuthorization removes the authorization once the association exists, as recommended by Route 53;
+// the association is not affected by it
+func deleteAssociationAuthorization(ctx context.Context, ownerAPI awsapi.Route53, hostedZoneID string, vpc *route53types.VPC) {
+	if _, err := ownerAPI.DeleteVPCAssociationAuthorization(ctx, &route53.DeleteVPCAssociationAuthorizationInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		VPC:          vpc,
+	}); err != nil {
+		logger.Warning("failed to delete the VPC association authorization for hosted zone %q: %v", hostedZoneID, err)
+	}
+}
@@ -0,0 +1,125 @@
+package hostedzones_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/hostedzones"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+)
+
+var _ = Describe("Associate", func() {
+	const (
+		vpcID        = "vpc-1234"
+		hostedZoneID = "Z0123456789"
+		roleARN      = "arn:aws:iam::111122223333:role/route53-association"
+	)
+
+	var (
+		route53API *mocksv2.Route53
+		ownerAPI   *mocksv2.Route53
+		manager    *hostedzones.Manager
+		vpc        *route53types.VPC
+	)
+
+	mockGetHostedZone := func(client *mocksv2.Route53, privateZone bool, vpcIDs ...string) {
+		var vpcs []route53types.VPC
+		for _, id := range vpcIDs {
+			vpcs = append(vpcs, route53types.VPC{VPCId: aws.String(id), VPCRegion: route53types.VPCRegionUsWest2})
+		}
+		client.On("GetHostedZone", mock.Anything, &route53.GetHostedZoneInput{
+			Id: aws.String(hostedZoneID),
+		}).Return(&route53.GetHostedZoneOutput{
+			HostedZone: &route53types.HostedZone{
+				Id:     aws.String(hostedZoneID),
+				Name:   aws.String("corp.example.com."),
+				Config: &route53types.HostedZoneConfig{PrivateZone: privateZone},
+			},
+			VPCs: vpcs,
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		route53API = &mocksv2.Route53{}
+		ownerAPI = &mocksv2.Route53{}
+		manager = hostedzones.NewManager(route53API, &mocksv2.STS{}, "us-west-2", api.AWSRoleSessionConfig{})
+		manager.SetNewRoute53ForRole(func(arn string) awsapi.Route53 {
+			Expect(arn).To(Equal(roleARN))
+			return ownerAPI
+		})
+		vpc = &route53types.VPC{VPCId: aws.String(vpcID), VPCRegion: route53types.VPCRegionUsWest2}
+	})
+
+	It("associates a hosted zone owned by the same account", func() {
+		mockGetHostedZone(route53API, true, "vpc-other")
+		route53API.On("AssociateVPCWithHostedZone", mock.Anything, &route53.AssociateVPCWithHostedZoneInput{
+			HostedZoneId: aws.String(hostedZoneID),
+			VPC:          vpc,
+			Comment:      aws.String("associated by eksctl"),
+		}).Return(&route53.AssociateVPCWithHostedZoneOutput{}, nil)
+
+		err := manager.Associate(context.Background(), vpcID, []api.PrivateHostedZoneAssociation{{HostedZoneID: hostedZoneID}}, false)
+		Expect(err).NotTo(HaveOccurred())
+		route53API.AssertExpectations(GinkgoT())
+	})
+
+	It("authorizes and associates a hosted zone owned by another account", func() {
+		mockGetHostedZone(ownerAPI, true)
+		ownerAPI.On("CreateVPCAssociationAuthorization", mock.Anything, &route53.CreateVPCAssociationAuthorizationInput{
+			HostedZoneId: aws.String(hostedZoneID),
+			VPC:          vpc,
+		}).Return(&route53.CreateVPCAssociationAuthorizationOutput{}, nil)
+		route53API.On("AssociateVPCWithHostedZone", mock.Anything, mock.Anything).Return(&route53.AssociateVPCWithHostedZoneOutput{}, nil)
+		ownerAPI.On("DeleteVPCAssociationAuthorization", mock.Anything, &route53.DeleteVPCAssociationAuthorizationInput{
+			HostedZoneId: aws.String(hostedZoneID),
+			VPC:          vpc,
+		}).Return(&route53.DeleteVPCAssociationAuthorizationOutput{}, nil)
+
+		err := manager.Associate(context.Background(), vpcID, []api.PrivateHostedZoneAssociation{
+			{HostedZoneID: hostedZoneID, AuthorizationRoleARN: roleARN},
+		}, false)
+		Expect(err).NotTo(HaveOccurred())
+		ownerAPI.AssertExpectations(GinkgoT())
+		route53API.AssertExpectations(GinkgoT())
+	})
+
+	It("skips hosted zones already associated with the VPC", func() {
+		mockGetHostedZone(route53API, true, vpcID)
+
+		err := manager.Associate(context.Background(), vpcID, []api.PrivateHostedZoneAssociation{{HostedZoneID: hostedZoneID}}, false)
+		Expect(err).NotTo(HaveOccurred())
+		route53API.AssertNotCalled(GinkgoT(), "AssociateVPCWithHostedZone", mock.Anything, mock.Anything)
+	})
+
+	It("does not associate hosted zones in plan mode", func() {
+		mockGetHostedZone(route53API, true)
+
+		err := manager.Associate(context.Background(), vpcID, []api.PrivateHostedZoneAssociation{{HostedZoneID: hostedZoneID}}, true)
+		Expect(err).NotTo(HaveOccurred())
+		route53API.AssertNotCalled(GinkgoT(), "AssociateVPCWithHostedZone", mock.Anything, mock.Anything)
+	})
+
+	It("returns an error for a public hosted zone", func() {
+		mockGetHostedZone(route53API, false)
+
+		err := manager.Associate(context.Background(), vpcID, []api.PrivateHostedZoneAssociation{{HostedZoneID: hostedZoneID}}, false)
+		Expect(err).To(MatchError(ContainSubstring("only private hosted zones can be associated with a VPC")))
+	})
+
+	It("suggests setting authorizationRoleARN when the hosted zone cannot be described", func() {
+		route53API.On("GetHostedZone", mock.Anything, mock.Anything).Return(nil, errors.New("NoSuchHostedZone"))
+
+		err := manager.Associate(context.Background(), vpcID, []api.PrivateHostedZoneAssociation{{HostedZoneID: hostedZoneID}}, false)
+		Expect(err).To(MatchError(ContainSubstring("authorizationRoleARN must be set for hosted zones owned by another account")))
+	})
+})
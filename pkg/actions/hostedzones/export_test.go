@@ -0,0 +1,7 @@
+package hostedzones
+
+import "github.com/weaveworks/eksctl/pkg/awsapi"
+
+func (m *Manager) SetNewRoute53ForRole(newRoute53ForRole func(roleARN string) awsapi.Route53) {
+	m.newRoute53ForRole = newRoute53ForRole
+}
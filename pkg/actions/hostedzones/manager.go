@@ -0,0 +1,34 @@
+package hostedzones
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Manager associates Route 53 private hosted zones with a cluster VPC
+type Manager struct {
+	route53API awsapi.Route53
+	region     string
+
+	// newRoute53ForRole returns a Route53 client using the credentials of the given role,
+	// for hosted zones owned by another account
+	newRoute53ForRole func(roleARN string) awsapi.Route53
+}
+
+// NewManager creates a new Manager, assuming the roles owning the hosted zones of other accounts in roleSession
+func NewManager(route53API awsapi.Route53, stsAPI awsapi.STS, region string, roleSession api.AWSRoleSessionConfig) *Manager {
+	return &Manager{
+		route53API: route53API,
+		region:     region,
+		newRoute53ForRole: func(roleARN string) awsapi.Route53 {
+			return route53.New(route53.Options{
+				Region:      region,
+				Credentials: aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsAPI, roleARN, roleSession.ApplyTo)),
+			})
+		},
+	}
+}
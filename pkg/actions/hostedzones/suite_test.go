@@ -0,0 +1,11 @@
+package hostedzones_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestHostedZones(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,35 @@
+package hostedzones
+
+import (
+	"context"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// AssociateTask associates the private hosted zones in the ClusterConfig with the cluster VPC
+type AssociateTask struct {
+	manager *Manager
+	spec    *api.ClusterConfig
+	ctx     context.Context
+}
+
+// NewAssociateTask creates a task that associates private hosted zones with the cluster VPC;
+// the VPC ID is read from spec when the task runs, so it can follow the creation of the VPC
+func NewAssociateTask(ctx context.Context, manager *Manager, spec *api.ClusterConfig) tasks.Task {
+	return tasks.SynchronousTask{
+		SynchronousTaskIface: &AssociateTask{
+			manager: manager,
+			spec:    spec,
+			ctx:     ctx,
+		},
+	}
+}
+
+func (t *AssociateTask) Describe() string {
+	return "associate private hosted zones with cluster VPC"
+}
+
+func (t *AssociateTask) Do() error {
+	return t.manager.Associate(t.ctx, t.spec.VPC.ID, t.spec.VPC.PrivateHostedZoneAssociations, false)
+}
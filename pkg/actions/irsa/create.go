@@ -1,7 +1,14 @@
 package irsa
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 )
 
@@ -15,3 +22,49 @@ func (a *Manager) CreateIAMServiceAccount(iamServiceAccounts []*api.ClusterIAMSe
 
 	return err
 }
+
+// EnsureIAMServiceAccount creates serviceAccount, the IAM role of a component installed by eksctl and, unless it is
+// role only, its Kubernetes service account, when no iamserviceaccount stack exists for it yet. It returns the ARN of
+// the IAM role, which is empty in plan mode, and whether the iamserviceaccount already existed. As the role is all
+// there is to a role only iamserviceaccount, failing to get its ARN once created is an error
+func EnsureIAMServiceAccount(ctx context.Context, stackManager manager.StackManager, oidcManager *iamoidc.OpenIDConnectManager,
+	clientSetGetter kubernetes.ClientSetGetter, serviceAccount *api.ClusterIAMServiceAccount, plan bool) (roleARN string, existing bool, err error) {
+	resource := fmt.Sprintf("IAM service account %q", serviceAccount.NameString())
+	if api.IsEnabled(serviceAccount.RoleOnly) {
+		resource = fmt.Sprintf("IAM role for %q", serviceAccount.NameString())
+	}
+
+	existingServiceAccounts, err := stackManager.GetIAMServiceAccounts(ctx)
+	if err != nil {
+		return "", false, fmt.Errorf("listing IAM service accounts: %w", err)
+	}
+	for _, sa := range existingServiceAccounts {
+		if sa.NameString() == serviceAccount.NameString() {
+			logger.Info("using existing %s", resource)
+			if sa.Status != nil && sa.Status.RoleARN != nil {
+				return *sa.Status.RoleARN, true, nil
+			}
+			return "", true, nil
+		}
+	}
+
+	if plan {
+		logger.Info("(plan) would create %s", resource)
+		return "", false, nil
+	}
+	taskTree := stackManager.NewTasksToCreateIAMServiceAccounts([]*api.ClusterIAMServiceAccount{serviceAccount}, oidcManager, clientSetGetter)
+	logger.Info(taskTree.Describe())
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return "", false, fmt.Errorf("failed to create %s", resource)
+	}
+	if serviceAccount.Status == nil || serviceAccount.Status.RoleARN == nil {
+		if api.IsEnabled(serviceAccount.RoleOnly) {
+			return "", false, fmt.Errorf("%s was not created", resource)
+		}
+		return "", false, nil
+	}
+	return *serviceAccount.Status.RoleARN, false, nil
+}
@@ -0,0 +1,84 @@
+package irsa_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("EnsureIAMServiceAccount", func() {
+	var (
+		fakeStackManager *fakes.FakeStackManager
+		serviceAccount   *api.ClusterIAMServiceAccount
+	)
+
+	BeforeEach(func() {
+		fakeStackManager = new(fakes.FakeStackManager)
+		serviceAccount = &api.ClusterIAMServiceAccount{
+			ClusterIAMMeta: api.ClusterIAMMeta{
+				Name:      "ebs-csi-controller-sa",
+				Namespace: "kube-system",
+			},
+			RoleOnly: api.Enabled(),
+		}
+	})
+
+	It("reuses an existing iamserviceaccount", func() {
+		fakeStackManager.GetIAMServiceAccountsReturns([]*api.ClusterIAMServiceAccount{
+			{
+				ClusterIAMMeta: serviceAccount.ClusterIAMMeta,
+				Status:         &api.ClusterIAMServiceAccountStatus{RoleARN: aws.String("arn:aws:iam::123456789012:role/ebs")},
+			},
+		}, nil)
+
+		roleARN, existing, err := irsa.EnsureIAMServiceAccount(context.Background(), fakeStackManager, nil, nil, serviceAccount, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(existing).To(BeTrue())
+		Expect(roleARN).To(Equal("arn:aws:iam::123456789012:role/ebs"))
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+	})
+
+	It("creates nothing in plan mode", func() {
+		roleARN, existing, err := irsa.EnsureIAMServiceAccount(context.Background(), fakeStackManager, nil, nil, serviceAccount, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(existing).To(BeFalse())
+		Expect(roleARN).To(BeEmpty())
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+	})
+
+	It("creates the iamserviceaccount and returns the ARN of its role", func() {
+		fakeStackManager.NewTasksToCreateIAMServiceAccountsStub = func(serviceAccounts []*api.ClusterIAMServiceAccount, _ *iamoidc.OpenIDConnectManager, _ kubernetes.ClientSetGetter) *tasks.TaskTree {
+			serviceAccounts[0].Status = &api.ClusterIAMServiceAccountStatus{RoleARN: aws.String("arn:aws:iam::123456789012:role/ebs")}
+			return &tasks.TaskTree{}
+		}
+
+		roleARN, existing, err := irsa.EnsureIAMServiceAccount(context.Background(), fakeStackManager, nil, nil, serviceAccount, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(existing).To(BeFalse())
+		Expect(roleARN).To(Equal("arn:aws:iam::123456789012:role/ebs"))
+	})
+
+	It("fails when the role of a role only iamserviceaccount is unknown once created", func() {
+		fakeStackManager.NewTasksToCreateIAMServiceAccountsReturns(&tasks.TaskTree{})
+
+		_, _, err := irsa.EnsureIAMServiceAccount(context.Background(), fakeStackManager, nil, nil, serviceAccount, false)
+		Expect(err).To(MatchError(`IAM role for "kube-system/ebs-csi-controller-sa" was not created`))
+	})
+
+	It("fails when the iamserviceaccounts cannot be listed", func() {
+		fakeStackManager.GetIAMServiceAccountsReturns(nil, fmt.Errorf("throttled"))
+
+		_, _, err := irsa.EnsureIAMServiceAccount(context.Background(), fakeStackManager, nil, nil, serviceAccount, false)
+		Expect(err).To(MatchError("listing IAM service accounts: throttled"))
+	})
+})
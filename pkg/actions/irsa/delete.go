@@ -3,6 +3,9 @@ package irsa
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 )
 
@@ -14,7 +17,25 @@ func (m *Manager) Delete(ctx context.Context, serviceAccounts []string, plan, wa
 	taskTree.PlanMode = plan
 
 	err = doTasks(taskTree, actionDelete)
+	if err == nil && plan {
+		err = m.logStacksToDelete(ctx, serviceAccounts)
+	}
 
 	logPlanModeWarning(plan && taskTree.Len() > 0)
 	return err
 }
+
+func (m *Manager) logStacksToDelete(ctx context.Context, serviceAccounts []string) error {
+	stacks, err := m.stackManager.DescribeIAMServiceAccountStacks(ctx)
+	if err != nil {
+		return err
+	}
+	names := sets.NewString(serviceAccounts...)
+	var stacksToDelete []*manager.Stack
+	for _, s := range stacks {
+		if names.Has(manager.GetIAMServiceAccountName(s)) {
+			stacksToDelete = append(stacksToDelete, s)
+		}
+	}
+	return manager.LogStacksToDelete(ctx, m.stackManager, stacksToDelete, nil)
+}
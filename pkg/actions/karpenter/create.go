@@ -54,14 +54,24 @@ func (i *Installer) Create(ctx context.Context) error {
 		AttachPolicyARNs: []string{policyArn},
 		RoleName:         roleName,
 	}
+	if i.Config.Karpenter.ServiceAccountRoleARN != "" {
+		// Use the supplied role instead of creating one.
+		roleARN = i.Config.Karpenter.ServiceAccountRoleARN
+		iamServiceAccount.AttachPolicyARNs = nil
+		iamServiceAccount.RoleName = ""
+		iamServiceAccount.AttachRoleARN = roleARN
+	}
 	if api.IsEnabled(i.Config.Karpenter.CreateServiceAccount) {
 		// Create the service account role only.
 		iamServiceAccount.RoleOnly = api.Enabled()
 	}
-	karpenterServiceAccountTaskTree := i.StackManager.NewTasksToCreateIAMServiceAccounts([]*api.ClusterIAMServiceAccount{iamServiceAccount}, i.OIDC, clientSetGetter)
-	logger.Info(karpenterServiceAccountTaskTree.Describe())
-	if err := doTasks(karpenterServiceAccountTaskTree); err != nil {
-		return fmt.Errorf("failed to create/attach service account: %w", err)
+	// There is nothing to create when the role is supplied and the service account is created by the chart.
+	if iamServiceAccount.AttachRoleARN == "" || !api.IsEnabled(iamServiceAccount.RoleOnly) {
+		karpenterServiceAccountTaskTree := i.StackManager.NewTasksToCreateIAMServiceAccounts([]*api.ClusterIAMServiceAccount{iamServiceAccount}, i.OIDC, clientSetGetter)
+		logger.Info(karpenterServiceAccountTaskTree.Describe())
+		if err := doTasks(karpenterServiceAccountTaskTree); err != nil {
+			return fmt.Errorf("failed to create/attach service account: %w", err)
+		}
 	}
 
 	// create identity mapping for EC2 nodes to be able to join the cluster.
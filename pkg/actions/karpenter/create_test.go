@@ -148,6 +148,86 @@ var _ = Describe("Create", func() {
 				Expect(output.String()).To(ContainSubstring("failed to add tags for subnets: nope"))
 			})
 		})
+		When("subnets are shared from another account via AWS RAM", func() {
+			BeforeEach(func() {
+				p = mockprovider.NewMockProvider()
+				p.MockEC2().On("CreateTags", mock.Anything, &ec2.CreateTagsInput{
+					Resources: []string{
+						privateSubnet1,
+						publicSubnet1,
+					},
+					Tags: []ec2types.Tag{
+						{
+							Key:   aws.String("kubernetes.io/cluster/" + clusterName),
+							Value: aws.String(""),
+						},
+					},
+				}).Return(&ec2.CreateTagsOutput{}, nil)
+				ctl.AWSProvider = p
+				cfg.Metadata.AccountID = "123456789012"
+				for _, subnets := range []api.AZSubnetMapping{cfg.VPC.Subnets.Private, cfg.VPC.Subnets.Public} {
+					for k, subnet := range subnets {
+						subnet.OwnerID = cfg.Metadata.AccountID
+						if subnet.ID == privateSubnet2 || subnet.ID == publicSubnet2 {
+							subnet.OwnerID = "210987654321"
+						}
+						subnets[k] = subnet
+					}
+				}
+			})
+			It("only tags the subnets owned by the cluster's account", func() {
+				fakeKarpenterInstaller.InstallReturns(nil)
+				install := &karpenteractions.Installer{
+					StackManager:       fakeStackManager,
+					CTL:                ctl,
+					Config:             cfg,
+					KarpenterInstaller: fakeKarpenterInstaller,
+					ClientSet:          fakeClientSet,
+				}
+				Expect(install.Create(context.Background())).To(Succeed())
+				Expect(p.MockEC2().AssertNumberOfCalls(GinkgoT(), "CreateTags", 1)).To(BeTrue())
+			})
+		})
+		When("a service account role is supplied", func() {
+			const roleARN = "arn:aws:iam::123456789012:role/karpenter-controller"
+
+			BeforeEach(func() {
+				cfg.Karpenter.ServiceAccountRoleARN = roleARN
+			})
+
+			It("attaches the role to the service account created by eksctl", func() {
+				install := &karpenteractions.Installer{
+					StackManager:       fakeStackManager,
+					CTL:                ctl,
+					Config:             cfg,
+					KarpenterInstaller: fakeKarpenterInstaller,
+					ClientSet:          fakeClientSet,
+				}
+				Expect(install.Create(context.Background())).To(Succeed())
+				Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(1))
+				serviceAccounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+				Expect(serviceAccounts).To(HaveLen(1))
+				Expect(serviceAccounts[0].AttachRoleARN).To(Equal(roleARN))
+				Expect(serviceAccounts[0].AttachPolicyARNs).To(BeEmpty())
+				_, serviceAccountRoleARN, _ := fakeKarpenterInstaller.InstallArgsForCall(0)
+				Expect(serviceAccountRoleARN).To(Equal(roleARN))
+			})
+
+			It("creates no IAM service account when the chart creates the service account", func() {
+				cfg.Karpenter.CreateServiceAccount = api.Enabled()
+				install := &karpenteractions.Installer{
+					StackManager:       fakeStackManager,
+					CTL:                ctl,
+					Config:             cfg,
+					KarpenterInstaller: fakeKarpenterInstaller,
+					ClientSet:          fakeClientSet,
+				}
+				Expect(install.Create(context.Background())).To(Succeed())
+				Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+				_, serviceAccountRoleARN, _ := fakeKarpenterInstaller.InstallArgsForCall(0)
+				Expect(serviceAccountRoleARN).To(Equal(roleARN))
+			})
+		})
 		When("Karpenter install fails", func() {
 			It("errors", func() {
 				fakeKarpenterInstaller.InstallReturns(errors.New("nope"))
@@ -77,15 +77,23 @@ func (k *karpenterIAMRolesTask) makeKarpenterStackName() string {
 
 // ensureSubnetsHaveTags sets of overwrites kubernetes.io/cluster/<name> tags on subnets with the current value.
 func (k *karpenterIAMRolesTask) ensureSubnetsHaveTags(ctx context.Context) error {
+	clusterTag := fmt.Sprintf(kubernetesTagFormat, k.cfg.Metadata.Name)
 	var ids []string
-	for _, subnet := range k.cfg.VPC.Subnets.Private {
-		ids = append(ids, subnet.ID)
+	for _, subnets := range []api.AZSubnetMapping{k.cfg.VPC.Subnets.Private, k.cfg.VPC.Subnets.Public} {
+		for _, subnet := range subnets {
+			// tags on subnets shared via AWS RAM can only be set by the owner account
+			if subnet.IsShared(k.cfg.Metadata.AccountID) {
+				logger.Warning("skipping tagging subnet %q as it is shared from account %s via AWS RAM; "+
+					"the subnet owner must add the tag %q for Karpenter to discover it", subnet.ID, subnet.OwnerID, clusterTag)
+				continue
+			}
+			ids = append(ids, subnet.ID)
+		}
 	}
-	for _, subnet := range k.cfg.VPC.Subnets.Public {
-		ids = append(ids, subnet.ID)
+	if len(ids) == 0 {
+		return nil
 	}
 	sort.Strings(ids)
-	clusterTag := fmt.Sprintf(kubernetesTagFormat, k.cfg.Metadata.Name)
 	creatTagsInput := &ec2.CreateTagsInput{
 		Resources: ids,
 		Tags: []ec2types.Tag{
@@ -0,0 +1,157 @@
+package loadbalancercontroller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// Namespace is the namespace the AWS Load Balancer Controller is installed into
+	Namespace = "kube-system"
+	// ServiceAccountName is the name of the service account used by the AWS Load Balancer Controller
+	ServiceAccountName = "aws-load-balancer-controller"
+	// ReleaseName is the name of the Helm release
+	ReleaseName = "aws-load-balancer-controller"
+
+	chartName    = "aws-load-balancer-controller"
+	chartRepoURL = "https://aws.github.io/eks-charts"
+	chartVersion = "1.6.2"
+
+	// PublicSubnetRoleTag marks public subnets for internet-facing load balancers
+	PublicSubnetRoleTag = "kubernetes.io/role/elb"
+	// PrivateSubnetRoleTag marks private subnets for internal load balancers
+	PrivateSubnetRoleTag = "kubernetes.io/role/internal-elb"
+)
+
+// Installer installs the AWS Load Balancer Controller on an existing cluster
+type Installer struct {
+	cfg             *api.ClusterConfig
+	stackManager    manager.StackManager
+	ec2API          awsapi.EC2
+	helmInstaller   providers.HelmInstaller
+	oidc            *iamoidc.OpenIDConnectManager
+	clientSetGetter kubernetes.ClientSetGetter
+}
+
+// NewInstaller creates a new Installer. The VPC and subnets of the cluster must be loaded into cfg.
+func NewInstaller(cfg *api.ClusterConfig, stackManager manager.StackManager, ec2API awsapi.EC2, helmInstaller providers.HelmInstaller,
+	oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *Installer {
+	return &Installer{
+		cfg:             cfg,
+		stackManager:    stackManager,
+		ec2API:          ec2API,
+		helmInstaller:   helmInstaller,
+		oidc:            oidc,
+		clientSetGetter: clientSetGetter,
+	}
+}
+
+// Options defines options for Install
+type Options struct {
+	// AccountID is the ID of the account owning the cluster, subnets shared from other accounts are not tagged
+	AccountID string
+	// Plan only logs the intended changes
+	Plan bool
+}
+
+// Install tags the cluster subnets for load balancer discovery, creates the IAM service account for the
+// AWS Load Balancer Controller and installs the AWS Load Balancer Controller Helm chart
+func (i *Installer) Install(ctx context.Context, opts Options) error {
+	if err := i.tagSubnets(ctx, i.cfg.VPC.Subnets.Public, PublicSubnetRoleTag, opts); err != nil {
+		return err
+	}
+	if err := i.tagSubnets(ctx, i.cfg.VPC.Subnets.Private, PrivateSubnetRoleTag, opts); err != nil {
+		return err
+	}
+	if err := i.createServiceAccount(ctx, opts.Plan); err != nil {
+		return err
+	}
+
+	if opts.Plan {
+		logger.Info("(plan) would install the AWS Load Balancer Controller in namespace %q", Namespace)
+		return nil
+	}
+	logger.Info("installing the AWS Load Balancer Controller")
+	if err := i.helmInstaller.InstallChart(ctx, providers.InstallChartOpts{
+		ChartName:   chartName,
+		RepoURL:     chartRepoURL,
+		Namespace:   Namespace,
+		ReleaseName: ReleaseName,
+		Version:     chartVersion,
+		Values: map[string]interface{}{
+			"clusterName": i.cfg.Metadata.Name,
+			"region":      i.cfg.Metadata.Region,
+			"vpcId":       i.cfg.VPC.ID,
+			"serviceAccount": map[string]interface{}{
+				"create": false,
+				"name":   ServiceAccountName,
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("installing the AWS Load Balancer Controller: %w", err)
+	}
+	logger.Success("installed the AWS Load Balancer Controller")
+	return nil
+}
+
+func (i *Installer) tagSubnets(ctx context.Context, subnets api.AZSubnetMapping, roleTag string, opts Options) error {
+	var subnetIDs []string
+	for _, subnet := range subnets {
+		if subnet.IsShared(opts.AccountID) {
+			logger.Warning("subnet %q is owned by account %s, it must be tagged with %s=1 by the owning account", subnet.ID, subnet.OwnerID, roleTag)
+			continue
+		}
+		subnetIDs = append(subnetIDs, subnet.ID)
+	}
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	sort.Strings(subnetIDs)
+
+	if opts.Plan {
+		logger.Info("(plan) would tag subnets %v with %s=1", subnetIDs, roleTag)
+		return nil
+	}
+	if _, err := i.ec2API.CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: subnetIDs,
+		Tags: []ec2types.Tag{
+			{
+				Key:   aws.String(roleTag),
+				Value: aws.String("1"),
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("tagging subnets %v with %s: %w", subnetIDs, roleTag, err)
+	}
+	logger.Info("tagged subnets %v with %s=1", subnetIDs, roleTag)
+	return nil
+}
+
+func (i *Installer) createServiceAccount(ctx context.Context, plan bool) error {
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      ServiceAccountName,
+			Namespace: Namespace,
+		},
+		WellKnownPolicies: api.WellKnownPolicies{
+			AWSLoadBalancerController: true,
+		},
+	}
+
+	_, _, err := irsa.EnsureIAMServiceAccount(ctx, i.stackManager, i.oidc, i.clientSetGetter, serviceAccount, plan)
+	return err
+}
@@ -0,0 +1,128 @@
+package loadbalancercontroller_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/loadbalancercontroller"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	providerfakes "github.com/weaveworks/eksctl/pkg/karpenter/providers/fakes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("AWS Load Balancer Controller", func() {
+	const accountID = "123456789012"
+
+	var (
+		cfg              *api.ClusterConfig
+		fakeStackManager *fakes.FakeStackManager
+		ec2API           *mocksv2.EC2
+		helmInstaller    *providerfakes.FakeHelmInstaller
+		installer        *loadbalancercontroller.Installer
+	)
+
+	mockCreateTags := func(tagKey string, subnetIDs ...string) {
+		ec2API.On("CreateTags", mock.Anything, &ec2.CreateTagsInput{
+			Resources: subnetIDs,
+			Tags: []ec2types.Tag{
+				{
+					Key:   aws.String(tagKey),
+					Value: aws.String("1"),
+				},
+			},
+		}).Return(&ec2.CreateTagsOutput{}, nil).Once()
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.VPC.ID = "vpc-1234"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Public: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {ID: "subnet-public-a"},
+				"us-west-2b": {ID: "subnet-public-b"},
+			}),
+			Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {ID: "subnet-private-a"},
+				"us-west-2b": {ID: "subnet-private-b", OwnerID: "111122223333"},
+			}),
+		}
+
+		fakeStackManager = &fakes.FakeStackManager{}
+		fakeStackManager.NewTasksToCreateIAMServiceAccountsReturns(&tasks.TaskTree{})
+		ec2API = &mocksv2.EC2{}
+		helmInstaller = &providerfakes.FakeHelmInstaller{}
+		installer = loadbalancercontroller.NewInstaller(cfg, fakeStackManager, ec2API, helmInstaller, nil, nil)
+	})
+
+	It("tags the subnets, creates the service account and installs the chart", func() {
+		mockCreateTags("kubernetes.io/role/elb", "subnet-public-a", "subnet-public-b")
+		mockCreateTags("kubernetes.io/role/internal-elb", "subnet-private-a")
+
+		Expect(installer.Install(context.Background(), loadbalancercontroller.Options{AccountID: accountID})).To(Succeed())
+		ec2API.AssertExpectations(GinkgoT())
+
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(1))
+		serviceAccounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+		Expect(serviceAccounts).To(HaveLen(1))
+		Expect(serviceAccounts[0].NameString()).To(Equal("kube-system/aws-load-balancer-controller"))
+		Expect(serviceAccounts[0].WellKnownPolicies.AWSLoadBalancerController).To(BeTrue())
+
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(1))
+		_, opts := helmInstaller.InstallChartArgsForCall(0)
+		Expect(opts).To(Equal(providers.InstallChartOpts{
+			ChartName:   "aws-load-balancer-controller",
+			RepoURL:     "https://aws.github.io/eks-charts",
+			Namespace:   "kube-system",
+			ReleaseName: "aws-load-balancer-controller",
+			Version:     "1.6.2",
+			Values: map[string]interface{}{
+				"clusterName": "my-cluster",
+				"region":      "us-west-2",
+				"vpcId":       "vpc-1234",
+				"serviceAccount": map[string]interface{}{
+					"create": false,
+					"name":   "aws-load-balancer-controller",
+				},
+			},
+		}))
+	})
+
+	It("does not create the service account when it already exists", func() {
+		ec2API.On("CreateTags", mock.Anything, mock.Anything).Return(&ec2.CreateTagsOutput{}, nil)
+		fakeStackManager.GetIAMServiceAccountsReturns([]*api.ClusterIAMServiceAccount{
+			{ClusterIAMMeta: api.ClusterIAMMeta{Name: "aws-load-balancer-controller", Namespace: "kube-system"}},
+		}, nil)
+
+		Expect(installer.Install(context.Background(), loadbalancercontroller.Options{AccountID: accountID})).To(Succeed())
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(1))
+	})
+
+	It("does not make any changes in plan mode", func() {
+		Expect(installer.Install(context.Background(), loadbalancercontroller.Options{AccountID: accountID, Plan: true})).To(Succeed())
+		ec2API.AssertNotCalled(GinkgoT(), "CreateTags", mock.Anything, mock.Anything)
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(0))
+	})
+
+	It("returns an error when tagging fails", func() {
+		ec2API.On("CreateTags", mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+
+		err := installer.Install(context.Background(), loadbalancercontroller.Options{AccountID: accountID})
+		Expect(err).To(MatchError(ContainSubstring("tagging subnets [subnet-public-a subnet-public-b] with kubernetes.io/role/elb: access denied")))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(0))
+	})
+})
@@ -0,0 +1,11 @@
+package loadbalancercontroller_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestLoadBalancerController(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
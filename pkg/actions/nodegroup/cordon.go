@@ -0,0 +1,26 @@
+package nodegroup
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// Cordon marks all nodes of the given nodegroups unschedulable, or schedulable again when cordon is false
+func (m *Manager) Cordon(ctx context.Context, nodeGroups []eks.KubeNodeGroup, cordon bool) error {
+	verb := "cordoned"
+	if !cordon {
+		verb = "uncordoned"
+	}
+	for _, ng := range nodeGroups {
+		updated, err := drain.CordonNodeGroup(ctx, m.clientSet, ng, cordon)
+		if err != nil {
+			return err
+		}
+		logger.Success("%s %d node(s) in nodegroup %q", verb, updated, ng.NameString())
+	}
+	return nil
+}
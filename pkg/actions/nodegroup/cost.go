@@ -0,0 +1,20 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cost"
+	"github.com/weaveworks/eksctl/pkg/utils/nodes"
+)
+
+// logCostEstimate logs the estimated monthly cost of the instances and volumes of the nodegroups to create
+func (m *Manager) logCostEstimate(ctx context.Context) error {
+	pricer := cost.NewPricer(m.ctl.AWSProvider.Pricing(), m.ctl.AWSProvider.Region())
+	estimate, err := cost.NewEstimator(pricer).EstimateNodeGroups(ctx, nodes.ToNodePools(m.cfg))
+	if err != nil {
+		return err
+	}
+	estimate.Log(fmt.Sprintf("the nodegroups of cluster %q", m.cfg.Metadata.Name))
+	return nil
+}
@@ -8,10 +8,12 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
+	"github.com/weaveworks/eksctl/pkg/actions/hooks"
+	"github.com/weaveworks/eksctl/pkg/actions/nodeterminationhandler"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
 	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
-	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
@@ -31,6 +33,14 @@ type CreateOpts struct {
 	DryRunSettings            DryRunSettings
 	SkipOutdatedAddonsCheck   bool
 	ConfigFileProvided        bool
+	// QuotaCheck is what to do when the vCPU quotas do not allow the instances of the nodegroups to be launched,
+	// the quotas are not checked if it is empty
+	QuotaCheck preflight.QuotaCheckMode
+	// EstimateCost logs the estimated monthly cost of the nodegroups instead of creating them
+	EstimateCost bool
+	// SpotPlacement is what to do with the Spot placement scores of the availability zones of Spot nodegroups,
+	// the scores are not obtained if it is empty
+	SpotPlacement preflight.SpotPlacementMode
 }
 
 type DryRunSettings struct {
@@ -125,7 +135,7 @@ func (m *Manager) Create(ctx context.Context, options CreateOpts, nodegroupFilte
 		return err
 	}
 
-	logFiltered := cmdutils.ApplyFilter(cfg, nodegroupFilter)
+	logFiltered := filter.ApplyNodeGroupFilter(cfg, nodegroupFilter)
 	logFiltered()
 	logMsg := func(resource string, count int) {
 		logger.Info("will create a CloudFormation stack for each of %d %s in cluster %q", count, resource, meta.Name)
@@ -144,9 +154,20 @@ func (m *Manager) Create(ctx context.Context, options CreateOpts, nodegroupFilte
 		clusterConfigCopy.NodeGroups = cfg.NodeGroups
 		clusterConfigCopy.ManagedNodeGroups = cfg.ManagedNodeGroups
 		if options.ConfigFileProvided {
-			return cmdutils.PrintDryRunConfig(clusterConfigCopy, options.DryRunSettings.OutStream)
+			return printers.PrintDryRunConfig(clusterConfigCopy, options.DryRunSettings.OutStream)
 		}
-		return cmdutils.PrintNodeGroupDryRunConfig(clusterConfigCopy, options.DryRunSettings.OutStream)
+		return printers.PrintNodeGroupDryRunConfig(clusterConfigCopy, options.DryRunSettings.OutStream)
+	}
+
+	if options.EstimateCost {
+		return m.logCostEstimate(ctx)
+	}
+
+	preflight.NewSpotPlacementScorer(ctl.AWSProvider.EC2(), ctl.AWSProvider.Region()).
+		ApplySpotPlacementScores(ctx, cfg, nodes.ToNodePools(cfg), options.SpotPlacement)
+
+	if err := m.checkQuotas(ctx, options.QuotaCheck); err != nil {
+		return err
 	}
 
 	if err := m.nodeCreationTasks(ctx, isOwnedCluster); err != nil {
@@ -157,6 +178,12 @@ func (m *Manager) Create(ctx context.Context, options CreateOpts, nodegroupFilte
 		return err
 	}
 
+	if cfg.Hooks != nil {
+		if err := hooks.RunForCluster(ctl, cfg, hooks.PostNodeGroupCreate, cfg.Hooks.PostNodeGroupCreate); err != nil {
+			return err
+		}
+	}
+
 	if err := eks.ValidateExistingNodeGroupsForCompatibility(ctx, cfg, m.stackManager); err != nil {
 		logger.Critical("failed checking nodegroups", err.Error())
 	}
@@ -272,6 +299,7 @@ func (m *Manager) postNodeCreationTasks(ctx context.Context, clientSet kubernete
 		}
 	}
 	logger.Success("created %d nodegroup(s) in cluster %q", len(m.cfg.NodeGroups), m.cfg.Metadata.Name)
+	nodeterminationhandler.SuggestInstall(m.cfg)
 	for _, ng := range m.cfg.ManagedNodeGroups {
 		if err := eks.WaitForNodes(timeoutCtx, clientSet, ng); err != nil {
 			if m.cfg.PrivateCluster.Enabled {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 
@@ -49,6 +50,17 @@ func (m *Manager) Delete(ctx context.Context, nodeGroups []*api.NodeGroup, manag
 
 	tasks.PlanMode = plan
 	logger.Info(tasks.Describe())
+	if plan {
+		var stacksToDelete []*manager.Stack
+		for _, s := range stacks {
+			if shouldDelete(s.NodeGroupName) {
+				stacksToDelete = append(stacksToDelete, s.Stack)
+			}
+		}
+		if err := manager.LogStacksToDelete(ctx, m.stackManager, stacksToDelete, nil); err != nil {
+			return err
+		}
+	}
 	if errs := tasks.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "nodegroup(s)")
 	}
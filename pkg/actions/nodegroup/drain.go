@@ -21,6 +21,7 @@ type DrainInput struct {
 	Undo                  bool
 	DisableEviction       bool
 	Parallel              int
+	EvictionOptions       drain.EvictionOptions
 }
 
 func (m *Manager) Drain(ctx context.Context, input *DrainInput) error {
@@ -36,7 +37,7 @@ func (m *Manager) Drain(ctx context.Context, input *DrainInput) error {
 	for _, nodegroup := range input.NodeGroups {
 		nodegroup := nodegroup
 		g.Go(func() error {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, nodegroup, input.MaxGracePeriod, input.NodeDrainWaitPeriod, input.PodEvictionWaitPeriod, input.Undo, input.DisableEviction, input.Parallel)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, nodegroup, input.MaxGracePeriod, input.NodeDrainWaitPeriod, input.PodEvictionWaitPeriod, input.Undo, input.DisableEviction, input.Parallel, input.EvictionOptions)
 			return nodeGroupDrainer.Drain(ctx, sem)
 		})
 	}
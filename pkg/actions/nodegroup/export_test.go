@@ -1,6 +1,8 @@
 package nodegroup
 
 import (
+	"time"
+
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
 )
@@ -21,3 +23,7 @@ func (m *Manager) GetStackManager() manager.StackManager {
 func (m *Manager) MockKubeProvider(k eks.KubeProvider) {
 	m.ctl.KubeProvider = k
 }
+
+func SetRollPollInterval(interval time.Duration) {
+	rollPollInterval = interval
+}
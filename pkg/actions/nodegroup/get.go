@@ -43,6 +43,29 @@ type Summary struct {
 	AutoScalingGroupName string
 	Version              string
 	NodeGroupType        api.NodeGroupType `json:"Type"`
+	// ReleaseVersion is the AMI release version of a managed nodegroup using an EKS optimized AMI
+	ReleaseVersion string `json:",omitempty"`
+	// LatestReleaseVersion is the latest AMI release version available for the Kubernetes version of the nodegroup,
+	// it is only set by AddUpdateStatus for the wide output
+	LatestReleaseVersion string `json:",omitempty"`
+	// LaunchTemplateVersion is the version of the launch template used by a managed nodegroup
+	LaunchTemplateVersion string `json:",omitempty"`
+	// HealthIssues lists the codes of the health issues reported by EKS for a managed nodegroup
+	HealthIssues []string `json:",omitempty"`
+	// UpdateStatus is the type and status of the most recent update of a managed nodegroup,
+	// it is only set by AddUpdateStatus for the wide output
+	UpdateStatus string `json:",omitempty"`
+}
+
+// FilterOwned returns the summaries of the nodegroups created by eksctl, which are the ones with a stack
+func FilterOwned(summaries []*Summary) []*Summary {
+	owned := make([]*Summary, 0)
+	for _, s := range summaries {
+		if s.StackName != "" {
+			owned = append(owned, s)
+		}
+	}
+	return owned
 }
 
 func (m *Manager) GetAll(ctx context.Context) ([]*Summary, error) {
@@ -293,32 +316,110 @@ func (m *Manager) getManagedSummary(ctx context.Context, nodeGroupName string) (
 		}
 	}
 
-	var imageID string
+	var imageID, releaseVersion string
 	if ng.AmiType == ekstypes.AMITypesCustom {
 		// ReleaseVersion contains the AMI ID for custom AMIs.
 		imageID = *ng.ReleaseVersion
 	} else {
 		imageID = string(ng.AmiType)
+		releaseVersion = aws.ToString(ng.ReleaseVersion)
+	}
+
+	var launchTemplateVersion string
+	if ng.LaunchTemplate != nil {
+		launchTemplateVersion = aws.ToString(ng.LaunchTemplate.Version)
+	}
+
+	var healthIssues []string
+	if ng.Health != nil {
+		for _, issue := range ng.Health.Issues {
+			healthIssues = append(healthIssues, string(issue.Code))
+		}
 	}
 
 	return &Summary{
-		StackName:            aws.ToString(stack.StackName),
-		Name:                 *ng.NodegroupName,
-		Cluster:              *ng.ClusterName,
-		Status:               string(ng.Status),
-		MaxSize:              int(*ng.ScalingConfig.MaxSize),
-		MinSize:              int(*ng.ScalingConfig.MinSize),
-		DesiredCapacity:      int(*ng.ScalingConfig.DesiredSize),
-		InstanceType:         m.getInstanceTypes(ctx, ng),
-		ImageID:              imageID,
-		CreationTime:         *ng.CreatedAt,
-		NodeInstanceRoleARN:  *ng.NodeRole,
-		AutoScalingGroupName: strings.Join(asgs, ","),
-		Version:              getOptionalValue(ng.Version),
-		NodeGroupType:        api.NodeGroupTypeManaged,
+		StackName:             aws.ToString(stack.StackName),
+		Name:                  *ng.NodegroupName,
+		Cluster:               *ng.ClusterName,
+		Status:                string(ng.Status),
+		MaxSize:               int(*ng.ScalingConfig.MaxSize),
+		MinSize:               int(*ng.ScalingConfig.MinSize),
+		DesiredCapacity:       int(*ng.ScalingConfig.DesiredSize),
+		InstanceType:          m.getInstanceTypes(ctx, ng),
+		ImageID:               imageID,
+		CreationTime:          *ng.CreatedAt,
+		NodeInstanceRoleARN:   *ng.NodeRole,
+		AutoScalingGroupName:  strings.Join(asgs, ","),
+		Version:               getOptionalValue(ng.Version),
+		NodeGroupType:         api.NodeGroupTypeManaged,
+		ReleaseVersion:        releaseVersion,
+		LaunchTemplateVersion: launchTemplateVersion,
+		HealthIssues:          healthIssues,
 	}, nil
 }
 
+// AddUpdateStatus sets the status of the most recent update and the latest AMI release version
+// available of the managed nodegroups in summaries. It is best-effort, as the status is only
+// informational, failures are logged and leave the fields of the nodegroup unset
+func (m *Manager) AddUpdateStatus(ctx context.Context, summaries []*Summary) {
+	for _, summary := range summaries {
+		if summary.NodeGroupType != api.NodeGroupTypeManaged {
+			continue
+		}
+
+		updateStatus, err := m.getLatestUpdateStatus(ctx, summary.Name)
+		if err != nil {
+			logger.Warning("unable to get the update status of nodegroup %q: %v", summary.Name, err)
+		} else {
+			summary.UpdateStatus = updateStatus
+		}
+
+		if summary.ReleaseVersion == "" || summary.Version == "-" {
+			continue
+		}
+		// ImageID holds the AMI type of managed nodegroups using an EKS optimized AMI
+		latestReleaseVersion, err := m.getLatestReleaseVersion(ctx, summary.Version, &ekstypes.Nodegroup{
+			AmiType: ekstypes.AMITypes(summary.ImageID),
+		})
+		if err != nil {
+			logger.Warning("unable to get the latest release version of nodegroup %q: %v", summary.Name, err)
+			continue
+		}
+		summary.LatestReleaseVersion = latestReleaseVersion
+	}
+}
+
+func (m *Manager) getLatestUpdateStatus(ctx context.Context, nodeGroupName string) (string, error) {
+	var latest *ekstypes.Update
+	paginator := eks.NewListUpdatesPaginator(m.ctl.AWSProvider.EKS(), &eks.ListUpdatesInput{
+		Name:          aws.String(m.cfg.Metadata.Name),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	for paginator.HasMorePages() {
+		updates, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("listing updates: %w", err)
+		}
+		for _, updateID := range updates.UpdateIds {
+			out, err := m.ctl.AWSProvider.EKS().DescribeUpdate(ctx, &eks.DescribeUpdateInput{
+				Name:          aws.String(m.cfg.Metadata.Name),
+				NodegroupName: aws.String(nodeGroupName),
+				UpdateId:      aws.String(updateID),
+			})
+			if err != nil {
+				return "", fmt.Errorf("describing update %q: %w", updateID, err)
+			}
+			if latest == nil || aws.ToTime(out.Update.CreatedAt).After(aws.ToTime(latest.CreatedAt)) {
+				latest = out.Update
+			}
+		}
+	}
+	if latest == nil {
+		return "", nil
+	}
+	return fmt.Sprintf("%s %s", latest.Type, latest.Status), nil
+}
+
 func (m *Manager) getInstanceTypes(ctx context.Context, ng *ekstypes.Nodegroup) string {
 	if len(ng.InstanceTypes) > 0 {
 		return strings.Join(ng.InstanceTypes, ",")
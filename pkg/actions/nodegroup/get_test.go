@@ -14,6 +14,8 @@ import (
 
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/aws/smithy-go"
 
 	corev1 "k8s.io/api/core/v1"
@@ -133,6 +135,7 @@ var _ = Describe("Get", func() {
 						Version:              "1.18",
 						NodeGroupType:        api.NodeGroupTypeManaged,
 					}))
+					Expect(nodegroup.FilterOwned(summaries)).To(Equal(summaries))
 				})
 			})
 
@@ -197,6 +200,7 @@ var _ = Describe("Get", func() {
 						Version:              "1.18",
 						NodeGroupType:        api.NodeGroupTypeManaged,
 					}))
+					Expect(nodegroup.FilterOwned(summaries)).To(BeEmpty())
 				})
 			})
 
@@ -261,24 +265,128 @@ var _ = Describe("Get", func() {
 
 					ngSummary := *summaries[0]
 					Expect(ngSummary).To(Equal(nodegroup.Summary{
-						StackName:            "",
-						Cluster:              clusterName,
-						Name:                 ngName,
-						Status:               "my-status",
-						MaxSize:              4,
-						MinSize:              0,
-						DesiredCapacity:      2,
-						InstanceType:         "big",
-						ImageID:              "ami-type",
-						CreationTime:         t,
-						NodeInstanceRoleARN:  "node-role",
-						AutoScalingGroupName: "asg-name",
-						Version:              "1.18",
-						NodeGroupType:        api.NodeGroupTypeManaged,
+						StackName:             "",
+						Cluster:               clusterName,
+						Name:                  ngName,
+						Status:                "my-status",
+						MaxSize:               4,
+						MinSize:               0,
+						DesiredCapacity:       2,
+						InstanceType:          "big",
+						ImageID:               "ami-type",
+						CreationTime:          t,
+						NodeInstanceRoleARN:   "node-role",
+						AutoScalingGroupName:  "asg-name",
+						Version:               "1.18",
+						NodeGroupType:         api.NodeGroupTypeManaged,
+						LaunchTemplateVersion: "5",
 					}))
 				})
 			})
 
+			When("a nodegroup has health issues and updates", func() {
+				BeforeEach(func() {
+					p.MockEKS().On("DescribeNodegroup", mock.Anything, mock.Anything).Return(&awseks.DescribeNodegroupOutput{
+						Nodegroup: &ekstypes.Nodegroup{
+							NodegroupName: aws.String(ngName),
+							ClusterName:   aws.String(clusterName),
+							Status:        "DEGRADED",
+							ScalingConfig: &ekstypes.NodegroupScalingConfig{
+								DesiredSize: aws.Int32(2),
+								MaxSize:     aws.Int32(4),
+								MinSize:     aws.Int32(0),
+							},
+							InstanceTypes:  []string{"m5.large"},
+							AmiType:        ekstypes.AMITypesAl2X8664,
+							ReleaseVersion: aws.String("1.30.0-20240601"),
+							CreatedAt:      &t,
+							NodeRole:       aws.String("node-role"),
+							Version:        aws.String("1.30"),
+							Health: &ekstypes.NodegroupHealth{
+								Issues: []ekstypes.Issue{
+									{Code: ekstypes.NodegroupIssueCodeAsgInstanceLaunchFailures},
+									{Code: ekstypes.NodegroupIssueCodeInstanceLimitExceeded},
+								},
+							},
+						},
+					}, nil)
+					fakeStackManager.DescribeNodeGroupStackReturns(nil, fmt.Errorf("error describing cloudformation stack"))
+				})
+
+				It("returns the health issue codes and the release version", func() {
+					summaries, err := m.GetAll(context.Background())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(summaries).To(HaveLen(1))
+					Expect(summaries[0].HealthIssues).To(Equal([]string{"AsgInstanceLaunchFailures", "InstanceLimitExceeded"}))
+					Expect(summaries[0].ReleaseVersion).To(Equal("1.30.0-20240601"))
+					Expect(summaries[0].UpdateStatus).To(BeEmpty())
+					Expect(summaries[0].LatestReleaseVersion).To(BeEmpty())
+				})
+
+				It("adds the status of the most recent update and the latest release version", func() {
+					p.MockEKS().On("ListUpdates", mock.Anything, &awseks.ListUpdatesInput{
+						Name:          aws.String(clusterName),
+						NodegroupName: aws.String(ngName),
+					}, mock.Anything).Return(&awseks.ListUpdatesOutput{
+						UpdateIds: []string{"old-update"},
+						NextToken: aws.String("token"),
+					}, nil)
+					p.MockEKS().On("ListUpdates", mock.Anything, &awseks.ListUpdatesInput{
+						Name:          aws.String(clusterName),
+						NodegroupName: aws.String(ngName),
+						NextToken:     aws.String("token"),
+					}, mock.Anything).Return(&awseks.ListUpdatesOutput{
+						UpdateIds: []string{"new-update"},
+					}, nil)
+					p.MockEKS().On("DescribeUpdate", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
+						return *input.UpdateId == "old-update"
+					})).Return(&awseks.DescribeUpdateOutput{
+						Update: &ekstypes.Update{
+							Type:      ekstypes.UpdateTypeConfigUpdate,
+							Status:    ekstypes.UpdateStatusSuccessful,
+							CreatedAt: aws.Time(t.Add(-time.Hour)),
+						},
+					}, nil)
+					p.MockEKS().On("DescribeUpdate", mock.Anything, mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
+						return *input.UpdateId == "new-update"
+					})).Return(&awseks.DescribeUpdateOutput{
+						Update: &ekstypes.Update{
+							Type:      ekstypes.UpdateTypeVersionUpdate,
+							Status:    ekstypes.UpdateStatusInProgress,
+							CreatedAt: aws.Time(t),
+						},
+					}, nil)
+					p.MockSSM().On("GetParameter", mock.Anything, &ssm.GetParameterInput{
+						Name: aws.String("/aws/service/eks/optimized-ami/1.30/amazon-linux-2/recommended/release_version"),
+					}).Return(&ssm.GetParameterOutput{
+						Parameter: &ssmtypes.Parameter{
+							Value: aws.String("1.30.0-20240701"),
+						},
+					}, nil)
+
+					summaries, err := m.GetAll(context.Background())
+					Expect(err).NotTo(HaveOccurred())
+					m.AddUpdateStatus(context.Background(), summaries)
+					Expect(summaries[0].UpdateStatus).To(Equal("VersionUpdate InProgress"))
+					Expect(summaries[0].LatestReleaseVersion).To(Equal("1.30.0-20240701"))
+				})
+
+				It("leaves the update status unset when the updates cannot be listed", func() {
+					p.MockEKS().On("ListUpdates", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+					p.MockSSM().On("GetParameter", mock.Anything, mock.Anything).Return(&ssm.GetParameterOutput{
+						Parameter: &ssmtypes.Parameter{
+							Value: aws.String("1.30.0-20240701"),
+						},
+					}, nil)
+
+					summaries, err := m.GetAll(context.Background())
+					Expect(err).NotTo(HaveOccurred())
+					m.AddUpdateStatus(context.Background(), summaries)
+					Expect(summaries[0].UpdateStatus).To(BeEmpty())
+					Expect(summaries[0].LatestReleaseVersion).To(Equal("1.30.0-20240701"))
+				})
+			})
+
 			When("a nodegroup has a custom AMI", func() {
 				BeforeEach(func() {
 					p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
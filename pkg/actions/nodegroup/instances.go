@@ -0,0 +1,146 @@
+package nodegroup
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// describeInstancesBatchSize is the number of instance IDs passed to each DescribeInstances call
+const describeInstancesBatchSize = 100
+
+const (
+	// InstanceLifecycleOnDemand is the lifecycle of on-demand instances
+	InstanceLifecycleOnDemand = "on-demand"
+	// InstanceLifecycleSpot is the lifecycle of spot instances
+	InstanceLifecycleSpot = "spot"
+)
+
+// InstanceSummary represents a node of the cluster and its EC2 instance
+type InstanceSummary struct {
+	NodeName         string
+	InstanceID       string
+	InstanceType     string
+	Lifecycle        string
+	AvailabilityZone string
+	ImageID          string
+	LaunchTime       time.Time
+	NodeGroup        string
+}
+
+// GetInstances returns a summary of the EC2 instance of each node of the cluster, sorted by node name.
+// Nodes that are not backed by an EC2 instance, e.g. Fargate nodes, only have their name and nodegroup set
+func (m *Manager) GetInstances(ctx context.Context) ([]*InstanceSummary, error) {
+	nodes, err := m.clientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+
+	// Create an empty array here so that an object is returned rather than null
+	summaries := make([]*InstanceSummary, 0, len(nodes.Items))
+	summariesByInstanceID := map[string]*InstanceSummary{}
+	var instanceIDs []string
+	for _, node := range nodes.Items {
+		summary := &InstanceSummary{
+			NodeName:  node.Name,
+			NodeGroup: getNodeGroupName(node),
+		}
+		summaries = append(summaries, summary)
+		if instanceID := getInstanceID(node); instanceID != "" {
+			summary.InstanceID = instanceID
+			summariesByInstanceID[instanceID] = summary
+			instanceIDs = append(instanceIDs, instanceID)
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].NodeName < summaries[j].NodeName
+	})
+
+	for start := 0; start < len(instanceIDs); start += describeInstancesBatchSize {
+		end := start + describeInstancesBatchSize
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+		instances, err := m.describeInstances(ctx, instanceIDs[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances {
+			summary, ok := summariesByInstanceID[aws.ToString(instance.InstanceId)]
+			if !ok {
+				continue
+			}
+			summary.InstanceType = string(instance.InstanceType)
+			summary.Lifecycle = InstanceLifecycleOnDemand
+			if instance.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot {
+				summary.Lifecycle = InstanceLifecycleSpot
+			}
+			if instance.Placement != nil {
+				summary.AvailabilityZone = aws.ToString(instance.Placement.AvailabilityZone)
+			}
+			summary.ImageID = aws.ToString(instance.ImageId)
+			summary.LaunchTime = aws.ToTime(instance.LaunchTime)
+		}
+	}
+
+	for _, summary := range summaries {
+		if summary.InstanceID != "" && summary.InstanceType == "" {
+			logger.Warning("no EC2 instance found for node %q", summary.NodeName)
+		}
+	}
+	return summaries, nil
+}
+
+func (m *Manager) describeInstances(ctx context.Context, instanceIDs []string) ([]ec2types.Instance, error) {
+	var (
+		instances []ec2types.Instance
+		nextToken *string
+	)
+	for {
+		out, err := m.ctl.AWSProvider.EC2().DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+			InstanceIds: instanceIDs,
+			NextToken:   nextToken,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "describing instances")
+		}
+		for _, reservation := range out.Reservations {
+			instances = append(instances, reservation.Instances...)
+		}
+		if out.NextToken == nil {
+			return instances, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
+// getInstanceID returns the ID of the EC2 instance of a node from its provider ID,
+// which has the form aws:///<availability-zone>/<instance-id>
+func getInstanceID(node corev1.Node) string {
+	if !strings.HasPrefix(node.Spec.ProviderID, "aws:///") {
+		return ""
+	}
+	instanceID := node.Spec.ProviderID[strings.LastIndex(node.Spec.ProviderID, "/")+1:]
+	if !strings.HasPrefix(instanceID, "i-") {
+		return ""
+	}
+	return instanceID
+}
+
+func getNodeGroupName(node corev1.Node) string {
+	if name, ok := node.Labels[api.NodeGroupNameLabel]; ok {
+		return name
+	}
+	return node.Labels[api.EKSNodeGroupNameLabel]
+}
@@ -0,0 +1,112 @@
+package nodegroup_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("GetInstances", func() {
+	var (
+		p          *mockprovider.MockProvider
+		m          *nodegroup.Manager
+		launchTime = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	newNode := func(name, providerID string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Spec:       corev1.NodeSpec{ProviderID: providerID},
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		clientSet := fake.NewSimpleClientset(
+			newNode("node-b", "aws:///us-west-2b/i-2", map[string]string{api.EKSNodeGroupNameLabel: "managed-ng"}),
+			newNode("node-a", "aws:///us-west-2a/i-1", map[string]string{api.NodeGroupNameLabel: "unmanaged-ng"}),
+			newNode("fargate-node", "aws:///us-west-2a/fargate-ip-10-0-0-1", nil),
+		)
+		m = nodegroup.New(cfg, &eks.ClusterProvider{AWSProvider: p}, clientSet, nil)
+	})
+
+	It("returns the EC2 instance of each node", func() {
+		p.MockEC2().On("DescribeInstances", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeInstancesInput) bool {
+			return len(input.InstanceIds) == 2
+		})).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []ec2types.Reservation{
+				{
+					Instances: []ec2types.Instance{
+						{
+							InstanceId:   aws.String("i-1"),
+							InstanceType: ec2types.InstanceTypeM5Large,
+							Placement:    &ec2types.Placement{AvailabilityZone: aws.String("us-west-2a")},
+							ImageId:      aws.String("ami-1"),
+							LaunchTime:   aws.Time(launchTime),
+						},
+						{
+							InstanceId:        aws.String("i-2"),
+							InstanceType:      ec2types.InstanceTypeC5Xlarge,
+							InstanceLifecycle: ec2types.InstanceLifecycleTypeSpot,
+							Placement:         &ec2types.Placement{AvailabilityZone: aws.String("us-west-2b")},
+							ImageId:           aws.String("ami-2"),
+							LaunchTime:        aws.Time(launchTime),
+						},
+					},
+				},
+			},
+		}, nil)
+
+		instances, err := m.GetInstances(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(Equal([]*nodegroup.InstanceSummary{
+			{
+				NodeName: "fargate-node",
+			},
+			{
+				NodeName:         "node-a",
+				InstanceID:       "i-1",
+				InstanceType:     "m5.large",
+				Lifecycle:        nodegroup.InstanceLifecycleOnDemand,
+				AvailabilityZone: "us-west-2a",
+				ImageID:          "ami-1",
+				LaunchTime:       launchTime,
+				NodeGroup:        "unmanaged-ng",
+			},
+			{
+				NodeName:         "node-b",
+				InstanceID:       "i-2",
+				InstanceType:     "c5.xlarge",
+				Lifecycle:        nodegroup.InstanceLifecycleSpot,
+				AvailabilityZone: "us-west-2b",
+				ImageID:          "ami-2",
+				LaunchTime:       launchTime,
+				NodeGroup:        "managed-ng",
+			},
+		}))
+	})
+
+	It("returns an error when the instances cannot be described", func() {
+		p.MockEC2().On("DescribeInstances", mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+
+		_, err := m.GetInstances(context.Background())
+		Expect(err).To(MatchError("describing instances: access denied"))
+	})
+})
@@ -0,0 +1,145 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/utils/nodes"
+)
+
+func (m *Manager) newQuotaChecker() *preflight.QuotaChecker {
+	return preflight.NewQuotaChecker(m.ctl.AWSProvider.EC2(), m.ctl.AWSProvider.ServiceQuotas())
+}
+
+// checkQuotas checks that the vCPU quotas allow the instances of the nodegroups to create
+func (m *Manager) checkQuotas(ctx context.Context, mode preflight.QuotaCheckMode) error {
+	if mode == "" || mode == preflight.QuotaCheckSkip {
+		return nil
+	}
+	var requests []preflight.InstanceRequest
+	for _, ng := range nodes.ToNodePools(m.cfg) {
+		requests = append(requests, preflight.NodeGroupInstanceRequests(ng)...)
+	}
+	return m.newQuotaChecker().EnforceVCPUQuotas(ctx, requests, mode)
+}
+
+// CheckScalingQuotas checks that the vCPU quotas allow the instances launched by scaling out a nodegroup
+func (m *Manager) CheckScalingQuotas(ctx context.Context, ng *api.NodeGroupBase, mode preflight.QuotaCheckMode) error {
+	if mode == "" || mode == preflight.QuotaCheckSkip {
+		return nil
+	}
+	nodePool, nodeCount, err := m.describeNodePool(ctx, ng.Name)
+	if err != nil {
+		logger.Warning("unable to check the vCPU quotas of nodegroup %q: %v", ng.Name, err)
+		return nil
+	}
+	desiredCount := nodeCount
+	if ng.DesiredCapacity != nil {
+		desiredCount = *ng.DesiredCapacity
+	} else if ng.MinSize != nil && *ng.MinSize > nodeCount {
+		desiredCount = *ng.MinSize
+	}
+	requests := preflight.ScaleInstanceRequests(nodePool, nodeCount, desiredCount)
+	return m.newQuotaChecker().EnforceVCPUQuotas(ctx, requests, mode)
+}
+
+// describeNodePool returns the instance types and capacity type of an existing nodegroup, and its desired capacity
+func (m *Manager) describeNodePool(ctx context.Context, name string) (api.NodePool, int, error) {
+	stackInfos, err := m.stackManager.DescribeNodeGroupStacksAndResources(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if stackInfo, ok := stackInfos[name]; ok {
+		nodeGroupType, err := manager.GetNodeGroupType(stackInfo.Stack.Tags)
+		if err != nil {
+			return nil, 0, err
+		}
+		if nodeGroupType == api.NodeGroupTypeUnmanaged {
+			return m.describeUnmanagedNodePool(ctx, stackInfo)
+		}
+	}
+	return m.describeManagedNodePool(ctx, name)
+}
+
+func (m *Manager) describeManagedNodePool(ctx context.Context, name string) (api.NodePool, int, error) {
+	output, err := m.ctl.AWSProvider.EKS().DescribeNodegroup(ctx, &awseks.DescribeNodegroupInput{
+		ClusterName:   aws.String(m.cfg.Metadata.Name),
+		NodegroupName: aws.String(name),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("describing nodegroup: %w", err)
+	}
+	describedNG := output.Nodegroup
+	ng := api.NewManagedNodeGroup()
+	ng.InstanceTypes = describedNG.InstanceTypes
+	if len(ng.InstanceTypes) == 0 {
+		if instanceType := m.getInstanceTypes(ctx, describedNG); instanceType != "-" {
+			ng.InstanceType = instanceType
+		}
+	}
+	ng.Spot = describedNG.CapacityType == ekstypes.CapacityTypesSpot
+	var nodeCount int
+	if describedNG.ScalingConfig != nil {
+		nodeCount = int(aws.ToInt32(describedNG.ScalingConfig.DesiredSize))
+	}
+	return ng, nodeCount, nil
+}
+
+func (m *Manager) describeUnmanagedNodePool(ctx context.Context, stackInfo manager.StackInfo) (api.NodePool, int, error) {
+	asgName, err := nodeGroupASGName(stackInfo)
+	if err != nil {
+		return nil, 0, err
+	}
+	output, err := m.ctl.AWSProvider.ASG().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{asgName},
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("describing Auto Scaling group %q: %w", asgName, err)
+	}
+	if len(output.AutoScalingGroups) != 1 {
+		return nil, 0, fmt.Errorf("expected to find exactly one Auto Scaling group %q; got %d", asgName, len(output.AutoScalingGroups))
+	}
+	asg := output.AutoScalingGroups[0]
+
+	ng := api.NewNodeGroup()
+	if policy := asg.MixedInstancesPolicy; policy != nil {
+		distribution := &api.NodeGroupInstancesDistribution{}
+		if d := policy.InstancesDistribution; d != nil {
+			if d.OnDemandBaseCapacity != nil {
+				distribution.OnDemandBaseCapacity = aws.Int(int(*d.OnDemandBaseCapacity))
+			}
+			if d.OnDemandPercentageAboveBaseCapacity != nil {
+				distribution.OnDemandPercentageAboveBaseCapacity = aws.Int(int(*d.OnDemandPercentageAboveBaseCapacity))
+			}
+		}
+		if policy.LaunchTemplate != nil {
+			for _, override := range policy.LaunchTemplate.Overrides {
+				distribution.InstanceTypes = append(distribution.InstanceTypes, aws.ToString(override.InstanceType))
+			}
+		}
+		ng.InstancesDistribution = distribution
+	} else if lt := asg.LaunchTemplate; lt != nil {
+		versions, err := m.ctl.AWSProvider.EC2().DescribeLaunchTemplateVersions(ctx, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: lt.LaunchTemplateId,
+			Versions:         []string{aws.ToString(lt.Version)},
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("describing launch template %q: %w", aws.ToString(lt.LaunchTemplateId), err)
+		}
+		if len(versions.LaunchTemplateVersions) == 1 && versions.LaunchTemplateVersions[0].LaunchTemplateData != nil {
+			ng.InstanceType = string(versions.LaunchTemplateVersions[0].LaunchTemplateData.InstanceType)
+		}
+	}
+	return ng, int(aws.ToInt32(asg.DesiredCapacity)), nil
+}
@@ -0,0 +1,171 @@
+package nodegroup_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("CheckScalingQuotas", func() {
+	const (
+		clusterName = "my-cluster"
+		ngName      = "my-ng"
+	)
+
+	var (
+		p                *mockprovider.MockProvider
+		m                *nodegroup.Manager
+		fakeStackManager *fakes.FakeStackManager
+		ng               *api.NodeGroupBase
+	)
+
+	mockNodeGroupStack := func(nodeGroupType api.NodeGroupType) {
+		fakeStackManager.DescribeNodeGroupStacksAndResourcesReturns(map[string]manager.StackInfo{
+			ngName: {
+				Stack: &manager.Stack{
+					Tags: []types.Tag{
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(nodeGroupType))},
+					},
+				},
+				Resources: []types.StackResource{
+					{PhysicalResourceId: aws.String("asg-1234"), LogicalResourceId: aws.String("NodeGroup")},
+				},
+			},
+		}, nil)
+	}
+
+	// mockVCPUQuota mocks an account with no running instances and a quota of 16 vCPUs, in which each instance has
+	// 4 vCPUs
+	mockVCPUQuota := func(quotaCode string) {
+		p.MockEC2().On("DescribeInstanceTypes", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []ec2types.InstanceTypeInfo{
+				{InstanceType: "m5.xlarge", VCpuInfo: &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(4)}},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeInstances", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstancesOutput{}, nil)
+		p.MockServiceQuotas().On("GetServiceQuota", mock.Anything, &servicequotas.GetServiceQuotaInput{
+			ServiceCode: aws.String("ec2"),
+			QuotaCode:   aws.String(quotaCode),
+		}).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(16)},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		m = nodegroup.New(cfg, &eks.ClusterProvider{AWSProvider: p}, fake.NewSimpleClientset(), nil)
+		fakeStackManager = new(fakes.FakeStackManager)
+		m.SetStackManager(fakeStackManager)
+
+		ng = &api.NodeGroupBase{
+			Name:          ngName,
+			ScalingConfig: &api.ScalingConfig{},
+		}
+	})
+
+	Context("managed nodegroup", func() {
+		BeforeEach(func() {
+			mockNodeGroupStack(api.NodeGroupTypeManaged)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+				ClusterName:   aws.String(clusterName),
+				NodegroupName: aws.String(ngName),
+			}).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &ekstypes.Nodegroup{
+					NodegroupName: aws.String(ngName),
+					InstanceTypes: []string{"m5.xlarge"},
+					CapacityType:  ekstypes.CapacityTypesSpot,
+					ScalingConfig: &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(2)},
+				},
+			}, nil)
+			mockVCPUQuota("L-34B43A08")
+		})
+
+		It("fails when the added instances exceed the spot quota", func() {
+			ng.DesiredCapacity = aws.Int(7)
+			err := m.CheckScalingQuotas(context.Background(), ng, preflight.QuotaCheckFail)
+			Expect(err).To(MatchError(ContainSubstring("20 vCPUs are needed and 0 of 16 are in use, request an increase of quota L-34B43A08 of service ec2")))
+		})
+
+		It("passes when the added instances fit in the quota", func() {
+			ng.DesiredCapacity = aws.Int(6)
+			Expect(m.CheckScalingQuotas(context.Background(), ng, preflight.QuotaCheckFail)).To(Succeed())
+		})
+
+		It("does not check the quotas when the nodegroup is scaled in", func() {
+			ng.DesiredCapacity = aws.Int(1)
+			Expect(m.CheckScalingQuotas(context.Background(), ng, preflight.QuotaCheckFail)).To(Succeed())
+			p.MockServiceQuotas().AssertNotCalled(GinkgoT(), "GetServiceQuota", mock.Anything, mock.Anything)
+		})
+
+		It("only warns with --quota-check=warn", func() {
+			ng.DesiredCapacity = aws.Int(10)
+			Expect(m.CheckScalingQuotas(context.Background(), ng, preflight.QuotaCheckWarn)).To(Succeed())
+		})
+	})
+
+	Context("unmanaged nodegroup", func() {
+		BeforeEach(func() {
+			mockNodeGroupStack(api.NodeGroupTypeUnmanaged)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+				AutoScalingGroupNames: []string{"asg-1234"},
+			}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+					{
+						DesiredCapacity: aws.Int32(3),
+						LaunchTemplate: &autoscalingtypes.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-1234"),
+							Version:          aws.String("2"),
+						},
+					},
+				},
+			}, nil)
+			p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything, &ec2.DescribeLaunchTemplateVersionsInput{
+				LaunchTemplateId: aws.String("lt-1234"),
+				Versions:         []string{"2"},
+			}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+					{LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{InstanceType: "m5.xlarge"}},
+				},
+			}, nil)
+			mockVCPUQuota("L-1216C47A")
+		})
+
+		It("checks the on-demand quota of the instance type of the launch template", func() {
+			ng.MinSize = aws.Int(8)
+			err := m.CheckScalingQuotas(context.Background(), ng, preflight.QuotaCheckFail)
+			Expect(err).To(MatchError(ContainSubstring("20 vCPUs are needed and 0 of 16 are in use, request an increase of quota L-1216C47A of service ec2")))
+		})
+	})
+
+	It("warns when the nodegroup cannot be described", func() {
+		fakeStackManager.DescribeNodeGroupStacksAndResourcesReturns(nil, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, mock.Anything).Return(nil, &ekstypes.ResourceNotFoundException{})
+		ng.DesiredCapacity = aws.Int(10)
+		Expect(m.CheckScalingQuotas(context.Background(), ng, preflight.QuotaCheckFail)).To(Succeed())
+	})
+})
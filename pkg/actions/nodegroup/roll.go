@@ -0,0 +1,217 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
+)
+
+// rollPollInterval is how often the Auto Scaling group is checked for the replacement of a terminated instance
+var rollPollInterval = 15 * time.Second
+
+// RollInput holds the options for replacing the instances of a nodegroup
+type RollInput struct {
+	NodeGroupName         string
+	Plan                  bool
+	MaxGracePeriod        time.Duration
+	PodEvictionWaitPeriod time.Duration
+	DisableEviction       bool
+	EvictionOptions       drain.EvictionOptions
+}
+
+type asgInstance struct {
+	asgName    string
+	instanceID string
+}
+
+// Roll replaces the instances of a nodegroup one at a time: the node of each instance is cordoned and drained,
+// the instance is terminated and Roll waits for the Auto Scaling group to bring up a replacement that joins the cluster
+func (m *Manager) Roll(ctx context.Context, input *RollInput) error {
+	asgNames, err := m.getAutoScalingGroupNames(ctx, input.NodeGroupName)
+	if err != nil {
+		return err
+	}
+
+	asgs, err := m.ctl.AWSProvider.ASG().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: asgNames,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing Auto Scaling groups of nodegroup %q", input.NodeGroupName)
+	}
+	var instances []asgInstance
+	for _, asg := range asgs.AutoScalingGroups {
+		for _, instance := range asg.Instances {
+			instances = append(instances, asgInstance{
+				asgName:    aws.ToString(asg.AutoScalingGroupName),
+				instanceID: aws.ToString(instance.InstanceId),
+			})
+		}
+	}
+	if len(instances) == 0 {
+		logger.Warning("no instances found in nodegroup %q", input.NodeGroupName)
+		return nil
+	}
+
+	nodes, err := m.getNodesByInstanceID(ctx)
+	if err != nil {
+		return err
+	}
+	for i, instance := range instances {
+		utilsplan.LogIntendedAction(input.Plan, "replace instance %q (node %q) of nodegroup %q [%d/%d]", instance.instanceID, nodes[instance.instanceID].Name, input.NodeGroupName, i+1, len(instances))
+	}
+	if input.Plan {
+		return nil
+	}
+
+	ng := &api.NodeGroupBase{Name: input.NodeGroupName}
+	nodeDrainer := drain.NewNodeGroupDrainer(m.clientSet, ng, input.MaxGracePeriod, 0, input.PodEvictionWaitPeriod, false, input.DisableEviction, 1, input.EvictionOptions)
+	for i, instance := range instances {
+		logger.Info("replacing instance %q of nodegroup %q [%d/%d]", instance.instanceID, input.NodeGroupName, i+1, len(instances))
+		if node, ok := nodes[instance.instanceID]; ok {
+			if err := nodeDrainer.DrainNode(ctx, node.Name); err != nil {
+				return errors.Wrapf(err, "draining node %q", node.Name)
+			}
+		} else {
+			logger.Warning("no node found for instance %q, terminating it without draining", instance.instanceID)
+		}
+
+		if _, err := m.ctl.AWSProvider.ASG().TerminateInstanceInAutoScalingGroup(ctx, &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+			InstanceId:                     aws.String(instance.instanceID),
+			ShouldDecrementDesiredCapacity: aws.Bool(false),
+		}); err != nil {
+			return errors.Wrapf(err, "terminating instance %q", instance.instanceID)
+		}
+		if err := m.waitForReplacement(ctx, instance); err != nil {
+			return err
+		}
+	}
+
+	logger.Success("replaced %d instance(s) of nodegroup %q", len(instances), input.NodeGroupName)
+	return nil
+}
+
+func (m *Manager) getAutoScalingGroupNames(ctx context.Context, nodeGroupName string) ([]string, error) {
+	nodeGroupStacks, err := m.stackManager.DescribeNodeGroupStacksAndResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if stackInfo, ok := nodeGroupStacks[nodeGroupName]; ok {
+		nodeGroupType, err := manager.GetNodeGroupType(stackInfo.Stack.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if nodeGroupType == api.NodeGroupTypeUnmanaged {
+			for _, resource := range stackInfo.Resources {
+				if aws.ToString(resource.LogicalResourceId) == "NodeGroup" && resource.PhysicalResourceId != nil {
+					return []string{*resource.PhysicalResourceId}, nil
+				}
+			}
+			return nil, fmt.Errorf("failed to find NodeGroup auto scaling group")
+		}
+	}
+
+	out, err := m.ctl.AWSProvider.EKS().DescribeNodegroup(ctx, &awseks.DescribeNodegroupInput{
+		ClusterName:   aws.String(m.cfg.Metadata.Name),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing nodegroup %q", nodeGroupName)
+	}
+	var asgNames []string
+	if out.Nodegroup.Resources != nil {
+		for _, asg := range out.Nodegroup.Resources.AutoScalingGroups {
+			asgNames = append(asgNames, aws.ToString(asg.Name))
+		}
+	}
+	if len(asgNames) == 0 {
+		return nil, fmt.Errorf("no Auto Scaling group found for nodegroup %q", nodeGroupName)
+	}
+	return asgNames, nil
+}
+
+// getNodesByInstanceID returns the nodes of the cluster indexed by the ID of their EC2 instance
+func (m *Manager) getNodesByInstanceID(ctx context.Context) (map[string]corev1.Node, error) {
+	nodes, err := m.clientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	nodesByInstanceID := map[string]corev1.Node{}
+	for _, node := range nodes.Items {
+		// the provider ID of a node has the form aws:///<availability-zone>/<instance-id>
+		if idx := strings.LastIndex(node.Spec.ProviderID, "/"); idx != -1 {
+			nodesByInstanceID[node.Spec.ProviderID[idx+1:]] = node
+		}
+	}
+	return nodesByInstanceID, nil
+}
+
+// waitForReplacement waits until the terminated instance is out of service and the Auto Scaling group
+// has as many instances in service as its desired capacity, all of them with a ready node
+func (m *Manager) waitForReplacement(ctx context.Context, terminated asgInstance) error {
+	logger.Info("waiting for instance %q to be replaced in Auto Scaling group %q", terminated.instanceID, terminated.asgName)
+	ticker := time.NewTicker(rollPollInterval)
+	defer ticker.Stop()
+	for {
+		replaced, err := m.isReplaced(ctx, terminated)
+		if err != nil {
+			return err
+		}
+		if replaced {
+			logger.Info("instance %q has been replaced", terminated.instanceID)
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for instance %q to be replaced: %w", terminated.instanceID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Manager) isReplaced(ctx context.Context, terminated asgInstance) (bool, error) {
+	out, err := m.ctl.AWSProvider.ASG().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{terminated.asgName},
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "describing Auto Scaling group %q", terminated.asgName)
+	}
+	if len(out.AutoScalingGroups) != 1 {
+		return false, fmt.Errorf("couldn't find Auto Scaling group %q", terminated.asgName)
+	}
+	asg := out.AutoScalingGroups[0]
+
+	nodes, err := m.getNodesByInstanceID(ctx)
+	if err != nil {
+		return false, err
+	}
+	ready := 0
+	for _, instance := range asg.Instances {
+		if instance.LifecycleState != asgtypes.LifecycleStateInService {
+			continue
+		}
+		if aws.ToString(instance.InstanceId) == terminated.instanceID {
+			return false, nil
+		}
+		if node, ok := nodes[aws.ToString(instance.InstanceId)]; ok && eks.IsNodeReady(&node) {
+			ready++
+		}
+	}
+	logger.Debug("%d of %d instance(s) in service with a ready node in Auto Scaling group %q", ready, aws.ToInt32(asg.DesiredCapacity), terminated.asgName)
+	return ready >= int(aws.ToInt32(asg.DesiredCapacity)), nil
+}
@@ -0,0 +1,133 @@
+package nodegroup_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Roll", func() {
+	var (
+		p                *mockprovider.MockProvider
+		cfg              *api.ClusterConfig
+		m                *nodegroup.Manager
+		fakeStackManager *fakes.FakeStackManager
+		fakeClientSet    *fake.Clientset
+	)
+
+	newNode := func(name, instanceID string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2a/" + instanceID},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	asgWithInstances := func(instances ...autoscalingtypes.Instance) *autoscaling.DescribeAutoScalingGroupsOutput {
+		return &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []autoscalingtypes.AutoScalingGroup{
+				{
+					AutoScalingGroupName: aws.String("asg-1"),
+					DesiredCapacity:      aws.Int32(1),
+					Instances:            instances,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		nodegroup.SetRollPollInterval(time.Millisecond)
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+
+		fakeClientSet = fake.NewSimpleClientset(newNode("node-1", "i-1"), newNode("node-2", "i-2"))
+		m = nodegroup.New(cfg, &eks.ClusterProvider{AWSProvider: p}, fakeClientSet, nil)
+		fakeStackManager = new(fakes.FakeStackManager)
+		m.SetStackManager(fakeStackManager)
+		fakeStackManager.DescribeNodeGroupStacksAndResourcesReturns(map[string]manager.StackInfo{}, nil)
+
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String("my-cluster"),
+			NodegroupName: aws.String("ng-1"),
+		}).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &ekstypes.Nodegroup{
+				Resources: &ekstypes.NodegroupResources{
+					AutoScalingGroups: []ekstypes.AutoScalingGroup{{Name: aws.String("asg-1")}},
+				},
+			},
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(asgWithInstances(autoscalingtypes.Instance{
+			InstanceId:     aws.String("i-1"),
+			LifecycleState: autoscalingtypes.LifecycleStateInService,
+		}), nil).Once()
+	})
+
+	It("drains and terminates each instance and waits for its replacement", func() {
+		p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything, &autoscaling.TerminateInstanceInAutoScalingGroupInput{
+			InstanceId:                     aws.String("i-1"),
+			ShouldDecrementDesiredCapacity: aws.Bool(false),
+		}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil).Once()
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(asgWithInstances(autoscalingtypes.Instance{
+			InstanceId:     aws.String("i-1"),
+			LifecycleState: autoscalingtypes.LifecycleStateInService,
+		}), nil).Once()
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(asgWithInstances(autoscalingtypes.Instance{
+			InstanceId:     aws.String("i-1"),
+			LifecycleState: autoscalingtypes.LifecycleStateTerminating,
+		}, autoscalingtypes.Instance{
+			InstanceId:     aws.String("i-2"),
+			LifecycleState: autoscalingtypes.LifecycleStateInService,
+		}), nil).Once()
+
+		Expect(m.Roll(context.Background(), &nodegroup.RollInput{NodeGroupName: "ng-1"})).To(Succeed())
+
+		node, err := fakeClientSet.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(node.Spec.Unschedulable).To(BeTrue())
+		p.MockASG().AssertNumberOfCalls(GinkgoT(), "TerminateInstanceInAutoScalingGroup", 1)
+		p.MockASG().AssertNumberOfCalls(GinkgoT(), "DescribeAutoScalingGroups", 3)
+	})
+
+	It("does not replace any instance in plan mode", func() {
+		Expect(m.Roll(context.Background(), &nodegroup.RollInput{NodeGroupName: "ng-1", Plan: true})).To(Succeed())
+
+		node, err := fakeClientSet.CoreV1().Nodes().Get(context.Background(), "node-1", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(node.Spec.Unschedulable).To(BeFalse())
+		p.MockASG().AssertNotCalled(GinkgoT(), "TerminateInstanceInAutoScalingGroup", mock.Anything, mock.Anything)
+	})
+
+	It("times out when the instance is not replaced", func() {
+		p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything, mock.Anything).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, mock.Anything).Return(asgWithInstances(autoscalingtypes.Instance{
+			InstanceId:     aws.String("i-1"),
+			LifecycleState: autoscalingtypes.LifecycleStateTerminating,
+		}), nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		err := m.Roll(ctx, &nodegroup.RollInput{NodeGroupName: "ng-1"})
+		Expect(err).To(MatchError(ContainSubstring(`timed out waiting for instance "i-1" to be replaced`)))
+	})
+})
@@ -19,6 +19,41 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
+// ValidateScaleSizes validates the sizes a nodegroup is scaled to, of which at least one must be set
+func ValidateScaleSizes(ng *api.NodeGroupBase) error {
+	if ng.ScalingConfig == nil {
+		ng.ScalingConfig = &api.ScalingConfig{}
+	}
+
+	if ng.DesiredCapacity == nil && ng.MinSize == nil && ng.MaxSize == nil {
+		return fmt.Errorf("at least one of minimum, maximum and desired nodes must be set")
+	}
+
+	if ng.DesiredCapacity != nil && *ng.DesiredCapacity < 0 {
+		return fmt.Errorf("number of nodes must be 0 or greater")
+	}
+
+	if ng.MinSize != nil && *ng.MinSize < 0 {
+		return fmt.Errorf("minimum of nodes must be 0 or greater")
+	}
+	if ng.MaxSize != nil && *ng.MaxSize < 0 {
+		return fmt.Errorf("maximum of nodes must be 0 or greater")
+	}
+
+	if ng.MaxSize != nil && ng.MinSize != nil && *ng.MaxSize < *ng.MinSize {
+		return fmt.Errorf("maximum number of nodes must be greater than minimum number of nodes")
+	}
+
+	if ng.MaxSize != nil && ng.DesiredCapacity != nil && *ng.MaxSize < *ng.DesiredCapacity {
+		return fmt.Errorf("maximum number of nodes must be greater than or equal to number of nodes")
+	}
+
+	if ng.MinSize != nil && ng.DesiredCapacity != nil && *ng.MinSize > *ng.DesiredCapacity {
+		return fmt.Errorf("minimum number of nodes must be fewer than or equal to number of nodes")
+	}
+	return nil
+}
+
 func (m *Manager) Scale(ctx context.Context, ng *api.NodeGroupBase, wait bool) error {
 	logger.Info("scaling nodegroup %q in cluster %s", ng.Name, m.cfg.Metadata.Name)
 
@@ -52,15 +87,9 @@ func (m *Manager) Scale(ctx context.Context, ng *api.NodeGroupBase, wait bool) e
 }
 
 func (m *Manager) scaleUnmanagedNodeGroup(ctx context.Context, ng *api.NodeGroupBase, stackInfo manager.StackInfo, wait bool) error {
-	asgName := ""
-	for _, resource := range stackInfo.Resources {
-		if *resource.LogicalResourceId == "NodeGroup" {
-			asgName = *resource.PhysicalResourceId
-			break
-		}
-	}
-	if asgName == "" {
-		return fmt.Errorf("failed to find NodeGroup auto scaling group")
+	asgName, err := nodeGroupASGName(stackInfo)
+	if err != nil {
+		return err
 	}
 
 	if err := validateNodeGroupAMI(ctx, m.ctl.AWSProvider, asgName); err != nil {
@@ -152,6 +181,15 @@ func (m *Manager) scaleManagedNodeGroup(ctx context.Context, ng *api.NodeGroupBa
 	return nil
 }
 
+func nodeGroupASGName(stackInfo manager.StackInfo) (string, error) {
+	for _, resource := range stackInfo.Resources {
+		if *resource.LogicalResourceId == "NodeGroup" {
+			return *resource.PhysicalResourceId, nil
+		}
+	}
+	return "", fmt.Errorf("failed to find NodeGroup auto scaling group")
+}
+
 func validateNodeGroupAMI(ctx context.Context, awsProvider api.ClusterProvider, asgName string) error {
 	asg, err := awsProvider.ASG().DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
 		AutoScalingGroupNames: []string{asgName},
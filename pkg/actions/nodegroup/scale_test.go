@@ -277,3 +277,16 @@ var _ = Describe("Scale", func() {
 
 	})
 })
+
+var _ = Describe("ValidateScaleSizes", func() {
+	It("accepts a single size", func() {
+		Expect(nodegroup.ValidateScaleSizes(&api.NodeGroupBase{
+			Name:          "ng-1",
+			ScalingConfig: &api.ScalingConfig{MaxSize: aws.Int(5)},
+		})).To(Succeed())
+	})
+
+	It("requires a size", func() {
+		Expect(nodegroup.ValidateScaleSizes(&api.NodeGroupBase{Name: "ng-1"})).To(MatchError("at least one of minimum, maximum and desired nodes must be set"))
+	})
+})
@@ -0,0 +1,110 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+// VersionGap describes how far a nodegroup is behind a Kubernetes version
+type VersionGap struct {
+	Name          string
+	NodeGroupType api.NodeGroupType `json:"Type"`
+	// Version is the Kubernetes version of the nodegroup, empty if it is unknown
+	Version       string
+	TargetVersion string
+	// ReleaseVersion is the AMI release version of managed nodegroups, or the AMI ID of unmanaged nodegroups
+	ReleaseVersion string
+	// LatestReleaseVersion is the latest AMI release version for TargetVersion, only set for managed nodegroups
+	// using an EKS-optimized Amazon Linux 2 AMI
+	LatestReleaseVersion string
+	UpgradeRequired      bool
+}
+
+// GetVersionGaps returns the version gap between every nodegroup of the cluster and targetVersion
+func (m *Manager) GetVersionGaps(ctx context.Context, targetVersion string) ([]VersionGap, error) {
+	unmanagedSummaries, err := m.getUnmanagedSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var gaps []VersionGap
+	for _, s := range unmanagedSummaries {
+		upgradeRequired, err := isBehind(s.Version, targetVersion)
+		if err != nil {
+			return nil, err
+		}
+		gaps = append(gaps, VersionGap{
+			Name:            s.Name,
+			NodeGroupType:   api.NodeGroupTypeUnmanaged,
+			Version:         s.Version,
+			TargetVersion:   targetVersion,
+			ReleaseVersion:  s.ImageID,
+			UpgradeRequired: upgradeRequired,
+		})
+	}
+
+	managedNodeGroups, err := m.ctl.AWSProvider.EKS().ListNodegroups(ctx, &eks.ListNodegroupsInput{
+		ClusterName: aws.String(m.cfg.Metadata.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, ngName := range managedNodeGroups.Nodegroups {
+		gap, err := m.getManagedVersionGap(ctx, ngName, targetVersion)
+		if err != nil {
+			return nil, err
+		}
+		gaps = append(gaps, *gap)
+	}
+	return gaps, nil
+}
+
+func (m *Manager) getManagedVersionGap(ctx context.Context, nodeGroupName, targetVersion string) (*VersionGap, error) {
+	output, err := m.ctl.AWSProvider.EKS().DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(m.cfg.Metadata.Name),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing nodegroup %q: %w", nodeGroupName, err)
+	}
+	ng := output.Nodegroup
+
+	gap := &VersionGap{
+		Name:           nodeGroupName,
+		NodeGroupType:  api.NodeGroupTypeManaged,
+		Version:        aws.ToString(ng.Version),
+		TargetVersion:  targetVersion,
+		ReleaseVersion: aws.ToString(ng.ReleaseVersion),
+	}
+	if gap.UpgradeRequired, err = isBehind(gap.Version, targetVersion); err != nil {
+		return nil, err
+	}
+	if ng.AmiType != ekstypes.AMITypesCustom {
+		if gap.LatestReleaseVersion, err = m.getLatestReleaseVersion(ctx, targetVersion, ng); err != nil {
+			return nil, fmt.Errorf("getting latest release version for nodegroup %q: %w", nodeGroupName, err)
+		}
+		if gap.LatestReleaseVersion != "" && gap.LatestReleaseVersion != gap.ReleaseVersion {
+			gap.UpgradeRequired = true
+		}
+	}
+	return gap, nil
+}
+
+// isBehind returns true if version is lower than targetVersion, and false if version is unknown
+func isBehind(version, targetVersion string) (bool, error) {
+	if version == "" {
+		return false, nil
+	}
+	c, err := utils.CompareVersions(version, targetVersion)
+	if err != nil {
+		return false, err
+	}
+	return c < 0, nil
+}
@@ -0,0 +1,95 @@
+package nodegroup_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("GetVersionGaps", func() {
+	var (
+		p *mockprovider.MockProvider
+		m *nodegroup.Manager
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		p = mockprovider.NewMockProvider()
+		m = nodegroup.New(cfg, &eks.ClusterProvider{AWSProvider: p}, fake.NewSimpleClientset(), nil)
+		fakeStackManager := new(fakes.FakeStackManager)
+		m.SetStackManager(fakeStackManager)
+
+		p.MockEKS().On("ListNodegroups", mock.Anything, mock.Anything).Return(&awseks.ListNodegroupsOutput{
+			Nodegroups: []string{"ng-al2", "ng-custom"},
+		}, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String("my-cluster"),
+			NodegroupName: aws.String("ng-al2"),
+		}).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &ekstypes.Nodegroup{
+				NodegroupName:  aws.String("ng-al2"),
+				AmiType:        ekstypes.AMITypesAl2X8664,
+				Version:        aws.String("1.27"),
+				ReleaseVersion: aws.String("1.27.1-20230601"),
+			},
+		}, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything, &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String("my-cluster"),
+			NodegroupName: aws.String("ng-custom"),
+		}).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &ekstypes.Nodegroup{
+				NodegroupName:  aws.String("ng-custom"),
+				AmiType:        ekstypes.AMITypesCustom,
+				Version:        aws.String("1.26"),
+				ReleaseVersion: aws.String("ami-123"),
+			},
+		}, nil)
+		p.MockSSM().On("GetParameter", mock.Anything, &ssm.GetParameterInput{
+			Name: aws.String("/aws/service/eks/optimized-ami/1.27/amazon-linux-2/recommended/release_version"),
+		}).Return(&ssm.GetParameterOutput{
+			Parameter: &ssmtypes.Parameter{
+				Value: aws.String("1.27.3-20230703"),
+			},
+		}, nil)
+	})
+
+	It("returns the Kubernetes and AMI version gaps of the nodegroups", func() {
+		gaps, err := m.GetVersionGaps(context.Background(), "1.27")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gaps).To(Equal([]nodegroup.VersionGap{
+			{
+				Name:                 "ng-al2",
+				NodeGroupType:        api.NodeGroupTypeManaged,
+				Version:              "1.27",
+				TargetVersion:        "1.27",
+				ReleaseVersion:       "1.27.1-20230601",
+				LatestReleaseVersion: "1.27.3-20230703",
+				UpgradeRequired:      true,
+			},
+			{
+				Name:            "ng-custom",
+				NodeGroupType:   api.NodeGroupTypeManaged,
+				Version:         "1.26",
+				TargetVersion:   "1.27",
+				ReleaseVersion:  "ami-123",
+				UpgradeRequired: true,
+			},
+		}))
+	})
+})
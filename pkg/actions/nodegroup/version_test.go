@@ -0,0 +1,86 @@
+package nodegroup_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("Version", func() {
+	type setVersionInput struct {
+		ctl             *eks.ClusterProvider
+		meta            *api.ClusterMeta
+		expectedVersion string
+		expectedErr     string
+	}
+
+	providerVersion1_23 := &eks.ClusterProvider{
+		Status: &eks.ProviderStatus{
+			ClusterInfo: &eks.ClusterInfo{
+				Cluster: &types.Cluster{
+					Version: aws.String(api.Version1_23),
+				},
+			},
+		},
+	}
+
+	DescribeTable("SetVersion",
+		func(input setVersionInput) {
+			err := nodegroup.SetVersion(input.ctl, input.meta)
+			if input.expectedErr != "" {
+				Expect(err).To(MatchError(ContainSubstring(input.expectedErr)))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input.meta.Version).To(Equal(input.expectedVersion))
+		},
+		Entry("version is left empty", setVersionInput{
+			ctl:             providerVersion1_23,
+			meta:            &api.ClusterMeta{},
+			expectedVersion: api.Version1_23,
+		}),
+		Entry("version is set to auto", setVersionInput{
+			ctl: providerVersion1_23,
+			meta: &api.ClusterMeta{
+				Version: "auto",
+			},
+			expectedVersion: api.Version1_23,
+		}),
+		Entry("version is set to latest", setVersionInput{
+			ctl: providerVersion1_23,
+			meta: &api.ClusterMeta{
+				Version: "latest",
+			},
+			expectedVersion: api.LatestVersion,
+		}),
+		Entry("version is set to deprecated version", setVersionInput{
+			meta: &api.ClusterMeta{
+				Version: api.Version1_15,
+			},
+			expectedErr: fmt.Sprintf("invalid version, %s is no longer supported", api.Version1_15),
+		}),
+		Entry("version is set to unsupported version", setVersionInput{
+			meta: &api.ClusterMeta{
+				Version: "100",
+			},
+			expectedErr: fmt.Sprintf("invalid version 100, supported values: auto, default, latest, %s", strings.Join(api.SupportedVersions(), ", ")),
+		}),
+		Entry("fails to retrieve control plane version", setVersionInput{
+			ctl: &eks.ClusterProvider{
+				Status: &eks.ProviderStatus{},
+			},
+			meta: &api.ClusterMeta{
+				Version: "auto",
+			},
+			expectedErr: "unable to get control plane version",
+		}),
+	)
+})
@@ -0,0 +1,328 @@
+package nodeterminationhandler
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// Namespace is the namespace AWS Node Termination Handler is installed into
+	Namespace = "kube-system"
+	// ServiceAccountName is the name of the service account used by AWS Node Termination Handler
+	ServiceAccountName = "aws-node-termination-handler"
+	// ReleaseName is the name of the Helm release
+	ReleaseName = "aws-node-termination-handler"
+	// LifecycleHookName is the name of the termination lifecycle hook added to the Auto Scaling groups
+	LifecycleHookName = "eksctl-node-termination-handler"
+
+	chartName    = "aws-node-termination-handler"
+	chartRepoURL = "https://aws.github.io/eks-charts"
+	chartVersion = "0.21.0"
+
+	// lifecycleHookHeartbeatTimeout is the time in seconds Node Termination Handler has to drain a node
+	// before the instance is terminated
+	lifecycleHookHeartbeatTimeout = 300
+)
+
+// MakeStackName returns the name of the stack holding the Node Termination Handler queue
+func MakeStackName(clusterName string) string {
+	return fmt.Sprintf("eksctl-%s-node-termination-handler", clusterName)
+}
+
+// ManagedTag returns the tag identifying the instances Node Termination Handler drains. The tag is specific to the
+// cluster as the EventBridge rules of all clusters in the region receive the events of all instances.
+func ManagedTag(clusterName string) string {
+	return fmt.Sprintf("aws-node-termination-handler/%s", clusterName)
+}
+
+// SuggestInstall logs how to install Node Termination Handler when the cluster has self-managed nodegroups using Spot Instances
+func SuggestInstall(cfg *api.ClusterConfig) {
+	var spotNodeGroups []string
+	for _, ng := range cfg.NodeGroups {
+		if api.HasSpotInstances(ng) {
+			spotNodeGroups = append(spotNodeGroups, ng.Name)
+		}
+	}
+	if len(spotNodeGroups) == 0 {
+		return
+	}
+	logger.Info("nodegroup(s) %s use Spot Instances, to drain nodes gracefully on interruption, run 'eksctl utils install-node-termination-handler --region=%s --cluster=%s'",
+		strings.Join(spotNodeGroups, ", "), cfg.Metadata.Region, cfg.Metadata.Name)
+}
+
+// Installer installs AWS Node Termination Handler in queue mode on an existing cluster
+type Installer struct {
+	cfg             *api.ClusterConfig
+	stackManager    manager.StackManager
+	asgAPI          awsapi.ASG
+	ec2API          awsapi.EC2
+	helmInstaller   providers.HelmInstaller
+	oidc            *iamoidc.OpenIDConnectManager
+	clientSetGetter kubernetes.ClientSetGetter
+}
+
+// NewInstaller creates a new Installer
+func NewInstaller(cfg *api.ClusterConfig, stackManager manager.StackManager, asgAPI awsapi.ASG, ec2API awsapi.EC2, helmInstaller providers.HelmInstaller,
+	oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *Installer {
+	return &Installer{
+		cfg:             cfg,
+		stackManager:    stackManager,
+		asgAPI:          asgAPI,
+		ec2API:          ec2API,
+		helmInstaller:   helmInstaller,
+		oidc:            oidc,
+		clientSetGetter: clientSetGetter,
+	}
+}
+
+// Options defines options for Install
+type Options struct {
+	// Plan only logs the intended changes
+	Plan bool
+}
+
+// Install creates the SQS queue and EventBridge rules for Node Termination Handler, adds a termination lifecycle hook
+// and the managed tag to the Auto Scaling groups of the self-managed nodegroups using Spot Instances, creates the
+// IAM service account for Node Termination Handler and installs the Node Termination Handler Helm chart in queue mode
+func (i *Installer) Install(ctx context.Context, opts Options) error {
+	spotGroups, err := i.spotAutoScalingGroups(ctx)
+	if err != nil {
+		return err
+	}
+	if len(spotGroups) == 0 {
+		return fmt.Errorf("no self-managed nodegroups using Spot Instances found in cluster %q", i.cfg.Metadata.Name)
+	}
+
+	queueURL, err := i.createQueue(ctx, opts.Plan)
+	if err != nil {
+		return err
+	}
+	for _, asg := range spotGroups {
+		if err := i.manageAutoScalingGroup(ctx, asg, opts.Plan); err != nil {
+			return err
+		}
+	}
+	if err := i.createServiceAccount(ctx, opts.Plan); err != nil {
+		return err
+	}
+
+	if opts.Plan {
+		logger.Info("(plan) would install AWS Node Termination Handler in queue mode in namespace %q", Namespace)
+		return nil
+	}
+	logger.Info("installing AWS Node Termination Handler")
+	if err := i.helmInstaller.InstallChart(ctx, providers.InstallChartOpts{
+		ChartName:   chartName,
+		RepoURL:     chartRepoURL,
+		Namespace:   Namespace,
+		ReleaseName: ReleaseName,
+		Version:     chartVersion,
+		Values:      i.chartValues(queueURL),
+	}); err != nil {
+		return fmt.Errorf("installing AWS Node Termination Handler: %w", err)
+	}
+	logger.Success("installed AWS Node Termination Handler")
+	return nil
+}
+
+func (i *Installer) chartValues(queueURL string) map[string]interface{} {
+	return map[string]interface{}{
+		"enableSqsTerminationDraining": true,
+		"queueURL":                     queueURL,
+		"awsRegion":                    i.cfg.Metadata.Region,
+		"checkTagBeforeDraining":       true,
+		"managedTag":                   ManagedTag(i.cfg.Metadata.Name),
+		"serviceAccount": map[string]interface{}{
+			"create": false,
+			"name":   ServiceAccountName,
+		},
+	}
+}
+
+// spotAutoScalingGroups returns the Auto Scaling groups of the self-managed nodegroups using Spot Instances.
+// Managed nodegroups are skipped as EKS drains their Spot Instances.
+func (i *Installer) spotAutoScalingGroups(ctx context.Context) ([]asgtypes.AutoScalingGroup, error) {
+	nodeGroupStacks, err := i.stackManager.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing nodegroup stacks: %w", err)
+	}
+	var asgNames []string
+	for _, ngStack := range nodeGroupStacks {
+		if ngStack.Type != api.NodeGroupTypeUnmanaged {
+			continue
+		}
+		names, err := i.stackManager.GetAutoScalingGroupName(ctx, ngStack.Stack)
+		if err != nil {
+			return nil, fmt.Errorf("getting Auto Scaling group for nodegroup %q: %w", ngStack.NodeGroupName, err)
+		}
+		if names != "" {
+			asgNames = append(asgNames, strings.Split(names, ",")...)
+		}
+	}
+	if len(asgNames) == 0 {
+		return nil, nil
+	}
+
+	var spotGroups []asgtypes.AutoScalingGroup
+	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(i.asgAPI, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: asgNames,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing Auto Scaling groups: %w", err)
+		}
+		for _, asg := range output.AutoScalingGroups {
+			if usesSpotInstances(asg) {
+				spotGroups = append(spotGroups, asg)
+			}
+		}
+	}
+	return spotGroups, nil
+}
+
+func usesSpotInstances(asg asgtypes.AutoScalingGroup) bool {
+	if asg.MixedInstancesPolicy == nil || asg.MixedInstancesPolicy.InstancesDistribution == nil {
+		return false
+	}
+	onDemandPercentage := asg.MixedInstancesPolicy.InstancesDistribution.OnDemandPercentageAboveBaseCapacity
+	return onDemandPercentage != nil && *onDemandPercentage < 100
+}
+
+func (i *Installer) createQueue(ctx context.Context, plan bool) (string, error) {
+	stackName := MakeStackName(i.cfg.Metadata.Name)
+	stacks, err := i.stackManager.ListStacksMatching(ctx, fmt.Sprintf("^%s$", regexp.QuoteMeta(stackName)))
+	if err != nil {
+		return "", fmt.Errorf("listing stacks: %w", err)
+	}
+	if len(stacks) > 0 {
+		for _, o := range stacks[0].Outputs {
+			if aws.ToString(o.OutputKey) == outputs.NodeTerminationHandlerQueueURL {
+				logger.Info("using existing queue %q from stack %q", aws.ToString(o.OutputValue), stackName)
+				return aws.ToString(o.OutputValue), nil
+			}
+		}
+		return "", fmt.Errorf("stack %q does not have a %s output, it may not have been created successfully", stackName, outputs.NodeTerminationHandlerQueueURL)
+	}
+
+	if plan {
+		logger.Info("(plan) would create the SQS queue and EventBridge rules for AWS Node Termination Handler in stack %q", stackName)
+		return "", nil
+	}
+	resourceSet := builder.NewNodeTerminationHandlerResourceSet()
+	if err := resourceSet.AddAllResources(); err != nil {
+		return "", err
+	}
+	logger.Info("creating the SQS queue and EventBridge rules for AWS Node Termination Handler in stack %q", stackName)
+	errCh := make(chan error)
+	if err := i.stackManager.CreateStack(ctx, stackName, resourceSet, nil, nil, errCh); err != nil {
+		return "", err
+	}
+	if err := <-errCh; err != nil {
+		return "", fmt.Errorf("creating the AWS Node Termination Handler queue: %w", err)
+	}
+	return resourceSet.QueueURL, nil
+}
+
+// manageAutoScalingGroup adds the termination lifecycle hook and the managed tag to the Auto Scaling group,
+// and tags its running instances as the tag only propagates to new instances
+func (i *Installer) manageAutoScalingGroup(ctx context.Context, asg asgtypes.AutoScalingGroup, plan bool) error {
+	asgName := aws.ToString(asg.AutoScalingGroupName)
+	if plan {
+		logger.Info("(plan) would add a termination lifecycle hook and tag %q to Auto Scaling group %q", ManagedTag(i.cfg.Metadata.Name), asgName)
+		return nil
+	}
+
+	if _, err := i.asgAPI.PutLifecycleHook(ctx, &autoscaling.PutLifecycleHookInput{
+		AutoScalingGroupName: asg.AutoScalingGroupName,
+		LifecycleHookName:    aws.String(LifecycleHookName),
+		LifecycleTransition:  aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+		DefaultResult:        aws.String("CONTINUE"),
+		HeartbeatTimeout:     aws.Int32(lifecycleHookHeartbeatTimeout),
+	}); err != nil {
+		return fmt.Errorf("adding termination lifecycle hook to Auto Scaling group %q: %w", asgName, err)
+	}
+
+	managedTag := ManagedTag(i.cfg.Metadata.Name)
+	if _, err := i.asgAPI.CreateOrUpdateTags(ctx, &autoscaling.CreateOrUpdateTagsInput{
+		Tags: []asgtypes.Tag{
+			{
+				ResourceId:        asg.AutoScalingGroupName,
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String(managedTag),
+				Value:             aws.String(""),
+				PropagateAtLaunch: aws.Bool(true),
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("tagging Auto Scaling group %q: %w", asgName, err)
+	}
+
+	var instanceIDs []string
+	for _, instance := range asg.Instances {
+		instanceIDs = append(instanceIDs, aws.ToString(instance.InstanceId))
+	}
+	if len(instanceIDs) > 0 {
+		if _, err := i.ec2API.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: instanceIDs,
+			Tags: []ec2types.Tag{
+				{
+					Key:   aws.String(managedTag),
+					Value: aws.String(""),
+				},
+			},
+		}); err != nil {
+			return fmt.Errorf("tagging instances of Auto Scaling group %q: %w", asgName, err)
+		}
+	}
+	logger.Info("added termination lifecycle hook and tag %q to Auto Scaling group %q", managedTag, asgName)
+	return nil
+}
+
+func (i *Installer) createServiceAccount(ctx context.Context, plan bool) error {
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		ClusterIAMMeta: api.ClusterIAMMeta{
+			Name:      ServiceAccountName,
+			Namespace: Namespace,
+		},
+		AttachPolicy: api.InlineDocument{
+			"Version": "2012-10-17",
+			"Statement": []interface{}{
+				map[string]interface{}{
+					"Effect": "Allow",
+					"Action": []string{
+						"autoscaling:CompleteLifecycleAction",
+						"autoscaling:DescribeAutoScalingInstances",
+						"autoscaling:DescribeTags",
+						"ec2:DescribeInstances",
+						"sqs:DeleteMessage",
+						"sqs:ReceiveMessage",
+					},
+					"Resource": "*",
+				},
+			},
+		},
+	}
+
+	_, _, err := irsa.EnsureIAMServiceAccount(ctx, i.stackManager, i.oidc, i.clientSetGetter, serviceAccount, plan)
+	return err
+}
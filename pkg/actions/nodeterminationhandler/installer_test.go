@@ -0,0 +1,181 @@
+package nodeterminationhandler_test
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodeterminationhandler"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+	providerfakes "github.com/weaveworks/eksctl/pkg/karpenter/providers/fakes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+var _ = Describe("Node Termination Handler", func() {
+	const queueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/queue"
+
+	var (
+		cfg              *api.ClusterConfig
+		fakeStackManager *fakes.FakeStackManager
+		asgAPI           *mocksv2.ASG
+		ec2API           *mocksv2.EC2
+		helmInstaller    *providerfakes.FakeHelmInstaller
+		installer        *nodeterminationhandler.Installer
+	)
+
+	autoScalingGroup := func(name string, onDemandPercentage *int32, instanceIDs ...string) asgtypes.AutoScalingGroup {
+		asg := asgtypes.AutoScalingGroup{AutoScalingGroupName: aws.String(name)}
+		if onDemandPercentage != nil {
+			asg.MixedInstancesPolicy = &asgtypes.MixedInstancesPolicy{
+				InstancesDistribution: &asgtypes.InstancesDistribution{
+					OnDemandPercentageAboveBaseCapacity: onDemandPercentage,
+				},
+			}
+		}
+		for _, id := range instanceIDs {
+			asg.Instances = append(asg.Instances, asgtypes.Instance{InstanceId: aws.String(id)})
+		}
+		return asg
+	}
+
+	mockAutoScalingGroups := func(asgs ...asgtypes.AutoScalingGroup) {
+		asgAPI.On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{"asg-spot", "asg-on-demand"},
+		}, mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: asgs}, nil)
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		fakeStackManager = &fakes.FakeStackManager{}
+		fakeStackManager.ListNodeGroupStacksWithStatusesReturns([]manager.NodeGroupStack{
+			{NodeGroupName: "spot", Type: api.NodeGroupTypeUnmanaged, Stack: &manager.Stack{StackName: aws.String("spot")}},
+			{NodeGroupName: "on-demand", Type: api.NodeGroupTypeUnmanaged, Stack: &manager.Stack{StackName: aws.String("on-demand")}},
+			{NodeGroupName: "managed", Type: api.NodeGroupTypeManaged, Stack: &manager.Stack{StackName: aws.String("managed")}},
+		}, nil)
+		fakeStackManager.GetAutoScalingGroupNameReturnsOnCall(0, "asg-spot", nil)
+		fakeStackManager.GetAutoScalingGroupNameReturnsOnCall(1, "asg-on-demand", nil)
+		fakeStackManager.CreateStackStub = func(_ context.Context, _ string, rs builder.ResourceSetReader, _, _ map[string]string, errCh chan error) error {
+			go func() {
+				errCh <- rs.GetAllOutputs(cfntypes.Stack{
+					Outputs: []cfntypes.Output{
+						{OutputKey: aws.String("QueueURL"), OutputValue: aws.String(queueURL)},
+					},
+				})
+			}()
+			return nil
+		}
+		fakeStackManager.NewTasksToCreateIAMServiceAccountsReturns(&tasks.TaskTree{})
+
+		asgAPI = &mocksv2.ASG{}
+		ec2API = &mocksv2.EC2{}
+		helmInstaller = &providerfakes.FakeHelmInstaller{}
+		installer = nodeterminationhandler.NewInstaller(cfg, fakeStackManager, asgAPI, ec2API, helmInstaller, nil, nil)
+	})
+
+	It("creates the queue, manages the Spot Auto Scaling groups and installs the chart in queue mode", func() {
+		mockAutoScalingGroups(
+			autoScalingGroup("asg-spot", aws.Int32(0), "i-1", "i-2"),
+			autoScalingGroup("asg-on-demand", aws.Int32(100), "i-3"),
+		)
+		asgAPI.On("PutLifecycleHook", mock.Anything, &autoscaling.PutLifecycleHookInput{
+			AutoScalingGroupName: aws.String("asg-spot"),
+			LifecycleHookName:    aws.String("eksctl-node-termination-handler"),
+			LifecycleTransition:  aws.String("autoscaling:EC2_INSTANCE_TERMINATING"),
+			DefaultResult:        aws.String("CONTINUE"),
+			HeartbeatTimeout:     aws.Int32(300),
+		}).Return(&autoscaling.PutLifecycleHookOutput{}, nil).Once()
+		asgAPI.On("CreateOrUpdateTags", mock.Anything, &autoscaling.CreateOrUpdateTagsInput{
+			Tags: []asgtypes.Tag{
+				{
+					ResourceId:        aws.String("asg-spot"),
+					ResourceType:      aws.String("auto-scaling-group"),
+					Key:               aws.String("aws-node-termination-handler/my-cluster"),
+					Value:             aws.String(""),
+					PropagateAtLaunch: aws.Bool(true),
+				},
+			},
+		}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil).Once()
+		ec2API.On("CreateTags", mock.Anything, &ec2.CreateTagsInput{
+			Resources: []string{"i-1", "i-2"},
+			Tags: []ec2types.Tag{
+				{Key: aws.String("aws-node-termination-handler/my-cluster"), Value: aws.String("")},
+			},
+		}).Return(&ec2.CreateTagsOutput{}, nil).Once()
+
+		Expect(installer.Install(context.Background(), nodeterminationhandler.Options{})).To(Succeed())
+		asgAPI.AssertExpectations(GinkgoT())
+		ec2API.AssertExpectations(GinkgoT())
+
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(1))
+		_, stackName, _, _, _, _ := fakeStackManager.CreateStackArgsForCall(0)
+		Expect(stackName).To(Equal("eksctl-my-cluster-node-termination-handler"))
+
+		serviceAccounts, _, _ := fakeStackManager.NewTasksToCreateIAMServiceAccountsArgsForCall(0)
+		Expect(serviceAccounts[0].NameString()).To(Equal("kube-system/aws-node-termination-handler"))
+		Expect(serviceAccounts[0].AttachPolicy).To(HaveKey("Statement"))
+
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(1))
+		_, opts := helmInstaller.InstallChartArgsForCall(0)
+		Expect(opts.ChartName).To(Equal("aws-node-termination-handler"))
+		Expect(opts.RepoURL).To(Equal("https://aws.github.io/eks-charts"))
+		Expect(opts.Namespace).To(Equal("kube-system"))
+		Expect(opts.Values).To(And(
+			HaveKeyWithValue("enableSqsTerminationDraining", true),
+			HaveKeyWithValue("queueURL", queueURL),
+			HaveKeyWithValue("managedTag", "aws-node-termination-handler/my-cluster"),
+		))
+	})
+
+	It("reuses an existing queue", func() {
+		mockAutoScalingGroups(autoScalingGroup("asg-spot", aws.Int32(20)))
+		asgAPI.On("PutLifecycleHook", mock.Anything, mock.Anything).Return(&autoscaling.PutLifecycleHookOutput{}, nil)
+		asgAPI.On("CreateOrUpdateTags", mock.Anything, mock.Anything).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+		fakeStackManager.ListStacksMatchingReturns([]*cfntypes.Stack{
+			{
+				StackName: aws.String("eksctl-my-cluster-node-termination-handler"),
+				Outputs: []cfntypes.Output{
+					{OutputKey: aws.String("QueueURL"), OutputValue: aws.String("https://sqs/existing")},
+				},
+			},
+		}, nil)
+
+		Expect(installer.Install(context.Background(), nodeterminationhandler.Options{})).To(Succeed())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+		_, opts := helmInstaller.InstallChartArgsForCall(0)
+		Expect(opts.Values).To(HaveKeyWithValue("queueURL", "https://sqs/existing"))
+	})
+
+	It("fails when no self-managed nodegroup uses Spot Instances", func() {
+		mockAutoScalingGroups(autoScalingGroup("asg-spot", nil), autoScalingGroup("asg-on-demand", aws.Int32(100)))
+
+		err := installer.Install(context.Background(), nodeterminationhandler.Options{})
+		Expect(err).To(MatchError(`no self-managed nodegroups using Spot Instances found in cluster "my-cluster"`))
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+	})
+
+	It("does not make any changes in plan mode", func() {
+		mockAutoScalingGroups(autoScalingGroup("asg-spot", aws.Int32(0), "i-1"))
+
+		Expect(installer.Install(context.Background(), nodeterminationhandler.Options{Plan: true})).To(Succeed())
+		Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+		Expect(fakeStackManager.NewTasksToCreateIAMServiceAccountsCallCount()).To(Equal(0))
+		Expect(helmInstaller.InstallChartCallCount()).To(Equal(0))
+		asgAPI.AssertNotCalled(GinkgoT(), "PutLifecycleHook", mock.Anything, mock.Anything)
+	})
+})
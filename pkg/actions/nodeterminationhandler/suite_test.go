@@ -0,0 +1,11 @@
+package nodeterminationhandler_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestNodeTerminationHandler(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,158 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Phase is the outcome of the last reconciliation of a ClusterConfig
+type Phase string
+
+// Values for `Phase`
+const (
+	PhaseReady  Phase = "Ready"
+	PhaseFailed Phase = "Failed"
+)
+
+// Controller watches the ClusterConfig resources and reconciles them when they change, and every resync period
+type Controller struct {
+	client     dynamic.Interface
+	reconciler *Reconciler
+	informer   cache.SharedIndexInformer
+	queue      workqueue.RateLimitingInterface
+}
+
+// NewController returns a Controller for the ClusterConfig resources of namespace, or of all namespaces if it is
+// empty
+func NewController(client dynamic.Interface, namespace string, resyncPeriod time.Duration, reconciler *Reconciler) *Controller {
+	c := &Controller{
+		client:     client,
+		reconciler: reconciler,
+		informer: dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, resyncPeriod, namespace, nil).
+			ForResource(GroupVersionResource).Informer(),
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "clusterconfigs"),
+	}
+	c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if needsReconcile(oldObj.(*unstructured.Unstructured), newObj.(*unstructured.Unstructured)) {
+				c.enqueue(newObj)
+			}
+		},
+		// deleting a ClusterConfig leaves its cluster as it is
+	})
+	return c
+}
+
+// Run reconciles the ClusterConfig resources with the given number of workers until ctx is done, then waits for the
+// running reconciliations to finish
+func (c *Controller) Run(ctx context.Context, workers int) error {
+	defer c.queue.ShutDown()
+
+	go c.informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
+		return errors.New("failed to sync the cache of ClusterConfig resources")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c.processNextItem(ctx) {
+			}
+		}()
+	}
+	<-ctx.Done()
+	c.queue.ShutDown()
+	wg.Wait()
+	return nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Warning("getting the key of a ClusterConfig: %v", err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// needsReconcile reports whether an update of a ClusterConfig changed its spec or annotations, or is a resync,
+// updates of its status only are ignored as the controller makes them
+func needsReconcile(oldObj, newObj *unstructured.Unstructured) bool {
+	return oldObj.GetResourceVersion() == newObj.GetResourceVersion() ||
+		oldObj.GetGeneration() != newObj.GetGeneration() ||
+		!reflect.DeepEqual(oldObj.GetAnnotations(), newObj.GetAnnotations())
+}
+
+func (c *Controller) processNextItem(ctx context.Context) bool {
+	item, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(item)
+	if ctx.Err() != nil {
+		return false
+	}
+
+	key := item.(string)
+	if err := c.sync(key); err != nil {
+		logger.Warning("reconciling ClusterConfig %s: %v", key, err)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+// sync reconciles the ClusterConfig of key and records the outcome in its status
+func (c *Controller) sync(key string) error {
+	obj, exists, err := c.informer.GetIndexer().GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		logger.Debug("ClusterConfig %s was deleted", key)
+		return nil
+	}
+	clusterConfig := obj.(*unstructured.Unstructured).DeepCopy()
+
+	logger.Info("reconciling ClusterConfig %s", key)
+	// reconciliations are not cancelled on shutdown, as stopping them half-way would leave stacks to clean up
+	reconcileErr := c.reconciler.Reconcile(context.Background(), clusterConfig)
+
+	status := map[string]interface{}{
+		"observedGeneration": clusterConfig.GetGeneration(),
+		"phase":              string(PhaseReady),
+		"lastReconcileTime":  time.Now().UTC().Format(time.RFC3339),
+	}
+	if reconcileErr != nil {
+		status["phase"] = string(PhaseFailed)
+		status["message"] = reconcileErr.Error()
+	}
+	if err := unstructured.SetNestedField(clusterConfig.Object, status, "status"); err != nil {
+		return err
+	}
+	if _, err := c.client.Resource(GroupVersionResource).Namespace(clusterConfig.GetNamespace()).
+		UpdateStatus(context.Background(), clusterConfig, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("updating status: %w", err)
+	}
+	if reconcileErr != nil {
+		return reconcileErr
+	}
+	logger.Success("reconciled ClusterConfig %s", key)
+	return nil
+}
@@ -0,0 +1,82 @@
+package operator_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/operator"
+)
+
+var _ = Describe("Controller", func() {
+	var (
+		ops           *fakeOperations
+		dynamicClient *dynamicfake.FakeDynamicClient
+		cancel        context.CancelFunc
+		done          chan error
+	)
+
+	BeforeEach(func() {
+		ops = &fakeOperations{}
+		dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			operator.GroupVersionResource: operator.Kind + "List",
+		}, newClusterConfig("dev", fullSpec()))
+	})
+
+	JustBeforeEach(func() {
+		reconciler, err := operator.NewReconciler(func(string) operator.Operations {
+			return ops
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan error, 1)
+		go func() {
+			done <- operator.NewController(dynamicClient, "default", time.Hour, reconciler).Run(ctx, 1)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	getStatus := func() map[string]interface{} {
+		obj, err := dynamicClient.Resource(operator.GroupVersionResource).Namespace("default").Get(context.Background(), "dev", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		status, _, err := unstructured.NestedMap(obj.Object, "status")
+		Expect(err).NotTo(HaveOccurred())
+		return status
+	}
+
+	It("reconciles the ClusterConfig and records it in its status", func() {
+		Eventually(getStatus).Should(And(
+			HaveKeyWithValue("phase", string(operator.PhaseReady)),
+			HaveKey("lastReconcileTime"),
+			Not(HaveKey("message")),
+		))
+		Expect(ops.recorded()).NotTo(BeEmpty())
+		Expect(ops.recorded()[0].operation).To(Equal("CreateNodeGroups"))
+	})
+
+	When("the reconciliation fails", func() {
+		BeforeEach(func() {
+			ops.err = errors.New("stack failed")
+		})
+
+		It("records the error in the status", func() {
+			Eventually(getStatus).Should(And(
+				HaveKeyWithValue("phase", string(operator.PhaseFailed)),
+				HaveKeyWithValue("message", "creating nodegroups: stack failed"),
+			))
+		})
+	})
+})
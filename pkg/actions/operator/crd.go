@@ -0,0 +1,31 @@
+package operator
+
+import (
+	// go go:embed to work
+	_ "embed"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// CRD is the CustomResourceDefinition of the ClusterConfig resources the operator reconciles
+//
+//go:embed assets/crd.yaml
+var CRD []byte
+
+// Values of the ClusterConfig resources
+const (
+	Group   = "operator.eksctl.io"
+	Version = "v1alpha1"
+	Kind    = "ClusterConfig"
+
+	// PruneAnnotation, set to "true" on a ClusterConfig, deletes the nodegroups, addons and IAM service accounts of
+	// its cluster that are not in its spec
+	PruneAnnotation = Group + "/prune"
+)
+
+// GroupVersionResource is the resource of the ClusterConfig resources
+var GroupVersionResource = schema.GroupVersionResource{
+	Group:    Group,
+	Version:  Version,
+	Resource: "clusterconfigs",
+}
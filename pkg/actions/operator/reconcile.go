@@ -0,0 +1,198 @@
+package operator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/client"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// Operations are the operations of the eksctl Go API the operator runs, implemented by client.Client
+type Operations interface {
+	CreateNodeGroups(ctx context.Context, cfg *api.ClusterConfig, options client.CreateNodeGroupsOptions) error
+	ListNodeGroups(ctx context.Context, clusterName string) ([]string, error)
+	DeleteNodeGroup(ctx context.Context, clusterName, name string, options client.DeleteNodeGroupOptions) error
+	CreateAddons(ctx context.Context, cfg *api.ClusterConfig, options client.CreateAddonsOptions) error
+	ListAddons(ctx context.Context, clusterName string) ([]string, error)
+	DeleteAddon(ctx context.Context, clusterName, name string, options client.DeleteAddonOptions) error
+	CreateIAMServiceAccounts(ctx context.Context, cfg *api.ClusterConfig, options client.CreateIAMServiceAccountsOptions) error
+	ListIAMServiceAccounts(ctx context.Context, clusterName string) ([]string, error)
+	DeleteIAMServiceAccounts(ctx context.Context, cfg *api.ClusterConfig, options client.DeleteIAMServiceAccountsOptions) error
+}
+
+// Reconciler brings the nodegroups, addons and IAM service accounts of a cluster to the ones of a ClusterConfig
+type Reconciler struct {
+	newOperations func(region string) Operations
+}
+
+// NewReconciler returns a Reconciler running the operations returned by newOperations for the region of each
+// ClusterConfig
+func NewReconciler(newOperations func(region string) Operations) (*Reconciler, error) {
+	if err := api.Register(); err != nil {
+		return nil, err
+	}
+	return &Reconciler{newOperations: newOperations}, nil
+}
+
+// Reconcile creates the nodegroups, addons and IAM service accounts of obj missing from its cluster, which must
+// exist. Existing ones are not updated. With PruneAnnotation, the ones created by eksctl that are not in obj are
+// deleted
+func (r *Reconciler) Reconcile(ctx context.Context, obj *unstructured.Unstructured) error {
+	data, err := clusterConfigData(obj)
+	if err != nil {
+		return err
+	}
+	// each operation sets the defaults of its ClusterConfig, so each gets its own copy
+	newClusterConfig := func() (*api.ClusterConfig, error) {
+		return eks.ParseConfig(data)
+	}
+	cfg, err := newClusterConfig()
+	if err != nil {
+		return fmt.Errorf("invalid spec: %w", err)
+	}
+	ops := r.newOperations(cfg.Metadata.Region)
+
+	if len(cfg.NodeGroups) > 0 || len(cfg.ManagedNodeGroups) > 0 {
+		cfg, err := newClusterConfig()
+		if err != nil {
+			return err
+		}
+		if err := ops.CreateNodeGroups(ctx, cfg, client.CreateNodeGroupsOptions{}); err != nil {
+			return fmt.Errorf("creating nodegroups: %w", err)
+		}
+	}
+	if cfg.IAM != nil && len(cfg.IAM.ServiceAccounts) > 0 {
+		cfg, err := newClusterConfig()
+		if err != nil {
+			return err
+		}
+		if err := ops.CreateIAMServiceAccounts(ctx, cfg, client.CreateIAMServiceAccountsOptions{}); err != nil {
+			return fmt.Errorf("creating IAM service accounts: %w", err)
+		}
+	}
+	if len(cfg.Addons) > 0 {
+		cfg, err := newClusterConfig()
+		if err != nil {
+			return err
+		}
+		if err := ops.CreateAddons(ctx, cfg, client.CreateAddonsOptions{}); err != nil {
+			return fmt.Errorf("creating addons: %w", err)
+		}
+	}
+
+	if obj.GetAnnotations()[PruneAnnotation] != "true" {
+		return nil
+	}
+	return prune(ctx, ops, cfg)
+}
+
+// networkingAddons provide the networking and DNS of the cluster, they are never pruned
+var networkingAddons = sets.NewString(api.VPCCNIAddon, api.CoreDNSAddon, api.KubeProxyAddon)
+
+// prune deletes the nodegroups, addons and IAM service accounts created by eksctl for the cluster of cfg that are not
+// in cfg. The networking addons are never deleted
+func prune(ctx context.Context, ops Operations, cfg *api.ClusterConfig) error {
+	clusterName := cfg.Metadata.Name
+
+	nodeGroups := sets.NewString()
+	for _, ng := range cfg.NodeGroups {
+		nodeGroups.Insert(ng.Name)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		nodeGroups.Insert(ng.Name)
+	}
+	existingNodeGroups, err := ops.ListNodeGroups(ctx, clusterName)
+	if err != nil {
+		return fmt.Errorf("listing nodegroups: %w", err)
+	}
+	for _, name := range existingNodeGroups {
+		if nodeGroups.Has(name) {
+			continue
+		}
+		logger.Info("deleting nodegroup %q of cluster %q, as it is not in the ClusterConfig", name, clusterName)
+		if err := ops.DeleteNodeGroup(ctx, clusterName, name, client.DeleteNodeGroupOptions{Wait: true}); err != nil {
+			return fmt.Errorf("deleting nodegroup %q: %w", name, err)
+		}
+	}
+
+	addons := sets.NewString()
+	for _, a := range cfg.Addons {
+		addons.Insert(a.Name)
+	}
+	existingAddons, err := ops.ListAddons(ctx, clusterName)
+	if err != nil {
+		return fmt.Errorf("listing addons: %w", err)
+	}
+	for _, name := range existingAddons {
+		if addons.Has(name) || networkingAddons.Has(name) {
+			continue
+		}
+		logger.Info("deleting addon %q of cluster %q, as it is not in the ClusterConfig", name, clusterName)
+		if err := ops.DeleteAddon(ctx, clusterName, name, client.DeleteAddonOptions{}); err != nil {
+			return fmt.Errorf("deleting addon %q: %w", name, err)
+		}
+	}
+
+	serviceAccounts := sets.NewString()
+	if cfg.IAM != nil {
+		for _, sa := range cfg.IAM.ServiceAccounts {
+			serviceAccounts.Insert(sa.NameString())
+		}
+	}
+	existingServiceAccounts, err := ops.ListIAMServiceAccounts(ctx, clusterName)
+	if err != nil {
+		return fmt.Errorf("listing IAM service accounts: %w", err)
+	}
+	toDelete := api.NewClusterConfig()
+	toDelete.Metadata.Name = clusterName
+	toDelete.Metadata.Region = cfg.Metadata.Region
+	for _, name := range existingServiceAccounts {
+		if serviceAccounts.Has(name) {
+			continue
+		}
+		meta, err := api.ClusterIAMServiceAccountNameStringToClusterIAMMeta(name)
+		if err != nil {
+			return err
+		}
+		logger.Info("deleting IAM service account %q of cluster %q, as it is not in the ClusterConfig", name, clusterName)
+		toDelete.IAM.ServiceAccounts = append(toDelete.IAM.ServiceAccounts, &api.ClusterIAMServiceAccount{ClusterIAMMeta: *meta})
+	}
+	if len(toDelete.IAM.ServiceAccounts) > 0 {
+		if err := ops.DeleteIAMServiceAccounts(ctx, toDelete, client.DeleteIAMServiceAccountsOptions{Wait: true}); err != nil {
+			return fmt.Errorf("deleting IAM service accounts: %w", err)
+		}
+	}
+	return nil
+}
+
+// clusterConfigData returns the eksctl ClusterConfig of the spec of obj, whose metadata.name defaults to the name
+// of obj
+func clusterConfigData(obj *unstructured.Unstructured) ([]byte, error) {
+	spec, found, err := unstructured.NestedMap(obj.Object, "spec")
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+	if !found {
+		return nil, errors.New("spec must be set")
+	}
+	name, _, err := unstructured.NestedString(spec, "metadata", "name")
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+	if name == "" {
+		if err := unstructured.SetNestedField(spec, obj.GetName(), "metadata", "name"); err != nil {
+			return nil, fmt.Errorf("invalid spec: %w", err)
+		}
+	}
+	spec["apiVersion"] = api.SchemeGroupVersion.String()
+	spec["kind"] = api.ClusterConfigKind
+	return json.Marshal(spec)
+}
@@ -0,0 +1,223 @@
+package operator_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/weaveworks/eksctl/pkg/actions/operator"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/client"
+)
+
+type call struct {
+	operation string
+	cluster   string
+	names     []string
+	options   interface{}
+}
+
+// fakeOperations records the operations run, and lists the nodegroups, addons and service accounts it is set with
+type fakeOperations struct {
+	mu              sync.Mutex
+	calls           []call
+	err             error
+	nodeGroups      []string
+	addons          []string
+	serviceAccounts []string
+}
+
+func (f *fakeOperations) record(c call) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, c)
+	return f.err
+}
+
+func (f *fakeOperations) recorded() []call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]call{}, f.calls...)
+}
+
+func (f *fakeOperations) CreateNodeGroups(_ context.Context, cfg *api.ClusterConfig, options client.CreateNodeGroupsOptions) error {
+	var names []string
+	for _, ng := range cfg.NodeGroups {
+		names = append(names, ng.Name)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		names = append(names, ng.Name)
+	}
+	return f.record(call{operation: "CreateNodeGroups", cluster: cfg.Metadata.Name, names: names, options: options})
+}
+
+func (f *fakeOperations) ListNodeGroups(_ context.Context, _ string) ([]string, error) {
+	return f.nodeGroups, nil
+}
+
+func (f *fakeOperations) DeleteNodeGroup(_ context.Context, clusterName, name string, options client.DeleteNodeGroupOptions) error {
+	return f.record(call{operation: "DeleteNodeGroup", cluster: clusterName, names: []string{name}, options: options})
+}
+
+func (f *fakeOperations) CreateAddons(_ context.Context, cfg *api.ClusterConfig, options client.CreateAddonsOptions) error {
+	var names []string
+	for _, a := range cfg.Addons {
+		names = append(names, a.Name)
+	}
+	return f.record(call{operation: "CreateAddons", cluster: cfg.Metadata.Name, names: names, options: options})
+}
+
+func (f *fakeOperations) ListAddons(_ context.Context, _ string) ([]string, error) {
+	return f.addons, nil
+}
+
+func (f *fakeOperations) DeleteAddon(_ context.Context, clusterName, name string, options client.DeleteAddonOptions) error {
+	return f.record(call{operation: "DeleteAddon", cluster: clusterName, names: []string{name}, options: options})
+}
+
+func (f *fakeOperations) CreateIAMServiceAccounts(_ context.Context, cfg *api.ClusterConfig, options client.CreateIAMServiceAccountsOptions) error {
+	return f.record(call{operation: "CreateIAMServiceAccounts", cluster: cfg.Metadata.Name, names: serviceAccountNames(cfg), options: options})
+}
+
+func (f *fakeOperations) ListIAMServiceAccounts(_ context.Context, _ string) ([]string, error) {
+	return f.serviceAccounts, nil
+}
+
+func (f *fakeOperations) DeleteIAMServiceAccounts(_ context.Context, cfg *api.ClusterConfig, options client.DeleteIAMServiceAccountsOptions) error {
+	return f.record(call{operation: "DeleteIAMServiceAccounts", cluster: cfg.Metadata.Name, names: serviceAccountNames(cfg), options: options})
+}
+
+func serviceAccountNames(cfg *api.ClusterConfig) []string {
+	var names []string
+	for _, sa := range cfg.IAM.ServiceAccounts {
+		names = append(names, sa.NameString())
+	}
+	return names
+}
+
+func newClusterConfig(name string, spec map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": operator.Group + "/" + operator.Version,
+		"kind":       operator.Kind,
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "default",
+		},
+	}}
+	if spec != nil {
+		obj.Object["spec"] = spec
+	}
+	return obj
+}
+
+func fullSpec() map[string]interface{} {
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "dev",
+			"region": "us-west-2",
+		},
+		"nodeGroups": []interface{}{
+			map[string]interface{}{"name": "ng-1"},
+		},
+		"managedNodeGroups": []interface{}{
+			map[string]interface{}{"name": "mng-1"},
+		},
+		"addons": []interface{}{
+			map[string]interface{}{"name": "vpc-cni"},
+		},
+		"iam": map[string]interface{}{
+			"withOIDC": true,
+			"serviceAccounts": []interface{}{
+				map[string]interface{}{
+					"metadata":         map[string]interface{}{"name": "s3-reader", "namespace": "backend"},
+					"attachPolicyARNs": []interface{}{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ops        *fakeOperations
+		regions    []string
+		reconciler *operator.Reconciler
+	)
+
+	BeforeEach(func() {
+		ops = &fakeOperations{}
+		regions = nil
+		var err error
+		reconciler, err = operator.NewReconciler(func(region string) operator.Operations {
+			regions = append(regions, region)
+			return ops
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("creates the nodegroups, IAM service accounts and addons of the spec", func() {
+		Expect(reconciler.Reconcile(context.Background(), newClusterConfig("dev", fullSpec()))).To(Succeed())
+		Expect(regions).To(Equal([]string{"us-west-2"}))
+		Expect(ops.recorded()).To(Equal([]call{
+			{operation: "CreateNodeGroups", cluster: "dev", names: []string{"ng-1", "mng-1"}, options: client.CreateNodeGroupsOptions{}},
+			{operation: "CreateIAMServiceAccounts", cluster: "dev", names: []string{"backend/s3-reader"}, options: client.CreateIAMServiceAccountsOptions{}},
+			{operation: "CreateAddons", cluster: "dev", names: []string{"vpc-cni"}, options: client.CreateAddonsOptions{}},
+		}))
+	})
+
+	It("skips the sections the spec leaves empty", func() {
+		spec := map[string]interface{}{
+			"metadata": map[string]interface{}{"region": "us-west-2"},
+			"addons": []interface{}{
+				map[string]interface{}{"name": "coredns"},
+			},
+		}
+		Expect(reconciler.Reconcile(context.Background(), newClusterConfig("prod", spec))).To(Succeed())
+		Expect(ops.recorded()).To(Equal([]call{
+			{operation: "CreateAddons", cluster: "prod", names: []string{"coredns"}, options: client.CreateAddonsOptions{}},
+		}))
+	})
+
+	It("does not prune without the annotation", func() {
+		ops.nodeGroups = []string{"ng-1", "ng-old"}
+		Expect(reconciler.Reconcile(context.Background(), newClusterConfig("dev", fullSpec()))).To(Succeed())
+		for _, c := range ops.recorded() {
+			Expect(c.operation).NotTo(HavePrefix("Delete"))
+		}
+	})
+
+	It("deletes what the spec does not have with the prune annotation", func() {
+		ops.nodeGroups = []string{"ng-1", "mng-1", "ng-old"}
+		ops.addons = []string{"vpc-cni", "kube-proxy", "coredns", "aws-ebs-csi-driver"}
+		ops.serviceAccounts = []string{"backend/s3-reader", "backend/old", "kube-system/legacy"}
+		obj := newClusterConfig("dev", fullSpec())
+		obj.SetAnnotations(map[string]string{operator.PruneAnnotation: "true"})
+
+		Expect(reconciler.Reconcile(context.Background(), obj)).To(Succeed())
+		Expect(ops.recorded()[3:]).To(Equal([]call{
+			{operation: "DeleteNodeGroup", cluster: "dev", names: []string{"ng-old"}, options: client.DeleteNodeGroupOptions{Wait: true}},
+			{operation: "DeleteAddon", cluster: "dev", names: []string{"aws-ebs-csi-driver"}, options: client.DeleteAddonOptions{}},
+			{operation: "DeleteIAMServiceAccounts", cluster: "dev", names: []string{"backend/old", "kube-system/legacy"}, options: client.DeleteIAMServiceAccountsOptions{Wait: true}},
+		}))
+	})
+
+	It("stops at the first failing operation", func() {
+		ops.err = errors.New("stack failed")
+		err := reconciler.Reconcile(context.Background(), newClusterConfig("dev", fullSpec()))
+		Expect(err).To(MatchError("creating nodegroups: stack failed"))
+		Expect(ops.recorded()).To(HaveLen(1))
+	})
+
+	DescribeTable("rejects invalid specs", func(spec map[string]interface{}, expectedErr string) {
+		err := reconciler.Reconcile(context.Background(), newClusterConfig("dev", spec))
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		Expect(ops.recorded()).To(BeEmpty())
+	},
+		Entry("without a spec", nil, "spec must be set"),
+		Entry("with an unknown field", map[string]interface{}{"nodeGroup": []interface{}{}}, `unknown field "nodeGroup"`),
+	)
+})
@@ -0,0 +1,202 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+const (
+	// BinaryPrefix is the prefix of the names of the plugin binaries, `eksctl foo bar` runs eksctl-foo-bar or eksctl-foo
+	BinaryPrefix = "eksctl-"
+	// ContextEnvVar is the environment variable set to the path of the context file when a plugin is run
+	ContextEnvVar = "EKSCTL_PLUGIN_CONTEXT"
+	// ContextVersion is the version of the format of the context file, changed when a field is removed or changes
+	// meaning, but not when one is added
+	ContextVersion = "v1"
+)
+
+// the names of plugins are restricted not to run binaries out of PATH, e.g. with `eksctl ../foo`
+var nameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Plugin is a binary extending eksctl with a command
+type Plugin struct {
+	// Name is the command the plugin runs as, e.g. "foo-bar" for `eksctl foo bar`
+	Name string
+	// Path is the path of the binary
+	Path string
+	// Args are the arguments following the command
+	Args []string
+}
+
+// Context is what a plugin is told of the invocation of eksctl, written as JSON to the file ContextEnvVar is set to
+type Context struct {
+	// Version is ContextVersion
+	Version string `json:"version"`
+	// EksctlVersion is the version of eksctl running the plugin
+	EksctlVersion string `json:"eksctlVersion"`
+	// Plugin is the name of the plugin
+	Plugin string `json:"plugin"`
+	// Args are the arguments of the plugin
+	Args []string `json:"args"`
+	// Provider is the AWS profile and region the plugin should use
+	Provider Provider `json:"provider"`
+	// ClusterConfigFile is the path of the config file set with --config-file or -f
+	ClusterConfigFile string `json:"clusterConfigFile,omitempty"`
+	// ClusterConfig is the cluster loaded from the config file, or the one named with --cluster when no config
+	// file is set
+	ClusterConfig *api.ClusterConfig `json:"clusterConfig,omitempty"`
+}
+
+// Provider is the AWS profile and region the plugin should use
+type Provider struct {
+	Region  string `json:"region,omitempty"`
+	Profile string `json:"profile,omitempty"`
+}
+
+// Find returns the plugin args run, or nil if they do not run one. The longest command matching a binary on the
+// PATH wins, and commands built into eksctl cannot be overridden
+func Find(args []string, isBuiltin func(command string) bool) *Plugin {
+	var commands []string
+	for _, arg := range args {
+		if !nameRegexp.MatchString(arg) {
+			break
+		}
+		commands = append(commands, arg)
+	}
+	if len(commands) == 0 || isBuiltin(commands[0]) {
+		return nil
+	}
+	for i := len(commands); i > 0; i-- {
+		name := strings.Join(commands[:i], "-")
+		if path, err := exec.LookPath(BinaryPrefix + name); err == nil {
+			return &Plugin{
+				Name: name,
+				Path: path,
+				Args: args[i:],
+			}
+		}
+	}
+	return nil
+}
+
+// NewContext returns the context of a plugin, parsing the flags of its arguments eksctl knows of and loading the
+// cluster from the config file they set
+func NewContext(p *Plugin) (*Context, error) {
+	var clusterName, region, profile, configFile string
+	fs := pflag.NewFlagSet(p.Name, pflag.ContinueOnError)
+	fs.ParseErrorsWhitelist.UnknownFlags = true
+	fs.SetOutput(io.Discard)
+	fs.StringVarP(&clusterName, "cluster", "c", "", "")
+	fs.StringVarP(&region, "region", "r", "", "")
+	fs.StringVarP(&profile, "profile", "p", "", "")
+	fs.StringVarP(&configFile, "config-file", "f", "", "")
+	// the flags are the plugin's, it reports those it does not accept
+	_ = fs.Parse(p.Args)
+
+	pluginContext := &Context{
+		Version:           ContextVersion,
+		EksctlVersion:     version.GetVersion(),
+		Plugin:            p.Name,
+		Args:              p.Args,
+		ClusterConfigFile: configFile,
+	}
+	if pluginContext.Args == nil {
+		pluginContext.Args = []string{}
+	}
+
+	switch {
+	case configFile != "" && configFile != "-":
+		// reading the config file from stdin would leave nothing for the plugin to read
+		if err := api.Register(); err != nil {
+			return nil, err
+		}
+		cfg, err := eks.LoadClusterConfigFromFile(configFile, eks.LoadConfigOptions{ClusterName: clusterName})
+		if err != nil {
+			return nil, err
+		}
+		pluginContext.ClusterConfig = cfg
+	case clusterName != "":
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		cfg.Metadata.Region = region
+		pluginContext.ClusterConfig = cfg
+	}
+
+	if region == "" && pluginContext.ClusterConfig != nil {
+		region = pluginContext.ClusterConfig.Metadata.Region
+	}
+	pluginContext.Provider.Region = firstNonEmpty(region, os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION"))
+	pluginContext.Provider.Profile = firstNonEmpty(profile, os.Getenv("AWS_PROFILE"))
+	return pluginContext, nil
+}
+
+// Run runs a plugin with its context and returns its exit code
+func Run(p *Plugin, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	pluginContext, err := NewContext(p)
+	if err != nil {
+		return 1, fmt.Errorf("running plugin %q: %w", p.Name, err)
+	}
+	contextFile, err := writeContext(pluginContext)
+	if err != nil {
+		return 1, fmt.Errorf("running plugin %q: %w", p.Name, err)
+	}
+	defer os.Remove(contextFile)
+
+	cmd := exec.Command(p.Path, p.Args...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("EKSCTL_VERSION=%s", pluginContext.EksctlVersion),
+		fmt.Sprintf("%s=%s", ContextEnvVar, contextFile),
+	)
+
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return 1, fmt.Errorf("running plugin %q: %w", p.Name, err)
+	}
+	return 0, nil
+}
+
+// writeContext writes the context to a file only the user can read, as the config of the cluster may hold secrets
+func writeContext(pluginContext *Context) (string, error) {
+	f, err := os.CreateTemp("", "eksctl-plugin-context-*.json")
+	if err != nil {
+		return "", fmt.Errorf("writing plugin context: %w", err)
+	}
+	if err := json.NewEncoder(f).Encode(pluginContext); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return "", fmt.Errorf("writing plugin context: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return "", fmt.Errorf("writing plugin context: %w", err)
+	}
+	return f.Name(), nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
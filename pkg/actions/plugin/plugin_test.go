@@ -0,0 +1,131 @@
+package plugin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/plugin"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+var _ = Describe("Plugin", func() {
+	var binDir string
+
+	writePlugin := func(name, script string) string {
+		path := filepath.Join(binDir, plugin.BinaryPrefix+name)
+		Expect(os.WriteFile(path, []byte("#!/usr/bin/env sh\n"+script), 0755)).To(Succeed())
+		return path
+	}
+	isBuiltin := func(command string) bool {
+		return command == "create"
+	}
+
+	BeforeEach(func() {
+		binDir = GinkgoT().TempDir()
+		GinkgoT().Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+		GinkgoT().Setenv("AWS_REGION", "")
+		GinkgoT().Setenv("AWS_DEFAULT_REGION", "")
+		GinkgoT().Setenv("AWS_PROFILE", "")
+	})
+
+	Context("Find", func() {
+		It("finds the plugin of the longest command", func() {
+			fooPath := writePlugin("foo", "")
+			fooBarPath := writePlugin("foo-bar", "")
+
+			Expect(plugin.Find([]string{"foo", "bar", "--cluster", "dev"}, isBuiltin)).To(Equal(&plugin.Plugin{
+				Name: "foo-bar",
+				Path: fooBarPath,
+				Args: []string{"--cluster", "dev"},
+			}))
+			Expect(plugin.Find([]string{"foo", "baz"}, isBuiltin)).To(Equal(&plugin.Plugin{
+				Name: "foo",
+				Path: fooPath,
+				Args: []string{"baz"},
+			}))
+		})
+
+		It("does not find a plugin for built-in commands, flags or paths", func() {
+			writePlugin("create", "")
+			Expect(plugin.Find([]string{"create", "cluster"}, isBuiltin)).To(BeNil())
+			Expect(plugin.Find([]string{"--foo"}, isBuiltin)).To(BeNil())
+			Expect(plugin.Find([]string{"../foo"}, isBuiltin)).To(BeNil())
+			Expect(plugin.Find([]string{"missing"}, isBuiltin)).To(BeNil())
+			Expect(plugin.Find(nil, isBuiltin)).To(BeNil())
+		})
+	})
+
+	Context("NewContext", func() {
+		It("loads the cluster from the config file", func() {
+			configFile := filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+			Expect(os.WriteFile(configFile, []byte(`apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: dev
+  region: eu-west-1
+`), 0644)).To(Succeed())
+			GinkgoT().Setenv("AWS_PROFILE", "team")
+
+			pluginContext, err := plugin.NewContext(&plugin.Plugin{Name: "foo", Args: []string{"-f", configFile, "--unknown", "value"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginContext.Version).To(Equal(plugin.ContextVersion))
+			Expect(pluginContext.EksctlVersion).To(Equal(version.GetVersion()))
+			Expect(pluginContext.ClusterConfigFile).To(Equal(configFile))
+			Expect(pluginContext.ClusterConfig.Metadata.Name).To(Equal("dev"))
+			Expect(pluginContext.Provider).To(Equal(plugin.Provider{Region: "eu-west-1", Profile: "team"}))
+		})
+
+		It("names the cluster set with --cluster, and prefers the flags to the environment", func() {
+			GinkgoT().Setenv("AWS_REGION", "us-east-1")
+			pluginContext, err := plugin.NewContext(&plugin.Plugin{Name: "foo", Args: []string{"--cluster=dev", "--region", "us-west-2", "--profile", "admin"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginContext.ClusterConfig.Metadata.Name).To(Equal("dev"))
+			Expect(pluginContext.ClusterConfig.Metadata.Region).To(Equal("us-west-2"))
+			Expect(pluginContext.Provider).To(Equal(plugin.Provider{Region: "us-west-2", Profile: "admin"}))
+
+			pluginContext, err = plugin.NewContext(&plugin.Plugin{Name: "foo"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pluginContext.ClusterConfig).To(BeNil())
+			Expect(pluginContext.Args).To(BeEmpty())
+			Expect(pluginContext.Provider.Region).To(Equal("us-east-1"))
+		})
+
+		It("fails when the config file cannot be loaded", func() {
+			_, err := plugin.NewContext(&plugin.Plugin{Name: "foo", Args: []string{"-f", "missing.yaml"}})
+			Expect(err).To(MatchError(ContainSubstring(`reading config file "missing.yaml"`)))
+		})
+	})
+
+	Context("Run", func() {
+		It("runs the plugin with its context and returns its exit code", func() {
+			writePlugin("foo", `echo "$@"
+echo "EKSCTL_VERSION=$EKSCTL_VERSION"
+cat "$EKSCTL_PLUGIN_CONTEXT"
+echo "$EKSCTL_PLUGIN_CONTEXT" >&2
+exit 3`)
+			p := plugin.Find([]string{"foo", "--cluster", "dev"}, isBuiltin)
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			exitCode, err := plugin.Run(p, strings.NewReader(""), stdout, stderr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exitCode).To(Equal(3))
+
+			lines := strings.SplitN(stdout.String(), "\n", 3)
+			Expect(lines[0]).To(Equal("--cluster dev"))
+			Expect(lines[1]).To(Equal("EKSCTL_VERSION=" + version.GetVersion()))
+			var pluginContext plugin.Context
+			Expect(json.Unmarshal([]byte(lines[2]), &pluginContext)).To(Succeed())
+			Expect(pluginContext.Plugin).To(Equal("foo"))
+			Expect(pluginContext.Args).To(Equal([]string{"--cluster", "dev"}))
+			Expect(pluginContext.ClusterConfig.Metadata.Name).To(Equal("dev"))
+
+			By("removing the context file once the plugin exits")
+			Expect(strings.TrimSpace(stderr.String())).NotTo(BeAnExistingFile())
+		})
+	})
+})
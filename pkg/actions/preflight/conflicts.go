@@ -0,0 +1,82 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// checkConflicts checks that neither the cluster nor the stacks of the cluster and its nodegroups exist
+func (c *Checker) checkConflicts(ctx context.Context) []Check {
+	name := c.cfg.Metadata.Name
+	var checks []Check
+
+	_, err := c.provider.EKS().DescribeCluster(ctx, &eks.DescribeClusterInput{
+		Name: aws.String(name),
+	})
+	var notFoundErr *ekstypes.ResourceNotFoundException
+	switch {
+	case err == nil:
+		checks = append(checks, fail(KindConflict, "cluster %q already exists", name))
+	case errors.As(err, &notFoundErr):
+		checks = append(checks, pass(KindConflict, "no cluster named %q exists", name))
+	default:
+		checks = append(checks, warn(KindConflict, "unable to check whether cluster %q exists: %v", name, err))
+	}
+
+	stackNames := c.stackNames()
+	var stacks []string
+	paginator := cloudformation.NewListStacksPaginator(c.provider.CloudFormation(), &cloudformation.ListStacksInput{
+		StackStatusFilter: liveStackStatuses(),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return append(checks, warn(KindConflict, "unable to check whether the stacks of cluster %q exist: %v", name, err))
+		}
+		for _, stack := range output.StackSummaries {
+			if stackName := aws.ToString(stack.StackName); stackNames[stackName] {
+				stacks = append(stacks, stackName)
+			}
+		}
+	}
+	if len(stacks) > 0 {
+		sort.Strings(stacks)
+		return append(checks, fail(KindConflict, "stacks of cluster %q already exist: %s", name, strings.Join(stacks, ", ")))
+	}
+	return append(checks, pass(KindConflict, "none of the %d stacks of cluster %q exist", len(stackNames), name))
+}
+
+// stackNames returns the names of the stacks of the cluster and its nodegroups
+func (c *Checker) stackNames() map[string]bool {
+	name := c.cfg.Metadata.Name
+	names := map[string]bool{
+		"eksctl-" + name + "-cluster": true,
+	}
+	for _, ng := range c.cfg.NodeGroups {
+		names[fmt.Sprintf("eksctl-%s-nodegroup-%s", name, ng.Name)] = true
+	}
+	for _, ng := range c.cfg.ManagedNodeGroups {
+		names[fmt.Sprintf("eksctl-%s-nodegroup-%s", name, ng.Name)] = true
+	}
+	return names
+}
+
+// liveStackStatuses returns all the statuses of the stacks but the deleted ones
+func liveStackStatuses() []cfntypes.StackStatus {
+	var statuses []cfntypes.StackStatus
+	for _, status := range cfntypes.StackStatusCreateComplete.Values() {
+		if status != cfntypes.StackStatusDeleteComplete {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
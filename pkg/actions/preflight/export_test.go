@@ -0,0 +1,3 @@
+package preflight
+
+var QuotaFamily = quotaFamily
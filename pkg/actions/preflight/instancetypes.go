@@ -0,0 +1,52 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/smithy-go"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// checkInstanceTypes checks that the instance types of the nodegroups are offered in at least one of their
+// availability zones, as eksctl does when creating them
+func (c *Checker) checkInstanceTypes(ctx context.Context) []Check {
+	var checks []Check
+	check := func(name string, ng api.NodePool, withNodeGroup func(*api.ClusterConfig)) {
+		instanceTypes := ng.InstanceTypeList()
+		zones := ng.BaseNodeGroup().AvailabilityZones
+		if len(zones) == 0 {
+			zones = c.cfg.AvailabilityZones
+		}
+		if len(instanceTypes) == 0 || len(zones) == 0 {
+			// eksctl selects the instance types, or the availability zones offering them
+			return
+		}
+		// check the nodegroups one at a time to report on each of them
+		cfg := *c.cfg
+		cfg.NodeGroups, cfg.ManagedNodeGroups = nil, nil
+		withNodeGroup(&cfg)
+		if err := eks.CheckInstanceAvailability(ctx, &cfg, c.provider.EC2()); err != nil {
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) {
+				checks = append(checks, warn(KindInstanceType, "unable to check the instance types of nodegroup %q: %v", name, err))
+				return
+			}
+			checks = append(checks, fail(KindInstanceType, "nodegroup %q: %v", name, err))
+			return
+		}
+		checks = append(checks, pass(KindInstanceType, "nodegroup %q: %s offered in at least one of %s", name, strings.Join(instanceTypes, ", "), strings.Join(zones, ", ")))
+	}
+	for _, ng := range c.cfg.NodeGroups {
+		ng := ng
+		check(ng.Name, ng, func(cfg *api.ClusterConfig) { cfg.NodeGroups = []*api.NodeGroup{ng} })
+	}
+	for _, ng := range c.cfg.ManagedNodeGroups {
+		ng := ng
+		check(ng.Name, ng, func(cfg *api.ClusterConfig) { cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng} })
+	}
+	return checks
+}
@@ -0,0 +1,157 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// requiredActions returns the IAM actions eksctl calls to create the cluster in cfg
+func requiredActions(cfg *api.ClusterConfig) []string {
+	actions := []string{
+		"cloudformation:CreateStack",
+		"cloudformation:DescribeStacks",
+		"cloudformation:DescribeStackEvents",
+		"cloudformation:DeleteStack",
+		"eks:CreateCluster",
+		"eks:DescribeCluster",
+		"ec2:DescribeAvailabilityZones",
+		"ec2:DescribeInstanceTypeOfferings",
+		"ec2:DescribeSubnets",
+		"ec2:DescribeVpcs",
+		"ec2:CreateSecurityGroup",
+		"ec2:AuthorizeSecurityGroupIngress",
+		"iam:CreateRole",
+		"iam:GetRole",
+		"iam:AttachRolePolicy",
+		"iam:PassRole",
+		"ssm:GetParameter",
+	}
+	if cfg.VPC.ID == "" && (!cfg.HasAnySubnets() || cfg.HasSubnetCIDRPlan()) {
+		actions = append(actions,
+			"ec2:CreateVpc",
+			"ec2:CreateSubnet",
+			"ec2:CreateInternetGateway",
+			"ec2:AttachInternetGateway",
+			"ec2:CreateRouteTable",
+			"ec2:CreateRoute",
+			"ec2:AssociateRouteTable",
+			"ec2:AllocateAddress",
+			"ec2:CreateNatGateway",
+		)
+	}
+	if len(cfg.NodeGroups) > 0 {
+		actions = append(actions,
+			"ec2:CreateLaunchTemplate",
+			"ec2:RunInstances",
+			"iam:CreateInstanceProfile",
+			"iam:AddRoleToInstanceProfile",
+			"autoscaling:CreateAutoScalingGroup",
+		)
+	}
+	if len(cfg.ManagedNodeGroups) > 0 {
+		actions = append(actions,
+			"ec2:CreateLaunchTemplate",
+			"eks:CreateNodegroup",
+		)
+	}
+	if len(cfg.FargateProfiles) > 0 {
+		actions = append(actions, "eks:CreateFargateProfile")
+	}
+	if len(cfg.Addons) > 0 {
+		actions = append(actions, "eks:CreateAddon")
+	}
+	if api.IsEnabled(cfg.IAM.WithOIDC) {
+		actions = append(actions, "iam:CreateOpenIDConnectProvider")
+	}
+	if cfg.SecretsEncryption != nil && cfg.SecretsEncryption.KeyARN != "" {
+		actions = append(actions, "kms:DescribeKey", "kms:CreateGrant")
+	}
+	return uniqueSorted(actions)
+}
+
+func uniqueSorted(values []string) []string {
+	seen := map[string]struct{}{}
+	var unique []string
+	for _, v := range values {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			unique = append(unique, v)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+// checkPermissions simulates the IAM policies of the caller with the actions required to create the cluster
+func (c *Checker) checkPermissions(ctx context.Context) []Check {
+	identity, err := c.provider.STS().GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return []Check{warn(KindPermissions, "unable to get the caller identity: %v", err)}
+	}
+	principalARN, err := c.principalARN(ctx, aws.ToString(identity.Arn))
+	if err != nil {
+		return []Check{warn(KindPermissions, "unable to simulate the IAM policies of %s: %v", aws.ToString(identity.Arn), err)}
+	}
+	if principalARN == "" {
+		return []Check{pass(KindPermissions, "%s is the root user of the account, which is allowed all actions", aws.ToString(identity.Arn))}
+	}
+
+	actions := requiredActions(c.cfg)
+	var denied []string
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(c.provider.IAM(), &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalARN),
+		ActionNames:     actions,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return []Check{warn(KindPermissions, "unable to simulate the IAM policies of %s: %v", principalARN, err)}
+		}
+		for _, result := range output.EvaluationResults {
+			if result.EvalDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, aws.ToString(result.EvalActionName))
+			}
+		}
+	}
+	if len(denied) > 0 {
+		sort.Strings(denied)
+		return []Check{fail(KindPermissions, "the IAM policies of %s do not allow %s", principalARN, strings.Join(denied, ", "))}
+	}
+	return []Check{pass(KindPermissions, "the IAM policies of %s allow the %d actions required to create the cluster", principalARN, len(actions))}
+}
+
+// principalARN returns the ARN of the IAM user or role whose policies apply to the caller, or an empty string
+// for the root user
+func (c *Checker) principalARN(ctx context.Context, callerARN string) (string, error) {
+	parsed, err := arn.Parse(callerARN)
+	if err != nil {
+		return "", err
+	}
+	resource := strings.Split(parsed.Resource, "/")
+	switch {
+	case parsed.Service == "iam" && resource[0] == "root":
+		return "", nil
+	case parsed.Service == "iam" && resource[0] == "user":
+		return callerARN, nil
+	case parsed.Service == "sts" && resource[0] == "assumed-role" && len(resource) == 3:
+		// the ARN of the assumed role does not have the path of the role
+		role, err := c.provider.IAM().GetRole(ctx, &iam.GetRoleInput{
+			RoleName: aws.String(resource[1]),
+		})
+		if err != nil {
+			return "", fmt.Errorf("getting role %q: %w", resource[1], err)
+		}
+		return aws.ToString(role.Role.Arn), nil
+	}
+	return "", fmt.Errorf("the IAM policies of %s cannot be simulated", callerARN)
+}
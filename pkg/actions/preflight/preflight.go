@@ -0,0 +1,98 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Status is the outcome of a check
+type Status string
+
+const (
+	// StatusPass is the status of a check that found no problem
+	StatusPass Status = "pass"
+	// StatusWarn is the status of a check that could not be completed, or found a problem that does not
+	// prevent the cluster from being created
+	StatusWarn Status = "warn"
+	// StatusFail is the status of a check that found a problem that would make the creation fail
+	StatusFail Status = "fail"
+)
+
+// Kinds of checks
+const (
+	KindQuota        = "quota"
+	KindPermissions  = "permissions"
+	KindInstanceType = "instance-type"
+	KindConflict     = "conflict"
+)
+
+// Check is the outcome of a preflight check
+type Check struct {
+	Kind    string
+	Status  Status
+	Message string
+}
+
+// Result holds the outcome of the preflight checks of a config file
+type Result struct {
+	ConfigFile string
+	Passed     bool
+	Checks     []Check
+}
+
+// Checker checks that a cluster can be created before any stack is created
+type Checker struct {
+	cfg      *api.ClusterConfig
+	provider api.ClusterProvider
+}
+
+// NewChecker returns a Checker of the cluster in cfg, whose defaults must have been set
+func NewChecker(cfg *api.ClusterConfig, provider api.ClusterProvider) *Checker {
+	return &Checker{
+		cfg:      cfg,
+		provider: provider,
+	}
+}
+
+// Run runs all the checks, the result has not passed if any of them failed
+func (c *Checker) Run(ctx context.Context) *Result {
+	result := &Result{
+		Passed: true,
+	}
+	for _, check := range [][]Check{
+		c.checkConflicts(ctx),
+		c.checkQuotas(ctx),
+		c.checkPermissions(ctx),
+		c.checkInstanceTypes(ctx),
+	} {
+		result.Checks = append(result.Checks, check...)
+	}
+	for _, check := range result.Checks {
+		if check.Status == StatusFail {
+			result.Passed = false
+		}
+	}
+	return result
+}
+
+func newCheck(kind string, status Status, format string, args ...interface{}) Check {
+	return Check{
+		Kind:    kind,
+		Status:  status,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func pass(kind, format string, args ...interface{}) Check {
+	return newCheck(kind, StatusPass, format, args...)
+}
+
+func warn(kind, format string, args ...interface{}) Check {
+	return newCheck(kind, StatusWarn, format, args...)
+}
+
+func fail(kind, format string, args ...interface{}) Check {
+	return newCheck(kind, StatusFail, format, args...)
+}
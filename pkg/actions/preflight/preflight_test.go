@@ -0,0 +1,234 @@
+package preflight_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Checker", func() {
+	const (
+		clusterName = "preflight"
+		roleARN     = "arn:aws:iam::111122223333:role/admins/cluster-admin"
+	)
+
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	checksOfKind := func(result *preflight.Result, kind string) []preflight.Check {
+		var checks []preflight.Check
+		for _, check := range result.Checks {
+			if check.Kind == kind {
+				checks = append(checks, check)
+			}
+		}
+		return checks
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		cfg.VPC.ID = "vpc-1234"
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+		ng := api.NewManagedNodeGroup()
+		ng.Name = "workers"
+		ng.InstanceType = "m5.large"
+		ng.DesiredCapacity = aws.Int(2)
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+
+		p.MockEKS().On("DescribeCluster", mock.Anything, &eks.DescribeClusterInput{
+			Name: aws.String(clusterName),
+		}).Return(nil, &ekstypes.ResourceNotFoundException{})
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStacksOutput{
+			StackSummaries: []cfntypes.StackSummary{
+				{StackName: aws.String("eksctl-preflight-other-cluster")},
+				{StackName: aws.String("eksctl-other-nodegroup-workers")},
+			},
+		}, nil)
+
+		p.MockEC2().On("DescribeInstanceTypes", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []ec2types.InstanceTypeInfo{
+				{InstanceType: "m5.large", VCpuInfo: &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(2)}},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeInstances", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstancesOutput{}, nil)
+		p.MockServiceQuotas().On("GetServiceQuota", mock.Anything, mock.Anything).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(32)},
+		}, nil)
+
+		p.MockSTS().On("GetCallerIdentity", mock.Anything, mock.Anything).Return(&sts.GetCallerIdentityOutput{
+			Arn: aws.String("arn:aws:sts::111122223333:assumed-role/cluster-admin/session"),
+		}, nil)
+		p.MockIAM().On("GetRole", mock.Anything, &iam.GetRoleInput{
+			RoleName: aws.String("cluster-admin"),
+		}).Return(&iam.GetRoleOutput{
+			Role: &iamtypes.Role{Arn: aws.String(roleARN)},
+		}, nil)
+	})
+
+	mockSimulation := func(denied ...string) {
+		p.MockIAM().On("SimulatePrincipalPolicy", mock.Anything, mock.MatchedBy(func(input *iam.SimulatePrincipalPolicyInput) bool {
+			return aws.ToString(input.PolicySourceArn) == roleARN
+		}), mock.Anything).Return(func(_ context.Context, input *iam.SimulatePrincipalPolicyInput, _ ...func(*iam.Options)) *iam.SimulatePrincipalPolicyOutput {
+			output := &iam.SimulatePrincipalPolicyOutput{}
+			for _, action := range input.ActionNames {
+				decision := iamtypes.PolicyEvaluationDecisionTypeAllowed
+				for _, d := range denied {
+					if action == d {
+						decision = iamtypes.PolicyEvaluationDecisionTypeImplicitDeny
+					}
+				}
+				output.EvaluationResults = append(output.EvaluationResults, iamtypes.EvaluationResult{
+					EvalActionName: aws.String(action),
+					EvalDecision:   decision,
+				})
+			}
+			return output
+		}, nil)
+	}
+
+	mockOfferings := func(offerings ...ec2types.InstanceTypeOffering) {
+		p.MockEC2().On("DescribeInstanceTypeOfferings", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+			InstanceTypeOfferings: offerings,
+		}, nil)
+	}
+
+	allOfferings := []ec2types.InstanceTypeOffering{
+		{InstanceType: "m5.large", Location: aws.String("us-west-2a")},
+		{InstanceType: "m5.large", Location: aws.String("us-west-2b")},
+	}
+
+	It("passes when the cluster can be created", func() {
+		mockSimulation()
+		mockOfferings(allOfferings...)
+
+		result := preflight.NewChecker(cfg, p).Run(context.Background())
+		Expect(result.Passed).To(BeTrue())
+		Expect(result.Checks).To(HaveEach(HaveField("Status", preflight.StatusPass)))
+		Expect(checksOfKind(result, preflight.KindConflict)).To(HaveLen(2))
+		Expect(checksOfKind(result, preflight.KindQuota)).To(ConsistOf(
+			HaveField("Message", "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances: 4 vCPUs are needed and 0 of 32 are in use"),
+		))
+		Expect(checksOfKind(result, preflight.KindInstanceType)).To(ConsistOf(
+			HaveField("Message", `nodegroup "workers": m5.large offered in at least one of us-west-2a, us-west-2b`),
+		))
+	})
+
+	It("fails when the cluster already exists", func() {
+		mockSimulation()
+		mockOfferings(allOfferings...)
+		p.MockEKS().ExpectedCalls = nil
+		p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+		p.MockCloudFormation().ExpectedCalls = nil
+		p.MockCloudFormation().On("ListStacks", mock.Anything, mock.Anything, mock.Anything).Return(&cloudformation.ListStacksOutput{
+			StackSummaries: []cfntypes.StackSummary{
+				{StackName: aws.String("eksctl-preflight-nodegroup-workers")},
+				{StackName: aws.String("eksctl-preflight-cluster")},
+				{StackName: aws.String("eksctl-preflight-addon-vpc-cni")},
+			},
+		}, nil)
+
+		result := preflight.NewChecker(cfg, p).Run(context.Background())
+		Expect(result.Passed).To(BeFalse())
+		Expect(checksOfKind(result, preflight.KindConflict)).To(ConsistOf(
+			preflight.Check{Kind: preflight.KindConflict, Status: preflight.StatusFail, Message: `cluster "preflight" already exists`},
+			preflight.Check{Kind: preflight.KindConflict, Status: preflight.StatusFail, Message: `stacks of cluster "preflight" already exist: eksctl-preflight-cluster, eksctl-preflight-nodegroup-workers`},
+		))
+	})
+
+	It("fails when the IAM policies of the caller deny required actions", func() {
+		mockSimulation("iam:PassRole", "eks:CreateNodegroup")
+		mockOfferings(allOfferings...)
+
+		result := preflight.NewChecker(cfg, p).Run(context.Background())
+		Expect(result.Passed).To(BeFalse())
+		Expect(checksOfKind(result, preflight.KindPermissions)).To(ConsistOf(preflight.Check{
+			Kind:    preflight.KindPermissions,
+			Status:  preflight.StatusFail,
+			Message: "the IAM policies of " + roleARN + " do not allow eks:CreateNodegroup, iam:PassRole",
+		}))
+	})
+
+	It("warns when the IAM policies cannot be simulated", func() {
+		p.MockIAM().On("SimulatePrincipalPolicy", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("AccessDenied"))
+		mockOfferings(allOfferings...)
+
+		result := preflight.NewChecker(cfg, p).Run(context.Background())
+		Expect(result.Passed).To(BeTrue())
+		Expect(checksOfKind(result, preflight.KindPermissions)).To(ConsistOf(HaveField("Status", preflight.StatusWarn)))
+	})
+
+	It("passes when an instance type is offered in one of the availability zones", func() {
+		mockSimulation()
+		mockOfferings(allOfferings[0])
+
+		result := preflight.NewChecker(cfg, p).Run(context.Background())
+		Expect(result.Passed).To(BeTrue())
+		Expect(checksOfKind(result, preflight.KindInstanceType)).To(ConsistOf(HaveField("Status", preflight.StatusPass)))
+	})
+
+	It("fails when an instance type is not offered in any of the availability zones", func() {
+		mockSimulation()
+		mockOfferings(ec2types.InstanceTypeOffering{InstanceType: "m5.large", Location: aws.String("us-west-2c")})
+
+		result := preflight.NewChecker(cfg, p).Run(context.Background())
+		Expect(result.Passed).To(BeFalse())
+		Expect(checksOfKind(result, preflight.KindInstanceType)).To(ConsistOf(preflight.Check{
+			Kind:    preflight.KindInstanceType,
+			Status:  preflight.StatusFail,
+			Message: `nodegroup "workers": none of the provided AZs "us-west-2a,us-west-2b" support instance type m5.large in nodegroup workers`,
+		}))
+	})
+
+	It("checks the quotas of VPCs and Elastic IPs when a VPC is created", func() {
+		mockSimulation()
+		mockOfferings(allOfferings...)
+		cfg.VPC.ID = ""
+		cfg.VPC.NAT = &api.ClusterNAT{Gateway: aws.String(api.ClusterHighlyAvailableNAT)}
+		p.MockEC2().On("DescribeVpcs", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: make([]ec2types.Vpc, 4),
+		}, nil)
+		p.MockEC2().On("DescribeAddresses", mock.Anything, mock.Anything).Return(&ec2.DescribeAddressesOutput{
+			Addresses: make([]ec2types.Address, 31),
+		}, nil)
+
+		result := preflight.NewChecker(cfg, p).Run(context.Background())
+		Expect(result.Passed).To(BeFalse())
+		Expect(checksOfKind(result, preflight.KindQuota)).To(ContainElements(
+			preflight.Check{
+				Kind:    preflight.KindQuota,
+				Status:  preflight.StatusPass,
+				Message: "VPCs per Region: 1 are needed and 4 of 32 are in use",
+			},
+			preflight.Check{
+				Kind:    preflight.KindQuota,
+				Status:  preflight.StatusFail,
+				Message: "EC2-VPC Elastic IPs: 2 are needed and 31 of 32 are in use, request an increase of quota L-0263D0A3 of service ec2",
+			},
+		))
+	})
+})
@@ -0,0 +1,492 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// Quota is a service quota, identified by its code in Service Quotas
+type Quota struct {
+	ServiceCode string
+	Code        string
+	Name        string
+}
+
+// Quotas of the resources eksctl creates
+var (
+	VPCsQuota = Quota{ServiceCode: "vpc", Code: "L-F678F1CE", Name: "VPCs per Region"}
+
+	ElasticIPsQuota = Quota{ServiceCode: "ec2", Code: "L-0263D0A3", Name: "EC2-VPC Elastic IPs"}
+)
+
+// onDemandVCPUQuotas are the quotas of the vCPUs of the running On-Demand instances, by instance family
+var onDemandVCPUQuotas = map[string]Quota{
+	"standard": {ServiceCode: "ec2", Code: "L-1216C47A", Name: "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances"},
+	"f":        {ServiceCode: "ec2", Code: "L-74FC7D96", Name: "Running On-Demand F instances"},
+	"g":        {ServiceCode: "ec2", Code: "L-DB2E81BA", Name: "Running On-Demand G and VT instances"},
+	"inf":      {ServiceCode: "ec2", Code: "L-1945791B", Name: "Running On-Demand Inf instances"},
+	"p":        {ServiceCode: "ec2", Code: "L-417A185B", Name: "Running On-Demand P instances"},
+	"x":        {ServiceCode: "ec2", Code: "L-7295265B", Name: "Running On-Demand X instances"},
+	"dl":       {ServiceCode: "ec2", Code: "L-6E869C2A", Name: "Running On-Demand DL instances"},
+	"trn":      {ServiceCode: "ec2", Code: "L-2C3B7624", Name: "Running On-Demand Trn instances"},
+	"u":        {ServiceCode: "ec2", Code: "L-43DA4232", Name: "Running On-Demand High Memory instances"},
+	"hpc":      {ServiceCode: "ec2", Code: "L-F7808C92", Name: "Running On-Demand HPC instances"},
+}
+
+// spotVCPUQuotas are the quotas of the vCPUs of the Spot Instance requests, by instance family
+var spotVCPUQuotas = map[string]Quota{
+	"standard": {ServiceCode: "ec2", Code: "L-34B43A08", Name: "All Standard (A, C, D, H, I, M, R, T, Z) Spot Instance Requests"},
+	"f":        {ServiceCode: "ec2", Code: "L-88CF9481", Name: "All F Spot Instance Requests"},
+	"g":        {ServiceCode: "ec2", Code: "L-3819A6DF", Name: "All G and VT Spot Instance Requests"},
+	"inf":      {ServiceCode: "ec2", Code: "L-B5D1601B", Name: "All Inf Spot Instance Requests"},
+	"p":        {ServiceCode: "ec2", Code: "L-7212CCBC", Name: "All P Spot Instance Requests"},
+	"x":        {ServiceCode: "ec2", Code: "L-E3A00192", Name: "All X Spot Instance Requests"},
+	"dl":       {ServiceCode: "ec2", Code: "L-85EED4F7", Name: "All DL Spot Instance Requests"},
+	"trn":      {ServiceCode: "ec2", Code: "L-6B0D517C", Name: "All Trn Spot Instance Requests"},
+}
+
+// quotaFamily returns the family of instanceType the vCPU quotas are set for, or an empty string if it has no
+// vCPU quota, e.g. for Mac instances that run on dedicated hosts
+func quotaFamily(instanceType string) string {
+	name := strings.SplitN(instanceType, ".", 2)[0]
+	family := name
+	for i, r := range name {
+		if !unicode.IsLetter(r) {
+			family = name[:i]
+			break
+		}
+	}
+	switch family {
+	case "":
+		return ""
+	case "inf", "dl", "trn", "hpc":
+		return family
+	case "vt":
+		return "g"
+	case "mac":
+		return ""
+	}
+	switch first := family[:1]; first {
+	case "f", "g", "p", "x", "u":
+		return first
+	case "a", "c", "d", "h", "i", "m", "r", "t", "z":
+		return "standard"
+	}
+	return ""
+}
+
+// VCPUQuota returns the quota of the vCPUs of instanceType, and false if it has none
+func VCPUQuota(instanceType string, spot bool) (Quota, bool) {
+	quotas := onDemandVCPUQuotas
+	if spot {
+		quotas = spotVCPUQuotas
+	}
+	quota, ok := quotas[quotaFamily(instanceType)]
+	return quota, ok
+}
+
+// InstanceRequest is a number of instances of a type to launch
+type InstanceRequest struct {
+	InstanceType string
+	Count        int
+	Spot         bool
+}
+
+// NodeGroupInstanceRequests returns the instances a nodegroup launches, of its first instance type. Spot instances
+// are requested for managed nodegroups with spot set, and for nodegroups whose instances distribution launches Spot
+// Instances above its on-demand capacity. It returns nil if the instance types are not known yet, e.g. when they
+// are selected with instanceSelector.
+func NodeGroupInstanceRequests(ng api.NodePool) []InstanceRequest {
+	return instanceRequests(ng, nodeCount(ng.BaseNodeGroup()))
+}
+
+// ScaleInstanceRequests returns the instances a nodegroup launches when it is scaled from one number of nodes to
+// another, or nil when it is scaled in
+func ScaleInstanceRequests(ng api.NodePool, from, to int) []InstanceRequest {
+	if to <= from {
+		return nil
+	}
+	var requests []InstanceRequest
+	for _, r := range instanceRequests(ng, to) {
+		for _, launched := range instanceRequests(ng, from) {
+			if launched.InstanceType == r.InstanceType && launched.Spot == r.Spot {
+				r.Count -= launched.Count
+			}
+		}
+		if r.Count > 0 {
+			requests = append(requests, r)
+		}
+	}
+	return requests
+}
+
+func instanceRequests(ng api.NodePool, count int) []InstanceRequest {
+	instanceTypes := ng.InstanceTypeList()
+	if len(instanceTypes) == 0 {
+		return nil
+	}
+	switch ng := ng.(type) {
+	case *api.ManagedNodeGroup:
+		return []InstanceRequest{{InstanceType: instanceTypes[0], Count: count, Spot: ng.Spot}}
+	case *api.NodeGroup:
+		if api.HasSpotInstances(ng) {
+			onDemand := onDemandCount(ng.InstancesDistribution, count)
+			return nonEmptyRequests(
+				InstanceRequest{InstanceType: instanceTypes[0], Count: onDemand},
+				InstanceRequest{InstanceType: instanceTypes[0], Count: count - onDemand, Spot: true},
+			)
+		}
+	}
+	return nonEmptyRequests(InstanceRequest{InstanceType: instanceTypes[0], Count: count})
+}
+
+// nodeCount returns how many nodes a nodegroup launches when it is created
+func nodeCount(ng *api.NodeGroupBase) int {
+	if ng.ScalingConfig != nil {
+		if ng.DesiredCapacity != nil {
+			return *ng.DesiredCapacity
+		}
+		if ng.MinSize != nil {
+			return *ng.MinSize
+		}
+	}
+	return api.DefaultNodeCount
+}
+
+// onDemandCount returns how many of count instances are launched on demand with the instances distribution
+func onDemandCount(distribution *api.NodeGroupInstancesDistribution, count int) int {
+	base := 0
+	if distribution.OnDemandBaseCapacity != nil {
+		base = *distribution.OnDemandBaseCapacity
+	}
+	if count <= base {
+		return count
+	}
+	percentage := 100
+	if distribution.OnDemandPercentageAboveBaseCapacity != nil {
+		percentage = *distribution.OnDemandPercentageAboveBaseCapacity
+	}
+	// the Auto Scaling group rounds the on-demand instances up
+	return base + ((count-base)*percentage+99)/100
+}
+
+func nonEmptyRequests(requests ...InstanceRequest) []InstanceRequest {
+	var nonEmpty []InstanceRequest
+	for _, r := range requests {
+		if r.Count > 0 {
+			nonEmpty = append(nonEmpty, r)
+		}
+	}
+	return nonEmpty
+}
+
+// QuotaChecker checks that the service quotas allow the resources eksctl creates
+type QuotaChecker struct {
+	ec2API    awsapi.EC2
+	quotasAPI awsapi.ServiceQuotas
+}
+
+// NewQuotaChecker returns a new QuotaChecker
+func NewQuotaChecker(ec2API awsapi.EC2, quotasAPI awsapi.ServiceQuotas) *QuotaChecker {
+	return &QuotaChecker{
+		ec2API:    ec2API,
+		quotasAPI: quotasAPI,
+	}
+}
+
+// QuotaUsage is the usage of a quota, once instances are launched
+type QuotaUsage struct {
+	Quota Quota
+	// Requested is the number of vCPUs of the instances to launch
+	Requested int
+	// InUse is the number of vCPUs of the running instances
+	InUse int
+	Value int
+}
+
+// Exceeded returns true if launching the instances exceeds the quota
+func (u QuotaUsage) Exceeded() bool {
+	return u.InUse+u.Requested > u.Value
+}
+
+// Check returns the check of the usage
+func (u QuotaUsage) Check() Check {
+	if u.Exceeded() {
+		return fail(KindQuota, "%s: %d vCPUs are needed and %d of %d are in use, request an increase of quota %s of service %s",
+			u.Quota.Name, u.Requested, u.InUse, u.Value, u.Quota.Code, u.Quota.ServiceCode)
+	}
+	return pass(KindQuota, "%s: %d vCPUs are needed and %d of %d are in use", u.Quota.Name, u.Requested, u.InUse, u.Value)
+}
+
+// VCPUUsage returns the usage of the vCPU quotas of the requested instances, sorted by quota code. Instance types
+// without a vCPU quota are ignored.
+func (q *QuotaChecker) VCPUUsage(ctx context.Context, requests []InstanceRequest) ([]QuotaUsage, error) {
+	usage := map[Quota]*QuotaUsage{}
+	instanceTypes := map[string]struct{}{}
+	for _, r := range requests {
+		if quota, ok := VCPUQuota(r.InstanceType, r.Spot); ok {
+			usage[quota] = &QuotaUsage{Quota: quota}
+			instanceTypes[r.InstanceType] = struct{}{}
+		}
+	}
+	if len(usage) == 0 {
+		return nil, nil
+	}
+
+	vCPUs, err := q.describeVCPUs(ctx, instanceTypes)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range requests {
+		if quota, ok := VCPUQuota(r.InstanceType, r.Spot); ok {
+			instanceVCPUs, ok := vCPUs[r.InstanceType]
+			if !ok {
+				return nil, fmt.Errorf("instance type %q does not exist", r.InstanceType)
+			}
+			usage[quota].Requested += r.Count * instanceVCPUs
+		}
+	}
+
+	paginator := ec2.NewDescribeInstancesPaginator(q.ec2API, &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: []string{string(ec2types.InstanceStateNamePending), string(ec2types.InstanceStateNameRunning)},
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing running instances: %w", err)
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				quota, ok := VCPUQuota(string(instance.InstanceType), instance.InstanceLifecycle == ec2types.InstanceLifecycleTypeSpot)
+				if !ok || usage[quota] == nil {
+					continue
+				}
+				if cpu := instance.CpuOptions; cpu != nil && cpu.CoreCount != nil && cpu.ThreadsPerCore != nil {
+					usage[quota].InUse += int(*cpu.CoreCount * *cpu.ThreadsPerCore)
+				}
+			}
+		}
+	}
+
+	var sorted []QuotaUsage
+	for quota, u := range usage {
+		value, err := q.quotaValue(ctx, quota)
+		if err != nil {
+			return nil, err
+		}
+		u.Value = value
+		sorted = append(sorted, *u)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Quota.Code < sorted[j].Quota.Code
+	})
+	return sorted, nil
+}
+
+func (q *QuotaChecker) describeVCPUs(ctx context.Context, instanceTypes map[string]struct{}) (map[string]int, error) {
+	input := &ec2.DescribeInstanceTypesInput{}
+	for instanceType := range instanceTypes {
+		input.InstanceTypes = append(input.InstanceTypes, ec2types.InstanceType(instanceType))
+	}
+	sort.Slice(input.InstanceTypes, func(i, j int) bool {
+		return input.InstanceTypes[i] < input.InstanceTypes[j]
+	})
+	vCPUs := map[string]int{}
+	paginator := ec2.NewDescribeInstanceTypesPaginator(q.ec2API, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("describing instance types: %w", err)
+		}
+		for _, instanceType := range output.InstanceTypes {
+			if instanceType.VCpuInfo != nil && instanceType.VCpuInfo.DefaultVCpus != nil {
+				vCPUs[string(instanceType.InstanceType)] = int(*instanceType.VCpuInfo.DefaultVCpus)
+			}
+		}
+	}
+	return vCPUs, nil
+}
+
+// quotaValue returns the value of quota in the account, or its default value if the account has not
+// requested an increase of the quota
+func (q *QuotaChecker) quotaValue(ctx context.Context, quota Quota) (int, error) {
+	output, err := q.quotasAPI.GetServiceQuota(ctx, &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(quota.ServiceCode),
+		QuotaCode:   aws.String(quota.Code),
+	})
+	if err == nil && output.Quota != nil && output.Quota.Value != nil {
+		return int(*output.Quota.Value), nil
+	}
+	defaultOutput, defaultErr := q.quotasAPI.GetAWSDefaultServiceQuota(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(quota.ServiceCode),
+		QuotaCode:   aws.String(quota.Code),
+	})
+	if defaultErr != nil {
+		return 0, fmt.Errorf("getting the value of quota %s (%s): %w", quota.Code, quota.Name, errors.Join(err, defaultErr))
+	}
+	if defaultOutput.Quota == nil || defaultOutput.Quota.Value == nil {
+		return 0, fmt.Errorf("quota %s (%s) has no value", quota.Code, quota.Name)
+	}
+	return int(*defaultOutput.Quota.Value), nil
+}
+
+// CheckVCPUs checks the vCPU quotas of the requested instances
+func (q *QuotaChecker) CheckVCPUs(ctx context.Context, requests []InstanceRequest) []Check {
+	usage, err := q.VCPUUsage(ctx, requests)
+	if err != nil {
+		return []Check{warn(KindQuota, "unable to check the vCPU quotas: %v", err)}
+	}
+	var checks []Check
+	for _, u := range usage {
+		checks = append(checks, u.Check())
+	}
+	return checks
+}
+
+// QuotaCheckMode is what to do when the vCPU quotas do not allow the instances of nodegroups to be launched
+type QuotaCheckMode string
+
+const (
+	// QuotaCheckFail fails before any stack is created or any nodegroup is scaled
+	QuotaCheckFail QuotaCheckMode = "fail"
+	// QuotaCheckWarn logs a warning and proceeds
+	QuotaCheckWarn QuotaCheckMode = "warn"
+	// QuotaCheckSkip does not check the quotas
+	QuotaCheckSkip QuotaCheckMode = "skip"
+)
+
+// ValidateQuotaCheckMode validates the value of --quota-check
+func ValidateQuotaCheckMode(mode QuotaCheckMode) error {
+	switch mode {
+	case QuotaCheckFail, QuotaCheckWarn, QuotaCheckSkip:
+		return nil
+	}
+	return fmt.Errorf("invalid value %q for --quota-check, valid values are %q, %q and %q", mode, QuotaCheckFail, QuotaCheckWarn, QuotaCheckSkip)
+}
+
+// EnforceVCPUQuotas checks the vCPU quotas of the requested instances, and returns an error if any of them is
+// exceeded with QuotaCheckFail. An empty mode does not check the quotas. A check that cannot be completed, e.g. when
+// the caller is not allowed to get the quotas, only logs a warning.
+func (q *QuotaChecker) EnforceVCPUQuotas(ctx context.Context, requests []InstanceRequest, mode QuotaCheckMode) error {
+	if mode == "" || mode == QuotaCheckSkip || len(requests) == 0 {
+		return nil
+	}
+	var exceeded []string
+	for _, check := range q.CheckVCPUs(ctx, requests) {
+		switch {
+		case check.Status == StatusPass:
+			logger.Debug("%s", check.Message)
+		case check.Status == StatusFail && mode == QuotaCheckFail:
+			exceeded = append(exceeded, check.Message)
+		default:
+			logger.Warning("%s", check.Message)
+		}
+	}
+	if len(exceeded) > 0 {
+		return fmt.Errorf("the vCPU quotas do not allow the instances to be launched, rerun with --quota-check=warn to proceed anyway: %s", strings.Join(exceeded, "; "))
+	}
+	return nil
+}
+
+// CheckVPCs checks that count VPCs can be created
+func (q *QuotaChecker) CheckVPCs(ctx context.Context, count int) Check {
+	inUse := 0
+	paginator := ec2.NewDescribeVpcsPaginator(q.ec2API, &ec2.DescribeVpcsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return warn(KindQuota, "unable to check quota %s (%s): describing VPCs: %v", VPCsQuota.Code, VPCsQuota.Name, err)
+		}
+		inUse += len(output.Vpcs)
+	}
+	return q.checkCount(ctx, VPCsQuota, count, inUse)
+}
+
+// CheckElasticIPs checks that count Elastic IP addresses can be allocated
+func (q *QuotaChecker) CheckElasticIPs(ctx context.Context, count int) Check {
+	output, err := q.ec2API.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return warn(KindQuota, "unable to check quota %s (%s): describing Elastic IP addresses: %v", ElasticIPsQuota.Code, ElasticIPsQuota.Name, err)
+	}
+	return q.checkCount(ctx, ElasticIPsQuota, count, len(output.Addresses))
+}
+
+func (q *QuotaChecker) checkCount(ctx context.Context, quota Quota, count, inUse int) Check {
+	value, err := q.quotaValue(ctx, quota)
+	if err != nil {
+		return warn(KindQuota, "unable to check quota %s (%s): %v", quota.Code, quota.Name, err)
+	}
+	if inUse+count > value {
+		return fail(KindQuota, "%s: %d are needed and %d of %d are in use, request an increase of quota %s of service %s",
+			quota.Name, count, inUse, value, quota.Code, quota.ServiceCode)
+	}
+	return pass(KindQuota, "%s: %d are needed and %d of %d are in use", quota.Name, count, inUse, value)
+}
+
+// checkQuotas checks the quotas of the VPC, its NAT gateways and the instances of the nodegroups
+func (c *Checker) checkQuotas(ctx context.Context) []Check {
+	q := NewQuotaChecker(c.provider.EC2(), c.provider.ServiceQuotas())
+
+	var requests []InstanceRequest
+	for _, ng := range c.cfg.NodeGroups {
+		requests = append(requests, NodeGroupInstanceRequests(ng)...)
+	}
+	for _, ng := range c.cfg.ManagedNodeGroups {
+		requests = append(requests, NodeGroupInstanceRequests(ng)...)
+	}
+	checks := q.CheckVCPUs(ctx, requests)
+
+	if CreatesVPC(c.cfg) {
+		checks = append(checks, q.CheckVPCs(ctx, 1))
+		if elasticIPs := NATGateways(c.cfg); elasticIPs > 0 {
+			checks = append(checks, q.CheckElasticIPs(ctx, elasticIPs))
+		}
+	}
+	return checks
+}
+
+// CreatesVPC returns whether eksctl creates the VPC of the cluster
+func CreatesVPC(cfg *api.ClusterConfig) bool {
+	return cfg.VPC.ID == "" && (!cfg.HasAnySubnets() || cfg.HasSubnetCIDRPlan())
+}
+
+// NATGateways returns the number of NAT gateways of a new VPC, each of which has an Elastic IP address
+func NATGateways(cfg *api.ClusterConfig) int {
+	gateway := api.ClusterNATDefault
+	if cfg.VPC.NAT != nil && cfg.VPC.NAT.Gateway != nil {
+		gateway = *cfg.VPC.NAT.Gateway
+	}
+	switch gateway {
+	case api.ClusterHighlyAvailableNAT:
+		// a NAT gateway per public subnet
+		if cfg.HasSubnetCIDRPlan() {
+			return len(cfg.VPC.Subnets.Public)
+		}
+		if len(cfg.AvailabilityZones) > 0 {
+			return len(cfg.AvailabilityZones)
+		}
+		return api.RecommendedAvailabilityZones
+	case api.ClusterDisableNAT:
+		return 0
+	default:
+		return 1
+	}
+}
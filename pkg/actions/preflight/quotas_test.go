@@ -0,0 +1,191 @@
+package preflight_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+)
+
+var _ = Describe("Quotas", func() {
+	DescribeTable("the family of the vCPU quotas of an instance type",
+		func(instanceType, family string) {
+			Expect(preflight.QuotaFamily(instanceType)).To(Equal(family))
+		},
+		Entry("general purpose", "m5.large", "standard"),
+		Entry("burstable", "t3a.medium", "standard"),
+		Entry("compute optimized", "c7gn.xlarge", "standard"),
+		Entry("GPU", "g5.2xlarge", "g"),
+		Entry("video transcoding", "vt1.3xlarge", "g"),
+		Entry("Inferentia", "inf2.xlarge", "inf"),
+		Entry("Trainium", "trn1.32xlarge", "trn"),
+		Entry("high memory", "u-6tb1.metal", "u"),
+		Entry("Mac", "mac2.metal", ""),
+	)
+
+	Describe("NodeGroupInstanceRequests", func() {
+		It("requests the desired capacity of the first instance type", func() {
+			ng := api.NewManagedNodeGroup()
+			ng.InstanceTypes = []string{"m5.large", "m5a.large"}
+			ng.DesiredCapacity = aws.Int(3)
+			ng.Spot = true
+			Expect(preflight.NodeGroupInstanceRequests(ng)).To(Equal([]preflight.InstanceRequest{
+				{InstanceType: "m5.large", Count: 3, Spot: true},
+			}))
+		})
+
+		It("splits the instances of a nodegroup between on-demand and spot", func() {
+			ng := api.NewNodeGroup()
+			ng.MinSize = aws.Int(4)
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+				InstanceTypes:                       []string{"c5.xlarge", "c5a.xlarge"},
+				OnDemandBaseCapacity:                aws.Int(1),
+				OnDemandPercentageAboveBaseCapacity: aws.Int(0),
+			}
+			Expect(preflight.NodeGroupInstanceRequests(ng)).To(Equal([]preflight.InstanceRequest{
+				{InstanceType: "c5.xlarge", Count: 1},
+				{InstanceType: "c5.xlarge", Count: 3, Spot: true},
+			}))
+		})
+
+		It("requests the instances added by scaling out a nodegroup", func() {
+			ng := api.NewNodeGroup()
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+				InstanceTypes:                       []string{"m5.large"},
+				OnDemandBaseCapacity:                aws.Int(2),
+				OnDemandPercentageAboveBaseCapacity: aws.Int(50),
+			}
+			Expect(preflight.ScaleInstanceRequests(ng, 1, 6)).To(Equal([]preflight.InstanceRequest{
+				{InstanceType: "m5.large", Count: 3},
+				{InstanceType: "m5.large", Count: 2, Spot: true},
+			}))
+			Expect(preflight.ScaleInstanceRequests(ng, 6, 4)).To(BeEmpty())
+		})
+
+		It("requests no instances when the instance types are not known", func() {
+			ng := api.NewManagedNodeGroup()
+			Expect(preflight.NodeGroupInstanceRequests(ng)).To(BeEmpty())
+		})
+	})
+
+	Describe("CheckVCPUs", func() {
+		var (
+			ec2API    *mocksv2.EC2
+			quotasAPI *mocksv2.ServiceQuotas
+			checker   *preflight.QuotaChecker
+		)
+
+		BeforeEach(func() {
+			ec2API = &mocksv2.EC2{}
+			quotasAPI = &mocksv2.ServiceQuotas{}
+			checker = preflight.NewQuotaChecker(ec2API, quotasAPI)
+
+			ec2API.On("DescribeInstanceTypes", mock.Anything, &ec2.DescribeInstanceTypesInput{
+				InstanceTypes: []ec2types.InstanceType{"m5.xlarge"},
+			}, mock.Anything).Return(&ec2.DescribeInstanceTypesOutput{
+				InstanceTypes: []ec2types.InstanceTypeInfo{
+					{InstanceType: "m5.xlarge", VCpuInfo: &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(4)}},
+				},
+			}, nil)
+			ec2API.On("DescribeInstances", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstancesOutput{
+				Reservations: []ec2types.Reservation{
+					{
+						Instances: []ec2types.Instance{
+							{InstanceType: "m5.2xlarge", CpuOptions: &ec2types.CpuOptions{CoreCount: aws.Int32(4), ThreadsPerCore: aws.Int32(2)}},
+							{InstanceType: "p3.2xlarge", CpuOptions: &ec2types.CpuOptions{CoreCount: aws.Int32(4), ThreadsPerCore: aws.Int32(2)}},
+							{
+								InstanceType:      "m5.large",
+								InstanceLifecycle: ec2types.InstanceLifecycleTypeSpot,
+								CpuOptions:        &ec2types.CpuOptions{CoreCount: aws.Int32(1), ThreadsPerCore: aws.Int32(2)},
+							},
+						},
+					},
+				},
+			}, nil)
+		})
+
+		mockQuotaValue := func(value float64) {
+			quotasAPI.On("GetServiceQuota", mock.Anything, &servicequotas.GetServiceQuotaInput{
+				ServiceCode: aws.String("ec2"),
+				QuotaCode:   aws.String("L-1216C47A"),
+			}).Return(&servicequotas.GetServiceQuotaOutput{
+				Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(value)},
+			}, nil)
+		}
+
+		It("passes when the quota allows the instances", func() {
+			mockQuotaValue(32)
+			checks := checker.CheckVCPUs(context.Background(), []preflight.InstanceRequest{{InstanceType: "m5.xlarge", Count: 3}})
+			Expect(checks).To(ConsistOf(preflight.Check{
+				Kind:    preflight.KindQuota,
+				Status:  preflight.StatusPass,
+				Message: "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances: 12 vCPUs are needed and 8 of 32 are in use",
+			}))
+		})
+
+		It("fails with the code of the quota when the instances exceed it", func() {
+			mockQuotaValue(16)
+			checks := checker.CheckVCPUs(context.Background(), []preflight.InstanceRequest{{InstanceType: "m5.xlarge", Count: 3}})
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Status).To(Equal(preflight.StatusFail))
+			Expect(checks[0].Message).To(HaveSuffix("12 vCPUs are needed and 8 of 16 are in use, request an increase of quota L-1216C47A of service ec2"))
+		})
+
+		It("uses the default value of a quota the account has not increased", func() {
+			quotasAPI.On("GetServiceQuota", mock.Anything, mock.Anything).Return(nil, errors.New("NoSuchResourceException"))
+			quotasAPI.On("GetAWSDefaultServiceQuota", mock.Anything, &servicequotas.GetAWSDefaultServiceQuotaInput{
+				ServiceCode: aws.String("ec2"),
+				QuotaCode:   aws.String("L-34B43A08"),
+			}).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+				Quota: &servicequotastypes.ServiceQuota{Value: aws.Float64(5)},
+			}, nil)
+
+			usage, err := checker.VCPUUsage(context.Background(), []preflight.InstanceRequest{{InstanceType: "m5.xlarge", Count: 1, Spot: true}})
+			Expect(err).NotTo(HaveOccurred())
+			quota, ok := preflight.VCPUQuota("m5.xlarge", true)
+			Expect(ok).To(BeTrue())
+			Expect(quota.Code).To(Equal("L-34B43A08"))
+			Expect(usage).To(ConsistOf(preflight.QuotaUsage{
+				Quota:     quota,
+				Requested: 4,
+				InUse:     2,
+				Value:     5,
+			}))
+			Expect(usage[0].Exceeded()).To(BeTrue())
+		})
+
+		DescribeTable("enforcing the quotas",
+			func(mode preflight.QuotaCheckMode, quotaValue float64, expectedErr string) {
+				mockQuotaValue(quotaValue)
+				err := checker.EnforceVCPUQuotas(context.Background(), []preflight.InstanceRequest{{InstanceType: "m5.xlarge", Count: 3}}, mode)
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+				}
+			},
+			Entry("fails when a quota is exceeded", preflight.QuotaCheckFail, 16.0, "rerun with --quota-check=warn to proceed anyway: Running On-Demand Standard"),
+			Entry("passes when the quotas allow the instances", preflight.QuotaCheckFail, 20.0, ""),
+			Entry("only warns when a quota is exceeded", preflight.QuotaCheckWarn, 16.0, ""),
+		)
+
+		It("does not call any API when the quotas are skipped", func() {
+			checker = preflight.NewQuotaChecker(&mocksv2.EC2{}, &mocksv2.ServiceQuotas{})
+			for _, mode := range []preflight.QuotaCheckMode{preflight.QuotaCheckSkip, ""} {
+				Expect(checker.EnforceVCPUQuotas(context.Background(), []preflight.InstanceRequest{{InstanceType: "m5.xlarge", Count: 3}}, mode)).To(Succeed())
+			}
+		})
+	})
+})
@@ -0,0 +1,208 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	utilstrings "github.com/weaveworks/eksctl/pkg/utils/strings"
+)
+
+// SpotPlacementMode is what to do with the Spot placement scores of the availability zones of Spot nodegroups
+type SpotPlacementMode string
+
+const (
+	// SpotPlacementRecommend logs the scores and recommends the availability zones with the highest score
+	SpotPlacementRecommend SpotPlacementMode = "recommend"
+	// SpotPlacementAuto limits the nodegroups that set neither availability zones nor subnets to the
+	// availability zones with the highest score
+	SpotPlacementAuto SpotPlacementMode = "auto"
+	// SpotPlacementSkip does not get the scores
+	SpotPlacementSkip SpotPlacementMode = "skip"
+)
+
+// ValidateSpotPlacementMode returns an error if mode is not a valid SpotPlacementMode
+func ValidateSpotPlacementMode(mode SpotPlacementMode) error {
+	switch mode {
+	case SpotPlacementRecommend, SpotPlacementAuto, SpotPlacementSkip:
+		return nil
+	default:
+		return fmt.Errorf("invalid value %q for --spot-placement, valid values are %q, %q and %q", mode, SpotPlacementRecommend, SpotPlacementAuto, SpotPlacementSkip)
+	}
+}
+
+// ZoneScore is the Spot placement score of an availability zone, from 1 to 10. A score of 10 means a Spot request
+// is highly likely to succeed in the zone.
+type ZoneScore struct {
+	Zone  string
+	Score int
+}
+
+// SpotPlacementScorer gets the Spot placement scores of the availability zones of Spot nodegroups
+type SpotPlacementScorer struct {
+	ec2API awsapi.EC2
+	region string
+}
+
+// NewSpotPlacementScorer returns a SpotPlacementScorer of the availability zones of region
+func NewSpotPlacementScorer(ec2API awsapi.EC2, region string) *SpotPlacementScorer {
+	return &SpotPlacementScorer{
+		ec2API: ec2API,
+		region: region,
+	}
+}
+
+// ZoneScores returns the scores of launching count Spot Instances of instanceTypes in a single availability zone,
+// for each of zones, sorted from the highest score
+func (s *SpotPlacementScorer) ZoneScores(ctx context.Context, instanceTypes []string, count int, zones []string) ([]ZoneScore, error) {
+	output, err := s.ec2API.GetSpotPlacementScores(ctx, &ec2.GetSpotPlacementScoresInput{
+		InstanceTypes:          instanceTypes,
+		TargetCapacity:         aws.Int32(int32(count)),
+		RegionNames:            []string{s.region},
+		SingleAvailabilityZone: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting Spot placement scores: %w", err)
+	}
+
+	// the scores are returned by availability zone ID, which differs between accounts
+	var zoneIDs []string
+	for _, score := range output.SpotPlacementScores {
+		zoneIDs = append(zoneIDs, aws.ToString(score.AvailabilityZoneId))
+	}
+	if len(zoneIDs) == 0 {
+		return nil, nil
+	}
+	zonesOutput, err := s.ec2API.DescribeAvailabilityZones(ctx, &ec2.DescribeAvailabilityZonesInput{
+		ZoneIds: zoneIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing availability zones: %w", err)
+	}
+	zoneNames := map[string]string{}
+	for _, z := range zonesOutput.AvailabilityZones {
+		zoneNames[aws.ToString(z.ZoneId)] = aws.ToString(z.ZoneName)
+	}
+
+	var scores []ZoneScore
+	for _, score := range output.SpotPlacementScores {
+		zone, ok := zoneNames[aws.ToString(score.AvailabilityZoneId)]
+		if !ok || (len(zones) > 0 && !utilstrings.Contains(zones, zone)) {
+			continue
+		}
+		scores = append(scores, ZoneScore{Zone: zone, Score: int(aws.ToInt32(score.Score))})
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].Zone < scores[j].Zone
+	})
+	return scores, nil
+}
+
+// ApplySpotPlacementScores logs the Spot placement scores of the availability zones of the Spot nodegroups and, with
+// SpotPlacementAuto, limits the nodegroups that set neither availability zones nor subnets to the zones with the
+// highest score. It only warns when the scores cannot be obtained.
+func (s *SpotPlacementScorer) ApplySpotPlacementScores(ctx context.Context, cfg *api.ClusterConfig, nodePools []api.NodePool, mode SpotPlacementMode) {
+	if mode == "" || mode == SpotPlacementSkip {
+		return
+	}
+	for _, np := range nodePools {
+		ng := np.BaseNodeGroup()
+		if ng.OutpostARN != "" {
+			continue
+		}
+		if ng, ok := np.(*api.NodeGroup); ok && len(ng.LocalZones) > 0 {
+			continue
+		}
+		spotCount := 0
+		for _, r := range NodeGroupInstanceRequests(np) {
+			if r.Spot {
+				spotCount += r.Count
+			}
+		}
+		if spotCount == 0 {
+			continue
+		}
+
+		scores, err := s.ZoneScores(ctx, np.InstanceTypeList(), spotCount, nodeGroupZones(cfg, ng))
+		if err != nil {
+			logger.Warning("unable to get the Spot placement scores of nodegroup %q: %v", ng.Name, err)
+			continue
+		}
+		if len(scores) == 0 {
+			continue
+		}
+		logger.Info("Spot placement scores of nodegroup %q (1 to 10, higher is more likely to succeed): %s", ng.Name, formatZoneScores(scores))
+
+		best := bestZones(scores)
+		if len(best) == len(scores) {
+			continue
+		}
+		canPick := len(ng.AvailabilityZones) == 0 && len(ng.Subnets) == 0
+		if mode == SpotPlacementAuto && canPick {
+			ng.AvailabilityZones = best
+			logger.Info("limiting nodegroup %q to availability zones %s, which have the highest Spot placement score", ng.Name, strings.Join(best, ", "))
+			continue
+		}
+		if canPick {
+			logger.Info("set availabilityZones of nodegroup %q to %s, or rerun with --spot-placement=auto, to reduce Spot interruptions", ng.Name, strings.Join(best, ", "))
+		} else {
+			logger.Info("availability zones %s have the highest Spot placement score for nodegroup %q", strings.Join(best, ", "), ng.Name)
+		}
+	}
+}
+
+// nodeGroupZones returns the availability zones a nodegroup can launch instances in, or nil when they are not known
+func nodeGroupZones(cfg *api.ClusterConfig, ng *api.NodeGroupBase) []string {
+	if len(ng.AvailabilityZones) > 0 {
+		return ng.AvailabilityZones
+	}
+	if cfg.VPC != nil && cfg.VPC.Subnets != nil {
+		subnets := cfg.VPC.Subnets.Public
+		if ng.PrivateNetworking {
+			subnets = cfg.VPC.Subnets.Private
+		}
+		var zones []string
+		for key, subnet := range subnets {
+			zone := subnet.AZ
+			if zone == "" {
+				zone = key
+			}
+			if !utilstrings.Contains(zones, zone) {
+				zones = append(zones, zone)
+			}
+		}
+		if len(zones) > 0 {
+			return zones
+		}
+	}
+	return cfg.AvailabilityZones
+}
+
+// bestZones returns the zones with the highest score of scores, sorted from the highest score
+func bestZones(scores []ZoneScore) []string {
+	var zones []string
+	for _, s := range scores {
+		if s.Score == scores[0].Score {
+			zones = append(zones, s.Zone)
+		}
+	}
+	return zones
+}
+
+func formatZoneScores(scores []ZoneScore) string {
+	var parts []string
+	for _, s := range scores {
+		parts = append(parts, fmt.Sprintf("%s=%d", s.Zone, s.Score))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,110 @@
+package preflight_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+)
+
+var _ = Describe("Spot placement scores", func() {
+	var (
+		ec2API *mocksv2.EC2
+		scorer *preflight.SpotPlacementScorer
+		cfg    *api.ClusterConfig
+		ng     *api.ManagedNodeGroup
+	)
+
+	BeforeEach(func() {
+		ec2API = &mocksv2.EC2{}
+		scorer = preflight.NewSpotPlacementScorer(ec2API, "us-west-2")
+
+		cfg = api.NewClusterConfig()
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b", "us-west-2c"}
+		ng = api.NewManagedNodeGroup()
+		ng.Name = "spot"
+		ng.Spot = true
+		ng.InstanceTypes = []string{"m5.large", "m5a.large"}
+		ng.DesiredCapacity = aws.Int(4)
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+	})
+
+	mockScores := func() {
+		ec2API.On("GetSpotPlacementScores", mock.Anything, &ec2.GetSpotPlacementScoresInput{
+			InstanceTypes:          []string{"m5.large", "m5a.large"},
+			TargetCapacity:         aws.Int32(4),
+			RegionNames:            []string{"us-west-2"},
+			SingleAvailabilityZone: aws.Bool(true),
+		}).Return(&ec2.GetSpotPlacementScoresOutput{
+			SpotPlacementScores: []ec2types.SpotPlacementScore{
+				{AvailabilityZoneId: aws.String("usw2-az1"), Score: aws.Int32(3)},
+				{AvailabilityZoneId: aws.String("usw2-az2"), Score: aws.Int32(9)},
+				{AvailabilityZoneId: aws.String("usw2-az3"), Score: aws.Int32(9)},
+				{AvailabilityZoneId: aws.String("usw2-az4"), Score: aws.Int32(10)},
+			},
+		}, nil)
+		ec2API.On("DescribeAvailabilityZones", mock.Anything, mock.Anything).Return(&ec2.DescribeAvailabilityZonesOutput{
+			AvailabilityZones: []ec2types.AvailabilityZone{
+				{ZoneId: aws.String("usw2-az1"), ZoneName: aws.String("us-west-2b")},
+				{ZoneId: aws.String("usw2-az2"), ZoneName: aws.String("us-west-2c")},
+				{ZoneId: aws.String("usw2-az3"), ZoneName: aws.String("us-west-2a")},
+				{ZoneId: aws.String("usw2-az4"), ZoneName: aws.String("us-west-2d")},
+			},
+		}, nil)
+	}
+
+	It("returns the scores of the zones by name, from the highest score", func() {
+		mockScores()
+		scores, err := scorer.ZoneScores(context.Background(), ng.InstanceTypes, 4, cfg.AvailabilityZones)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(scores).To(Equal([]preflight.ZoneScore{
+			{Zone: "us-west-2a", Score: 9},
+			{Zone: "us-west-2c", Score: 9},
+			{Zone: "us-west-2b", Score: 3},
+		}))
+	})
+
+	It("limits the nodegroup to the zones with the highest score with auto", func() {
+		mockScores()
+		scorer.ApplySpotPlacementScores(context.Background(), cfg, []api.NodePool{ng}, preflight.SpotPlacementAuto)
+		Expect(ng.AvailabilityZones).To(Equal([]string{"us-west-2a", "us-west-2c"}))
+	})
+
+	It("does not change the nodegroup with recommend", func() {
+		mockScores()
+		scorer.ApplySpotPlacementScores(context.Background(), cfg, []api.NodePool{ng}, preflight.SpotPlacementRecommend)
+		Expect(ng.AvailabilityZones).To(BeEmpty())
+		ec2API.AssertCalled(GinkgoT(), "GetSpotPlacementScores", mock.Anything, mock.Anything)
+	})
+
+	It("does not change a nodegroup that sets its subnets", func() {
+		mockScores()
+		ng.Subnets = []string{"subnet-1234"}
+		scorer.ApplySpotPlacementScores(context.Background(), cfg, []api.NodePool{ng}, preflight.SpotPlacementAuto)
+		Expect(ng.AvailabilityZones).To(BeEmpty())
+	})
+
+	It("only warns when the scores cannot be obtained", func() {
+		ec2API.On("GetSpotPlacementScores", mock.Anything, mock.Anything).Return(nil, errors.New("UnauthorizedOperation"))
+		scorer.ApplySpotPlacementScores(context.Background(), cfg, []api.NodePool{ng}, preflight.SpotPlacementAuto)
+		Expect(ng.AvailabilityZones).To(BeEmpty())
+	})
+
+	It("does not get the scores of on-demand nodegroups or with skip", func() {
+		onDemand := api.NewManagedNodeGroup()
+		onDemand.InstanceTypes = []string{"m5.large"}
+		scorer.ApplySpotPlacementScores(context.Background(), cfg, []api.NodePool{onDemand}, preflight.SpotPlacementAuto)
+		scorer.ApplySpotPlacementScores(context.Background(), cfg, []api.NodePool{ng}, preflight.SpotPlacementSkip)
+		ec2API.AssertNotCalled(GinkgoT(), "GetSpotPlacementScores", mock.Anything, mock.Anything)
+	})
+})
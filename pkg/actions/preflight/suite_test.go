@@ -0,0 +1,11 @@
+package preflight_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestPreflight(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
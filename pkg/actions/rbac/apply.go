@@ -0,0 +1,206 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/strings"
+)
+
+const (
+	managedByLabelKey   = "app.kubernetes.io/managed-by"
+	managedByLabelValue = "eksctl"
+)
+
+// Applier creates the cluster roles and bindings of the rbac section, or updates them when they exist
+type Applier struct {
+	clientSet kubernetes.Interface
+}
+
+// NewApplier creates a new Applier
+func NewApplier(clientSet kubernetes.Interface) *Applier {
+	return &Applier{
+		clientSet: clientSet,
+	}
+}
+
+// Apply creates or updates the cluster roles, then the bindings, of rbac
+func (a *Applier) Apply(ctx context.Context, rbac *api.RBAC) error {
+	for _, clusterRole := range rbac.ClusterRoles {
+		if err := a.applyClusterRole(ctx, clusterRole); err != nil {
+			return fmt.Errorf("applying cluster role %q: %w", clusterRole.Name, err)
+		}
+		logger.Info("applied cluster role %q", clusterRole.Name)
+	}
+
+	for _, binding := range rbac.Bindings {
+		if err := a.applyBinding(ctx, binding); err != nil {
+			return fmt.Errorf("applying binding %q: %w", binding.Name, err)
+		}
+		logger.Info("bound cluster role %q to group %q", binding.ClusterRole, binding.SubjectGroup())
+	}
+	return nil
+}
+
+func (a *Applier) applyClusterRole(ctx context.Context, clusterRole *api.RBACClusterRole) error {
+	desired := &rbacv1.ClusterRole{
+		ObjectMeta: newObjectMeta(clusterRole.Name, ""),
+	}
+	for _, rule := range clusterRole.Rules {
+		desired.Rules = append(desired.Rules, rbacv1.PolicyRule{
+			APIGroups:       rule.APIGroups,
+			Resources:       rule.Resources,
+			ResourceNames:   rule.ResourceNames,
+			NonResourceURLs: rule.NonResourceURLs,
+			Verbs:           rule.Verbs,
+		})
+	}
+
+	clusterRoles := a.clientSet.RbacV1().ClusterRoles()
+	existing, err := clusterRoles.Get(ctx, clusterRole.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = clusterRoles.Create(ctx, desired, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	existing.Labels = mergeLabels(existing.Labels, desired.Labels)
+	existing.Rules = desired.Rules
+	_, err = clusterRoles.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+func (a *Applier) applyBinding(ctx context.Context, binding *api.RBACBinding) error {
+	roleRef := rbacv1.RoleRef{
+		APIGroup: rbacv1.GroupName,
+		Kind:     "ClusterRole",
+		Name:     binding.ClusterRole,
+	}
+	subjects := []rbacv1.Subject{
+		{
+			APIGroup: rbacv1.GroupName,
+			Kind:     rbacv1.GroupKind,
+			Name:     binding.SubjectGroup(),
+		},
+	}
+
+	if len(binding.Namespaces) == 0 {
+		return a.applyClusterRoleBinding(ctx, &rbacv1.ClusterRoleBinding{
+			ObjectMeta: newObjectMeta(binding.Name, ""),
+			RoleRef:    roleRef,
+			Subjects:   subjects,
+		})
+	}
+	for _, namespace := range binding.Namespaces {
+		// a role binding cannot be created in a namespace that does not exist, e.g. in a cluster that is being created
+		if err := kubewrapper.MaybeCreateNamespace(a.clientSet, namespace); err != nil {
+			return fmt.Errorf("creating namespace %q: %w", namespace, err)
+		}
+		if err := a.applyRoleBinding(ctx, &rbacv1.RoleBinding{
+			ObjectMeta: newObjectMeta(binding.Name, namespace),
+			RoleRef:    roleRef,
+			Subjects:   subjects,
+		}); err != nil {
+			return fmt.Errorf("in namespace %q: %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+func (a *Applier) applyClusterRoleBinding(ctx context.Context, desired *rbacv1.ClusterRoleBinding) error {
+	bindings := a.clientSet.RbacV1().ClusterRoleBindings()
+	existing, err := bindings.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = bindings.Create(ctx, desired, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	// the role of a binding cannot be changed, the binding is replaced instead
+	if existing.RoleRef != desired.RoleRef {
+		if err := bindings.Delete(ctx, desired.Name, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		_, err = bindings.Create(ctx, desired, metav1.CreateOptions{})
+		return err
+	}
+	existing.Labels = mergeLabels(existing.Labels, desired.Labels)
+	existing.Subjects = desired.Subjects
+	_, err = bindings.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+func (a *Applier) applyRoleBinding(ctx context.Context, desired *rbacv1.RoleBinding) error {
+	bindings := a.clientSet.RbacV1().RoleBindings(desired.Namespace)
+	existing, err := bindings.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = bindings.Create(ctx, desired, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if existing.RoleRef != desired.RoleRef {
+		if err := bindings.Delete(ctx, desired.Name, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		_, err = bindings.Create(ctx, desired, metav1.CreateOptions{})
+		return err
+	}
+	existing.Labels = mergeLabels(existing.Labels, desired.Labels)
+	existing.Subjects = desired.Subjects
+	_, err = bindings.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// IdentityMappings returns the aws-auth mappings of the IAM principals of the bindings, one per
+// principal with the groups of all the bindings it is in, as a later mapping of an ARN shadows the
+// earlier ones
+func IdentityMappings(rbac *api.RBAC) []*api.IAMIdentityMapping {
+	var mappings []*api.IAMIdentityMapping
+	byARN := map[string]*api.IAMIdentityMapping{}
+	for _, binding := range rbac.Bindings {
+		for _, principal := range binding.IAMPrincipals {
+			mapping, ok := byARN[principal]
+			if !ok {
+				mapping = &api.IAMIdentityMapping{ARN: principal}
+				byARN[principal] = mapping
+				mappings = append(mappings, mapping)
+			}
+			if !strings.Contains(mapping.Groups, binding.SubjectGroup()) {
+				mapping.Groups = append(mapping.Groups, binding.SubjectGroup())
+			}
+		}
+	}
+	return mappings
+}
+
+func newObjectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels: map[string]string{
+			managedByLabelKey: managedByLabelValue,
+		},
+	}
+}
+
+func mergeLabels(existing, labels map[string]string) map[string]string {
+	if existing == nil {
+		existing = map[string]string{}
+	}
+	for k, v := range labels {
+		existing[k] = v
+	}
+	return existing
+}
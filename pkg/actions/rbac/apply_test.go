@@ -0,0 +1,177 @@
+package rbac_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/weaveworks/eksctl/pkg/actions/rbac"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("RBAC", func() {
+	var (
+		clientSet *fake.Clientset
+		cfg       *api.RBAC
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientSet = fake.NewSimpleClientset()
+		cfg = &api.RBAC{
+			ClusterRoles: []*api.RBACClusterRole{
+				{
+					Name: "operator",
+					Rules: []api.RBACPolicyRule{
+						{
+							APIGroups: []string{"apps"},
+							Resources: []string{"deployments"},
+							Verbs:     []string{"get", "list", "update"},
+						},
+					},
+				},
+			},
+			Bindings: []*api.RBACBinding{
+				{
+					Name:          "viewers",
+					ClusterRole:   "view",
+					IAMPrincipals: []string{"arn:aws:iam::123456789012:role/developers", "arn:aws:iam::123456789012:role/oncall"},
+				},
+				{
+					Name:          "operators",
+					ClusterRole:   "operator",
+					Namespaces:    []string{"team-a", "team-b"},
+					Group:         "team-operators",
+					IAMPrincipals: []string{"arn:aws:iam::123456789012:role/oncall"},
+				},
+			},
+		}
+	})
+
+	It("creates the cluster roles and bindings", func() {
+		Expect(rbac.NewApplier(clientSet).Apply(ctx, cfg)).To(Succeed())
+
+		clusterRole, err := clientSet.RbacV1().ClusterRoles().Get(ctx, "operator", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterRole.Labels).To(HaveKeyWithValue("app.kubernetes.io/managed-by", "eksctl"))
+		Expect(clusterRole.Rules).To(Equal([]rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"deployments"},
+				Verbs:     []string{"get", "list", "update"},
+			},
+		}))
+
+		clusterRoleBinding, err := clientSet.RbacV1().ClusterRoleBindings().Get(ctx, "viewers", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterRoleBinding.RoleRef.Name).To(Equal("view"))
+		Expect(clusterRoleBinding.Subjects).To(ConsistOf(rbacv1.Subject{
+			APIGroup: rbacv1.GroupName,
+			Kind:     rbacv1.GroupKind,
+			Name:     "eksctl:rbac:viewers",
+		}))
+
+		for _, namespace := range []string{"team-a", "team-b"} {
+			roleBinding, err := clientSet.RbacV1().RoleBindings(namespace).Get(ctx, "operators", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roleBinding.RoleRef.Kind).To(Equal("ClusterRole"))
+			Expect(roleBinding.RoleRef.Name).To(Equal("operator"))
+			Expect(roleBinding.Subjects[0].Name).To(Equal("team-operators"))
+		}
+		_, err = clientSet.RbacV1().ClusterRoleBindings().Get(ctx, "operators", metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("creates the namespaces of the role bindings that do not exist", func() {
+		_, err := clientSet.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "team-a",
+				Labels: map[string]string{"team": "a"},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		// reject role bindings in missing namespaces as the NamespaceLifecycle admission plugin does
+		clientSet.PrependReactor("create", "rolebindings", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			namespace := action.GetNamespace()
+			if _, err := clientSet.Tracker().Get(corev1.SchemeGroupVersion.WithResource("namespaces"), "", namespace); err != nil {
+				return true, nil, apierrors.NewNotFound(corev1.Resource("namespaces"), namespace)
+			}
+			return false, nil, nil
+		})
+
+		Expect(rbac.NewApplier(clientSet).Apply(ctx, cfg)).To(Succeed())
+
+		namespace, err := clientSet.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(namespace.Labels).To(HaveKeyWithValue("team", "a"))
+		_, err = clientSet.CoreV1().Namespaces().Get(ctx, "team-b", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		for _, namespace := range []string{"team-a", "team-b"} {
+			_, err := clientSet.RbacV1().RoleBindings(namespace).Get(ctx, "operators", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+	})
+
+	It("updates existing cluster roles and bindings", func() {
+		_, err := clientSet.RbacV1().ClusterRoles().Create(ctx, &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "operator",
+				Labels: map[string]string{"team": "platform"},
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					Resources: []string{"pods"},
+					Verbs:     []string{"delete"},
+				},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = clientSet.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "viewers"},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     "edit",
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(rbac.NewApplier(clientSet).Apply(ctx, cfg)).To(Succeed())
+
+		clusterRole, err := clientSet.RbacV1().ClusterRoles().Get(ctx, "operator", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterRole.Labels).To(Equal(map[string]string{
+			"team":                         "platform",
+			"app.kubernetes.io/managed-by": "eksctl",
+		}))
+		Expect(clusterRole.Rules).To(HaveLen(1))
+		Expect(clusterRole.Rules[0].Resources).To(Equal([]string{"deployments"}))
+
+		clusterRoleBinding, err := clientSet.RbacV1().ClusterRoleBindings().Get(ctx, "viewers", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterRoleBinding.RoleRef.Name).To(Equal("view"))
+		Expect(clusterRoleBinding.Subjects).To(HaveLen(1))
+	})
+
+	It("maps each IAM principal once, to the groups of all its bindings", func() {
+		Expect(rbac.IdentityMappings(cfg)).To(Equal([]*api.IAMIdentityMapping{
+			{
+				ARN:    "arn:aws:iam::123456789012:role/developers",
+				Groups: []string{"eksctl:rbac:viewers"},
+			},
+			{
+				ARN:    "arn:aws:iam::123456789012:role/oncall",
+				Groups: []string{"eksctl:rbac:viewers", "team-operators"},
+			},
+		}))
+	})
+})
@@ -0,0 +1,11 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestRBAC(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+)
+
+// maxFinishedJobs bounds the number of finished jobs kept, the oldest are forgotten first
+const maxFinishedJobs = 1000
+
+// Operation is the eksctl operation a job runs
+type Operation string
+
+// Values for `Operation`
+const (
+	OperationCreateCluster            Operation = "CreateCluster"
+	OperationDeleteCluster            Operation = "DeleteCluster"
+	OperationCreateNodeGroups         Operation = "CreateNodeGroups"
+	OperationScaleNodeGroup           Operation = "ScaleNodeGroup"
+	OperationDeleteNodeGroup          Operation = "DeleteNodeGroup"
+	OperationCreateAddons             Operation = "CreateAddons"
+	OperationDeleteAddon              Operation = "DeleteAddon"
+	OperationCreateIAMServiceAccounts Operation = "CreateIAMServiceAccounts"
+	OperationDeleteIAMServiceAccounts Operation = "DeleteIAMServiceAccounts"
+)
+
+// JobStatus is the status of a job
+type JobStatus string
+
+// Values for `JobStatus`
+const (
+	JobStatusRunning   JobStatus = "Running"
+	JobStatusSucceeded JobStatus = "Succeeded"
+	JobStatusFailed    JobStatus = "Failed"
+)
+
+// Job is an operation run by the server
+type Job struct {
+	ID        string    `json:"id"`
+	Operation Operation `json:"operation"`
+	Cluster   string    `json:"cluster"`
+	// Resource is the nodegroup, addon or service account the operation is run on, if any
+	Resource   string     `json:"resource,omitempty"`
+	Status     JobStatus  `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// jobStore runs the jobs and keeps their status
+type jobStore struct {
+	ctx     context.Context
+	mu      sync.Mutex
+	jobs    map[string]*Job
+	running sync.WaitGroup
+}
+
+func newJobStore(ctx context.Context) *jobStore {
+	return &jobStore{
+		ctx:  ctx,
+		jobs: map[string]*Job{},
+	}
+}
+
+// start runs an operation on a cluster, failing if another job is running on the cluster, as their CloudFormation
+// stacks would conflict
+func (s *jobStore) start(operation Operation, cluster, resource string, run func(ctx context.Context) error) (*Job, error) {
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, job := range s.jobs {
+		if job.Cluster == cluster && job.Status == JobStatusRunning {
+			return nil, &httpError{status: http.StatusConflict, err: fmt.Errorf("job %s is already running on cluster %q", job.ID, cluster)}
+		}
+	}
+	job := &Job{
+		ID:        id,
+		Operation: operation,
+		Cluster:   cluster,
+		Resource:  resource,
+		Status:    JobStatusRunning,
+		StartedAt: time.Now().UTC(),
+	}
+	s.jobs[id] = job
+	s.forgetOldJobs()
+
+	s.running.Add(1)
+	go func() {
+		defer s.running.Done()
+		logger.Info("job %s: running %s on cluster %q", id, operation, cluster)
+		err := run(s.ctx)
+		s.finish(id, err)
+	}()
+	copied := *job
+	return &copied, nil
+}
+
+func (s *jobStore) finish(id string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := s.jobs[id]
+	finishedAt := time.Now().UTC()
+	job.FinishedAt = &finishedAt
+	if err != nil {
+		job.Status = JobStatusFailed
+		job.Error = err.Error()
+		logger.Warning("job %s: %s on cluster %q failed: %v", id, job.Operation, job.Cluster, err)
+		return
+	}
+	job.Status = JobStatusSucceeded
+	logger.Success("job %s: %s on cluster %q succeeded", id, job.Operation, job.Cluster)
+}
+
+func (s *jobStore) get(id string) (Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+	return *job, true
+}
+
+// list returns the jobs, the most recent first
+func (s *jobStore) list() []Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := make([]Job, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		jobs = append(jobs, *job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.After(jobs[j].StartedAt)
+	})
+	return jobs
+}
+
+func (s *jobStore) wait() {
+	s.running.Wait()
+}
+
+// forgetOldJobs forgets the oldest finished jobs beyond maxFinishedJobs, s.mu must be held
+func (s *jobStore) forgetOldJobs() {
+	var finished []*Job
+	for _, job := range s.jobs {
+		if job.FinishedAt != nil {
+			finished = append(finished, job)
+		}
+	}
+	if len(finished) <= maxFinishedJobs {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].FinishedAt.Before(*finished[j].FinishedAt)
+	})
+	for _, job := range finished[:len(finished)-maxFinishedJobs] {
+		delete(s.jobs, job.ID)
+	}
+}
+
+func newJobID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating job ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
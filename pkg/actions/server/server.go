@@ -0,0 +1,388 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/client"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// maxBodySize bounds the size of the ClusterConfig payloads
+const maxBodySize = 1 << 20
+
+// Operations are the eksctl operations the server runs, implemented by *client.Client
+type Operations interface {
+	CreateCluster(ctx context.Context, cfg *api.ClusterConfig, options client.CreateClusterOptions) error
+	DeleteCluster(ctx context.Context, clusterName string, options client.DeleteClusterOptions) error
+	CreateNodeGroups(ctx context.Context, cfg *api.ClusterConfig, options client.CreateNodeGroupsOptions) error
+	DeleteNodeGroup(ctx context.Context, clusterName, name string, options client.DeleteNodeGroupOptions) error
+	ScaleNodeGroup(ctx context.Context, clusterName, name string, options client.ScaleNodeGroupOptions) error
+	CreateIAMServiceAccounts(ctx context.Context, cfg *api.ClusterConfig, options client.CreateIAMServiceAccountsOptions) error
+	DeleteIAMServiceAccounts(ctx context.Context, cfg *api.ClusterConfig, options client.DeleteIAMServiceAccountsOptions) error
+	CreateAddons(ctx context.Context, cfg *api.ClusterConfig, options client.CreateAddonsOptions) error
+	DeleteAddon(ctx context.Context, clusterName, name string, options client.DeleteAddonOptions) error
+}
+
+// Server is an HTTP server running eksctl operations as asynchronous jobs
+type Server struct {
+	ops    Operations
+	jobs   *jobStore
+	token  string
+	region string
+}
+
+// New returns a server running the jobs with ctx. Requests must be authorised with token as a bearer token unless it
+// is empty. When region is set, the ClusterConfigs of the requests must be of that region
+func New(ctx context.Context, ops Operations, token, region string) (*Server, error) {
+	// the ClusterConfig payloads are decoded with the scheme of the API
+	if err := api.Register(); err != nil {
+		return nil, err
+	}
+	return &Server{
+		ops:    ops,
+		jobs:   newJobStore(ctx),
+		token:  token,
+		region: region,
+	}, nil
+}
+
+// Wait waits for the jobs started to finish
+func (s *Server) Wait() {
+	s.jobs.wait()
+}
+
+// ScaleRequest is the body of the requests scaling a nodegroup, the sizes left unset are not changed
+type ScaleRequest struct {
+	DesiredCapacity *int `json:"desiredCapacity,omitempty"`
+	MinSize         *int `json:"minSize,omitempty"`
+	MaxSize         *int `json:"maxSize,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// httpError is an error with the status code of the response reporting it
+type httpError struct {
+	status int
+	err    error
+}
+
+func (e *httpError) Error() string {
+	return e.err.Error()
+}
+
+func badRequest(format string, a ...interface{}) error {
+	return &httpError{status: http.StatusBadRequest, err: fmt.Errorf(format, a...)}
+}
+
+// ServeHTTP routes the requests of the API:
+//
+//	GET    /healthz
+//	GET    /v1/jobs
+//	GET    /v1/jobs/{id}
+//	POST   /v1/clusters
+//	DELETE /v1/clusters/{cluster}
+//	POST   /v1/clusters/{cluster}/nodegroups
+//	PATCH  /v1/clusters/{cluster}/nodegroups/{nodegroup}
+//	DELETE /v1/clusters/{cluster}/nodegroups/{nodegroup}
+//	POST   /v1/clusters/{cluster}/addons
+//	DELETE /v1/clusters/{cluster}/addons/{addon}
+//	POST   /v1/clusters/{cluster}/iamserviceaccounts
+//	DELETE /v1/clusters/{cluster}/iamserviceaccounts/{namespace}/{name}
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/healthz" {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+		return
+	}
+	if !s.authorized(r) {
+		writeError(w, &httpError{status: http.StatusUnauthorized, err: errors.New("missing or invalid bearer token")})
+		return
+	}
+
+	var (
+		job *Job
+		err error
+	)
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(segments) >= 2 && segments[0] == "v1" && segments[1] == "jobs":
+		s.serveJobs(w, r, segments[2:])
+		return
+	case len(segments) >= 2 && segments[0] == "v1" && segments[1] == "clusters":
+		job, err = s.startJob(r, segments[2:])
+	default:
+		err = &httpError{status: http.StatusNotFound, err: fmt.Errorf("no such path %q", r.URL.Path)}
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Location", "/v1/jobs/"+job.ID)
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+func (s *Server) serveJobs(w http.ResponseWriter, r *http.Request, segments []string) {
+	if r.Method != http.MethodGet {
+		writeError(w, methodNotAllowed(r))
+		return
+	}
+	switch len(segments) {
+	case 0:
+		writeJSON(w, http.StatusOK, s.jobs.list())
+	case 1:
+		job, ok := s.jobs.get(segments[0])
+		if !ok {
+			writeError(w, &httpError{status: http.StatusNotFound, err: fmt.Errorf("no job %q", segments[0])})
+			return
+		}
+		writeJSON(w, http.StatusOK, job)
+	default:
+		writeError(w, &httpError{status: http.StatusNotFound, err: fmt.Errorf("no such path %q", r.URL.Path)})
+	}
+}
+
+// startJob starts the job of an operation on a cluster, segments is the path following /v1/clusters
+func (s *Server) startJob(r *http.Request, segments []string) (*Job, error) {
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, &httpError{status: http.StatusNotFound, err: fmt.Errorf("no such path %q", r.URL.Path)}
+		}
+	}
+	query := r.URL.Query()
+	var resource string
+	if len(segments) > 1 {
+		resource = segments[1]
+	}
+
+	switch {
+	case r.Method == http.MethodPost && len(segments) == 0:
+		cfg, err := s.decodeClusterConfig(r, "")
+		if err != nil {
+			return nil, err
+		}
+		return s.jobs.start(OperationCreateCluster, cfg.Metadata.Name, "", func(ctx context.Context) error {
+			return s.ops.CreateCluster(ctx, cfg, client.CreateClusterOptions{})
+		})
+
+	case r.Method == http.MethodDelete && len(segments) == 1:
+		if err := validateBoolParams(query, "wait", "force"); err != nil {
+			return nil, err
+		}
+		options := client.DeleteClusterOptions{
+			Wait:  boolParam(query, "wait"),
+			Force: boolParam(query, "force"),
+		}
+		return s.jobs.start(OperationDeleteCluster, segments[0], "", func(ctx context.Context) error {
+			return s.ops.DeleteCluster(ctx, segments[0], options)
+		})
+
+	case r.Method == http.MethodPost && len(segments) == 2 && resource == "nodegroups":
+		cfg, err := s.decodeClusterConfig(r, segments[0])
+		if err != nil {
+			return nil, err
+		}
+		return s.jobs.start(OperationCreateNodeGroups, segments[0], "", func(ctx context.Context) error {
+			return s.ops.CreateNodeGroups(ctx, cfg, client.CreateNodeGroupsOptions{})
+		})
+
+	case r.Method == http.MethodPatch && len(segments) == 3 && resource == "nodegroups":
+		if err := validateBoolParams(query, "wait"); err != nil {
+			return nil, err
+		}
+		var scale ScaleRequest
+		if err := decodeJSON(r, &scale); err != nil {
+			return nil, err
+		}
+		options := client.ScaleNodeGroupOptions{
+			DesiredCapacity: scale.DesiredCapacity,
+			MinSize:         scale.MinSize,
+			MaxSize:         scale.MaxSize,
+			Wait:            boolParam(query, "wait"),
+		}
+		return s.jobs.start(OperationScaleNodeGroup, segments[0], segments[2], func(ctx context.Context) error {
+			return s.ops.ScaleNodeGroup(ctx, segments[0], segments[2], options)
+		})
+
+	case r.Method == http.MethodDelete && len(segments) == 3 && resource == "nodegroups":
+		if err := validateBoolParams(query, "wait", "drain"); err != nil {
+			return nil, err
+		}
+		options := client.DeleteNodeGroupOptions{
+			Wait:      boolParam(query, "wait"),
+			SkipDrain: query.Get("drain") != "" && !boolParam(query, "drain"),
+		}
+		return s.jobs.start(OperationDeleteNodeGroup, segments[0], segments[2], func(ctx context.Context) error {
+			return s.ops.DeleteNodeGroup(ctx, segments[0], segments[2], options)
+		})
+
+	case r.Method == http.MethodPost && len(segments) == 2 && resource == "addons":
+		if err := validateBoolParams(query, "force"); err != nil {
+			return nil, err
+		}
+		cfg, err := s.decodeClusterConfig(r, segments[0])
+		if err != nil {
+			return nil, err
+		}
+		options := client.CreateAddonsOptions{Force: boolParam(query, "force")}
+		return s.jobs.start(OperationCreateAddons, segments[0], "", func(ctx context.Context) error {
+			return s.ops.CreateAddons(ctx, cfg, options)
+		})
+
+	case r.Method == http.MethodDelete && len(segments) == 3 && resource == "addons":
+		if err := validateBoolParams(query, "preserve"); err != nil {
+			return nil, err
+		}
+		options := client.DeleteAddonOptions{Preserve: boolParam(query, "preserve")}
+		return s.jobs.start(OperationDeleteAddon, segments[0], segments[2], func(ctx context.Context) error {
+			return s.ops.DeleteAddon(ctx, segments[0], segments[2], options)
+		})
+
+	case r.Method == http.MethodPost && len(segments) == 2 && resource == "iamserviceaccounts":
+		if err := validateBoolParams(query, "overrideExisting"); err != nil {
+			return nil, err
+		}
+		cfg, err := s.decodeClusterConfig(r, segments[0])
+		if err != nil {
+			return nil, err
+		}
+		options := client.CreateIAMServiceAccountsOptions{OverrideExisting: boolParam(query, "overrideExisting")}
+		return s.jobs.start(OperationCreateIAMServiceAccounts, segments[0], "", func(ctx context.Context) error {
+			return s.ops.CreateIAMServiceAccounts(ctx, cfg, options)
+		})
+
+	case r.Method == http.MethodDelete && len(segments) == 4 && resource == "iamserviceaccounts":
+		if err := validateBoolParams(query, "wait"); err != nil {
+			return nil, err
+		}
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = segments[0]
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{
+			ClusterIAMMeta: api.ClusterIAMMeta{Namespace: segments[2], Name: segments[3]},
+		}}
+		options := client.DeleteIAMServiceAccountsOptions{Wait: boolParam(query, "wait")}
+		return s.jobs.start(OperationDeleteIAMServiceAccounts, segments[0], segments[2]+"/"+segments[3], func(ctx context.Context) error {
+			return s.ops.DeleteIAMServiceAccounts(ctx, cfg, options)
+		})
+	}
+
+	if knownPath(segments) {
+		return nil, methodNotAllowed(r)
+	}
+	return nil, &httpError{status: http.StatusNotFound, err: fmt.Errorf("no such path %q", r.URL.Path)}
+}
+
+// knownPath reports whether segments, the path following /v1/clusters, is a path of the API
+func knownPath(segments []string) bool {
+	switch len(segments) {
+	case 0, 1:
+		return true
+	case 2, 3:
+		switch segments[1] {
+		case "nodegroups", "addons":
+			return true
+		case "iamserviceaccounts":
+			return len(segments) == 2
+		}
+	case 4:
+		return segments[1] == "iamserviceaccounts"
+	}
+	return false
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.token == "" {
+		return true
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+// decodeClusterConfig decodes the ClusterConfig in the body of r, in YAML or JSON. The name of the cluster defaults
+// to clusterName and must match it when both are set, and its region must match the one of the server
+func (s *Server) decodeClusterConfig(r *http.Request, clusterName string) (*api.ClusterConfig, error) {
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		return nil, badRequest("reading ClusterConfig: %v", err)
+	}
+	if len(data) > maxBodySize {
+		return nil, &httpError{status: http.StatusRequestEntityTooLarge, err: fmt.Errorf("ClusterConfig exceeds %d bytes", maxBodySize)}
+	}
+	cfg, err := eks.ParseConfig(data)
+	if err != nil {
+		return nil, badRequest("invalid ClusterConfig: %v", err)
+	}
+	switch {
+	case cfg.Metadata.Name == "" && clusterName != "":
+		cfg.Metadata.Name = clusterName
+	case cfg.Metadata.Name == "":
+		return nil, badRequest("metadata.name must be set")
+	case clusterName != "" && cfg.Metadata.Name != clusterName:
+		return nil, badRequest("metadata.name %q does not match cluster %q of the path", cfg.Metadata.Name, clusterName)
+	}
+	if s.region != "" && cfg.Metadata.Region != "" && cfg.Metadata.Region != s.region {
+		return nil, badRequest("metadata.region %q does not match region %q of the server", cfg.Metadata.Region, s.region)
+	}
+	return cfg, nil
+}
+
+func decodeJSON(r *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(io.LimitReader(r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return badRequest("invalid request body: %v", err)
+	}
+	return nil
+}
+
+func boolParam(query map[string][]string, name string) bool {
+	values := query[name]
+	if len(values) == 0 {
+		return false
+	}
+	v, _ := strconv.ParseBool(values[0])
+	return v
+}
+
+func validateBoolParams(query map[string][]string, names ...string) error {
+	for _, name := range names {
+		if values := query[name]; len(values) > 0 {
+			if _, err := strconv.ParseBool(values[0]); err != nil {
+				return badRequest("invalid value %q of query parameter %s, must be true or false", values[0], name)
+			}
+		}
+	}
+	return nil
+}
+
+func methodNotAllowed(r *http.Request) error {
+	return &httpError{status: http.StatusMethodNotAllowed, err: fmt.Errorf("method %s is not allowed on %q", r.Method, r.URL.Path)}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var httpErr *httpError
+	if errors.As(err, &httpErr) {
+		status = httpErr.status
+	}
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Debug("writing response: %v", err)
+	}
+}
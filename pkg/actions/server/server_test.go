@@ -0,0 +1,292 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/server"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/client"
+)
+
+type call struct {
+	operation string
+	cluster   string
+	name      string
+	cfg       *api.ClusterConfig
+	options   interface{}
+}
+
+// fakeOperations records the operations run, blocking them until release is closed if it is set
+type fakeOperations struct {
+	mu      sync.Mutex
+	calls   []call
+	err     error
+	release chan struct{}
+}
+
+func (f *fakeOperations) record(c call) error {
+	if f.release != nil {
+		<-f.release
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, c)
+	return f.err
+}
+
+func (f *fakeOperations) recorded() []call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]call{}, f.calls...)
+}
+
+func (f *fakeOperations) CreateCluster(_ context.Context, cfg *api.ClusterConfig, options client.CreateClusterOptions) error {
+	return f.record(call{operation: "CreateCluster", cluster: cfg.Metadata.Name, cfg: cfg, options: options})
+}
+
+func (f *fakeOperations) DeleteCluster(_ context.Context, clusterName string, options client.DeleteClusterOptions) error {
+	return f.record(call{operation: "DeleteCluster", cluster: clusterName, options: options})
+}
+
+func (f *fakeOperations) CreateNodeGroups(_ context.Context, cfg *api.ClusterConfig, options client.CreateNodeGroupsOptions) error {
+	return f.record(call{operation: "CreateNodeGroups", cluster: cfg.Metadata.Name, cfg: cfg, options: options})
+}
+
+func (f *fakeOperations) DeleteNodeGroup(_ context.Context, clusterName, name string, options client.DeleteNodeGroupOptions) error {
+	return f.record(call{operation: "DeleteNodeGroup", cluster: clusterName, name: name, options: options})
+}
+
+func (f *fakeOperations) ScaleNodeGroup(_ context.Context, clusterName, name string, options client.ScaleNodeGroupOptions) error {
+	return f.record(call{operation: "ScaleNodeGroup", cluster: clusterName, name: name, options: options})
+}
+
+func (f *fakeOperations) CreateIAMServiceAccounts(_ context.Context, cfg *api.ClusterConfig, options client.CreateIAMServiceAccountsOptions) error {
+	return f.record(call{operation: "CreateIAMServiceAccounts", cluster: cfg.Metadata.Name, cfg: cfg, options: options})
+}
+
+func (f *fakeOperations) DeleteIAMServiceAccounts(_ context.Context, cfg *api.ClusterConfig, options client.DeleteIAMServiceAccountsOptions) error {
+	return f.record(call{operation: "DeleteIAMServiceAccounts", cluster: cfg.Metadata.Name, cfg: cfg, options: options})
+}
+
+func (f *fakeOperations) CreateAddons(_ context.Context, cfg *api.ClusterConfig, options client.CreateAddonsOptions) error {
+	return f.record(call{operation: "CreateAddons", cluster: cfg.Metadata.Name, cfg: cfg, options: options})
+}
+
+func (f *fakeOperations) DeleteAddon(_ context.Context, clusterName, name string, options client.DeleteAddonOptions) error {
+	return f.record(call{operation: "DeleteAddon", cluster: clusterName, name: name, options: options})
+}
+
+const clusterConfig = `
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: dev
+  region: us-west-2
+managedNodeGroups:
+  - name: ng-1
+`
+
+var _ = Describe("Server", func() {
+	var (
+		ops *fakeOperations
+		srv *server.Server
+	)
+
+	newServer := func(token string) {
+		var err error
+		srv, err = server.New(context.Background(), ops, token, "us-west-2")
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	do := func(method, path, body string, headers ...string) *httptest.ResponseRecorder {
+		var reader io.Reader
+		if body != "" {
+			reader = strings.NewReader(body)
+		}
+		req := httptest.NewRequest(method, path, reader)
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		rec := httptest.NewRecorder()
+		srv.ServeHTTP(rec, req)
+		return rec
+	}
+
+	decodeJob := func(rec *httptest.ResponseRecorder) server.Job {
+		var job server.Job
+		Expect(json.Unmarshal(rec.Body.Bytes(), &job)).To(Succeed())
+		return job
+	}
+
+	getJob := func(id string) server.Job {
+		rec := do(http.MethodGet, "/v1/jobs/"+id, "")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		return decodeJob(rec)
+	}
+
+	BeforeEach(func() {
+		ops = &fakeOperations{}
+		newServer("")
+	})
+
+	It("serves its health", func() {
+		rec := do(http.MethodGet, "/healthz", "")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+	})
+
+	It("creates a cluster and reports the job", func() {
+		rec := do(http.MethodPost, "/v1/clusters", clusterConfig)
+		Expect(rec.Code).To(Equal(http.StatusAccepted))
+		job := decodeJob(rec)
+		Expect(job.Operation).To(Equal(server.OperationCreateCluster))
+		Expect(job.Cluster).To(Equal("dev"))
+		Expect(job.Status).To(Equal(server.JobStatusRunning))
+		Expect(rec.Header().Get("Location")).To(Equal("/v1/jobs/" + job.ID))
+
+		srv.Wait()
+		job = getJob(job.ID)
+		Expect(job.Status).To(Equal(server.JobStatusSucceeded))
+		Expect(job.FinishedAt).NotTo(BeNil())
+
+		calls := ops.recorded()
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].cfg.ManagedNodeGroups[0].Name).To(Equal("ng-1"))
+	})
+
+	It("reports the error of a failed job", func() {
+		ops.err = errors.New("stack failed")
+		job := decodeJob(do(http.MethodPost, "/v1/clusters", clusterConfig))
+		srv.Wait()
+		job = getJob(job.ID)
+		Expect(job.Status).To(Equal(server.JobStatusFailed))
+		Expect(job.Error).To(Equal("stack failed"))
+	})
+
+	It("lists the jobs", func() {
+		do(http.MethodDelete, "/v1/clusters/dev", "")
+		do(http.MethodDelete, "/v1/clusters/prod", "")
+		srv.Wait()
+
+		rec := do(http.MethodGet, "/v1/jobs", "")
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		var jobs []server.Job
+		Expect(json.Unmarshal(rec.Body.Bytes(), &jobs)).To(Succeed())
+		Expect(jobs).To(HaveLen(2))
+	})
+
+	It("rejects a job on a cluster another job is running on", func() {
+		ops.release = make(chan struct{})
+		Expect(do(http.MethodPost, "/v1/clusters", clusterConfig).Code).To(Equal(http.StatusAccepted))
+		Expect(do(http.MethodDelete, "/v1/clusters/dev", "").Code).To(Equal(http.StatusConflict))
+		Expect(do(http.MethodDelete, "/v1/clusters/prod", "").Code).To(Equal(http.StatusAccepted))
+		close(ops.release)
+		srv.Wait()
+		Expect(do(http.MethodDelete, "/v1/clusters/dev", "").Code).To(Equal(http.StatusAccepted))
+		srv.Wait()
+	})
+
+	DescribeTable("starts the operations", func(method, path, body string, expected call) {
+		rec := do(method, path, body)
+		Expect(rec.Code).To(Equal(http.StatusAccepted), rec.Body.String())
+		srv.Wait()
+		calls := ops.recorded()
+		Expect(calls).To(HaveLen(1))
+		calls[0].cfg = nil
+		Expect(calls[0]).To(Equal(expected))
+	},
+		Entry("deleting a cluster", http.MethodDelete, "/v1/clusters/dev?wait=true&force=true", "",
+			call{operation: "DeleteCluster", cluster: "dev", options: client.DeleteClusterOptions{Wait: true, Force: true}}),
+		Entry("creating nodegroups", http.MethodPost, "/v1/clusters/dev/nodegroups", clusterConfig,
+			call{operation: "CreateNodeGroups", cluster: "dev", options: client.CreateNodeGroupsOptions{}}),
+		Entry("scaling a nodegroup", http.MethodPatch, "/v1/clusters/dev/nodegroups/ng-1?wait=true", `{"desiredCapacity": 3}`,
+			call{operation: "ScaleNodeGroup", cluster: "dev", name: "ng-1", options: client.ScaleNodeGroupOptions{DesiredCapacity: intPtr(3), Wait: true}}),
+		Entry("deleting a nodegroup without draining it", http.MethodDelete, "/v1/clusters/dev/nodegroups/ng-1?drain=false", "",
+			call{operation: "DeleteNodeGroup", cluster: "dev", name: "ng-1", options: client.DeleteNodeGroupOptions{SkipDrain: true}}),
+		Entry("creating addons", http.MethodPost, "/v1/clusters/dev/addons?force=true", clusterConfig,
+			call{operation: "CreateAddons", cluster: "dev", options: client.CreateAddonsOptions{Force: true}}),
+		Entry("deleting an addon", http.MethodDelete, "/v1/clusters/dev/addons/vpc-cni?preserve=true", "",
+			call{operation: "DeleteAddon", cluster: "dev", name: "vpc-cni", options: client.DeleteAddonOptions{Preserve: true}}),
+		Entry("creating IAM service accounts", http.MethodPost, "/v1/clusters/dev/iamserviceaccounts", clusterConfig,
+			call{operation: "CreateIAMServiceAccounts", cluster: "dev", options: client.CreateIAMServiceAccountsOptions{}}),
+		Entry("deleting an IAM service account", http.MethodDelete, "/v1/clusters/dev/iamserviceaccounts/kube-system/s3-reader?wait=true", "",
+			call{operation: "DeleteIAMServiceAccounts", cluster: "dev", options: client.DeleteIAMServiceAccountsOptions{Wait: true}}),
+	)
+
+	It("deletes the IAM service account of the path", func() {
+		do(http.MethodDelete, "/v1/clusters/dev/iamserviceaccounts/kube-system/s3-reader", "")
+		srv.Wait()
+		calls := ops.recorded()
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].cfg.IAM.ServiceAccounts).To(HaveLen(1))
+		Expect(calls[0].cfg.IAM.ServiceAccounts[0].NameString()).To(Equal("kube-system/s3-reader"))
+	})
+
+	It("names the cluster of a ClusterConfig without a name after the path", func() {
+		do(http.MethodPost, "/v1/clusters/prod/addons", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nmetadata:\n  region: us-west-2\naddons:\n  - name: vpc-cni\n")
+		srv.Wait()
+		calls := ops.recorded()
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].cfg.Metadata.Name).To(Equal("prod"))
+	})
+
+	DescribeTable("rejects invalid requests", func(method, path, body string, expectedStatus int, expectedErr string) {
+		rec := do(method, path, body)
+		Expect(rec.Code).To(Equal(expectedStatus))
+		Expect(rec.Body.String()).To(ContainSubstring(expectedErr))
+		Expect(ops.recorded()).To(BeEmpty())
+	},
+		Entry("with an invalid ClusterConfig", http.MethodPost, "/v1/clusters", "kind: ClusterConfig\nfoo: bar\n",
+			http.StatusBadRequest, "invalid ClusterConfig"),
+		Entry("with a ClusterConfig without a name", http.MethodPost, "/v1/clusters", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nmetadata:\n  region: us-west-2\n",
+			http.StatusBadRequest, "metadata.name must be set"),
+		Entry("with a ClusterConfig of another cluster", http.MethodPost, "/v1/clusters/prod/nodegroups", clusterConfig,
+			http.StatusBadRequest, `metadata.name \"dev\" does not match cluster \"prod\"`),
+		Entry("with a ClusterConfig of another region", http.MethodPost, "/v1/clusters", "apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nmetadata:\n  name: dev\n  region: eu-west-1\n",
+			http.StatusBadRequest, `metadata.region \"eu-west-1\" does not match region \"us-west-2\" of the server`),
+		Entry("with unknown fields of a scale request", http.MethodPatch, "/v1/clusters/dev/nodegroups/ng-1", `{"desired": 3}`,
+			http.StatusBadRequest, "invalid request body"),
+		Entry("with an invalid query parameter", http.MethodDelete, "/v1/clusters/dev?wait=maybe", "",
+			http.StatusBadRequest, "invalid value \\\"maybe\\\" of query parameter wait"),
+		Entry("with an unknown job", http.MethodGet, "/v1/jobs/unknown", "",
+			http.StatusNotFound, `no job \"unknown\"`),
+		Entry("with an unknown path", http.MethodGet, "/v1/foo", "",
+			http.StatusNotFound, "no such path"),
+		Entry("with an empty cluster name", http.MethodDelete, "/v1/clusters//nodegroups/ng-1", "",
+			http.StatusNotFound, "no such path"),
+		Entry("with a method not allowed", http.MethodGet, "/v1/clusters/dev/nodegroups", "",
+			http.StatusMethodNotAllowed, "method GET is not allowed"),
+	)
+
+	Context("with a token", func() {
+		BeforeEach(func() {
+			newServer("secret")
+		})
+
+		It("rejects requests without the token", func() {
+			Expect(do(http.MethodGet, "/v1/jobs", "").Code).To(Equal(http.StatusUnauthorized))
+			Expect(do(http.MethodGet, "/v1/jobs", "", "Authorization", "Bearer wrong").Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("accepts requests with the token", func() {
+			Expect(do(http.MethodGet, "/v1/jobs", "", "Authorization", "Bearer secret").Code).To(Equal(http.StatusOK))
+		})
+
+		It("serves its health without the token", func() {
+			Expect(do(http.MethodGet, "/healthz", "").Code).To(Equal(http.StatusOK))
+		})
+	})
+})
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,11 @@
+package tags_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestTags(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
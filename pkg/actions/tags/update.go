@@ -0,0 +1,279 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
+)
+
+// reservedTagPrefixes are the prefixes of the tags set by AWS and eksctl, which cannot be updated
+var reservedTagPrefixes = []string{"aws:", "alpha.eksctl.io/", "eksctl.cluster.k8s.io/", "eksctl.io/"}
+
+// maxEC2ResourcesPerCall is the maximum number of resources tagged by a call to CreateTags
+const maxEC2ResourcesPerCall = 1000
+
+// Manager updates the tags of a cluster and of the resources eksctl created for it
+type Manager struct {
+	clusterName  string
+	stackManager manager.StackManager
+	eksAPI       awsapi.EKS
+	asgAPI       awsapi.ASG
+	ec2API       awsapi.EC2
+}
+
+// New creates a new Manager
+func New(clusterName string, stackManager manager.StackManager, eksAPI awsapi.EKS, asgAPI awsapi.ASG, ec2API awsapi.EC2) *Manager {
+	return &Manager{
+		clusterName:  clusterName,
+		stackManager: stackManager,
+		eksAPI:       eksAPI,
+		asgAPI:       asgAPI,
+		ec2API:       ec2API,
+	}
+}
+
+// ValidateTags returns an error if tags is empty or sets a tag reserved by AWS or eksctl
+func ValidateTags(tags map[string]string) error {
+	if len(tags) == 0 {
+		return fmt.Errorf("at least one tag must be set")
+	}
+	for key := range tags {
+		for _, prefix := range reservedTagPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				return fmt.Errorf("tag %q cannot be updated, tags prefixed with %q are reserved", key, prefix)
+			}
+		}
+	}
+	return nil
+}
+
+// Update adds tags to the cluster, to the eksctl stacks of the cluster and, through them, to the
+// resources they hold, and to the Auto Scaling groups of the nodegroups, their launch templates and
+// their instances. Tags that already exist are updated, other tags are left as they are.
+func (m *Manager) Update(ctx context.Context, clusterARN string, tags map[string]string, plan bool) error {
+	stacks, err := m.stackManager.ListStacks(ctx)
+	if err != nil {
+		return err
+	}
+	// a stack being updated cannot be updated, nothing is changed so that the update can be retried
+	for _, s := range stacks {
+		if !m.stackManager.StackStatusIsNotTransitional(s) {
+			return fmt.Errorf("stack %q is in %s state, retry once its operation completes", aws.ToString(s.StackName), s.StackStatus)
+		}
+	}
+	asgNames, err := m.getAutoScalingGroupNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	utilsplan.LogIntendedAction(plan, "tag cluster %q", m.clusterName)
+	for _, s := range stacks {
+		if !hasTags(s.Tags, tags) {
+			utilsplan.LogIntendedAction(plan, "update the tags of stack %q", aws.ToString(s.StackName))
+		}
+	}
+	for _, name := range asgNames {
+		utilsplan.LogIntendedAction(plan, "tag Auto Scaling group %q, its launch templates and its instances", name)
+	}
+	if plan {
+		return nil
+	}
+
+	if _, err := m.eksAPI.TagResource(ctx, &eks.TagResourceInput{
+		ResourceArn: aws.String(clusterARN),
+		Tags:        tags,
+	}); err != nil {
+		return fmt.Errorf("tagging cluster %q: %w", m.clusterName, err)
+	}
+	logger.Info("tagged cluster %q", m.clusterName)
+
+	for _, s := range stacks {
+		if err := m.updateStackTags(ctx, s, tags); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range asgNames {
+		if err := m.tagAutoScalingGroup(ctx, name, tags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) updateStackTags(ctx context.Context, s *manager.Stack, tags map[string]string) error {
+	stackName := aws.ToString(s.StackName)
+	if hasTags(s.Tags, tags) {
+		logger.Debug("tags of stack %q are up to date", stackName)
+		return nil
+	}
+	template, err := m.stackManager.GetStackTemplate(ctx, stackName)
+	if err != nil {
+		return fmt.Errorf("getting template of stack %q: %w", stackName, err)
+	}
+	parameters := map[string]string{}
+	for _, p := range s.Parameters {
+		parameters[aws.ToString(p.ParameterKey)] = aws.ToString(p.ParameterValue)
+	}
+
+	updated := *s
+	updated.Tags = mergeStackTags(s.Tags, tags)
+	// the template is unchanged, CloudFormation propagates the tags of the stack to its resources
+	return m.stackManager.UpdateStack(ctx, manager.UpdateStackOptions{
+		Stack:         &updated,
+		ChangeSetName: m.stackManager.MakeChangeSetName("update-tags"),
+		Description:   fmt.Sprintf("updating tags of stack %q", stackName),
+		TemplateData:  manager.TemplateBody(template),
+		Parameters:    parameters,
+		Wait:          true,
+	})
+}
+
+func (m *Manager) getAutoScalingGroupNames(ctx context.Context) ([]string, error) {
+	nodeGroupStacks, err := m.stackManager.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var asgNames []string
+	for _, s := range nodeGroupStacks {
+		names, err := m.stackManager.GetAutoScalingGroupName(ctx, s)
+		if err != nil {
+			return nil, fmt.Errorf("getting Auto Scaling group of stack %q: %w", aws.ToString(s.StackName), err)
+		}
+		// managed nodegroups return their Auto Scaling groups as a comma-separated list
+		for _, name := range strings.Split(names, ",") {
+			if name != "" {
+				asgNames = append(asgNames, name)
+			}
+		}
+	}
+	return asgNames, nil
+}
+
+// tagAutoScalingGroup tags the Auto Scaling group with tags propagated to the instances it launches,
+// then tags its launch templates and its running instances, which are not tagged by the propagation
+func (m *Manager) tagAutoScalingGroup(ctx context.Context, name string, tags map[string]string) error {
+	var asgTags []asgtypes.Tag
+	for _, key := range sortedKeys(tags) {
+		asgTags = append(asgTags, asgtypes.Tag{
+			ResourceId:        aws.String(name),
+			ResourceType:      aws.String("auto-scaling-group"),
+			Key:               aws.String(key),
+			Value:             aws.String(tags[key]),
+			PropagateAtLaunch: aws.Bool(true),
+		})
+	}
+	for start := 0; start < len(asgTags); start += builder.MaximumCreatedTagNumberPerCall {
+		end := start + builder.MaximumCreatedTagNumberPerCall
+		if end > len(asgTags) {
+			end = len(asgTags)
+		}
+		if _, err := m.asgAPI.CreateOrUpdateTags(ctx, &autoscaling.CreateOrUpdateTagsInput{Tags: asgTags[start:end]}); err != nil {
+			return fmt.Errorf("tagging Auto Scaling group %q: %w", name, err)
+		}
+	}
+
+	out, err := m.asgAPI.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	})
+	if err != nil {
+		return fmt.Errorf("describing Auto Scaling group %q: %w", name, err)
+	}
+	var resources []string
+	for _, asg := range out.AutoScalingGroups {
+		if asg.LaunchTemplate != nil && asg.LaunchTemplate.LaunchTemplateId != nil {
+			resources = append(resources, *asg.LaunchTemplate.LaunchTemplateId)
+		}
+		if asg.MixedInstancesPolicy != nil && asg.MixedInstancesPolicy.LaunchTemplate != nil {
+			if spec := asg.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification; spec != nil && spec.LaunchTemplateId != nil {
+				resources = append(resources, *spec.LaunchTemplateId)
+			}
+		}
+		for _, instance := range asg.Instances {
+			resources = append(resources, aws.ToString(instance.InstanceId))
+		}
+	}
+
+	var ec2Tags []ec2types.Tag
+	for _, key := range sortedKeys(tags) {
+		ec2Tags = append(ec2Tags, ec2types.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(tags[key]),
+		})
+	}
+	for start := 0; start < len(resources); start += maxEC2ResourcesPerCall {
+		end := start + maxEC2ResourcesPerCall
+		if end > len(resources) {
+			end = len(resources)
+		}
+		if _, err := m.ec2API.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: resources[start:end],
+			Tags:      ec2Tags,
+		}); err != nil {
+			return fmt.Errorf("tagging launch templates and instances of Auto Scaling group %q: %w", name, err)
+		}
+	}
+	logger.Info("tagged Auto Scaling group %q, its launch templates and its %d instance(s)", name, countInstances(out.AutoScalingGroups))
+	return nil
+}
+
+func hasTags(stackTags []cfntypes.Tag, tags map[string]string) bool {
+	existing := map[string]string{}
+	for _, t := range stackTags {
+		existing[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	for key, value := range tags {
+		if v, ok := existing[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeStackTags(stackTags []cfntypes.Tag, tags map[string]string) []cfntypes.Tag {
+	var merged []cfntypes.Tag
+	for _, t := range stackTags {
+		if _, ok := tags[aws.ToString(t.Key)]; !ok {
+			merged = append(merged, t)
+		}
+	}
+	for _, key := range sortedKeys(tags) {
+		merged = append(merged, cfntypes.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(tags[key]),
+		})
+	}
+	return merged
+}
+
+func countInstances(asgs []asgtypes.AutoScalingGroup) int {
+	count := 0
+	for _, asg := range asgs {
+		count += len(asg.Instances)
+	}
+	return count
+}
+
+func sortedKeys(tags map[string]string) []string {
+	var keys []string
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
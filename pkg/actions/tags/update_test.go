@@ -0,0 +1,174 @@
+package tags_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	asgtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/tags"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+const clusterARN = "arn:aws:eks:us-west-2:123456789012:cluster/dev"
+
+var _ = Describe("Update tags", func() {
+	var (
+		fakeStackManager *fakes.FakeStackManager
+		p                *mockprovider.MockProvider
+		m                *tags.Manager
+		ctx              context.Context
+		clusterStack     *manager.Stack
+		nodeGroupStack   *manager.Stack
+		newTags          map[string]string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeStackManager = new(fakes.FakeStackManager)
+		p = mockprovider.NewMockProvider()
+		m = tags.New("dev", fakeStackManager, p.EKS(), p.ASG(), p.EC2())
+		newTags = map[string]string{"cost-center": "platform"}
+
+		clusterStack = &manager.Stack{
+			StackName:   aws.String("eksctl-dev-cluster"),
+			StackStatus: cfntypes.StackStatusCreateComplete,
+			Tags: []cfntypes.Tag{
+				{Key: aws.String("alpha.eksctl.io/cluster-name"), Value: aws.String("dev")},
+				{Key: aws.String("cost-center"), Value: aws.String("research")},
+			},
+			Parameters: []cfntypes.Parameter{
+				{ParameterKey: aws.String("VpcCIDR"), ParameterValue: aws.String("192.168.0.0/16")},
+			},
+		}
+		nodeGroupStack = &manager.Stack{
+			StackName:   aws.String("eksctl-dev-nodegroup-ng-1"),
+			StackStatus: cfntypes.StackStatusUpdateComplete,
+			Tags: []cfntypes.Tag{
+				{Key: aws.String("cost-center"), Value: aws.String("platform")},
+			},
+		}
+		fakeStackManager.ListStacksReturns([]*manager.Stack{clusterStack, nodeGroupStack}, nil)
+		fakeStackManager.StackStatusIsNotTransitionalReturns(true)
+		fakeStackManager.ListNodeGroupStacksReturns([]*manager.Stack{nodeGroupStack}, nil)
+		fakeStackManager.GetAutoScalingGroupNameReturns("asg-1,asg-2", nil)
+		fakeStackManager.GetStackTemplateReturns(`{"Resources":{}}`, nil)
+		fakeStackManager.MakeChangeSetNameReturns("eksctl-update-tags-1")
+	})
+
+	It("tags the cluster, the stacks and the Auto Scaling groups", func() {
+		p.MockEKS().On("TagResource", mock.Anything, &eks.TagResourceInput{
+			ResourceArn: aws.String(clusterARN),
+			Tags:        newTags,
+		}).Return(&eks.TagResourceOutput{}, nil)
+		for _, name := range []string{"asg-1", "asg-2"} {
+			p.MockASG().On("CreateOrUpdateTags", mock.Anything, &autoscaling.CreateOrUpdateTagsInput{
+				Tags: []asgtypes.Tag{
+					{
+						ResourceId:        aws.String(name),
+						ResourceType:      aws.String("auto-scaling-group"),
+						Key:               aws.String("cost-center"),
+						Value:             aws.String("platform"),
+						PropagateAtLaunch: aws.Bool(true),
+					},
+				},
+			}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+		}
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{"asg-1"},
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{
+				{
+					LaunchTemplate: &asgtypes.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-1")},
+					Instances: []asgtypes.Instance{
+						{InstanceId: aws.String("i-1")},
+						{InstanceId: aws.String("i-2")},
+					},
+				},
+			},
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{"asg-2"},
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []asgtypes.AutoScalingGroup{
+				{
+					MixedInstancesPolicy: &asgtypes.MixedInstancesPolicy{
+						LaunchTemplate: &asgtypes.LaunchTemplate{
+							LaunchTemplateSpecification: &asgtypes.LaunchTemplateSpecification{LaunchTemplateId: aws.String("lt-2")},
+						},
+					},
+				},
+			},
+		}, nil)
+		var taggedResources [][]string
+		p.MockEC2().On("CreateTags", mock.Anything, mock.MatchedBy(func(input *ec2.CreateTagsInput) bool {
+			return len(input.Tags) == 1 && *input.Tags[0].Key == "cost-center" && *input.Tags[0].Value == "platform"
+		})).Run(func(args mock.Arguments) {
+			taggedResources = append(taggedResources, args.Get(1).(*ec2.CreateTagsInput).Resources)
+		}).Return(&ec2.CreateTagsOutput{}, nil)
+
+		Expect(m.Update(ctx, clusterARN, newTags, false)).To(Succeed())
+
+		By("updating only the stack whose tags differ")
+		Expect(fakeStackManager.UpdateStackCallCount()).To(Equal(1))
+		_, options := fakeStackManager.UpdateStackArgsForCall(0)
+		Expect(*options.Stack.StackName).To(Equal("eksctl-dev-cluster"))
+		Expect(options.Stack.Tags).To(Equal([]cfntypes.Tag{
+			{Key: aws.String("alpha.eksctl.io/cluster-name"), Value: aws.String("dev")},
+			{Key: aws.String("cost-center"), Value: aws.String("platform")},
+		}))
+		Expect(options.TemplateData).To(Equal(manager.TemplateBody(`{"Resources":{}}`)))
+		Expect(options.Parameters).To(Equal(map[string]string{"VpcCIDR": "192.168.0.0/16"}))
+		Expect(options.Wait).To(BeTrue())
+		Expect(clusterStack.Tags[1].Value).To(Equal(aws.String("research")))
+
+		Expect(taggedResources).To(Equal([][]string{{"lt-1", "i-1", "i-2"}, {"lt-2"}}))
+		p.MockEKS().AssertExpectations(GinkgoT())
+		p.MockASG().AssertExpectations(GinkgoT())
+	})
+
+	It("does not change anything in plan mode", func() {
+		Expect(m.Update(ctx, clusterARN, newTags, true)).To(Succeed())
+		Expect(fakeStackManager.UpdateStackCallCount()).To(BeZero())
+		p.MockEKS().AssertNotCalled(GinkgoT(), "TagResource", mock.Anything, mock.Anything)
+		p.MockASG().AssertNotCalled(GinkgoT(), "CreateOrUpdateTags", mock.Anything, mock.Anything)
+	})
+
+	It("does not change anything while a stack is being updated", func() {
+		fakeStackManager.StackStatusIsNotTransitionalReturnsOnCall(1, false)
+		nodeGroupStack.StackStatus = cfntypes.StackStatusUpdateInProgress
+		err := m.Update(ctx, clusterARN, newTags, false)
+		Expect(err).To(MatchError(`stack "eksctl-dev-nodegroup-ng-1" is in UPDATE_IN_PROGRESS state, retry once its operation completes`))
+		p.MockEKS().AssertNotCalled(GinkgoT(), "TagResource", mock.Anything, mock.Anything)
+	})
+
+	It("fails when the cluster cannot be tagged", func() {
+		p.MockEKS().On("TagResource", mock.Anything, mock.Anything).Return(nil, errors.New("access denied"))
+		Expect(m.Update(ctx, clusterARN, newTags, false)).To(MatchError(`tagging cluster "dev": access denied`))
+		Expect(fakeStackManager.UpdateStackCallCount()).To(BeZero())
+	})
+
+	DescribeTable("ValidateTags", func(tagsToUpdate map[string]string, expectedErr string) {
+		err := tags.ValidateTags(tagsToUpdate)
+		if expectedErr == "" {
+			Expect(err).NotTo(HaveOccurred())
+			return
+		}
+		Expect(err).To(MatchError(expectedErr))
+	},
+		Entry("user tags", map[string]string{"team": "infra", "cost-center": "platform"}, ""),
+		Entry("no tags", map[string]string{}, "at least one tag must be set"),
+		Entry("an AWS tag", map[string]string{"aws:cloudformation:stack-name": "x"}, `tag "aws:cloudformation:stack-name" cannot be updated, tags prefixed with "aws:" are reserved`),
+		Entry("an eksctl tag", map[string]string{"alpha.eksctl.io/cluster-name": "prod"}, `tag "alpha.eksctl.io/cluster-name" cannot be updated, tags prefixed with "alpha.eksctl.io/" are reserved`),
+	)
+})
@@ -6,7 +6,12 @@ import (
 	"strings"
 
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/utils"
+	utilsstrings "github.com/weaveworks/eksctl/pkg/utils/strings"
 )
 
 // Addon holds the EKS addon configuration
@@ -43,6 +48,10 @@ type Addon struct {
 	// Force overwrites an existing self-managed add-on with an EKS managed add-on.
 	// Force is intended to be used when migrating an existing self-managed add-on to an EKS managed add-on.
 	Force bool `json:"-"`
+	// PreserveOnDelete leaves the Kubernetes resources of the addon running in the cluster
+	// when the addon is deleted with `eksctl delete addon`
+	// +optional
+	PreserveOnDelete bool `json:"preserveOnDelete,omitempty"`
 	// +optional
 	Publishers []string `json:"publishers,omitempty"`
 	// +optional
@@ -66,9 +75,153 @@ func (a Addon) Validate() error {
 		}
 	}
 
+	if a.CanonicalName() == CoreDNSAddon {
+		if err := a.validateCoreDNSConfigurationValues(); err != nil {
+			return err
+		}
+	}
+
+	if a.CanonicalName() == KubeProxyAddon {
+		if _, err := a.kubeProxyConfigurationValues(); err != nil {
+			return err
+		}
+	}
+
 	return a.checkOnlyOnePolicyProviderIsSet()
 }
 
+// ValidateForClusterVersion checks that the addon configuration is supported by the given Kubernetes version
+func (a Addon) ValidateForClusterVersion(clusterVersion string) error {
+	if a.CanonicalName() != KubeProxyAddon || clusterVersion == "" {
+		return nil
+	}
+	values, err := a.kubeProxyConfigurationValues()
+	if err != nil {
+		return err
+	}
+	if values.Mode == "" {
+		return nil
+	}
+	supported, err := utils.IsMinVersion(minimumVersionForKubeProxyMode, clusterVersion)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return fmt.Errorf("configurationValues.mode for addon %s requires Kubernetes version %s or greater; got %s", KubeProxyAddon, minimumVersionForKubeProxyMode, clusterVersion)
+	}
+	return nil
+}
+
+// kubeProxyConfigurationValues holds the subset of the kube-proxy addon configuration schema that eksctl validates
+type kubeProxyConfigurationValues struct {
+	Mode string `json:"mode,omitempty"`
+	IPVS *struct {
+		Scheduler string `json:"scheduler,omitempty"`
+	} `json:"ipvs,omitempty"`
+}
+
+// ipvsSchedulers are the IPVS scheduling algorithms supported by kube-proxy
+var ipvsSchedulers = []string{"rr", "wrr", "lc", "wlc", "lblc", "lblcr", "sh", "dh", "sed", "nq"}
+
+func (a Addon) kubeProxyConfigurationValues() (*kubeProxyConfigurationValues, error) {
+	var values kubeProxyConfigurationValues
+	if a.ConfigurationValues == "" {
+		return &values, nil
+	}
+	if err := yaml.Unmarshal([]byte(a.ConfigurationValues), &values); err != nil {
+		return nil, fmt.Errorf("invalid configurationValues for addon %s: %w", KubeProxyAddon, err)
+	}
+
+	switch values.Mode {
+	case "", KubeProxyModeIPTables:
+		if values.IPVS != nil && values.IPVS.Scheduler != "" {
+			return nil, fmt.Errorf("configurationValues.ipvs.scheduler for addon %s can only be set when mode is %q", KubeProxyAddon, KubeProxyModeIPVS)
+		}
+	case KubeProxyModeIPVS:
+		if values.IPVS != nil && values.IPVS.Scheduler != "" && !utilsstrings.Contains(ipvsSchedulers, values.IPVS.Scheduler) {
+			return nil, fmt.Errorf("invalid value %q for configurationValues.ipvs.scheduler for addon %s; allowed are %s", values.IPVS.Scheduler, KubeProxyAddon, strings.Join(ipvsSchedulers, ", "))
+		}
+	default:
+		return nil, fmt.Errorf("invalid value %q for configurationValues.mode for addon %s; allowed are %s and %s", values.Mode, KubeProxyAddon, KubeProxyModeIPTables, KubeProxyModeIPVS)
+	}
+	return &values, nil
+}
+
+// coreDNSConfigurationValues holds the subset of the coredns addon configuration schema that eksctl validates
+// before creating the addon; any other properties are passed through to EKS as-is
+type coreDNSConfigurationValues struct {
+	ReplicaCount        *int `json:"replicaCount,omitempty"`
+	PodDisruptionBudget *struct {
+		Enabled        *bool               `json:"enabled,omitempty"`
+		MinAvailable   *intstr.IntOrString `json:"minAvailable,omitempty"`
+		MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	} `json:"podDisruptionBudget,omitempty"`
+	Corefile string `json:"corefile,omitempty"`
+}
+
+func (a Addon) validateCoreDNSConfigurationValues() error {
+	if a.ConfigurationValues == "" {
+		return nil
+	}
+	var values coreDNSConfigurationValues
+	if err := yaml.Unmarshal([]byte(a.ConfigurationValues), &values); err != nil {
+		return fmt.Errorf("invalid configurationValues for addon %s: %w", CoreDNSAddon, err)
+	}
+
+	if values.ReplicaCount != nil && *values.ReplicaCount < 1 {
+		return fmt.Errorf("configurationValues.replicaCount for addon %s must be at least 1", CoreDNSAddon)
+	}
+
+	if pdb := values.PodDisruptionBudget; pdb != nil {
+		if pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+			return fmt.Errorf("only one of configurationValues.podDisruptionBudget.minAvailable and maxUnavailable can be set for addon %s", CoreDNSAddon)
+		}
+		if pdb.MinAvailable != nil && pdb.MinAvailable.Type == intstr.Int && values.ReplicaCount != nil && pdb.MinAvailable.IntValue() >= *values.ReplicaCount {
+			return fmt.Errorf("configurationValues.podDisruptionBudget.minAvailable (%d) for addon %s must be less than replicaCount (%d), otherwise CoreDNS pods can never be evicted",
+				pdb.MinAvailable.IntValue(), CoreDNSAddon, *values.ReplicaCount)
+		}
+	}
+
+	if values.Corefile != "" {
+		if err := validateCorefile(values.Corefile); err != nil {
+			return fmt.Errorf("invalid configurationValues.corefile for addon %s: %w", CoreDNSAddon, err)
+		}
+	}
+	return nil
+}
+
+// validateCorefile performs a basic structural check of a Corefile, ensuring it defines
+// at least one server block and that all blocks are closed
+func validateCorefile(corefile string) error {
+	depth, blocks := 0, 0
+	for _, line := range strings.Split(corefile, "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		for _, c := range line {
+			switch c {
+			case '{':
+				if depth == 0 {
+					blocks++
+				}
+				depth++
+			case '}':
+				depth--
+				if depth < 0 {
+					return errors.New("unexpected closing brace")
+				}
+			}
+		}
+	}
+	if depth != 0 {
+		return errors.New("unclosed server block")
+	}
+	if blocks == 0 {
+		return errors.New("at least one server block must be defined")
+	}
+	return nil
+}
+
 func (a *Addon) convertConfigurationValuesToJSON() (err error) {
 	rawConfigurationValues := []byte(a.ConfigurationValues)
 	var js map[string]interface{}
@@ -44,6 +44,63 @@ var _ = Describe("Addon", func() {
 			Entry("non-empty yaml", "replicaCount: 3"),
 		)
 
+		DescribeTable("when coredns configurationValues are set",
+			func(configurationValues, expectedErr string) {
+				err := v1alpha5.Addon{
+					Name:                v1alpha5.CoreDNSAddon,
+					ConfigurationValues: configurationValues,
+				}.Validate()
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+				}
+			},
+			Entry("valid replica count and PDB", `{"replicaCount":3,"podDisruptionBudget":{"enabled":true,"maxUnavailable":1}}`, ""),
+			Entry("valid Corefile with a stub domain", "corefile: |\n  .:53 {\n    forward . /etc/resolv.conf\n  }\n  corp.example.com:53 {\n    forward . 10.0.0.2\n  }\n", ""),
+			Entry("zero replicas", "replicaCount: 0", "replicaCount for addon coredns must be at least 1"),
+			Entry("both minAvailable and maxUnavailable", "podDisruptionBudget:\n  minAvailable: 1\n  maxUnavailable: 1", "only one of configurationValues.podDisruptionBudget.minAvailable and maxUnavailable"),
+			Entry("minAvailable not less than replicaCount", "replicaCount: 2\npodDisruptionBudget:\n  minAvailable: 2", "must be less than replicaCount (2)"),
+			Entry("percentage minAvailable", "replicaCount: 2\npodDisruptionBudget:\n  minAvailable: 50%", ""),
+			Entry("unclosed server block", "corefile: |\n  .:53 {\n    forward . /etc/resolv.conf\n", "unclosed server block"),
+			Entry("Corefile without a server block", "corefile: forward . /etc/resolv.conf", "at least one server block must be defined"),
+		)
+
+		DescribeTable("when kube-proxy configurationValues are set",
+			func(configurationValues, expectedErr string) {
+				err := v1alpha5.Addon{
+					Name:                v1alpha5.KubeProxyAddon,
+					ConfigurationValues: configurationValues,
+				}.Validate()
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+				}
+			},
+			Entry("iptables mode", `{"mode":"iptables"}`, ""),
+			Entry("ipvs mode with a scheduler", "mode: ipvs\nipvs:\n  scheduler: lc", ""),
+			Entry("unknown mode", "mode: nftables", `invalid value "nftables" for configurationValues.mode for addon kube-proxy; allowed are iptables and ipvs`),
+			Entry("unknown ipvs scheduler", "mode: ipvs\nipvs:\n  scheduler: fifo", `invalid value "fifo" for configurationValues.ipvs.scheduler`),
+			Entry("ipvs scheduler without ipvs mode", "ipvs:\n  scheduler: rr", `can only be set when mode is "ipvs"`),
+		)
+
+		DescribeTable("validating against the cluster version",
+			func(addon v1alpha5.Addon, clusterVersion, expectedErr string) {
+				err := addon.ValidateForClusterVersion(clusterVersion)
+				if expectedErr == "" {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(expectedErr))
+				}
+			},
+			Entry("kube-proxy mode on a supported version", v1alpha5.Addon{Name: v1alpha5.KubeProxyAddon, ConfigurationValues: "mode: ipvs"}, v1alpha5.Version1_24, ""),
+			Entry("kube-proxy mode on an unsupported version", v1alpha5.Addon{Name: v1alpha5.KubeProxyAddon, ConfigurationValues: "mode: ipvs"}, v1alpha5.Version1_23,
+				"configurationValues.mode for addon kube-proxy requires Kubernetes version 1.24 or greater; got 1.23"),
+			Entry("kube-proxy without mode on an old version", v1alpha5.Addon{Name: v1alpha5.KubeProxyAddon, ConfigurationValues: `{"resources":{}}`}, v1alpha5.Version1_22, ""),
+			Entry("other addons", v1alpha5.Addon{Name: v1alpha5.CoreDNSAddon, ConfigurationValues: "replicaCount: 2"}, v1alpha5.Version1_22, ""),
+		)
+
 		When("specifying more than one of serviceAccountRoleARN, attachPolicyARNs, attachPolicy", func() {
 			It("errors", func() {
 				err := v1alpha5.Addon{
@@ -0,0 +1,181 @@
+package v1alpha5
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Values for `AutoModeNodePool`
+const (
+	AutoModeNodePoolGeneralPurpose = "general-purpose"
+	AutoModeNodePoolSystem         = "system"
+)
+
+// AutoModeDefaultNodeClass is the NodeClass created by EKS and used by the built-in node pools
+const AutoModeDefaultNodeClass = "default"
+
+// AutoModeConfig holds the configuration for EKS Auto Mode
+type AutoModeConfig struct {
+	// Enabled enables EKS Auto Mode, letting EKS manage compute, block storage
+	// and load balancing for the cluster
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// NodeRoleARN is the IAM role assigned to the nodes launched by Auto Mode.
+	// A role is created by eksctl when not set
+	// +optional
+	NodeRoleARN string `json:"nodeRoleARN,omitempty"`
+	// NodePools lists the built-in node pools to enable, defaulting to all of them.
+	// Valid entries are `AutoModeNodePool` constants
+	// +optional
+	NodePools *[]string `json:"nodePools,omitempty"`
+	// NodeClasses lists the NodeClasses managed by
+	// `eksctl create nodeclass` and `eksctl delete nodeclass`
+	// +optional
+	NodeClasses []AutoModeNodeClass `json:"nodeClasses,omitempty"`
+	// CustomNodePools lists the NodePools managed by
+	// `eksctl create nodepool` and `eksctl delete nodepool`
+	// +optional
+	CustomNodePools []AutoModeCustomNodePool `json:"customNodePools,omitempty"`
+}
+
+// AutoModeNodeClass holds the configuration of an Auto Mode NodeClass,
+// rendered as an `eks.amazonaws.com/v1` NodeClass
+type AutoModeNodeClass struct {
+	// +required
+	Name string `json:"name"`
+	// Spec is the NodeClass spec, see
+	// https://docs.aws.amazon.com/eks/latest/userguide/create-node-class.html
+	// +required
+	Spec InlineDocument `json:"spec"`
+}
+
+// AutoModeCustomNodePool holds the configuration of an Auto Mode NodePool,
+// rendered as a `karpenter.sh/v1` NodePool
+type AutoModeCustomNodePool struct {
+	// +required
+	Name string `json:"name"`
+	// Spec is the NodePool spec, see
+	// https://docs.aws.amazon.com/eks/latest/userguide/create-node-pool.html
+	// +required
+	Spec InlineDocument `json:"spec"`
+}
+
+// SupportedAutoModeNodePools returns the built-in node pools provided by Auto Mode
+func SupportedAutoModeNodePools() []string {
+	return []string{AutoModeNodePoolGeneralPurpose, AutoModeNodePoolSystem}
+}
+
+// IsAutoModeEnabled determines if EKS Auto Mode is enabled for the cluster
+func (c *ClusterConfig) IsAutoModeEnabled() bool {
+	return c.AutoModeConfig != nil && IsEnabled(c.AutoModeConfig.Enabled)
+}
+
+// HasAutoModeNodePools determines if any built-in Auto Mode node pools are enabled
+func (a *AutoModeConfig) HasAutoModeNodePools() bool {
+	return a.NodePools != nil && len(*a.NodePools) > 0
+}
+
+func setAutoModeDefaults(autoModeConfig *AutoModeConfig) {
+	if IsEnabled(autoModeConfig.Enabled) && autoModeConfig.NodePools == nil {
+		autoModeConfig.NodePools = &[]string{AutoModeNodePoolGeneralPurpose, AutoModeNodePoolSystem}
+	}
+}
+
+// ValidateAutoModeConfig validates the autoModeConfig section of the cluster config
+func ValidateAutoModeConfig(cfg *ClusterConfig) error {
+	autoModeConfig := cfg.AutoModeConfig
+	if autoModeConfig == nil {
+		return nil
+	}
+	if !IsEnabled(autoModeConfig.Enabled) {
+		if autoModeConfig.NodeRoleARN != "" || autoModeConfig.NodePools != nil {
+			return errors.New("autoModeConfig.nodeRoleARN and autoModeConfig.nodePools can only be set when autoModeConfig.enabled is true")
+		}
+		if len(autoModeConfig.NodeClasses) > 0 || len(autoModeConfig.CustomNodePools) > 0 {
+			return errors.New("autoModeConfig.nodeClasses and autoModeConfig.customNodePools can only be set when autoModeConfig.enabled is true")
+		}
+		return nil
+	}
+
+	if cfg.IsControlPlaneOnOutposts() {
+		return errors.New("Auto Mode is not supported on Outposts")
+	}
+	if cfg.Karpenter != nil {
+		return errors.New("karpenter cannot be installed on an Auto Mode cluster, Auto Mode manages compute using its own built-in Karpenter")
+	}
+
+	if autoModeConfig.NodeRoleARN != "" {
+		if _, err := arn.Parse(autoModeConfig.NodeRoleARN); err != nil {
+			return fmt.Errorf("invalid autoModeConfig.nodeRoleARN %q: %w", autoModeConfig.NodeRoleARN, err)
+		}
+		if !autoModeConfig.HasAutoModeNodePools() {
+			return errors.New("autoModeConfig.nodeRoleARN cannot be set when autoModeConfig.nodePools is empty")
+		}
+	}
+
+	if autoModeConfig.NodePools != nil {
+		seen := map[string]bool{}
+		for i, nodePool := range *autoModeConfig.NodePools {
+			path := fmt.Sprintf("autoModeConfig.nodePools[%d]", i)
+			if !isSupportedAutoModeNodePool(nodePool) {
+				return fmt.Errorf("%s: unsupported node pool %q; supported values are %v", path, nodePool, SupportedAutoModeNodePools())
+			}
+			if seen[nodePool] {
+				return fmt.Errorf("%s: duplicate node pool %q", path, nodePool)
+			}
+			seen[nodePool] = true
+		}
+	}
+
+	nodeClassNames := map[string]bool{}
+	for i, nodeClass := range autoModeConfig.NodeClasses {
+		path := fmt.Sprintf("autoModeConfig.nodeClasses[%d]", i)
+		if err := validateAutoModeResource(path, nodeClass.Name, nodeClass.Spec, nodeClassNames); err != nil {
+			return err
+		}
+		if nodeClass.Name == AutoModeDefaultNodeClass {
+			return fmt.Errorf("%s.name: %q is reserved for the NodeClass managed by EKS", path, nodeClass.Name)
+		}
+	}
+	nodePoolNames := map[string]bool{}
+	for i, nodePool := range autoModeConfig.CustomNodePools {
+		path := fmt.Sprintf("autoModeConfig.customNodePools[%d]", i)
+		if err := validateAutoModeResource(path, nodePool.Name, nodePool.Spec, nodePoolNames); err != nil {
+			return err
+		}
+		if isSupportedAutoModeNodePool(nodePool.Name) {
+			return fmt.Errorf("%s.name: %q is reserved for a built-in node pool", path, nodePool.Name)
+		}
+	}
+	return nil
+}
+
+func validateAutoModeResource(path, name string, spec InlineDocument, seen map[string]bool) error {
+	if name == "" {
+		return fmt.Errorf("%s.name must be set", path)
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("%s.name: invalid name %q: %s", path, name, strings.Join(errs, ", "))
+	}
+	if seen[name] {
+		return fmt.Errorf("%s.name: duplicate name %q", path, name)
+	}
+	seen[name] = true
+	if len(spec) == 0 {
+		return fmt.Errorf("%s.spec must be set", path)
+	}
+	return nil
+}
+
+func isSupportedAutoModeNodePool(nodePool string) bool {
+	for _, np := range SupportedAutoModeNodePools() {
+		if nodePool == np {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,315 @@
+package v1alpha5
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+)
+
+// Values for `awsClient.retry.mode`
+const (
+	// AWSRetryModeStandard retries the calls that failed with a retryable error, with an exponential backoff
+	AWSRetryModeStandard = "standard"
+	// AWSRetryModeAdaptive also limits the rate of the calls once they are throttled
+	AWSRetryModeAdaptive = "adaptive"
+)
+
+// AWSClientConfig holds the configuration of the clients of the AWS APIs
+type AWSClientConfig struct {
+	// Retry configures how the calls to the AWS APIs are retried, e.g. to
+	// avoid being throttled when creating many clusters in parallel
+	// +optional
+	Retry *AWSRetryConfig `json:"retry,omitempty"`
+
+	// Endpoints overrides the endpoints of the AWS APIs, e.g. to use
+	// LocalStack or VPC endpoints
+	// +optional
+	Endpoints *AWSEndpoints `json:"endpoints,omitempty"`
+
+	// Profile is the AWS credentials profile whose credentials assume the
+	// first of AssumeRoles. It is overridden by `--profile`, but takes
+	// precedence over the `AWS_PROFILE` environment variable
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// AssumeRoles is a chain of roles, each assumed with the credentials of
+	// the previous one, the first one with the credentials of Profile.
+	// eksctl uses the credentials of the last role
+	// +optional
+	AssumeRoles []AWSAssumeRole `json:"assumeRoles,omitempty"`
+
+	// RoleSession sets the session of the roles eksctl assumes, so that
+	// CloudTrail attributes their calls to whoever ran eksctl
+	// +optional
+	RoleSession *AWSRoleSessionConfig `json:"roleSession,omitempty"`
+}
+
+// AWSRoleSessionConfig holds the session of the roles eksctl assumes: those of
+// the AWS credentials profile, of AssumeRoles and the roles owning the private
+// hosted zones of other accounts
+type AWSRoleSessionConfig struct {
+	// Name is the role session name, shown in the ARN of the assumed role in
+	// CloudTrail events. It is overridden by `--role-session-name`
+	// +optional
+	Name string `json:"name,omitempty"`
+	// SourceIdentity is recorded in CloudTrail events and kept through role
+	// chaining, the trust policies of the roles must allow
+	// `sts:SetSourceIdentity`. It is overridden by `--source-identity`
+	// +optional
+	SourceIdentity string `json:"sourceIdentity,omitempty"`
+	// Tags are the session tags, the trust policies of the roles must allow
+	// `sts:TagSession`
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// AWSAssumeRole is a role assumed by eksctl
+type AWSAssumeRole struct {
+	// +required
+	RoleARN string `json:"roleARN"`
+	// +optional
+	ExternalID string `json:"externalID,omitempty"`
+	// MFASerial is the serial number or ARN of the MFA device required to
+	// assume the role, eksctl prompts for its code and caches the
+	// credentials of the chain until they expire so that it is not
+	// prompted for again
+	// +optional
+	MFASerial string `json:"mfaSerial,omitempty"`
+	// Duration is how long the credentials of the role are valid for,
+	// between `15m` and `12h`. Roles assumed with the credentials of another
+	// role are limited to `1h` by STS.
+	// Defaults to `"1h"`
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// AWSRetryConfig holds the retry behaviour of the AWS clients. Unset fields
+// default to the `AWS_RETRY_MODE` and `AWS_MAX_ATTEMPTS` environment
+// variables, or the AWS config file, and to `EKSCTL_RETRY_BASE_BACKOFF`
+type AWSRetryConfig struct {
+	// Valid variants are: `"standard"`, `"adaptive"`. The adaptive mode
+	// also limits the rate of the calls once they are throttled, it is
+	// always used for CloudFormation calls unless the mode is set.
+	// Defaults to `"standard"`
+	// +optional
+	Mode string `json:"mode,omitempty"`
+	// MaxAttempts is the maximum number of attempts of each call, including
+	// the first one.
+	// Defaults to `13`
+	// +optional
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+	// BaseBackoff is a duration such as `500ms` scaling the exponential
+	// backoff of the retries: the delay before the nth retry is a random
+	// duration up to BaseBackoff * 2^n, capped at 20s.
+	// Defaults to `"1s"`
+	// +optional
+	BaseBackoff string `json:"baseBackoff,omitempty"`
+}
+
+// AWSEndpoints holds the URLs of the endpoints of the AWS APIs, such as
+// `http://localhost:4566` or `https://vpce-0a1b2c3d.eks.us-west-2.vpce.amazonaws.com`.
+// They take precedence over the `AWS_EKS_ENDPOINT`, `AWS_CLOUDFORMATION_ENDPOINT`,
+// `AWS_EC2_ENDPOINT`, `AWS_IAM_ENDPOINT` and `AWS_STS_ENDPOINT` environment variables
+type AWSEndpoints struct {
+	// +optional
+	EKS string `json:"eks,omitempty"`
+	// +optional
+	CloudFormation string `json:"cloudFormation,omitempty"`
+	// +optional
+	EC2 string `json:"ec2,omitempty"`
+	// +optional
+	IAM string `json:"iam,omitempty"`
+	// +optional
+	STS string `json:"sts,omitempty"`
+}
+
+// DefaultAssumeRoleDuration is how long the credentials of assumed roles are valid for by default
+const DefaultAssumeRoleDuration = time.Hour
+
+// DurationOrDefault returns the duration of the credentials of the role. The duration must have been validated.
+func (r *AWSAssumeRole) DurationOrDefault() time.Duration {
+	if r.Duration == "" {
+		return DefaultAssumeRoleDuration
+	}
+	duration, _ := time.ParseDuration(r.Duration)
+	return duration
+}
+
+// RequireMFA returns true if any of roles requires an MFA code to be assumed
+func RequireMFA(roles []AWSAssumeRole) bool {
+	for _, role := range roles {
+		if role.MFASerial != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSet returns true if any of the session options is set
+func (s *AWSRoleSessionConfig) IsSet() bool {
+	return s.Name != "" || s.SourceIdentity != "" || len(s.Tags) > 0
+}
+
+// ApplyTo sets the session options on the options of a role to assume
+func (s *AWSRoleSessionConfig) ApplyTo(o *stscreds.AssumeRoleOptions) {
+	if s.Name != "" {
+		o.RoleSessionName = s.Name
+	}
+	if s.SourceIdentity != "" {
+		o.SourceIdentity = aws.String(s.SourceIdentity)
+	}
+	if len(s.Tags) > 0 {
+		keys := make([]string, 0, len(s.Tags))
+		for key := range s.Tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		o.Tags = nil
+		for _, key := range keys {
+			o.Tags = append(o.Tags, ststypes.Tag{
+				Key:   aws.String(key),
+				Value: aws.String(s.Tags[key]),
+			})
+		}
+	}
+}
+
+// BaseBackoffDuration returns the base backoff, or 0 if it is not set. The base backoff must have been validated.
+func (r *AWSRetryConfig) BaseBackoffDuration() time.Duration {
+	if r.BaseBackoff == "" {
+		return 0
+	}
+	baseBackoff, _ := time.ParseDuration(r.BaseBackoff)
+	return baseBackoff
+}
+
+// ValidateAWSRetryConfig validates the retry behaviour of the AWS clients
+func ValidateAWSRetryConfig(r *AWSRetryConfig) error {
+	if r == nil {
+		return nil
+	}
+	switch r.Mode {
+	case "", AWSRetryModeStandard, AWSRetryModeAdaptive:
+	default:
+		return fmt.Errorf("invalid retry mode %q, valid options: %s, %s", r.Mode, AWSRetryModeStandard, AWSRetryModeAdaptive)
+	}
+	if r.MaxAttempts < 0 {
+		return errors.New("retry max attempts must be positive")
+	}
+	if r.BaseBackoff != "" {
+		baseBackoff, err := time.ParseDuration(r.BaseBackoff)
+		if err != nil {
+			return fmt.Errorf("invalid retry base backoff: %w", err)
+		}
+		if baseBackoff <= 0 {
+			return errors.New("retry base backoff must be positive")
+		}
+	}
+	return nil
+}
+
+func validateAWSEndpoints(e *AWSEndpoints) error {
+	if e == nil {
+		return nil
+	}
+	for _, endpoint := range []struct {
+		field, url string
+	}{
+		{"eks", e.EKS},
+		{"cloudFormation", e.CloudFormation},
+		{"ec2", e.EC2},
+		{"iam", e.IAM},
+		{"sts", e.STS},
+	} {
+		if endpoint.url == "" {
+			continue
+		}
+		if u, err := url.Parse(endpoint.url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("awsClient.endpoints.%s %q must be an http(s):// URL", endpoint.field, endpoint.url)
+		}
+	}
+	return nil
+}
+
+func validateAWSAssumeRoles(roles []AWSAssumeRole) error {
+	for i, role := range roles {
+		path := fmt.Sprintf("awsClient.assumeRoles[%d]", i)
+		if role.RoleARN == "" {
+			return fmt.Errorf("%s.roleARN must be set", path)
+		}
+		if _, err := arn.Parse(role.RoleARN); err != nil {
+			return fmt.Errorf("%s.roleARN %q is not a valid ARN: %w", path, role.RoleARN, err)
+		}
+		if role.Duration != "" {
+			duration, err := time.ParseDuration(role.Duration)
+			if err != nil {
+				return fmt.Errorf("invalid %s.duration: %w", path, err)
+			}
+			if duration < 15*time.Minute || duration > 12*time.Hour {
+				return fmt.Errorf("%s.duration must be between 15m and 12h", path)
+			}
+		}
+	}
+	return nil
+}
+
+var (
+	roleSessionValueRegex = regexp.MustCompile(`^[\w+=,.@-]{2,64}$`)
+	sessionTagKeyRegex    = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]{1,128}$`)
+	sessionTagValueRegex  = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]{0,256}$`)
+)
+
+// ValidateAWSRoleSessionConfig validates the session of the roles eksctl assumes
+func ValidateAWSRoleSessionConfig(s *AWSRoleSessionConfig) error {
+	if s == nil {
+		return nil
+	}
+	if s.Name != "" && !roleSessionValueRegex.MatchString(s.Name) {
+		return fmt.Errorf("invalid role session name %q, it must have 2 to 64 letters, digits or any of _+=,.@-", s.Name)
+	}
+	if s.SourceIdentity != "" {
+		if !roleSessionValueRegex.MatchString(s.SourceIdentity) || strings.HasPrefix(strings.ToLower(s.SourceIdentity), "aws:") {
+			return fmt.Errorf("invalid source identity %q, it must have 2 to 64 letters, digits or any of _+=,.@- and not start with aws:", s.SourceIdentity)
+		}
+	}
+	if len(s.Tags) > 50 {
+		return errors.New("at most 50 session tags can be set")
+	}
+	for key, value := range s.Tags {
+		if !sessionTagKeyRegex.MatchString(key) || strings.HasPrefix(strings.ToLower(key), "aws:") {
+			return fmt.Errorf("invalid session tag key %q", key)
+		}
+		if !sessionTagValueRegex.MatchString(value) {
+			return fmt.Errorf("invalid value %q of session tag %q", value, key)
+		}
+	}
+	return nil
+}
+
+func validateAWSClient(c *AWSClientConfig) error {
+	if c == nil {
+		return nil
+	}
+	if err := ValidateAWSRetryConfig(c.Retry); err != nil {
+		return fmt.Errorf("awsClient.retry: %w", err)
+	}
+	if err := validateAWSEndpoints(c.Endpoints); err != nil {
+		return err
+	}
+	if err := validateAWSAssumeRoles(c.AssumeRoles); err != nil {
+		return err
+	}
+	if err := ValidateAWSRoleSessionConfig(c.RoleSession); err != nil {
+		return fmt.Errorf("awsClient.roleSession: %w", err)
+	}
+	return nil
+}
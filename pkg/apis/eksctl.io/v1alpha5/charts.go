@@ -0,0 +1,121 @@
+package v1alpha5
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// DefaultHelmChartNamespace is the namespace Helm charts are installed into unless charts[].namespace is set
+	DefaultHelmChartNamespace = "default"
+	// DefaultHelmChartTimeout is the timeout for installing Helm charts unless charts[].timeout is set
+	DefaultHelmChartTimeout = 10 * time.Minute
+
+	// maxHelmReleaseNameLen is the maximum length of Helm release names
+	maxHelmReleaseNameLen = 53
+)
+
+// HelmChart holds the configuration of a Helm chart installed once the cluster
+// and its nodegroups are created
+type HelmChart struct {
+	// Name of the Helm release
+	// +required
+	Name string `json:"name"`
+	// Repository is the URL of the chart repository, either an HTTP(S) URL
+	// or an `oci://` registry URL
+	// +required
+	Repository string `json:"repository"`
+	// Chart is the name of the chart in the repository
+	// +required
+	Chart string `json:"chart"`
+	// +required
+	Version string `json:"version"`
+	// Namespace the release is installed into, created if it does not exist.
+	// Defaults to `"default"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Values passed to the chart
+	// +optional
+	Values InlineDocument `json:"values,omitempty"`
+	// Wait for the resources of the release to be ready before installing
+	// the next chart.
+	// Defaults to `true`
+	// +optional
+	Wait *bool `json:"wait,omitempty"`
+	// Timeout for installing the release, as a duration such as `5m`.
+	// Defaults to `"10m"`
+	// +optional
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// ReleaseNamespace returns the namespace the release is installed into
+func (h *HelmChart) ReleaseNamespace() string {
+	if h.Namespace != "" {
+		return h.Namespace
+	}
+	return DefaultHelmChartNamespace
+}
+
+// InstallTimeout returns the timeout for installing the release. The timeout must have been validated.
+func (h *HelmChart) InstallTimeout() time.Duration {
+	if h.Timeout == "" {
+		return DefaultHelmChartTimeout
+	}
+	timeout, _ := time.ParseDuration(h.Timeout)
+	return timeout
+}
+
+// HasCharts returns true if Helm charts are set in the charts section
+func (c *ClusterConfig) HasCharts() bool {
+	return len(c.Charts) > 0
+}
+
+func validateCharts(charts []*HelmChart) error {
+	releases := map[string]bool{}
+	for i, chart := range charts {
+		path := fmt.Sprintf("charts[%d]", i)
+		if chart.Name == "" {
+			return fmt.Errorf("%s.name must be set", path)
+		}
+		if errs := validation.IsDNS1123Subdomain(chart.Name); len(errs) > 0 || len(chart.Name) > maxHelmReleaseNameLen {
+			return fmt.Errorf("%s.name %q is not a valid Helm release name: must be a lowercase RFC 1123 subdomain of at most %d characters", path, chart.Name, maxHelmReleaseNameLen)
+		}
+		release := chart.ReleaseNamespace() + "/" + chart.Name
+		if releases[release] {
+			return fmt.Errorf("%s: release %q is defined more than once in namespace %q", path, chart.Name, chart.ReleaseNamespace())
+		}
+		releases[release] = true
+
+		if chart.Repository == "" {
+			return fmt.Errorf("%s.repository must be set", path)
+		}
+		if !strings.HasPrefix(chart.Repository, "https://") && !strings.HasPrefix(chart.Repository, "http://") && !strings.HasPrefix(chart.Repository, "oci://") {
+			return fmt.Errorf("%s.repository must be an http(s):// or oci:// URL", path)
+		}
+		if chart.Chart == "" {
+			return fmt.Errorf("%s.chart must be set", path)
+		}
+		if chart.Version == "" {
+			return fmt.Errorf("%s.version must be set", path)
+		}
+		if chart.Namespace != "" {
+			if errs := validation.IsDNS1123Label(chart.Namespace); len(errs) > 0 {
+				return fmt.Errorf("%s.namespace %q is not a valid namespace: %s", path, chart.Namespace, strings.Join(errs, ", "))
+			}
+		}
+		if chart.Timeout != "" {
+			timeout, err := time.ParseDuration(chart.Timeout)
+			if err != nil {
+				return fmt.Errorf("%s.timeout: %w", path, err)
+			}
+			if timeout <= 0 {
+				return errors.New(path + ".timeout must be positive")
+			}
+		}
+	}
+	return nil
+}
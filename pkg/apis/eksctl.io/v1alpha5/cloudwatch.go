@@ -31,6 +31,10 @@ type ClusterCloudWatchLogging struct {
 	// 1827, and 3653.
 	//+optional
 	LogRetentionInDays int `json:"logRetentionInDays,omitempty"`
+	// LogGroupKMSKeyID is the ARN of the KMS key used to encrypt the control plane logs
+	// in CloudWatch. The key policy must allow the CloudWatch Logs service to use the key
+	//+optional
+	LogGroupKMSKeyID string `json:"logGroupKMSKeyID,omitempty"`
 }
 
 // SupportedCloudWatchClusterLogTypes returns all supported logging facilities
@@ -60,9 +60,33 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		cfg.VPC.ManageSharedNodeSecurityGroupRules = Enabled()
 	}
 
+	if cfg.VPC != nil && cfg.VPC.FlowLogs != nil {
+		setVPCFlowLogsDefaults(cfg.VPC.FlowLogs)
+	}
+
+	if cfg.VPC != nil {
+		SetControlPlaneSecurityGroupIngressDefaults(cfg.VPC.ControlPlaneSecurityGroupIngress)
+	}
+
 	if cfg.Karpenter != nil && cfg.Karpenter.CreateServiceAccount == nil {
 		cfg.Karpenter.CreateServiceAccount = Disabled()
 	}
+
+	if cfg.AutoModeConfig != nil {
+		setAutoModeDefaults(cfg.AutoModeConfig)
+	}
+}
+
+func setVPCFlowLogsDefaults(flowLogs *VPCFlowLogs) {
+	if flowLogs.DestinationType == "" {
+		flowLogs.DestinationType = FlowLogsDestinationCloudWatchLogs
+	}
+	if flowLogs.TrafficType == "" {
+		flowLogs.TrafficType = FlowLogsTrafficTypeAll
+	}
+	if flowLogs.MaxAggregationInterval == 0 {
+		flowLogs.MaxAggregationInterval = 600
+	}
 }
 
 // IAMServiceAccountsWithImplicitServiceAccounts adds implicitly created
@@ -337,6 +361,16 @@ func DefaultClusterNAT() *ClusterNAT {
 	}
 }
 
+// SetControlPlaneSecurityGroupIngressDefaults sets the port of the control plane security group ingress rules to 443
+// when it is not set
+func SetControlPlaneSecurityGroupIngressDefaults(rules []ControlPlaneSecurityGroupIngressRule) {
+	for i := range rules {
+		if rules[i].Port == 0 {
+			rules[i].Port = 443
+		}
+	}
+}
+
 // SetClusterEndpointAccessDefaults sets the default values for cluster endpoint access
 func SetClusterEndpointAccessDefaults(vpc *ClusterVPC) {
 	endpointAccess := ClusterEndpointAccessDefaults()
@@ -74,6 +74,21 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Context("VPC flow logs", func() {
+		It("should default to all traffic delivered to CloudWatch Logs", func() {
+			cfg := NewClusterConfig()
+			cfg.VPC.FlowLogs = &VPCFlowLogs{}
+
+			SetClusterConfigDefaults(cfg)
+
+			Expect(*cfg.VPC.FlowLogs).To(Equal(VPCFlowLogs{
+				DestinationType:        FlowLogsDestinationCloudWatchLogs,
+				TrafficType:            FlowLogsTrafficTypeAll,
+				MaxAggregationInterval: 600,
+			}))
+		})
+	})
+
 	Context("SSH settings", func() {
 
 		It("Providing an SSH key enables SSH when SSH.Allow not set", func() {
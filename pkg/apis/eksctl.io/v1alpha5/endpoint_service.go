@@ -11,6 +11,7 @@ type EndpointService struct {
 	// Optional specifies whether the service is optional.
 	Optional bool
 	// OutpostsOnly specifies whether the endpoint is required only for Outposts clusters.
+	// For other clusters, the endpoint can be enabled via `privateCluster.additionalEndpointServices`.
 	OutpostsOnly bool
 	// RequiresChinaPrefix is true if the endpoint service requires a prefix for China regions.
 	RequiresChinaPrefix bool
@@ -75,6 +76,16 @@ var EndpointServices = []EndpointService{
 	EndpointServiceCloudWatch,
 }
 
+// ServiceName returns the name of the endpoint service in the specified region,
+// e.g. `com.amazonaws.us-west-2.ecr.api`.
+func (e EndpointService) ServiceName(region string) string {
+	serviceName := fmt.Sprintf("com.amazonaws.%s.%s", region, e.Name)
+	if e.RequiresChinaPrefix && Partition(region) == PartitionChina {
+		serviceName = "cn." + serviceName
+	}
+	return serviceName
+}
+
 // RequiredEndpointServices returns a list of endpoint services that are required for a fully-private cluster.
 func RequiredEndpointServices(controlPlaneOnOutposts bool) []EndpointService {
 	var requiredServices []EndpointService
@@ -110,7 +121,7 @@ func MapOptionalEndpointServices(endpointServiceNames []string, cloudWatchLoggin
 func getOptionalEndpointServices() map[string]EndpointService {
 	ret := map[string]EndpointService{}
 	for _, es := range EndpointServices {
-		if es.Optional {
+		if es.Optional || es.OutpostsOnly {
 			ret[es.Name] = es
 		}
 	}
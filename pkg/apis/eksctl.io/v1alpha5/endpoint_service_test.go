@@ -79,6 +79,18 @@ var _ = Describe("Endpoint Service", func() {
 				"logs",
 			},
 		}),
+		Entry("SSM endpoint services", optionalEndpointEntry{
+			endpointServiceNames: []string{
+				"ssm",
+				"ssmmessages",
+				"ec2messages",
+			},
+			expectedEndpointServiceNames: []string{
+				"ssm",
+				"ssmmessages",
+				"ec2messages",
+			},
+		}),
 		Entry("invalid endpoint services", optionalEndpointEntry{
 			endpointServiceNames: []string{
 				"cloudformation",
@@ -114,4 +126,11 @@ var _ = Describe("Endpoint Service", func() {
 		}),
 	)
 
+	DescribeTable("Service name", func(endpointService api.EndpointService, region, expectedServiceName string) {
+		Expect(endpointService.ServiceName(region)).To(Equal(expectedServiceName))
+	},
+		Entry("standard region", api.EndpointServiceS3, "us-west-2", "com.amazonaws.us-west-2.s3"),
+		Entry("China region without prefix", api.EndpointServiceS3, "cn-north-1", "com.amazonaws.cn-north-1.s3"),
+		Entry("China region with prefix", api.EndpointService{Name: "ecr.api", RequiresChinaPrefix: true}, "cn-north-1", "cn.com.amazonaws.cn-north-1.ecr.api"),
+	)
 })
@@ -90,6 +90,36 @@ var _ = Describe("Outposts validation", func() {
 			expectedErr: "KMS encryption is not supported on Outposts",
 		}),
 
+		Entry("zonal shift", outpostsEntry{
+			updateDefaultConfig: func(c *api.ClusterConfig) {
+				c.ZonalShiftConfig = &api.ZonalShiftConfig{
+					Enabled: api.Enabled(),
+				}
+			},
+
+			expectedErr: "zonal shift is not supported on Outposts",
+		}),
+
+		Entry("upgrade policy", outpostsEntry{
+			updateDefaultConfig: func(c *api.ClusterConfig) {
+				c.UpgradePolicy = &api.UpgradePolicy{
+					SupportType: api.SupportTypeStandard,
+				}
+			},
+
+			expectedErr: "upgradePolicy is not supported on Outposts",
+		}),
+
+		Entry("Auto Mode", outpostsEntry{
+			updateDefaultConfig: func(c *api.ClusterConfig) {
+				c.AutoModeConfig = &api.AutoModeConfig{
+					Enabled: api.Enabled(),
+				}
+			},
+
+			expectedErr: "Auto Mode is not supported on Outposts",
+		}),
+
 		Entry("Availability Zones", outpostsEntry{
 			updateDefaultConfig: func(c *api.ClusterConfig) {
 				c.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
@@ -0,0 +1,173 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// rbacGroupPrefix prefixes the default group of bindings
+const rbacGroupPrefix = "eksctl:rbac:"
+
+// RBAC holds the cluster roles and bindings created with the cluster, so that
+// baseline roles exist before anyone connects to it
+type RBAC struct {
+	// ClusterRoles are created with the cluster, or updated when they exist
+	// +optional
+	ClusterRoles []*RBACClusterRole `json:"clusterRoles,omitempty"`
+	// Bindings bind cluster roles to groups, which IAM principals are mapped
+	// to in the `aws-auth` ConfigMap
+	// +optional
+	Bindings []*RBACBinding `json:"bindings,omitempty"`
+}
+
+// RBACClusterRole holds the configuration of a ClusterRole
+type RBACClusterRole struct {
+	// +required
+	Name string `json:"name"`
+	// +required
+	Rules []RBACPolicyRule `json:"rules"`
+}
+
+// RBACPolicyRule holds a rule of a ClusterRole, with the fields of a Kubernetes PolicyRule
+type RBACPolicyRule struct {
+	// APIGroups of the resources, `""` is the core API group
+	// +optional
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+	// +optional
+	ResourceNames []string `json:"resourceNames,omitempty"`
+	// NonResourceURLs such as `/healthz`, only meaningful without resources
+	// +optional
+	NonResourceURLs []string `json:"nonResourceURLs,omitempty"`
+	// +required
+	Verbs []string `json:"verbs"`
+}
+
+// RBACBinding binds a cluster role to a group, cluster-wide or in namespaces
+type RBACBinding struct {
+	// Name of the ClusterRoleBinding, or of the RoleBinding in each namespace
+	// +required
+	Name string `json:"name"`
+	// ClusterRole bound, either one of rbac.clusterRoles or an existing one
+	// such as `view`
+	// +required
+	ClusterRole string `json:"clusterRole"`
+	// Namespaces the cluster role is bound in. The cluster role is bound
+	// cluster-wide when empty
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Group bound to the cluster role.
+	// Defaults to `"eksctl:rbac:<name>"`
+	// +optional
+	Group string `json:"group,omitempty"`
+	// IAMPrincipals are ARNs of IAM users and roles mapped to the group in
+	// the `aws-auth` ConfigMap
+	// +optional
+	IAMPrincipals []string `json:"iamPrincipals,omitempty"`
+}
+
+// SubjectGroup returns the group bound to the cluster role
+func (b *RBACBinding) SubjectGroup() string {
+	if b.Group != "" {
+		return b.Group
+	}
+	return rbacGroupPrefix + b.Name
+}
+
+// HasRBAC returns true if cluster roles or bindings are set in the rbac section
+func (c *ClusterConfig) HasRBAC() bool {
+	return c.RBAC != nil && (len(c.RBAC.ClusterRoles) > 0 || len(c.RBAC.Bindings) > 0)
+}
+
+func validateRBAC(rbac *RBAC, identityMappings []*IAMIdentityMapping) error {
+	if rbac == nil {
+		return nil
+	}
+
+	clusterRoles := map[string]bool{}
+	for i, clusterRole := range rbac.ClusterRoles {
+		path := fmt.Sprintf("rbac.clusterRoles[%d]", i)
+		if err := validateRBACName(path, clusterRole.Name); err != nil {
+			return err
+		}
+		if clusterRoles[clusterRole.Name] {
+			return fmt.Errorf("%s: cluster role %q is defined more than once", path, clusterRole.Name)
+		}
+		clusterRoles[clusterRole.Name] = true
+
+		if len(clusterRole.Rules) == 0 {
+			return fmt.Errorf("%s.rules must be set", path)
+		}
+		for j, rule := range clusterRole.Rules {
+			rulePath := fmt.Sprintf("%s.rules[%d]", path, j)
+			if len(rule.Verbs) == 0 {
+				return fmt.Errorf("%s.verbs must be set", rulePath)
+			}
+			if len(rule.Resources) == 0 && len(rule.NonResourceURLs) == 0 {
+				return fmt.Errorf("%s: one of resources or nonResourceURLs must be set", rulePath)
+			}
+			if len(rule.Resources) > 0 && len(rule.NonResourceURLs) > 0 {
+				return fmt.Errorf("%s: resources and nonResourceURLs cannot be set in the same rule", rulePath)
+			}
+		}
+	}
+
+	mappedARNs := map[string]bool{}
+	for _, mapping := range identityMappings {
+		if mapping.ARN != "" {
+			mappedARNs[mapping.ARN] = true
+		}
+	}
+
+	bindings := map[string]bool{}
+	for i, binding := range rbac.Bindings {
+		path := fmt.Sprintf("rbac.bindings[%d]", i)
+		if err := validateRBACName(path, binding.Name); err != nil {
+			return err
+		}
+		if bindings[binding.Name] {
+			return fmt.Errorf("%s: binding %q is defined more than once", path, binding.Name)
+		}
+		bindings[binding.Name] = true
+
+		if binding.ClusterRole == "" {
+			return fmt.Errorf("%s.clusterRole must be set", path)
+		}
+		for _, namespace := range binding.Namespaces {
+			if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+				return fmt.Errorf("%s.namespaces: %q is not a valid namespace: %s", path, namespace, strings.Join(errs, ", "))
+			}
+		}
+		if binding.Group == "" && len(binding.IAMPrincipals) == 0 {
+			return fmt.Errorf("%s: at least one of group or iamPrincipals must be set", path)
+		}
+		if strings.HasPrefix(binding.Group, "system:") {
+			return fmt.Errorf("%s.group %q cannot use the reserved system: prefix", path, binding.Group)
+		}
+		for _, principal := range binding.IAMPrincipals {
+			parsed, err := arn.Parse(principal)
+			if err != nil || !(strings.HasPrefix(parsed.Resource, "user/") || strings.HasPrefix(parsed.Resource, "role/")) {
+				return fmt.Errorf("%s.iamPrincipals: %q is not the ARN of an IAM user or role", path, principal)
+			}
+			if mappedARNs[principal] {
+				return fmt.Errorf("%s.iamPrincipals: %q is also set in iamIdentityMappings, add the group %q to that mapping instead", path, principal, binding.SubjectGroup())
+			}
+		}
+	}
+	return nil
+}
+
+func validateRBACName(path, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s.name must be set", path)
+	}
+	// RBAC objects are commonly named with colons, such as eks:viewer, which subdomains do not allow
+	if errs := validation.IsDNS1123Subdomain(strings.ReplaceAll(name, ":", "-")); len(errs) > 0 {
+		return fmt.Errorf("%s.name %q is not a valid name: %s", path, name, strings.Join(errs, ", "))
+	}
+	return nil
+}
@@ -3,7 +3,9 @@ package v1alpha5
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -256,6 +258,18 @@ const (
 	// EksctlVersionTag defines the version of eksctl which is used to provision or update EKS cluster
 	EksctlVersionTag = "alpha.eksctl.io/eksctl-version"
 
+	// CreatedByTag defines the tool which created a resource, its value is CreatedByTagValue
+	CreatedByTag = "alpha.eksctl.io/created-by"
+
+	// CreatedByTagValue is the value of CreatedByTag on the resources created by eksctl
+	CreatedByTagValue = "eksctl"
+
+	// ConfigHashTag defines the hash of the ClusterConfig a resource was created from
+	ConfigHashTag = "alpha.eksctl.io/config-hash"
+
+	// OwnerTag defines the ARN of the AWS identity which created a resource
+	OwnerTag = "alpha.eksctl.io/owner"
+
 	// ClusterNameTag defines the tag of the cluster name
 	ClusterNameTag = "alpha.eksctl.io/cluster-name"
 
@@ -402,10 +416,19 @@ const (
 // Values for core addons
 const (
 	minimumVPCCNIVersionForIPv6 = "1.10.0"
-	VPCCNIAddon                 = "vpc-cni"
-	KubeProxyAddon              = "kube-proxy"
-	CoreDNSAddon                = "coredns"
-	AWSEBSCSIDriverAddon        = "aws-ebs-csi-driver"
+	// minimumVersionForKubeProxyMode is the minimum cluster version whose kube-proxy addon accepts the `mode` configuration value
+	minimumVersionForKubeProxyMode = Version1_24
+	VPCCNIAddon                    = "vpc-cni"
+	KubeProxyAddon                 = "kube-proxy"
+	CoreDNSAddon                   = "coredns"
+	AWSEBSCSIDriverAddon           = "aws-ebs-csi-driver"
+	AWSEFSCSIDriverAddon           = "aws-efs-csi-driver"
+)
+
+// Values for kube-proxy proxy modes
+const (
+	KubeProxyModeIPTables = "iptables"
+	KubeProxyModeIPVS     = "ipvs"
 )
 
 // supported version of Karpenter
@@ -465,6 +488,20 @@ func IsSetAndNonEmptyString(s *string) bool { return s != nil && *s != "" }
 // IsEmpty will only return true if s is not nil and not empty
 func IsEmpty(s *string) bool { return !IsSetAndNonEmptyString(s) }
 
+// WithOwnershipTags returns a copy of tags along with CreatedByTag and, when owner is set, OwnerTag,
+// to tag the resources eksctl creates outside of a CloudFormation stack
+func WithOwnershipTags(tags map[string]string, owner string) map[string]string {
+	withOwnership := make(map[string]string, len(tags)+2)
+	for k, v := range tags {
+		withOwnership[k] = v
+	}
+	withOwnership[CreatedByTag] = CreatedByTagValue
+	if owner != "" {
+		withOwnership[OwnerTag] = owner
+	}
+	return withOwnership
+}
+
 // SupportedRegions are the regions where EKS is available
 func SupportedRegions() []string {
 	return []string{
@@ -502,6 +539,28 @@ func SupportedRegions() []string {
 	}
 }
 
+// FIPSRegions are the regions where EKS has FIPS endpoints
+func FIPSRegions() []string {
+	return []string{
+		RegionUSWest1,
+		RegionUSWest2,
+		RegionUSEast1,
+		RegionUSEast2,
+		RegionUSGovWest1,
+		RegionUSGovEast1,
+	}
+}
+
+// IsFIPSRegion returns true if EKS has FIPS endpoints in the given region
+func IsFIPSRegion(region string) bool {
+	for _, r := range FIPSRegions() {
+		if region == r {
+			return true
+		}
+	}
+	return false
+}
+
 // Partition gives the partition a region belongs to
 func Partition(region string) string {
 	switch region {
@@ -656,6 +715,10 @@ type ClusterMeta struct {
 	// Annotations are arbitrary metadata ignored by `eksctl`.
 	// +optional
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// DeletionProtection enables termination protection on the cluster stack, `eksctl delete cluster`
+	// refuses to delete the cluster until it is disabled with `eksctl utils set-deletion-protection`
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
 	// Internal fields
 	// AccountID the ID of the account hosting this cluster
 	AccountID string `json:"-"`
@@ -791,6 +854,7 @@ type ClusterProvider interface {
 	IAM() awsapi.IAM
 	Region() string
 	Profile() Profile
+	RoleSession() AWSRoleSessionConfig
 	WaitTimeout() time.Duration
 	ConfigProvider() client.ConfigProvider
 	Session() *session.Session
@@ -801,6 +865,11 @@ type ClusterProvider interface {
 	STSPresigner() STSPresigner
 	EC2() awsapi.EC2
 	Outposts() awsapi.Outposts
+	Route53() awsapi.Route53
+	KMS() awsapi.KMS
+	ServiceQuotas() awsapi.ServiceQuotas
+	Pricing() awsapi.Pricing
+	S3() awsapi.S3
 }
 
 // STSPresigner defines the method to pre-sign GetCallerIdentity requests to add a proper header required by EKS for
@@ -820,6 +889,12 @@ type ProviderConfig struct {
 	Region      string
 	Profile     Profile
 	WaitTimeout time.Duration
+
+	Retry            AWSRetryConfig
+	Endpoints        AWSEndpoints
+	UseFIPSEndpoints bool
+	AssumeRoles      []AWSAssumeRole
+	RoleSession      AWSRoleSessionConfig
 }
 
 // Profile is the AWS profile to use.
@@ -871,6 +946,11 @@ type ClusterConfig struct {
 	// +optional
 	ManagedNodeGroups []*ManagedNodeGroup `json:"managedNodeGroups,omitempty"`
 
+	// NodeGroupDefaults holds settings shared by the nodegroups and managed nodegroups, merged into each of them
+	// when the config is parsed. See [nodegroup defaults](/usage/creating-and-managing-clusters/#nodegroup-defaults)
+	// +optional
+	NodeGroupDefaults *InlineDocument `json:"nodeGroupDefaults,omitempty"`
+
 	// +optional
 	FargateProfiles []*FargateProfile `json:"fargateProfiles,omitempty"`
 
@@ -889,6 +969,23 @@ type ClusterConfig struct {
 	// +optional
 	SecretsEncryption *SecretsEncryption `json:"secretsEncryption,omitempty"`
 
+	// ZonalShiftConfig registers the cluster with Amazon Application Recovery
+	// Controller (ARC) zonal shift.
+	// See [zonal shift](/usage/zonal-shift/)
+	// +optional
+	ZonalShiftConfig *ZonalShiftConfig `json:"zonalShiftConfig,omitempty"`
+
+	// UpgradePolicy sets whether the cluster enters extended support at the
+	// end of standard support for its Kubernetes version.
+	// See [cluster upgrades](/usage/cluster-upgrade/)
+	// +optional
+	UpgradePolicy *UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// AutoModeConfig enables EKS Auto Mode.
+	// See [Auto Mode](/usage/auto-mode/)
+	// +optional
+	AutoModeConfig *AutoModeConfig `json:"autoModeConfig,omitempty"`
+
 	Status *ClusterStatus `json:"-"`
 
 	// future gitops plans, replacing the Git configuration above
@@ -902,6 +999,62 @@ type ClusterConfig struct {
 	// Outpost specifies the Outpost configuration.
 	// +optional
 	Outpost *Outpost `json:"outpost,omitempty"`
+
+	// Hooks are shell commands and Kubernetes manifests run at points of
+	// the cluster lifecycle.
+	// See [Lifecycle hooks](/usage/hooks/)
+	// +optional
+	Hooks *Hooks `json:"hooks,omitempty"`
+
+	// Charts are Helm charts installed, in order, once the cluster and its
+	// nodegroups are created.
+	// See [Helm charts](/usage/helm-charts/)
+	// +optional
+	Charts []*HelmChart `json:"charts,omitempty"`
+
+	// RBAC holds cluster roles and bindings created with the cluster.
+	// See [RBAC bootstrap](/usage/rbac-bootstrap/)
+	// +optional
+	RBAC *RBAC `json:"rbac,omitempty"`
+
+	// AWSClient configures the clients of the AWS APIs
+	// +optional
+	AWSClient *AWSClientConfig `json:"awsClient,omitempty"`
+}
+
+// Hooks holds the hooks run at each point of the cluster lifecycle, in order.
+// A failing hook stops the operation.
+type Hooks struct {
+	// PostClusterCreate hooks run once the cluster and its nodegroups are ready
+	// +optional
+	PostClusterCreate []Hook `json:"postClusterCreate,omitempty"`
+
+	// PostNodeGroupCreate hooks run once nodegroups are created, by both
+	// `create cluster` and `create nodegroup`
+	// +optional
+	PostNodeGroupCreate []Hook `json:"postNodegroupCreate,omitempty"`
+
+	// PreDelete hooks run before the cluster is deleted
+	// +optional
+	PreDelete []Hook `json:"preDelete,omitempty"`
+}
+
+// Hook is either a shell command or a Kubernetes manifest.
+type Hook struct {
+	// Name of the hook, used in logs
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Command is run with `sh -c`. `KUBECONFIG` is set to a kubeconfig for
+	// the cluster, `EKSCTL_CLUSTER_NAME` and `EKSCTL_REGION` to the name
+	// and region of the cluster
+	// +optional
+	Command string `json:"command,omitempty"`
+
+	// Manifest is the path or the HTTP(S) URL of a Kubernetes manifest to
+	// create or replace the resources of
+	// +optional
+	Manifest string `json:"manifest,omitempty"`
 }
 
 // Outpost holds the Outpost configuration.
@@ -952,6 +1105,10 @@ type Karpenter struct {
 	// WithSpotInterruptionQueue if true, adds all required policies and rules
 	// for supporting Spot Interruption Queue on Karpenter deployments
 	WithSpotInterruptionQueue *bool `json:"withSpotInterruptionQueue,omitempty"`
+	// ServiceAccountRoleARN is an existing IAM role used by the Karpenter controller
+	// through IRSA. A role is created by eksctl when not set
+	// +optional
+	ServiceAccountRoleARN string `json:"serviceAccountRoleARN,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1035,6 +1192,16 @@ func (c *ClusterConfig) IPv6Enabled() bool {
 	return c.KubernetesNetworkConfig != nil && c.KubernetesNetworkConfig.IPv6Enabled()
 }
 
+// Hash returns the SHA-256 hash of the JSON representation of the ClusterConfig, prefixed with "sha256:"
+func (c *ClusterConfig) Hash() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
 // SetClusterState updates the cluster state and populates the ClusterStatus using *eks.Cluster.
 func (c *ClusterConfig) SetClusterState(cluster *ekstypes.Cluster) error {
 	if networkConfig := cluster.KubernetesNetworkConfig; networkConfig != nil && networkConfig.ServiceIpv4Cidr != nil {
@@ -1268,6 +1435,9 @@ func (n *NodeGroup) SetInstanceType(instanceType string) {
 type GitOps struct {
 	// Flux holds options to enable Flux v2 on your cluster
 	Flux *Flux `json:"flux,omitempty"`
+
+	// ArgoCD holds options to install Argo CD on your cluster instead of Flux v2
+	ArgoCD *ArgoCD `json:"argocd,omitempty"`
 }
 
 // Flux groups all configuration options related to a Git repository used for
@@ -1289,6 +1459,58 @@ func (c *ClusterConfig) HasGitOpsFluxConfigured() bool {
 	return c.GitOps != nil && c.GitOps.Flux != nil
 }
 
+// ArgoCD groups all configuration options related to installing Argo CD and
+// bootstrapping it from a Git repository with an app-of-apps Application.
+type ArgoCD struct {
+	// Version of the argo-cd Helm chart.
+	// Defaults to `"5.51.6"`
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Namespace Argo CD is installed into.
+	// Defaults to `"argocd"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Repository holding the manifests of the Argo CD Applications
+	// +required
+	Repository *ArgoCDRepository `json:"repository,omitempty"`
+}
+
+// ArgoCDRepository holds the Git repository the app-of-apps Application is synced from.
+type ArgoCDRepository struct {
+	// URL of the Git repository
+	// +required
+	URL string `json:"url,omitempty"`
+
+	// Path of the directory holding the Application manifests
+	// +required
+	Path string `json:"path,omitempty"`
+
+	// TargetRevision is the branch, tag or commit to sync.
+	// Defaults to `"HEAD"`
+	// +optional
+	TargetRevision string `json:"targetRevision,omitempty"`
+
+	// Username for HTTPS repositories
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// PasswordEnvVar is the name of the environment variable holding the
+	// password or access token for HTTPS repositories
+	// +optional
+	PasswordEnvVar string `json:"passwordEnvVar,omitempty"`
+
+	// SSHPrivateKeyPath is the path to the private key for SSH repositories
+	// +optional
+	SSHPrivateKeyPath string `json:"sshPrivateKeyPath,omitempty"`
+}
+
+// HasGitOpsArgoCDConfigured returns true if gitops.argocd configuration is not nil
+func (c *ClusterConfig) HasGitOpsArgoCDConfigured() bool {
+	return c.GitOps != nil && c.GitOps.ArgoCD != nil
+}
+
 type (
 	// NodeGroupSGs controls security groups for this nodegroup
 	NodeGroupSGs struct {
@@ -1792,6 +2014,15 @@ func HasMixedInstances(ng *NodeGroup) bool {
 	return ng.InstancesDistribution != nil && len(ng.InstancesDistribution.InstanceTypes) > 0
 }
 
+// HasSpotInstances checks if a nodegroup launches Spot Instances above its on-demand capacity
+func HasSpotInstances(ng *NodeGroup) bool {
+	if !HasMixedInstances(ng) {
+		return false
+	}
+	onDemandPercentage := ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity
+	return onDemandPercentage != nil && *onDemandPercentage < 100
+}
+
 // IsAMI returns true if the argument is an AMI ID
 func IsAMI(amiFlag string) bool {
 	return strings.HasPrefix(amiFlag, "ami-")
@@ -1841,6 +2072,34 @@ type SecretsEncryption struct {
 	KeyARN string `json:"keyARN,omitempty"`
 }
 
+// ZonalShiftConfig holds the zonal shift configuration of the cluster
+type ZonalShiftConfig struct {
+	// Enabled registers the cluster with ARC zonal shift, allowing zonal
+	// shifts and zonal autoshift to move traffic away from an impaired
+	// availability zone
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SupportType is the support period a cluster enters at the end of standard support
+type SupportType string
+
+const (
+	// SupportTypeStandard upgrades the cluster automatically at the end of standard support
+	SupportTypeStandard SupportType = "STANDARD"
+	// SupportTypeExtended keeps the cluster on its Kubernetes version during extended support, which is billed
+	SupportTypeExtended SupportType = "EXTENDED"
+)
+
+// UpgradePolicy holds the upgrade policy of the cluster
+type UpgradePolicy struct {
+	// SupportType is either `STANDARD`, to upgrade the cluster automatically at
+	// the end of standard support, or `EXTENDED`, to keep it on its Kubernetes
+	// version during extended support, at an additional cost
+	// +optional
+	SupportType SupportType `json:"supportType,omitempty"`
+}
+
 // PrivateCluster defines the configuration for a fully-private cluster.
 type PrivateCluster struct {
 	// Enabled enables creation of a fully-private cluster.
@@ -1852,7 +2111,7 @@ type PrivateCluster struct {
 
 	// AdditionalEndpointServices specifies additional endpoint services that
 	// must be enabled for private access.
-	// Valid entries are "cloudformation", "autoscaling" and "logs".
+	// Valid entries are "cloudformation", "autoscaling", "logs", "ssm", "ssmmessages" and "ec2messages".
 	AdditionalEndpointServices []string `json:"additionalEndpointServices,omitempty"`
 }
 
@@ -83,3 +83,25 @@ var _ = Describe("Types", func() {
 	})
 
 })
+
+var _ = Describe("HasSpotInstances", func() {
+	DescribeTable("detects nodegroups using Spot Instances",
+		func(distribution *NodeGroupInstancesDistribution, expected bool) {
+			ng := NewNodeGroup()
+			ng.InstancesDistribution = distribution
+			Expect(HasSpotInstances(ng)).To(Equal(expected))
+		},
+		Entry("without instances distribution", nil, false),
+		Entry("with on-demand instances only", &NodeGroupInstancesDistribution{
+			InstanceTypes:                       []string{"m5.large"},
+			OnDemandPercentageAboveBaseCapacity: aws.Int(100),
+		}, false),
+		Entry("with the default on-demand percentage", &NodeGroupInstancesDistribution{
+			InstanceTypes: []string{"m5.large"},
+		}, false),
+		Entry("with Spot Instances", &NodeGroupInstancesDistribution{
+			InstanceTypes:                       []string{"m5.large", "m5a.large"},
+			OnDemandPercentageAboveBaseCapacity: aws.Int(0),
+		}, true),
+	)
+})
@@ -89,6 +89,15 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return err
 	}
 
+	for i, addon := range cfg.Addons {
+		if err := addon.Validate(); err != nil {
+			return fmt.Errorf("addons[%d]: %w", i, err)
+		}
+		if err := addon.ValidateForClusterVersion(cfg.Metadata.Version); err != nil {
+			return fmt.Errorf("addons[%d]: %w", i, err)
+		}
+	}
+
 	// names must be unique across both managed and unmanaged nodegroups
 	ngNames := nameSet{}
 	validateNg := func(ng *NodeGroupBase, path string) error {
@@ -108,6 +117,30 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return err
 	}
 
+	if err := validateGitOps(cfg.GitOps); err != nil {
+		return err
+	}
+
+	if err := ValidateHooks(cfg.Hooks); err != nil {
+		return err
+	}
+
+	if err := validateCharts(cfg.Charts); err != nil {
+		return err
+	}
+
+	if err := validateRBAC(cfg.RBAC, cfg.IAMIdentityMappings); err != nil {
+		return err
+	}
+
+	if err := validateAWSClient(cfg.AWSClient); err != nil {
+		return err
+	}
+
+	if err := validateUpgradePolicy(cfg.UpgradePolicy); err != nil {
+		return err
+	}
+
 	var ngOutpostARN string
 	for i, ng := range cfg.NodeGroups {
 		path := fmt.Sprintf("nodeGroups[%d]", i)
@@ -129,7 +162,7 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		}
 	}
 
-	if err := validateCloudWatchLogging(cfg); err != nil {
+	if err := ValidateCloudWatchLogging(cfg); err != nil {
 		return err
 	}
 
@@ -163,6 +196,12 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		if cfg.SecretsEncryption != nil && cfg.SecretsEncryption.KeyARN != "" {
 			return errors.New("KMS encryption is not supported on Outposts")
 		}
+		if cfg.ZonalShiftConfig != nil && IsEnabled(cfg.ZonalShiftConfig.Enabled) {
+			return errors.New("zonal shift is not supported on Outposts")
+		}
+		if cfg.UpgradePolicy != nil && cfg.UpgradePolicy.SupportType != "" {
+			return errors.New("upgradePolicy is not supported on Outposts")
+		}
 		const zonesErr = "cannot specify %s on Outposts; the AZ defaults to the Outpost AZ"
 		if len(cfg.AvailabilityZones) > 0 {
 			return fmt.Errorf(zonesErr, "availabilityZones")
@@ -204,6 +243,10 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return fmt.Errorf("failed to validate Karpenter config: %w", err)
 	}
 
+	if err := ValidateAutoModeConfig(cfg); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -243,16 +286,28 @@ func validateKarpenterConfig(cfg *ClusterConfig) error {
 	if IsDisabled(cfg.IAM.WithOIDC) {
 		return errors.New("iam.withOIDC must be enabled with Karpenter")
 	}
+
+	if cfg.Karpenter.ServiceAccountRoleARN != "" {
+		if _, err := arn.Parse(cfg.Karpenter.ServiceAccountRoleARN); err != nil {
+			return fmt.Errorf("invalid karpenter.serviceAccountRoleARN %q: %w", cfg.Karpenter.ServiceAccountRoleARN, err)
+		}
+	}
 	return nil
 }
 
-func validateCloudWatchLogging(clusterConfig *ClusterConfig) error {
+// ValidateCloudWatchLogging validates the cluster logging configuration
+func ValidateCloudWatchLogging(clusterConfig *ClusterConfig) error {
 	if !clusterConfig.HasClusterCloudWatchLogging() {
 		if clusterConfig.CloudWatch != nil &&
 			clusterConfig.CloudWatch.ClusterLogging != nil &&
 			clusterConfig.CloudWatch.ClusterLogging.LogRetentionInDays != 0 {
 			return errors.New("cannot set cloudWatch.clusterLogging.logRetentionInDays without enabling log types")
 		}
+		if clusterConfig.CloudWatch != nil &&
+			clusterConfig.CloudWatch.ClusterLogging != nil &&
+			clusterConfig.CloudWatch.ClusterLogging.LogGroupKMSKeyID != "" {
+			return errors.New("cannot set cloudWatch.clusterLogging.logGroupKMSKeyID without enabling log types")
+		}
 		return nil
 	}
 
@@ -267,6 +322,9 @@ func validateCloudWatchLogging(clusterConfig *ClusterConfig) error {
 			return errors.Errorf("log type %q (cloudWatch.clusterLogging.enableTypes[%d]) is unknown", logType, i)
 		}
 	}
+	if kmsKeyID := clusterConfig.CloudWatch.ClusterLogging.LogGroupKMSKeyID; kmsKeyID != "" && !arn.IsARN(kmsKeyID) {
+		return errors.Errorf("invalid value %q for cloudWatch.clusterLogging.logGroupKMSKeyID; must be the ARN of a KMS key", kmsKeyID)
+	}
 	if logRetentionDays := clusterConfig.CloudWatch.ClusterLogging.LogRetentionInDays; logRetentionDays != 0 {
 		for _, v := range LogRetentionInDaysValues {
 			if v == logRetentionDays {
@@ -307,6 +365,11 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 		}
 		c.VPC.PublicAccessCIDRs = cidrs
 	}
+	if c.VPC.ClusterEndpoints != nil {
+		if err := c.VPC.ClusterEndpoints.validatePublicAccessCIDRChanges(); err != nil {
+			return err
+		}
+	}
 	if len(c.VPC.ExtraIPv6CIDRs) > 0 {
 		if !c.IPv6Enabled() {
 			return fmt.Errorf("cannot specify vpc.extraIPv6CIDRs with an IPv4 cluster")
@@ -337,13 +400,67 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 		}
 	}
 
+	if c.HasSubnetCIDRPlan() {
+		if err := c.validateSubnetCIDRPlan(); err != nil {
+			return err
+		}
+	}
+
+	if c.VPC.NAT != nil {
+		if err := validateClusterNAT(c.VPC.NAT); err != nil {
+			return err
+		}
+	}
+
+	if c.VPC.FlowLogs != nil {
+		if c.VPC.ID != "" {
+			return errors.New("vpc.flowLogs is only supported with an eksctl-created VPC")
+		}
+		if err := validateVPCFlowLogs(c.VPC.FlowLogs); err != nil {
+			return err
+		}
+	}
+
+	if c.VPC.TransitGateway != nil {
+		if c.VPC.ID != "" || (c.HasAnySubnets() && !c.HasSubnetCIDRPlan()) {
+			return errors.New("vpc.transitGateway is only supported with an eksctl-created VPC")
+		}
+		if c.IPv6Enabled() {
+			return errors.New("vpc.transitGateway is not supported with IPv6")
+		}
+		if err := c.validateVPCTransitGateway(); err != nil {
+			return err
+		}
+	}
+
+	if len(c.VPC.ExtraControlPlaneSecurityGroupIDs) > 0 {
+		if err := validateExtraControlPlaneSecurityGroupIDs(c.VPC.ExtraControlPlaneSecurityGroupIDs); err != nil {
+			return err
+		}
+	}
+
+	if len(c.VPC.ControlPlaneSecurityGroupIngress) > 0 {
+		if c.VPC.SecurityGroup != "" {
+			return errors.New("vpc.controlPlaneSecurityGroupIngress is only supported with the control plane security group created by eksctl")
+		}
+		if err := ValidateControlPlaneSecurityGroupIngress(c.VPC.ControlPlaneSecurityGroupIngress); err != nil {
+			return err
+		}
+	}
+
+	if len(c.VPC.PrivateHostedZoneAssociations) > 0 {
+		if err := validatePrivateHostedZoneAssociations(c.VPC.PrivateHostedZoneAssociations); err != nil {
+			return err
+		}
+	}
+
 	// manageSharedNodeSecurityGroupRules cannot be disabled if using eksctl managed security groups
 	if c.VPC.SharedNodeSecurityGroup == "" && IsDisabled(c.VPC.ManageSharedNodeSecurityGroupRules) {
 		return errors.New("vpc.manageSharedNodeSecurityGroupRules must be enabled when using eksctl-managed security groups")
 	}
 
 	if c.VPC.HostnameType != "" {
-		if c.HasAnySubnets() {
+		if c.HasAnySubnets() && !c.HasSubnetCIDRPlan() {
 			return errors.New("vpc.hostnameType is not supported with a pre-existing VPC")
 		}
 		var hostnameType ec2types.HostnameType
@@ -371,6 +488,219 @@ func (c *ClusterConfig) ValidateVPCConfig() error {
 	return nil
 }
 
+// validateSubnetCIDRPlan validates the subnet CIDRs declared for an eksctl-created VPC
+func (c *ClusterConfig) validateSubnetCIDRPlan() error {
+	switch {
+	case c.IPv6Enabled():
+		return errors.New("subnet CIDRs cannot be specified for an IPv6 cluster")
+	case len(c.LocalZones) > 0:
+		return errors.New("subnet CIDRs cannot be specified together with localZones")
+	case c.IsControlPlaneOnOutposts():
+		return errors.New("subnet CIDRs cannot be specified for a cluster on Outposts")
+	}
+
+	subnets := c.VPC.Subnets
+	if c.IsFullyPrivate() {
+		if len(subnets.Public) > 0 {
+			return errors.New("vpc.subnets.public cannot be specified for a fully-private cluster")
+		}
+	} else {
+		if len(subnets.Public) != len(subnets.Private) {
+			return errors.New("vpc.subnets.public and vpc.subnets.private must define subnets for the same availability zones")
+		}
+		for az := range subnets.Public {
+			if _, ok := subnets.Private[az]; !ok {
+				return errors.New("vpc.subnets.public and vpc.subnets.private must define subnets for the same availability zones")
+			}
+		}
+	}
+
+	type namedCIDR struct {
+		name string
+		cidr *net.IPNet
+	}
+	var cidrs []namedCIDR
+	for topology, mapping := range map[string]AZSubnetMapping{"private": subnets.Private, "public": subnets.Public} {
+		for name, s := range mapping {
+			if s.AZ != "" && s.AZ != name {
+				return fmt.Errorf("vpc.subnets.%s.%s: subnets with a CIDR must be keyed by their availability zone", topology, name)
+			}
+			subnetName := fmt.Sprintf("vpc.subnets.%s.%s", topology, name)
+			if c.VPC.CIDR != nil && !containsCIDR(&c.VPC.CIDR.IPNet, &s.CIDR.IPNet) {
+				return fmt.Errorf("%s: CIDR %s is not within the VPC CIDR %s", subnetName, s.CIDR, c.VPC.CIDR)
+			}
+			cidrs = append(cidrs, namedCIDR{name: subnetName, cidr: &s.CIDR.IPNet})
+		}
+	}
+	for i := range cidrs {
+		for j := i + 1; j < len(cidrs); j++ {
+			if cidrs[i].cidr.Contains(cidrs[j].cidr.IP) || cidrs[j].cidr.Contains(cidrs[i].cidr.IP) {
+				return fmt.Errorf("CIDR %s of %s overlaps with CIDR %s of %s", cidrs[i].cidr, cidrs[i].name, cidrs[j].cidr, cidrs[j].name)
+			}
+		}
+	}
+	return nil
+}
+
+// containsCIDR reports whether the child network is fully contained in the parent network
+func containsCIDR(parent, child *net.IPNet) bool {
+	parentSize, _ := parent.Mask.Size()
+	childSize, _ := child.Mask.Size()
+	return parent.Contains(child.IP) && childSize >= parentSize
+}
+
+func validateClusterNAT(nat *ClusterNAT) error {
+	if nat.Gateway != nil {
+		switch *nat.Gateway {
+		case ClusterHighlyAvailableNAT, ClusterSingleNAT, ClusterDisableNAT, ClusterInstanceNAT:
+		default:
+			return fmt.Errorf("invalid value %q for vpc.nat.gateway; supported values are %v", *nat.Gateway,
+				[]string{ClusterHighlyAvailableNAT, ClusterSingleNAT, ClusterDisableNAT, ClusterInstanceNAT})
+		}
+	}
+	if nat.InstanceType != "" && (nat.Gateway == nil || *nat.Gateway != ClusterInstanceNAT) {
+		return fmt.Errorf("vpc.nat.instanceType can only be set when vpc.nat.gateway is %q", ClusterInstanceNAT)
+	}
+	return nil
+}
+
+func validateVPCFlowLogs(flowLogs *VPCFlowLogs) error {
+	switch flowLogs.DestinationType {
+	case "", FlowLogsDestinationCloudWatchLogs:
+	case FlowLogsDestinationS3:
+		if flowLogs.DestinationARN == "" {
+			return fmt.Errorf("vpc.flowLogs.destinationARN must be set when vpc.flowLogs.destinationType is %q", FlowLogsDestinationS3)
+		}
+		if flowLogs.LogRetentionInDays != 0 {
+			return fmt.Errorf("vpc.flowLogs.logRetentionInDays is not supported when vpc.flowLogs.destinationType is %q", FlowLogsDestinationS3)
+		}
+	default:
+		return fmt.Errorf("invalid value %q for vpc.flowLogs.destinationType; supported values are %v", flowLogs.DestinationType,
+			[]string{FlowLogsDestinationCloudWatchLogs, FlowLogsDestinationS3})
+	}
+
+	if flowLogs.DestinationARN != "" {
+		if _, err := arn.Parse(flowLogs.DestinationARN); err != nil {
+			return fmt.Errorf("invalid vpc.flowLogs.destinationARN %q: %w", flowLogs.DestinationARN, err)
+		}
+		if flowLogs.LogRetentionInDays != 0 {
+			return errors.New("vpc.flowLogs.logRetentionInDays can only be set when eksctl creates the log group")
+		}
+	}
+
+	switch flowLogs.TrafficType {
+	case "", FlowLogsTrafficTypeAll, FlowLogsTrafficTypeAccept, FlowLogsTrafficTypeReject:
+	default:
+		return fmt.Errorf("invalid value %q for vpc.flowLogs.trafficType; supported values are %v", flowLogs.TrafficType,
+			[]string{FlowLogsTrafficTypeAll, FlowLogsTrafficTypeAccept, FlowLogsTrafficTypeReject})
+	}
+
+	switch flowLogs.MaxAggregationInterval {
+	case 0, 60, 600:
+	default:
+		return fmt.Errorf("invalid value %d for vpc.flowLogs.maxAggregationInterval; supported values are 60 and 600", flowLogs.MaxAggregationInterval)
+	}
+
+	if flowLogs.LogRetentionInDays != 0 {
+		for _, v := range LogRetentionInDaysValues {
+			if v == flowLogs.LogRetentionInDays {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid value %d for vpc.flowLogs.logRetentionInDays; supported values are %v", flowLogs.LogRetentionInDays, LogRetentionInDaysValues)
+	}
+	return nil
+}
+
+func (c *ClusterConfig) validateVPCTransitGateway() error {
+	tgw := c.VPC.TransitGateway
+	if !strings.HasPrefix(tgw.ID, "tgw-") {
+		return fmt.Errorf("invalid vpc.transitGateway.id %q; expected a transit gateway ID of the form tgw-xxxxxxxx", tgw.ID)
+	}
+	for i, route := range tgw.Routes {
+		_, routeCIDR, err := net.ParseCIDR(route)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR %q in vpc.transitGateway.routes[%d]: %w", route, i, err)
+		}
+		if routeCIDR.IP.To4() == nil {
+			return fmt.Errorf("vpc.transitGateway.routes[%d]: only IPv4 CIDRs are supported, got %q", i, route)
+		}
+		if c.VPC.CIDR != nil && (c.VPC.CIDR.Contains(routeCIDR.IP) || routeCIDR.Contains(c.VPC.CIDR.IP)) {
+			return fmt.Errorf("vpc.transitGateway.routes[%d]: CIDR %q overlaps with the VPC CIDR %q", i, route, c.VPC.CIDR)
+		}
+		for _, other := range tgw.Routes[:i] {
+			if other == route {
+				return fmt.Errorf("vpc.transitGateway.routes[%d]: duplicate CIDR %q", i, route)
+			}
+		}
+	}
+	return nil
+}
+
+// maxExtraControlPlaneSecurityGroups is the number of security groups EKS allows on the control plane network
+// interfaces, minus the control plane security group
+const maxExtraControlPlaneSecurityGroups = 4
+
+func validateExtraControlPlaneSecurityGroupIDs(securityGroupIDs []string) error {
+	if len(securityGroupIDs) > maxExtraControlPlaneSecurityGroups {
+		return fmt.Errorf("at most %d security groups can be set in vpc.extraControlPlaneSecurityGroupIDs, got %d",
+			maxExtraControlPlaneSecurityGroups, len(securityGroupIDs))
+	}
+	for i, id := range securityGroupIDs {
+		if !strings.HasPrefix(id, "sg-") {
+			return fmt.Errorf("invalid vpc.extraControlPlaneSecurityGroupIDs[%d] %q; expected a security group ID of the form sg-xxxxxxxx", i, id)
+		}
+		for _, other := range securityGroupIDs[:i] {
+			if other == id {
+				return fmt.Errorf("vpc.extraControlPlaneSecurityGroupIDs[%d]: duplicate security group %q", i, id)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateControlPlaneSecurityGroupIngress validates the control plane security group ingress rules and normalizes
+// their CIDRs
+func ValidateControlPlaneSecurityGroupIngress(rules []ControlPlaneSecurityGroupIngressRule) error {
+	for i := range rules {
+		rule := &rules[i]
+		_, ipNet, err := net.ParseCIDR(rule.CIDR)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR %q in vpc.controlPlaneSecurityGroupIngress[%d]: %w", rule.CIDR, i, err)
+		}
+		rule.CIDR = ipNet.String()
+		if rule.Port < 1 || rule.Port > 65535 {
+			return fmt.Errorf("invalid port %d in vpc.controlPlaneSecurityGroupIngress[%d]", rule.Port, i)
+		}
+		for _, other := range rules[:i] {
+			if other.CIDR == rule.CIDR && other.Port == rule.Port {
+				return fmt.Errorf("vpc.controlPlaneSecurityGroupIngress[%d]: duplicate rule for CIDR %q and port %d", i, rule.CIDR, rule.Port)
+			}
+		}
+	}
+	return nil
+}
+
+func validatePrivateHostedZoneAssociations(associations []PrivateHostedZoneAssociation) error {
+	for i, association := range associations {
+		path := fmt.Sprintf("vpc.privateHostedZoneAssociations[%d]", i)
+		if association.HostedZoneID == "" {
+			return fmt.Errorf("%s.hostedZoneID must be set", path)
+		}
+		if association.AuthorizationRoleARN != "" {
+			if _, err := arn.Parse(association.AuthorizationRoleARN); err != nil {
+				return fmt.Errorf("invalid %s.authorizationRoleARN %q: %w", path, association.AuthorizationRoleARN, err)
+			}
+		}
+		for _, other := range associations[:i] {
+			if other.HostedZoneID == association.HostedZoneID {
+				return fmt.Errorf("%s: duplicate hosted zone %q", path, association.HostedZoneID)
+			}
+		}
+	}
+	return nil
+}
+
 func (c *ClusterConfig) unsupportedVPCCNIAddonVersion() (bool, error) {
 	for _, addon := range c.Addons {
 		if addon.Name == VPCCNIAddon {
@@ -442,6 +772,9 @@ func (c *ClusterConfig) addonContainsManagedAddons(addons []string) []string {
 // ValidateClusterEndpointConfig checks the endpoint configuration for potential issues
 func (c *ClusterConfig) ValidateClusterEndpointConfig() error {
 	if c.VPC.ClusterEndpoints != nil {
+		if c.VPC.ClusterEndpoints.onlyChangesPublicAccessCIDRs() {
+			return nil
+		}
 		if !c.HasClusterEndpointAccess() {
 			return ErrClusterEndpointNoAccess
 		}
@@ -454,6 +787,38 @@ func (c *ClusterConfig) ValidateClusterEndpointConfig() error {
 	return nil
 }
 
+// onlyChangesPublicAccessCIDRs reports whether the endpoints config leaves endpoint access untouched
+// and only adds or removes public access CIDRs
+func (e *ClusterEndpoints) onlyChangesPublicAccessCIDRs() bool {
+	return e.PrivateAccess == nil && e.PublicAccess == nil &&
+		(len(e.AddPublicAccessCIDRs) > 0 || len(e.RemovePublicAccessCIDRs) > 0)
+}
+
+func (e *ClusterEndpoints) validatePublicAccessCIDRChanges() error {
+	if len(e.AddPublicAccessCIDRs) > 0 {
+		cidrs, err := validateCIDRs(e.AddPublicAccessCIDRs)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR in vpc.clusterEndpoints.addPublicAccessCIDRs: %w", err)
+		}
+		e.AddPublicAccessCIDRs = cidrs
+	}
+	if len(e.RemovePublicAccessCIDRs) > 0 {
+		cidrs, err := validateCIDRs(e.RemovePublicAccessCIDRs)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR in vpc.clusterEndpoints.removePublicAccessCIDRs: %w", err)
+		}
+		e.RemovePublicAccessCIDRs = cidrs
+	}
+	for _, cidr := range e.AddPublicAccessCIDRs {
+		for _, removed := range e.RemovePublicAccessCIDRs {
+			if cidr == removed {
+				return fmt.Errorf("CIDR %q cannot be both added to and removed from the public access CIDRs", cidr)
+			}
+		}
+	}
+	return nil
+}
+
 // ValidatePrivateCluster validates the private cluster config
 func (c *ClusterConfig) ValidatePrivateCluster() error {
 	if c.PrivateCluster.Enabled {
@@ -485,6 +850,27 @@ func (c *ClusterConfig) ValidatePrivateCluster() error {
 	return nil
 }
 
+// validateServiceCIDROverlap ensures the service CIDR does not collide with any of the VPC CIDRs
+func (c *ClusterConfig) validateServiceCIDROverlap(serviceCIDR *net.IPNet) error {
+	if c.VPC == nil {
+		return nil
+	}
+	vpcCIDRs := c.VPC.ExtraCIDRs
+	if c.VPC.CIDR != nil {
+		vpcCIDRs = append([]string{c.VPC.CIDR.String()}, vpcCIDRs...)
+	}
+	for _, cidr := range vpcCIDRs {
+		_, vpcCIDR, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if vpcCIDR.Contains(serviceCIDR.IP) || serviceCIDR.Contains(vpcCIDR.IP) {
+			return fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR %q overlaps with VPC CIDR %q", serviceCIDR.String(), cidr)
+		}
+	}
+	return nil
+}
+
 // validateKubernetesNetworkConfig validates the k8s network config
 func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
 	if c.KubernetesNetworkConfig == nil {
@@ -495,9 +881,13 @@ func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
 			return errors.New("service IPv4 CIDR is not supported with IPv6")
 		}
 		serviceIP := c.KubernetesNetworkConfig.ServiceIPv4CIDR
-		if _, _, err := net.ParseCIDR(serviceIP); serviceIP != "" && err != nil {
+		_, serviceCIDR, err := net.ParseCIDR(serviceIP)
+		if err != nil {
 			return errors.Wrap(err, "invalid IPv4 CIDR for kubernetesNetworkConfig.serviceIPv4CIDR")
 		}
+		if err := c.validateServiceCIDROverlap(serviceCIDR); err != nil {
+			return err
+		}
 	}
 
 	switch strings.ToLower(c.KubernetesNetworkConfig.IPFamily) {
@@ -1565,3 +1955,71 @@ func validateIAMIdentityMappings(clusterConfig *ClusterConfig) error {
 	}
 	return nil
 }
+
+func validateGitOps(gitOps *GitOps) error {
+	if gitOps == nil {
+		return nil
+	}
+	if gitOps.Flux != nil && gitOps.ArgoCD != nil {
+		return errors.New("only one of gitops.flux and gitops.argocd can be set")
+	}
+	if gitOps.ArgoCD != nil {
+		return ValidateArgoCD(gitOps.ArgoCD)
+	}
+	return nil
+}
+
+// ValidateArgoCD validates the gitops.argocd configuration
+func ValidateArgoCD(argoCD *ArgoCD) error {
+	repo := argoCD.Repository
+	if repo == nil {
+		return errors.New("gitops.argocd.repository must be set")
+	}
+	if repo.URL == "" {
+		return errors.New("gitops.argocd.repository.url must be set")
+	}
+	if repo.Path == "" {
+		return errors.New("gitops.argocd.repository.path must be set")
+	}
+	if repo.SSHPrivateKeyPath != "" && (repo.Username != "" || repo.PasswordEnvVar != "") {
+		return errors.New("gitops.argocd.repository.sshPrivateKeyPath cannot be set with gitops.argocd.repository.username or gitops.argocd.repository.passwordEnvVar")
+	}
+	if (repo.Username == "") != (repo.PasswordEnvVar == "") {
+		return errors.New("gitops.argocd.repository.username and gitops.argocd.repository.passwordEnvVar must be set together")
+	}
+	return nil
+}
+
+// ValidateHooks validates the hooks configuration
+func ValidateHooks(hooks *Hooks) error {
+	if hooks == nil {
+		return nil
+	}
+	for _, phase := range []struct {
+		path  string
+		hooks []Hook
+	}{
+		{"hooks.postClusterCreate", hooks.PostClusterCreate},
+		{"hooks.postNodegroupCreate", hooks.PostNodeGroupCreate},
+		{"hooks.preDelete", hooks.PreDelete},
+	} {
+		for i, hook := range phase.hooks {
+			if (hook.Command == "") == (hook.Manifest == "") {
+				return fmt.Errorf("exactly one of %[1]s[%[2]d].command and %[1]s[%[2]d].manifest must be set", phase.path, i)
+			}
+		}
+	}
+	return nil
+}
+
+func validateUpgradePolicy(upgradePolicy *UpgradePolicy) error {
+	if upgradePolicy == nil {
+		return nil
+	}
+	switch upgradePolicy.SupportType {
+	case "", SupportTypeStandard, SupportTypeExtended:
+		return nil
+	default:
+		return fmt.Errorf("invalid value %q for upgradePolicy.supportType, must be one of %s or %s", upgradePolicy.SupportType, SupportTypeStandard, SupportTypeExtended)
+	}
+}
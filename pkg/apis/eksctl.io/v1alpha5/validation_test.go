@@ -2,6 +2,7 @@ package v1alpha5_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 
@@ -10,6 +11,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 )
 
 var _ = Describe("ClusterConfig validation", func() {
@@ -666,6 +668,28 @@ var _ = Describe("ClusterConfig validation", func() {
 			},
 			expectedErr: "cannot set cloudWatch.clusterLogging.logRetentionInDays without enabling log types",
 		}),
+
+		Entry("valid log group KMS key", logRetentionEntry{
+			logging: &api.ClusterCloudWatchLogging{
+				LogGroupKMSKeyID: "arn:aws:kms:us-west-2:000000000000:key/12345678-1234-1234-1234-123456789012",
+				EnableTypes:      []string{"api"},
+			},
+		}),
+
+		Entry("log group KMS key that is not an ARN", logRetentionEntry{
+			logging: &api.ClusterCloudWatchLogging{
+				LogGroupKMSKeyID: "12345678-1234-1234-1234-123456789012",
+				EnableTypes:      []string{"api"},
+			},
+			expectedErr: "must be the ARN of a KMS key",
+		}),
+
+		Entry("log group KMS key without enableTypes", logRetentionEntry{
+			logging: &api.ClusterCloudWatchLogging{
+				LogGroupKMSKeyID: "arn:aws:kms:us-west-2:000000000000:key/12345678-1234-1234-1234-123456789012",
+			},
+			expectedErr: "cannot set cloudWatch.clusterLogging.logGroupKMSKeyID without enabling log types",
+		}),
 	)
 
 	type vpcHostnameTypeEntry struct {
@@ -718,6 +742,481 @@ var _ = Describe("ClusterConfig validation", func() {
 		}),
 	)
 
+	type subnetCIDRPlanEntry struct {
+		updateClusterConfig func(*api.ClusterConfig)
+		expectedErr         string
+	}
+
+	DescribeTable("subnet CIDR plan", func(e subnetCIDRPlanEntry) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.VPC.CIDR = ipnet.MustParseCIDR("10.20.0.0/16")
+		clusterConfig.VPC.Subnets = &api.ClusterSubnets{
+			Public: api.AZSubnetMapping{
+				"us-west-2a": api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.0.0/24")},
+				"us-west-2b": api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.1.0/24")},
+			},
+			Private: api.AZSubnetMapping{
+				"us-west-2a": api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.64.0/18")},
+				"us-west-2b": api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.128.0/18")},
+			},
+		}
+		if e.updateClusterConfig != nil {
+			e.updateClusterConfig(clusterConfig)
+		}
+		Expect(clusterConfig.HasSubnetCIDRPlan()).To(BeTrue())
+		err := api.ValidateClusterConfig(clusterConfig)
+		if e.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(e.expectedErr)))
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("valid plan", subnetCIDRPlanEntry{}),
+		Entry("valid plan with hostnameType", subnetCIDRPlanEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.VPC.HostnameType = "resource-name"
+			},
+		}),
+		Entry("subnet outside the VPC CIDR", subnetCIDRPlanEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.VPC.Subnets.Public["us-west-2a"] = api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.30.0.0/24")}
+			},
+			expectedErr: "vpc.subnets.public.us-west-2a: CIDR 10.30.0.0/24 is not within the VPC CIDR 10.20.0.0/16",
+		}),
+		Entry("subnet larger than the VPC CIDR", subnetCIDRPlanEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.VPC.Subnets.Public["us-west-2a"] = api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.0.0/15")}
+			},
+			expectedErr: "is not within the VPC CIDR",
+		}),
+		Entry("overlapping subnets", subnetCIDRPlanEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.VPC.Subnets.Public["us-west-2b"] = api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.64.0/24")}
+			},
+			expectedErr: "overlaps with CIDR",
+		}),
+		Entry("mismatched availability zones", subnetCIDRPlanEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				delete(c.VPC.Subnets.Public, "us-west-2b")
+				c.VPC.Subnets.Public["us-west-2c"] = api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.1.0/24")}
+			},
+			expectedErr: "vpc.subnets.public and vpc.subnets.private must define subnets for the same availability zones",
+		}),
+		Entry("subnet not keyed by availability zone", subnetCIDRPlanEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.VPC.Subnets.Private["us-west-2a"] = api.AZSubnetSpec{AZ: "us-west-2c", CIDR: ipnet.MustParseCIDR("10.20.64.0/18")}
+			},
+			expectedErr: "subnets with a CIDR must be keyed by their availability zone",
+		}),
+		Entry("public subnets in a fully-private cluster", subnetCIDRPlanEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.PrivateCluster = &api.PrivateCluster{Enabled: true}
+			},
+			expectedErr: "vpc.subnets.public cannot be specified for a fully-private cluster",
+		}),
+		Entry("local zones", subnetCIDRPlanEntry{
+			updateClusterConfig: func(c *api.ClusterConfig) {
+				c.LocalZones = []string{"us-west-2-lax-1a"}
+			},
+			expectedErr: "subnet CIDRs cannot be specified together with localZones",
+		}),
+	)
+
+	type vpcNATEntry struct {
+		nat         *api.ClusterNAT
+		expectedErr string
+	}
+
+	DescribeTable("vpc.nat", func(n vpcNATEntry) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.VPC.NAT = n.nat
+		err := api.ValidateClusterConfig(clusterConfig)
+		if n.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(n.expectedErr)))
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("invalid gateway", vpcNATEntry{
+			nat:         &api.ClusterNAT{Gateway: aws.String("invalid")},
+			expectedErr: `invalid value "invalid" for vpc.nat.gateway`,
+		}),
+		Entry("instance NAT", vpcNATEntry{
+			nat: &api.ClusterNAT{Gateway: aws.String(api.ClusterInstanceNAT)},
+		}),
+		Entry("instance NAT with an instance type", vpcNATEntry{
+			nat: &api.ClusterNAT{Gateway: aws.String(api.ClusterInstanceNAT), InstanceType: "t4g.nano"},
+		}),
+		Entry("instance type without instance NAT", vpcNATEntry{
+			nat:         &api.ClusterNAT{Gateway: aws.String(api.ClusterSingleNAT), InstanceType: "t3.nano"},
+			expectedErr: `vpc.nat.instanceType can only be set when vpc.nat.gateway is "Instance"`,
+		}),
+	)
+
+	type vpcFlowLogsEntry struct {
+		vpcID       string
+		flowLogs    *api.VPCFlowLogs
+		expectedErr string
+	}
+
+	DescribeTable("vpc.flowLogs", func(f vpcFlowLogsEntry) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.VPC.ID = f.vpcID
+		clusterConfig.VPC.FlowLogs = f.flowLogs
+		err := api.ValidateClusterConfig(clusterConfig)
+		if f.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(f.expectedErr)))
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("defaults", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{},
+		}),
+		Entry("CloudWatch Logs with retention", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				DestinationType:        api.FlowLogsDestinationCloudWatchLogs,
+				TrafficType:            api.FlowLogsTrafficTypeReject,
+				MaxAggregationInterval: 60,
+				LogRetentionInDays:     30,
+			},
+		}),
+		Entry("S3 bucket", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				DestinationType: api.FlowLogsDestinationS3,
+				DestinationARN:  "arn:aws:s3:::flow-logs-bucket",
+			},
+		}),
+		Entry("pre-existing VPC", vpcFlowLogsEntry{
+			vpcID:       "vpc-1234",
+			flowLogs:    &api.VPCFlowLogs{},
+			expectedErr: "vpc.flowLogs is only supported with an eksctl-created VPC",
+		}),
+		Entry("S3 bucket without an ARN", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				DestinationType: api.FlowLogsDestinationS3,
+			},
+			expectedErr: `vpc.flowLogs.destinationARN must be set when vpc.flowLogs.destinationType is "s3"`,
+		}),
+		Entry("invalid destination type", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				DestinationType: "kinesis",
+			},
+			expectedErr: `invalid value "kinesis" for vpc.flowLogs.destinationType`,
+		}),
+		Entry("invalid destination ARN", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				DestinationARN: "my-log-group",
+			},
+			expectedErr: `invalid vpc.flowLogs.destinationARN "my-log-group"`,
+		}),
+		Entry("retention with an existing log group", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				DestinationARN:     "arn:aws:logs:us-west-2:123456789012:log-group:flow-logs",
+				LogRetentionInDays: 30,
+			},
+			expectedErr: "vpc.flowLogs.logRetentionInDays can only be set when eksctl creates the log group",
+		}),
+		Entry("invalid traffic type", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				TrafficType: "DROP",
+			},
+			expectedErr: `invalid value "DROP" for vpc.flowLogs.trafficType`,
+		}),
+		Entry("invalid aggregation interval", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				MaxAggregationInterval: 120,
+			},
+			expectedErr: "invalid value 120 for vpc.flowLogs.maxAggregationInterval",
+		}),
+		Entry("invalid retention", vpcFlowLogsEntry{
+			flowLogs: &api.VPCFlowLogs{
+				LogRetentionInDays: 2,
+			},
+			expectedErr: "invalid value 2 for vpc.flowLogs.logRetentionInDays",
+		}),
+	)
+
+	type vpcTransitGatewayEntry struct {
+		vpcID          string
+		transitGateway *api.VPCTransitGateway
+		expectedErr    string
+	}
+
+	DescribeTable("vpc.transitGateway", func(t vpcTransitGatewayEntry) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.VPC.ID = t.vpcID
+		clusterConfig.VPC.TransitGateway = t.transitGateway
+		err := api.ValidateClusterConfig(clusterConfig)
+		if t.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(t.expectedErr)))
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("attachment without routes", vpcTransitGatewayEntry{
+			transitGateway: &api.VPCTransitGateway{ID: "tgw-0123456789abcdef0"},
+		}),
+		Entry("attachment with routes", vpcTransitGatewayEntry{
+			transitGateway: &api.VPCTransitGateway{
+				ID:     "tgw-0123456789abcdef0",
+				Routes: []string{"10.0.0.0/8", "172.16.0.0/12"},
+			},
+		}),
+		Entry("pre-existing VPC", vpcTransitGatewayEntry{
+			vpcID:          "vpc-1234",
+			transitGateway: &api.VPCTransitGateway{ID: "tgw-0123456789abcdef0"},
+			expectedErr:    "vpc.transitGateway is only supported with an eksctl-created VPC",
+		}),
+		Entry("invalid ID", vpcTransitGatewayEntry{
+			transitGateway: &api.VPCTransitGateway{ID: "0123456789abcdef0"},
+			expectedErr:    `invalid vpc.transitGateway.id "0123456789abcdef0"`,
+		}),
+		Entry("invalid route", vpcTransitGatewayEntry{
+			transitGateway: &api.VPCTransitGateway{
+				ID:     "tgw-0123456789abcdef0",
+				Routes: []string{"10.0.0.0"},
+			},
+			expectedErr: `invalid CIDR "10.0.0.0" in vpc.transitGateway.routes[0]`,
+		}),
+		Entry("IPv6 route", vpcTransitGatewayEntry{
+			transitGateway: &api.VPCTransitGateway{
+				ID:     "tgw-0123456789abcdef0",
+				Routes: []string{"2001:db8::/32"},
+			},
+			expectedErr: `vpc.transitGateway.routes[0]: only IPv4 CIDRs are supported`,
+		}),
+		Entry("route overlapping the VPC CIDR", vpcTransitGatewayEntry{
+			transitGateway: &api.VPCTransitGateway{
+				ID:     "tgw-0123456789abcdef0",
+				Routes: []string{"192.168.0.0/24"},
+			},
+			expectedErr: `vpc.transitGateway.routes[0]: CIDR "192.168.0.0/24" overlaps with the VPC CIDR "192.168.0.0/16"`,
+		}),
+		Entry("duplicate route", vpcTransitGatewayEntry{
+			transitGateway: &api.VPCTransitGateway{
+				ID:     "tgw-0123456789abcdef0",
+				Routes: []string{"10.0.0.0/8", "10.0.0.0/8"},
+			},
+			expectedErr: `vpc.transitGateway.routes[1]: duplicate CIDR "10.0.0.0/8"`,
+		}),
+	)
+
+	type controlPlaneSecurityGroupsEntry struct {
+		securityGroup      string
+		extraSecurityGroup []string
+		ingress            []api.ControlPlaneSecurityGroupIngressRule
+		expectedErr        string
+	}
+
+	DescribeTable("control plane security groups", func(e controlPlaneSecurityGroupsEntry) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.VPC.SecurityGroup = e.securityGroup
+		clusterConfig.VPC.ExtraControlPlaneSecurityGroupIDs = e.extraSecurityGroup
+		clusterConfig.VPC.ControlPlaneSecurityGroupIngress = e.ingress
+		api.SetClusterConfigDefaults(clusterConfig)
+		err := api.ValidateClusterConfig(clusterConfig)
+		if e.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(e.expectedErr)))
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("extra security groups", controlPlaneSecurityGroupsEntry{
+			extraSecurityGroup: []string{"sg-1", "sg-2"},
+		}),
+		Entry("too many extra security groups", controlPlaneSecurityGroupsEntry{
+			extraSecurityGroup: []string{"sg-1", "sg-2", "sg-3", "sg-4", "sg-5"},
+			expectedErr:        "at most 4 security groups can be set in vpc.extraControlPlaneSecurityGroupIDs, got 5",
+		}),
+		Entry("invalid extra security group", controlPlaneSecurityGroupsEntry{
+			extraSecurityGroup: []string{"1234"},
+			expectedErr:        `invalid vpc.extraControlPlaneSecurityGroupIDs[0] "1234"`,
+		}),
+		Entry("duplicate extra security group", controlPlaneSecurityGroupsEntry{
+			extraSecurityGroup: []string{"sg-1", "sg-1"},
+			expectedErr:        `vpc.extraControlPlaneSecurityGroupIDs[1]: duplicate security group "sg-1"`,
+		}),
+		Entry("ingress rules", controlPlaneSecurityGroupsEntry{
+			ingress: []api.ControlPlaneSecurityGroupIngressRule{{CIDR: "10.0.0.0/16"}, {CIDR: "2002::/64", Port: 8443}},
+		}),
+		Entry("ingress rules with a user-supplied control plane security group", controlPlaneSecurityGroupsEntry{
+			securityGroup: "sg-1",
+			ingress:       []api.ControlPlaneSecurityGroupIngressRule{{CIDR: "10.0.0.0/16"}},
+			expectedErr:   "vpc.controlPlaneSecurityGroupIngress is only supported with the control plane security group created by eksctl",
+		}),
+		Entry("ingress rule with an invalid CIDR", controlPlaneSecurityGroupsEntry{
+			ingress:     []api.ControlPlaneSecurityGroupIngressRule{{CIDR: "10.0.0.0"}},
+			expectedErr: `invalid CIDR "10.0.0.0" in vpc.controlPlaneSecurityGroupIngress[0]`,
+		}),
+		Entry("ingress rule with an invalid port", controlPlaneSecurityGroupsEntry{
+			ingress:     []api.ControlPlaneSecurityGroupIngressRule{{CIDR: "10.0.0.0/16", Port: 70000}},
+			expectedErr: "invalid port 70000 in vpc.controlPlaneSecurityGroupIngress[0]",
+		}),
+		Entry("duplicate ingress rule", controlPlaneSecurityGroupsEntry{
+			ingress:     []api.ControlPlaneSecurityGroupIngressRule{{CIDR: "10.0.0.0/16"}, {CIDR: "10.0.0.0/16", Port: 443}},
+			expectedErr: `vpc.controlPlaneSecurityGroupIngress[1]: duplicate rule for CIDR "10.0.0.0/16" and port 443`,
+		}),
+	)
+
+	type privateHostedZonesEntry struct {
+		associations []api.PrivateHostedZoneAssociation
+		expectedErr  string
+	}
+
+	DescribeTable("vpc.privateHostedZoneAssociations", func(e privateHostedZonesEntry) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.VPC.PrivateHostedZoneAssociations = e.associations
+		err := api.ValidateClusterConfig(clusterConfig)
+		if e.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(e.expectedErr)))
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("valid associations", privateHostedZonesEntry{
+			associations: []api.PrivateHostedZoneAssociation{
+				{HostedZoneID: "Z1"},
+				{HostedZoneID: "Z2", AuthorizationRoleARN: "arn:aws:iam::111122223333:role/route53"},
+			},
+		}),
+		Entry("missing hosted zone ID", privateHostedZonesEntry{
+			associations: []api.PrivateHostedZoneAssociation{{}},
+			expectedErr:  "vpc.privateHostedZoneAssociations[0].hostedZoneID must be set",
+		}),
+		Entry("invalid role ARN", privateHostedZonesEntry{
+			associations: []api.PrivateHostedZoneAssociation{{HostedZoneID: "Z1", AuthorizationRoleARN: "route53"}},
+			expectedErr:  `invalid vpc.privateHostedZoneAssociations[0].authorizationRoleARN "route53"`,
+		}),
+		Entry("duplicate hosted zone", privateHostedZonesEntry{
+			associations: []api.PrivateHostedZoneAssociation{{HostedZoneID: "Z1"}, {HostedZoneID: "Z1"}},
+			expectedErr:  `vpc.privateHostedZoneAssociations[1]: duplicate hosted zone "Z1"`,
+		}),
+	)
+
+	type autoModeEntry struct {
+		autoModeConfig *api.AutoModeConfig
+		karpenter      bool
+		expectedErr    string
+	}
+
+	DescribeTable("autoModeConfig", func(e autoModeEntry) {
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.AutoModeConfig = e.autoModeConfig
+		if e.karpenter {
+			clusterConfig.IAM.WithOIDC = api.Enabled()
+			clusterConfig.Karpenter = &api.Karpenter{Version: "0.20.0"}
+		}
+		api.SetClusterConfigDefaults(clusterConfig)
+		err := api.ValidateClusterConfig(clusterConfig)
+		if e.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(e.expectedErr)))
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("enabled with the default node pools", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{Enabled: api.Enabled()},
+		}),
+		Entry("enabled with a node role", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled:     api.Enabled(),
+				NodeRoleARN: "arn:aws:iam::111122223333:role/auto-mode-nodes",
+				NodePools:   &[]string{api.AutoModeNodePoolSystem},
+			},
+		}),
+		Entry("enabled without node pools", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{Enabled: api.Enabled(), NodePools: &[]string{}},
+		}),
+		Entry("node role without node pools", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled:     api.Enabled(),
+				NodeRoleARN: "arn:aws:iam::111122223333:role/auto-mode-nodes",
+				NodePools:   &[]string{},
+			},
+			expectedErr: "autoModeConfig.nodeRoleARN cannot be set when autoModeConfig.nodePools is empty",
+		}),
+		Entry("invalid node role", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{Enabled: api.Enabled(), NodeRoleARN: "auto-mode-nodes"},
+			expectedErr:    `invalid autoModeConfig.nodeRoleARN "auto-mode-nodes"`,
+		}),
+		Entry("unsupported node pool", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{Enabled: api.Enabled(), NodePools: &[]string{"gpu"}},
+			expectedErr:    `autoModeConfig.nodePools[0]: unsupported node pool "gpu"`,
+		}),
+		Entry("duplicate node pool", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{Enabled: api.Enabled(), NodePools: &[]string{"system", "system"}},
+			expectedErr:    `autoModeConfig.nodePools[1]: duplicate node pool "system"`,
+		}),
+		Entry("fields set while disabled", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{NodePools: &[]string{"system"}},
+			expectedErr:    "autoModeConfig.nodeRoleARN and autoModeConfig.nodePools can only be set when autoModeConfig.enabled is true",
+		}),
+		Entry("enabled together with Karpenter", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{Enabled: api.Enabled()},
+			karpenter:      true,
+			expectedErr:    "karpenter cannot be installed on an Auto Mode cluster",
+		}),
+		Entry("with NodeClasses and NodePools", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled:         api.Enabled(),
+				NodeClasses:     []api.AutoModeNodeClass{{Name: "private", Spec: api.InlineDocument{"subnetSelectorTerms": []interface{}{}}}},
+				CustomNodePools: []api.AutoModeCustomNodePool{{Name: "gpu", Spec: api.InlineDocument{"template": map[string]interface{}{}}}},
+			},
+		}),
+		Entry("NodeClasses set while disabled", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				NodeClasses: []api.AutoModeNodeClass{{Name: "private", Spec: api.InlineDocument{"role": "nodes"}}},
+			},
+			expectedErr: "autoModeConfig.nodeClasses and autoModeConfig.customNodePools can only be set when autoModeConfig.enabled is true",
+		}),
+		Entry("NodeClass without a name", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled:     api.Enabled(),
+				NodeClasses: []api.AutoModeNodeClass{{Spec: api.InlineDocument{"role": "nodes"}}},
+			},
+			expectedErr: "autoModeConfig.nodeClasses[0].name must be set",
+		}),
+		Entry("NodeClass with an invalid name", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled:     api.Enabled(),
+				NodeClasses: []api.AutoModeNodeClass{{Name: "Private_Nodes", Spec: api.InlineDocument{"role": "nodes"}}},
+			},
+			expectedErr: `autoModeConfig.nodeClasses[0].name: invalid name "Private_Nodes"`,
+		}),
+		Entry("NodeClass without a spec", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled:     api.Enabled(),
+				NodeClasses: []api.AutoModeNodeClass{{Name: "private"}},
+			},
+			expectedErr: "autoModeConfig.nodeClasses[0].spec must be set",
+		}),
+		Entry("NodeClass using the name of the EKS managed NodeClass", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled:     api.Enabled(),
+				NodeClasses: []api.AutoModeNodeClass{{Name: "default", Spec: api.InlineDocument{"role": "nodes"}}},
+			},
+			expectedErr: `autoModeConfig.nodeClasses[0].name: "default" is reserved for the NodeClass managed by EKS`,
+		}),
+		Entry("duplicate NodePool", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled: api.Enabled(),
+				CustomNodePools: []api.AutoModeCustomNodePool{
+					{Name: "gpu", Spec: api.InlineDocument{"weight": 10}},
+					{Name: "gpu", Spec: api.InlineDocument{"weight": 20}},
+				},
+			},
+			expectedErr: `autoModeConfig.customNodePools[1].name: duplicate name "gpu"`,
+		}),
+		Entry("NodePool using the name of a built-in node pool", autoModeEntry{
+			autoModeConfig: &api.AutoModeConfig{
+				Enabled:         api.Enabled(),
+				CustomNodePools: []api.AutoModeCustomNodePool{{Name: "system", Spec: api.InlineDocument{"weight": 10}}},
+			},
+			expectedErr: `autoModeConfig.customNodePools[0].name: "system" is reserved for a built-in node pool`,
+		}),
+	)
+
 	Describe("Cluster Endpoint access", func() {
 		var cfg *api.ClusterConfig
 
@@ -783,6 +1282,30 @@ var _ = Describe("ClusterConfig validation", func() {
 					err := api.ValidateClusterConfig(cfg)
 					Expect(err).To(MatchError(api.ErrClusterEndpointNoAccess))
 				})
+
+				It("should normalize public access CIDRs to add and remove", func() {
+					cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+						AddPublicAccessCIDRs:    []string{"10.1.2.3/16"},
+						RemovePublicAccessCIDRs: []string{"0.0.0.0/0"},
+					}
+					Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+					Expect(cfg.VPC.ClusterEndpoints.AddPublicAccessCIDRs).To(Equal([]string{"10.1.0.0/16"}))
+				})
+
+				It("should error on an invalid public access CIDR to add", func() {
+					cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{AddPublicAccessCIDRs: []string{"not-a-cidr"}}
+					err := api.ValidateClusterConfig(cfg)
+					Expect(err).To(MatchError(ContainSubstring("invalid CIDR in vpc.clusterEndpoints.addPublicAccessCIDRs")))
+				})
+
+				It("should error when a CIDR is both added and removed", func() {
+					cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+						AddPublicAccessCIDRs:    []string{"1.1.1.1/32"},
+						RemovePublicAccessCIDRs: []string{"1.1.1.1/32"},
+					}
+					err := api.ValidateClusterConfig(cfg)
+					Expect(err).To(MatchError(ContainSubstring(`CIDR "1.1.1.1/32" cannot be both added to and removed from the public access CIDRs`)))
+				})
 			})
 		})
 	})
@@ -1204,6 +1727,38 @@ var _ = Describe("ClusterConfig validation", func() {
 			})
 		})
 
+		Context("serviceIPv4CIDR", func() {
+			It("accepts a CIDR that does not overlap with the VPC", func() {
+				cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "10.100.0.0/16"
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			})
+
+			When("the CIDR is invalid", func() {
+				It("returns an error", func() {
+					cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "10.100.0.0/99"
+					err = api.ValidateClusterConfig(cfg)
+					Expect(err).To(MatchError(ContainSubstring("invalid IPv4 CIDR for kubernetesNetworkConfig.serviceIPv4CIDR")))
+				})
+			})
+
+			When("the CIDR overlaps with the VPC CIDR", func() {
+				It("returns an error", func() {
+					cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "192.168.128.0/20"
+					err = api.ValidateClusterConfig(cfg)
+					Expect(err).To(MatchError(`kubernetesNetworkConfig.serviceIPv4CIDR "192.168.128.0/20" overlaps with VPC CIDR "192.168.0.0/16"`))
+				})
+			})
+
+			When("the CIDR overlaps with one of the extra VPC CIDRs", func() {
+				It("returns an error", func() {
+					cfg.VPC.ExtraCIDRs = []string{"10.0.0.0/8"}
+					cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "10.100.0.0/16"
+					err = api.ValidateClusterConfig(cfg)
+					Expect(err).To(MatchError(ContainSubstring(`overlaps with VPC CIDR "10.0.0.0/8"`)))
+				})
+			})
+		})
+
 		Context("extraCIDRs", func() {
 			It("validates cidrs", func() {
 				cfg.VPC.ExtraCIDRs = []string{"192.168.0.0/24"}
@@ -1984,6 +2539,16 @@ var _ = Describe("ClusterConfig validation", func() {
 			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("failed to validate Karpenter config: iam.withOIDC must be enabled with Karpenter")))
 		})
 
+		It("returns an error when the service account role is not a valid ARN", func() {
+			cfg := api.NewClusterConfig()
+			cfg.IAM.WithOIDC = api.Enabled()
+			cfg.Karpenter = &api.Karpenter{
+				Version:               "0.20.0",
+				ServiceAccountRoleARN: "karpenter-controller",
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`invalid karpenter.serviceAccountRoleARN "karpenter-controller"`)))
+		})
+
 		It("returns an error when version is missing", func() {
 			cfg := api.NewClusterConfig()
 			cfg.Karpenter = &api.Karpenter{}
@@ -2118,6 +2683,406 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("GitOps", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.GitOps = &api.GitOps{
+				ArgoCD: &api.ArgoCD{
+					Repository: &api.ArgoCDRepository{
+						URL:  "https://github.com/org/platform.git",
+						Path: "apps",
+					},
+				},
+			}
+		})
+
+		It("accepts a public Argo CD repository", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("accepts Argo CD repository credentials", func() {
+			cfg.GitOps.ArgoCD.Repository.Username = "git"
+			cfg.GitOps.ArgoCD.Repository.PasswordEnvVar = "GIT_TOKEN"
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		DescribeTable("rejects an invalid configuration", func(update func(*api.GitOps), expectedErr string) {
+			update(cfg.GitOps)
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(expectedErr))
+		},
+			Entry("both Flux and Argo CD", func(g *api.GitOps) {
+				g.Flux = &api.Flux{GitProvider: "github"}
+			}, "only one of gitops.flux and gitops.argocd can be set"),
+			Entry("no repository", func(g *api.GitOps) {
+				g.ArgoCD.Repository = nil
+			}, "gitops.argocd.repository must be set"),
+			Entry("no repository URL", func(g *api.GitOps) {
+				g.ArgoCD.Repository.URL = ""
+			}, "gitops.argocd.repository.url must be set"),
+			Entry("no repository path", func(g *api.GitOps) {
+				g.ArgoCD.Repository.Path = ""
+			}, "gitops.argocd.repository.path must be set"),
+			Entry("a username without password", func(g *api.GitOps) {
+				g.ArgoCD.Repository.Username = "git"
+			}, "gitops.argocd.repository.username and gitops.argocd.repository.passwordEnvVar must be set together"),
+			Entry("an SSH key with a password", func(g *api.GitOps) {
+				g.ArgoCD.Repository.SSHPrivateKeyPath = "~/.ssh/id_ed25519"
+				g.ArgoCD.Repository.PasswordEnvVar = "GIT_TOKEN"
+			}, "gitops.argocd.repository.sshPrivateKeyPath cannot be set with gitops.argocd.repository.username or gitops.argocd.repository.passwordEnvVar"),
+		)
+	})
+
+	Describe("Hooks", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Hooks = &api.Hooks{
+				PostClusterCreate: []api.Hook{
+					{Name: "label-namespaces", Command: "kubectl label ns default team=platform"},
+					{Manifest: "https://example.com/manifest.yaml"},
+				},
+				PreDelete: []api.Hook{
+					{Command: "kubectl delete svc --all"},
+				},
+			}
+		})
+
+		It("accepts commands and manifests", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("rejects a hook with neither a command nor a manifest", func() {
+			cfg.Hooks.PostNodeGroupCreate = []api.Hook{{Name: "empty"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("exactly one of hooks.postNodegroupCreate[0].command and hooks.postNodegroupCreate[0].manifest must be set"))
+		})
+
+		It("rejects a hook with both a command and a manifest", func() {
+			cfg.Hooks.PreDelete[0].Manifest = "manifest.yaml"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("exactly one of hooks.preDelete[0].command and hooks.preDelete[0].manifest must be set"))
+		})
+	})
+
+	Describe("Upgrade policy", func() {
+		It("accepts the standard and extended support types", func() {
+			cfg := api.NewClusterConfig()
+			for _, supportType := range []api.SupportType{api.SupportTypeStandard, api.SupportTypeExtended} {
+				cfg.UpgradePolicy = &api.UpgradePolicy{SupportType: supportType}
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			}
+		})
+
+		It("rejects an unknown support type", func() {
+			cfg := api.NewClusterConfig()
+			cfg.UpgradePolicy = &api.UpgradePolicy{SupportType: "PREMIUM"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid value "PREMIUM" for upgradePolicy.supportType, must be one of STANDARD or EXTENDED`))
+		})
+	})
+
+	Describe("Charts", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Charts = []*api.HelmChart{
+				{
+					Name:       "cert-manager",
+					Repository: "https://charts.jetstack.io",
+					Chart:      "cert-manager",
+					Version:    "v1.13.2",
+					Namespace:  "cert-manager",
+					Values: api.InlineDocument{
+						"installCRDs": true,
+					},
+				},
+				{
+					Name:       "karpenter",
+					Repository: "oci://public.ecr.aws/karpenter",
+					Chart:      "karpenter",
+					Version:    "0.33.0",
+					Wait:       api.Disabled(),
+					Timeout:    "5m",
+				},
+			}
+		})
+
+		It("accepts HTTP(S) and OCI repositories", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("defaults the namespace and the timeout", func() {
+			Expect(cfg.Charts[1].ReleaseNamespace()).To(Equal("default"))
+			Expect(cfg.Charts[1].InstallTimeout()).To(Equal(5 * time.Minute))
+			Expect(cfg.Charts[0].ReleaseNamespace()).To(Equal("cert-manager"))
+			Expect(cfg.Charts[0].InstallTimeout()).To(Equal(10 * time.Minute))
+		})
+
+		DescribeTable("rejects an invalid chart", func(update func(*api.HelmChart), expectedErr interface{}) {
+			update(cfg.Charts[1])
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(expectedErr))
+		},
+			Entry("no name", func(c *api.HelmChart) {
+				c.Name = ""
+			}, "charts[1].name must be set"),
+			Entry("an invalid name", func(c *api.HelmChart) {
+				c.Name = "Karpenter"
+			}, `charts[1].name "Karpenter" is not a valid Helm release name: must be a lowercase RFC 1123 subdomain of at most 53 characters`),
+			Entry("a duplicate release", func(c *api.HelmChart) {
+				c.Name = "cert-manager"
+				c.Namespace = "cert-manager"
+			}, `charts[1]: release "cert-manager" is defined more than once in namespace "cert-manager"`),
+			Entry("no repository", func(c *api.HelmChart) {
+				c.Repository = ""
+			}, "charts[1].repository must be set"),
+			Entry("a repository that is not a URL", func(c *api.HelmChart) {
+				c.Repository = "karpenter/karpenter"
+			}, "charts[1].repository must be an http(s):// or oci:// URL"),
+			Entry("no chart", func(c *api.HelmChart) {
+				c.Chart = ""
+			}, "charts[1].chart must be set"),
+			Entry("no version", func(c *api.HelmChart) {
+				c.Version = ""
+			}, "charts[1].version must be set"),
+			Entry("an invalid namespace", func(c *api.HelmChart) {
+				c.Namespace = "kube_system"
+			}, ContainSubstring(`charts[1].namespace "kube_system" is not a valid namespace`)),
+			Entry("an invalid timeout", func(c *api.HelmChart) {
+				c.Timeout = "5"
+			}, `charts[1].timeout: time: missing unit in duration "5"`),
+			Entry("a negative timeout", func(c *api.HelmChart) {
+				c.Timeout = "-1m"
+			}, "charts[1].timeout must be positive"),
+		)
+	})
+
+	Describe("RBAC", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.RBAC = &api.RBAC{
+				ClusterRoles: []*api.RBACClusterRole{
+					{
+						Name: "eksctl:operator",
+						Rules: []api.RBACPolicyRule{
+							{
+								APIGroups: []string{"", "apps"},
+								Resources: []string{"pods", "deployments"},
+								Verbs:     []string{"get", "list", "watch", "update"},
+							},
+							{
+								NonResourceURLs: []string{"/healthz"},
+								Verbs:           []string{"get"},
+							},
+						},
+					},
+				},
+				Bindings: []*api.RBACBinding{
+					{
+						Name:          "viewers",
+						ClusterRole:   "view",
+						IAMPrincipals: []string{"arn:aws:iam::123456789012:role/developers"},
+					},
+					{
+						Name:        "operators",
+						ClusterRole: "eksctl:operator",
+						Namespaces:  []string{"team-a"},
+						Group:       "team-operators",
+					},
+				},
+			}
+		})
+
+		It("accepts cluster roles and bindings", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("defaults the group of a binding", func() {
+			Expect(cfg.RBAC.Bindings[0].SubjectGroup()).To(Equal("eksctl:rbac:viewers"))
+			Expect(cfg.RBAC.Bindings[1].SubjectGroup()).To(Equal("team-operators"))
+		})
+
+		DescribeTable("rejects an invalid rbac section", func(update func(*api.RBAC), expectedErr interface{}) {
+			update(cfg.RBAC)
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(expectedErr))
+		},
+			Entry("a cluster role without name", func(r *api.RBAC) {
+				r.ClusterRoles[0].Name = ""
+			}, "rbac.clusterRoles[0].name must be set"),
+			Entry("a cluster role with an invalid name", func(r *api.RBAC) {
+				r.ClusterRoles[0].Name = "team/operator"
+			}, ContainSubstring(`rbac.clusterRoles[0].name "team/operator" is not a valid name`)),
+			Entry("a duplicate cluster role", func(r *api.RBAC) {
+				r.ClusterRoles = append(r.ClusterRoles, r.ClusterRoles[0])
+			}, `rbac.clusterRoles[1]: cluster role "eksctl:operator" is defined more than once`),
+			Entry("a cluster role without rules", func(r *api.RBAC) {
+				r.ClusterRoles[0].Rules = nil
+			}, "rbac.clusterRoles[0].rules must be set"),
+			Entry("a rule without verbs", func(r *api.RBAC) {
+				r.ClusterRoles[0].Rules[1].Verbs = nil
+			}, "rbac.clusterRoles[0].rules[1].verbs must be set"),
+			Entry("a rule without resources", func(r *api.RBAC) {
+				r.ClusterRoles[0].Rules[1].NonResourceURLs = nil
+			}, "rbac.clusterRoles[0].rules[1]: one of resources or nonResourceURLs must be set"),
+			Entry("a rule with resources and non-resource URLs", func(r *api.RBAC) {
+				r.ClusterRoles[0].Rules[1].Resources = []string{"pods"}
+			}, "rbac.clusterRoles[0].rules[1]: resources and nonResourceURLs cannot be set in the same rule"),
+			Entry("a duplicate binding", func(r *api.RBAC) {
+				r.Bindings[1].Name = "viewers"
+			}, `rbac.bindings[1]: binding "viewers" is defined more than once`),
+			Entry("a binding without cluster role", func(r *api.RBAC) {
+				r.Bindings[1].ClusterRole = ""
+			}, "rbac.bindings[1].clusterRole must be set"),
+			Entry("an invalid namespace", func(r *api.RBAC) {
+				r.Bindings[1].Namespaces = []string{"team_a"}
+			}, ContainSubstring(`rbac.bindings[1].namespaces: "team_a" is not a valid namespace`)),
+			Entry("a binding without group nor IAM principals", func(r *api.RBAC) {
+				r.Bindings[1].Group = ""
+			}, "rbac.bindings[1]: at least one of group or iamPrincipals must be set"),
+			Entry("a system group", func(r *api.RBAC) {
+				r.Bindings[1].Group = "system:masters"
+			}, `rbac.bindings[1].group "system:masters" cannot use the reserved system: prefix`),
+			Entry("an IAM principal that is not a user or role", func(r *api.RBAC) {
+				r.Bindings[0].IAMPrincipals = []string{"arn:aws:iam::123456789012:group/developers"}
+			}, `rbac.bindings[0].iamPrincipals: "arn:aws:iam::123456789012:group/developers" is not the ARN of an IAM user or role`),
+		)
+
+		It("rejects an IAM principal also set in iamIdentityMappings", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{
+				{
+					ARN:      "arn:aws:iam::123456789012:role/developers",
+					Username: "developer",
+				},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`rbac.bindings[0].iamPrincipals: "arn:aws:iam::123456789012:role/developers" is also set in iamIdentityMappings, add the group "eksctl:rbac:viewers" to that mapping instead`))
+		})
+	})
+
+	Describe("AWS client", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.AWSClient = &api.AWSClientConfig{
+				Retry: &api.AWSRetryConfig{
+					Mode:        "adaptive",
+					MaxAttempts: 20,
+					BaseBackoff: "500ms",
+				},
+			}
+		})
+
+		It("accepts a valid retry config", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.AWSClient.Retry.BaseBackoffDuration()).To(Equal(500 * time.Millisecond))
+		})
+
+		DescribeTable("rejects an invalid retry config", func(update func(*api.AWSRetryConfig), expectedErr string) {
+			update(cfg.AWSClient.Retry)
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(expectedErr))
+		},
+			Entry("an unknown mode", func(r *api.AWSRetryConfig) {
+				r.Mode = "legacy"
+			}, `awsClient.retry: invalid retry mode "legacy", valid options: standard, adaptive`),
+			Entry("negative max attempts", func(r *api.AWSRetryConfig) {
+				r.MaxAttempts = -1
+			}, "awsClient.retry: retry max attempts must be positive"),
+			Entry("an invalid base backoff", func(r *api.AWSRetryConfig) {
+				r.BaseBackoff = "1"
+			}, `awsClient.retry: invalid retry base backoff: time: missing unit in duration "1"`),
+			Entry("a negative base backoff", func(r *api.AWSRetryConfig) {
+				r.BaseBackoff = "-1s"
+			}, "awsClient.retry: retry base backoff must be positive"),
+		)
+
+		It("accepts http(s) endpoints", func() {
+			cfg.AWSClient.Endpoints = &api.AWSEndpoints{
+				EKS:            "http://localhost:4566",
+				CloudFormation: "https://vpce-0a1b2c3d.cloudformation.us-west-2.vpce.amazonaws.com",
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("rejects an endpoint that is not a URL", func() {
+			cfg.AWSClient.Endpoints = &api.AWSEndpoints{
+				STS: "localhost:4566",
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`awsClient.endpoints.sts "localhost:4566" must be an http(s):// URL`))
+		})
+
+		It("accepts a chain of roles to assume", func() {
+			cfg.AWSClient.AssumeRoles = []api.AWSAssumeRole{
+				{
+					RoleARN:   "arn:aws:iam::111122223333:role/intermediate",
+					MFASerial: "arn:aws:iam::111122223333:mfa/alice",
+					Duration:  "12h",
+				},
+				{
+					RoleARN: "arn:aws:iam::444455556666:role/target",
+				},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.AWSClient.AssumeRoles[0].DurationOrDefault()).To(Equal(12 * time.Hour))
+			Expect(cfg.AWSClient.AssumeRoles[1].DurationOrDefault()).To(Equal(time.Hour))
+			Expect(api.RequireMFA(cfg.AWSClient.AssumeRoles)).To(BeTrue())
+			Expect(api.RequireMFA(cfg.AWSClient.AssumeRoles[1:])).To(BeFalse())
+		})
+
+		DescribeTable("rejects an invalid role to assume", func(role api.AWSAssumeRole, expectedErr string) {
+			cfg.AWSClient.AssumeRoles = []api.AWSAssumeRole{
+				{
+					RoleARN: "arn:aws:iam::111122223333:role/intermediate",
+				},
+				role,
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("a missing role ARN", api.AWSAssumeRole{}, "awsClient.assumeRoles[1].roleARN must be set"),
+			Entry("an invalid role ARN", api.AWSAssumeRole{
+				RoleARN: "target",
+			}, `awsClient.assumeRoles[1].roleARN "target" is not a valid ARN`),
+			Entry("an invalid duration", api.AWSAssumeRole{
+				RoleARN:  "arn:aws:iam::444455556666:role/target",
+				Duration: "1",
+			}, `invalid awsClient.assumeRoles[1].duration: time: missing unit in duration "1"`),
+			Entry("a duration too short", api.AWSAssumeRole{
+				RoleARN:  "arn:aws:iam::444455556666:role/target",
+				Duration: "5m",
+			}, "awsClient.assumeRoles[1].duration must be between 15m and 12h"),
+		)
+
+		It("accepts a role session", func() {
+			cfg.AWSClient.RoleSession = &api.AWSRoleSessionConfig{
+				Name:           "deploy-42",
+				SourceIdentity: "alice@example.com",
+				Tags: map[string]string{
+					"pipeline": "deploy production",
+				},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		DescribeTable("rejects an invalid role session", func(roleSession api.AWSRoleSessionConfig, expectedErr string) {
+			cfg.AWSClient.RoleSession = &roleSession
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(expectedErr))
+		},
+			Entry("a name with spaces", api.AWSRoleSessionConfig{
+				Name: "deploy 42",
+			}, `awsClient.roleSession: invalid role session name "deploy 42", it must have 2 to 64 letters, digits or any of _+=,.@-`),
+			Entry("a source identity starting with aws:", api.AWSRoleSessionConfig{
+				SourceIdentity: "aws:alice",
+			}, `awsClient.roleSession: invalid source identity "aws:alice", it must have 2 to 64 letters, digits or any of _+=,.@- and not start with aws:`),
+			Entry("a reserved tag key", api.AWSRoleSessionConfig{
+				Tags: map[string]string{"aws:user": "alice"},
+			}, `awsClient.roleSession: invalid session tag key "aws:user"`),
+			Entry("an invalid tag value", api.AWSRoleSessionConfig{
+				Tags: map[string]string{"user": "alice!"},
+			}, `awsClient.roleSession: invalid value "alice!" of session tag "user"`),
+		)
+	})
+
 	Describe("Validate SecretsEncryption", func() {
 		var cfg *api.ClusterConfig
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"sort"
 
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
@@ -25,10 +26,37 @@ const (
 	// ClusterDisableNAT disables NAT
 	ClusterDisableNAT = "Disable"
 
+	// ClusterInstanceNAT configures a single NAT instance instead of a managed NAT gateway
+	ClusterInstanceNAT = "Instance"
+
 	// (default)
 	ClusterNATDefault = ClusterSingleNAT
 )
 
+// DefaultNATInstanceType is the default instance type used for the NAT instance
+const DefaultNATInstanceType = "t3.nano"
+
+// Values for `FlowLogsDestinationType`
+const (
+	// FlowLogsDestinationCloudWatchLogs publishes flow logs to CloudWatch Logs (default)
+	FlowLogsDestinationCloudWatchLogs = "cloud-watch-logs"
+
+	// FlowLogsDestinationS3 publishes flow logs to an S3 bucket
+	FlowLogsDestinationS3 = "s3"
+)
+
+// Values for `FlowLogsTrafficType`
+const (
+	// FlowLogsTrafficTypeAll logs accepted and rejected traffic (default)
+	FlowLogsTrafficTypeAll = "ALL"
+
+	// FlowLogsTrafficTypeAccept logs accepted traffic only
+	FlowLogsTrafficTypeAccept = "ACCEPT"
+
+	// FlowLogsTrafficTypeReject logs rejected traffic only
+	FlowLogsTrafficTypeReject = "REJECT"
+)
+
 // AZSubnetMapping holds subnet to AZ mappings.
 // If the key is an AZ, that also becomes the name of the subnet
 // otherwise use the key to refer to this subnet.
@@ -175,6 +203,33 @@ type (
 		// k8s API endpoint
 		// +optional
 		PublicAccessCIDRs []string `json:"publicAccessCIDRs,omitempty"`
+		// ControlPlaneSubnetIDs lists the subnets in which EKS places the control plane network interfaces
+		// of an existing cluster; they can be changed with `eksctl utils update-cluster-vpc-config`
+		// +optional
+		ControlPlaneSubnetIDs []string `json:"controlPlaneSubnetIDs,omitempty"`
+		// ControlPlaneSecurityGroupIDs lists the security groups attached to the control plane network interfaces
+		// of an existing cluster; they can be changed with `eksctl utils update-cluster-vpc-config`
+		// +optional
+		ControlPlaneSecurityGroupIDs []string `json:"controlPlaneSecurityGroupIDs,omitempty"`
+		// ExtraControlPlaneSecurityGroupIDs lists existing security groups to attach to the control plane
+		// network interfaces when the cluster is created, in addition to the control plane security group
+		// +optional
+		ExtraControlPlaneSecurityGroupIDs []string `json:"extraControlPlaneSecurityGroupIDs,omitempty"`
+		// ControlPlaneSecurityGroupIngress lists additional ingress rules for the control plane security group
+		// created by eksctl; on an existing cluster they can be changed with
+		// `eksctl utils update-control-plane-security-group-ingress`
+		// +optional
+		ControlPlaneSecurityGroupIngress []ControlPlaneSecurityGroupIngressRule `json:"controlPlaneSecurityGroupIngress,omitempty"`
+		// FlowLogs enables VPC Flow Logs for the eksctl-created VPC
+		// +optional
+		FlowLogs *VPCFlowLogs `json:"flowLogs,omitempty"`
+		// TransitGateway attaches the eksctl-created VPC to an existing transit gateway
+		// +optional
+		TransitGateway *VPCTransitGateway `json:"transitGateway,omitempty"`
+		// PrivateHostedZoneAssociations lists Route 53 private hosted zones to associate with the cluster VPC,
+		// e.g. to resolve internal corporate DNS names; see `eksctl utils associate-private-hosted-zones`
+		// +optional
+		PrivateHostedZoneAssociations []PrivateHostedZoneAssociation `json:"privateHostedZoneAssociations,omitempty"`
 	}
 	// ClusterSubnets holds private and public subnets
 	ClusterSubnets struct {
@@ -198,6 +253,10 @@ type (
 		CIDRIndex int `json:"-"`
 
 		OutpostARN string `json:"-"`
+
+		// OwnerID is the ID of the account that owns the subnet, which differs
+		// from the cluster's account for subnets shared via AWS RAM
+		OwnerID string `json:"-"`
 	}
 	// Network holds ID and CIDR
 	Network struct {
@@ -214,12 +273,88 @@ type (
 	ClusterNAT struct {
 		// Valid variants are `ClusterNAT` constants
 		Gateway *string `json:"gateway,omitempty"`
+		// InstanceType is the EC2 instance type used for the NAT instance
+		// when `gateway` is set to `Instance`.
+		// Defaults to `"t3.nano"`
+		// +optional
+		InstanceType string `json:"instanceType,omitempty"`
+	}
+
+	// VPCFlowLogs holds the configuration for VPC Flow Logs
+	VPCFlowLogs struct {
+		// Valid variants are `FlowLogsDestinationType` constants
+		// +optional
+		DestinationType string `json:"destinationType,omitempty"`
+		// DestinationARN is the ARN of the S3 bucket (optionally including a folder) when
+		// `destinationType` is `s3`, or of an existing CloudWatch Logs log group.
+		// If omitted for `cloud-watch-logs`, eksctl creates a log group for the cluster.
+		// +optional
+		DestinationARN string `json:"destinationARN,omitempty"`
+		// Valid variants are `FlowLogsTrafficType` constants
+		// +optional
+		TrafficType string `json:"trafficType,omitempty"`
+		// LogFormat is a custom log record format, e.g. `${version} ${srcaddr} ${dstaddr}`.
+		// If omitted, the default AWS format is used
+		// +optional
+		LogFormat string `json:"logFormat,omitempty"`
+		// MaxAggregationInterval is the maximum interval in seconds during which a flow is captured,
+		// either 60 or 600.
+		// Defaults to `600`
+		// +optional
+		MaxAggregationInterval int `json:"maxAggregationInterval,omitempty"`
+		// LogRetentionInDays sets the number of days to retain the flow logs in the log group created by eksctl
+		// (see [CloudWatch docs](https://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutRetentionPolicy.html#API_PutRetentionPolicy_RequestSyntax)).
+		// +optional
+		LogRetentionInDays int `json:"logRetentionInDays,omitempty"`
+	}
+
+	// VPCTransitGateway holds the configuration for attaching the VPC to a transit gateway
+	VPCTransitGateway struct {
+		// ID of the transit gateway, which must be in the same region as the cluster
+		// and either owned by or shared with the cluster's account
+		// +required
+		ID string `json:"id"`
+		// Routes lists the destination CIDRs that private subnets route through the transit gateway
+		// +optional
+		Routes []string `json:"routes,omitempty"`
+	}
+
+	// PrivateHostedZoneAssociation holds a Route 53 private hosted zone to associate with the cluster VPC
+	PrivateHostedZoneAssociation struct {
+		// HostedZoneID is the ID of the private hosted zone
+		// +required
+		HostedZoneID string `json:"hostedZoneID"`
+		// AuthorizationRoleARN is an IAM role in the account that owns the hosted zone; it is assumed to
+		// authorize the association when the hosted zone belongs to another account
+		// +optional
+		AuthorizationRoleARN string `json:"authorizationRoleARN,omitempty"`
+	}
+
+	// ControlPlaneSecurityGroupIngressRule allows TCP traffic from a CIDR to the control plane
+	ControlPlaneSecurityGroupIngressRule struct {
+		// CIDR is the IPv4 or IPv6 source CIDR
+		// +required
+		CIDR string `json:"cidr"`
+		// Port is the TCP port to open.
+		// Defaults to `443`
+		// +optional
+		Port int `json:"port,omitempty"`
+		// +optional
+		Description string `json:"description,omitempty"`
 	}
 
 	// ClusterEndpoints holds cluster api server endpoint access information
 	ClusterEndpoints struct {
 		PrivateAccess *bool `json:"privateAccess,omitempty"`
 		PublicAccess  *bool `json:"publicAccess,omitempty"`
+		// AddPublicAccessCIDRs lists CIDRs to add to the public access CIDRs of an existing cluster
+		// with `eksctl utils update-cluster-endpoints`
+		// +optional
+		AddPublicAccessCIDRs []string `json:"addPublicAccessCIDRs,omitempty"`
+		// RemovePublicAccessCIDRs lists CIDRs to remove from the public access CIDRs of an existing cluster
+		// with `eksctl utils update-cluster-endpoints`
+		// +optional
+		RemovePublicAccessCIDRs []string `json:"removePublicAccessCIDRs,omitempty"`
 	}
 )
 
@@ -285,7 +420,7 @@ func ImportSubnet(subnets AZSubnetMapping, subnet *ec2types.Subnet, makeSubnetAl
 
 	subnetAlias := makeSubnetAlias(subnet)
 	if network, ok := subnets[subnetAlias]; !ok {
-		newS := AZSubnetSpec{ID: subnetID, AZ: az, CIDR: subnetCIDR}
+		newS := AZSubnetSpec{ID: subnetID, AZ: az, CIDR: subnetCIDR, OwnerID: aws.ToString(subnet.OwnerId)}
 		// Used if we find an exact ID match
 		var idKey string
 		// Used if we match to AZ/CIDR
@@ -331,6 +466,7 @@ func ImportSubnet(subnets AZSubnetMapping, subnet *ec2types.Subnet, makeSubnetAl
 			return fmt.Errorf("subnet CIDR %q is not the same as %q", network.CIDR.String(), subnetCIDR.String())
 		}
 		network.AZ = az
+		network.OwnerID = aws.ToString(subnet.OwnerId)
 		if subnet.OutpostArn != nil {
 			network.OutpostARN = *subnet.OutpostArn
 		}
@@ -350,6 +486,24 @@ func (m AZSubnetMapping) SelectOutpostSubnetIDs() []string {
 	return subnetIDs
 }
 
+// IsShared returns true if the subnet is owned by an account other than accountID, i.e. it has been shared
+// with accountID via AWS RAM.
+func (s AZSubnetSpec) IsShared(accountID string) bool {
+	return s.OwnerID != "" && accountID != "" && s.OwnerID != accountID
+}
+
+// SelectSharedSubnetIDs returns the IDs of all subnets that are not owned by accountID.
+func (m AZSubnetMapping) SelectSharedSubnetIDs(accountID string) []string {
+	var subnetIDs []string
+	for _, s := range m {
+		if s.IsShared(accountID) {
+			subnetIDs = append(subnetIDs, s.ID)
+		}
+	}
+	sort.Strings(subnetIDs)
+	return subnetIDs
+}
+
 func (m AZSubnetMapping) getOutpostARN() (outpostARN string, found bool) {
 	for _, s := range m {
 		if s.OutpostARN != "" {
@@ -381,6 +535,22 @@ func (c *ClusterConfig) HasAnySubnets() bool {
 	return c.VPC.Subnets != nil && (len(c.VPC.Subnets.Private) > 0 || len(c.VPC.Subnets.Public) > 0)
 }
 
+// HasSubnetCIDRPlan checks if the subnets were declared only with CIDRs, i.e. eksctl
+// is expected to create the VPC with the given subnet layout instead of the default split
+func (c *ClusterConfig) HasSubnetCIDRPlan() bool {
+	if c.VPC.ID != "" || !c.HasAnySubnets() {
+		return false
+	}
+	for _, subnets := range []AZSubnetMapping{c.VPC.Subnets.Private, c.VPC.Subnets.Public} {
+		for _, s := range subnets {
+			if s.ID != "" || s.CIDR == nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // HasSufficientPrivateSubnets validates if there is a sufficient
 // number of private subnets available to create a cluster
 func (c *ClusterConfig) HasSufficientPrivateSubnets() bool {
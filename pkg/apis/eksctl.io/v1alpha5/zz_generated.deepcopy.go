@@ -25,6 +25,113 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSAssumeRole) DeepCopyInto(out *AWSAssumeRole) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSAssumeRole.
+func (in *AWSAssumeRole) DeepCopy() *AWSAssumeRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSAssumeRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSClientConfig) DeepCopyInto(out *AWSClientConfig) {
+	*out = *in
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(AWSRetryConfig)
+		**out = **in
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(AWSEndpoints)
+		**out = **in
+	}
+	if in.AssumeRoles != nil {
+		in, out := &in.AssumeRoles, &out.AssumeRoles
+		*out = make([]AWSAssumeRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleSession != nil {
+		in, out := &in.RoleSession, &out.RoleSession
+		*out = new(AWSRoleSessionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSClientConfig.
+func (in *AWSClientConfig) DeepCopy() *AWSClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEndpoints) DeepCopyInto(out *AWSEndpoints) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEndpoints.
+func (in *AWSEndpoints) DeepCopy() *AWSEndpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEndpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRetryConfig) DeepCopyInto(out *AWSRetryConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRetryConfig.
+func (in *AWSRetryConfig) DeepCopy() *AWSRetryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRetryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSRoleSessionConfig) DeepCopyInto(out *AWSRoleSessionConfig) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSRoleSessionConfig.
+func (in *AWSRoleSessionConfig) DeepCopy() *AWSRoleSessionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSRoleSessionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in AZSubnetMapping) DeepCopyInto(out *AZSubnetMapping) {
 	{
@@ -112,6 +219,121 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCD) DeepCopyInto(out *ArgoCD) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(ArgoCDRepository)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCD.
+func (in *ArgoCD) DeepCopy() *ArgoCD {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCD)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDRepository) DeepCopyInto(out *ArgoCDRepository) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDRepository.
+func (in *ArgoCDRepository) DeepCopy() *ArgoCDRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoModeConfig) DeepCopyInto(out *AutoModeConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.NodeClasses != nil {
+		in, out := &in.NodeClasses, &out.NodeClasses
+		*out = make([]AutoModeNodeClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomNodePools != nil {
+		in, out := &in.CustomNodePools, &out.CustomNodePools
+		*out = make([]AutoModeCustomNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoModeConfig.
+func (in *AutoModeConfig) DeepCopy() *AutoModeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoModeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoModeCustomNodePool) DeepCopyInto(out *AutoModeCustomNodePool) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoModeCustomNodePool.
+func (in *AutoModeCustomNodePool) DeepCopy() *AutoModeCustomNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoModeCustomNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoModeNodeClass) DeepCopyInto(out *AutoModeNodeClass) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoModeNodeClass.
+func (in *AutoModeNodeClass) DeepCopy() *AutoModeNodeClass {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoModeNodeClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CapacityReservation) DeepCopyInto(out *CapacityReservation) {
 	*out = *in
@@ -286,6 +508,10 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 			}
 		}
 	}
+	if in.NodeGroupDefaults != nil {
+		in, out := &in.NodeGroupDefaults, &out.NodeGroupDefaults
+		*out = (*in).DeepCopy()
+	}
 	if in.FargateProfiles != nil {
 		in, out := &in.FargateProfiles, &out.FargateProfiles
 		*out = make([]*FargateProfile, len(*in))
@@ -317,6 +543,21 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(SecretsEncryption)
 		**out = **in
 	}
+	if in.ZonalShiftConfig != nil {
+		in, out := &in.ZonalShiftConfig, &out.ZonalShiftConfig
+		*out = new(ZonalShiftConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradePolicy != nil {
+		in, out := &in.UpgradePolicy, &out.UpgradePolicy
+		*out = new(UpgradePolicy)
+		**out = **in
+	}
+	if in.AutoModeConfig != nil {
+		in, out := &in.AutoModeConfig, &out.AutoModeConfig
+		*out = new(AutoModeConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(ClusterStatus)
@@ -337,6 +578,32 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(Outpost)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(Hooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = make([]*HelmChart, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(HelmChart)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.RBAC != nil {
+		in, out := &in.RBAC, &out.RBAC
+		*out = new(RBAC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSClient != nil {
+		in, out := &in.AWSClient, &out.AWSClient
+		*out = new(AWSClientConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -404,6 +671,16 @@ func (in *ClusterEndpoints) DeepCopyInto(out *ClusterEndpoints) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AddPublicAccessCIDRs != nil {
+		in, out := &in.AddPublicAccessCIDRs, &out.AddPublicAccessCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemovePublicAccessCIDRs != nil {
+		in, out := &in.RemovePublicAccessCIDRs, &out.RemovePublicAccessCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -445,6 +722,11 @@ func (in *ClusterIAM) DeepCopyInto(out *ClusterIAM) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.OIDCThumbprint != nil {
+		in, out := &in.OIDCThumbprint, &out.OIDCThumbprint
+		*out = new(string)
+		**out = **in
+	}
 	if in.ServiceAccounts != nil {
 		in, out := &in.ServiceAccounts, &out.ServiceAccounts
 		*out = make([]*ClusterIAMServiceAccount, len(*in))
@@ -583,6 +865,11 @@ func (in *ClusterMeta) DeepCopyInto(out *ClusterMeta) {
 			(*out)[key] = val
 		}
 	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -722,6 +1009,41 @@ func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ControlPlaneSubnetIDs != nil {
+		in, out := &in.ControlPlaneSubnetIDs, &out.ControlPlaneSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ControlPlaneSecurityGroupIDs != nil {
+		in, out := &in.ControlPlaneSecurityGroupIDs, &out.ControlPlaneSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraControlPlaneSecurityGroupIDs != nil {
+		in, out := &in.ExtraControlPlaneSecurityGroupIDs, &out.ExtraControlPlaneSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ControlPlaneSecurityGroupIngress != nil {
+		in, out := &in.ControlPlaneSecurityGroupIngress, &out.ControlPlaneSecurityGroupIngress
+		*out = make([]ControlPlaneSecurityGroupIngressRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(VPCFlowLogs)
+		**out = **in
+	}
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(VPCTransitGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateHostedZoneAssociations != nil {
+		in, out := &in.PrivateHostedZoneAssociations, &out.PrivateHostedZoneAssociations
+		*out = make([]PrivateHostedZoneAssociation, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -735,6 +1057,22 @@ func (in *ClusterVPC) DeepCopy() *ClusterVPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneSecurityGroupIngressRule) DeepCopyInto(out *ControlPlaneSecurityGroupIngressRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSecurityGroupIngressRule.
+func (in *ControlPlaneSecurityGroupIngressRule) DeepCopy() *ControlPlaneSecurityGroupIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneSecurityGroupIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointService) DeepCopyInto(out *EndpointService) {
 	*out = *in
@@ -862,6 +1200,11 @@ func (in *GitOps) DeepCopyInto(out *GitOps) {
 		*out = new(Flux)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArgoCD != nil {
+		in, out := &in.ArgoCD, &out.ArgoCD
+		*out = new(ArgoCD)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -875,6 +1218,75 @@ func (in *GitOps) DeepCopy() *GitOps {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChart) DeepCopyInto(out *HelmChart) {
+	*out = *in
+	in.Values.DeepCopyInto(&out.Values)
+	if in.Wait != nil {
+		in, out := &in.Wait, &out.Wait
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChart.
+func (in *HelmChart) DeepCopy() *HelmChart {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hook) DeepCopyInto(out *Hook) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hook.
+func (in *Hook) DeepCopy() *Hook {
+	if in == nil {
+		return nil
+	}
+	out := new(Hook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hooks) DeepCopyInto(out *Hooks) {
+	*out = *in
+	if in.PostClusterCreate != nil {
+		in, out := &in.PostClusterCreate, &out.PostClusterCreate
+		*out = make([]Hook, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostNodeGroupCreate != nil {
+		in, out := &in.PostNodeGroupCreate, &out.PostNodeGroupCreate
+		*out = make([]Hook, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreDelete != nil {
+		in, out := &in.PreDelete, &out.PreDelete
+		*out = make([]Hook, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hooks.
+func (in *Hooks) DeepCopy() *Hooks {
+	if in == nil {
+		return nil
+	}
+	out := new(Hooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMIdentityMapping) DeepCopyInto(out *IAMIdentityMapping) {
 	*out = *in
@@ -1726,6 +2138,22 @@ func (in *PrivateCluster) DeepCopy() *PrivateCluster {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateHostedZoneAssociation) DeepCopyInto(out *PrivateHostedZoneAssociation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateHostedZoneAssociation.
+func (in *PrivateHostedZoneAssociation) DeepCopy() *PrivateHostedZoneAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateHostedZoneAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Profile) DeepCopyInto(out *Profile) {
 	*out = *in
@@ -1746,6 +2174,14 @@ func (in *Profile) DeepCopy() *Profile {
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
 	out.Profile = in.Profile
+	out.Retry = in.Retry
+	out.Endpoints = in.Endpoints
+	if in.AssumeRoles != nil {
+		in, out := &in.AssumeRoles, &out.AssumeRoles
+		*out = make([]AWSAssumeRole, len(*in))
+		copy(*out, *in)
+	}
+	in.RoleSession.DeepCopyInto(&out.RoleSession)
 	return
 }
 
@@ -1759,6 +2195,134 @@ func (in *ProviderConfig) DeepCopy() *ProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBAC) DeepCopyInto(out *RBAC) {
+	*out = *in
+	if in.ClusterRoles != nil {
+		in, out := &in.ClusterRoles, &out.ClusterRoles
+		*out = make([]*RBACClusterRole, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RBACClusterRole)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]*RBACBinding, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RBACBinding)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBAC.
+func (in *RBAC) DeepCopy() *RBAC {
+	if in == nil {
+		return nil
+	}
+	out := new(RBAC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBACBinding) DeepCopyInto(out *RBACBinding) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IAMPrincipals != nil {
+		in, out := &in.IAMPrincipals, &out.IAMPrincipals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBACBinding.
+func (in *RBACBinding) DeepCopy() *RBACBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(RBACBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBACClusterRole) DeepCopyInto(out *RBACClusterRole) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RBACPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBACClusterRole.
+func (in *RBACClusterRole) DeepCopy() *RBACClusterRole {
+	if in == nil {
+		return nil
+	}
+	out := new(RBACClusterRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBACPolicyRule) DeepCopyInto(out *RBACPolicyRule) {
+	*out = *in
+	if in.APIGroups != nil {
+		in, out := &in.APIGroups, &out.APIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceNames != nil {
+		in, out := &in.ResourceNames, &out.ResourceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NonResourceURLs != nil {
+		in, out := &in.NonResourceURLs, &out.NonResourceURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBACPolicyRule.
+func (in *RBACPolicyRule) DeepCopy() *RBACPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RBACPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingConfig) DeepCopyInto(out *ScalingConfig) {
 	*out = *in
@@ -1806,6 +2370,59 @@ func (in *SecretsEncryption) DeepCopy() *SecretsEncryption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePolicy) DeepCopyInto(out *UpgradePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePolicy.
+func (in *UpgradePolicy) DeepCopy() *UpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFlowLogs) DeepCopyInto(out *VPCFlowLogs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogs.
+func (in *VPCFlowLogs) DeepCopy() *VPCFlowLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFlowLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCTransitGateway) DeepCopyInto(out *VPCTransitGateway) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCTransitGateway.
+func (in *VPCTransitGateway) DeepCopy() *VPCTransitGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCTransitGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMapping) DeepCopyInto(out *VolumeMapping) {
 	*out = *in
@@ -1877,3 +2494,24 @@ func (in *WellKnownPolicies) DeepCopy() *WellKnownPolicies {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZonalShiftConfig) DeepCopyInto(out *ZonalShiftConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZonalShiftConfig.
+func (in *ZonalShiftConfig) DeepCopy() *ZonalShiftConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZonalShiftConfig)
+	in.DeepCopyInto(out)
+	return out
+}
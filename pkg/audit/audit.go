@@ -0,0 +1,350 @@
+// Package audit records the mutating eksctl commands, with who ran them, their arguments, the ClusterConfig they
+// applied, the CloudFormation stacks they changed and their outcome, as evidence for change management. The entries
+// are appended to a local file and, optionally, sent to CloudWatch Logs
+package audit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/progress"
+)
+
+const (
+	// LogEnvVar is the environment variable setting the file the entries are appended to, instead of
+	// ~/.eksctl/audit.log, or turning the local audit log off when set to Off
+	LogEnvVar = "EKSCTL_AUDIT_LOG"
+	// LogGroupEnvVar is the environment variable setting the CloudWatch Logs log group the entries are sent to
+	LogGroupEnvVar = "EKSCTL_AUDIT_LOG_GROUP"
+	// Off is the value of EKSCTL_AUDIT_LOG turning the local audit log off
+	Off = "off"
+)
+
+// Outcome is the outcome of a command
+type Outcome string
+
+// Values for Outcome
+const (
+	OutcomeSucceeded Outcome = "succeeded"
+	OutcomeFailed    Outcome = "failed"
+)
+
+// Entry is the record of a mutating command
+type Entry struct {
+	Time    time.Time `json:"time"`
+	Command string    `json:"command"`
+	Args    []string  `json:"args"`
+	// User is the ARN of the AWS identity that ran the command, it is empty when the command failed before
+	// authenticating to AWS
+	User    string `json:"user,omitempty"`
+	Account string `json:"account,omitempty"`
+	Region  string `json:"region,omitempty"`
+	Cluster string `json:"cluster,omitempty"`
+	// ConfigHash is the SHA-256 hash of the ClusterConfig loaded by the command, from the config file or the flags
+	ConfigHash string `json:"configHash,omitempty"`
+	// Stacks are the CloudFormation stacks the command created, updated or deleted
+	Stacks   []string `json:"stacks"`
+	Outcome  Outcome  `json:"outcome"`
+	Error    string   `json:"error,omitempty"`
+	ExitCode int      `json:"exitCode"`
+	Duration string   `json:"duration"`
+}
+
+// mutatingVerbs are the verbs of the commands changing clusters
+var mutatingVerbs = map[string]bool{
+	"associate":    true,
+	"create":       true,
+	"cordon":       true,
+	"delete":       true,
+	"deregister":   true,
+	"disassociate": true,
+	"drain":        true,
+	"enable":       true,
+	"register":     true,
+	"scale":        true,
+	"set":          true,
+	"uncordon":     true,
+	"unset":        true,
+	"update":       true,
+	"upgrade":      true,
+}
+
+// readOnlyUtils are the utils commands that do not change clusters, every other one does
+var readOnlyUtils = map[string]bool{
+	"check-api-deprecations":       true,
+	"describe-addon-configuration": true,
+	"describe-addon-versions":      true,
+	"describe-stacks":              true,
+	"get-token":                    true,
+	"nodegroup-health":             true,
+	"schema":                       true,
+	"write-kubeconfig":             true,
+}
+
+// Redacted replaces the values of the sensitive flags in the recorded arguments
+const Redacted = "REDACTED"
+
+// sensitiveFlagWords are the words in the names of the flags whose values are redacted, on top of --set, which
+// can set any field of the config file, e.g. a password in the values of a chart
+var sensitiveFlagWords = []string{"password", "secret", "token"}
+
+func isSensitive(flag *pflag.Flag) bool {
+	if flag == nil || flag.Value.Type() == "bool" {
+		return false
+	}
+	if flag.Name == "set" {
+		return true
+	}
+	for _, word := range sensitiveFlagWords {
+		if strings.Contains(flag.Name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactArgs returns a copy of args, the arguments of a command with flags, with the values of its sensitive
+// flags, e.g. --set, replaced by Redacted
+func RedactArgs(args []string, flags *pflag.FlagSet) []string {
+	redacted := append([]string{}, args...)
+	for i := 0; i < len(redacted); i++ {
+		arg := redacted[i]
+		if arg == "--" {
+			break
+		}
+		var (
+			flag     *pflag.Flag
+			name     string
+			hasValue bool
+		)
+		switch {
+		case strings.HasPrefix(arg, "--"):
+			name, _, hasValue = strings.Cut(arg, "=")
+			flag = flags.Lookup(strings.TrimPrefix(name, "--"))
+		case strings.HasPrefix(arg, "-") && len(arg) > 1:
+			name, hasValue = arg[:2], len(arg) > 2
+			flag = flags.ShorthandLookup(arg[1:2])
+		default:
+			continue
+		}
+		if !isSensitive(flag) {
+			continue
+		}
+		if hasValue {
+			redacted[i] = name + "=" + Redacted
+		} else if i+1 < len(redacted) {
+			i++
+			redacted[i] = Redacted
+		}
+	}
+	return redacted
+}
+
+// IsMutating returns whether the command at commandPath, e.g. eksctl create nodegroup, changes clusters
+func IsMutating(commandPath string) bool {
+	fields := strings.Fields(commandPath)
+	if len(fields) < 2 {
+		return false
+	}
+	if fields[1] == "utils" {
+		return len(fields) > 2 && !readOnlyUtils[fields[2]]
+	}
+	return mutatingVerbs[fields[1]]
+}
+
+// Recorder records the entry of a running command, it is a progress.Listener collecting the stacks of the command
+type Recorder struct {
+	mu             sync.Mutex
+	entry          Entry
+	started        time.Time
+	provider       api.ClusterProvider
+	stacks         map[string]bool
+	removeListener func()
+}
+
+var (
+	mu      sync.Mutex
+	current *Recorder
+)
+
+// Start starts recording the entry of command run with args, SetCaller and SetClusterConfig add to it until
+// it is stopped
+func Start(command string, args []string) *Recorder {
+	r := NewRecorder(command, args, time.Now())
+	r.removeListener = progress.AddListener(r)
+	mu.Lock()
+	defer mu.Unlock()
+	current = r
+	return r
+}
+
+// SetCaller records the AWS identity the running command authenticated as, and the provider used to send the
+// entry to CloudWatch Logs
+func SetCaller(provider api.ClusterProvider, arn, account string) {
+	if r := currentRecorder(); r != nil {
+		r.SetCaller(provider, arn, account)
+	}
+}
+
+// SetClusterConfig records the ClusterConfig loaded by the running command
+func SetClusterConfig(cfg *api.ClusterConfig) {
+	if r := currentRecorder(); r != nil {
+		r.SetClusterConfig(cfg)
+	}
+}
+
+func currentRecorder() *Recorder {
+	mu.Lock()
+	defer mu.Unlock()
+	return current
+}
+
+// NewRecorder returns a Recorder for command run with args, started at started
+func NewRecorder(command string, args []string, started time.Time) *Recorder {
+	return &Recorder{
+		entry: Entry{
+			Time:    started.UTC(),
+			Command: command,
+			Args:    args,
+			Stacks:  []string{},
+		},
+		started:        started,
+		stacks:         map[string]bool{},
+		removeListener: func() {},
+	}
+}
+
+// SetCaller records the AWS identity the command authenticated as, and the provider used to send the entry
+// to CloudWatch Logs
+func (r *Recorder) SetCaller(provider api.ClusterProvider, arn, account string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.provider = provider
+	r.entry.User = arn
+	r.entry.Account = account
+	if r.entry.Region == "" {
+		r.entry.Region = provider.Region()
+	}
+}
+
+// SetClusterConfig records the cluster, region and hash of cfg
+func (r *Recorder) SetClusterConfig(cfg *api.ClusterConfig) {
+	hash, err := cfg.Hash()
+	if err != nil {
+		logger.Debug("not recording the hash of the ClusterConfig in the audit log: %v", err)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entry.ConfigHash = hash
+	if cfg.Metadata != nil {
+		r.entry.Cluster = cfg.Metadata.Name
+		if cfg.Metadata.Region != "" {
+			r.entry.Region = cfg.Metadata.Region
+		}
+	}
+}
+
+// TaskStarted implements progress.Listener
+func (r *Recorder) TaskStarted(string) {}
+
+// TaskCompleted implements progress.Listener
+func (r *Recorder) TaskCompleted(string, error) {}
+
+// StackStatus implements progress.Listener, the stacks waited for are those created, updated or deleted
+func (r *Recorder) StackStatus(stackName, _ string) {
+	r.addStack(stackName)
+}
+
+// ResourceCreated implements progress.Listener
+func (r *Recorder) ResourceCreated(resourceType, name, _ string) {
+	if resourceType == "AWS::CloudFormation::Stack" {
+		r.addStack(name)
+	}
+}
+
+func (r *Recorder) addStack(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.stacks[name] {
+		r.stacks[name] = true
+		r.entry.Stacks = append(r.entry.Stacks, name)
+	}
+}
+
+// Finish returns the entry of the command, which returned err and exits with exitCode
+func (r *Recorder) Finish(err error, exitCode int, finished time.Time) *Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry := r.entry
+	entry.Stacks = append([]string{}, r.entry.Stacks...)
+	entry.Outcome = OutcomeSucceeded
+	if err != nil {
+		entry.Outcome = OutcomeFailed
+		entry.Error = err.Error()
+	}
+	entry.ExitCode = exitCode
+	entry.Duration = finished.Sub(r.started).Round(time.Millisecond).String()
+	return &entry
+}
+
+// Stop stops recording, and writes the entry of the command, which returned err and exits with exitCode, to the
+// audit logs set by the environment. Failing to write it is only logged, not to change the outcome of the command
+func (r *Recorder) Stop(err error, exitCode int) {
+	r.removeListener()
+	mu.Lock()
+	if current == r {
+		current = nil
+	}
+	mu.Unlock()
+
+	entry := r.Finish(err, exitCode, time.Now())
+	if path, pathErr := LogPath(); pathErr != nil {
+		logger.Warning("not writing the audit log: %v", pathErr)
+	} else if path != "" {
+		if err := AppendToFile(path, entry); err != nil {
+			logger.Warning("writing the audit log: %v", err)
+		}
+	}
+
+	logGroup := os.Getenv(LogGroupEnvVar)
+	if logGroup == "" {
+		return
+	}
+	r.mu.Lock()
+	provider := r.provider
+	r.mu.Unlock()
+	if provider == nil {
+		logger.Warning("not sending the audit log to CloudWatch Logs as the command failed before authenticating to AWS")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := PutToCloudWatchLogs(ctx, provider.CloudWatchLogs(), logGroup, entry); err != nil {
+		logger.Warning("sending the audit log to CloudWatch Logs: %v", err)
+	}
+}
+
+// LogPath returns the file the entries are appended to, set by EKSCTL_AUDIT_LOG, or ~/.eksctl/audit.log, it is
+// empty when the local audit log is turned off
+func LogPath() (string, error) {
+	if path, ok := os.LookupEnv(LogEnvVar); ok {
+		if path == Off {
+			return "", nil
+		}
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".eksctl", "audit.log"), nil
+}
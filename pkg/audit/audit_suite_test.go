@@ -0,0 +1,11 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestAudit(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,202 @@
+package audit_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/audit"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Audit", func() {
+	started := time.Date(2022, 5, 3, 9, 12, 33, 0, time.UTC)
+
+	DescribeTable("IsMutating", func(commandPath string, expected bool) {
+		Expect(audit.IsMutating(commandPath)).To(Equal(expected))
+	},
+		Entry("create cluster", "eksctl create cluster", true),
+		Entry("delete nodegroup", "eksctl delete nodegroup", true),
+		Entry("scale nodegroup", "eksctl scale nodegroup", true),
+		Entry("get clusters", "eksctl get clusters", false),
+		Entry("version", "eksctl version", false),
+		Entry("root", "eksctl", false),
+		Entry("utils update-kube-proxy", "eksctl utils update-kube-proxy", true),
+		Entry("utils write-kubeconfig", "eksctl utils write-kubeconfig", false),
+		Entry("utils describe-stacks", "eksctl utils describe-stacks", false),
+		Entry("utils get-token", "eksctl utils get-token", false),
+		Entry("utils describe-addon-versions", "eksctl utils describe-addon-versions", false),
+		Entry("utils describe-addon-configuration", "eksctl utils describe-addon-configuration", false),
+	)
+
+	DescribeTable("RedactArgs", func(args, expected []string) {
+		flags := pflag.NewFlagSet("create cluster", pflag.ContinueOnError)
+		flags.StringP("name", "n", "", "")
+		flags.StringArray("set", nil, "")
+		flags.StringP("admin-password", "p", "", "")
+		flags.String("token-file", "", "")
+		flags.Bool("encrypt-existing-secrets", false, "")
+		Expect(audit.RedactArgs(args, flags)).To(Equal(expected))
+	},
+		Entry("no sensitive flags",
+			[]string{"create", "cluster", "--name", "dev"},
+			[]string{"create", "cluster", "--name", "dev"}),
+		Entry("--set followed by its value",
+			[]string{"create", "cluster", "--set", "charts[0].values.password=s3cr3t", "-n", "dev"},
+			[]string{"create", "cluster", "--set", audit.Redacted, "-n", "dev"}),
+		Entry("--set with its value",
+			[]string{"create", "cluster", "--set=metadata.name=dev"},
+			[]string{"create", "cluster", "--set=" + audit.Redacted}),
+		Entry("shorthands",
+			[]string{"create", "cluster", "-p", "s3cr3t", "-ps3cr3t"},
+			[]string{"create", "cluster", "-p", audit.Redacted, "-p=" + audit.Redacted}),
+		Entry("flags named after tokens",
+			[]string{"create", "cluster", "--token-file", "/tmp/token"},
+			[]string{"create", "cluster", "--token-file", audit.Redacted}),
+		Entry("boolean flags",
+			[]string{"create", "cluster", "--encrypt-existing-secrets", "dev"},
+			[]string{"create", "cluster", "--encrypt-existing-secrets", "dev"}),
+		Entry("arguments after --",
+			[]string{"create", "cluster", "--", "--set", "dev"},
+			[]string{"create", "cluster", "--", "--set", "dev"}),
+	)
+
+	Describe("Recorder", func() {
+		var (
+			p *mockprovider.MockProvider
+			r *audit.Recorder
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			r = audit.NewRecorder("eksctl create nodegroup", []string{"create", "nodegroup", "-f", "cluster.yaml"}, started)
+		})
+
+		It("records a succeeded command", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "dev"
+			cfg.Metadata.Region = "us-west-2"
+			r.SetCaller(p, "arn:aws:iam::123456789012:user/alice", "123456789012")
+			r.SetClusterConfig(cfg)
+			r.StackStatus("eksctl-dev-nodegroup-ng-1", "CREATE_IN_PROGRESS")
+			r.StackStatus("eksctl-dev-nodegroup-ng-1", "CREATE_COMPLETE")
+			r.ResourceCreated("AWS::CloudFormation::Stack", "eksctl-dev-addon-vpc-cni", "id")
+			r.ResourceCreated("AWS::EC2::Instance", "i-1234", "id")
+
+			entry := r.Finish(nil, 0, started.Add(90*time.Second))
+			Expect(entry.Command).To(Equal("eksctl create nodegroup"))
+			Expect(entry.User).To(Equal("arn:aws:iam::123456789012:user/alice"))
+			Expect(entry.Account).To(Equal("123456789012"))
+			Expect(entry.Cluster).To(Equal("dev"))
+			Expect(entry.Region).To(Equal("us-west-2"))
+			Expect(entry.ConfigHash).To(HavePrefix("sha256:"))
+			Expect(entry.Stacks).To(Equal([]string{"eksctl-dev-nodegroup-ng-1", "eksctl-dev-addon-vpc-cni"}))
+			Expect(entry.Outcome).To(Equal(audit.OutcomeSucceeded))
+			Expect(entry.Error).To(BeEmpty())
+			Expect(entry.Duration).To(Equal("1m30s"))
+		})
+
+		It("hashes the same ClusterConfig the same way", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "dev"
+			r.SetClusterConfig(cfg)
+			other := audit.NewRecorder("eksctl delete cluster", nil, started)
+			other.SetClusterConfig(cfg)
+			Expect(r.Finish(nil, 0, started).ConfigHash).To(Equal(other.Finish(nil, 0, started).ConfigHash))
+		})
+
+		It("records a failed command", func() {
+			entry := r.Finish(errors.New("nodegroup ng-1 already exists"), 1, started)
+			Expect(entry.Outcome).To(Equal(audit.OutcomeFailed))
+			Expect(entry.Error).To(Equal("nodegroup ng-1 already exists"))
+			Expect(entry.ExitCode).To(Equal(1))
+			Expect(entry.User).To(BeEmpty())
+			Expect(entry.Stacks).To(BeEmpty())
+		})
+	})
+
+	Describe("AppendToFile", func() {
+		It("appends the entries as lines of JSON", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "eksctl", "audit.log")
+			for _, cluster := range []string{"dev", "prod"} {
+				entry := audit.NewRecorder("eksctl create cluster", nil, started)
+				cfg := api.NewClusterConfig()
+				cfg.Metadata.Name = cluster
+				entry.SetClusterConfig(cfg)
+				Expect(audit.AppendToFile(path, entry.Finish(nil, 0, started))).To(Succeed())
+			}
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+			Expect(lines).To(HaveLen(2))
+			var entry audit.Entry
+			Expect(json.Unmarshal([]byte(lines[1]), &entry)).To(Succeed())
+			Expect(entry.Cluster).To(Equal("prod"))
+
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+
+	Describe("LogPath", func() {
+		It("is turned off by EKSCTL_AUDIT_LOG=off", func() {
+			GinkgoT().Setenv(audit.LogEnvVar, audit.Off)
+			path, err := audit.LogPath()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(BeEmpty())
+		})
+
+		It("is set by EKSCTL_AUDIT_LOG", func() {
+			GinkgoT().Setenv(audit.LogEnvVar, "/var/log/eksctl.log")
+			Expect(audit.LogPath()).To(Equal("/var/log/eksctl.log"))
+		})
+	})
+
+	Describe("PutToCloudWatchLogs", func() {
+		var (
+			p     *mockprovider.MockProvider
+			entry *audit.Entry
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			entry = audit.NewRecorder("eksctl delete cluster", nil, started).Finish(nil, 0, started)
+		})
+
+		It("puts the entry in the log stream of its day", func() {
+			p.MockCloudWatchLogs().On("CreateLogStream", mock.Anything, mock.MatchedBy(func(input *cloudwatchlogs.CreateLogStreamInput) bool {
+				return *input.LogGroupName == "eksctl-audit" && *input.LogStreamName == "eksctl/2022/05/03"
+			})).Return(nil, &cwltypes.ResourceAlreadyExistsException{})
+			p.MockCloudWatchLogs().On("PutLogEvents", mock.Anything, mock.MatchedBy(func(input *cloudwatchlogs.PutLogEventsInput) bool {
+				return *input.LogStreamName == "eksctl/2022/05/03" && len(input.LogEvents) == 1 &&
+					*input.LogEvents[0].Timestamp == started.UnixMilli() &&
+					strings.Contains(*input.LogEvents[0].Message, `"command":"eksctl delete cluster"`)
+			})).Return(&cloudwatchlogs.PutLogEventsOutput{}, nil)
+
+			Expect(audit.PutToCloudWatchLogs(context.Background(), p.CloudWatchLogs(), "eksctl-audit", entry)).To(Succeed())
+			p.MockCloudWatchLogs().AssertExpectations(GinkgoT())
+		})
+
+		It("fails when the log stream cannot be created", func() {
+			p.MockCloudWatchLogs().On("CreateLogStream", mock.Anything, mock.Anything).Return(nil, &cwltypes.ResourceNotFoundException{})
+
+			err := audit.PutToCloudWatchLogs(context.Background(), p.CloudWatchLogs(), "eksctl-audit", entry)
+			Expect(err).To(MatchError(ContainSubstring("creating log stream eksctl/2022/05/03 in log group eksctl-audit")))
+			p.MockCloudWatchLogs().AssertNotCalled(GinkgoT(), "PutLogEvents", mock.Anything, mock.Anything)
+		})
+	})
+})
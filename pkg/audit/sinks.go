@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// AppendToFile appends entry to the file at path as a line of JSON, creating the file readable by its owner only
+func AppendToFile(path string, entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// logStreamName returns the log stream of entry, one per day, e.g. eksctl/2022/05/03
+func logStreamName(entry *Entry) string {
+	return entry.Time.Format("eksctl/2006/01/02")
+}
+
+// PutToCloudWatchLogs sends entry as a JSON event to the log stream of its day in logGroup, which must exist
+func PutToCloudWatchLogs(ctx context.Context, cwlAPI awsapi.CloudWatchLogs, logGroup string, entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	stream := logStreamName(entry)
+	if _, err := cwlAPI.CreateLogStream(ctx, &cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  &logGroup,
+		LogStreamName: &stream,
+	}); err != nil {
+		var exists *cwltypes.ResourceAlreadyExistsException
+		if !errors.As(err, &exists) {
+			return fmt.Errorf("creating log stream %s in log group %s: %w", stream, logGroup, err)
+		}
+	}
+	if _, err := cwlAPI.PutLogEvents(ctx, &cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  &logGroup,
+		LogStreamName: &stream,
+		LogEvents: []cwltypes.InputLogEvent{
+			{
+				Message:   aws.String(string(data)),
+				Timestamp: aws.Int64(entry.Time.UnixMilli()),
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("putting log event in log group %s: %w", logGroup, err)
+	}
+	return nil
+}
@@ -11,3 +11,8 @@ package generate
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh iam IAM
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh eks EKS
 //go:generate ../../../build/scripts/generate-aws-interfaces.sh outposts Outposts
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh pricing Pricing
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh route53 Route53
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh s3 S3
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh kms KMS
+//go:generate ../../../build/scripts/generate-aws-interfaces.sh servicequotas ServiceQuotas
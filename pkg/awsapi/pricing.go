@@ -0,0 +1,65 @@
+// Code generated by ifacemaker; DO NOT EDIT.
+
+package awsapi
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	. "github.com/aws/aws-sdk-go-v2/service/pricing"
+)
+
+// Pricing provides an interface to the AWS Pricing service.
+type Pricing interface {
+	// Options returns a copy of the client configuration.
+	//
+	// Callers SHOULD NOT perform mutations on any inner structures within client
+	// config. Config overrides should instead be made on a per-operation basis through
+	// functional options.
+	Options() pricing.Options
+	// Returns the metadata for one service or a list of the metadata for all
+	// services. Use this without a service code to get the service codes for all
+	// services. Use it with a service code, such as AmazonEC2 , to get information
+	// specific to that service, such as the attribute names available for that
+	// service. For example, some of the attribute names available for EC2 are
+	// volumeType , maxIopsVolume , operation , locationType , and
+	// instanceCapacity10xlarge .
+	DescribeServices(ctx context.Context, params *DescribeServicesInput, optFns ...func(*Options)) (*DescribeServicesOutput, error)
+	// Returns a list of attribute values. Attributes are similar to the details in a
+	// Price List API offer file. For a list of available attributes, see [Offer File Definitions]in the [Billing and Cost Management User Guide].
+	//
+	// [Billing and Cost Management User Guide]: https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/billing-what-is.html
+	// [Offer File Definitions]: https://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/reading-an-offer.html#pps-defs
+	GetAttributeValues(ctx context.Context, params *GetAttributeValuesInput, optFns ...func(*Options)) (*GetAttributeValuesOutput, error)
+	//	This feature is in preview release and is subject to change. Your use of
+	//
+	// Amazon Web Services Price List API is subject to the Beta Service Participation
+	// terms of the [Amazon Web Services Service Terms](Section 1.10).
+	//
+	// This returns the URL that you can retrieve your Price List file from. This URL
+	// is based on the PriceListArn and FileFormat that you retrieve from the [ListPriceLists]
+	// response.
+	//
+	// [Amazon Web Services Service Terms]: https://aws.amazon.com/service-terms/
+	// [ListPriceLists]: https://docs.aws.amazon.com/aws-cost-management/latest/APIReference/API_pricing_ListPriceLists.html
+	GetPriceListFileUrl(ctx context.Context, params *GetPriceListFileUrlInput, optFns ...func(*Options)) (*GetPriceListFileUrlOutput, error)
+	// Returns a list of all products that match the filter criteria.
+	GetProducts(ctx context.Context, params *GetProductsInput, optFns ...func(*Options)) (*GetProductsOutput, error)
+	//	This feature is in preview release and is subject to change. Your use of
+	//
+	// Amazon Web Services Price List API is subject to the Beta Service Participation
+	// terms of the [Amazon Web Services Service Terms](Section 1.10).
+	//
+	// This returns a list of Price List references that the requester if authorized
+	// to view, given a ServiceCode , CurrencyCode , and an EffectiveDate . Use without
+	// a RegionCode filter to list Price List references from all available Amazon Web
+	// Services Regions. Use with a RegionCode filter to get the Price List reference
+	// that's specific to a specific Amazon Web Services Region. You can use the
+	// PriceListArn from the response to get your preferred Price List files through
+	// the [GetPriceListFileUrl]API.
+	//
+	// [Amazon Web Services Service Terms]: https://aws.amazon.com/service-terms/
+	// [GetPriceListFileUrl]: https://docs.aws.amazon.com/aws-cost-management/latest/APIReference/API_pricing_GetPriceListFileUrl.html
+	ListPriceLists(ctx context.Context, params *ListPriceListsInput, optFns ...func(*Options)) (*ListPriceListsOutput, error)
+}
+
@@ -0,0 +1,68 @@
+// Code generated by ifacemaker; DO NOT EDIT.
+
+package awsapi
+
+import (
+	"context"
+
+	. "github.com/aws/aws-sdk-go-v2/service/servicequotas"
+)
+
+// ServiceQuotas provides an interface to the AWS ServiceQuotas service.
+type ServiceQuotas interface {
+	// Associates your quota request template with your organization. When a new
+	// account is created in your organization, the quota increase requests in the
+	// template are automatically applied to the account. You can add a quota increase
+	// request for any adjustable quota to your template.
+	AssociateServiceQuotaTemplate(ctx context.Context, params *AssociateServiceQuotaTemplateInput, optFns ...func(*Options)) (*AssociateServiceQuotaTemplateOutput, error)
+	// Deletes the quota increase request for the specified quota from your quota
+	// request template.
+	DeleteServiceQuotaIncreaseRequestFromTemplate(ctx context.Context, params *DeleteServiceQuotaIncreaseRequestFromTemplateInput, optFns ...func(*Options)) (*DeleteServiceQuotaIncreaseRequestFromTemplateOutput, error)
+	// Disables your quota request template. After a template is disabled, the quota
+	// increase requests in the template are not applied to new accounts in your
+	// organization. Disabling a quota request template does not apply its quota
+	// increase requests.
+	DisassociateServiceQuotaTemplate(ctx context.Context, params *DisassociateServiceQuotaTemplateInput, optFns ...func(*Options)) (*DisassociateServiceQuotaTemplateOutput, error)
+	// Retrieves the default value for the specified quota. The default value does not
+	// reflect any quota increases.
+	GetAWSDefaultServiceQuota(ctx context.Context, params *GetAWSDefaultServiceQuotaInput, optFns ...func(*Options)) (*GetAWSDefaultServiceQuotaOutput, error)
+	// Retrieves the status of the association for the quota request template.
+	GetAssociationForServiceQuotaTemplate(ctx context.Context, params *GetAssociationForServiceQuotaTemplateInput, optFns ...func(*Options)) (*GetAssociationForServiceQuotaTemplateOutput, error)
+	// Retrieves information about the specified quota increase request.
+	GetRequestedServiceQuotaChange(ctx context.Context, params *GetRequestedServiceQuotaChangeInput, optFns ...func(*Options)) (*GetRequestedServiceQuotaChangeOutput, error)
+	// Retrieves the applied quota value for the specified quota. For some quotas,
+	// only the default values are available. If the applied quota value is not
+	// available for a quota, the quota is not retrieved.
+	GetServiceQuota(ctx context.Context, params *GetServiceQuotaInput, optFns ...func(*Options)) (*GetServiceQuotaOutput, error)
+	// Retrieves information about the specified quota increase request in your quota
+	// request template.
+	GetServiceQuotaIncreaseRequestFromTemplate(ctx context.Context, params *GetServiceQuotaIncreaseRequestFromTemplateInput, optFns ...func(*Options)) (*GetServiceQuotaIncreaseRequestFromTemplateOutput, error)
+	// Lists the default values for the quotas for the specified AWS service. A
+	// default value does not reflect any quota increases.
+	ListAWSDefaultServiceQuotas(ctx context.Context, params *ListAWSDefaultServiceQuotasInput, optFns ...func(*Options)) (*ListAWSDefaultServiceQuotasOutput, error)
+	// Retrieves the quota increase requests for the specified service.
+	ListRequestedServiceQuotaChangeHistory(ctx context.Context, params *ListRequestedServiceQuotaChangeHistoryInput, optFns ...func(*Options)) (*ListRequestedServiceQuotaChangeHistoryOutput, error)
+	// Retrieves the quota increase requests for the specified quota.
+	ListRequestedServiceQuotaChangeHistoryByQuota(ctx context.Context, params *ListRequestedServiceQuotaChangeHistoryByQuotaInput, optFns ...func(*Options)) (*ListRequestedServiceQuotaChangeHistoryByQuotaOutput, error)
+	// Lists the quota increase requests in the specified quota request template.
+	ListServiceQuotaIncreaseRequestsInTemplate(ctx context.Context, params *ListServiceQuotaIncreaseRequestsInTemplateInput, optFns ...func(*Options)) (*ListServiceQuotaIncreaseRequestsInTemplateOutput, error)
+	// Lists the applied quota values for the specified AWS service. For some quotas,
+	// only the default values are available. If the applied quota value is not
+	// available for a quota, the quota is not retrieved.
+	ListServiceQuotas(ctx context.Context, params *ListServiceQuotasInput, optFns ...func(*Options)) (*ListServiceQuotasOutput, error)
+	// Lists the names and codes for the services integrated with Service Quotas.
+	ListServices(ctx context.Context, params *ListServicesInput, optFns ...func(*Options)) (*ListServicesOutput, error)
+	// Returns a list of the tags assigned to the specified applied quota.
+	ListTagsForResource(ctx context.Context, params *ListTagsForResourceInput, optFns ...func(*Options)) (*ListTagsForResourceOutput, error)
+	// Adds a quota increase request to your quota request template.
+	PutServiceQuotaIncreaseRequestIntoTemplate(ctx context.Context, params *PutServiceQuotaIncreaseRequestIntoTemplateInput, optFns ...func(*Options)) (*PutServiceQuotaIncreaseRequestIntoTemplateOutput, error)
+	// Submits a quota increase request for the specified quota.
+	RequestServiceQuotaIncrease(ctx context.Context, params *RequestServiceQuotaIncreaseInput, optFns ...func(*Options)) (*RequestServiceQuotaIncreaseOutput, error)
+	// Adds tags to the specified applied quota. You can include one or more tags to
+	// add to the quota.
+	TagResource(ctx context.Context, params *TagResourceInput, optFns ...func(*Options)) (*TagResourceOutput, error)
+	// Removes tags from the specified applied quota. You can specify one or more tags
+	// to remove.
+	UntagResource(ctx context.Context, params *UntagResourceInput, optFns ...func(*Options)) (*UntagResourceOutput, error)
+}
+
@@ -18,8 +18,13 @@ import (
 	"github.com/weaveworks/eksctl/pkg/utils/strings"
 )
 
+// zoneIDsToAvoid are the zones with insufficient capacity for EKS, in which control plane subnets cannot be created,
+// see https://docs.aws.amazon.com/eks/latest/userguide/network-reqs.html
 var zoneIDsToAvoid = map[string][]string{
-	api.RegionCNNorth1: {"cnn1-az4"}, // https://github.com/weaveworks/eksctl/issues/3916
+	api.RegionUSEast1:    {"use1-az3"},
+	api.RegionUSWest1:    {"usw1-az2"},
+	api.RegionCACentral1: {"cac1-az3"},
+	api.RegionCNNorth1:   {"cnn1-az4"}, // https://github.com/weaveworks/eksctl/issues/3916
 }
 
 func GetAvailabilityZones(ctx context.Context, ec2API awsapi.EC2, region string, spec *api.ClusterConfig) ([]string, error) {
@@ -30,6 +35,9 @@ func GetAvailabilityZones(ctx context.Context, ec2API awsapi.EC2, region string,
 
 	numberOfZones := len(zones)
 	if numberOfZones < api.MinRequiredAvailabilityZones {
+		if instanceTypes := nodes.CollectUniqueInstanceTypes(nodes.ToNodePools(spec)); len(instanceTypes) > 0 {
+			return nil, fmt.Errorf("only %d zones discovered %v offer instance type(s) %s, at least %d are required", numberOfZones, zones, gostrings.Join(instanceTypes, ","), api.MinRequiredAvailabilityZones)
+		}
 		return nil, fmt.Errorf("only %d zones discovered %v, at least %d are required", numberOfZones, zones, api.MinRequiredAvailabilityZones)
 	}
 
@@ -120,7 +128,7 @@ func FilterBasedOnAvailability(ctx context.Context, zones []string, np []api.Nod
 }
 
 func GetInstanceTypeOfferings(ctx context.Context, ec2API awsapi.EC2, instances []string, zones []string) (map[string]map[string]struct{}, error) {
-	output, err := ec2API.DescribeInstanceTypeOfferings(ctx, &ec2.DescribeInstanceTypeOfferingsInput{
+	input := &ec2.DescribeInstanceTypeOfferingsInput{
 		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("instance-type"),
@@ -133,21 +141,25 @@ func GetInstanceTypeOfferings(ctx context.Context, ec2API awsapi.EC2, instances
 		},
 		LocationType: ec2types.LocationTypeAvailabilityZone,
 		MaxResults:   aws.Int32(100),
-	})
-	if err != nil {
-		return nil, fmt.Errorf("unable to list offerings for instance types: %w", err)
 	}
 
 	// zoneToInstanceMap['us-west-1b']['t2.small']=struct{}{}
 	// zoneToInstanceMap['us-west-1b']['t2.large']=struct{}{}
 	zoneToInstanceMap := make(map[string]map[string]struct{})
-	for _, offer := range output.InstanceTypeOfferings {
-		if _, ok := zoneToInstanceMap[aws.ToString(offer.Location)]; !ok {
-			zoneToInstanceMap[aws.ToString(offer.Location)] = make(map[string]struct{})
+	// a page holds at most 100 offerings, which several instance types in every zone of a region exceed
+	p := ec2.NewDescribeInstanceTypeOfferingsPaginator(ec2API, input)
+	for p.HasMorePages() {
+		output, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list offerings for instance types: %w", err)
+		}
+		for _, offer := range output.InstanceTypeOfferings {
+			if _, ok := zoneToInstanceMap[aws.ToString(offer.Location)]; !ok {
+				zoneToInstanceMap[aws.ToString(offer.Location)] = make(map[string]struct{})
+			}
+			zoneToInstanceMap[aws.ToString(offer.Location)][string(offer.InstanceType)] = struct{}{}
 		}
-		zoneToInstanceMap[aws.ToString(offer.Location)][string(offer.InstanceType)] = struct{}{}
 	}
-
 	return zoneToInstanceMap, nil
 }
 
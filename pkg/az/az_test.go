@@ -214,7 +214,7 @@ var _ = Describe("AZ", func() {
 				},
 				LocationType: ec2types.LocationTypeAvailabilityZone,
 				MaxResults:   aws.Int32(100),
-			}).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+			}, mock.Anything).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
 				InstanceTypeOfferings: []ec2types.InstanceTypeOffering{
 					{
 						InstanceType: "t2.small",
@@ -258,6 +258,76 @@ var _ = Describe("AZ", func() {
 		})
 	})
 
+	When("the offerings of the instance types span several pages", func() {
+		BeforeEach(func() {
+			spec.NodeGroups = []*api.NodeGroup{
+				{
+					NodeGroupBase: &api.NodeGroupBase{
+						Name:         "test-az",
+						InstanceType: "t2.small",
+					},
+				},
+			}
+			p.MockEC2().On("DescribeAvailabilityZones", mock.Anything, mock.Anything).Return(&ec2.DescribeAvailabilityZonesOutput{
+				AvailabilityZones: []ec2types.AvailabilityZone{
+					createAvailabilityZone(region, ec2types.AvailabilityZoneStateAvailable, "zone1"),
+					createAvailabilityZone(region, ec2types.AvailabilityZoneStateAvailable, "zone2"),
+					createAvailabilityZone(region, ec2types.AvailabilityZoneStateAvailable, "zone3"),
+				},
+			}, nil)
+			p.MockEC2().On("DescribeInstanceTypeOfferings", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeInstanceTypeOfferingsInput) bool {
+				return input.NextToken == nil
+			}), mock.Anything).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+				InstanceTypeOfferings: []ec2types.InstanceTypeOffering{
+					{InstanceType: "t2.small", Location: aws.String("zone1")},
+				},
+				NextToken: aws.String("token"),
+			}, nil).Once()
+			p.MockEC2().On("DescribeInstanceTypeOfferings", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeInstanceTypeOfferingsInput) bool {
+				return aws.ToString(input.NextToken) == "token"
+			}), mock.Anything).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+				InstanceTypeOfferings: []ec2types.InstanceTypeOffering{
+					{InstanceType: "t2.small", Location: aws.String("zone3")},
+				},
+			}, nil).Once()
+		})
+
+		It("should consider the offerings of all pages", func() {
+			zones, err := az.GetAvailabilityZones(context.Background(), p.MockEC2(), region, spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zones).To(ConsistOf("zone1", "zone3"))
+		})
+	})
+
+	When("too few zones offer the instance types", func() {
+		BeforeEach(func() {
+			spec.NodeGroups = []*api.NodeGroup{
+				{
+					NodeGroupBase: &api.NodeGroupBase{
+						Name:         "test-az",
+						InstanceType: "p4d.24xlarge",
+					},
+				},
+			}
+			p.MockEC2().On("DescribeAvailabilityZones", mock.Anything, mock.Anything).Return(&ec2.DescribeAvailabilityZonesOutput{
+				AvailabilityZones: []ec2types.AvailabilityZone{
+					createAvailabilityZone(region, ec2types.AvailabilityZoneStateAvailable, "zone1"),
+					createAvailabilityZone(region, ec2types.AvailabilityZoneStateAvailable, "zone2"),
+				},
+			}, nil)
+			p.MockEC2().On("DescribeInstanceTypeOfferings", mock.Anything, mock.Anything, mock.Anything).Return(&ec2.DescribeInstanceTypeOfferingsOutput{
+				InstanceTypeOfferings: []ec2types.InstanceTypeOffering{
+					{InstanceType: "p4d.24xlarge", Location: aws.String("zone2")},
+				},
+			}, nil)
+		})
+
+		It("errors naming the instance types", func() {
+			_, err := az.GetAvailabilityZones(context.Background(), p.MockEC2(), region, spec)
+			Expect(err).To(MatchError("only 1 zones discovered [zone2] offer instance type(s) p4d.24xlarge, at least 2 are required"))
+		})
+	})
+
 	When("fetching the AZs errors", func() {
 		BeforeEach(func() {
 			p.MockEC2().On("DescribeAvailabilityZones", mock.Anything, &ec2.DescribeAvailabilityZonesInput{
@@ -349,6 +419,20 @@ var _ = Describe("AZ", func() {
 			}, nil)
 		})
 
+		It("should not use the zone with insufficient capacity for EKS", func() {
+			p.MockEC2().ExpectedCalls = nil
+			p.MockEC2().On("DescribeAvailabilityZones", mock.Anything, mock.Anything).Return(&ec2.DescribeAvailabilityZonesOutput{
+				AvailabilityZones: []ec2types.AvailabilityZone{
+					createAvailabilityZoneWithID(region, ec2types.AvailabilityZoneStateAvailable, "us-east-1a", "use1-az1"),
+					createAvailabilityZoneWithID(region, ec2types.AvailabilityZoneStateAvailable, "us-east-1b", "use1-az3"),
+					createAvailabilityZoneWithID(region, ec2types.AvailabilityZoneStateAvailable, "us-east-1c", "use1-az6"),
+				},
+			}, nil)
+			zones, err := az.GetAvailabilityZones(context.Background(), p.MockEC2(), region, spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zones).To(ConsistOf("us-east-1a", "us-east-1c"))
+		})
+
 		It("should only use 2 AZs, rather than the default 3", func() {
 			zones, err := az.GetAvailabilityZones(context.Background(), p.MockEC2(), region, spec)
 			Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,249 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	gfn "github.com/weaveworks/goformation/v4/cloudformation"
+	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
+	gfnfirehose "github.com/weaveworks/goformation/v4/cloudformation/kinesisfirehose"
+	gfnlogs "github.com/weaveworks/goformation/v4/cloudformation/logs"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	auditLogDeliveryStreamResource         = "AuditLogDeliveryStream"
+	auditLogDeliveryRoleResource           = "AuditLogDeliveryRole"
+	auditLogDeliveryRolePolicyResource     = "PolicyAuditLogDelivery"
+	auditLogSubscriptionRoleResource       = "AuditLogSubscriptionRole"
+	auditLogSubscriptionRolePolicyResource = "PolicyAuditLogSubscription"
+	auditLogSubscriptionFilterResource     = "AuditLogSubscriptionFilter"
+)
+
+// AuditLogDestination is where the audit logs are exported to. Either DeliveryStreamARN, S3BucketARN or
+// OpenSearchDomainARN along with S3BucketARN for the documents that failed to be indexed must be set.
+type AuditLogDestination struct {
+	// DeliveryStreamARN is an existing Firehose delivery stream
+	DeliveryStreamARN string
+	// S3BucketARN is the bucket a new delivery stream writes to
+	S3BucketARN string
+	// S3Prefix is prepended to the keys of the objects written to the bucket
+	S3Prefix string
+	// OpenSearchDomainARN is the OpenSearch domain a new delivery stream indexes to
+	OpenSearchDomainARN string
+	// OpenSearchIndexName is the name of the index in the OpenSearch domain
+	OpenSearchIndexName string
+}
+
+// AuditLogExportResourceSet stores the resources that stream the audit logs of a cluster from CloudWatch Logs
+// to a Firehose delivery stream, creating the delivery stream unless an existing one is used
+type AuditLogExportResourceSet struct {
+	rs            *resourceSet
+	logGroupName  string
+	filterPattern string
+	destination   AuditLogDestination
+
+	// DeliveryStreamARN is set once the stack has been created
+	DeliveryStreamARN string
+}
+
+// NewAuditLogExportResourceSet returns a resource set that subscribes the delivery stream of destination to the log
+// events of logGroupName matching filterPattern
+func NewAuditLogExportResourceSet(logGroupName, filterPattern string, destination AuditLogDestination) *AuditLogExportResourceSet {
+	return &AuditLogExportResourceSet{
+		rs:            newResourceSet(),
+		logGroupName:  logGroupName,
+		filterPattern: filterPattern,
+		destination:   destination,
+	}
+}
+
+// AddAllResources adds all the audit log export resources to the resource set
+func (a *AuditLogExportResourceSet) AddAllResources() error {
+	a.rs.template.Description = fmt.Sprintf("Audit log export %s", templateDescriptionSuffix)
+
+	var deliveryStreamARN *gfnt.Value
+	if a.destination.DeliveryStreamARN != "" {
+		deliveryStreamARN = gfnt.NewString(a.destination.DeliveryStreamARN)
+	} else {
+		if err := a.addDeliveryStream(); err != nil {
+			return err
+		}
+		deliveryStreamARN = gfnt.MakeFnGetAttString(auditLogDeliveryStreamResource, "Arn")
+	}
+
+	refSubscriptionRole := a.rs.newResource(auditLogSubscriptionRoleResource, &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+			gfnt.NewString("logs.amazonaws.com"),
+		),
+	})
+	a.rs.attachAllowPolicy(auditLogSubscriptionRolePolicyResource, refSubscriptionRole, []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": deliveryStreamARN,
+			"Action": []string{
+				"firehose:PutRecord",
+				"firehose:PutRecordBatch",
+			},
+		},
+	})
+
+	subscriptionFilter := &gfnlogs.SubscriptionFilter{
+		LogGroupName:   gfnt.NewString(a.logGroupName),
+		FilterPattern:  gfnt.NewString(a.filterPattern),
+		DestinationArn: deliveryStreamARN,
+		RoleArn:        gfnt.MakeFnGetAttString(auditLogSubscriptionRoleResource, "Arn"),
+	}
+	// the role must be allowed to put records before CloudWatch Logs tests the subscription
+	subscriptionFilter.AWSCloudFormationDependsOn = []string{auditLogSubscriptionRolePolicyResource}
+	a.rs.newResource(auditLogSubscriptionFilterResource, subscriptionFilter)
+	a.rs.withIAM = true
+
+	a.rs.defineOutput(outputs.AuditLogDeliveryStreamARN, deliveryStreamARN, false, func(v string) error {
+		a.DeliveryStreamARN = v
+		return nil
+	})
+	return nil
+}
+
+func (a *AuditLogExportResourceSet) addDeliveryStream() error {
+	if a.destination.S3BucketARN == "" {
+		return errors.New("an S3 bucket is required to create a delivery stream")
+	}
+
+	refDeliveryRole := a.rs.newResource(auditLogDeliveryRoleResource, &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+			gfnt.NewString("firehose.amazonaws.com"),
+		),
+	})
+	a.rs.attachAllowPolicy(auditLogDeliveryRolePolicyResource, refDeliveryRole, a.deliveryStatements())
+	deliveryRoleARN := gfnt.MakeFnGetAttString(auditLogDeliveryRoleResource, "Arn")
+
+	s3Configuration := &gfnfirehose.DeliveryStream_S3DestinationConfiguration{
+		BucketARN: gfnt.NewString(a.destination.S3BucketARN),
+		RoleARN:   deliveryRoleARN,
+	}
+	if a.destination.S3Prefix != "" {
+		s3Configuration.Prefix = gfnt.NewString(a.destination.S3Prefix)
+	}
+
+	deliveryStream := &gfnfirehose.DeliveryStream{
+		DeliveryStreamType: gfnt.NewString("DirectPut"),
+	}
+	if a.destination.OpenSearchDomainARN != "" {
+		deliveryStream.AmazonopensearchserviceDestinationConfiguration = &gfnfirehose.DeliveryStream_AmazonopensearchserviceDestinationConfiguration{
+			DomainARN:               gfnt.NewString(a.destination.OpenSearchDomainARN),
+			IndexName:               gfnt.NewString(a.destination.OpenSearchIndexName),
+			RoleARN:                 deliveryRoleARN,
+			S3BackupMode:            gfnt.NewString("FailedDocumentsOnly"),
+			S3Configuration:         s3Configuration,
+			ProcessingConfiguration: makeCloudWatchLogsProcessingConfiguration(),
+		}
+	} else {
+		deliveryStream.ExtendedS3DestinationConfiguration = &gfnfirehose.DeliveryStream_ExtendedS3DestinationConfiguration{
+			BucketARN:               s3Configuration.BucketARN,
+			RoleARN:                 s3Configuration.RoleARN,
+			Prefix:                  s3Configuration.Prefix,
+			ProcessingConfiguration: makeCloudWatchLogsProcessingConfiguration(),
+		}
+	}
+	// the role must be allowed to write to the destination before Firehose validates it
+	deliveryStream.AWSCloudFormationDependsOn = []string{auditLogDeliveryRolePolicyResource}
+	a.rs.newResource(auditLogDeliveryStreamResource, deliveryStream)
+	return nil
+}
+
+// makeCloudWatchLogsProcessingConfiguration decompresses the records sent by CloudWatch Logs and extracts the log
+// events from them, so that the destination receives the audit events as JSON documents
+func makeCloudWatchLogsProcessingConfiguration() *gfnfirehose.DeliveryStream_ProcessingConfiguration {
+	return &gfnfirehose.DeliveryStream_ProcessingConfiguration{
+		Enabled: gfnt.True(),
+		Processors: []gfnfirehose.DeliveryStream_Processor{
+			{
+				Type: gfnt.NewString("Decompression"),
+				Parameters: []gfnfirehose.DeliveryStream_ProcessorParameter{
+					{
+						ParameterName:  gfnt.NewString("CompressionFormat"),
+						ParameterValue: gfnt.NewString("GZIP"),
+					},
+				},
+			},
+			{
+				Type: gfnt.NewString("CloudWatchLogProcessing"),
+				Parameters: []gfnfirehose.DeliveryStream_ProcessorParameter{
+					{
+						ParameterName:  gfnt.NewString("DataMessageExtraction"),
+						ParameterValue: gfnt.NewString("true"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *AuditLogExportResourceSet) deliveryStatements() []cft.MapOfInterfaces {
+	statements := []cft.MapOfInterfaces{
+		{
+			"Effect": effectAllow,
+			"Resource": []string{
+				a.destination.S3BucketARN,
+				a.destination.S3BucketARN + "/*",
+			},
+			"Action": []string{
+				"s3:AbortMultipartUpload",
+				"s3:GetBucketLocation",
+				"s3:GetObject",
+				"s3:ListBucket",
+				"s3:ListBucketMultipartUploads",
+				"s3:PutObject",
+			},
+		},
+	}
+	if a.destination.OpenSearchDomainARN != "" {
+		statements = append(statements, cft.MapOfInterfaces{
+			"Effect": effectAllow,
+			"Resource": []string{
+				a.destination.OpenSearchDomainARN,
+				a.destination.OpenSearchDomainARN + "/*",
+			},
+			"Action": []string{
+				"es:DescribeDomain",
+				"es:DescribeDomains",
+				"es:DescribeDomainConfig",
+				"es:ESHttpGet",
+				"es:ESHttpPost",
+				"es:ESHttpPut",
+			},
+		})
+	}
+	return statements
+}
+
+// RenderJSON returns the rendered JSON
+func (a *AuditLogExportResourceSet) RenderJSON() ([]byte, error) {
+	return a.rs.renderJSON()
+}
+
+// Template returns the CloudFormation template
+func (a *AuditLogExportResourceSet) Template() gfn.Template {
+	return *a.rs.template
+}
+
+// WithIAM implements the ResourceSet interface
+func (a *AuditLogExportResourceSet) WithIAM() bool {
+	return a.rs.withIAM
+}
+
+// WithNamedIAM implements the ResourceSet interface
+func (a *AuditLogExportResourceSet) WithNamedIAM() bool {
+	return false
+}
+
+// GetAllOutputs collects all outputs of the audit log export stack
+func (a *AuditLogExportResourceSet) GetAllOutputs(stack types.Stack) error {
+	return a.rs.GetAllOutputs(stack)
+}
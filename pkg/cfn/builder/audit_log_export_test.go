@@ -0,0 +1,109 @@
+package builder_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("Audit log export stack", func() {
+	type template struct {
+		Resources map[string]struct {
+			Type       string
+			Properties map[string]interface{}
+			DependsOn  []string
+		}
+		Outputs map[string]struct {
+			Value interface{}
+		}
+	}
+
+	render := func(rs *builder.AuditLogExportResourceSet) template {
+		Expect(rs.AddAllResources()).To(Succeed())
+		data, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		var t template
+		Expect(json.Unmarshal(data, &t)).To(Succeed())
+		return t
+	}
+
+	const logGroupName = "/aws/eks/my-cluster/cluster"
+
+	It("subscribes an existing delivery stream to the log group", func() {
+		t := render(builder.NewAuditLogExportResourceSet(logGroupName, `{ $.kind = "Event" }`, builder.AuditLogDestination{
+			DeliveryStreamARN: "arn:aws:firehose:us-west-2:123456789012:deliverystream/audit",
+		}))
+
+		Expect(t.Resources).To(HaveLen(3))
+		Expect(t.Resources).NotTo(HaveKey("AuditLogDeliveryStream"))
+		Expect(t.Resources["AuditLogSubscriptionRole"].Type).To(Equal("AWS::IAM::Role"))
+		Expect(t.Resources["PolicyAuditLogSubscription"].Type).To(Equal("AWS::IAM::Policy"))
+
+		filter := t.Resources["AuditLogSubscriptionFilter"]
+		Expect(filter.Type).To(Equal("AWS::Logs::SubscriptionFilter"))
+		Expect(filter.DependsOn).To(ConsistOf("PolicyAuditLogSubscription"))
+		Expect(filter.Properties).To(And(
+			HaveKeyWithValue("LogGroupName", logGroupName),
+			HaveKeyWithValue("FilterPattern", `{ $.kind = "Event" }`),
+			HaveKeyWithValue("DestinationArn", "arn:aws:firehose:us-west-2:123456789012:deliverystream/audit"),
+		))
+		Expect(t.Outputs["DeliveryStreamARN"].Value).To(Equal("arn:aws:firehose:us-west-2:123456789012:deliverystream/audit"))
+	})
+
+	It("creates a delivery stream to S3", func() {
+		t := render(builder.NewAuditLogExportResourceSet(logGroupName, `{ $.kind = "Event" }`, builder.AuditLogDestination{
+			S3BucketARN: "arn:aws:s3:::audit-logs",
+			S3Prefix:    "my-cluster/",
+		}))
+
+		Expect(t.Resources).To(HaveLen(6))
+		Expect(t.Resources["AuditLogDeliveryRole"].Type).To(Equal("AWS::IAM::Role"))
+		deliveryStream := t.Resources["AuditLogDeliveryStream"]
+		Expect(deliveryStream.Type).To(Equal("AWS::KinesisFirehose::DeliveryStream"))
+		Expect(deliveryStream.DependsOn).To(ConsistOf("PolicyAuditLogDelivery"))
+		Expect(deliveryStream.Properties).To(HaveKey("ExtendedS3DestinationConfiguration"))
+		s3Config := deliveryStream.Properties["ExtendedS3DestinationConfiguration"].(map[string]interface{})
+		Expect(s3Config).To(And(
+			HaveKeyWithValue("BucketARN", "arn:aws:s3:::audit-logs"),
+			HaveKeyWithValue("Prefix", "my-cluster/"),
+			HaveKey("ProcessingConfiguration"),
+		))
+		Expect(t.Resources["AuditLogSubscriptionFilter"].Properties["DestinationArn"]).To(Equal(map[string]interface{}{
+			"Fn::GetAtt": []interface{}{"AuditLogDeliveryStream", "Arn"},
+		}))
+	})
+
+	It("creates a delivery stream to OpenSearch backed up to S3", func() {
+		t := render(builder.NewAuditLogExportResourceSet(logGroupName, `{ $.kind = "Event" }`, builder.AuditLogDestination{
+			S3BucketARN:         "arn:aws:s3:::audit-logs",
+			OpenSearchDomainARN: "arn:aws:es:us-west-2:123456789012:domain/audit",
+			OpenSearchIndexName: "eks-audit-logs",
+		}))
+
+		deliveryStream := t.Resources["AuditLogDeliveryStream"]
+		Expect(deliveryStream.Properties).NotTo(HaveKey("ExtendedS3DestinationConfiguration"))
+		openSearchConfig := deliveryStream.Properties["AmazonopensearchserviceDestinationConfiguration"].(map[string]interface{})
+		Expect(openSearchConfig).To(And(
+			HaveKeyWithValue("DomainARN", "arn:aws:es:us-west-2:123456789012:domain/audit"),
+			HaveKeyWithValue("IndexName", "eks-audit-logs"),
+			HaveKeyWithValue("S3BackupMode", "FailedDocumentsOnly"),
+			HaveKey("S3Configuration"),
+		))
+
+		policy, err := json.Marshal(t.Resources["PolicyAuditLogDelivery"].Properties["PolicyDocument"])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(policy)).To(And(
+			ContainSubstring(`"arn:aws:es:us-west-2:123456789012:domain/audit/*"`),
+			ContainSubstring(`"es:ESHttpPost"`),
+			ContainSubstring(`"arn:aws:s3:::audit-logs/*"`),
+		))
+	})
+
+	It("requires an S3 bucket to create a delivery stream", func() {
+		rs := builder.NewAuditLogExportResourceSet(logGroupName, `{ $.kind = "Event" }`, builder.AuditLogDestination{})
+		Expect(rs.AddAllResources()).To(MatchError("an S3 bucket is required to create a delivery stream"))
+	})
+})
@@ -0,0 +1,42 @@
+package builder
+
+import (
+	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const cfnAutoModeNodeRoleResource = "AutoModeNodeRole"
+
+// addResourcesForAutoMode adds the IAM role assumed by the nodes launched by Auto Mode,
+// when its ARN is not supplied by the user
+func (c *ClusterResourceSet) addResourcesForAutoMode() {
+	autoModeConfig := c.spec.AutoModeConfig
+	if !autoModeConfig.HasAutoModeNodePools() {
+		return
+	}
+	if autoModeConfig.NodeRoleARN != "" {
+		c.rs.defineOutputWithoutCollector(outputs.ClusterAutoModeNodeRoleARN, autoModeConfig.NodeRoleARN, false)
+		return
+	}
+
+	c.rs.withIAM = true
+	role := &gfniam.Role{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(MakeServiceRef("EC2")),
+		ManagedPolicyArns: gfnt.NewSlice(makePolicyARNs(
+			iamPolicyAmazonEKSWorkerNodeMinimalPolicy,
+			iamPolicyAmazonEC2ContainerRegistryPullOnly,
+		)...),
+	}
+	if api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRolePermissionsBoundary) {
+		role.PermissionsBoundary = gfnt.NewString(*c.spec.IAM.ServiceRolePermissionsBoundary)
+	}
+	c.newResource(cfnAutoModeNodeRoleResource, role)
+	c.rs.defineOutputFromAtt(outputs.ClusterAutoModeNodeRoleARN, cfnAutoModeNodeRoleResource, "Arn", false, func(v string) error {
+		autoModeConfig.NodeRoleARN = v
+		return nil
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -87,10 +88,18 @@ func (c *ClusterResourceSet) AddAllResources(ctx context.Context) error {
 	c.addResourcesForIAM()
 	c.addResourcesForControlPlane(subnetDetails)
 
+	if c.spec.VPC.FlowLogs != nil {
+		c.addResourcesForVPCFlowLogs(vpcID)
+	}
+
 	if len(c.spec.FargateProfiles) > 0 {
 		c.addResourcesForFargate()
 	}
 
+	if c.spec.IsAutoModeEnabled() {
+		c.addResourcesForAutoMode()
+	}
+
 	c.rs.defineOutput(outputs.ClusterStackName, gfnt.RefStackName, false, func(v string) error {
 		if c.spec.Status == nil {
 			c.spec.Status = &api.ClusterStatus{}
@@ -146,10 +155,18 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups(vpcID *gfnt.Value) *c
 				})
 			}
 		}
+
+		for i, rule := range c.spec.VPC.ControlPlaneSecurityGroupIngress {
+			c.newResource(fmt.Sprintf("IngressControlPlaneRule%d", i), makeControlPlaneIngressRule(refControlPlaneSG, rule))
+		}
 	} else {
 		refControlPlaneSG = gfnt.NewString(c.spec.VPC.SecurityGroup)
 	}
-	c.securityGroups = []*gfnt.Value{refControlPlaneSG} // only this one SG is passed to EKS API, nodes are isolated
+	c.securityGroups = []*gfnt.Value{refControlPlaneSG} // this SG is passed to EKS API, nodes are isolated
+	// user-supplied extra SGs are attached to the control plane as well
+	for _, id := range c.spec.VPC.ExtraControlPlaneSecurityGroupIDs {
+		c.securityGroups = append(c.securityGroups, gfnt.NewString(id))
+	}
 
 	if c.spec.VPC.SharedNodeSecurityGroup == "" {
 		refClusterSharedNodeSG = c.newResource(cfnSharedNodeSGResource, &gfnec2.SecurityGroup{
@@ -223,6 +240,26 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups(vpcID *gfnt.Value) *c
 	}
 }
 
+func makeControlPlaneIngressRule(refControlPlaneSG *gfnt.Value, rule api.ControlPlaneSecurityGroupIngressRule) *gfnec2.SecurityGroupIngress {
+	description := rule.Description
+	if description == "" {
+		description = fmt.Sprintf("Allow %s to communicate to controlplane on port %d", rule.CIDR, rule.Port)
+	}
+	ingress := &gfnec2.SecurityGroupIngress{
+		GroupId:     refControlPlaneSG,
+		Description: gfnt.NewString(description),
+		IpProtocol:  gfnt.NewString("tcp"),
+		FromPort:    gfnt.NewInteger(rule.Port),
+		ToPort:      gfnt.NewInteger(rule.Port),
+	}
+	if ip, _, err := net.ParseCIDR(rule.CIDR); err == nil && ip.To4() == nil {
+		ingress.CidrIpv6 = gfnt.NewString(rule.CIDR)
+	} else {
+		ingress.CidrIp = gfnt.NewString(rule.CIDR)
+	}
+	return ingress
+}
+
 // RenderJSON returns the rendered JSON
 func (c *ClusterResourceSet) RenderJSON() ([]byte, error) {
 	return c.rs.renderJSON()
@@ -250,6 +250,49 @@ var _ = Describe("Cluster Template Builder", func() {
 			})
 		})
 
+		Context("when controlPlaneSecurityGroupIngress is defined", func() {
+			BeforeEach(func() {
+				cfg.VPC.ControlPlaneSecurityGroupIngress = []api.ControlPlaneSecurityGroupIngressRule{
+					{CIDR: "10.10.0.0/16", Port: 443},
+					{CIDR: "2002::/64", Port: 8443, Description: "management network"},
+				}
+			})
+
+			It("should add control plane ingress rules", func() {
+				Expect(clusterTemplate.Resources["IngressControlPlaneRule0"].Properties).To(Equal(fakes.Properties{
+					CidrIP:     "10.10.0.0/16",
+					IPProtocol: "tcp",
+					FromPort:   443,
+					ToPort:     443,
+					GroupID: map[string]interface{}{
+						"Ref": "ControlPlaneSecurityGroup"},
+					Description: "Allow 10.10.0.0/16 to communicate to controlplane on port 443",
+				}))
+				Expect(clusterTemplate.Resources["IngressControlPlaneRule1"].Properties).To(Equal(fakes.Properties{
+					CidrIPv6:   "2002::/64",
+					IPProtocol: "tcp",
+					FromPort:   8443,
+					ToPort:     8443,
+					GroupID: map[string]interface{}{
+						"Ref": "ControlPlaneSecurityGroup"},
+					Description: "management network",
+				}))
+			})
+		})
+
+		Context("when extraControlPlaneSecurityGroupIDs are defined", func() {
+			BeforeEach(func() {
+				cfg.VPC.ExtraControlPlaneSecurityGroupIDs = []string{"sg-1", "sg-2"}
+			})
+
+			It("should attach them to the control plane after the control plane security group", func() {
+				securityGroupIDs := clusterTemplate.Resources["ControlPlane"].Properties.ResourcesVpcConfig.SecurityGroupIds
+				Expect(securityGroupIDs).To(HaveLen(3))
+				Expect(isRefTo(securityGroupIDs[0], "ControlPlaneSecurityGroup")).To(BeTrue())
+				Expect(securityGroupIDs[1:]).To(Equal([]interface{}{"sg-1", "sg-2"}))
+			})
+		})
+
 		Context("if SharedNodeSecurityGroup is set", func() {
 			BeforeEach(func() {
 				cfg.VPC.SharedNodeSecurityGroup = "foo"
@@ -308,6 +351,49 @@ var _ = Describe("Cluster Template Builder", func() {
 			})
 		})
 
+		Context("when VPC flow logs are enabled", func() {
+			BeforeEach(func() {
+				cfg.VPC.FlowLogs = &api.VPCFlowLogs{
+					DestinationType:    api.FlowLogsDestinationCloudWatchLogs,
+					TrafficType:        api.FlowLogsTrafficTypeReject,
+					LogRetentionInDays: 30,
+				}
+			})
+
+			It("adds a flow log delivering to an eksctl-created log group", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("VPCFlowLog"))
+				flowLog := clusterTemplate.Resources["VPCFlowLog"].Properties
+				Expect(flowLog.TrafficType).To(Equal(api.FlowLogsTrafficTypeReject))
+				Expect(flowLog.LogDestinationType).To(Equal(api.FlowLogsDestinationCloudWatchLogs))
+				Expect(flowLog.LogDestination).To(Equal(makeGetAttr("VPCFlowLogGroup", "Arn")))
+				Expect(flowLog.DeliverLogsPermissionArn).To(Equal(makeGetAttr("VPCFlowLogRole", "Arn")))
+
+				Expect(clusterTemplate.Resources).To(HaveKey("VPCFlowLogGroup"))
+				Expect(clusterTemplate.Resources["VPCFlowLogGroup"].Properties.LogGroupName).To(Equal("/aws/vpc/" + cfg.Metadata.Name + "/flowlogs"))
+				Expect(clusterTemplate.Resources["VPCFlowLogGroup"].Properties.RetentionInDays).To(Equal(30))
+				Expect(clusterTemplate.Resources).To(HaveKey("VPCFlowLogRole"))
+				Expect(clusterTemplate.Resources).To(HaveKey("PolicyVPCFlowLogDelivery"))
+			})
+
+			When("the destination is an S3 bucket", func() {
+				BeforeEach(func() {
+					cfg.VPC.FlowLogs = &api.VPCFlowLogs{
+						DestinationType: api.FlowLogsDestinationS3,
+						DestinationARN:  "arn:aws:s3:::flow-logs-bucket/eks",
+						TrafficType:     api.FlowLogsTrafficTypeAll,
+					}
+				})
+
+				It("delivers to the bucket without creating a log group or IAM role", func() {
+					Expect(clusterTemplate.Resources).To(HaveKey("VPCFlowLog"))
+					Expect(clusterTemplate.Resources["VPCFlowLog"].Properties.LogDestination).To(Equal("arn:aws:s3:::flow-logs-bucket/eks"))
+					Expect(clusterTemplate.Resources["VPCFlowLog"].Properties.DeliverLogsPermissionArn).To(BeNil())
+					Expect(clusterTemplate.Resources).NotTo(HaveKey("VPCFlowLogGroup"))
+					Expect(clusterTemplate.Resources).NotTo(HaveKey("VPCFlowLogRole"))
+				})
+			})
+		})
+
 		Context("when ServiceRolePermissionsBoundary is set", func() {
 			BeforeEach(func() {
 				pb := "foo"
@@ -585,6 +671,59 @@ var _ = Describe("Cluster Template Builder", func() {
 			})
 		})
 
+		Context("when Auto Mode is enabled", func() {
+			BeforeEach(func() {
+				cfg.AutoModeConfig = &api.AutoModeConfig{
+					Enabled:   api.Enabled(),
+					NodePools: &[]string{api.AutoModeNodePoolGeneralPurpose, api.AutoModeNodePoolSystem},
+				}
+			})
+
+			It("should add the Auto Mode policies to the service role and allow it to tag sessions", func() {
+				serviceRole := clusterTemplate.Resources["ServiceRole"].Properties
+				Expect(serviceRole.ManagedPolicyArns).To(ContainElements(
+					makePolicyARNRef("AmazonEKSComputePolicy"),
+					makePolicyARNRef("AmazonEKSBlockStoragePolicy"),
+					makePolicyARNRef("AmazonEKSLoadBalancingPolicy"),
+					makePolicyARNRef("AmazonEKSNetworkingPolicy"),
+				))
+				assumeRolePolicy, err := json.Marshal(serviceRole.AssumeRolePolicyDocument)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(assumeRolePolicy)).To(ContainSubstring(`"sts:TagSession"`))
+			})
+
+			It("should add the node role", func() {
+				Expect(clusterTemplate.Resources).To(HaveKey("AutoModeNodeRole"))
+				Expect(clusterTemplate.Resources["AutoModeNodeRole"].Properties.ManagedPolicyArns).To(ConsistOf(
+					makePolicyARNRef("AmazonEKSWorkerNodeMinimalPolicy"),
+					makePolicyARNRef("AmazonEC2ContainerRegistryPullOnly"),
+				))
+				Expect(clusterTemplate.Outputs).To(HaveKey("AutoModeNodeRoleARN"))
+			})
+
+			When("the node role is supplied", func() {
+				BeforeEach(func() {
+					cfg.AutoModeConfig.NodeRoleARN = "arn:aws:iam::111122223333:role/auto-mode-nodes"
+				})
+
+				It("should not create a node role", func() {
+					Expect(clusterTemplate.Resources).NotTo(HaveKey("AutoModeNodeRole"))
+					Expect(clusterTemplate.Outputs).To(HaveKey("AutoModeNodeRoleARN"))
+				})
+			})
+
+			When("no node pools are enabled", func() {
+				BeforeEach(func() {
+					cfg.AutoModeConfig.NodePools = &[]string{}
+				})
+
+				It("should not create a node role", func() {
+					Expect(clusterTemplate.Resources).NotTo(HaveKey("AutoModeNodeRole"))
+					Expect(clusterTemplate.Outputs).NotTo(HaveKey("AutoModeNodeRoleARN"))
+				})
+			})
+		})
+
 		Context("when the spec has insufficient subnets", func() {
 			BeforeEach(func() {
 				cfg.VPC.Subnets = &api.ClusterSubnets{}
@@ -0,0 +1,118 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	gfn "github.com/weaveworks/goformation/v4/cloudformation"
+	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
+	gfnefs "github.com/weaveworks/goformation/v4/cloudformation/efs"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+)
+
+const (
+	efsSecurityGroupResource = "EFSSecurityGroup"
+	efsFileSystemResource    = "FileSystem"
+)
+
+var sgPortNFS = gfnt.NewInteger(2049)
+
+// EFSResourceSet stores the resources of an EFS file system with a mount target in each of the given subnets
+type EFSResourceSet struct {
+	rs                   *resourceSet
+	vpcID                string
+	subnetIDs            []string
+	nodeSecurityGroupIDs []string
+	kmsKeyID             string
+
+	// FileSystemID is set once the stack has been created
+	FileSystemID string
+}
+
+// NewEFSResourceSet returns a resource set for an EFS file system that nodes in the given security groups can mount.
+// There can only be one mount target per availability zone, subnetIDs must be in distinct availability zones.
+func NewEFSResourceSet(vpcID string, subnetIDs, nodeSecurityGroupIDs []string, kmsKeyID string) *EFSResourceSet {
+	return &EFSResourceSet{
+		rs:                   newResourceSet(),
+		vpcID:                vpcID,
+		subnetIDs:            subnetIDs,
+		nodeSecurityGroupIDs: nodeSecurityGroupIDs,
+		kmsKeyID:             kmsKeyID,
+	}
+}
+
+// AddAllResources adds all the EFS resources to the resource set
+func (e *EFSResourceSet) AddAllResources() error {
+	e.rs.template.Description = fmt.Sprintf("EFS file system %s", templateDescriptionSuffix)
+
+	refSG := e.rs.newResource(efsSecurityGroupResource, &gfnec2.SecurityGroup{
+		GroupDescription: gfnt.NewString("Allow nodes to mount the EFS file system"),
+		VpcId:            gfnt.NewString(e.vpcID),
+	})
+	for i, sg := range e.nodeSecurityGroupIDs {
+		e.rs.newResource(fmt.Sprintf("IngressNFS%d", i), &gfnec2.SecurityGroupIngress{
+			GroupId:               refSG,
+			SourceSecurityGroupId: gfnt.NewString(sg),
+			Description:           gfnt.NewString(fmt.Sprintf("Allow NFS traffic from %s", sg)),
+			IpProtocol:            gfnt.NewString("tcp"),
+			FromPort:              sgPortNFS,
+			ToPort:                sgPortNFS,
+		})
+	}
+
+	fileSystem := &gfnefs.FileSystem{
+		Encrypted:       gfnt.True(),
+		PerformanceMode: gfnt.NewString("generalPurpose"),
+		FileSystemTags: []gfnefs.FileSystem_ElasticFileSystemTag{
+			{
+				Key:   gfnt.NewString("Name"),
+				Value: gfnt.MakeFnSubString(fmt.Sprintf("${%s}/%s", gfnt.StackName, efsFileSystemResource)),
+			},
+		},
+	}
+	if e.kmsKeyID != "" {
+		fileSystem.KmsKeyId = gfnt.NewString(e.kmsKeyID)
+	}
+	refFileSystem := e.rs.newResource(efsFileSystemResource, fileSystem)
+
+	for i, subnetID := range e.subnetIDs {
+		e.rs.newResource(fmt.Sprintf("MountTarget%d", i), &gfnefs.MountTarget{
+			FileSystemId:   refFileSystem,
+			SubnetId:       gfnt.NewString(subnetID),
+			SecurityGroups: gfnt.NewSlice(refSG),
+		})
+	}
+
+	e.rs.defineOutput(outputs.EFSFileSystemID, refFileSystem, false, func(v string) error {
+		e.FileSystemID = v
+		return nil
+	})
+	return nil
+}
+
+// RenderJSON returns the rendered JSON
+func (e *EFSResourceSet) RenderJSON() ([]byte, error) {
+	return e.rs.renderJSON()
+}
+
+// Template returns the CloudFormation template
+func (e *EFSResourceSet) Template() gfn.Template {
+	return *e.rs.template
+}
+
+// WithIAM implements the ResourceSet interface
+func (e *EFSResourceSet) WithIAM() bool {
+	return false
+}
+
+// WithNamedIAM implements the ResourceSet interface
+func (e *EFSResourceSet) WithNamedIAM() bool {
+	return false
+}
+
+// GetAllOutputs collects all outputs of the EFS stack
+func (e *EFSResourceSet) GetAllOutputs(stack types.Stack) error {
+	return e.rs.GetAllOutputs(stack)
+}
@@ -0,0 +1,70 @@
+package builder_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("EFS stack", func() {
+	type template struct {
+		Resources map[string]struct {
+			Type       string
+			Properties map[string]interface{}
+		}
+		Outputs map[string]struct {
+			Value interface{}
+		}
+	}
+
+	render := func(rs *builder.EFSResourceSet) template {
+		Expect(rs.AddAllResources()).To(Succeed())
+		data, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		var t template
+		Expect(json.Unmarshal(data, &t)).To(Succeed())
+		return t
+	}
+
+	It("creates the file system, a mount target per subnet and allows NFS traffic from the nodes", func() {
+		t := render(builder.NewEFSResourceSet("vpc-1", []string{"subnet-a", "subnet-b"}, []string{"sg-nodes", "sg-cluster"}, ""))
+
+		Expect(t.Resources).To(HaveLen(6))
+		Expect(t.Resources["EFSSecurityGroup"].Type).To(Equal("AWS::EC2::SecurityGroup"))
+		Expect(t.Resources["EFSSecurityGroup"].Properties).To(HaveKeyWithValue("VpcId", "vpc-1"))
+
+		Expect(t.Resources["IngressNFS0"].Type).To(Equal("AWS::EC2::SecurityGroupIngress"))
+		Expect(t.Resources["IngressNFS0"].Properties).To(And(
+			HaveKeyWithValue("GroupId", map[string]interface{}{"Ref": "EFSSecurityGroup"}),
+			HaveKeyWithValue("SourceSecurityGroupId", "sg-nodes"),
+			HaveKeyWithValue("IpProtocol", "tcp"),
+			HaveKeyWithValue("FromPort", float64(2049)),
+			HaveKeyWithValue("ToPort", float64(2049)),
+		))
+		Expect(t.Resources["IngressNFS1"].Properties).To(HaveKeyWithValue("SourceSecurityGroupId", "sg-cluster"))
+
+		Expect(t.Resources["FileSystem"].Type).To(Equal("AWS::EFS::FileSystem"))
+		Expect(t.Resources["FileSystem"].Properties).To(HaveKeyWithValue("Encrypted", true))
+		Expect(t.Resources["FileSystem"].Properties).NotTo(HaveKey("KmsKeyId"))
+
+		for i, subnet := range []string{"subnet-a", "subnet-b"} {
+			mountTarget := t.Resources["MountTarget"+string(rune('0'+i))]
+			Expect(mountTarget.Type).To(Equal("AWS::EFS::MountTarget"))
+			Expect(mountTarget.Properties).To(And(
+				HaveKeyWithValue("FileSystemId", map[string]interface{}{"Ref": "FileSystem"}),
+				HaveKeyWithValue("SubnetId", subnet),
+				HaveKeyWithValue("SecurityGroups", []interface{}{map[string]interface{}{"Ref": "EFSSecurityGroup"}}),
+			))
+		}
+
+		Expect(t.Outputs["FileSystemID"].Value).To(Equal(map[string]interface{}{"Ref": "FileSystem"}))
+	})
+
+	It("encrypts the file system with the given KMS key", func() {
+		t := render(builder.NewEFSResourceSet("vpc-1", []string{"subnet-a"}, []string{"sg-nodes"}, "arn:aws:kms:us-west-2:123456789012:key/1234"))
+		Expect(t.Resources["FileSystem"].Properties).To(HaveKeyWithValue("KmsKeyId", "arn:aws:kms:us-west-2:123456789012:key/1234"))
+	})
+})
@@ -64,6 +64,8 @@ type Properties struct {
 	VpcID, SubnetID                                         interface{}
 	EgressOnlyInternetGatewayID, RouteTableID, AllocationID interface{}
 	GatewayID, InternetGatewayID, NatGatewayID              interface{}
+	InstanceID, TransitGatewayID                            interface{}
+	SubnetIds                                               []interface{}
 	DestinationCidrBlock, DestinationIpv6CidrBlock          interface{}
 	MapPublicIPOnLaunch                                     bool
 	AssignIpv6AddressOnCreation                             *bool
@@ -75,6 +77,13 @@ type Properties struct {
 
 	AmazonProvidedIpv6CidrBlock bool
 	AvailabilityZone, Domain    string
+	InstanceType                string
+	SourceDestCheck             *bool
+
+	TrafficType, LogDestinationType          string
+	LogDestination, DeliverLogsPermissionArn interface{}
+	LogGroupName                             string
+	RetentionInDays                          int
 
 	Name, Version      string
 	RoleArn            interface{}
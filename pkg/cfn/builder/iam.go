@@ -23,6 +23,11 @@ const (
 	iamPolicyAmazonEKSVPCResourceController     = "AmazonEKSVPCResourceController"
 	iamPolicyAmazonEKSLocalOutpostClusterPolicy = "AmazonEKSLocalOutpostClusterPolicy"
 
+	iamPolicyAmazonEKSComputePolicy       = "AmazonEKSComputePolicy"
+	iamPolicyAmazonEKSBlockStoragePolicy  = "AmazonEKSBlockStoragePolicy"
+	iamPolicyAmazonEKSLoadBalancingPolicy = "AmazonEKSLoadBalancingPolicy"
+	iamPolicyAmazonEKSNetworkingPolicy    = "AmazonEKSNetworkingPolicy"
+
 	iamPolicyAmazonEKSWorkerNodePolicy           = "AmazonEKSWorkerNodePolicy"
 	iamPolicyAmazonEKSCNIPolicy                  = "AmazonEKS_CNI_Policy"
 	iamPolicyAmazonEC2ContainerRegistryPowerUser = "AmazonEC2ContainerRegistryPowerUser"
@@ -30,6 +35,9 @@ const (
 	iamPolicyCloudWatchAgentServerPolicy         = "CloudWatchAgentServerPolicy"
 	iamPolicyAmazonSSMManagedInstanceCore        = "AmazonSSMManagedInstanceCore"
 
+	iamPolicyAmazonEKSWorkerNodeMinimalPolicy   = "AmazonEKSWorkerNodeMinimalPolicy"
+	iamPolicyAmazonEC2ContainerRegistryPullOnly = "AmazonEC2ContainerRegistryPullOnly"
+
 	iamPolicyAmazonEKSFargatePodExecutionRolePolicy = "AmazonEKSFargatePodExecutionRolePolicy"
 )
 
@@ -94,11 +102,21 @@ func (c *ClusterResourceSet) addResourcesForIAM() {
 		if !api.IsDisabled(c.spec.IAM.VPCResourceControllerPolicy) {
 			managedPolicyARNs = append(managedPolicyARNs, iamPolicyAmazonEKSVPCResourceController)
 		}
+		assumeRolePolicyDocument := cft.MakeAssumeRolePolicyDocumentForServices(MakeServiceRef("EKS"))
+		if c.spec.IsAutoModeEnabled() {
+			// Auto Mode needs the cluster role to manage instances, volumes, load balancers and network
+			// interfaces, and tags the sessions it uses for them
+			managedPolicyARNs = append(managedPolicyARNs,
+				iamPolicyAmazonEKSComputePolicy,
+				iamPolicyAmazonEKSBlockStoragePolicy,
+				iamPolicyAmazonEKSLoadBalancingPolicy,
+				iamPolicyAmazonEKSNetworkingPolicy,
+			)
+			assumeRolePolicyDocument = cft.MakeAssumeRoleAndTagSessionPolicyDocumentForServices(MakeServiceRef("EKS"))
+		}
 		role = &gfniam.Role{
-			AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
-				MakeServiceRef("EKS"),
-			),
-			ManagedPolicyArns: gfnt.NewSlice(makePolicyARNs(managedPolicyARNs...)...),
+			AssumeRolePolicyDocument: assumeRolePolicyDocument,
+			ManagedPolicyArns:        gfnt.NewSlice(makePolicyARNs(managedPolicyARNs...)...),
 		}
 	}
 
@@ -0,0 +1,125 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	gfn "github.com/weaveworks/goformation/v4/cloudformation"
+	gfnevents "github.com/weaveworks/goformation/v4/cloudformation/events"
+	gfnsqs "github.com/weaveworks/goformation/v4/cloudformation/sqs"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	nodeTerminationHandlerQueue       = "NodeTerminationHandlerQueue"
+	nodeTerminationHandlerQueuePolicy = "NodeTerminationHandlerQueuePolicy"
+	nodeTerminationHandlerQueueTarget = "NodeTerminationHandlerQueueTarget"
+
+	// ASGTerminationRule is the name of the rule sending Auto Scaling group termination lifecycle actions to the queue
+	ASGTerminationRule = "ASGTerminationRule"
+
+	awsAutoScaling = "aws.autoscaling"
+)
+
+// NodeTerminationHandlerResourceSet stores the resources of the SQS queue and the EventBridge rules
+// used by the AWS Node Termination Handler in queue mode
+type NodeTerminationHandlerResourceSet struct {
+	rs *resourceSet
+
+	// QueueURL is set once the stack has been created
+	QueueURL string
+}
+
+// NewNodeTerminationHandlerResourceSet returns a resource set for the AWS Node Termination Handler queue
+func NewNodeTerminationHandlerResourceSet() *NodeTerminationHandlerResourceSet {
+	return &NodeTerminationHandlerResourceSet{
+		rs: newResourceSet(),
+	}
+}
+
+// AddAllResources adds the queue and the rules sending interruption, rebalance, state change, scheduled change
+// and Auto Scaling group termination events to it
+func (n *NodeTerminationHandlerResourceSet) AddAllResources() error {
+	n.rs.template.Description = fmt.Sprintf("AWS Node Termination Handler queue %s", templateDescriptionSuffix)
+
+	// the queue name is generated by CloudFormation as the Karpenter interruption queue is named after the cluster
+	queueRef := n.rs.newResource(nodeTerminationHandlerQueue, &gfnsqs.Queue{
+		MessageRetentionPeriod: gfnt.NewInteger(defaultMessageRetentionPeriod),
+	})
+	queueARN := gfnt.MakeFnGetAtt(nodeTerminationHandlerQueue, gfnt.NewString("Arn"))
+
+	n.rs.newResource(nodeTerminationHandlerQueuePolicy, &gfnsqs.QueuePolicy{
+		Queues: gfnt.NewSlice(queueRef),
+		PolicyDocument: cft.MakePolicyDocument(cft.MapOfInterfaces{
+			"Effect": effectAllow,
+			"Principal": cft.MapOfInterfaces{
+				"Service": cft.SliceOfInterfaces{
+					eventsService,
+					sqsService,
+				},
+			},
+			"Resource": queueARN,
+			"Action": []string{
+				sqsSendMessage,
+			},
+		}),
+	})
+
+	rules := []struct {
+		name, source, detailType string
+	}{
+		{ASGTerminationRule, awsAutoScaling, "EC2 Instance-terminate Lifecycle Action"},
+		{SpotInterruptionRule, awsEC2, "EC2 Spot Instance Interruption Warning"},
+		{RebalanceRule, awsEC2, "EC2 Instance Rebalance Recommendation"},
+		{InstanceStateChangeRule, awsEC2, "EC2 Instance State-change Notification"},
+		{ScheduledChangeRule, awsHealth, "AWS Health Event"},
+	}
+	for _, r := range rules {
+		n.rs.newResource(r.name, &gfnevents.Rule{
+			EventPattern: cft.MapOfInterfaces{
+				"source":      gfnt.NewSlice(gfnt.NewString(r.source)),
+				"detail-type": gfnt.NewSlice(gfnt.NewString(r.detailType)),
+			},
+			Targets: []gfnevents.Rule_Target{
+				{
+					Id:  gfnt.NewString(nodeTerminationHandlerQueueTarget),
+					Arn: queueARN,
+				},
+			},
+		})
+	}
+
+	n.rs.defineOutput(outputs.NodeTerminationHandlerQueueURL, queueRef, false, func(v string) error {
+		n.QueueURL = v
+		return nil
+	})
+	return nil
+}
+
+// RenderJSON returns the rendered JSON
+func (n *NodeTerminationHandlerResourceSet) RenderJSON() ([]byte, error) {
+	return n.rs.renderJSON()
+}
+
+// Template returns the CloudFormation template
+func (n *NodeTerminationHandlerResourceSet) Template() gfn.Template {
+	return *n.rs.template
+}
+
+// WithIAM implements the ResourceSet interface
+func (n *NodeTerminationHandlerResourceSet) WithIAM() bool {
+	return false
+}
+
+// WithNamedIAM implements the ResourceSet interface
+func (n *NodeTerminationHandlerResourceSet) WithNamedIAM() bool {
+	return false
+}
+
+// GetAllOutputs collects all outputs of the node termination handler stack
+func (n *NodeTerminationHandlerResourceSet) GetAllOutputs(stack types.Stack) error {
+	return n.rs.GetAllOutputs(stack)
+}
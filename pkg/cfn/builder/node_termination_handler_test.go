@@ -0,0 +1,52 @@
+package builder_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("Node Termination Handler stack", func() {
+	type template struct {
+		Resources map[string]struct {
+			Type       string
+			Properties map[string]interface{}
+		}
+		Outputs map[string]struct {
+			Value interface{}
+		}
+	}
+
+	It("creates the queue and the rules sending events to it", func() {
+		rs := builder.NewNodeTerminationHandlerResourceSet()
+		Expect(rs.AddAllResources()).To(Succeed())
+		data, err := rs.RenderJSON()
+		Expect(err).NotTo(HaveOccurred())
+		var t template
+		Expect(json.Unmarshal(data, &t)).To(Succeed())
+
+		Expect(t.Resources).To(HaveLen(7))
+		Expect(t.Resources["NodeTerminationHandlerQueue"].Type).To(Equal("AWS::SQS::Queue"))
+		Expect(t.Resources["NodeTerminationHandlerQueue"].Properties).NotTo(HaveKey("QueueName"))
+		Expect(t.Resources["NodeTerminationHandlerQueuePolicy"].Type).To(Equal("AWS::SQS::QueuePolicy"))
+
+		queueARN := map[string]interface{}{"Fn::GetAtt": []interface{}{"NodeTerminationHandlerQueue", "Arn"}}
+		for name, detailType := range map[string]string{
+			"ASGTerminationRule":      "EC2 Instance-terminate Lifecycle Action",
+			"SpotInterruptionRule":    "EC2 Spot Instance Interruption Warning",
+			"RebalanceRule":           "EC2 Instance Rebalance Recommendation",
+			"InstanceStateChangeRule": "EC2 Instance State-change Notification",
+			"ScheduledChangeRule":     "AWS Health Event",
+		} {
+			rule := t.Resources[name]
+			Expect(rule.Type).To(Equal("AWS::Events::Rule"))
+			Expect(rule.Properties["EventPattern"]).To(HaveKeyWithValue("detail-type", []interface{}{detailType}))
+			Expect(rule.Properties["Targets"]).To(ConsistOf(HaveKeyWithValue("Arn", queueARN)))
+		}
+
+		Expect(t.Outputs["QueueURL"].Value).To(Equal(map[string]interface{}{"Ref": "NodeTerminationHandlerQueue"}))
+	})
+})
@@ -276,6 +276,21 @@ func cloudWatchMetricsStatements() []cft.MapOfInterfaces {
 	}
 }
 
+func vpcFlowLogsDeliveryStatements() []cft.MapOfInterfaces {
+	return []cft.MapOfInterfaces{
+		{
+			"Effect":   effectAllow,
+			"Resource": resourceAll,
+			"Action": []string{
+				"logs:CreateLogStream",
+				"logs:PutLogEvents",
+				"logs:DescribeLogGroups",
+				"logs:DescribeLogStreams",
+			},
+		},
+	}
+}
+
 func certManagerHostedZonesStatements() []cft.MapOfInterfaces {
 	return []cft.MapOfInterfaces{
 		{
@@ -57,7 +57,12 @@ func (e *VPCEndpointResourceSet) AddResources(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	endpointServices := append(api.RequiredEndpointServices(e.clusterConfig.IsControlPlaneOnOutposts()), additionalServices...)
+	endpointServices := api.RequiredEndpointServices(e.clusterConfig.IsControlPlaneOnOutposts())
+	for _, es := range additionalServices {
+		if !hasEndpointService(endpointServices, es) {
+			endpointServices = append(endpointServices, es)
+		}
+	}
 	endpointServiceDetails, err := e.buildVPCEndpointServices(ctx, endpointServices)
 	if err != nil {
 		return fmt.Errorf("error building endpoint service details: %w", err)
@@ -120,7 +125,7 @@ func (e *VPCEndpointResourceSet) buildVPCEndpointServices(ctx context.Context, e
 	serviceNames := make([]string, len(endpointServices))
 	serviceDomain := fmt.Sprintf("com.amazonaws.%s", e.region)
 	for i, endpoint := range endpointServices {
-		serviceNames[i] = makeServiceName(serviceDomain, e.region, endpoint)
+		serviceNames[i] = endpoint.ServiceName(e.region)
 	}
 
 	var (
@@ -158,7 +163,7 @@ func (e *VPCEndpointResourceSet) buildVPCEndpointServices(ctx context.Context, e
 	}
 
 	var ret []VPCEndpointServiceDetails
-	s3ServiceName := makeServiceName(serviceDomain, e.region, api.EndpointServiceS3)
+	s3ServiceName := api.EndpointServiceS3.ServiceName(e.region)
 	for _, sd := range serviceDetails {
 		if len(sd.ServiceType) > 1 {
 			return nil, fmt.Errorf("endpoint service %q with multiple service types isn't supported", *sd.ServiceName)
@@ -198,10 +203,11 @@ func serviceEndpointTypeExpected(serviceName string, endpointType ec2types.Servi
 	return endpointType == ec2types.ServiceTypeInterface
 }
 
-func makeServiceName(domain, region string, endpointService api.EndpointService) string {
-	serviceName := fmt.Sprintf("%s.%s", domain, endpointService.Name)
-	if endpointService.RequiresChinaPrefix && api.Partition(region) == api.PartitionChina {
-		serviceName = "cn." + serviceName
+func hasEndpointService(endpointServices []api.EndpointService, endpointService api.EndpointService) bool {
+	for _, es := range endpointServices {
+		if es.Name == endpointService.Name {
+			return true
+		}
 	}
-	return serviceName
+	return false
 }
@@ -0,0 +1,65 @@
+package builder
+
+import (
+	"fmt"
+
+	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
+	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
+	gfnlogs "github.com/weaveworks/goformation/v4/cloudformation/logs"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const (
+	cfnVPCFlowLogResource           = "VPCFlowLog"
+	cfnVPCFlowLogGroupResource      = "VPCFlowLogGroup"
+	cfnVPCFlowLogRoleResource       = "VPCFlowLogRole"
+	cfnVPCFlowLogRolePolicyResource = "PolicyVPCFlowLogDelivery"
+)
+
+// addResourcesForVPCFlowLogs adds a flow log for the VPC, along with the IAM role required to deliver
+// to CloudWatch Logs and the log group, when its ARN is not supplied by the user
+func (c *ClusterResourceSet) addResourcesForVPCFlowLogs(vpcID *gfnt.Value) {
+	flowLogsConfig := c.spec.VPC.FlowLogs
+
+	flowLog := &gfnec2.FlowLog{
+		ResourceId:         vpcID,
+		ResourceType:       gfnt.NewString("VPC"),
+		TrafficType:        gfnt.NewString(flowLogsConfig.TrafficType),
+		LogDestinationType: gfnt.NewString(flowLogsConfig.DestinationType),
+	}
+	if flowLogsConfig.LogFormat != "" {
+		flowLog.LogFormat = gfnt.NewString(flowLogsConfig.LogFormat)
+	}
+	if flowLogsConfig.MaxAggregationInterval != 0 {
+		flowLog.MaxAggregationInterval = gfnt.NewInteger(flowLogsConfig.MaxAggregationInterval)
+	}
+
+	if flowLogsConfig.DestinationARN != "" {
+		flowLog.LogDestination = gfnt.NewString(flowLogsConfig.DestinationARN)
+	} else {
+		logGroup := &gfnlogs.LogGroup{
+			LogGroupName: gfnt.NewString(fmt.Sprintf("/aws/vpc/%s/flowlogs", c.spec.Metadata.Name)),
+		}
+		if flowLogsConfig.LogRetentionInDays != 0 {
+			logGroup.RetentionInDays = gfnt.NewInteger(flowLogsConfig.LogRetentionInDays)
+		}
+		c.rs.newResource(cfnVPCFlowLogGroupResource, logGroup)
+		flowLog.LogDestination = gfnt.MakeFnGetAttString(cfnVPCFlowLogGroupResource, "Arn")
+	}
+
+	if flowLogsConfig.DestinationType == api.FlowLogsDestinationCloudWatchLogs {
+		refRole := c.rs.newResource(cfnVPCFlowLogRoleResource, &gfniam.Role{
+			AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
+				gfnt.NewString("vpc-flow-logs.amazonaws.com"),
+			),
+		})
+		c.rs.attachAllowPolicy(cfnVPCFlowLogRolePolicyResource, refRole, vpcFlowLogsDeliveryStatements())
+		c.rs.withIAM = true
+		flowLog.DeliverLogsPermissionArn = gfnt.MakeFnGetAttString(cfnVPCFlowLogRoleResource, "Arn")
+	}
+
+	c.rs.newResource(cfnVPCFlowLogResource, flowLog)
+}
@@ -2,7 +2,9 @@ package builder
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/weaveworks/eksctl/pkg/awsapi"
@@ -13,6 +15,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -21,6 +24,8 @@ const (
 	cfnSharedNodeSGResource           = "ClusterSharedNodeSecurityGroup"
 	cfnIngressClusterToNodeSGResource = "IngressDefaultClusterToNodeSG"
 	cfnVPCResource                    = "VPC"
+
+	cfnTransitGatewayAttachmentResource = "TransitGatewayAttachment"
 )
 
 // A IPv4VPCResourceSet builds the resources required for the specified VPC
@@ -83,6 +88,9 @@ func (v *IPv4VPCResourceSet) addResources() error {
 	if v.clusterConfig.IsFullyPrivate() {
 		v.noNAT()
 		v.subnetDetails.Private = v.addSubnets(nil, api.SubnetTopologyPrivate, vpc.Subnets.Private)
+		if vpc.TransitGateway != nil {
+			v.addTransitGatewayAttachment()
+		}
 		return nil
 	}
 
@@ -134,6 +142,10 @@ func (v *IPv4VPCResourceSet) addResources() error {
 		}
 	}
 
+	if vpc.TransitGateway != nil {
+		v.addTransitGatewayAttachment()
+	}
+
 	return nil
 }
 
@@ -322,6 +334,8 @@ func (v *IPv4VPCResourceSet) addNATGateways() error {
 		v.singleNAT()
 	case api.ClusterDisableNAT:
 		v.noNAT()
+	case api.ClusterInstanceNAT:
+		v.instanceNAT()
 	default:
 		// TODO validate this before starting to add resources
 		return fmt.Errorf("%s is not a valid NAT gateway mode", *v.clusterConfig.VPC.NAT.Gateway)
@@ -410,6 +424,74 @@ func (v *IPv4VPCResourceSet) singleNAT() {
 	})
 }
 
+// natInstanceUserData enables IP forwarding and masquerades traffic from the private subnets
+// through the instance's primary interface
+const natInstanceUserData = `#!/bin/bash
+set -o errexit
+echo "net.ipv4.ip_forward = 1" > /etc/sysctl.d/90-eksctl-nat.conf
+sysctl -p /etc/sysctl.d/90-eksctl-nat.conf
+dnf install -y iptables-services
+systemctl enable --now iptables
+iface=$(ip route show default | awk '{print $5; exit}')
+iptables -t nat -A POSTROUTING -o "$iface" -j MASQUERADE
+iptables -F FORWARD
+service iptables save
+`
+
+func (v *IPv4VPCResourceSet) instanceNAT() {
+	sortedAZs := v.clusterConfig.AvailabilityZones
+	firstUpperAZ := makeAZResourceName(sortedAZs[0])
+
+	instanceType := v.clusterConfig.VPC.NAT.InstanceType
+	if instanceType == "" {
+		instanceType = api.DefaultNATInstanceType
+	}
+	arch := "x86_64"
+	if instanceutils.IsARMInstanceType(instanceType) {
+		arch = "arm64"
+	}
+
+	refSG := v.rs.newResource("NATInstanceSecurityGroup", &gfnec2.SecurityGroup{
+		GroupDescription: gfnt.NewString("Allow traffic from the VPC to the NAT instance"),
+		VpcId:            v.vpcID,
+		SecurityGroupIngress: []gfnec2.SecurityGroup_Ingress{{
+			CidrIp:      gfnt.NewString(v.clusterConfig.VPC.CIDR.String()),
+			Description: gfnt.NewString("Allow all traffic from the VPC"),
+			IpProtocol:  gfnt.NewString("-1"),
+		}},
+	})
+	refInstance := v.rs.newResource("NATInstance", &gfnec2.Instance{
+		ImageId:          gfnt.NewString(fmt.Sprintf("{{resolve:ssm:/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-%s}}", arch)),
+		InstanceType:     gfnt.NewString(instanceType),
+		SubnetId:         gfnt.MakeRef("SubnetPublic" + firstUpperAZ),
+		SecurityGroupIds: gfnt.NewSlice(refSG),
+		SourceDestCheck:  gfnt.False(),
+		UserData:         gfnt.NewString(base64.StdEncoding.EncodeToString([]byte(natInstanceUserData))),
+	})
+	v.rs.newResource("NATIP", &gfnec2.EIP{
+		Domain:     gfnt.NewString("vpc"),
+		InstanceId: refInstance,
+	})
+
+	forEachNATSubnet(v.clusterConfig.VPC, func(subnetAlias string) {
+		subnetAZResourceName := makeAZResourceName(subnetAlias)
+
+		refRT := v.rs.newResource("PrivateRouteTable"+subnetAZResourceName, &gfnec2.RouteTable{
+			VpcId: v.vpcID,
+		})
+
+		v.rs.newResource("NATPrivateSubnetRoute"+subnetAZResourceName, &gfnec2.Route{
+			RouteTableId:         refRT,
+			DestinationCidrBlock: gfnt.NewString(InternetCIDR),
+			InstanceId:           refInstance,
+		})
+		v.rs.newResource("RouteTableAssociationPrivate"+subnetAZResourceName, &gfnec2.SubnetRouteTableAssociation{
+			SubnetId:     gfnt.MakeRef("SubnetPrivate" + subnetAZResourceName),
+			RouteTableId: refRT,
+		})
+	})
+}
+
 func (v *IPv4VPCResourceSet) noNAT() {
 	forEachNATSubnet(v.clusterConfig.VPC, func(subnetAlias string) {
 		subnetAZResourceName := makeAZResourceName(subnetAlias)
@@ -424,6 +506,39 @@ func (v *IPv4VPCResourceSet) noNAT() {
 	})
 }
 
+// addTransitGatewayAttachment attaches the private subnets to the transit gateway and routes the configured
+// CIDRs through it from each private route table
+func (v *IPv4VPCResourceSet) addTransitGatewayAttachment() {
+	tgw := v.clusterConfig.VPC.TransitGateway
+	subnetAliases := make([]string, 0, len(v.clusterConfig.VPC.Subnets.Private))
+	for subnetAlias := range v.clusterConfig.VPC.Subnets.Private {
+		subnetAliases = append(subnetAliases, subnetAlias)
+	}
+	sort.Strings(subnetAliases)
+
+	var subnetRefs []*gfnt.Value
+	for _, subnetAlias := range subnetAliases {
+		subnetRefs = append(subnetRefs, gfnt.MakeRef("SubnetPrivate"+makeAZResourceName(subnetAlias)))
+	}
+	v.rs.newResource(cfnTransitGatewayAttachmentResource, &gfnec2.TransitGatewayAttachment{
+		TransitGatewayId: gfnt.NewString(tgw.ID),
+		VpcId:            v.vpcID,
+		SubnetIds:        gfnt.NewSlice(subnetRefs...),
+	})
+
+	for _, subnetAlias := range subnetAliases {
+		subnetAZResourceName := makeAZResourceName(subnetAlias)
+		for i, route := range tgw.Routes {
+			v.rs.newResource(fmt.Sprintf("TransitGatewayPrivateSubnetRoute%s%d", subnetAZResourceName, i), &gfnec2.Route{
+				RouteTableId:               gfnt.MakeRef("PrivateRouteTable" + subnetAZResourceName),
+				DestinationCidrBlock:       gfnt.NewString(route),
+				TransitGatewayId:           gfnt.NewString(tgw.ID),
+				AWSCloudFormationDependsOn: []string{cfnTransitGatewayAttachmentResource},
+			})
+		}
+	}
+}
+
 func forEachNATSubnet(clusterVPC *api.ClusterVPC, fn func(subnetAlias string)) {
 	for subnetAlias := range clusterVPC.Subnets.Private {
 		fn(subnetAlias)
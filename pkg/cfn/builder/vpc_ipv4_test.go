@@ -3,6 +3,7 @@ package builder_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -237,6 +238,63 @@ var _ = Describe("VPC Template Builder", func() {
 			})
 		})
 
+		Context("instance nat is set", func() {
+			BeforeEach(func() {
+				*cfg.VPC.NAT.Gateway = api.ClusterInstanceNAT
+			})
+
+			It("adds a NAT instance and routes private subnets through it", func() {
+				Expect(vpcTemplate.Resources).NotTo(HaveKey("NATGateway"))
+
+				Expect(vpcTemplate.Resources).To(HaveKey("NATInstance"))
+				Expect(vpcTemplate.Resources["NATInstance"].Properties.InstanceType).To(Equal(api.DefaultNATInstanceType))
+				Expect(vpcTemplate.Resources["NATInstance"].Properties.SubnetID).To(Equal(makeRef(publicSubnetRef1)))
+				Expect(*vpcTemplate.Resources["NATInstance"].Properties.SourceDestCheck).To(BeFalse())
+				Expect(vpcTemplate.Resources).To(HaveKey("NATInstanceSecurityGroup"))
+
+				Expect(vpcTemplate.Resources).To(HaveKey("NATIP"))
+				Expect(vpcTemplate.Resources["NATIP"].Properties.InstanceID).To(Equal(makeRef("NATInstance")))
+
+				Expect(vpcTemplate.Resources).To(HaveKey("NATPrivateSubnetRouteUSWEST2A"))
+				Expect(vpcTemplate.Resources["NATPrivateSubnetRouteUSWEST2A"].Properties.RouteTableID).To(Equal(makeRef(privRouteTableA)))
+				Expect(vpcTemplate.Resources["NATPrivateSubnetRouteUSWEST2A"].Properties.InstanceID).To(Equal(makeRef("NATInstance")))
+				Expect(vpcTemplate.Resources).To(HaveKey("NATPrivateSubnetRouteUSWEST2B"))
+				Expect(vpcTemplate.Resources["NATPrivateSubnetRouteUSWEST2B"].Properties.InstanceID).To(Equal(makeRef("NATInstance")))
+			})
+		})
+
+		Context("transit gateway is set", func() {
+			BeforeEach(func() {
+				cfg.VPC.TransitGateway = &api.VPCTransitGateway{
+					ID:     "tgw-0123456789abcdef0",
+					Routes: []string{"10.0.0.0/8", "172.16.0.0/12"},
+				}
+			})
+
+			It("attaches the private subnets to the transit gateway", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey("TransitGatewayAttachment"))
+				attachment := vpcTemplate.Resources["TransitGatewayAttachment"]
+				Expect(attachment.Type).To(Equal("AWS::EC2::TransitGatewayAttachment"))
+				Expect(attachment.Properties.TransitGatewayID).To(Equal("tgw-0123456789abcdef0"))
+				Expect(attachment.Properties.VpcID).To(Equal(makeRef(vpcResourceKey)))
+				Expect(attachment.Properties.SubnetIds).To(ConsistOf(makeRef(privateSubnetRef1), makeRef(privateSubnetRef2)))
+			})
+
+			It("routes the CIDRs through the transit gateway from each private route table", func() {
+				for _, az := range []string{"USWEST2A", "USWEST2B"} {
+					for i, cidr := range []string{"10.0.0.0/8", "172.16.0.0/12"} {
+						routeKey := fmt.Sprintf("TransitGatewayPrivateSubnetRoute%s%d", az, i)
+						Expect(vpcTemplate.Resources).To(HaveKey(routeKey))
+						route := vpcTemplate.Resources[routeKey]
+						Expect(route.Properties.RouteTableID).To(Equal(makeRef("PrivateRouteTable" + az)))
+						Expect(route.Properties.DestinationCidrBlock).To(Equal(cidr))
+						Expect(route.Properties.TransitGatewayID).To(Equal("tgw-0123456789abcdef0"))
+						Expect(route.DependsOn).To(ConsistOf("TransitGatewayAttachment"))
+					}
+				}
+			})
+		})
+
 		Context("nat is disabled", func() {
 			BeforeEach(func() {
 				*cfg.VPC.NAT.Gateway = api.ClusterDisableNAT
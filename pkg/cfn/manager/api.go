@@ -24,6 +24,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
+	"github.com/weaveworks/eksctl/pkg/progress"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -32,6 +33,7 @@ const (
 	resourceTypeAutoScalingGroup = "auto-scaling-group"
 	outputsRootPath              = "Outputs"
 	mappingsRootPath             = "Mappings"
+	deletionPolicyRetain         = "Retain"
 	ourStackRegexFmt             = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|addon-.+|fargate|karpenter)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
 	clusterStackRegex            = "eksctl-.*-cluster"
 )
@@ -83,6 +85,7 @@ type StackCollection struct {
 	region          string
 	waitTimeout     time.Duration
 	sharedTags      []types.Tag
+	owner           string
 }
 
 func newTag(key, value string) types.Tag {
@@ -91,6 +94,12 @@ func newTag(key, value string) types.Tag {
 
 // NewStackCollection creates a stack manager for a single cluster
 func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) StackManager {
+	return NewStackCollectionWithOwner(provider, spec, "")
+}
+
+// NewStackCollectionWithOwner creates a stack manager for a single cluster, whose created stacks are
+// tagged with owner, the ARN of the AWS identity creating them
+func NewStackCollectionWithOwner(provider api.ClusterProvider, spec *api.ClusterConfig, owner string) StackManager {
 	tags := []types.Tag{
 		newTag(api.ClusterNameTag, spec.Metadata.Name),
 		newTag(api.OldClusterNameTag, spec.Metadata.Name),
@@ -102,6 +111,7 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) S
 	return &StackCollection{
 		spec:              spec,
 		sharedTags:        tags,
+		owner:             owner,
 		cloudformationAPI: provider.CloudFormation(),
 		ec2API:            provider.EC2(),
 		eksAPI:            provider.EKS(),
@@ -115,6 +125,26 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) S
 	}
 }
 
+// Owner returns the ARN of the AWS identity the created stacks are tagged with as their owner, it is empty when unknown
+func (c *StackCollection) Owner() string {
+	return c.owner
+}
+
+// ownershipTags returns the tags recording which tool, config and identity created a stack. They are only
+// set at creation, updates keep the tags of the stack
+func (c *StackCollection) ownershipTags() []types.Tag {
+	tags := []types.Tag{newTag(api.CreatedByTag, api.CreatedByTagValue)}
+	if hash, err := c.spec.Hash(); err != nil {
+		logger.Debug("not tagging the stack with the hash of the ClusterConfig: %v", err)
+	} else {
+		tags = append(tags, newTag(api.ConfigHashTag, hash))
+	}
+	if c.owner != "" {
+		tags = append(tags, newTag(api.OwnerTag, c.owner))
+	}
+	return tags
+}
+
 // DoCreateStackRequest requests the creation of a CloudFormation stack
 func (c *StackCollection) DoCreateStackRequest(ctx context.Context, i *Stack, templateData TemplateData, tags, parameters map[string]string, withIAM bool, withNamedIAM bool) error {
 	input := &cloudformation.CreateStackInput{
@@ -122,6 +152,7 @@ func (c *StackCollection) DoCreateStackRequest(ctx context.Context, i *Stack, te
 		DisableRollback: aws.Bool(c.disableRollback),
 	}
 	input.Tags = append(input.Tags, c.sharedTags...)
+	input.Tags = append(input.Tags, c.ownershipTags()...)
 	for k, v := range tags {
 		input.Tags = append(input.Tags, newTag(k, v))
 	}
@@ -160,6 +191,7 @@ func (c *StackCollection) DoCreateStackRequest(ctx context.Context, i *Stack, te
 		return errors.Wrapf(err, "creating CloudFormation stack %q", *i.StackName)
 	}
 	i.StackId = s.StackId
+	progress.ResourceCreated("AWS::CloudFormation::Stack", *i.StackName, aws.ToString(s.StackId))
 	return nil
 }
 
@@ -204,6 +236,7 @@ func (c *StackCollection) createClusterStack(ctx context.Context, stackName stri
 		ctx, cancelFunc := context.WithTimeout(context.Background(), c.waitTimeout)
 		defer cancelFunc()
 
+		ctx, stopWait := startStackWait(ctx, "create", *stack.StackName)
 		stack, err := waiter.WaitForStack(ctx, c.cloudformationAPI, *stack.StackId, *stack.StackName, func(attempts int) time.Duration {
 			// Wait 30s for the first two requests, and 1m for subsequent requests.
 			if attempts <= 2 {
@@ -211,6 +244,7 @@ func (c *StackCollection) createClusterStack(ctx context.Context, stackName stri
 			}
 			return 1 * time.Minute
 		})
+		stopWait(err)
 
 		if err != nil {
 			troubleshoot()
@@ -223,6 +257,16 @@ func (c *StackCollection) createClusterStack(ctx context.Context, stackName stri
 			return
 		}
 
+		// termination protection is enabled once the stack has been created, so that a stack that failed to be
+		// created can still be deleted
+		if api.IsEnabled(c.spec.Metadata.DeletionProtection) {
+			if err := c.UpdateStackTerminationProtection(ctx, *stack.StackName, true); err != nil {
+				errCh <- err
+				return
+			}
+			logger.Info("enabled deletion protection on stack %q", *stack.StackName)
+		}
+
 		errCh <- nil
 	}()
 
@@ -376,6 +420,17 @@ func (c *StackCollection) UpdateStack(ctx context.Context, options UpdateStackOp
 	return nil
 }
 
+// UpdateStackTerminationProtection enables or disables termination protection on the stack
+func (c *StackCollection) UpdateStackTerminationProtection(ctx context.Context, stackName string, enabled bool) error {
+	if _, err := c.cloudformationAPI.UpdateTerminationProtection(ctx, &cloudformation.UpdateTerminationProtectionInput{
+		StackName:                   aws.String(stackName),
+		EnableTerminationProtection: aws.Bool(enabled),
+	}); err != nil {
+		return errors.Wrapf(err, "updating termination protection of stack %q", stackName)
+	}
+	return nil
+}
+
 // DescribeStack describes a cloudformation stack.
 func (c *StackCollection) DescribeStack(ctx context.Context, i *Stack) (*Stack, error) {
 	input := &cloudformation.DescribeStacksInput{
@@ -0,0 +1,47 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// auxiliaryStackSuffixes are the suffixes of the stacks eksctl creates for a cluster outside of the cluster, nodegroup
+// and addon stacks, they hold resources that must be deleted along with the cluster
+var auxiliaryStackSuffixes = []string{
+	// pkg/actions/efscsi
+	"efs",
+	// pkg/actions/nodeterminationhandler
+	"node-termination-handler",
+	// pkg/actions/auditlogs
+	"audit-log-export",
+}
+
+func fmtAuxiliaryStacksRegexForCluster(name string) string {
+	return fmt.Sprintf("^eksctl-%s-(%s)$", name, strings.Join(auxiliaryStackSuffixes, "|"))
+}
+
+// ListAuxiliaryStacks returns the auxiliary stacks of the cluster, e.g. the EFS stack
+func (c *StackCollection) ListAuxiliaryStacks(ctx context.Context) ([]*Stack, error) {
+	return c.ListStacksMatching(ctx, fmtAuxiliaryStacksRegexForCluster(c.spec.Metadata.Name))
+}
+
+// NewTasksToDeleteAuxiliaryStacks defines tasks required to delete the auxiliary stacks of the cluster. As some of them
+// hold resources in the cluster VPC, the deletion always waits for the stacks to be deleted
+func (c *StackCollection) NewTasksToDeleteAuxiliaryStacks(ctx context.Context) (*tasks.TaskTree, error) {
+	stacks, err := c.ListAuxiliaryStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	taskTree := &tasks.TaskTree{Parallel: true}
+	for _, s := range stacks {
+		taskTree.Append(&taskWithStackSpec{
+			info:  fmt.Sprintf("delete stack %q", *s.StackName),
+			stack: s,
+			call:  c.DeleteStackBySpecSync,
+		})
+	}
+	return taskTree, nil
+}
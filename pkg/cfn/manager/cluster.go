@@ -3,6 +3,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -236,6 +237,186 @@ func (c *StackCollection) AppendNewClusterStackResource(ctx context.Context, ext
 	return true, nil
 }
 
+// UpdateClusterVPCEndpoints adds and removes VPC endpoints in the cluster stack so that they match the endpoint services
+// required by a fully-private cluster and those listed in privateCluster.additionalEndpointServices
+func (c *StackCollection) UpdateClusterVPCEndpoints(ctx context.Context, plan bool) (bool, error) {
+	name := c.MakeClusterStackName()
+
+	currentTemplate, err := c.GetStackTemplate(ctx, name)
+	if err != nil {
+		return false, errors.Wrapf(err, "error getting stack template %s", name)
+	}
+
+	currentResources := gjson.Get(currentTemplate, resourcesRootPath)
+	if !currentResources.IsObject() {
+		return false, fmt.Errorf("unexpected template format of the current stack ")
+	}
+	if !currentResources.Get("VPC").Exists() {
+		return false, errors.New("VPC endpoints can only be updated for a VPC created by eksctl")
+	}
+
+	newStack := builder.NewClusterResourceSet(c.ec2API, c.region, c.spec, &currentResources, false)
+	if err := newStack.AddAllResources(ctx); err != nil {
+		return false, err
+	}
+
+	newTemplate, err := newStack.RenderJSON()
+	if err != nil {
+		return false, errors.Wrapf(err, "rendering template for %q stack", name)
+	}
+	newResources := gjson.Get(string(newTemplate), resourcesRootPath)
+
+	var (
+		iterErr         error
+		addEndpoints    []string
+		removeEndpoints []string
+	)
+	newResources.ForEach(func(key, value gjson.Result) bool {
+		k := key.String()
+		if !isVPCEndpointResource(k) || currentResources.Get(k).Exists() {
+			return true
+		}
+		addEndpoints = append(addEndpoints, k)
+		currentTemplate, iterErr = sjson.Set(currentTemplate, resourcesRootPath+"."+k, value.Value())
+		return iterErr == nil
+	})
+	if iterErr != nil {
+		return false, errors.Wrap(iterErr, "adding VPC endpoints to current stack template")
+	}
+	currentResources.ForEach(func(key, _ gjson.Result) bool {
+		k := key.String()
+		if !isVPCEndpointResource(k) || newResources.Get(k).Exists() {
+			return true
+		}
+		removeEndpoints = append(removeEndpoints, k)
+		currentTemplate, iterErr = sjson.Delete(currentTemplate, resourcesRootPath+"."+k)
+		return iterErr == nil
+	})
+	if iterErr != nil {
+		return false, errors.Wrap(iterErr, "removing VPC endpoints from current stack template")
+	}
+
+	if len(addEndpoints) == 0 && len(removeEndpoints) == 0 {
+		logger.Success("all VPC endpoints in cluster stack %q are up-to-date", name)
+		return false, nil
+	}
+
+	describeUpdate := fmt.Sprintf("updating stack to add VPC endpoints %v and remove VPC endpoints %v", addEndpoints, removeEndpoints)
+	if plan {
+		logger.Info("(plan) %s", describeUpdate)
+		return true, nil
+	}
+
+	if err := c.UpdateStack(ctx, UpdateStackOptions{
+		StackName:     name,
+		ChangeSetName: c.MakeChangeSetName("update-vpc-endpoints"),
+		Description:   describeUpdate,
+		TemplateData:  TemplateBody(currentTemplate),
+		Wait:          true,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func isVPCEndpointResource(resourceName string) bool {
+	return strings.HasPrefix(resourceName, "VPCEndpoint")
+}
+
+// UpdateControlPlaneSecurityGroupIngress adds, updates and removes the ingress rules of the control plane security group
+// in the cluster stack so that they match vpc.controlPlaneSecurityGroupIngress
+func (c *StackCollection) UpdateControlPlaneSecurityGroupIngress(ctx context.Context, plan bool) (bool, error) {
+	name := c.MakeClusterStackName()
+
+	currentTemplate, err := c.GetStackTemplate(ctx, name)
+	if err != nil {
+		return false, errors.Wrapf(err, "error getting stack template %s", name)
+	}
+
+	currentResources := gjson.Get(currentTemplate, resourcesRootPath)
+	if !currentResources.IsObject() {
+		return false, fmt.Errorf("unexpected template format of the current stack ")
+	}
+	if !currentResources.Get("ControlPlaneSecurityGroup").Exists() {
+		return false, errors.New("ingress rules can only be updated for a control plane security group created by eksctl")
+	}
+
+	newStack := builder.NewClusterResourceSet(c.ec2API, c.region, c.spec, &currentResources, false)
+	if err := newStack.AddAllResources(ctx); err != nil {
+		return false, err
+	}
+
+	newTemplate, err := newStack.RenderJSON()
+	if err != nil {
+		return false, errors.Wrapf(err, "rendering template for %q stack", name)
+	}
+	newResources := gjson.Get(string(newTemplate), resourcesRootPath)
+
+	var (
+		iterErr     error
+		addRules    []string
+		updateRules []string
+		removeRules []string
+	)
+	newResources.ForEach(func(key, value gjson.Result) bool {
+		k := key.String()
+		if !isControlPlaneIngressRuleResource(k) {
+			return true
+		}
+		if current := currentResources.Get(k); !current.Exists() {
+			addRules = append(addRules, k)
+		} else if !reflect.DeepEqual(current.Value(), value.Value()) {
+			updateRules = append(updateRules, k)
+		} else {
+			return true
+		}
+		currentTemplate, iterErr = sjson.Set(currentTemplate, resourcesRootPath+"."+k, value.Value())
+		return iterErr == nil
+	})
+	if iterErr != nil {
+		return false, errors.Wrap(iterErr, "adding ingress rules to current stack template")
+	}
+	currentResources.ForEach(func(key, _ gjson.Result) bool {
+		k := key.String()
+		if !isControlPlaneIngressRuleResource(k) || newResources.Get(k).Exists() {
+			return true
+		}
+		removeRules = append(removeRules, k)
+		currentTemplate, iterErr = sjson.Delete(currentTemplate, resourcesRootPath+"."+k)
+		return iterErr == nil
+	})
+	if iterErr != nil {
+		return false, errors.Wrap(iterErr, "removing ingress rules from current stack template")
+	}
+
+	if len(addRules) == 0 && len(updateRules) == 0 && len(removeRules) == 0 {
+		logger.Success("all control plane security group ingress rules in cluster stack %q are up-to-date", name)
+		return false, nil
+	}
+
+	describeUpdate := fmt.Sprintf("updating stack to add ingress rules %v, update ingress rules %v and remove ingress rules %v",
+		addRules, updateRules, removeRules)
+	if plan {
+		logger.Info("(plan) %s", describeUpdate)
+		return true, nil
+	}
+
+	if err := c.UpdateStack(ctx, UpdateStackOptions{
+		StackName:     name,
+		ChangeSetName: c.MakeChangeSetName("update-control-plane-ingress"),
+		Description:   describeUpdate,
+		TemplateData:  TemplateBody(currentTemplate),
+		Wait:          true,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func isControlPlaneIngressRuleResource(resourceName string) bool {
+	return strings.HasPrefix(resourceName, "IngressControlPlaneRule")
+}
+
 // ClusterHasDedicatedVPC returns true if the cluster was created with a dedicated VPC.
 func (c *StackCollection) ClusterHasDedicatedVPC(ctx context.Context) (bool, error) {
 	stackName := c.MakeClusterStackName()
@@ -294,3 +475,73 @@ func getClusterNameTag(s *Stack) string {
 	}
 	return ""
 }
+
+// RetainClusterStackResources sets the DeletionPolicy of the resources of the cluster stack whose type is one of
+// resourceTypes to Retain, so that they are kept when the stack is deleted, and returns their logical IDs
+func (c *StackCollection) RetainClusterStackResources(ctx context.Context, resourceTypes []string) ([]string, error) {
+	name := c.MakeClusterStackName()
+
+	currentTemplate, err := c.GetStackTemplate(ctx, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting stack template %s", name)
+	}
+	currentResources := gjson.Get(currentTemplate, resourcesRootPath)
+	if !currentResources.IsObject() {
+		return nil, fmt.Errorf("unexpected template format of the current stack ")
+	}
+
+	var (
+		retained []string
+		updated  bool
+		setErr   error
+	)
+	currentResources.ForEach(func(key, value gjson.Result) bool {
+		if !contains(resourceTypes, value.Get("Type").String()) {
+			return true
+		}
+		retained = append(retained, key.String())
+		if value.Get("DeletionPolicy").String() == deletionPolicyRetain {
+			return true
+		}
+		updated = true
+		currentTemplate, setErr = sjson.Set(currentTemplate, resourcesRootPath+"."+key.String()+".DeletionPolicy", deletionPolicyRetain)
+		return setErr == nil
+	})
+	if setErr != nil {
+		return nil, errors.Wrap(setErr, "setting deletion policy in current stack template")
+	}
+	if !updated {
+		return retained, nil
+	}
+
+	if err := c.UpdateStack(ctx, UpdateStackOptions{
+		StackName:     name,
+		ChangeSetName: c.MakeChangeSetName("retain-resources"),
+		Description:   fmt.Sprintf("updating stack to retain resources %v on deletion", retained),
+		TemplateData:  TemplateBody(currentTemplate),
+		Wait:          true,
+	}); err != nil {
+		return nil, err
+	}
+
+	// the stack is deleted right after, so make sure the update was not skipped for not containing any change
+	updatedTemplate, err := c.GetStackTemplate(ctx, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting stack template %s", name)
+	}
+	for _, logicalID := range retained {
+		if gjson.Get(updatedTemplate, resourcesRootPath+"."+logicalID+".DeletionPolicy").String() != deletionPolicyRetain {
+			return nil, fmt.Errorf("deletion policy of resource %q in stack %q was not updated", logicalID, name)
+		}
+	}
+	return retained, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
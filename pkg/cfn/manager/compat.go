@@ -93,7 +93,15 @@ func (c *StackCollection) EnsureMapPublicIPOnLaunchEnabled(ctx context.Context)
 	// First, make sure we enable the options in EC2. This is to make sure the settings are applied even
 	// if the stacks in Cloudformation have the setting enabled (since a stack update would produce "nothing to change"
 	// and therefore the setting would not be updated)
-	publicIDs := c.spec.VPC.Subnets.Public.WithIDs()
+	var publicIDs []string
+	for _, subnet := range c.spec.VPC.Subnets.Public {
+		if subnet.IsShared(c.spec.Metadata.AccountID) {
+			logger.Warning("skipping subnet %q as it is shared from account %s via AWS RAM; "+
+				"MapPublicIpOnLaunch must be enabled by the subnet owner", subnet.ID, subnet.OwnerID)
+			continue
+		}
+		publicIDs = append(publicIDs, subnet.ID)
+	}
 	logger.Debug("enabling attribute MapPublicIpOnLaunch via EC2 on subnets %q", publicIDs)
 	err := vpc.EnsureMapPublicIPOnLaunchEnabled(ctx, c.ec2API, publicIDs)
 	if err != nil {
@@ -27,7 +27,7 @@ func deleteAll(_ string) bool { return true }
 type NewOIDCManager func() (*iamoidc.OpenIDConnectManager, error)
 
 // NewTasksToDeleteClusterWithNodeGroups defines tasks required to delete the given cluster along with all of its resources
-func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(ctx context.Context, clusterStack *Stack, nodeGroupStacks []NodeGroupStack, clusterOperable bool, newOIDCManager NewOIDCManager, cluster *ekstypes.Cluster, clientSetGetter kubernetes.ClientSetGetter, wait, force bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error) {
+func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(ctx context.Context, clusterStack *Stack, nodeGroupStacks []NodeGroupStack, clusterOperable bool, newOIDCManager NewOIDCManager, cluster *ekstypes.Cluster, clientSetGetter kubernetes.ClientSetGetter, wait, force, retainOIDCProvider bool, cleanup func(chan error, string) error) (*tasks.TaskTree, error) {
 	taskTree := &tasks.TaskTree{Parallel: false}
 
 	nodeGroupTasks, err := c.NewTasksToDeleteNodeGroups(nodeGroupStacks, deleteAll, true, cleanup)
@@ -41,7 +41,7 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(ctx context.Cont
 	}
 
 	if clusterOperable {
-		serviceAccountAndOIDCTasks, err := c.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx, newOIDCManager, cluster, clientSetGetter, force)
+		serviceAccountAndOIDCTasks, err := c.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx, newOIDCManager, cluster, clientSetGetter, force, retainOIDCProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -62,6 +62,16 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(ctx context.Cont
 		taskTree.Append(deleteAddonIAMTasks)
 	}
 
+	deleteAuxiliaryStacksTasks, err := c.NewTasksToDeleteAuxiliaryStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleteAuxiliaryStacksTasks.Len() > 0 {
+		deleteAuxiliaryStacksTasks.IsSubTask = true
+		taskTree.Append(deleteAuxiliaryStacksTasks)
+	}
+
 	if clusterStack == nil {
 		return nil, &StackNotFoundErr{ClusterName: c.spec.Metadata.Name}
 	}
@@ -183,7 +193,7 @@ func (c *StackCollection) NewTaskToDeleteUnownedNodeGroup(ctx context.Context, c
 
 // NewTasksToDeleteOIDCProviderWithIAMServiceAccounts defines tasks required to delete all of the iamserviceaccounts
 // along with associated IAM OIDC provider
-func (c *StackCollection) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx context.Context, newOIDCManager NewOIDCManager, cluster *ekstypes.Cluster, clientSetGetter kubernetes.ClientSetGetter, force bool) (*tasks.TaskTree, error) {
+func (c *StackCollection) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx context.Context, newOIDCManager NewOIDCManager, cluster *ekstypes.Cluster, clientSetGetter kubernetes.ClientSetGetter, force, retainOIDCProvider bool) (*tasks.TaskTree, error) {
 	taskTree := &tasks.TaskTree{Parallel: false}
 
 	oidc, err := newOIDCManager()
@@ -212,6 +222,11 @@ func (c *StackCollection) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(ctx
 		}
 	}
 
+	if retainOIDCProvider {
+		logger.Info("retaining IAM OIDC provider")
+		return taskTree, nil
+	}
+
 	providerExists, err := oidc.CheckProviderExists(ctx)
 	if err != nil {
 		if apierrors.IsAccessDeniedError(err) {
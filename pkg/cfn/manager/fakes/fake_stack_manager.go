@@ -463,6 +463,19 @@ type FakeStackManager struct {
 		result1 bool
 		result2 error
 	}
+	ListAuxiliaryStacksStub        func(context.Context) ([]*types.Stack, error)
+	listAuxiliaryStacksMutex       sync.RWMutex
+	listAuxiliaryStacksArgsForCall []struct {
+		arg1 context.Context
+	}
+	listAuxiliaryStacksReturns struct {
+		result1 []*types.Stack
+		result2 error
+	}
+	listAuxiliaryStacksReturnsOnCall map[int]struct {
+		result1 []*types.Stack
+		result2 error
+	}
 	ListClusterStackNamesStub        func(context.Context) ([]string, error)
 	listClusterStackNamesMutex       sync.RWMutex
 	listClusterStackNamesArgsForCall []struct {
@@ -515,6 +528,20 @@ type FakeStackManager struct {
 		result1 []manager.NodeGroupStack
 		result2 error
 	}
+	ListStackResourcesStub        func(context.Context, string) ([]types.StackResourceSummary, error)
+	listStackResourcesMutex       sync.RWMutex
+	listStackResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	listStackResourcesReturns struct {
+		result1 []types.StackResourceSummary
+		result2 error
+	}
+	listStackResourcesReturnsOnCall map[int]struct {
+		result1 []types.StackResourceSummary
+		result2 error
+	}
 	ListStacksStub        func(context.Context) ([]*types.Stack, error)
 	listStacksMutex       sync.RWMutex
 	listStacksArgsForCall []struct {
@@ -662,11 +689,24 @@ type FakeStackManager struct {
 	newTasksToCreateIAMServiceAccountsReturnsOnCall map[int]struct {
 		result1 *tasks.TaskTree
 	}
-	NewTasksToDeleteClusterWithNodeGroupsStub        func(context.Context, *types.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)
+	NewTasksToDeleteAuxiliaryStacksStub        func(context.Context) (*tasks.TaskTree, error)
+	newTasksToDeleteAuxiliaryStacksMutex       sync.RWMutex
+	newTasksToDeleteAuxiliaryStacksArgsForCall []struct {
+		arg1 context.Context
+	}
+	newTasksToDeleteAuxiliaryStacksReturns struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}
+	newTasksToDeleteAuxiliaryStacksReturnsOnCall map[int]struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}
+	NewTasksToDeleteClusterWithNodeGroupsStub        func(context.Context, *manager.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)
 	newTasksToDeleteClusterWithNodeGroupsMutex       sync.RWMutex
 	newTasksToDeleteClusterWithNodeGroupsArgsForCall []struct {
 		arg1  context.Context
-		arg2  *types.Stack
+		arg2  *manager.Stack
 		arg3  []manager.NodeGroupStack
 		arg4  bool
 		arg5  manager.NewOIDCManager
@@ -674,7 +714,8 @@ type FakeStackManager struct {
 		arg7  kubernetes.ClientSetGetter
 		arg8  bool
 		arg9  bool
-		arg10 func(chan error, string) error
+		arg10 bool
+		arg11 func(chan error, string) error
 	}
 	newTasksToDeleteClusterWithNodeGroupsReturns struct {
 		result1 *tasks.TaskTree
@@ -716,7 +757,7 @@ type FakeStackManager struct {
 		result1 *tasks.TaskTree
 		result2 error
 	}
-	NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub        func(context.Context, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool) (*tasks.TaskTree, error)
+	NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub        func(context.Context, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool) (*tasks.TaskTree, error)
 	newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex       sync.RWMutex
 	newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall []struct {
 		arg1 context.Context
@@ -724,6 +765,7 @@ type FakeStackManager struct {
 		arg3 *typesc.Cluster
 		arg4 kubernetes.ClientSetGetter
 		arg5 bool
+		arg6 bool
 	}
 	newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns struct {
 		result1 *tasks.TaskTree
@@ -747,6 +789,16 @@ type FakeStackManager struct {
 	newUnmanagedNodeGroupTaskReturnsOnCall map[int]struct {
 		result1 *tasks.TaskTree
 	}
+	OwnerStub        func() string
+	ownerMutex       sync.RWMutex
+	ownerArgsForCall []struct {
+	}
+	ownerReturns struct {
+		result1 string
+	}
+	ownerReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PropagateManagedNodeGroupTagsToASGStub        func(string, map[string]string, []string, chan error) error
 	propagateManagedNodeGroupTagsToASGMutex       sync.RWMutex
 	propagateManagedNodeGroupTagsToASGArgsForCall []struct {
@@ -772,6 +824,20 @@ type FakeStackManager struct {
 	refreshFargatePodExecutionRoleARNReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RetainClusterStackResourcesStub        func(context.Context, []string) ([]string, error)
+	retainClusterStackResourcesMutex       sync.RWMutex
+	retainClusterStackResourcesArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+	}
+	retainClusterStackResourcesReturns struct {
+		result1 []string
+		result2 error
+	}
+	retainClusterStackResourcesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	StackStatusIsNotTransitionalStub        func(*types.Stack) bool
 	stackStatusIsNotTransitionalMutex       sync.RWMutex
 	stackStatusIsNotTransitionalArgsForCall []struct {
@@ -783,6 +849,34 @@ type FakeStackManager struct {
 	stackStatusIsNotTransitionalReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	UpdateClusterVPCEndpointsStub        func(context.Context, bool) (bool, error)
+	updateClusterVPCEndpointsMutex       sync.RWMutex
+	updateClusterVPCEndpointsArgsForCall []struct {
+		arg1 context.Context
+		arg2 bool
+	}
+	updateClusterVPCEndpointsReturns struct {
+		result1 bool
+		result2 error
+	}
+	updateClusterVPCEndpointsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	UpdateControlPlaneSecurityGroupIngressStub        func(context.Context, bool) (bool, error)
+	updateControlPlaneSecurityGroupIngressMutex       sync.RWMutex
+	updateControlPlaneSecurityGroupIngressArgsForCall []struct {
+		arg1 context.Context
+		arg2 bool
+	}
+	updateControlPlaneSecurityGroupIngressReturns struct {
+		result1 bool
+		result2 error
+	}
+	updateControlPlaneSecurityGroupIngressReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	UpdateNodeGroupStackStub        func(context.Context, string, string, bool) error
 	updateNodeGroupStackMutex       sync.RWMutex
 	updateNodeGroupStackArgsForCall []struct {
@@ -809,6 +903,19 @@ type FakeStackManager struct {
 	updateStackReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateStackTerminationProtectionStub        func(context.Context, string, bool) error
+	updateStackTerminationProtectionMutex       sync.RWMutex
+	updateStackTerminationProtectionArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}
+	updateStackTerminationProtectionReturns struct {
+		result1 error
+	}
+	updateStackTerminationProtectionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -2934,6 +3041,70 @@ func (fake *FakeStackManager) HasClusterStackFromListReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) ListAuxiliaryStacks(arg1 context.Context) ([]*types.Stack, error) {
+	fake.listAuxiliaryStacksMutex.Lock()
+	ret, specificReturn := fake.listAuxiliaryStacksReturnsOnCall[len(fake.listAuxiliaryStacksArgsForCall)]
+	fake.listAuxiliaryStacksArgsForCall = append(fake.listAuxiliaryStacksArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListAuxiliaryStacksStub
+	fakeReturns := fake.listAuxiliaryStacksReturns
+	fake.recordInvocation("ListAuxiliaryStacks", []interface{}{arg1})
+	fake.listAuxiliaryStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListAuxiliaryStacksCallCount() int {
+	fake.listAuxiliaryStacksMutex.RLock()
+	defer fake.listAuxiliaryStacksMutex.RUnlock()
+	return len(fake.listAuxiliaryStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListAuxiliaryStacksCalls(stub func(context.Context) ([]*types.Stack, error)) {
+	fake.listAuxiliaryStacksMutex.Lock()
+	defer fake.listAuxiliaryStacksMutex.Unlock()
+	fake.ListAuxiliaryStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListAuxiliaryStacksArgsForCall(i int) context.Context {
+	fake.listAuxiliaryStacksMutex.RLock()
+	defer fake.listAuxiliaryStacksMutex.RUnlock()
+	argsForCall := fake.listAuxiliaryStacksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ListAuxiliaryStacksReturns(result1 []*types.Stack, result2 error) {
+	fake.listAuxiliaryStacksMutex.Lock()
+	defer fake.listAuxiliaryStacksMutex.Unlock()
+	fake.ListAuxiliaryStacksStub = nil
+	fake.listAuxiliaryStacksReturns = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListAuxiliaryStacksReturnsOnCall(i int, result1 []*types.Stack, result2 error) {
+	fake.listAuxiliaryStacksMutex.Lock()
+	defer fake.listAuxiliaryStacksMutex.Unlock()
+	fake.ListAuxiliaryStacksStub = nil
+	if fake.listAuxiliaryStacksReturnsOnCall == nil {
+		fake.listAuxiliaryStacksReturnsOnCall = make(map[int]struct {
+			result1 []*types.Stack
+			result2 error
+		})
+	}
+	fake.listAuxiliaryStacksReturnsOnCall[i] = struct {
+		result1 []*types.Stack
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) ListClusterStackNames(arg1 context.Context) ([]string, error) {
 	fake.listClusterStackNamesMutex.Lock()
 	ret, specificReturn := fake.listClusterStackNamesReturnsOnCall[len(fake.listClusterStackNamesArgsForCall)]
@@ -2954,6 +3125,8 @@ func (fake *FakeStackManager) ListClusterStackNames(arg1 context.Context) ([]str
 }
 
 func (fake *FakeStackManager) ListClusterStackNamesCallCount() int {
+	fake.listAuxiliaryStacksMutex.RLock()
+	defer fake.listAuxiliaryStacksMutex.RUnlock()
 	fake.listClusterStackNamesMutex.RLock()
 	defer fake.listClusterStackNamesMutex.RUnlock()
 	return len(fake.listClusterStackNamesArgsForCall)
@@ -3190,6 +3363,71 @@ func (fake *FakeStackManager) ListNodeGroupStacksWithStatusesReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) ListStackResources(arg1 context.Context, arg2 string) ([]types.StackResourceSummary, error) {
+	fake.listStackResourcesMutex.Lock()
+	ret, specificReturn := fake.listStackResourcesReturnsOnCall[len(fake.listStackResourcesArgsForCall)]
+	fake.listStackResourcesArgsForCall = append(fake.listStackResourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListStackResourcesStub
+	fakeReturns := fake.listStackResourcesReturns
+	fake.recordInvocation("ListStackResources", []interface{}{arg1, arg2})
+	fake.listStackResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListStackResourcesCallCount() int {
+	fake.listStackResourcesMutex.RLock()
+	defer fake.listStackResourcesMutex.RUnlock()
+	return len(fake.listStackResourcesArgsForCall)
+}
+
+func (fake *FakeStackManager) ListStackResourcesCalls(stub func(context.Context, string) ([]types.StackResourceSummary, error)) {
+	fake.listStackResourcesMutex.Lock()
+	defer fake.listStackResourcesMutex.Unlock()
+	fake.ListStackResourcesStub = stub
+}
+
+func (fake *FakeStackManager) ListStackResourcesArgsForCall(i int) (context.Context, string) {
+	fake.listStackResourcesMutex.RLock()
+	defer fake.listStackResourcesMutex.RUnlock()
+	argsForCall := fake.listStackResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ListStackResourcesReturns(result1 []types.StackResourceSummary, result2 error) {
+	fake.listStackResourcesMutex.Lock()
+	defer fake.listStackResourcesMutex.Unlock()
+	fake.ListStackResourcesStub = nil
+	fake.listStackResourcesReturns = struct {
+		result1 []types.StackResourceSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStackResourcesReturnsOnCall(i int, result1 []types.StackResourceSummary, result2 error) {
+	fake.listStackResourcesMutex.Lock()
+	defer fake.listStackResourcesMutex.Unlock()
+	fake.ListStackResourcesStub = nil
+	if fake.listStackResourcesReturnsOnCall == nil {
+		fake.listStackResourcesReturnsOnCall = make(map[int]struct {
+			result1 []types.StackResourceSummary
+			result2 error
+		})
+	}
+	fake.listStackResourcesReturnsOnCall[i] = struct {
+		result1 []types.StackResourceSummary
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) ListStacks(arg1 context.Context) ([]*types.Stack, error) {
 	fake.listStacksMutex.Lock()
 	ret, specificReturn := fake.listStacksReturnsOnCall[len(fake.listStacksArgsForCall)]
@@ -3905,7 +4143,71 @@ func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturnsOnCall(i
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context.Context, arg2 *types.Stack, arg3 []manager.NodeGroupStack, arg4 bool, arg5 manager.NewOIDCManager, arg6 *typesc.Cluster, arg7 kubernetes.ClientSetGetter, arg8 bool, arg9 bool, arg10 func(chan error, string) error) (*tasks.TaskTree, error) {
+func (fake *FakeStackManager) NewTasksToDeleteAuxiliaryStacks(arg1 context.Context) (*tasks.TaskTree, error) {
+	fake.newTasksToDeleteAuxiliaryStacksMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteAuxiliaryStacksReturnsOnCall[len(fake.newTasksToDeleteAuxiliaryStacksArgsForCall)]
+	fake.newTasksToDeleteAuxiliaryStacksArgsForCall = append(fake.newTasksToDeleteAuxiliaryStacksArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.NewTasksToDeleteAuxiliaryStacksStub
+	fakeReturns := fake.newTasksToDeleteAuxiliaryStacksReturns
+	fake.recordInvocation("NewTasksToDeleteAuxiliaryStacks", []interface{}{arg1})
+	fake.newTasksToDeleteAuxiliaryStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteAuxiliaryStacksCallCount() int {
+	fake.newTasksToDeleteAuxiliaryStacksMutex.RLock()
+	defer fake.newTasksToDeleteAuxiliaryStacksMutex.RUnlock()
+	return len(fake.newTasksToDeleteAuxiliaryStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteAuxiliaryStacksCalls(stub func(context.Context) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteAuxiliaryStacksMutex.Lock()
+	defer fake.newTasksToDeleteAuxiliaryStacksMutex.Unlock()
+	fake.NewTasksToDeleteAuxiliaryStacksStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteAuxiliaryStacksArgsForCall(i int) context.Context {
+	fake.newTasksToDeleteAuxiliaryStacksMutex.RLock()
+	defer fake.newTasksToDeleteAuxiliaryStacksMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteAuxiliaryStacksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteAuxiliaryStacksReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteAuxiliaryStacksMutex.Lock()
+	defer fake.newTasksToDeleteAuxiliaryStacksMutex.Unlock()
+	fake.NewTasksToDeleteAuxiliaryStacksStub = nil
+	fake.newTasksToDeleteAuxiliaryStacksReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteAuxiliaryStacksReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteAuxiliaryStacksMutex.Lock()
+	defer fake.newTasksToDeleteAuxiliaryStacksMutex.Unlock()
+	fake.NewTasksToDeleteAuxiliaryStacksStub = nil
+	if fake.newTasksToDeleteAuxiliaryStacksReturnsOnCall == nil {
+		fake.newTasksToDeleteAuxiliaryStacksReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTasksToDeleteAuxiliaryStacksReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context.Context, arg2 *manager.Stack, arg3 []manager.NodeGroupStack, arg4 bool, arg5 manager.NewOIDCManager, arg6 *typesc.Cluster, arg7 kubernetes.ClientSetGetter, arg8 bool, arg9 bool, arg10 bool, arg11 func(chan error, string) error) (*tasks.TaskTree, error) {
 	var arg3Copy []manager.NodeGroupStack
 	if arg3 != nil {
 		arg3Copy = make([]manager.NodeGroupStack, len(arg3))
@@ -3915,7 +4217,7 @@ func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context
 	ret, specificReturn := fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)]
 	fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall = append(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall, struct {
 		arg1  context.Context
-		arg2  *types.Stack
+		arg2  *manager.Stack
 		arg3  []manager.NodeGroupStack
 		arg4  bool
 		arg5  manager.NewOIDCManager
@@ -3923,14 +4225,15 @@ func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context
 		arg7  kubernetes.ClientSetGetter
 		arg8  bool
 		arg9  bool
-		arg10 func(chan error, string) error
-	}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8, arg9, arg10})
+		arg10 bool
+		arg11 func(chan error, string) error
+	}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11})
 	stub := fake.NewTasksToDeleteClusterWithNodeGroupsStub
 	fakeReturns := fake.newTasksToDeleteClusterWithNodeGroupsReturns
-	fake.recordInvocation("NewTasksToDeleteClusterWithNodeGroups", []interface{}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8, arg9, arg10})
+	fake.recordInvocation("NewTasksToDeleteClusterWithNodeGroups", []interface{}{arg1, arg2, arg3Copy, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11})
 	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3939,22 +4242,24 @@ func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 context
 }
 
 func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCallCount() int {
+	fake.newTasksToDeleteAuxiliaryStacksMutex.RLock()
+	defer fake.newTasksToDeleteAuxiliaryStacksMutex.RUnlock()
 	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
 	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
 	return len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCalls(stub func(context.Context, *types.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCalls(stub func(context.Context, *manager.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
 	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
 	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
 	fake.NewTasksToDeleteClusterWithNodeGroupsStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsArgsForCall(i int) (context.Context, *types.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, func(chan error, string) error) {
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsArgsForCall(i int) (context.Context, *manager.Stack, []manager.NodeGroupStack, bool, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool, bool, func(chan error, string) error) {
 	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
 	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
 	argsForCall := fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9, argsForCall.arg10, argsForCall.arg11
 }
 
 func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
@@ -4127,7 +4432,7 @@ func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(arg1 context.Context, arg2 manager.NewOIDCManager, arg3 *typesc.Cluster, arg4 kubernetes.ClientSetGetter, arg5 bool) (*tasks.TaskTree, error) {
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(arg1 context.Context, arg2 manager.NewOIDCManager, arg3 *typesc.Cluster, arg4 kubernetes.ClientSetGetter, arg5 bool, arg6 bool) (*tasks.TaskTree, error) {
 	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
 	ret, specificReturn := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)]
 	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall, struct {
@@ -4136,13 +4441,14 @@ func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts
 		arg3 *typesc.Cluster
 		arg4 kubernetes.ClientSetGetter
 		arg5 bool
-	}{arg1, arg2, arg3, arg4, arg5})
+		arg6 bool
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub
 	fakeReturns := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns
-	fake.recordInvocation("NewTasksToDeleteOIDCProviderWithIAMServiceAccounts", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("NewTasksToDeleteOIDCProviderWithIAMServiceAccounts", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -4156,17 +4462,17 @@ func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts
 	return len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCalls(stub func(context.Context, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool) (*tasks.TaskTree, error)) {
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCalls(stub func(context.Context, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool) (*tasks.TaskTree, error)) {
 	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
 	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
 	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall(i int) (context.Context, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool) {
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall(i int) (context.Context, manager.NewOIDCManager, *typesc.Cluster, kubernetes.ClientSetGetter, bool, bool) {
 	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
 	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
 	argsForCall := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
@@ -4264,6 +4570,59 @@ func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturnsOnCall(i int, resu
 	}{result1}
 }
 
+func (fake *FakeStackManager) Owner() string {
+	fake.ownerMutex.Lock()
+	ret, specificReturn := fake.ownerReturnsOnCall[len(fake.ownerArgsForCall)]
+	fake.ownerArgsForCall = append(fake.ownerArgsForCall, struct {
+	}{})
+	stub := fake.OwnerStub
+	fakeReturns := fake.ownerReturns
+	fake.recordInvocation("Owner", []interface{}{})
+	fake.ownerMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) OwnerCallCount() int {
+	fake.ownerMutex.RLock()
+	defer fake.ownerMutex.RUnlock()
+	return len(fake.ownerArgsForCall)
+}
+
+func (fake *FakeStackManager) OwnerCalls(stub func() string) {
+	fake.ownerMutex.Lock()
+	defer fake.ownerMutex.Unlock()
+	fake.OwnerStub = stub
+}
+
+func (fake *FakeStackManager) OwnerReturns(result1 string) {
+	fake.ownerMutex.Lock()
+	defer fake.ownerMutex.Unlock()
+	fake.OwnerStub = nil
+	fake.ownerReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) OwnerReturnsOnCall(i int, result1 string) {
+	fake.ownerMutex.Lock()
+	defer fake.ownerMutex.Unlock()
+	fake.OwnerStub = nil
+	if fake.ownerReturnsOnCall == nil {
+		fake.ownerReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.ownerReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToASG(arg1 string, arg2 map[string]string, arg3 []string, arg4 chan error) error {
 	var arg3Copy []string
 	if arg3 != nil {
@@ -4292,6 +4651,8 @@ func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToASG(arg1 string, ar
 }
 
 func (fake *FakeStackManager) PropagateManagedNodeGroupTagsToASGCallCount() int {
+	fake.ownerMutex.RLock()
+	defer fake.ownerMutex.RUnlock()
 	fake.propagateManagedNodeGroupTagsToASGMutex.RLock()
 	defer fake.propagateManagedNodeGroupTagsToASGMutex.RUnlock()
 	return len(fake.propagateManagedNodeGroupTagsToASGArgsForCall)
@@ -4394,6 +4755,76 @@ func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeStackManager) RetainClusterStackResources(arg1 context.Context, arg2 []string) ([]string, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.retainClusterStackResourcesMutex.Lock()
+	ret, specificReturn := fake.retainClusterStackResourcesReturnsOnCall[len(fake.retainClusterStackResourcesArgsForCall)]
+	fake.retainClusterStackResourcesArgsForCall = append(fake.retainClusterStackResourcesArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.RetainClusterStackResourcesStub
+	fakeReturns := fake.retainClusterStackResourcesReturns
+	fake.recordInvocation("RetainClusterStackResources", []interface{}{arg1, arg2Copy})
+	fake.retainClusterStackResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) RetainClusterStackResourcesCallCount() int {
+	fake.retainClusterStackResourcesMutex.RLock()
+	defer fake.retainClusterStackResourcesMutex.RUnlock()
+	return len(fake.retainClusterStackResourcesArgsForCall)
+}
+
+func (fake *FakeStackManager) RetainClusterStackResourcesCalls(stub func(context.Context, []string) ([]string, error)) {
+	fake.retainClusterStackResourcesMutex.Lock()
+	defer fake.retainClusterStackResourcesMutex.Unlock()
+	fake.RetainClusterStackResourcesStub = stub
+}
+
+func (fake *FakeStackManager) RetainClusterStackResourcesArgsForCall(i int) (context.Context, []string) {
+	fake.retainClusterStackResourcesMutex.RLock()
+	defer fake.retainClusterStackResourcesMutex.RUnlock()
+	argsForCall := fake.retainClusterStackResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) RetainClusterStackResourcesReturns(result1 []string, result2 error) {
+	fake.retainClusterStackResourcesMutex.Lock()
+	defer fake.retainClusterStackResourcesMutex.Unlock()
+	fake.RetainClusterStackResourcesStub = nil
+	fake.retainClusterStackResourcesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) RetainClusterStackResourcesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.retainClusterStackResourcesMutex.Lock()
+	defer fake.retainClusterStackResourcesMutex.Unlock()
+	fake.RetainClusterStackResourcesStub = nil
+	if fake.retainClusterStackResourcesReturnsOnCall == nil {
+		fake.retainClusterStackResourcesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.retainClusterStackResourcesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) StackStatusIsNotTransitional(arg1 *types.Stack) bool {
 	fake.stackStatusIsNotTransitionalMutex.Lock()
 	ret, specificReturn := fake.stackStatusIsNotTransitionalReturnsOnCall[len(fake.stackStatusIsNotTransitionalArgsForCall)]
@@ -4455,6 +4886,136 @@ func (fake *FakeStackManager) StackStatusIsNotTransitionalReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *FakeStackManager) UpdateClusterVPCEndpoints(arg1 context.Context, arg2 bool) (bool, error) {
+	fake.updateClusterVPCEndpointsMutex.Lock()
+	ret, specificReturn := fake.updateClusterVPCEndpointsReturnsOnCall[len(fake.updateClusterVPCEndpointsArgsForCall)]
+	fake.updateClusterVPCEndpointsArgsForCall = append(fake.updateClusterVPCEndpointsArgsForCall, struct {
+		arg1 context.Context
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.UpdateClusterVPCEndpointsStub
+	fakeReturns := fake.updateClusterVPCEndpointsReturns
+	fake.recordInvocation("UpdateClusterVPCEndpoints", []interface{}{arg1, arg2})
+	fake.updateClusterVPCEndpointsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) UpdateClusterVPCEndpointsCallCount() int {
+	fake.updateClusterVPCEndpointsMutex.RLock()
+	defer fake.updateClusterVPCEndpointsMutex.RUnlock()
+	return len(fake.updateClusterVPCEndpointsArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateClusterVPCEndpointsCalls(stub func(context.Context, bool) (bool, error)) {
+	fake.updateClusterVPCEndpointsMutex.Lock()
+	defer fake.updateClusterVPCEndpointsMutex.Unlock()
+	fake.UpdateClusterVPCEndpointsStub = stub
+}
+
+func (fake *FakeStackManager) UpdateClusterVPCEndpointsArgsForCall(i int) (context.Context, bool) {
+	fake.updateClusterVPCEndpointsMutex.RLock()
+	defer fake.updateClusterVPCEndpointsMutex.RUnlock()
+	argsForCall := fake.updateClusterVPCEndpointsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) UpdateClusterVPCEndpointsReturns(result1 bool, result2 error) {
+	fake.updateClusterVPCEndpointsMutex.Lock()
+	defer fake.updateClusterVPCEndpointsMutex.Unlock()
+	fake.UpdateClusterVPCEndpointsStub = nil
+	fake.updateClusterVPCEndpointsReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) UpdateClusterVPCEndpointsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.updateClusterVPCEndpointsMutex.Lock()
+	defer fake.updateClusterVPCEndpointsMutex.Unlock()
+	fake.UpdateClusterVPCEndpointsStub = nil
+	if fake.updateClusterVPCEndpointsReturnsOnCall == nil {
+		fake.updateClusterVPCEndpointsReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.updateClusterVPCEndpointsReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) UpdateControlPlaneSecurityGroupIngress(arg1 context.Context, arg2 bool) (bool, error) {
+	fake.updateControlPlaneSecurityGroupIngressMutex.Lock()
+	ret, specificReturn := fake.updateControlPlaneSecurityGroupIngressReturnsOnCall[len(fake.updateControlPlaneSecurityGroupIngressArgsForCall)]
+	fake.updateControlPlaneSecurityGroupIngressArgsForCall = append(fake.updateControlPlaneSecurityGroupIngressArgsForCall, struct {
+		arg1 context.Context
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.UpdateControlPlaneSecurityGroupIngressStub
+	fakeReturns := fake.updateControlPlaneSecurityGroupIngressReturns
+	fake.recordInvocation("UpdateControlPlaneSecurityGroupIngress", []interface{}{arg1, arg2})
+	fake.updateControlPlaneSecurityGroupIngressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) UpdateControlPlaneSecurityGroupIngressCallCount() int {
+	fake.updateControlPlaneSecurityGroupIngressMutex.RLock()
+	defer fake.updateControlPlaneSecurityGroupIngressMutex.RUnlock()
+	return len(fake.updateControlPlaneSecurityGroupIngressArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateControlPlaneSecurityGroupIngressCalls(stub func(context.Context, bool) (bool, error)) {
+	fake.updateControlPlaneSecurityGroupIngressMutex.Lock()
+	defer fake.updateControlPlaneSecurityGroupIngressMutex.Unlock()
+	fake.UpdateControlPlaneSecurityGroupIngressStub = stub
+}
+
+func (fake *FakeStackManager) UpdateControlPlaneSecurityGroupIngressArgsForCall(i int) (context.Context, bool) {
+	fake.updateControlPlaneSecurityGroupIngressMutex.RLock()
+	defer fake.updateControlPlaneSecurityGroupIngressMutex.RUnlock()
+	argsForCall := fake.updateControlPlaneSecurityGroupIngressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) UpdateControlPlaneSecurityGroupIngressReturns(result1 bool, result2 error) {
+	fake.updateControlPlaneSecurityGroupIngressMutex.Lock()
+	defer fake.updateControlPlaneSecurityGroupIngressMutex.Unlock()
+	fake.UpdateControlPlaneSecurityGroupIngressStub = nil
+	fake.updateControlPlaneSecurityGroupIngressReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) UpdateControlPlaneSecurityGroupIngressReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.updateControlPlaneSecurityGroupIngressMutex.Lock()
+	defer fake.updateControlPlaneSecurityGroupIngressMutex.Unlock()
+	fake.UpdateControlPlaneSecurityGroupIngressStub = nil
+	if fake.updateControlPlaneSecurityGroupIngressReturnsOnCall == nil {
+		fake.updateControlPlaneSecurityGroupIngressReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.updateControlPlaneSecurityGroupIngressReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) UpdateNodeGroupStack(arg1 context.Context, arg2 string, arg3 string, arg4 bool) error {
 	fake.updateNodeGroupStackMutex.Lock()
 	ret, specificReturn := fake.updateNodeGroupStackReturnsOnCall[len(fake.updateNodeGroupStackArgsForCall)]
@@ -4581,6 +5142,69 @@ func (fake *FakeStackManager) UpdateStackReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStackManager) UpdateStackTerminationProtection(arg1 context.Context, arg2 string, arg3 bool) error {
+	fake.updateStackTerminationProtectionMutex.Lock()
+	ret, specificReturn := fake.updateStackTerminationProtectionReturnsOnCall[len(fake.updateStackTerminationProtectionArgsForCall)]
+	fake.updateStackTerminationProtectionArgsForCall = append(fake.updateStackTerminationProtectionArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateStackTerminationProtectionStub
+	fakeReturns := fake.updateStackTerminationProtectionReturns
+	fake.recordInvocation("UpdateStackTerminationProtection", []interface{}{arg1, arg2, arg3})
+	fake.updateStackTerminationProtectionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) UpdateStackTerminationProtectionCallCount() int {
+	fake.updateStackTerminationProtectionMutex.RLock()
+	defer fake.updateStackTerminationProtectionMutex.RUnlock()
+	return len(fake.updateStackTerminationProtectionArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateStackTerminationProtectionCalls(stub func(context.Context, string, bool) error) {
+	fake.updateStackTerminationProtectionMutex.Lock()
+	defer fake.updateStackTerminationProtectionMutex.Unlock()
+	fake.UpdateStackTerminationProtectionStub = stub
+}
+
+func (fake *FakeStackManager) UpdateStackTerminationProtectionArgsForCall(i int) (context.Context, string, bool) {
+	fake.updateStackTerminationProtectionMutex.RLock()
+	defer fake.updateStackTerminationProtectionMutex.RUnlock()
+	argsForCall := fake.updateStackTerminationProtectionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) UpdateStackTerminationProtectionReturns(result1 error) {
+	fake.updateStackTerminationProtectionMutex.Lock()
+	defer fake.updateStackTerminationProtectionMutex.Unlock()
+	fake.UpdateStackTerminationProtectionStub = nil
+	fake.updateStackTerminationProtectionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) UpdateStackTerminationProtectionReturnsOnCall(i int, result1 error) {
+	fake.updateStackTerminationProtectionMutex.Lock()
+	defer fake.updateStackTerminationProtectionMutex.Unlock()
+	fake.UpdateStackTerminationProtectionStub = nil
+	if fake.updateStackTerminationProtectionReturnsOnCall == nil {
+		fake.updateStackTerminationProtectionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateStackTerminationProtectionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -4658,6 +5282,8 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.listNodeGroupStacksMutex.RUnlock()
 	fake.listNodeGroupStacksWithStatusesMutex.RLock()
 	defer fake.listNodeGroupStacksWithStatusesMutex.RUnlock()
+	fake.listStackResourcesMutex.RLock()
+	defer fake.listStackResourcesMutex.RUnlock()
 	fake.listStacksMutex.RLock()
 	defer fake.listStacksMutex.RUnlock()
 	fake.listStacksMatchingMutex.RLock()
@@ -4694,12 +5320,20 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.propagateManagedNodeGroupTagsToASGMutex.RUnlock()
 	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
 	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
+	fake.retainClusterStackResourcesMutex.RLock()
+	defer fake.retainClusterStackResourcesMutex.RUnlock()
 	fake.stackStatusIsNotTransitionalMutex.RLock()
 	defer fake.stackStatusIsNotTransitionalMutex.RUnlock()
+	fake.updateClusterVPCEndpointsMutex.RLock()
+	defer fake.updateClusterVPCEndpointsMutex.RUnlock()
+	fake.updateControlPlaneSecurityGroupIngressMutex.RLock()
+	defer fake.updateControlPlaneSecurityGroupIngressMutex.RUnlock()
 	fake.updateNodeGroupStackMutex.RLock()
 	defer fake.updateNodeGroupStackMutex.RUnlock()
 	fake.updateStackMutex.RLock()
 	defer fake.updateStackMutex.RUnlock()
+	fake.updateStackTerminationProtectionMutex.RLock()
+	defer fake.updateStackTerminationProtectionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
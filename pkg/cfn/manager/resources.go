@@ -0,0 +1,72 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// ListStackResources lists the resources of a stack that have not been deleted
+func (c *StackCollection) ListStackResources(ctx context.Context, stackName string) ([]types.StackResourceSummary, error) {
+	var resources []types.StackResourceSummary
+	paginator := cloudformation.NewListStackResourcesPaginator(c.cloudformationAPI, &cloudformation.ListStackResourcesInput{
+		StackName: aws.String(stackName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing resources of stack %q: %w", stackName, err)
+		}
+		for _, r := range output.StackResourceSummaries {
+			if r.ResourceStatus != types.ResourceStatusDeleteComplete {
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources, nil
+}
+
+// LogStacksToDelete logs each of the stacks along with the resources that are deleted with it, so that the
+// impact of a deletion can be reviewed in plan mode. Resources of retainedResourceTypes are marked as retained.
+func LogStacksToDelete(ctx context.Context, stackManager StackManager, stacks []*Stack, retainedResourceTypes []string) error {
+	for _, s := range stacks {
+		stackName := aws.ToString(s.StackName)
+		resources, err := stackManager.ListStackResources(ctx, stackName)
+		if err != nil {
+			return err
+		}
+		logger.Info("(plan) would delete stack %q with %d resource(s)", stackName, len(resources))
+		for _, r := range resources {
+			resourceType := aws.ToString(r.ResourceType)
+			var retained string
+			if contains(retainedResourceTypes, resourceType) {
+				retained = " [retained]"
+			}
+			logger.Info("    - %s %s (%s)%s", resourceType, aws.ToString(r.LogicalResourceId), aws.ToString(r.PhysicalResourceId), retained)
+		}
+	}
+	return nil
+}
+
+// StacksInTaskTree returns the stacks the tasks of taskTree and of its sub-trees operate on, in the order of the tasks
+func StacksInTaskTree(taskTree *tasks.TaskTree) []*Stack {
+	if taskTree == nil {
+		return nil
+	}
+	var stacks []*Stack
+	for _, t := range taskTree.Tasks {
+		switch t := t.(type) {
+		case *tasks.TaskTree:
+			stacks = append(stacks, StacksInTaskTree(t)...)
+		case StackTask:
+			stacks = append(stacks, t.StackSpec())
+		}
+	}
+	return stacks
+}
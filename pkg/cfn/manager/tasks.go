@@ -10,6 +10,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -80,13 +81,20 @@ func (t *taskWithClusterIAMServiceAccountSpec) Do(errs chan error) error {
 	return t.stackCollection.createIAMServiceAccountTask(context.TODO(), errs, t.serviceAccount, t.oidc)
 }
 
+// StackTask is a task operating on a single stack
+type StackTask interface {
+	tasks.Task
+	StackSpec() *Stack
+}
+
 type taskWithStackSpec struct {
 	info  string
 	stack *Stack
 	call  func(context.Context, *Stack, chan error) error
 }
 
-func (t *taskWithStackSpec) Describe() string { return t.info }
+func (t *taskWithStackSpec) Describe() string  { return t.info }
+func (t *taskWithStackSpec) StackSpec() *Stack { return t.stack }
 func (t *taskWithStackSpec) Do(errs chan error) error {
 	return t.call(context.TODO(), t.stack, errs)
 }
@@ -97,7 +105,8 @@ type asyncTaskWithStackSpec struct {
 	call  func(context.Context, *Stack) (*Stack, error)
 }
 
-func (t *asyncTaskWithStackSpec) Describe() string { return t.info + " [async]" }
+func (t *asyncTaskWithStackSpec) Describe() string  { return t.info + " [async]" }
+func (t *asyncTaskWithStackSpec) StackSpec() *Stack { return t.stack }
 func (t *asyncTaskWithStackSpec) Do(errs chan error) error {
 	_, err := t.call(context.TODO(), t.stack)
 	close(errs)
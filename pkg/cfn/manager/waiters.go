@@ -4,13 +4,19 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/progress"
+	"github.com/weaveworks/eksctl/pkg/tracing"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
 )
 
 func (c *StackCollection) troubleshootStackFailureCause(ctx context.Context, i *Stack, desiredStatus string) {
@@ -50,6 +56,42 @@ func (c *StackCollection) troubleshootStackFailureCause(ctx context.Context, i *
 	}
 }
 
+// reportStackStatus reports the status of the stack being waited for to the progress listeners, it returns
+// the status or lastStatus if the stack could not be described
+func reportStackStatus(i *Stack, out *cloudformation.DescribeStacksOutput, lastStatus types.StackStatus) types.StackStatus {
+	if out != nil && len(out.Stacks) > 0 {
+		progress.StackStatus(*i.StackName, string(out.Stacks[0].StackStatus))
+		return out.Stacks[0].StackStatus
+	}
+	return lastStatus
+}
+
+// startStackWait starts measuring the time spent waiting for a stack to be created, updated or deleted,
+// given as operation, it returns a function that stops it with the error returned by the wait
+func startStackWait(ctx context.Context, operation, stackName string) (context.Context, func(error)) {
+	started := time.Now()
+	ctx, span := tracing.Start(ctx, fmt.Sprintf("wait for CloudFormation stack to %s", operation), attribute.String("aws.cloudformation.stack", stackName))
+	return ctx, func(err error) {
+		tracing.End(span, err)
+		metrics.ObserveStackWait(operation, time.Since(started), err)
+	}
+}
+
+// waitError annotates the error returned by a stack waiter with the exit code of a rollback, if the stack
+// was last seen failed or rolling back, or of a timeout if the waiter ran out of time
+func waitError(err error, lastStatus types.StackStatus) error {
+	switch status := string(lastStatus); {
+	case err == nil:
+		return nil
+	case strings.Contains(status, "ROLLBACK") || strings.HasSuffix(status, "_FAILED"):
+		return exitcode.Wrap(exitcode.StackRollback, err)
+	case strings.HasPrefix(err.Error(), "exceeded max wait time"):
+		return exitcode.Wrap(exitcode.Timeout, err)
+	default:
+		return err
+	}
+}
+
 type noChangeError struct {
 	msg string
 }
@@ -61,18 +103,24 @@ func (e *noChangeError) Error() string {
 // DoWaitUntilStackIsCreated blocks until the given stack's
 // creation has completed.
 func (c *StackCollection) DoWaitUntilStackIsCreated(ctx context.Context, i *Stack) error {
+	ctx, stopWait := startStackWait(ctx, "create", *i.StackName)
+	var lastStatus types.StackStatus
 	setCustomRetryer := func(o *cloudformation.StackCreateCompleteWaiterOptions) {
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
+			lastStatus = reportStackStatus(i, out, lastStatus)
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
 
 	waiter := cloudformation.NewStackCreateCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+	err := waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
 		StackName: i.StackName,
 	}, c.waitTimeout, setCustomRetryer)
+	err = waitError(err, lastStatus)
+	stopWait(err)
+	return err
 }
 
 func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack, stack builder.ResourceSetReader, errs chan error) {
@@ -95,18 +143,24 @@ func (c *StackCollection) waitUntilStackIsCreated(ctx context.Context, i *Stack,
 }
 
 func (c *StackCollection) doWaitUntilStackIsDeleted(ctx context.Context, i *Stack) error {
+	ctx, stopWait := startStackWait(ctx, "delete", *i.StackName)
+	var lastStatus types.StackStatus
 	setCustomRetryer := func(o *cloudformation.StackDeleteCompleteWaiterOptions) {
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
+			lastStatus = reportStackStatus(i, out, lastStatus)
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
 
 	waiter := cloudformation.NewStackDeleteCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+	err := waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
 		StackName: i.StackName,
 	}, c.waitTimeout, setCustomRetryer)
+	err = waitError(err, lastStatus)
+	stopWait(err)
+	return err
 }
 
 func (c *StackCollection) waitUntilStackIsDeleted(ctx context.Context, i *Stack, errs chan error) {
@@ -120,18 +174,24 @@ func (c *StackCollection) waitUntilStackIsDeleted(ctx context.Context, i *Stack,
 }
 
 func (c *StackCollection) doWaitUntilStackIsUpdated(ctx context.Context, i *Stack) error {
+	ctx, stopWait := startStackWait(ctx, "update", *i.StackName)
+	var lastStatus types.StackStatus
 	setCustomRetryer := func(o *cloudformation.StackUpdateCompleteWaiterOptions) {
 		defaultRetryer := o.Retryable
 		o.Retryable = func(ctx context.Context, in *cloudformation.DescribeStacksInput, out *cloudformation.DescribeStacksOutput, err error) (bool, error) {
 			logger.Info("waiting for CloudFormation stack %q", *i.StackName)
+			lastStatus = reportStackStatus(i, out, lastStatus)
 			return defaultRetryer(ctx, in, out, err)
 		}
 	}
 
 	waiter := cloudformation.NewStackUpdateCompleteWaiter(c.cloudformationAPI)
-	return waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
+	err := waiter.Wait(ctx, &cloudformation.DescribeStacksInput{
 		StackName: i.StackName,
 	}, c.waitTimeout, setCustomRetryer)
+	err = waitError(err, lastStatus)
+	stopWait(err)
+	return err
 }
 
 func (c *StackCollection) doWaitUntilChangeSetIsCreated(ctx context.Context, i *Stack, changesetName string) error {
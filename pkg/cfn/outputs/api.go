@@ -33,6 +33,7 @@ const (
 	ClusterSharedNodeSecurityGroup  = "SharedNodeSecurityGroup"
 	ClusterServiceRoleARN           = "ServiceRoleARN"
 	ClusterFeatureNATMode           = "FeatureNATMode"
+	ClusterAutoModeNodeRoleARN      = "AutoModeNodeRoleARN"
 
 	// outputs from nodegroup stack
 	NodeGroupInstanceRoleARN    = "InstanceRoleARN"
@@ -50,6 +51,15 @@ const (
 
 	// IAMServiceAccountRoleName is the name of iamserviceaccount role resource and output.
 	IAMServiceAccountRoleName = "Role1"
+
+	// outputs from EFS stack
+	EFSFileSystemID = "FileSystemID"
+
+	// outputs from audit log export stack
+	AuditLogDeliveryStreamARN = "DeliveryStreamARN"
+
+	// outputs from node termination handler stack
+	NodeTerminationHandlerQueueURL = "QueueURL"
 )
 
 type (
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
 )
 
 // NextDelay returns the amount of time to wait before the next retry given the number of attempts.
@@ -66,7 +67,7 @@ func describeStackStatus(ctx context.Context, cfnAPI awsapi.CloudFormation, stac
 		types.StackStatusDeleteInProgress,
 		types.StackStatusDeleteFailed,
 		types.StackStatusDeleteComplete:
-		return &stack, false, errors.New("ResourceNotReady: failed waiting for successful resource state")
+		return &stack, false, exitcode.Wrap(exitcode.StackRollback, errors.New("ResourceNotReady: failed waiting for successful resource state"))
 
 	default:
 		return &stack, false, nil
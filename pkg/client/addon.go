@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// CreateAddonsOptions are the options of CreateAddons
+type CreateAddonsOptions struct {
+	// Force migrates existing self-managed addons to EKS managed addons
+	Force bool
+}
+
+// DeleteAddonOptions are the options of DeleteAddon
+type DeleteAddonOptions struct {
+	// Preserve deletes the addon from the EKS API but keeps its Kubernetes resources
+	Preserve bool
+}
+
+// CreateAddons creates the addons of cfg in its existing cluster, as `eksctl create addon -f` does
+func (c *Client) CreateAddons(ctx context.Context, cfg *api.ClusterConfig, options CreateAddonsOptions) error {
+	if len(cfg.Addons) == 0 {
+		return errors.New("addons must be set")
+	}
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		return err
+	}
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	oidcProviderExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return err
+	}
+	if !oidcProviderExists {
+		logger.Warning("no IAM OIDC provider associated with cluster %q, addons will not get IAM roles for service accounts", cfg.Metadata.Name)
+	}
+	if err := setClusterVersionFromEKS(ctx, ctl, cfg); err != nil {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	addonManager, err := addon.New(cfg, ctl.AWSProvider.EKS(), ctl.NewStackManager(cfg), oidcProviderExists, oidc, clientSet)
+	if err != nil {
+		return err
+	}
+	for _, a := range cfg.Addons {
+		if options.Force {
+			a.Force = true
+		}
+		if err := addonManager.Create(ctx, a, c.options.WaitTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteAddon deletes an addon, as `eksctl delete addon` does
+func (c *Client) DeleteAddon(ctx context.Context, clusterName, name string, options DeleteAddonOptions) error {
+	if name == "" {
+		return errors.New("the name of the addon must be set")
+	}
+	cfg := c.clusterConfigFor(clusterName)
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		return err
+	}
+	if err := setClusterVersionFromEKS(ctx, ctl, cfg); err != nil {
+		return err
+	}
+
+	addonManager, err := addon.New(cfg, ctl.AWSProvider.EKS(), ctl.NewStackManager(cfg), api.IsEnabled(cfg.IAM.WithOIDC), nil, nil)
+	if err != nil {
+		return err
+	}
+	a := &api.Addon{Name: name}
+	if options.Preserve {
+		return addonManager.DeleteWithPreserve(ctx, a)
+	}
+	return addonManager.Delete(ctx, a)
+}
+
+// setClusterVersionFromEKS sets the version of cfg to the one of its cluster, which the addon versions depend on
+func setClusterVersionFromEKS(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig) error {
+	output, err := ctl.AWSProvider.EKS().DescribeCluster(ctx, &awseks.DescribeClusterInput{
+		Name: &cfg.Metadata.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch cluster %q version: %w", cfg.Metadata.Name, err)
+	}
+	cfg.Metadata.Version = *output.Cluster.Version
+	return nil
+}
@@ -0,0 +1,130 @@
+// Package client is the Go API of eksctl, for programs creating and managing EKS clusters without running the eksctl
+// binary. Clusters, nodegroups, IAM service accounts and addons are described with the ClusterConfig of the config
+// files, and each operation behaves like the eksctl command of the same name run with a config file.
+//
+// The package does not depend on the CLI of eksctl, its errors are returned rather than printed, and each operation
+// stops waiting for AWS when its context is done. Progress is still logged with github.com/kris-nova/logger, whose
+// writer and level callers may set.
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/outposts"
+)
+
+// Options configure the AWS session of a Client
+type Options struct {
+	// Region is the AWS region, metadata.region of the ClusterConfig is used when it is not set, and the region of
+	// the AWS profile when neither is
+	Region string
+	// Profile is the AWS profile, the default credentials chain is used when it is not set
+	Profile string
+	// WaitTimeout bounds the time spent waiting for each AWS resource, defaults to api.DefaultWaitTimeout
+	WaitTimeout time.Duration
+	// CloudFormationRoleARN is the IAM role CloudFormation assumes to create, update and delete the stacks
+	CloudFormationRoleARN string
+}
+
+// Client creates and manages EKS clusters
+type Client struct {
+	options Options
+}
+
+// New returns a Client
+func New(options Options) *Client {
+	if options.WaitTimeout == 0 {
+		options.WaitTimeout = api.DefaultWaitTimeout
+	}
+	return &Client{options: options}
+}
+
+func (c *Client) providerConfig(cfg *api.ClusterConfig) *api.ProviderConfig {
+	region := c.options.Region
+	if region == "" {
+		region = cfg.Metadata.Region
+	}
+	return &api.ProviderConfig{
+		CloudFormationRoleARN: c.options.CloudFormationRoleARN,
+		Region:                region,
+		Profile:               api.Profile{Name: c.options.Profile},
+		WaitTimeout:           c.options.WaitTimeout,
+	}
+}
+
+// newProvider returns the provider of the AWS services, setting the region and account ID of cfg
+func (c *Client) newProvider(ctx context.Context, cfg *api.ClusterConfig) (*eks.ClusterProvider, error) {
+	providerConfig := c.providerConfig(cfg)
+	ctl, err := eks.New(ctx, providerConfig, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !ctl.IsSupportedRegion() {
+		return nil, fmt.Errorf("region %s is not supported - use one of: %s", ctl.AWSProvider.Region(), strings.Join(api.SupportedRegions(), ", "))
+	}
+	return ctl, nil
+}
+
+// newProviderForExistingCluster returns the provider of the AWS services of a cluster that must exist, and sets
+// the defaults of cfg once setVersion, if not nil, has set its version from the one of the control plane
+func (c *Client) newProviderForExistingCluster(ctx context.Context, cfg *api.ClusterConfig, setVersion func(ctl *eks.ClusterProvider, meta *api.ClusterMeta) error) (*eks.ClusterProvider, error) {
+	ctl, err := c.newProvider(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctl.RefreshClusterStatus(ctx, cfg); err != nil {
+		return nil, err
+	}
+	if setVersion != nil {
+		if err := setVersion(ctl, cfg.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	if err := initializeClusterConfig(cfg); err != nil {
+		return nil, err
+	}
+	if cfg.IsControlPlaneOnOutposts() {
+		ctl.AWSProvider = outposts.WrapClusterProvider(ctl.AWSProvider)
+	}
+	return ctl, nil
+}
+
+// initializeClusterConfig sets the defaults of cfg and validates it, as eksctl does with config files
+func initializeClusterConfig(cfg *api.ClusterConfig) error {
+	api.SetClusterConfigDefaults(cfg)
+	if err := api.ValidateClusterConfig(cfg); err != nil {
+		return err
+	}
+	for i, ng := range cfg.NodeGroups {
+		if err := api.ValidateNodeGroup(i, ng, cfg); err != nil {
+			return err
+		}
+		// defaulting of nodegroups depends on their validation
+		api.SetNodeGroupDefaults(ng, cfg.Metadata, cfg.IsControlPlaneOnOutposts())
+	}
+	for i, ng := range cfg.ManagedNodeGroups {
+		api.SetManagedNodeGroupDefaults(ng, cfg.Metadata, cfg.IsControlPlaneOnOutposts())
+		if err := api.ValidateManagedNodeGroup(i, ng); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clusterConfigFor returns the ClusterConfig of an existing cluster known only by its name
+func (c *Client) clusterConfigFor(clusterName string) *api.ClusterConfig {
+	cfg := api.NewClusterConfig()
+	cfg.Metadata.Name = clusterName
+	cfg.Metadata.Region = c.options.Region
+	return cfg
+}
+
+// withWaitTimeout returns ctx bounded by the wait timeout of the client
+func (c *Client) withWaitTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, c.options.WaitTimeout)
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Client", func() {
+	Context("New", func() {
+		It("defaults the wait timeout", func() {
+			c := New(Options{})
+			Expect(c.options.WaitTimeout).To(Equal(api.DefaultWaitTimeout))
+		})
+
+		It("keeps the wait timeout set", func() {
+			c := New(Options{WaitTimeout: time.Minute})
+			Expect(c.options.WaitTimeout).To(Equal(time.Minute))
+		})
+	})
+
+	Context("providerConfig", func() {
+		It("uses the region of the client over the one of the cluster", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Region = "us-west-2"
+			providerConfig := New(Options{Region: "eu-west-1", Profile: "team"}).providerConfig(cfg)
+			Expect(providerConfig.Region).To(Equal("eu-west-1"))
+			Expect(providerConfig.Profile.Name).To(Equal("team"))
+			Expect(providerConfig.WaitTimeout).To(Equal(api.DefaultWaitTimeout))
+		})
+
+		It("uses the region of the cluster when the client has none", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Region = "us-west-2"
+			Expect(New(Options{}).providerConfig(cfg).Region).To(Equal("us-west-2"))
+		})
+	})
+
+	Context("CreateCluster", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "dev"
+		})
+
+		It("sets the VPC defaults of config files", func() {
+			cfg.VPC = nil
+			setCreateClusterDefaults(cfg)
+			Expect(cfg.VPC).NotTo(BeNil())
+			Expect(*cfg.VPC.NAT.Gateway).To(Equal(api.ClusterSingleNAT))
+			Expect(cfg.VPC.ClusterEndpoints).NotTo(BeNil())
+		})
+
+		It("sets the NAT gateway left empty", func() {
+			cfg.VPC.NAT = &api.ClusterNAT{}
+			setCreateClusterDefaults(cfg)
+			Expect(*cfg.VPC.NAT.Gateway).To(Equal(api.ClusterSingleNAT))
+		})
+
+		DescribeTable("rejects the features it does not support", func(update func(*api.ClusterConfig), expectedErr string) {
+			update(cfg)
+			err := New(Options{}).CreateCluster(context.Background(), cfg, CreateClusterOptions{})
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("without a name", func(cfg *api.ClusterConfig) {
+				cfg.Metadata.Name = ""
+			}, "metadata.name must be set"),
+			Entry("with Karpenter", func(cfg *api.ClusterConfig) {
+				cfg.Karpenter = &api.Karpenter{}
+			}, "karpenter is not supported"),
+			Entry("with GitOps", func(cfg *api.ClusterConfig) {
+				cfg.GitOps = &api.GitOps{}
+			}, "gitops is not supported"),
+			Entry("with hooks", func(cfg *api.ClusterConfig) {
+				cfg.Hooks = &api.Hooks{}
+			}, "hooks is not supported"),
+			Entry("with subnets and availability zones", func(cfg *api.ClusterConfig) {
+				cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+				cfg.VPC.Subnets = &api.ClusterSubnets{
+					Private: api.AZSubnetMapping{"us-west-2a": api.AZSubnetSpec{ID: "subnet-1"}},
+				}
+			}, "vpc.subnets and availabilityZones cannot be set at the same time"),
+		)
+	})
+
+	Context("ScaleNodeGroup", func() {
+		DescribeTable("rejects invalid sizes", func(options ScaleNodeGroupOptions, expectedErr string) {
+			err := New(Options{}).ScaleNodeGroup(context.Background(), "dev", "ng-1", options)
+			Expect(err).To(MatchError(expectedErr))
+		},
+			Entry("when no size is set", ScaleNodeGroupOptions{}, "at least one of minimum, maximum and desired nodes must be set"),
+			Entry("when the desired capacity is negative", ScaleNodeGroupOptions{DesiredCapacity: intPtr(-1)}, "number of nodes must be 0 or greater"),
+			Entry("when the maximum is below the minimum", ScaleNodeGroupOptions{MinSize: intPtr(3), MaxSize: intPtr(2)}, "maximum number of nodes must be greater than minimum number of nodes"),
+			Entry("when the desired capacity is above the maximum", ScaleNodeGroupOptions{DesiredCapacity: intPtr(3), MaxSize: intPtr(2)}, "maximum number of nodes must be greater than or equal to number of nodes"),
+			Entry("when the desired capacity is below the minimum", ScaleNodeGroupOptions{DesiredCapacity: intPtr(1), MinSize: intPtr(2)}, "minimum number of nodes must be fewer than or equal to number of nodes"),
+		)
+	})
+
+	Context("IAM service accounts and addons", func() {
+		It("requires service accounts to create", func() {
+			err := New(Options{}).CreateIAMServiceAccounts(context.Background(), api.NewClusterConfig(), CreateIAMServiceAccountsOptions{})
+			Expect(err).To(MatchError("iam.serviceAccounts must be set"))
+		})
+
+		It("requires service accounts to delete", func() {
+			err := New(Options{}).DeleteIAMServiceAccounts(context.Background(), api.NewClusterConfig(), DeleteIAMServiceAccountsOptions{})
+			Expect(err).To(MatchError("iam.serviceAccounts must be set"))
+		})
+
+		It("requires addons to create", func() {
+			err := New(Options{}).CreateAddons(context.Background(), api.NewClusterConfig(), CreateAddonsOptions{})
+			Expect(err).To(MatchError("addons must be set"))
+		})
+
+		It("requires the name of the addon to delete", func() {
+			err := New(Options{}).DeleteAddon(context.Background(), "dev", "", DeleteAddonOptions{})
+			Expect(err).To(MatchError("the name of the addon must be set"))
+		})
+	})
+})
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/utils/nodes"
+)
+
+const defaultPodEvictionWaitPeriod = 10 * time.Second
+
+// CreateClusterOptions are the options of CreateCluster
+type CreateClusterOptions struct {
+	// SkipNeuronDevicePlugin does not install the Neuron device plugin for Inferentia and Trainium nodes
+	SkipNeuronDevicePlugin bool
+	// SkipNvidiaDevicePlugin does not install the Nvidia device plugin for GPU nodes
+	SkipNvidiaDevicePlugin bool
+	// QuotaCheck is what to do when the vCPU quotas do not allow the instances of the nodegroups to be launched,
+	// defaults to preflight.QuotaCheckFail
+	QuotaCheck preflight.QuotaCheckMode
+	// SpotPlacement is what to do with the Spot placement scores of the availability zones of Spot nodegroups,
+	// defaults to preflight.SpotPlacementRecommend
+	SpotPlacement preflight.SpotPlacementMode
+	// KubeconfigPath is the file the kubeconfig of the cluster is written to, it is not written when empty
+	KubeconfigPath string
+	// SetContext makes the context of the cluster the current context of the kubeconfig
+	SetContext bool
+}
+
+// DeleteClusterOptions are the options of DeleteCluster
+type DeleteClusterOptions struct {
+	// Wait waits for the deletion of all the resources of the cluster, rather than returning once the deletion of
+	// the cluster stack has started
+	Wait bool
+	// Force deletes the cluster even if its pods cannot be evicted
+	Force bool
+	// DisableNodegroupEviction deletes the nodegroups without draining their nodes
+	DisableNodegroupEviction bool
+	// PodEvictionWaitPeriod is the time waited after failing to evict a pod, defaults to 10s
+	PodEvictionWaitPeriod time.Duration
+	// Parallel is the number of nodes drained in parallel, defaults to 1
+	Parallel int
+}
+
+// CreateCluster creates the cluster described by cfg with its nodegroups and addons, as
+// `eksctl create cluster -f` does, and returns once its nodes have joined. The defaults of cfg are set, and the VPC
+// created or imported is recorded in cfg.VPC.
+//
+// Karpenter, GitOps, Helm charts, hooks and control planes on Outposts are not supported.
+func (c *Client) CreateCluster(ctx context.Context, cfg *api.ClusterConfig, options CreateClusterOptions) error {
+	if options.QuotaCheck == "" {
+		options.QuotaCheck = preflight.QuotaCheckFail
+	}
+	if options.SpotPlacement == "" {
+		options.SpotPlacement = preflight.SpotPlacementRecommend
+	}
+	if err := preflight.ValidateQuotaCheckMode(options.QuotaCheck); err != nil {
+		return err
+	}
+	if err := preflight.ValidateSpotPlacementMode(options.SpotPlacement); err != nil {
+		return err
+	}
+	setCreateClusterDefaults(cfg)
+	if err := validateCreateClusterConfig(cfg); err != nil {
+		return err
+	}
+	if err := cluster.SetVersion(cfg); err != nil {
+		return err
+	}
+
+	ctl, err := c.newProvider(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if err := initializeClusterConfig(cfg); err != nil {
+		return err
+	}
+	if err := cfg.ValidatePrivateCluster(); err != nil {
+		return err
+	}
+	if err := cfg.ValidateClusterEndpointConfig(); err != nil {
+		return err
+	}
+	if err := cluster.CreateOrImportVPC(ctx, cfg, ctl, cluster.VPCOptions{}); err != nil {
+		return err
+	}
+
+	nodeGroupService := eks.NewNodeGroupService(ctl.AWSProvider, selector.New(ctl.AWSProvider.Session()), nil)
+	if err := nodeGroupService.ExpandInstanceSelectorOptions(nodes.ToNodePools(cfg), cfg.AvailabilityZones); err != nil {
+		return err
+	}
+
+	var kubeconfigOptions *cluster.KubeconfigOptions
+	if options.KubeconfigPath != "" {
+		kubeconfigOptions = &cluster.KubeconfigOptions{
+			Path:       options.KubeconfigPath,
+			SetContext: options.SetContext,
+		}
+	}
+	return cluster.Create(ctx, cfg, ctl, nodeGroupService, cluster.CreateOptions{
+		WaitTimeout:               c.options.WaitTimeout,
+		QuotaCheck:                options.QuotaCheck,
+		SpotPlacement:             options.SpotPlacement,
+		InstallNeuronDevicePlugin: !options.SkipNeuronDevicePlugin,
+		InstallNvidiaDevicePlugin: !options.SkipNvidiaDevicePlugin,
+		Kubeconfig:                kubeconfigOptions,
+		ConfigFileProvided:        true,
+		Command:                   "client.CreateCluster",
+	})
+}
+
+// DeleteCluster deletes a cluster with its nodegroups, addons and IAM service accounts, as `eksctl delete cluster`
+// does
+func (c *Client) DeleteCluster(ctx context.Context, clusterName string, options DeleteClusterOptions) error {
+	if options.PodEvictionWaitPeriod == 0 {
+		options.PodEvictionWaitPeriod = defaultPodEvictionWaitPeriod
+	}
+	if options.Parallel == 0 {
+		options.Parallel = 1
+	}
+
+	cfg := c.clusterConfigFor(clusterName)
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		if !options.Force {
+			return err
+		}
+		// the cluster stack may have failed to create the cluster, leaving other resources to delete
+		logger.Warning("failed to create provider for cluster; force = true skipping: %v", err)
+		if ctl, err = c.newProvider(ctx, cfg); err != nil {
+			return err
+		}
+	}
+	if err := cluster.CheckDeletionProtection(ctx, ctl.NewStackManager(cfg), clusterName); err != nil {
+		return err
+	}
+
+	clusterToDelete, err := cluster.New(ctx, cfg, ctl)
+	if err != nil {
+		return err
+	}
+	return clusterToDelete.Delete(ctx, 20*time.Second, options.PodEvictionWaitPeriod, options.Wait, options.Force, options.DisableNodegroupEviction, false, options.Parallel, nil)
+}
+
+// validateCreateClusterConfig rejects the features of `eksctl create cluster` CreateCluster does not support, cfg must
+// have its defaults set
+func validateCreateClusterConfig(cfg *api.ClusterConfig) error {
+	if cfg.Metadata.Name == "" {
+		return errors.New("metadata.name must be set")
+	}
+	if api.IsInvalidNameArg(cfg.Metadata.Name) {
+		return api.ErrInvalidName(cfg.Metadata.Name)
+	}
+	unsupported := map[string]bool{
+		"karpenter": cfg.Karpenter != nil,
+		"gitops":    cfg.GitOps != nil,
+		"charts":    cfg.HasCharts(),
+		"hooks":     cfg.Hooks != nil,
+		"outpost":   cfg.IsControlPlaneOnOutposts(),
+	}
+	for _, field := range []string{"karpenter", "gitops", "charts", "hooks", "outpost"} {
+		if unsupported[field] {
+			return fmt.Errorf("%s is not supported by the Go client, use `eksctl create cluster -f` instead", field)
+		}
+	}
+	if _, hasNodeGroupsOnOutposts := cfg.FindNodeGroupOutpostARN(); hasNodeGroupsOnOutposts {
+		return errors.New("nodegroups on Outposts are not supported by the Go client, use `eksctl create nodegroup` instead")
+	}
+	if cfg.HasAnySubnets() && len(cfg.AvailabilityZones) != 0 {
+		return errors.New("vpc.subnets and availabilityZones cannot be set at the same time")
+	}
+	return nil
+}
+
+// setCreateClusterDefaults sets the defaults of the VPC eksctl sets when loading a config file
+func setCreateClusterDefaults(cfg *api.ClusterConfig) {
+	ipv6Enabled := cfg.IPv6Enabled()
+	if cfg.VPC == nil {
+		cfg.VPC = api.NewClusterVPC(ipv6Enabled)
+	}
+	if cfg.VPC.NAT == nil && !ipv6Enabled {
+		cfg.VPC.NAT = api.DefaultClusterNAT()
+	}
+	if cfg.VPC.NAT != nil && api.IsEmpty(cfg.VPC.NAT.Gateway) {
+		cfg.VPC.NAT.Gateway = aws.String(api.ClusterSingleNAT)
+	}
+	api.SetClusterEndpointAccessDefaults(cfg.VPC)
+}
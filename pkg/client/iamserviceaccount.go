@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+)
+
+// CreateIAMServiceAccountsOptions are the options of CreateIAMServiceAccounts
+type CreateIAMServiceAccountsOptions struct {
+	// OverrideExisting updates the metadata of the service accounts that exist in Kubernetes, rather than skipping
+	// them
+	OverrideExisting bool
+}
+
+// DeleteIAMServiceAccountsOptions are the options of DeleteIAMServiceAccounts
+type DeleteIAMServiceAccountsOptions struct {
+	// Wait waits for the deletion of the IAM roles of the service accounts
+	Wait bool
+}
+
+// CreateIAMServiceAccounts creates the IAM roles and service accounts of cfg.IAM.ServiceAccounts in its existing
+// cluster, as `eksctl create iamserviceaccount -f` does. The cluster must have an IAM OIDC provider
+func (c *Client) CreateIAMServiceAccounts(ctx context.Context, cfg *api.ClusterConfig, options CreateIAMServiceAccountsOptions) error {
+	if cfg.IAM == nil || len(cfg.IAM.ServiceAccounts) == 0 {
+		return errors.New("iam.serviceAccounts must be set")
+	}
+	ctl, oidc, clientSet, err := c.newIRSAProvider(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	saFilter := filter.NewIAMServiceAccountFilter()
+	if err := saFilter.SetExcludeExistingFilter(ctx, stackManager, clientSet, cfg.IAM.ServiceAccounts, options.OverrideExisting); err != nil {
+		return err
+	}
+	serviceAccounts := saFilter.FilterMatching(cfg.IAM.ServiceAccounts)
+	saFilter.LogInfo(cfg.IAM.ServiceAccounts)
+
+	return irsa.New(cfg.Metadata.Name, stackManager, oidc, clientSet).CreateIAMServiceAccount(serviceAccounts, false)
+}
+
+// DeleteIAMServiceAccounts deletes the IAM roles and service accounts of cfg.IAM.ServiceAccounts, as
+// `eksctl delete iamserviceaccount -f` does
+func (c *Client) DeleteIAMServiceAccounts(ctx context.Context, cfg *api.ClusterConfig, options DeleteIAMServiceAccountsOptions) error {
+	if cfg.IAM == nil || len(cfg.IAM.ServiceAccounts) == 0 {
+		return errors.New("iam.serviceAccounts must be set")
+	}
+	ctl, oidc, clientSet, err := c.newIRSAProvider(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, sa := range cfg.IAM.ServiceAccounts {
+		names = append(names, sa.NameString())
+	}
+	return irsa.New(cfg.Metadata.Name, ctl.NewStackManager(cfg), oidc, clientSet).Delete(ctx, names, false, options.Wait)
+}
+
+// newIRSAProvider returns the provider of the AWS services of the existing cluster of cfg, with its IAM OIDC
+// provider and Kubernetes client
+func (c *Client) newIRSAProvider(ctx context.Context, cfg *api.ClusterConfig) (*eks.ClusterProvider, *iamoidc.OpenIDConnectManager, kubernetes.Interface, error) {
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return nil, nil, nil, err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	providerExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !providerExists {
+		return nil, nil, nil, errors.New("no IAM OIDC provider associated with cluster, use `eksctl utils associate-iam-oidc-provider` to associate one")
+	}
+	return ctl, oidc, clientSet, nil
+}
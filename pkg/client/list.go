@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// ListNodeGroups returns the names of the nodegroups and managed nodegroups of a cluster created by eksctl, the
+// nodegroups created by other tools are left out
+func (c *Client) ListNodeGroups(ctx context.Context, clusterName string) ([]string, error) {
+	cfg := c.clusterConfigFor(clusterName)
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		return nil, err
+	}
+	stackManager := ctl.NewStackManager(cfg)
+	stacks, err := stackManager.ListNodeGroupStacks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting nodegroup stacks: %w", err)
+	}
+	var names []string
+	for _, s := range stacks {
+		names = append(names, stackManager.GetNodeGroupName(s))
+	}
+	return names, nil
+}
+
+// ListAddons returns the names of the EKS managed addons of a cluster created by eksctl, i.e. the ones with an addon
+// IAM stack or with the created-by tag. The addons installed by EKS and the ones created by other tools are left out
+func (c *Client) ListAddons(ctx context.Context, clusterName string) ([]string, error) {
+	cfg := c.clusterConfigFor(clusterName)
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		return nil, err
+	}
+	stackManager := ctl.NewStackManager(cfg)
+	stacks, err := stackManager.GetIAMAddonsStacks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting addon IAM stacks: %w", err)
+	}
+	withStack := sets.NewString()
+	for _, s := range stacks {
+		withStack.Insert(stackManager.GetIAMAddonName(s))
+	}
+
+	var names []string
+	paginator := awseks.NewListAddonsPaginator(ctl.AWSProvider.EKS(), &awseks.ListAddonsInput{
+		ClusterName: &cfg.Metadata.Name,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list addons: %w", err)
+		}
+		for _, name := range output.Addons {
+			if withStack.Has(name) {
+				names = append(names, name)
+				continue
+			}
+			addon, err := ctl.AWSProvider.EKS().DescribeAddon(ctx, &awseks.DescribeAddonInput{
+				ClusterName: &cfg.Metadata.Name,
+				AddonName:   aws.String(name),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to describe addon %q: %w", name, err)
+			}
+			if addon.Addon.Tags[api.CreatedByTag] == api.CreatedByTagValue {
+				names = append(names, name)
+			}
+		}
+	}
+	return names, nil
+}
+
+// ListIAMServiceAccounts returns the names, as namespace/name, of the IAM service accounts of a cluster created by
+// eksctl
+func (c *Client) ListIAMServiceAccounts(ctx context.Context, clusterName string) ([]string, error) {
+	cfg := c.clusterConfigFor(clusterName)
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		return nil, err
+	}
+	serviceAccounts, err := ctl.NewStackManager(cfg).GetIAMServiceAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, sa := range serviceAccounts {
+		names = append(names, sa.NameString())
+	}
+	return names, nil
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+const defaultMaxGracePeriod = 10 * time.Minute
+
+// CreateNodeGroupsOptions are the options of CreateNodeGroups
+type CreateNodeGroupsOptions struct {
+	// SkipAuthConfigMapUpdate does not add the IAM roles of the unmanaged nodegroups to the aws-auth ConfigMap
+	SkipAuthConfigMapUpdate bool
+	// SkipNeuronDevicePlugin does not install the Neuron device plugin for Inferentia and Trainium nodes
+	SkipNeuronDevicePlugin bool
+	// SkipNvidiaDevicePlugin does not install the Nvidia device plugin for GPU nodes
+	SkipNvidiaDevicePlugin bool
+	// QuotaCheck is what to do when the vCPU quotas do not allow the instances of the nodegroups to be launched,
+	// defaults to preflight.QuotaCheckFail
+	QuotaCheck preflight.QuotaCheckMode
+	// SpotPlacement is what to do with the Spot placement scores of the availability zones of Spot nodegroups,
+	// defaults to preflight.SpotPlacementRecommend
+	SpotPlacement preflight.SpotPlacementMode
+}
+
+// DeleteNodeGroupOptions are the options of DeleteNodeGroup
+type DeleteNodeGroupOptions struct {
+	// Wait waits for the deletion of the nodegroup
+	Wait bool
+	// SkipDrain deletes the nodegroup without draining its nodes
+	SkipDrain bool
+	// SkipAuthConfigMapUpdate does not remove the IAM role of the nodegroup from the aws-auth ConfigMap
+	SkipAuthConfigMapUpdate bool
+	// MaxGracePeriod caps the termination grace period of the pods evicted, defaults to 10m
+	MaxGracePeriod time.Duration
+	// PodEvictionWaitPeriod is the time waited after failing to evict a pod, defaults to 10s
+	PodEvictionWaitPeriod time.Duration
+	// Parallel is the number of nodes drained in parallel, defaults to 1
+	Parallel int
+}
+
+// ScaleNodeGroupOptions are the options of ScaleNodeGroup, the sizes left nil are not changed
+type ScaleNodeGroupOptions struct {
+	DesiredCapacity *int
+	MinSize         *int
+	MaxSize         *int
+	// Wait waits for the nodegroup to reach its desired capacity
+	Wait bool
+}
+
+// CreateNodeGroups creates the nodegroups and managed nodegroups of cfg in its existing cluster, as
+// `eksctl create nodegroup -f` does. The version of the nodegroups defaults to the one of the control plane
+func (c *Client) CreateNodeGroups(ctx context.Context, cfg *api.ClusterConfig, options CreateNodeGroupsOptions) error {
+	if options.QuotaCheck == "" {
+		options.QuotaCheck = preflight.QuotaCheckFail
+	}
+	if options.SpotPlacement == "" {
+		options.SpotPlacement = preflight.SpotPlacementRecommend
+	}
+	if err := preflight.ValidateQuotaCheckMode(options.QuotaCheck); err != nil {
+		return err
+	}
+	if err := preflight.ValidateSpotPlacementMode(options.SpotPlacement); err != nil {
+		return err
+	}
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nodegroup.SetVersion)
+	if err != nil {
+		return err
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	return nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).Create(ctx, nodegroup.CreateOpts{
+		UpdateAuthConfigMap:       !options.SkipAuthConfigMapUpdate,
+		InstallNeuronDevicePlugin: !options.SkipNeuronDevicePlugin,
+		InstallNvidiaDevicePlugin: !options.SkipNvidiaDevicePlugin,
+		ConfigFileProvided:        true,
+		QuotaCheck:                options.QuotaCheck,
+		SpotPlacement:             options.SpotPlacement,
+	}, filter.NewNodeGroupFilter())
+}
+
+// DeleteNodeGroup drains and deletes a nodegroup or managed nodegroup, as `eksctl delete nodegroup` does
+func (c *Client) DeleteNodeGroup(ctx context.Context, clusterName, name string, options DeleteNodeGroupOptions) error {
+	if options.MaxGracePeriod == 0 {
+		options.MaxGracePeriod = defaultMaxGracePeriod
+	}
+	if options.PodEvictionWaitPeriod == 0 {
+		options.PodEvictionWaitPeriod = defaultPodEvictionWaitPeriod
+	}
+	if options.Parallel == 0 {
+		options.Parallel = 1
+	}
+
+	cfg := c.clusterConfigFor(clusterName)
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		return err
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	stackManager := ctl.NewStackManager(cfg)
+	if err := addNodeGroup(ctx, stackManager, ctl.AWSProvider, cfg, name); err != nil {
+		return err
+	}
+
+	updateAuthConfigMap := !options.SkipAuthConfigMapUpdate
+	if updateAuthConfigMap {
+		for _, ng := range cfg.NodeGroups {
+			if err := ctl.GetNodeGroupIAM(ctx, stackManager, ng); err != nil {
+				logger.Warning("continuing with deletion, error getting instance role ARN for nodegroup %q: %v", ng.Name, err)
+			}
+		}
+	}
+
+	nodeGroupManager := nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session()))
+	if !options.SkipDrain {
+		drainCtx, cancel := c.withWaitTimeout(ctx)
+		defer cancel()
+		if err := nodeGroupManager.Drain(drainCtx, &nodegroup.DrainInput{
+			NodeGroups:            eks.ToKubeNodeGroups(cfg),
+			MaxGracePeriod:        options.MaxGracePeriod,
+			PodEvictionWaitPeriod: options.PodEvictionWaitPeriod,
+			Parallel:              options.Parallel,
+		}); err != nil {
+			return fmt.Errorf("draining nodegroup %q: %w", name, err)
+		}
+	}
+	if err := nodeGroupManager.Delete(ctx, cfg.NodeGroups, cfg.ManagedNodeGroups, options.Wait, false); err != nil {
+		return err
+	}
+
+	if updateAuthConfigMap {
+		for _, ng := range cfg.NodeGroups {
+			if ng.IAM != nil && ng.IAM.InstanceRoleARN != "" {
+				if err := authconfigmap.RemoveNodeGroup(clientSet, ng); err != nil {
+					logger.Warning(err.Error())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ScaleNodeGroup changes the sizes of a nodegroup or managed nodegroup, as `eksctl scale nodegroup` does
+func (c *Client) ScaleNodeGroup(ctx context.Context, clusterName, name string, options ScaleNodeGroupOptions) error {
+	ng := &api.NodeGroupBase{
+		Name: name,
+		ScalingConfig: &api.ScalingConfig{
+			DesiredCapacity: options.DesiredCapacity,
+			MinSize:         options.MinSize,
+			MaxSize:         options.MaxSize,
+		},
+	}
+	if err := nodegroup.ValidateScaleSizes(ng); err != nil {
+		return err
+	}
+
+	cfg := c.clusterConfigFor(clusterName)
+	ctl, err := c.newProviderForExistingCluster(ctx, cfg, nil)
+	if err != nil {
+		return err
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	return nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).Scale(ctx, ng, options.Wait)
+}
+
+// addNodeGroup adds the nodegroup named name to cfg, with the type of its stack, or as an unowned managed nodegroup
+// if it has no stack
+func addNodeGroup(ctx context.Context, stackManager manager.StackManager, provider api.ClusterProvider, cfg *api.ClusterConfig, name string) error {
+	nodeGroupType, err := stackManager.GetNodeGroupStackType(ctx, manager.GetNodegroupOption{
+		NodeGroupName: name,
+	})
+	if err != nil {
+		logger.Debug("failed to fetch nodegroup %q stack: %v", name, err)
+		if _, err := provider.EKS().DescribeNodegroup(ctx, &awseks.DescribeNodegroupInput{
+			ClusterName:   &cfg.Metadata.Name,
+			NodegroupName: &name,
+		}); err != nil {
+			return err
+		}
+		nodeGroupType = api.NodeGroupTypeUnowned
+	}
+
+	base := &api.NodeGroupBase{Name: name}
+	switch nodeGroupType {
+	case api.NodeGroupTypeUnmanaged:
+		cfg.NodeGroups = append(cfg.NodeGroups, &api.NodeGroup{NodeGroupBase: base})
+	case api.NodeGroupTypeManaged:
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, &api.ManagedNodeGroup{NodeGroupBase: base})
+	case api.NodeGroupTypeUnowned:
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, &api.ManagedNodeGroup{NodeGroupBase: base, Unowned: true})
+	}
+	return nil
+}
@@ -14,6 +14,8 @@ import (
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
 )
 
 const (
@@ -37,7 +39,8 @@ type ManifestFile struct {
 // GetManifestTemplate returns the resources for EKS Connector.
 func GetManifestTemplate() (ManifestTemplate, error) {
 	client := &http.Client{
-		Timeout: 45 * time.Second,
+		Transport: proxy.NewTransport(),
+		Timeout:   45 * time.Second,
 	}
 
 	connectorManifests, err := getResource(client, connectorManifestsURL)
@@ -2,6 +2,10 @@ package cmdutils
 
 import (
 	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
 )
 
 var addonFlagsIncompatibleWithoutConfigFile = []string{}
@@ -10,6 +14,8 @@ var addonFlagsIncompatibleWithConfigFile = []string{
 	"version",
 	"service-account-role-arn",
 	"attach-policy-arn",
+	"configuration-values",
+	"preserve",
 }
 
 func NewCreateOrUpgradeAddonLoader(cmd *Cmd) ClusterConfigLoader {
@@ -39,6 +45,33 @@ func NewCreateOrUpgradeAddonLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewUpdateAllAddonsLoader will load config or use flags for 'eksctl update addon --all'
+func NewUpdateAllAddonsLoader(cmd *Cmd, versionPolicy string, parallel int) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+	l.flagsIncompatibleWithConfigFile.Insert(addonFlagsIncompatibleWithConfigFile...)
+	l.flagsIncompatibleWithoutConfigFile.Insert(addonFlagsIncompatibleWithoutConfigFile...)
+	validate := func() error {
+		if !sets.NewString(addon.VersionPolicies()...).Has(versionPolicy) {
+			return fmt.Errorf("invalid value %q for --version-policy, must be one of %v", versionPolicy, addon.VersionPolicies())
+		}
+		if parallel < 1 {
+			return fmt.Errorf("--parallel must be at least 1")
+		}
+		return nil
+	}
+	l.validateWithConfigFile = validate
+	l.validateWithoutConfigFile = func() error {
+		if err := validateCluster(cmd); err != nil {
+			return err
+		}
+		if cmd.NameArg != "" || cmd.ClusterConfig.Addons[0].Name != "" || cmd.ClusterConfig.Addons[0].Version != "" {
+			return fmt.Errorf("--all cannot be used with an addon name or --version")
+		}
+		return validate()
+	}
+	return l
+}
+
 func NewDeleteAddonLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
 	l.flagsIncompatibleWithConfigFile.Insert(addonFlagsIncompatibleWithConfigFile...)
@@ -0,0 +1,88 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const autoModeResourceName = "name"
+
+// NewCreateAutoModeResourceLoader will load config for
+// 'eksctl create nodeclass' and 'eksctl create nodepool'
+func NewCreateAutoModeResourceLoader(cmd *Cmd, name *string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+	// the name flag selects a single resource from the config file
+	l.flagsIncompatibleWithConfigFile.Delete(autoModeResourceName)
+	l.validateWithConfigFile = func() error {
+		if err := validateAutoModeResourceNameFlagAndArg(cmd, name); err != nil {
+			return err
+		}
+		return validateAutoModeResources(cmd.ClusterConfig)
+	}
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+	return l
+}
+
+// NewGetAutoModeResourceLoader will load config or use flags for
+// 'eksctl get nodeclass' and 'eksctl get nodepool'
+func NewGetAutoModeResourceLoader(cmd *Cmd, name *string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+	l.flagsIncompatibleWithConfigFile.Delete(autoModeResourceName)
+	l.validateWithConfigFile = func() error {
+		return validateAutoModeResourceNameFlagAndArg(cmd, name)
+	}
+	l.validateWithoutConfigFile = func() error {
+		if err := validateCluster(cmd); err != nil {
+			return err
+		}
+		return validateAutoModeResourceNameFlagAndArg(cmd, name)
+	}
+	return l
+}
+
+// NewDeleteAutoModeResourceLoader will load config or use flags for
+// 'eksctl delete nodeclass' and 'eksctl delete nodepool'
+func NewDeleteAutoModeResourceLoader(cmd *Cmd, name *string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+	l.flagsIncompatibleWithConfigFile.Delete(autoModeResourceName)
+	l.validateWithConfigFile = func() error {
+		if err := validateAutoModeResourceNameFlagAndArg(cmd, name); err != nil {
+			return err
+		}
+		return validateAutoModeResources(cmd.ClusterConfig)
+	}
+	l.validateWithoutConfigFile = func() error {
+		if err := validateCluster(cmd); err != nil {
+			return err
+		}
+		if err := validateAutoModeResourceNameFlagAndArg(cmd, name); err != nil {
+			return err
+		}
+		if *name == "" {
+			return ErrMustBeSet(fmt.Sprintf("--%s", autoModeResourceName))
+		}
+		return nil
+	}
+	return l
+}
+
+func validateAutoModeResourceNameFlagAndArg(cmd *Cmd, name *string) error {
+	if *name != "" && cmd.NameArg != "" {
+		return ErrFlagAndArg(fmt.Sprintf("--%s", autoModeResourceName), *name, cmd.NameArg)
+	}
+	if cmd.NameArg != "" {
+		*name = cmd.NameArg
+	}
+	return nil
+}
+
+func validateAutoModeResources(cfg *api.ClusterConfig) error {
+	if !cfg.IsAutoModeEnabled() {
+		return errors.New("autoModeConfig.enabled must be true to manage NodeClasses and NodePools")
+	}
+	return api.ValidateAutoModeConfig(cfg)
+}
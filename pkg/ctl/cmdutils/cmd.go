@@ -6,10 +6,12 @@ import (
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/outposts"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
 )
 
 // Cmd holds attributes that are common between commands;
@@ -23,11 +25,21 @@ type Cmd struct {
 	NameArg string
 
 	ClusterConfigFile string
+	// ClusterConfigName selects a cluster of a config file holding several clusters
+	ClusterConfigName string
+	// ClusterConfigOverlays are the files merged into the config file
+	ClusterConfigOverlays []string
+	// ExpandEnv replaces the references to environment variables in the config file
+	ExpandEnv bool
+	// SetValues override fields of the config file, e.g. path.to.field=value
+	SetValues []string
 
 	ProviderConfig api.ProviderConfig
 	ClusterConfig  *api.ClusterConfig
 
 	Include, Exclude []string
+	// OwnedOnly restricts the command to the resources created by eksctl
+	OwnedOnly bool
 }
 
 // NewCtl performs common defaulting and validation and constructs a new
@@ -49,8 +61,22 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 	return ctl, nil
 }
 
+// LoadConfigOptions returns the options for loading ClusterConfigFile
+func (c *Cmd) LoadConfigOptions() eks.LoadConfigOptions {
+	return eks.LoadConfigOptions{
+		ClusterName: c.ClusterConfigName,
+		Overlays:    c.ClusterConfigOverlays,
+		ExpandEnv:   c.ExpandEnv,
+		SetValues:   c.SetValues,
+	}
+}
+
 // InitializeClusterConfig validates and initializes the ClusterConfig.
 func (c *Cmd) InitializeClusterConfig() error {
+	return exitcode.Wrap(exitcode.Validation, c.initializeClusterConfig())
+}
+
+func (c *Cmd) initializeClusterConfig() error {
 	api.SetClusterConfigDefaults(c.ClusterConfig)
 
 	if err := api.ValidateClusterConfig(c.ClusterConfig); err != nil {
@@ -129,7 +155,14 @@ func AddResourceCmd(flagGrouping *FlagGrouping, parentVerbCmd *cobra.Command, ne
 	}
 	c.FlagSetGroup = flagGrouping.New(c.CobraCommand)
 	newCmd(c)
+	c.FlagSetGroup.inFlagSetOf("config-file", func(fs *pflag.FlagSet) {
+		AddClusterConfigNameFlag(fs, &c.ClusterConfigName)
+		AddConfigOverlayFlag(fs, &c.ClusterConfigOverlays)
+		AddExpandEnvFlag(fs, &c.ExpandEnv)
+		AddSetValuesFlag(fs, &c.SetValues)
+	})
 	c.FlagSetGroup.AddTo(c.CobraCommand)
+	registerCompletions(c, parentVerbCmd.Name())
 	parentVerbCmd.AddCommand(c.CobraCommand)
 }
 
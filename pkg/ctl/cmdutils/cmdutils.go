@@ -3,6 +3,7 @@ package cmdutils
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+	utilsplan "github.com/weaveworks/eksctl/pkg/utils/plan"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -51,27 +53,17 @@ func AddPreRun(cmd *cobra.Command, newFn func(cmd *cobra.Command, args []string)
 
 // LogIntendedAction calls logger.Info with appropriate prefix
 func LogIntendedAction(plan bool, msgFmt string, args ...interface{}) {
-	prefix := "will "
-	if plan {
-		prefix = "(plan) would "
-	}
-	logger.Info(prefix+msgFmt, args...)
+	utilsplan.LogIntendedAction(plan, msgFmt, args...)
 }
 
 // LogCompletedAction calls logger.Success with appropriate prefix
 func LogCompletedAction(plan bool, msgFmt string, args ...interface{}) {
-	prefix := ""
-	if plan {
-		prefix = "(plan) would have "
-	}
-	logger.Success(prefix+msgFmt, args...)
+	utilsplan.LogCompletedAction(plan, msgFmt, args...)
 }
 
 // LogPlanModeWarning will log a message to inform user that they are in plan-mode
 func LogPlanModeWarning(plan bool) {
-	if plan {
-		logger.Warning("no changes were applied, run again with '--approve' to apply the changes")
-	}
+	utilsplan.LogPlanModeWarning(plan)
 }
 
 // LogRegionAndVersionInfo will log the selected region and build version
@@ -92,6 +84,11 @@ func AddApproveFlag(fs *pflag.FlagSet, cmd *Cmd) {
 	})
 }
 
+// AddOwnedOnlyFlag adds common `--owned-only` flag
+func AddOwnedOnlyFlag(fs *pflag.FlagSet, cmd *Cmd, resource string) {
+	fs.BoolVar(&cmd.OwnedOnly, "owned-only", false, fmt.Sprintf("Only operate on %s created by eksctl, leaving the others untouched", resource))
+}
+
 // GetNameArg tests to ensure there is only 1 name argument
 func GetNameArg(args []string) string {
 	if len(args) > 1 {
@@ -112,6 +109,12 @@ func AddCommonFlagsForAWS(cmd *Cmd, p *api.ProviderConfig, addCfnOptions bool) {
 			fs.StringVar(&p.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
 			fs.BoolVar(&p.CloudFormationDisableRollback, "cfn-disable-rollback", false, "for debugging: If a stack fails, do not roll it back. Be careful, this may lead to unintentional resource consumption!")
 		}
+		fs.StringVar(&p.Retry.Mode, "retry-mode", "", fmt.Sprintf("how AWS API calls are retried, one of %q, %q (defaults to the value of the AWS_RETRY_MODE environment variable, or %q)", api.AWSRetryModeStandard, api.AWSRetryModeAdaptive, api.AWSRetryModeStandard))
+		fs.IntVar(&p.Retry.MaxAttempts, "retry-max-attempts", 0, "maximum number of attempts of each AWS API call (defaults to the value of the AWS_MAX_ATTEMPTS environment variable, or 13)")
+		fs.StringVar(&p.Retry.BaseBackoff, "retry-base-backoff", "", "base of the exponential backoff of the retries of AWS API calls, the delay before the nth retry is a random duration up to base * 2^n (defaults to the value of the EKSCTL_RETRY_BASE_BACKOFF environment variable, or 1s)")
+		fs.StringVar(&p.RoleSession.Name, "role-session-name", "", "session name of the roles eksctl assumes, recorded in CloudTrail to attribute their calls")
+		fs.StringVar(&p.RoleSession.SourceIdentity, "source-identity", "", "source identity of the roles eksctl assumes, recorded in CloudTrail to attribute their calls, e.g. the name of the user or pipeline running eksctl")
+		fs.BoolVar(&p.UseFIPSEndpoints, "use-fips-endpoints", false, "use the FIPS endpoints of the AWS APIs, where available (defaults to the value of the AWS_USE_FIPS_ENDPOINT environment variable)")
 	})
 
 	AddPreRun(cmd.CobraCommand, func(c *cobra.Command, args []string) {
@@ -123,6 +126,11 @@ func AddCommonFlagsForAWS(cmd *Cmd, p *api.ProviderConfig, addCfnOptions bool) {
 				}
 			}
 		}
+		if !c.Flag("use-fips-endpoints").Changed {
+			if val, ok := os.LookupEnv("AWS_USE_FIPS_ENDPOINT"); ok {
+				p.UseFIPSEndpoints, _ = strconv.ParseBool(val)
+			}
+		}
 	})
 }
 
@@ -189,6 +197,21 @@ func AddUpdateAuthConfigMap(fs *pflag.FlagSet, updateAuthConfigMap *bool, descri
 	fs.BoolVar(updateAuthConfigMap, "update-auth-configmap", true, description)
 }
 
+// AddEstimateCostFlag adds common --estimate-cost flag
+func AddEstimateCostFlag(fs *pflag.FlagSet, estimateCost *bool, resource string) {
+	fs.BoolVar(estimateCost, "estimate-cost", false, fmt.Sprintf("print the estimated monthly cost of the %s with on-demand prices, and exit without creating anything", resource))
+}
+
+// AddSpotPlacementFlag adds common --spot-placement flag
+func AddSpotPlacementFlag(fs *pflag.FlagSet, spotPlacement *string) {
+	fs.StringVar(spotPlacement, "spot-placement", "recommend", "what to do with the Spot placement scores of the availability zones of Spot nodegroups: log them and recommend the best zones, limit the nodegroups without availability zones or subnets to the best zones, or skip them (valid options: recommend, auto, skip)")
+}
+
+// AddQuotaCheckFlag adds common --quota-check flag
+func AddQuotaCheckFlag(fs *pflag.FlagSet, quotaCheck *string) {
+	fs.StringVar(quotaCheck, "quota-check", "fail", "what to do when the EC2 vCPU quotas do not allow the instances of the nodegroups to be launched (valid options: fail, warn, skip)")
+}
+
 // AddSubnetIDs adds common --subnet-ids flag
 func AddSubnetIDs(fs *pflag.FlagSet, subnetIDs *[]string, description string) {
 	fs.StringSliceVar(subnetIDs, "subnet-ids", nil, description)
@@ -202,10 +225,20 @@ func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath, authenticatorRol
 	fs.BoolVar(autoPath, "auto-kubeconfig", false, fmt.Sprintf("save kubeconfig file by cluster name, e.g. %q", kubeconfig.AutoPath(exampleName)))
 }
 
+// AddKubeconfigContextNameFlag adds common --kubeconfig-context-name flag
+func AddKubeconfigContextNameFlag(fs *pflag.FlagSet, contextName *string) {
+	fs.StringVar(contextName, "kubeconfig-context-name", "", "name of the context written to kubeconfig, with the placeholders {cluster}, {region}, {account} and {user}, e.g. {cluster}-{region} (default {user}@{cluster}.{region}.eksctl.io)")
+}
+
 // AddCommonFlagsForGetCmd adds common flafs for get commands
 func AddCommonFlagsForGetCmd(fs *pflag.FlagSet, chunkSize *int, outputMode *printers.Type) {
 	fs.IntVar(chunkSize, "chunk-size", 100, "return large lists in chunks rather than all at once, pass 0 to disable")
-	fs.StringVarP(outputMode, "output", "o", "table", "specifies the output format (valid option: table, json, yaml)")
+	AddOutputFlag(fs, outputMode)
+}
+
+// AddOutputFlag adds the output flag of get commands, for those not listing in chunks
+func AddOutputFlag(fs *pflag.FlagSet, outputMode *printers.Type) {
+	fs.StringVarP(outputMode, "output", "o", "table", "specifies the output format (valid option: table, json, yaml, jsonpath=<expression>, go-template=<template>)")
 }
 
 // AddStringToStringVarPFlag is a wrapper that prefixes the description of the flag for consistency
@@ -0,0 +1,248 @@
+package cmdutils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/spf13/cobra"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
+)
+
+const (
+	// completionTimeout bounds the time spent querying AWS to complete a flag, shells do not show anything until then
+	completionTimeout = 5 * time.Second
+	// completionCacheTTL is how long the names of the resources are cached, not to query AWS on every tab
+	completionCacheTTL = time.Minute
+)
+
+// completionClients are the clients of the AWS services the names of the resources are listed from
+type completionClients struct {
+	eks awsapi.EKS
+	ec2 awsapi.EC2
+}
+
+// lister lists the names of a kind of resources, those of a cluster need clusterName to be set
+type lister func(ctx context.Context, clients completionClients, clusterName string) ([]string, error)
+
+// registerCompletions completes the cluster, nodegroup and addon names and the regions of the flags and
+// arguments of a command with those of the account, except for the names of the resources it creates
+func registerCompletions(c *Cmd, verb string) {
+	cmd := c.CobraCommand
+	register := func(flag, kind string, list lister) {
+		if cmd.Flags().Lookup(flag) == nil {
+			return
+		}
+		_ = cmd.RegisterFlagCompletionFunc(flag, func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+			return c.complete(kind, list)
+		})
+	}
+	register("region", "regions", listRegions)
+	register("cluster", "clusters", listClusters)
+	register("nodegroup", "nodegroups", listNodeGroups)
+
+	if verb == "create" {
+		return
+	}
+	var list lister
+	switch cmd.Name() {
+	case "cluster":
+		list = listClusters
+	case "nodegroup":
+		list = listNodeGroups
+	case "addon":
+		list = listAddons
+	default:
+		return
+	}
+	kind := cmd.Name() + "s"
+	register("name", kind, list)
+	if cmd.ValidArgsFunction == nil {
+		cmd.ValidArgsFunction = func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return c.complete(kind, list)
+		}
+	}
+}
+
+// complete returns the names of a kind of resources in the region and of the cluster set with the flags, from
+// the cache when they were listed less than completionCacheTTL ago
+func (c *Cmd) complete(kind string, list lister) ([]string, cobra.ShellCompDirective) {
+	var clusterName string
+	if kind != "regions" && kind != "clusters" {
+		if c.ClusterConfig != nil {
+			clusterName = c.ClusterConfig.Metadata.Name
+		}
+		if clusterName == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	options := []func(*config.LoadOptions) error{
+		config.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions(func(t *http.Transport) {
+			t.Proxy = proxy.FromEnvironment
+		})),
+	}
+	if c.ProviderConfig.Region != "" {
+		options = append(options, config.WithRegion(c.ProviderConfig.Region))
+	}
+	if c.ProviderConfig.Profile.Name != "" && !c.ProviderConfig.Profile.SourceIsEnvVar {
+		options = append(options, config.WithSharedConfigProfile(c.ProviderConfig.Profile.Name))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if cfg.Region == "" {
+		cfg.Region = api.DefaultRegion
+	}
+
+	key := strings.Join([]string{kind, c.ProviderConfig.Profile.Name, cfg.Region, clusterName}, "/")
+	cache := newCompletionCache()
+	if names, ok := cache.get(key); ok {
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+	names, err := list(ctx, completionClients{eks: eks.NewFromConfig(cfg), ec2: ec2.NewFromConfig(cfg)}, clusterName)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	cache.set(key, names)
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+func listRegions(ctx context.Context, clients completionClients, _ string) ([]string, error) {
+	output, err := clients.ec2.DescribeRegions(ctx, &ec2.DescribeRegionsInput{})
+	if err != nil {
+		// the supported regions are a good enough guess when the enabled ones cannot be listed
+		return api.SupportedRegions(), nil
+	}
+	var regions []string
+	for _, region := range output.Regions {
+		regions = append(regions, aws.ToString(region.RegionName))
+	}
+	sort.Strings(regions)
+	return regions, nil
+}
+
+func listClusters(ctx context.Context, clients completionClients, _ string) ([]string, error) {
+	var clusters []string
+	paginator := eks.NewListClustersPaginator(clients.eks, &eks.ListClustersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, output.Clusters...)
+	}
+	return clusters, nil
+}
+
+func listNodeGroups(ctx context.Context, clients completionClients, clusterName string) ([]string, error) {
+	var nodeGroups []string
+	paginator := eks.NewListNodegroupsPaginator(clients.eks, &eks.ListNodegroupsInput{
+		ClusterName: aws.String(clusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroups = append(nodeGroups, output.Nodegroups...)
+	}
+	return nodeGroups, nil
+}
+
+func listAddons(ctx context.Context, clients completionClients, clusterName string) ([]string, error) {
+	var addons []string
+	paginator := eks.NewListAddonsPaginator(clients.eks, &eks.ListAddonsInput{
+		ClusterName: aws.String(clusterName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		addons = append(addons, output.Addons...)
+	}
+	return addons, nil
+}
+
+// completionCache caches the names of the resources listed to complete flags in a file, as every completion
+// runs eksctl anew
+type completionCache struct {
+	path string
+	now  func() time.Time
+}
+
+type completionCacheEntry struct {
+	Expires time.Time
+	Names   []string
+}
+
+func newCompletionCache() *completionCache {
+	cache := &completionCache{now: time.Now}
+	if home, err := os.UserHomeDir(); err == nil {
+		cache.path = filepath.Join(home, ".eksctl", "cache", "completion.json")
+	}
+	return cache
+}
+
+func (c *completionCache) load() map[string]completionCacheEntry {
+	entries := map[string]completionCacheEntry{}
+	if data, err := os.ReadFile(c.path); err == nil {
+		_ = json.Unmarshal(data, &entries)
+	}
+	return entries
+}
+
+func (c *completionCache) get(key string) ([]string, bool) {
+	if c.path == "" {
+		return nil, false
+	}
+	entry, ok := c.load()[key]
+	if !ok || c.now().After(entry.Expires) {
+		return nil, false
+	}
+	return entry.Names, true
+}
+
+// set caches names, failing to write the cache only makes the next completion slower
+func (c *completionCache) set(key string, names []string) {
+	if c.path == "" {
+		return
+	}
+	entries := c.load()
+	now := c.now()
+	for k, entry := range entries {
+		if now.After(entry.Expires) {
+			delete(entries, k)
+		}
+	}
+	entries[key] = completionCacheEntry{Expires: now.Add(completionCacheTTL), Names: names}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return
+	}
+	_ = os.WriteFile(c.path, data, 0600)
+}
@@ -0,0 +1,120 @@
+package cmdutils
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks/mocksv2"
+)
+
+var _ = Describe("completion", func() {
+	Context("listers", func() {
+		var (
+			eksAPI  *mocksv2.EKS
+			ec2API  *mocksv2.EC2
+			clients completionClients
+		)
+
+		BeforeEach(func() {
+			eksAPI = &mocksv2.EKS{}
+			ec2API = &mocksv2.EC2{}
+			clients = completionClients{eks: eksAPI, ec2: ec2API}
+		})
+
+		It("lists the clusters", func() {
+			eksAPI.On("ListClusters", mock.Anything, mock.Anything, mock.Anything).Return(&eks.ListClustersOutput{
+				Clusters: []string{"dev", "prod"},
+			}, nil)
+			Expect(listClusters(context.Background(), clients, "")).To(Equal([]string{"dev", "prod"}))
+		})
+
+		It("lists the nodegroups and addons of a cluster", func() {
+			eksAPI.On("ListNodegroups", mock.Anything, &eks.ListNodegroupsInput{
+				ClusterName: aws.String("dev"),
+			}, mock.Anything).Return(&eks.ListNodegroupsOutput{Nodegroups: []string{"ng-1"}}, nil)
+			eksAPI.On("ListAddons", mock.Anything, &eks.ListAddonsInput{
+				ClusterName: aws.String("dev"),
+			}, mock.Anything).Return(&eks.ListAddonsOutput{Addons: []string{"coredns", "vpc-cni"}}, nil)
+
+			Expect(listNodeGroups(context.Background(), clients, "dev")).To(Equal([]string{"ng-1"}))
+			Expect(listAddons(context.Background(), clients, "dev")).To(Equal([]string{"coredns", "vpc-cni"}))
+		})
+
+		It("lists the enabled regions, or the supported ones when they cannot be listed", func() {
+			ec2API.On("DescribeRegions", mock.Anything, mock.Anything).Return(&ec2.DescribeRegionsOutput{
+				Regions: []ec2types.Region{{RegionName: aws.String("us-west-2")}, {RegionName: aws.String("eu-west-1")}},
+			}, nil).Once()
+			Expect(listRegions(context.Background(), clients, "")).To(Equal([]string{"eu-west-1", "us-west-2"}))
+
+			ec2API.On("DescribeRegions", mock.Anything, mock.Anything).Return(nil, errors.New("denied"))
+			Expect(listRegions(context.Background(), clients, "")).To(Equal(api.SupportedRegions()))
+		})
+	})
+
+	It("caches the names until they expire", func() {
+		now := time.Now()
+		cache := &completionCache{
+			path: filepath.Join(GinkgoT().TempDir(), "cache", "completion.json"),
+			now:  func() time.Time { return now },
+		}
+		_, ok := cache.get("clusters")
+		Expect(ok).To(BeFalse())
+
+		cache.set("clusters", []string{"dev"})
+		names, ok := cache.get("clusters")
+		Expect(ok).To(BeTrue())
+		Expect(names).To(Equal([]string{"dev"}))
+
+		now = now.Add(completionCacheTTL + time.Second)
+		_, ok = cache.get("clusters")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("completes the arguments of the commands of existing resources only", func() {
+		newCmd := func(resource string) *Cmd {
+			c := &Cmd{
+				CobraCommand:  &cobra.Command{Use: resource},
+				ClusterConfig: api.NewClusterConfig(),
+			}
+			c.CobraCommand.Flags().AddFlagSet(func() *pflag.FlagSet {
+				fs := &pflag.FlagSet{}
+				AddClusterFlag(fs, c.ClusterConfig.Metadata)
+				fs.String("name", "", "")
+				return fs
+			}())
+			return c
+		}
+
+		c := newCmd("nodegroup")
+		registerCompletions(c, "delete")
+		Expect(c.CobraCommand.ValidArgsFunction).NotTo(BeNil())
+
+		c = newCmd("nodegroup")
+		registerCompletions(c, "create")
+		Expect(c.CobraCommand.ValidArgsFunction).To(BeNil())
+
+		c = newCmd("iamserviceaccount")
+		registerCompletions(c, "get")
+		Expect(c.CobraCommand.ValidArgsFunction).To(BeNil())
+
+		By("not completing the nodegroups of an unknown cluster")
+		c = newCmd("nodegroup")
+		names, directive := c.complete("nodegroups", listNodeGroups)
+		Expect(names).To(BeEmpty())
+		Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+	})
+})
@@ -15,8 +15,10 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/actions/irsa"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/audit"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
 	"github.com/weaveworks/eksctl/pkg/utils/names"
 	utilstrings "github.com/weaveworks/eksctl/pkg/utils/strings"
 )
@@ -26,6 +28,30 @@ func AddConfigFileFlag(fs *pflag.FlagSet, path *string) {
 	fs.StringVarP(path, "config-file", "f", "", "load configuration from a file (or stdin if set to '-')")
 }
 
+// AddClusterConfigNameFlag adds a flag to select one of the clusters of a config file holding several clusters,
+// it is added to every command with a config file flag
+func AddClusterConfigNameFlag(fs *pflag.FlagSet, name *string) {
+	fs.StringVar(name, "cluster-name", "", "name of the cluster to load from a config file holding several clusters")
+}
+
+// AddConfigOverlayFlag adds a flag for the files merged into the config file, e.g. per-environment settings,
+// it is added to every command with a config file flag
+func AddConfigOverlayFlag(fs *pflag.FlagSet, overlays *[]string) {
+	fs.StringSliceVar(overlays, "overlay", nil, "files merged in order into the config file, e.g. with the settings of an environment")
+}
+
+// AddExpandEnvFlag adds a flag to replace the references to environment variables in the config file,
+// it is added to every command with a config file flag
+func AddExpandEnvFlag(fs *pflag.FlagSet, expandEnv *bool) {
+	fs.BoolVar(expandEnv, "expand-env", false, "replace ${VAR}, ${VAR:-default} and ${VAR:?message} in the config file and overlays with environment variables, $$ escapes a $")
+}
+
+// AddSetValuesFlag adds a flag to override fields of the config file,
+// it is added to every command with a config file flag
+func AddSetValuesFlag(fs *pflag.FlagSet, values *[]string) {
+	fs.StringArrayVar(values, "set", nil, "set a field of the config file after the overlays are merged, e.g. managedNodeGroups[ng-1].desiredCapacity=3 (can be repeated)")
+}
+
 // ClusterConfigLoader is an interface that loaders should implement
 type ClusterConfigLoader interface {
 	Load() error
@@ -53,6 +79,10 @@ var (
 		"include",
 		"exclude",
 		"only-missing",
+		"cluster-name",
+		"overlay",
+		"expand-env",
+		"set",
 	}
 
 	commonCreateFlagsIncompatibleWithDryRun = []string{
@@ -90,6 +120,19 @@ var (
 		"instance-name",
 		"instance-prefix",
 	}
+
+	clusterFlagsIncompatibleWithConfigFile = []string{
+		"tags",
+		"zones",
+		"fargate",
+		"enable-auto-mode",
+		"vpc-private-subnets",
+		"vpc-public-subnets",
+		"vpc-cidr",
+		"vpc-nat-mode",
+		"vpc-from-kops-cluster",
+		"service-cidr",
+	}
 )
 
 func newCommonClusterConfigLoader(cmd *Cmd) *commonClusterConfigLoader {
@@ -105,12 +148,27 @@ func newCommonClusterConfigLoader(cmd *Cmd) *commonClusterConfigLoader {
 	}
 }
 
-// Load ClusterConfig or use flags
+// Load ClusterConfig or use flags, errors are annotated with the exit code of a validation failure
 func (l *commonClusterConfigLoader) Load() error {
+	if err := l.load(); err != nil {
+		return exitcode.Wrap(exitcode.Validation, err)
+	}
+	audit.SetClusterConfig(l.ClusterConfig)
+	return nil
+}
+
+func (l *commonClusterConfigLoader) load() error {
 	if err := api.Register(); err != nil {
 		return err
 	}
 
+	if err := api.ValidateAWSRetryConfig(&l.ProviderConfig.Retry); err != nil {
+		return err
+	}
+	if err := api.ValidateAWSRoleSessionConfig(&l.ProviderConfig.RoleSession); err != nil {
+		return err
+	}
+
 	if l.ClusterConfigFile == "" {
 		if flagName, found := findChangedFlag(l.CobraCommand, l.flagsIncompatibleWithoutConfigFile.List()); found {
 			return errors.Errorf("cannot use --%s unless a config file is specified via --config-file/-f", flagName)
@@ -123,7 +181,7 @@ func (l *commonClusterConfigLoader) Load() error {
 	// The reference to ClusterConfig should only be reassigned if ClusterConfigFile is specified
 	// because other parts of the code store the pointer locally and access it directly instead of via
 	// the Cmd reference
-	if l.ClusterConfig, err = eks.LoadConfigFromFile(l.ClusterConfigFile); err != nil {
+	if l.ClusterConfig, err = eks.LoadClusterConfigFromFile(l.ClusterConfigFile, l.LoadConfigOptions()); err != nil {
 		return err
 	}
 	meta := l.ClusterConfig.Metadata
@@ -149,9 +207,57 @@ func (l *commonClusterConfigLoader) Load() error {
 	}
 	l.ProviderConfig.Region = meta.Region
 
+	if awsClient := l.ClusterConfig.AWSClient; awsClient != nil && awsClient.Retry != nil {
+		if err := api.ValidateAWSRetryConfig(awsClient.Retry); err != nil {
+			return fmt.Errorf("awsClient.retry: %w", err)
+		}
+		setRetryDefaults(&l.ProviderConfig.Retry, awsClient.Retry)
+	}
+	if awsClient := l.ClusterConfig.AWSClient; awsClient != nil && awsClient.Endpoints != nil {
+		l.ProviderConfig.Endpoints = *awsClient.Endpoints
+	}
+	if awsClient := l.ClusterConfig.AWSClient; awsClient != nil {
+		if awsClient.Profile != "" && (l.ProviderConfig.Profile.Name == "" || l.ProviderConfig.Profile.SourceIsEnvVar) {
+			l.ProviderConfig.Profile = api.Profile{
+				Name: awsClient.Profile,
+			}
+		}
+		l.ProviderConfig.AssumeRoles = awsClient.AssumeRoles
+		if awsClient.RoleSession != nil {
+			if err := api.ValidateAWSRoleSessionConfig(awsClient.RoleSession); err != nil {
+				return fmt.Errorf("awsClient.roleSession: %w", err)
+			}
+			setRoleSessionDefaults(&l.ProviderConfig.RoleSession, awsClient.RoleSession)
+		}
+	}
+
 	return l.validateWithConfigFile()
 }
 
+// setRetryDefaults sets the retry options that were not set with flags to their values in the config file
+func setRetryDefaults(retry *api.AWSRetryConfig, fromConfig *api.AWSRetryConfig) {
+	if retry.Mode == "" {
+		retry.Mode = fromConfig.Mode
+	}
+	if retry.MaxAttempts == 0 {
+		retry.MaxAttempts = fromConfig.MaxAttempts
+	}
+	if retry.BaseBackoff == "" {
+		retry.BaseBackoff = fromConfig.BaseBackoff
+	}
+}
+
+// setRoleSessionDefaults sets the role session options that were not set with flags to their values in the config file
+func setRoleSessionDefaults(roleSession *api.AWSRoleSessionConfig, fromConfig *api.AWSRoleSessionConfig) {
+	if roleSession.Name == "" {
+		roleSession.Name = fromConfig.Name
+	}
+	if roleSession.SourceIdentity == "" {
+		roleSession.SourceIdentity = fromConfig.SourceIdentity
+	}
+	roleSession.Tags = fromConfig.Tags
+}
+
 func findChangedFlag(cmd *cobra.Command, flagNames []string) (string, bool) {
 	for _, f := range flagNames {
 		if flag := cmd.Flag(f); flag != nil && flag.Changed {
@@ -194,23 +300,28 @@ func NewMetadataLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// ValidateInteractiveOptions returns an error if a flag of 'eksctl create cluster' that cannot be used with a config
+// file is set with --interactive, as the config file the wizard generates is used to create the cluster
+func ValidateInteractiveOptions(cmd *Cmd) error {
+	if cmd.ClusterConfigFile != "" {
+		return errors.New("cannot use --interactive when --config-file/-f is set")
+	}
+	if cmd.NameArg != "" {
+		return errors.New("cannot use --interactive with a name argument, the wizard asks for the name")
+	}
+	flags := append(append(append([]string{}, defaultFlagsIncompatibleWithConfigFile...), clusterFlagsIncompatibleWithConfigFile...), commonNGFlagsIncompatibleWithConfigFile...)
+	if flagName, found := findChangedFlag(cmd.CobraCommand, flags); found {
+		return fmt.Errorf("cannot use --%s with --interactive, the wizard asks for the settings of the cluster", flagName)
+	}
+	return nil
+}
+
 // NewCreateClusterLoader will load config or use flags for 'eksctl create cluster'
 func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.NodeGroup, params *CreateClusterCmdParams) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
 
 	ngFilter.SetExcludeAll(params.WithoutNodeGroup)
 
-	clusterFlagsIncompatibleWithConfigFile := []string{
-		"tags",
-		"zones",
-		"fargate",
-		"vpc-private-subnets",
-		"vpc-public-subnets",
-		"vpc-cidr",
-		"vpc-nat-mode",
-		"vpc-from-kops-cluster",
-	}
-
 	l.flagsIncompatibleWithConfigFile.Insert(append(clusterFlagsIncompatibleWithConfigFile, commonNGFlagsIncompatibleWithConfigFile...)...)
 
 	l.flagsIncompatibleWithoutConfigFile.Insert("install-vpc-controllers")
@@ -288,6 +399,16 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 			return errors.New("vpc.subnets and availabilityZones cannot be set at the same time")
 		}
 
+		if len(clusterConfig.VPC.ControlPlaneSubnetIDs) > 0 || len(clusterConfig.VPC.ControlPlaneSecurityGroupIDs) > 0 {
+			return errors.New("vpc.controlPlaneSubnetIDs and vpc.controlPlaneSecurityGroupIDs are not supported when creating a cluster; " +
+				"use `eksctl utils update-cluster-vpc-config` to change them on an existing cluster")
+		}
+
+		if endpoints := clusterConfig.VPC.ClusterEndpoints; endpoints != nil && (len(endpoints.AddPublicAccessCIDRs) > 0 || len(endpoints.RemovePublicAccessCIDRs) > 0) {
+			return errors.New("vpc.clusterEndpoints.addPublicAccessCIDRs and vpc.clusterEndpoints.removePublicAccessCIDRs are not supported when creating a cluster; " +
+				"use vpc.publicAccessCIDRs instead")
+		}
+
 		if clusterConfig.GitOps != nil {
 			fluxCfg := clusterConfig.GitOps.Flux
 
@@ -346,6 +467,18 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 			}
 		}
 
+		if params.AutoMode {
+			l.ClusterConfig.AutoModeConfig = &api.AutoModeConfig{
+				Enabled: api.Enabled(),
+			}
+			// Auto Mode provides the compute for the cluster, so no initial nodegroup is created unless the
+			// `managed` flag was explicitly provided.
+			if !l.CobraCommand.Flag("managed").Changed {
+				l.ClusterConfig.ManagedNodeGroups = nil
+				l.ClusterConfig.NodeGroups = nil
+			}
+		}
+
 		for _, ng := range l.ClusterConfig.NodeGroups {
 			// generate nodegroup name or use flag
 			ng.Name = names.ForNodeGroup(ng.Name, "")
@@ -608,26 +741,38 @@ func NewDeleteAndDrainNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *fil
 }
 
 // NewUtilsEnableLoggingLoader will load config or use flags for 'eksctl utils update-cluster-logging'
-func NewUtilsEnableLoggingLoader(cmd *Cmd) ClusterConfigLoader {
+func NewUtilsEnableLoggingLoader(cmd *Cmd, logRetentionInDays int, logGroupKMSKeyID string) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
 
 	l.flagsIncompatibleWithConfigFile.Insert(
 		"enable-types",
 		"disable-types",
+		"log-retention-days",
+		"log-group-kms-key-arn",
 	)
 
-	l.validateWithoutConfigFile = l.validateMetadataWithoutConfigFile
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		l.ClusterConfig.CloudWatch.ClusterLogging.LogRetentionInDays = logRetentionInDays
+		l.ClusterConfig.CloudWatch.ClusterLogging.LogGroupKMSKeyID = logGroupKMSKeyID
+		return nil
+	}
 
 	return l
 }
 
 // NewUtilsEnableEndpointAccessLoader will load config or use flags for 'eksctl utils update-cluster-endpoints'.
-func NewUtilsEnableEndpointAccessLoader(cmd *Cmd, privateAccess, publicAccess bool) ClusterConfigLoader {
+func NewUtilsEnableEndpointAccessLoader(cmd *Cmd, privateAccess, publicAccess bool, addPublicAccessCIDRs, removePublicAccessCIDRs []string) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
 
 	l.flagsIncompatibleWithConfigFile.Insert(
 		"private-access",
 		"public-access",
+		"public-access-cidrs",
+		"add-public-access-cidrs",
+		"remove-public-access-cidrs",
 	)
 	l.validateWithoutConfigFile = func() error {
 		if err := l.validateMetadataWithoutConfigFile(); err != nil {
@@ -648,6 +793,8 @@ func NewUtilsEnableEndpointAccessLoader(cmd *Cmd, privateAccess, publicAccess bo
 		} else {
 			cmd.ClusterConfig.VPC.ClusterEndpoints.PublicAccess = nil
 		}
+		cmd.ClusterConfig.VPC.ClusterEndpoints.AddPublicAccessCIDRs = addPublicAccessCIDRs
+		cmd.ClusterConfig.VPC.ClusterEndpoints.RemovePublicAccessCIDRs = removePublicAccessCIDRs
 
 		return nil
 	}
@@ -662,6 +809,292 @@ func NewUtilsEnableEndpointAccessLoader(cmd *Cmd, privateAccess, publicAccess bo
 	return l
 }
 
+// NewUtilsUpdateVPCEndpointsLoader will load config or use flags for 'eksctl utils update-vpc-endpoints'
+func NewUtilsUpdateVPCEndpointsLoader(cmd *Cmd, additionalEndpointServices []string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("additional-endpoint-services")
+
+	validateEndpointServices := func() error {
+		if err := api.ValidateAdditionalEndpointServices(l.ClusterConfig.PrivateCluster.AdditionalEndpointServices); err != nil {
+			return fmt.Errorf("invalid value in privateCluster.additionalEndpointServices: %w", err)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		l.ClusterConfig.PrivateCluster.AdditionalEndpointServices = additionalEndpointServices
+		return validateEndpointServices()
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.PrivateCluster == nil {
+			l.ClusterConfig.PrivateCluster = &api.PrivateCluster{}
+		}
+		if l.ClusterConfig.PrivateCluster.SkipEndpointCreation {
+			return errors.New("privateCluster.skipEndpointCreation cannot be set when updating VPC endpoints")
+		}
+		return validateEndpointServices()
+	}
+
+	return l
+}
+
+// NewUtilsUpdateClusterVPCConfigLoader will load config or use flags for 'eksctl utils update-cluster-vpc-config'
+func NewUtilsUpdateClusterVPCConfigLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"control-plane-subnet-ids",
+		"control-plane-security-group-ids",
+	)
+
+	validateVPCConfig := func() error {
+		vpc := l.ClusterConfig.VPC
+		if len(vpc.ControlPlaneSubnetIDs) == 0 && len(vpc.ControlPlaneSecurityGroupIDs) == 0 {
+			return errors.New("at least one of vpc.controlPlaneSubnetIDs or vpc.controlPlaneSecurityGroupIDs must be set")
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if err := validateVPCConfig(); err != nil {
+			return fmt.Errorf("at least one of --control-plane-subnet-ids or --control-plane-security-group-ids must be set")
+		}
+		return nil
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.VPC == nil {
+			l.ClusterConfig.VPC = api.NewClusterVPC(false)
+		}
+		return validateVPCConfig()
+	}
+
+	return l
+}
+
+// NewUtilsUpdateControlPlaneSecurityGroupIngressLoader will load config or use flags for
+// 'eksctl utils update-control-plane-security-group-ingress'
+func NewUtilsUpdateControlPlaneSecurityGroupIngressLoader(cmd *Cmd, ingressCIDRs []string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("ingress-cidrs")
+
+	validateIngressRules := func() error {
+		api.SetControlPlaneSecurityGroupIngressDefaults(l.ClusterConfig.VPC.ControlPlaneSecurityGroupIngress)
+		return api.ValidateControlPlaneSecurityGroupIngress(l.ClusterConfig.VPC.ControlPlaneSecurityGroupIngress)
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if flag := l.CobraCommand.Flag("ingress-cidrs"); flag == nil || !flag.Changed {
+			return errors.New("--ingress-cidrs must be set; pass an empty value to remove all ingress rules")
+		}
+		rules := []api.ControlPlaneSecurityGroupIngressRule{}
+		for _, cidr := range ingressCIDRs {
+			rules = append(rules, api.ControlPlaneSecurityGroupIngressRule{CIDR: cidr})
+		}
+		l.ClusterConfig.VPC.ControlPlaneSecurityGroupIngress = rules
+		return validateIngressRules()
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.VPC == nil {
+			l.ClusterConfig.VPC = api.NewClusterVPC(false)
+		}
+		if l.ClusterConfig.VPC.SecurityGroup != "" {
+			return errors.New("vpc.securityGroup cannot be set when updating the control plane security group ingress rules")
+		}
+		return validateIngressRules()
+	}
+
+	return l
+}
+
+// NewUtilsUpdateZonalShiftConfigLoader will load config or use flags for 'eksctl utils update-zonal-shift-config'
+func NewUtilsUpdateZonalShiftConfigLoader(cmd *Cmd, enabled bool) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("enable-zonal-shift")
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if flag := l.CobraCommand.Flag("enable-zonal-shift"); flag == nil || !flag.Changed {
+			return errors.New("--enable-zonal-shift must be set")
+		}
+		l.ClusterConfig.ZonalShiftConfig = &api.ZonalShiftConfig{
+			Enabled: &enabled,
+		}
+		return nil
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.ZonalShiftConfig == nil || l.ClusterConfig.ZonalShiftConfig.Enabled == nil {
+			return errors.New("zonalShiftConfig.enabled must be set")
+		}
+		return nil
+	}
+
+	return l
+}
+
+// NewUtilsSetDeletionProtectionLoader will load config or use flags for 'eksctl utils set-deletion-protection'
+func NewUtilsSetDeletionProtectionLoader(cmd *Cmd, enabled bool) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("deletion-protection")
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if flag := l.CobraCommand.Flag("deletion-protection"); flag == nil || !flag.Changed {
+			return errors.New("--deletion-protection must be set")
+		}
+		l.ClusterConfig.Metadata.DeletionProtection = &enabled
+		return nil
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.Metadata.DeletionProtection == nil {
+			return errors.New("metadata.deletionProtection must be set")
+		}
+		return nil
+	}
+
+	return l
+}
+
+// NewUtilsUpdateTagsLoader will load config or use flags for 'eksctl utils update-tags'
+func NewUtilsUpdateTagsLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("tags")
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if len(l.ClusterConfig.Metadata.Tags) == 0 {
+			return errors.New("--tags must be set")
+		}
+		return nil
+	}
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.Metadata.Tags) == 0 {
+			return errors.New("metadata.tags must be set")
+		}
+		return nil
+	}
+
+	return l
+}
+
+// NewUtilsRollNodeGroupLoader will load config or use flags for 'eksctl utils roll-nodegroup'
+func NewUtilsRollNodeGroupLoader(cmd *Cmd, nodeGroupName string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	validateNodeGroupName := func() error {
+		if nodeGroupName == "" {
+			return ErrMustBeSet("--name")
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		return validateNodeGroupName()
+	}
+
+	l.validateWithConfigFile = validateNodeGroupName
+
+	return l
+}
+
+// NewUtilsUpdateSupportTypeLoader will load config or use flags for 'eksctl utils update-support-type'
+func NewUtilsUpdateSupportTypeLoader(cmd *Cmd, supportType string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("support-type")
+
+	validateSupportType := func() error {
+		switch l.ClusterConfig.UpgradePolicy.SupportType {
+		case api.SupportTypeStandard, api.SupportTypeExtended:
+			return nil
+		default:
+			return fmt.Errorf("invalid support type %q, must be one of %s or %s", l.ClusterConfig.UpgradePolicy.SupportType, api.SupportTypeStandard, api.SupportTypeExtended)
+		}
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if supportType == "" {
+			return errors.New("--support-type must be set")
+		}
+		l.ClusterConfig.UpgradePolicy = &api.UpgradePolicy{
+			SupportType: api.SupportType(strings.ToUpper(supportType)),
+		}
+		return validateSupportType()
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.UpgradePolicy == nil || l.ClusterConfig.UpgradePolicy.SupportType == "" {
+			return errors.New("upgradePolicy.supportType must be set")
+		}
+		return validateSupportType()
+	}
+
+	return l
+}
+
+// NewUtilsAssociatePrivateHostedZonesLoader will load config or use flags for 'eksctl utils associate-private-hosted-zones'
+func NewUtilsAssociatePrivateHostedZonesLoader(cmd *Cmd, hostedZoneIDs []string, authorizationRoleARN string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("hosted-zone-ids", "authorization-role-arn")
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if len(hostedZoneIDs) == 0 {
+			return errors.New("--hosted-zone-ids must be set")
+		}
+		for _, id := range hostedZoneIDs {
+			l.ClusterConfig.VPC.PrivateHostedZoneAssociations = append(l.ClusterConfig.VPC.PrivateHostedZoneAssociations, api.PrivateHostedZoneAssociation{
+				HostedZoneID:         id,
+				AuthorizationRoleARN: authorizationRoleARN,
+			})
+		}
+		return nil
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.VPC == nil || len(l.ClusterConfig.VPC.PrivateHostedZoneAssociations) == 0 {
+			return errors.New("vpc.privateHostedZoneAssociations must be set")
+		}
+		return nil
+	}
+
+	return l
+}
+
 // NewUtilsAssociateIAMOIDCProviderLoader will load config or use flags for 'eksctl utils associal-iam-oidc-provider'
 func NewUtilsAssociateIAMOIDCProviderLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
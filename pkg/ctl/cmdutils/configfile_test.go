@@ -1,6 +1,7 @@
 package cmdutils
 
 import (
+	"os"
 	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -21,6 +22,22 @@ var _ = Describe("cmdutils configfile", func() {
 		}
 	}
 
+	// newClusterCmd returns a command of the cluster test-cluster without a config file, with the flags set up by
+	// addFlags, if not nil
+	newClusterCmd := func(addFlags func(fs *pflag.FlagSet)) *Cmd {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cobraCmd := newCmd()
+		if addFlags != nil {
+			addFlags(cobraCmd.Flags())
+		}
+		return &Cmd{
+			CobraCommand:   cobraCmd,
+			ClusterConfig:  cfg,
+			ProviderConfig: api.ProviderConfig{},
+		}
+	}
+
 	const examplesDir = "../../../examples/"
 
 	Context("load configfiles", func() {
@@ -78,6 +95,180 @@ var _ = Describe("cmdutils configfile", func() {
 			}
 		})
 
+		It("should load the cluster selected with --cluster-name", func() {
+			cmd := &Cmd{
+				ClusterConfig:     api.NewClusterConfig(),
+				CobraCommand:      newCmd(),
+				ClusterConfigFile: "../../eks/testdata/multi-cluster.yaml",
+				ClusterConfigName: "cluster-2",
+			}
+
+			Expect(NewMetadataLoader(cmd).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("cluster-2"))
+			Expect(cmd.ProviderConfig.Region).To(Equal("eu-north-1"))
+		})
+
+		It("should use the AWS client options of the config file that are not set with flags", func() {
+			configFile := filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+			Expect(os.WriteFile(configFile, []byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: retries
+  region: us-west-2
+awsClient:
+  retry:
+    mode: adaptive
+    maxAttempts: 20
+  endpoints:
+    eks: http://localhost:4566
+`), 0600)).To(Succeed())
+
+			cmd := &Cmd{
+				ClusterConfig:     api.NewClusterConfig(),
+				CobraCommand:      newCmd(),
+				ClusterConfigFile: configFile,
+				ProviderConfig: api.ProviderConfig{
+					Retry: api.AWSRetryConfig{
+						MaxAttempts: 5,
+						BaseBackoff: "2s",
+					},
+				},
+			}
+
+			Expect(NewMetadataLoader(cmd).Load()).To(Succeed())
+			Expect(cmd.ProviderConfig.Retry).To(Equal(api.AWSRetryConfig{
+				Mode:        "adaptive",
+				MaxAttempts: 5,
+				BaseBackoff: "2s",
+			}))
+			Expect(cmd.ProviderConfig.Endpoints).To(Equal(api.AWSEndpoints{
+				EKS: "http://localhost:4566",
+			}))
+		})
+
+		DescribeTable("should use the profile and the roles to assume of the config file", func(profile api.Profile, expectedProfile string) {
+			configFile := filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+			Expect(os.WriteFile(configFile, []byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: assume-roles
+  region: us-west-2
+awsClient:
+  profile: base
+  assumeRoles:
+  - roleARN: arn:aws:iam::111122223333:role/intermediate
+    mfaSerial: arn:aws:iam::111122223333:mfa/alice
+  - roleARN: arn:aws:iam::444455556666:role/target
+`), 0600)).To(Succeed())
+
+			cmd := &Cmd{
+				ClusterConfig:     api.NewClusterConfig(),
+				CobraCommand:      newCmd(),
+				ClusterConfigFile: configFile,
+				ProviderConfig: api.ProviderConfig{
+					Profile: profile,
+				},
+			}
+
+			Expect(NewMetadataLoader(cmd).Load()).To(Succeed())
+			Expect(cmd.ProviderConfig.Profile.Name).To(Equal(expectedProfile))
+			Expect(cmd.ProviderConfig.AssumeRoles).To(Equal([]api.AWSAssumeRole{
+				{
+					RoleARN:   "arn:aws:iam::111122223333:role/intermediate",
+					MFASerial: "arn:aws:iam::111122223333:mfa/alice",
+				},
+				{
+					RoleARN: "arn:aws:iam::444455556666:role/target",
+				},
+			}))
+		},
+			Entry("no profile set", api.Profile{}, "base"),
+			Entry("a profile set with AWS_PROFILE", api.Profile{Name: "env", SourceIsEnvVar: true}, "base"),
+			Entry("a profile set with --profile", api.Profile{Name: "flag"}, "flag"),
+		)
+
+		It("should use the role session of the config file that is not set with flags", func() {
+			configFile := filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+			Expect(os.WriteFile(configFile, []byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: role-session
+  region: us-west-2
+awsClient:
+  roleSession:
+    name: deploy
+    sourceIdentity: pipeline
+    tags:
+      team: platform
+`), 0600)).To(Succeed())
+
+			cmd := &Cmd{
+				ClusterConfig:     api.NewClusterConfig(),
+				CobraCommand:      newCmd(),
+				ClusterConfigFile: configFile,
+				ProviderConfig: api.ProviderConfig{
+					RoleSession: api.AWSRoleSessionConfig{
+						SourceIdentity: "alice",
+					},
+				},
+			}
+
+			Expect(NewMetadataLoader(cmd).Load()).To(Succeed())
+			Expect(cmd.ProviderConfig.RoleSession).To(Equal(api.AWSRoleSessionConfig{
+				Name:           "deploy",
+				SourceIdentity: "alice",
+				Tags: map[string]string{
+					"team": "platform",
+				},
+			}))
+		})
+
+		It("should reject an invalid role session name", func() {
+			cmd := &Cmd{
+				ClusterConfig: api.NewClusterConfig(),
+				CobraCommand:  newCmd(),
+				ProviderConfig: api.ProviderConfig{
+					RoleSession: api.AWSRoleSessionConfig{
+						Name: "a",
+					},
+				},
+			}
+
+			err := NewMetadataLoader(cmd).Load()
+			Expect(err).To(MatchError(`invalid role session name "a", it must have 2 to 64 letters, digits or any of _+=,.@-`))
+		})
+
+		It("should reject invalid retry options", func() {
+			cmd := &Cmd{
+				ClusterConfig: api.NewClusterConfig(),
+				CobraCommand:  newCmd(),
+				ProviderConfig: api.ProviderConfig{
+					Retry: api.AWSRetryConfig{
+						Mode: "legacy",
+					},
+				},
+			}
+
+			err := NewMetadataLoader(cmd).Load()
+			Expect(err).To(MatchError(`invalid retry mode "legacy", valid options: standard, adaptive`))
+		})
+
+		It("should not allow --cluster-name without a config file", func() {
+			cmd := &Cmd{
+				ClusterConfig: api.NewClusterConfig(),
+				NameArg:       "foo",
+				CobraCommand:  newCmd(),
+			}
+			AddClusterConfigNameFlag(cmd.CobraCommand.Flags(), &cmd.ClusterConfigName)
+			Expect(cmd.CobraCommand.Flags().Set("cluster-name", "cluster-2")).To(Succeed())
+
+			err := NewMetadataLoader(cmd).Load()
+			Expect(err).To(MatchError("cannot use --cluster-name unless a config file is specified via --config-file/-f"))
+		})
+
 		Describe("name argument", func() {
 			When("given as --name switch", func() {
 				It("succeeds", func() {
@@ -378,6 +569,40 @@ var _ = Describe("cmdutils configfile", func() {
 			}
 		})
 
+		When("enabling Auto Mode", func() {
+			newAutoModeCmd := func(args ...string) *Cmd {
+				cobraCmd := newCmd()
+				cobraCmd.Flags().Bool("managed", true, "")
+				Expect(cobraCmd.ParseFlags(args)).To(Succeed())
+				return &Cmd{
+					CobraCommand:   cobraCmd,
+					ClusterConfig:  api.NewClusterConfig(),
+					ProviderConfig: api.ProviderConfig{},
+				}
+			}
+
+			It("should enable Auto Mode and skip the initial nodegroup", func() {
+				cmd := newAutoModeCmd()
+				params := &CreateClusterCmdParams{AutoMode: true, CreateManagedNGOptions: CreateManagedNGOptions{
+					Managed: true,
+				}}
+				Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), api.NewNodeGroup(), params).Load()).To(Succeed())
+				Expect(cmd.ClusterConfig.IsAutoModeEnabled()).To(BeTrue())
+				Expect(cmd.ClusterConfig.ManagedNodeGroups).To(BeEmpty())
+				Expect(cmd.ClusterConfig.NodeGroups).To(BeEmpty())
+			})
+
+			It("should keep the initial nodegroup when --managed is set explicitly", func() {
+				cmd := newAutoModeCmd("--managed")
+				params := &CreateClusterCmdParams{AutoMode: true, CreateManagedNGOptions: CreateManagedNGOptions{
+					Managed: true,
+				}}
+				Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), api.NewNodeGroup(), params).Load()).To(Succeed())
+				Expect(cmd.ClusterConfig.IsAutoModeEnabled()).To(BeTrue())
+				Expect(cmd.ClusterConfig.ManagedNodeGroups).To(HaveLen(1))
+			})
+		})
+
 		It("loader should handle nodegroup exclusion with config file", func() {
 			loaderParams := []struct {
 				configFile       string
@@ -529,6 +754,268 @@ var _ = Describe("cmdutils configfile", func() {
 			})
 		})
 	})
+
+	Describe("UtilsUpdateVPCEndpointsLoader", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cmd = newClusterCmd(nil)
+		})
+
+		It("should set additional endpoint services from flags", func() {
+			Expect(NewUtilsUpdateVPCEndpointsLoader(cmd, []string{"ssm", "cloudformation"}).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.PrivateCluster.AdditionalEndpointServices).To(ConsistOf("ssm", "cloudformation"))
+		})
+
+		When("an unsupported endpoint service is passed", func() {
+			It("errors", func() {
+				err := NewUtilsUpdateVPCEndpointsLoader(cmd, []string{"s3-invalid"}).Load()
+				Expect(err).To(MatchError(ContainSubstring("invalid value in privateCluster.additionalEndpointServices")))
+			})
+		})
+	})
+
+	Describe("UtilsEnableEndpointAccessLoader", func() {
+		It("should set the public access CIDRs to add and remove from flags", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			cmd := &Cmd{
+				CobraCommand:   newCmd(),
+				ClusterConfig:  cfg,
+				ProviderConfig: api.ProviderConfig{},
+			}
+			Expect(NewUtilsEnableEndpointAccessLoader(cmd, false, false, []string{"1.1.1.1/32"}, []string{"0.0.0.0/0"}).Load()).To(Succeed())
+			Expect(cfg.VPC.ClusterEndpoints.AddPublicAccessCIDRs).To(Equal([]string{"1.1.1.1/32"}))
+			Expect(cfg.VPC.ClusterEndpoints.RemovePublicAccessCIDRs).To(Equal([]string{"0.0.0.0/0"}))
+			Expect(cfg.VPC.ClusterEndpoints.PrivateAccess).To(BeNil())
+			Expect(cfg.VPC.ClusterEndpoints.PublicAccess).To(BeNil())
+		})
+	})
+
+	Describe("UtilsUpdateControlPlaneSecurityGroupIngressLoader", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cmd = newClusterCmd(func(fs *pflag.FlagSet) {
+				fs.StringSlice("ingress-cidrs", nil, "")
+			})
+		})
+
+		It("should set the ingress rules from flags", func() {
+			Expect(cmd.CobraCommand.Flags().Set("ingress-cidrs", "10.0.0.0/16")).To(Succeed())
+			Expect(NewUtilsUpdateControlPlaneSecurityGroupIngressLoader(cmd, []string{"10.0.0.0/16"}).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.VPC.ControlPlaneSecurityGroupIngress).To(Equal([]api.ControlPlaneSecurityGroupIngressRule{
+				{CIDR: "10.0.0.0/16", Port: 443},
+			}))
+		})
+
+		It("should reject invalid CIDRs", func() {
+			Expect(cmd.CobraCommand.Flags().Set("ingress-cidrs", "10.0.0.0")).To(Succeed())
+			err := NewUtilsUpdateControlPlaneSecurityGroupIngressLoader(cmd, []string{"10.0.0.0"}).Load()
+			Expect(err).To(MatchError(ContainSubstring(`invalid CIDR "10.0.0.0"`)))
+		})
+
+		It("should error when --ingress-cidrs is not set", func() {
+			err := NewUtilsUpdateControlPlaneSecurityGroupIngressLoader(cmd, nil).Load()
+			Expect(err).To(MatchError(ContainSubstring("--ingress-cidrs must be set")))
+		})
+	})
+
+	Describe("UtilsUpdateZonalShiftConfigLoader", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cmd = newClusterCmd(func(fs *pflag.FlagSet) {
+				fs.Bool("enable-zonal-shift", false, "")
+			})
+		})
+
+		It("should set the zonal shift configuration from flags", func() {
+			Expect(cmd.CobraCommand.Flags().Set("enable-zonal-shift", "false")).To(Succeed())
+			Expect(NewUtilsUpdateZonalShiftConfigLoader(cmd, false).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.ZonalShiftConfig.Enabled).To(Equal(api.Disabled()))
+		})
+
+		It("should error when --enable-zonal-shift is not set", func() {
+			err := NewUtilsUpdateZonalShiftConfigLoader(cmd, false).Load()
+			Expect(err).To(MatchError("--enable-zonal-shift must be set"))
+		})
+	})
+
+	Describe("UtilsUpdateSupportTypeLoader", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cmd = newClusterCmd(func(fs *pflag.FlagSet) {
+				fs.String("support-type", "", "")
+			})
+		})
+
+		It("should set the upgrade policy from flags", func() {
+			Expect(NewUtilsUpdateSupportTypeLoader(cmd, "standard").Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.UpgradePolicy.SupportType).To(Equal(api.SupportTypeStandard))
+		})
+
+		It("should error when --support-type is not set", func() {
+			err := NewUtilsUpdateSupportTypeLoader(cmd, "").Load()
+			Expect(err).To(MatchError("--support-type must be set"))
+		})
+
+		It("should error when the support type is invalid", func() {
+			err := NewUtilsUpdateSupportTypeLoader(cmd, "premium").Load()
+			Expect(err).To(MatchError(ContainSubstring(`invalid support type "PREMIUM"`)))
+		})
+	})
+
+	Describe("UtilsSetDeletionProtectionLoader", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cmd = newClusterCmd(func(fs *pflag.FlagSet) {
+				fs.Bool("deletion-protection", false, "")
+			})
+		})
+
+		It("should set deletion protection from flags", func() {
+			Expect(cmd.CobraCommand.Flags().Set("deletion-protection", "true")).To(Succeed())
+			Expect(NewUtilsSetDeletionProtectionLoader(cmd, true).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.Metadata.DeletionProtection).To(Equal(api.Enabled()))
+		})
+
+		It("should error when --deletion-protection is not set", func() {
+			err := NewUtilsSetDeletionProtectionLoader(cmd, false).Load()
+			Expect(err).To(MatchError("--deletion-protection must be set"))
+		})
+	})
+
+	Describe("UtilsUpdateTagsLoader", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cmd = newClusterCmd(func(fs *pflag.FlagSet) {
+				fs.StringToString("tags", nil, "")
+			})
+		})
+
+		It("should load the tags from flags", func() {
+			cmd.ClusterConfig.Metadata.Tags = map[string]string{"cost-center": "platform"}
+			Expect(NewUtilsUpdateTagsLoader(cmd).Load()).To(Succeed())
+		})
+
+		It("should error when --tags is not set", func() {
+			err := NewUtilsUpdateTagsLoader(cmd).Load()
+			Expect(err).To(MatchError("--tags must be set"))
+		})
+	})
+
+	Describe("AutoModeResourceLoaders", func() {
+		newAutoModeResourceCmd := func(configFile string) *Cmd {
+			cobraCmd := newCmd()
+			cobraCmd.Flags().String("name", "", "")
+			return &Cmd{
+				CobraCommand:      cobraCmd,
+				ClusterConfigFile: configFile,
+				ClusterConfig:     api.NewClusterConfig(),
+				ProviderConfig:    api.ProviderConfig{},
+			}
+		}
+
+		It("should load NodeClasses and NodePools from the config file", func() {
+			cmd := newAutoModeResourceCmd(filepath.Join(examplesDir, "38-auto-mode.yaml"))
+			var name string
+			Expect(NewCreateAutoModeResourceLoader(cmd, &name).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.AutoModeConfig.NodeClasses).To(HaveLen(1))
+			Expect(cmd.ClusterConfig.AutoModeConfig.CustomNodePools).To(HaveLen(1))
+		})
+
+		It("should select a single resource using the name argument", func() {
+			cmd := newAutoModeResourceCmd(filepath.Join(examplesDir, "38-auto-mode.yaml"))
+			cmd.NameArg = "private"
+			var name string
+			Expect(NewCreateAutoModeResourceLoader(cmd, &name).Load()).To(Succeed())
+			Expect(name).To(Equal("private"))
+		})
+
+		It("should require a config file to create resources", func() {
+			cmd := newAutoModeResourceCmd("")
+			cmd.ClusterConfig.Metadata.Name = "auto-mode-cluster"
+			var name string
+			Expect(NewCreateAutoModeResourceLoader(cmd, &name).Load()).To(MatchError("--config-file must be set"))
+		})
+
+		It("should require Auto Mode to be enabled in the config file", func() {
+			cmd := newAutoModeResourceCmd(filepath.Join(examplesDir, "01-simple-cluster.yaml"))
+			var name string
+			Expect(NewCreateAutoModeResourceLoader(cmd, &name).Load()).To(MatchError("autoModeConfig.enabled must be true to manage NodeClasses and NodePools"))
+		})
+
+		It("should require a name to delete a resource without a config file", func() {
+			cmd := newAutoModeResourceCmd("")
+			cmd.ClusterConfig.Metadata.Name = "auto-mode-cluster"
+			var name string
+			Expect(NewDeleteAutoModeResourceLoader(cmd, &name).Load()).To(MatchError("--name must be set"))
+
+			cmd.NameArg = "gpu"
+			Expect(NewDeleteAutoModeResourceLoader(cmd, &name).Load()).To(Succeed())
+			Expect(name).To(Equal("gpu"))
+		})
+
+		It("should require a cluster to get resources without a config file", func() {
+			var name string
+			Expect(NewGetAutoModeResourceLoader(newAutoModeResourceCmd(""), &name).Load()).To(MatchError(ContainSubstring("--cluster must be set")))
+		})
+	})
+
+	Describe("UtilsAssociatePrivateHostedZonesLoader", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			cmd = &Cmd{
+				CobraCommand:   newCmd(),
+				ClusterConfig:  cfg,
+				ProviderConfig: api.ProviderConfig{},
+			}
+		})
+
+		It("should set the hosted zones from flags", func() {
+			roleARN := "arn:aws:iam::111122223333:role/route53"
+			Expect(NewUtilsAssociatePrivateHostedZonesLoader(cmd, []string{"Z1", "Z2"}, roleARN).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.VPC.PrivateHostedZoneAssociations).To(Equal([]api.PrivateHostedZoneAssociation{
+				{HostedZoneID: "Z1", AuthorizationRoleARN: roleARN},
+				{HostedZoneID: "Z2", AuthorizationRoleARN: roleARN},
+			}))
+		})
+
+		It("should error when no hosted zones are set", func() {
+			err := NewUtilsAssociatePrivateHostedZonesLoader(cmd, nil, "").Load()
+			Expect(err).To(MatchError("--hosted-zone-ids must be set"))
+		})
+	})
+
+	Describe("UtilsUpdateClusterVPCConfigLoader", func() {
+		var cmd *Cmd
+
+		BeforeEach(func() {
+			cmd = newClusterCmd(nil)
+		})
+
+		It("should accept subnets and security groups set from flags", func() {
+			cmd.ClusterConfig.VPC.ControlPlaneSubnetIDs = []string{"subnet-1", "subnet-2"}
+			cmd.ClusterConfig.VPC.ControlPlaneSecurityGroupIDs = []string{"sg-1"}
+			Expect(NewUtilsUpdateClusterVPCConfigLoader(cmd).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.VPC.ControlPlaneSubnetIDs).To(ConsistOf("subnet-1", "subnet-2"))
+			Expect(cmd.ClusterConfig.VPC.ControlPlaneSecurityGroupIDs).To(ConsistOf("sg-1"))
+		})
+
+		When("neither subnets nor security groups are set", func() {
+			It("errors", func() {
+					err := NewUtilsUpdateClusterVPCConfigLoader(cmd).Load()
+				Expect(err).To(MatchError("at least one of --control-plane-subnet-ids or --control-plane-security-group-ids must be set"))
+			})
+		})
+	})
 })
 
 func assertValidClusterEndpoint(endpoints *api.ClusterEndpoints, privateAccess, publicAccess bool) {
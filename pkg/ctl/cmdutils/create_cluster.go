@@ -11,6 +11,7 @@ type CreateClusterCmdParams struct {
 	AutoKubeconfigPath          bool
 	AuthenticatorRoleARN        string
 	SetContext                  bool
+	KubeconfigContextName       string
 	AvailabilityZones           []string
 	InstallWindowsVPCController bool
 
@@ -18,7 +19,9 @@ type CreateClusterCmdParams struct {
 	Subnets               map[api.SubnetTopology]*[]string
 	WithoutNodeGroup      bool
 	Fargate               bool
+	AutoMode              bool
 	DryRun                bool
+	Interactive           bool
 	CreateNGOptions
 	CreateManagedNGOptions
 }
@@ -35,4 +38,7 @@ type CreateNGOptions struct {
 	InstallNeuronDevicePlugin bool
 	InstallNvidiaDevicePlugin bool
 	DryRun                    bool
+	QuotaCheck                string
+	EstimateCost              bool
+	SpotPlacement             string
 }
@@ -2,6 +2,7 @@ package filter
 
 import (
 	"context"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -138,6 +139,22 @@ func (f *IAMServiceAccountFilter) SetDeleteFilter(ctx context.Context, lister se
 	return nil
 }
 
+// SetOwnedOnly uses lister to list existing iamserviceaccount stacks and configures the filter to exclude
+// the iamserviceaccounts of cfg that have no stack, as they were not created by eksctl
+func (f *IAMServiceAccountFilter) SetOwnedOnly(ctx context.Context, lister serviceAccountLister, cfg *api.ClusterConfig) error {
+	existing, err := lister.ListIAMServiceAccountStacks(ctx)
+	if err != nil {
+		return err
+	}
+
+	unowned := sets.NewString(f.collectNames(cfg.IAM.ServiceAccounts)...).Difference(sets.NewString(existing...))
+	if unowned.Len() > 0 {
+		logger.Info("%d %s(s) not created by eksctl (%s) will be excluded", unowned.Len(), "iamserviceaccount", strings.Join(unowned.List(), ","))
+		f.AppendExcludeNames(unowned.List()...)
+	}
+	return nil
+}
+
 // LogInfo prints out a user-friendly message about how filter was applied
 func (f *IAMServiceAccountFilter) LogInfo(serviceAccounts []*api.ClusterIAMServiceAccount) {
 	included, excluded := f.MatchAll(serviceAccounts)
@@ -70,6 +70,25 @@ var _ = Describe("iamserviceaccount filter", func() {
 			))
 		})
 
+		It("owned-only excludes the iamserviceaccounts without a stack", func() {
+			mockLister := newMockServiceAccountLister(
+				"sa/dev1",
+				"sa/test1",
+				"sa/only-remote-1",
+			)
+			err := filter.SetOwnedOnly(context.Background(), mockLister, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			included, excluded := filter.MatchAll(cfg.IAM.ServiceAccounts)
+			Expect(included.List()).To(ConsistOf("sa/dev1", "sa/test1"))
+			Expect(excluded.List()).To(ConsistOf(
+				"sa/dev2",
+				"sa/dev3",
+				"sa/test2",
+				"sa/test3",
+			))
+		})
+
 		It("exclude existing stacks works correctly", func() {
 			mockLister := newMockServiceAccountLister(
 				"sa/dev1",
@@ -111,6 +111,26 @@ func (f *NodeGroupFilter) SetOnlyRemote(ctx context.Context, eksAPI awsapi.EKS,
 	return nil
 }
 
+// SetOwnedOnly uses StackLister to list existing nodegroup stacks and configures the filter to exclude
+// the nodegroups of clusterConfig that have no stack, as they were not created by eksctl
+func (f *NodeGroupFilter) SetOwnedOnly(ctx context.Context, lister StackLister, clusterConfig *api.ClusterConfig) error {
+	nodeGroupsWithStacks, err := lister.ListNodeGroupStacksWithStatuses(ctx)
+	if err != nil {
+		return err
+	}
+	owned := sets.NewString()
+	for _, s := range nodeGroupsWithStacks {
+		owned.Insert(s.NodeGroupName)
+	}
+
+	unowned := sets.NewString(clusterConfig.GetAllNodeGroupNames()...).Difference(owned)
+	if unowned.Len() > 0 {
+		logger.Info("%d %s(s) not created by eksctl (%s) will be excluded", unowned.Len(), "nodegroup", strings.Join(unowned.List(), ","))
+		f.delegate.AppendExcludeNames(unowned.List()...)
+	}
+	return nil
+}
+
 // SetExcludeAll sets the ExcludeAll flag in the filter so that no nodegroups are matched
 func (f *NodeGroupFilter) SetExcludeAll(excludeAll bool) {
 	f.delegate.ExcludeAll = excludeAll
@@ -307,3 +327,29 @@ func (*NodeGroupFilter) collectNames(nodeGroups []*api.NodeGroup) sets.String {
 	}
 	return names
 }
+
+// ApplyNodeGroupFilter removes the nodegroups ngFilter does not match from clusterConfig and returns a log function
+func ApplyNodeGroupFilter(clusterConfig *api.ClusterConfig, ngFilter NodegroupFilter) func() {
+	var (
+		filteredNodeGroups        []*api.NodeGroup
+		filteredManagedNodeGroups []*api.ManagedNodeGroup
+	)
+
+	for _, ng := range clusterConfig.NodeGroups {
+		if ngFilter.Match(ng.NameString()) {
+			filteredNodeGroups = append(filteredNodeGroups, ng)
+		}
+	}
+
+	for _, ng := range clusterConfig.ManagedNodeGroups {
+		if ngFilter.Match(ng.NameString()) {
+			filteredManagedNodeGroups = append(filteredManagedNodeGroups, ng)
+		}
+	}
+
+	clusterConfig.NodeGroups, clusterConfig.ManagedNodeGroups = filteredNodeGroups, filteredManagedNodeGroups
+
+	return func() {
+		ngFilter.LogInfo(clusterConfig)
+	}
+}
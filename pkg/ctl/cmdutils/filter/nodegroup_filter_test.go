@@ -97,6 +97,32 @@ var _ = Describe("nodegroup filter", func() {
 			)).To(BeTrue())
 		})
 
+		It("owned-only excludes the nodegroups without stacks", func() {
+			mockLister := newMockStackLister(
+				"test-ng1a",
+				"test-ng2a",
+				"test-ng3a",
+			)
+			err := filter.SetOwnedOnly(context.Background(), mockLister, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			included, excluded := filter.matchAll(filter.collectNames(cfg.NodeGroups))
+			Expect(included).To(HaveLen(3))
+			Expect(included.HasAll("test-ng1a", "test-ng2a", "test-ng3a")).To(BeTrue())
+			Expect(excluded).To(HaveLen(3))
+			Expect(excluded.HasAll("test-ng1b", "test-ng2b", "test-ng3b")).To(BeTrue())
+		})
+
+		It("owned-only takes precedence over inclusion rules", func() {
+			err := filter.AppendIncludeGlobs(getNodeGroupNames(cfg), "test-ng1?")
+			Expect(err).NotTo(HaveOccurred())
+			filter.AppendIncludeNames("test-ng2b")
+			Expect(filter.SetOwnedOnly(context.Background(), newMockStackLister("test-ng1a"), cfg)).To(Succeed())
+
+			included, _ := filter.matchAll(filter.collectNames(cfg.NodeGroups))
+			Expect(included.List()).To(ConsistOf("test-ng1a"))
+		})
+
 		It("should match only local nodegroups", func() {
 			err := filter.AppendIncludeGlobs(getNodeGroupNames(cfg), "test-ng1?")
 			Expect(err).NotTo(HaveOccurred())
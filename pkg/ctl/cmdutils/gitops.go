@@ -48,6 +48,33 @@ func NewGitOpsConfigLoader(cmd *Cmd) *GitOpsConfigLoader {
 	return l
 }
 
+// NewArgoCDConfigLoader creates a new GitOpsConfigLoader which handles
+// loading of ClusterConfigFile for Argo CD-related commands.
+func NewArgoCDConfigLoader(cmd *Cmd) *GitOpsConfigLoader {
+	l := &GitOpsConfigLoader{
+		cmd: cmd,
+	}
+
+	l.validateWithConfigFile = func() error {
+		meta := l.cmd.ClusterConfig.Metadata
+		if meta.Name == "" {
+			return ErrMustBeSet("metadata.name")
+		}
+
+		if meta.Region == "" {
+			return ErrMustBeSet("metadata.region")
+		}
+
+		if l.cmd.ClusterConfig.GitOps == nil || l.cmd.ClusterConfig.GitOps.ArgoCD == nil {
+			return ErrMustBeSet("gitops.argocd")
+		}
+
+		return api.ValidateArgoCD(l.cmd.ClusterConfig.GitOps.ArgoCD)
+	}
+
+	return l
+}
+
 // Load ClusterConfig or use CLI flags.
 func (l *GitOpsConfigLoader) Load() error {
 	if err := api.Register(); err != nil {
@@ -62,7 +89,7 @@ func (l *GitOpsConfigLoader) Load() error {
 	// because other parts of the code store the pointer locally and access it directly instead of via
 	// the Cmd reference
 	var err error
-	if l.cmd.ClusterConfig, err = eks.LoadConfigFromFile(l.cmd.ClusterConfigFile); err != nil {
+	if l.cmd.ClusterConfig, err = eks.LoadClusterConfigFromFile(l.cmd.ClusterConfigFile, l.cmd.LoadConfigOptions()); err != nil {
 		return err
 	}
 
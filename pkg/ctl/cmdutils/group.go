@@ -55,6 +55,16 @@ func (n *NamedFlagSetGroup) InFlagSet(name string, cb func(*pflag.FlagSet)) {
 	n.list = append(n.list, nfs)
 }
 
+// inFlagSetOf calls cb with the FlagSet of the group holding the named flag, if any
+func (n *NamedFlagSetGroup) inFlagSetOf(flagName string, cb func(*pflag.FlagSet)) {
+	for _, nfs := range n.list {
+		if nfs.fs.Lookup(flagName) != nil {
+			cb(nfs.fs)
+			return
+		}
+	}
+}
+
 // AddTo mixes all flagsets in the given group into another flagset
 func (n *NamedFlagSetGroup) AddTo(cmd *cobra.Command) {
 	for _, nfs := range n.list {
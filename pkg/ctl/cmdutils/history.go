@@ -0,0 +1,19 @@
+package cmdutils
+
+import (
+	"context"
+	"os"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/history"
+)
+
+// RecordHistory adds the ClusterConfig of cmd, which succeeded, to the history store set by EKSCTL_HISTORY_STORE,
+// if any. Plans are not recorded. Failing to record it is only logged, as the changes to the cluster were made
+func RecordHistory(ctx context.Context, cmd *Cmd, ctl *eks.ClusterProvider) {
+	if cmd.Plan || os.Getenv(history.StoreEnvVar) == "" {
+		return
+	}
+	cfg := cmd.ClusterConfig
+	history.RecordCommand(ctx, ctl.AWSProvider, ctl.NewStackManager(cfg), cfg, cmd.CobraCommand.CommandPath(), ctl.Status.IAMRoleARN)
+}
@@ -0,0 +1,25 @@
+package cordon
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// Command will create the `cordon` commands
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("cordon", "Cordon resource(s)", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, cordonNodeGroupCmd)
+
+	return verbCmd
+}
+
+// UncordonCommand will create the `uncordon` commands
+func UncordonCommand(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("uncordon", "Uncordon resource(s)", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, uncordonNodeGroupCmd)
+
+	return verbCmd
+}
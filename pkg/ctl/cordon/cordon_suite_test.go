@@ -0,0 +1,11 @@
+package cordon
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestCtlCordon(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,83 @@
+package cordon
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("cordon", func() {
+	Describe("invalid-resource", func() {
+		It("with no flag", func() {
+			cmd := newDefaultCmd("invalid-resource")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: unknown command \"invalid-resource\" for \"cordon\""))
+			Expect(err.Error()).To(ContainSubstring("usage"))
+		})
+		It("with invalid-resource and some flag", func() {
+			cmd := newDefaultCmd("invalid-resource", "--invalid-flag", "foo")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: unknown command \"invalid-resource\" for \"cordon\""))
+			Expect(err.Error()).To(ContainSubstring("usage"))
+		})
+		It("with invalid-resource and additional argument", func() {
+			cmd := newDefaultCmd("invalid-resource", "foo")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: unknown command \"invalid-resource\" for \"cordon\""))
+			Expect(err.Error()).To(ContainSubstring("usage"))
+		})
+	})
+})
+
+var _ = Describe("uncordon", func() {
+	It("with invalid-resource", func() {
+		cmd := newVerbCmd(UncordonCommand, "invalid-resource")
+		_, err := cmd.execute()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Error: unknown command \"invalid-resource\" for \"uncordon\""))
+		Expect(err.Error()).To(ContainSubstring("usage"))
+	})
+})
+
+func newDefaultCmd(args ...string) *mockVerbCmd {
+	return newVerbCmd(Command, args...)
+}
+
+func newVerbCmd(command func(*cmdutils.FlagGrouping) *cobra.Command, args ...string) *mockVerbCmd {
+	flagGrouping := cmdutils.NewGrouping()
+	cmd := command(flagGrouping)
+	cmd.SetArgs(args)
+	return &mockVerbCmd{
+		parentCmd: cmd,
+	}
+}
+func newMockEmptyCmd(args ...string) *mockVerbCmd {
+	cmd := cmdutils.NewVerbCmd("get", "Get resource(s)", "")
+	cmd.SetArgs(args)
+	return &mockVerbCmd{
+		parentCmd: cmd,
+	}
+}
+
+type mockVerbCmd struct {
+	parentCmd *cobra.Command
+}
+
+func (c mockVerbCmd) execute() (string, error) {
+	outBuf := new(bytes.Buffer)
+	errBuf := new(bytes.Buffer)
+	c.parentCmd.SetOut(outBuf)
+	c.parentCmd.SetErr(errBuf)
+	err := c.parentCmd.Execute()
+	if err != nil {
+		err = errors.New(errBuf.String())
+	}
+	return outBuf.String(), err
+}
@@ -0,0 +1,106 @@
+package cordon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+)
+
+func cordonNodeGroupCmd(cmd *cmdutils.Cmd) {
+	nodeGroupWithRunFunc(cmd, true, doCordonNodeGroup)
+}
+
+func uncordonNodeGroupCmd(cmd *cmdutils.Cmd) {
+	nodeGroupWithRunFunc(cmd, false, doCordonNodeGroup)
+}
+
+func verb(cordon bool) string {
+	if cordon {
+		return "cordon"
+	}
+	return "uncordon"
+}
+
+func nodeGroupWithRunFunc(cmd *cmdutils.Cmd, cordon bool, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, cordon bool) error) {
+	cfg := api.NewClusterConfig()
+	ng := api.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	if cordon {
+		cmd.SetDescription("nodegroup", "Mark all nodes of a nodegroup unschedulable", "", "ng")
+	} else {
+		cmd.SetDescription("nodegroup", "Mark all nodes of a nodegroup schedulable", "", "ng")
+	}
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return runFunc(cmd, ng, cordon)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringVarP(&ng.Name, "name", "n", "", fmt.Sprintf("Name of the nodegroup to %s", verb(cordon)))
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, true)
+}
+
+func doCordonNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, cordon bool) error {
+	ngFilter := filter.NewNodeGroupFilter()
+
+	if err := cmdutils.NewDeleteAndDrainNodeGroupLoader(cmd, ng, ngFilter).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctx, cancel := context.WithTimeout(context.Background(), cmd.ProviderConfig.WaitTimeout)
+	defer cancel()
+
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	if cmd.ClusterConfigFile == "" {
+		if err := cmdutils.PopulateNodegroup(ctx, ctl.NewStackManager(cfg), ng.Name, cfg, ctl.AWSProvider); err != nil {
+			return err
+		}
+	}
+
+	logFiltered := cmdutils.ApplyFilter(cfg, ngFilter)
+	logFiltered()
+
+	cmdutils.LogIntendedAction(cmd.Plan, "%s %d nodegroup(s) in cluster %q", verb(cordon), len(cfg.NodeGroups), cfg.Metadata.Name)
+	cmdutils.LogIntendedAction(cmd.Plan, "%s %d managed nodegroup(s) in cluster %q", verb(cordon), len(cfg.ManagedNodeGroups), cfg.Metadata.Name)
+
+	cmdutils.LogPlanModeWarning(cmd.Plan && (len(cfg.NodeGroups) > 0 || len(cfg.ManagedNodeGroups) > 0))
+
+	if cmd.Plan {
+		return nil
+	}
+
+	return nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).Cordon(ctx, cmdutils.ToKubeNodeGroups(cfg), cordon)
+}
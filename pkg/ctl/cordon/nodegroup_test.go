@@ -0,0 +1,62 @@
+package cordon
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+type invalidParamsCase struct {
+	args  []string
+	error error
+}
+
+var _ = Describe("cordon node group", func() {
+	DescribeTable("cordon and uncordon node group successfully",
+		func(expectedCordon bool, args ...string) {
+			cmd := newMockEmptyCmd(args...)
+			count := 0
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+				nodeGroupWithRunFunc(cmd, expectedCordon, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, cordon bool) error {
+					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("clusterName"))
+					Expect(ng.Name).To(Equal("ng"))
+					Expect(cordon).To(Equal(expectedCordon))
+					count++
+					return nil
+				})
+			})
+			_, err := cmd.execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		},
+		Entry("cordon with valid details", true, "nodegroup", "--cluster", "clusterName", "--name", "ng"),
+		Entry("cordon with a region", true, "nodegroup", "--cluster", "clusterName", "--name", "ng", "--region", "us-west-2"),
+		Entry("uncordon with valid details", false, "nodegroup", "--cluster", "clusterName", "--name", "ng"),
+		Entry("uncordon with a region", false, "nodegroup", "--cluster", "clusterName", "--name", "ng", "--region", "us-west-2"),
+	)
+
+	DescribeTable("invalid flags or arguments",
+		func(c invalidParamsCase) {
+			cmd := newDefaultCmd(c.args...)
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(c.error.Error()))
+		},
+		Entry("missing required flag --cluster", invalidParamsCase{
+			args:  []string{"nodegroup"},
+			error: fmt.Errorf("Error: --cluster must be set"),
+		}),
+		Entry("missing required flag --name", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy"},
+			error: fmt.Errorf("Error: --name must be set"),
+		}),
+		Entry("setting --name and argument at the same time", invalidParamsCase{
+			args:  []string{"nodegroup", "ng", "--cluster", "dummy", "--name", "ng"},
+			error: fmt.Errorf("Error: --name=ng and argument ng cannot be used at the same time"),
+		}),
+	)
+})
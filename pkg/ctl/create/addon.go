@@ -29,6 +29,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Name, "name", "", "Add-on name")
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Version, "version", "", "Add-on version. Use `eksctl utils describe-addon-versions` to discover a version or set to \"latest\"")
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].ServiceAccountRoleARN, "service-account-role-arn", "", "Add-on serviceAccountRoleARN")
+		fs.StringVar(&cmd.ClusterConfig.Addons[0].ConfigurationValues, "configuration-values", "", "Add-on configuration values in JSON or YAML, validated against the configuration schema of the add-on version. Use `eksctl utils describe-addon-configuration` to view the schema")
 		fs.BoolVar(&force, "force", false, "Force migrates an existing self-managed add-on to an EKS managed add-on")
 		fs.BoolVar(&wait, "wait", false, "Wait for the addon creation to complete")
 
@@ -101,6 +102,7 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 			}
 		}
 
+		cmdutils.RecordHistory(ctx, cmd, clusterProvider)
 		return nil
 	}
 }
@@ -0,0 +1,67 @@
+package create
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/weaveworks/eksctl/pkg/actions/automode"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func createNodeClassCmd(cmd *cmdutils.Cmd) {
+	createAutoModeResourceCmd(cmd, "nodeclass", "Create Auto Mode NodeClass(es) from a config file", "NodeClass", automode.NodeClasses)
+}
+
+func createNodePoolCmd(cmd *cmdutils.Cmd) {
+	createAutoModeResourceCmd(cmd, "nodepool", "Create Auto Mode NodePool(s) from a config file", "NodePool", automode.NodePools)
+}
+
+func createAutoModeResourceCmd(cmd *cmdutils.Cmd, use, description, kind string, render func(*api.ClusterConfig) []*unstructured.Unstructured) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(use, description, "")
+
+	var name string
+	cmd.FlagSetGroup.InFlagSet("Auto Mode", func(fs *pflag.FlagSet) {
+		fs.StringVar(&name, "name", "", "Name of the "+kind+" to create, all of them are created if not set")
+	})
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewCreateAutoModeResourceLoader(cmd, &name).Load(); err != nil {
+			return err
+		}
+		return doCreateAutoModeResource(cmd, kind, name, render)
+	}
+}
+
+func doCreateAutoModeResource(cmd *cmdutils.Cmd, kind, name string, render func(*api.ClusterConfig) []*unstructured.Unstructured) error {
+	objects, err := automode.Filter(render(cmd.ClusterConfig), kind, name)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		logger.Info("no %ss defined in the config file", kind)
+		return nil
+	}
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	manager, err := automode.NewFromProvider(ctx, cmd.ClusterConfig, ctl)
+	if err != nil {
+		return err
+	}
+	return manager.CreateOrReplace(objects, false)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"sync"
 
@@ -14,28 +15,21 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	"k8s.io/apimachinery/pkg/runtime"
-	kubeclient "k8s.io/client-go/kubernetes"
-	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 
-	"github.com/weaveworks/eksctl/pkg/actions/addon"
-	"github.com/weaveworks/eksctl/pkg/actions/flux"
+	clusteractions "github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/cost"
 	"github.com/weaveworks/eksctl/pkg/actions/karpenter"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
-	"github.com/weaveworks/eksctl/pkg/authconfigmap"
-	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/kops"
-	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/outposts"
-	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
-	"github.com/weaveworks/eksctl/pkg/utils/kubectl"
 	"github.com/weaveworks/eksctl/pkg/utils/names"
 	"github.com/weaveworks/eksctl/pkg/utils/nodes"
-	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
@@ -62,23 +56,6 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 	})
 }
 
-func checkClusterVersion(cfg *api.ClusterConfig) error {
-	switch cfg.Metadata.Version {
-	case "auto":
-		cfg.Metadata.Version = api.DefaultVersion
-	case "latest":
-		cfg.Metadata.Version = api.LatestVersion
-	}
-
-	if err := api.ValidateClusterVersion(cfg); err != nil {
-		return err
-	}
-	if cfg.Metadata.Version == "" {
-		cfg.Metadata.Version = api.DefaultVersion
-	}
-	return nil
-}
-
 func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) error) {
 	cfg := api.NewClusterConfig()
 	ng := api.NewNodeGroup()
@@ -90,13 +67,33 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := preflight.ValidateQuotaCheckMode(preflight.QuotaCheckMode(params.QuotaCheck)); err != nil {
+			return exitcode.Wrap(exitcode.Validation, err)
+		}
+		if err := preflight.ValidateSpotPlacementMode(preflight.SpotPlacementMode(params.SpotPlacement)); err != nil {
+			return exitcode.Wrap(exitcode.Validation, err)
+		}
+		if params.Interactive {
+			if err := cmdutils.ValidateInteractiveOptions(cmd); err != nil {
+				return exitcode.Wrap(exitcode.Validation, err)
+			}
+			configFile, create, err := runClusterWizard(os.Stdin, cmd.CobraCommand.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+			if !create {
+				logger.Success("wrote config file %q, create the cluster with `eksctl create cluster -f %s`", configFile, configFile)
+				return nil
+			}
+			cmd.ClusterConfigFile = configFile
+		}
 		ngFilter := filter.NewNodeGroupFilter()
 		if err := cmdutils.NewCreateClusterLoader(cmd, ngFilter, ng, params).Load(); err != nil {
 			return err
 		}
-		err := checkClusterVersion(cmd.ClusterConfig)
+		err := clusteractions.SetVersion(cmd.ClusterConfig)
 		if err != nil {
-			return err
+			return exitcode.Wrap(exitcode.Validation, err)
 		}
 		return runFunc(cmd, ngFilter, params)
 	}
@@ -115,7 +112,12 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		fs.BoolVarP(&params.InstallWindowsVPCController, "install-vpc-controllers", "", false, "Install VPC controller that's required for Windows workloads")
 		fs.BoolVarP(&params.Fargate, "fargate", "", false, "Create a Fargate profile scheduling pods in the default and kube-system namespaces onto Fargate")
+		fs.BoolVar(&params.AutoMode, "enable-auto-mode", false, "Enable EKS Auto Mode, letting EKS manage compute, block storage and load balancing for the cluster")
 		fs.BoolVarP(&params.DryRun, "dry-run", "", false, "Dry-run mode that skips cluster creation and outputs a ClusterConfig")
+		fs.BoolVar(&params.Interactive, "interactive", false, "Ask for the region, version, VPC, nodegroup and addons of the cluster, and create it or write its config file")
+		cmdutils.AddQuotaCheckFlag(fs, &params.QuotaCheck)
+		cmdutils.AddEstimateCostFlag(fs, &params.EstimateCost, "cluster")
+		cmdutils.AddSpotPlacementFlag(fs, &params.SpotPlacement)
 
 		_ = fs.MarkDeprecated("install-vpc-controllers", vpcControllerInfoMessage)
 	})
@@ -137,7 +139,8 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 			api.SubnetTopologyPublic:  fs.StringSlice("vpc-public-subnets", nil, "re-use public subnets of an existing VPC; the subnets must exist in availability zones and not other types of zones"),
 		}
 		fs.StringVar(&params.KopsClusterNameForVPC, "vpc-from-kops-cluster", "", "re-use VPC from a given kops cluster")
-		fs.StringVar(cfg.VPC.NAT.Gateway, "vpc-nat-mode", api.ClusterSingleNAT, "VPC NAT mode, valid options: HighlyAvailable, Single, Disable")
+		fs.StringVar(cfg.VPC.NAT.Gateway, "vpc-nat-mode", api.ClusterSingleNAT, "VPC NAT mode, valid options: HighlyAvailable, Single, Disable, Instance")
+		fs.StringVar(&cfg.KubernetesNetworkConfig.ServiceIPv4CIDR, "service-cidr", "", "CIDR range from which Kubernetes service IPs are assigned; must not overlap with the VPC CIDR")
 	})
 
 	cmdutils.AddInstanceSelectorOptions(cmd.FlagSetGroup, ng)
@@ -146,19 +149,18 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 
 	cmd.FlagSetGroup.InFlagSet("Output kubeconfig", func(fs *pflag.FlagSet) {
 		cmdutils.AddCommonFlagsForKubeconfig(fs, &params.KubeconfigPath, &params.AuthenticatorRoleARN, &params.SetContext, &params.AutoKubeconfigPath, exampleClusterName)
+		cmdutils.AddKubeconfigContextNameFlag(fs, &params.KubeconfigContextName)
 		fs.BoolVar(&params.WriteKubeconfig, "write-kubeconfig", true, "toggle writing of kubeconfig")
 	})
 }
 
 func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams, ctl *eks.ClusterProvider) error {
-	var err error
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
 	if meta.Name != "" && api.IsInvalidNameArg(meta.Name) {
 		return api.ErrInvalidName(meta.Name)
 	}
-	printer := printers.NewJSONPrinter()
 
 	if params.DryRun {
 		originalWriter := logger.Writer
@@ -174,11 +176,11 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	})
 
 	if err := cfg.ValidatePrivateCluster(); err != nil {
-		return err
+		return exitcode.Wrap(exitcode.Validation, err)
 	}
 
 	if err := cfg.ValidateClusterEndpointConfig(); err != nil {
-		return err
+		return exitcode.Wrap(exitcode.Validation, err)
 	}
 
 	// If it's a private-only cluster warn the user.
@@ -198,6 +200,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		params.KubeconfigPath = kubeconfig.AutoPath(meta.Name)
 	}
 
+	if err := kubeconfig.ValidateContextNameTemplate(params.KubeconfigContextName); err != nil {
+		return err
+	}
+
 	ctx := context.Background()
 
 	if checkSubnetsGivenAsFlags(params) {
@@ -289,6 +295,12 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return err
 	}
 
+	if cfg.IsFullyPrivate() && cfg.PrivateCluster.SkipEndpointCreation && cfg.VPC.ID != "" && !params.DryRun {
+		if err := vpc.ValidateExistingEndpoints(ctx, ctl.AWSProvider.EC2(), cfg, ctl.AWSProvider.Region()); err != nil {
+			return err
+		}
+	}
+
 	nodeGroupService := eks.NewNodeGroupService(ctl.AWSProvider, selector.New(ctl.AWSProvider.Session()), outpostsService)
 	nodePools := nodes.ToNodePools(cfg)
 	if err := nodeGroupService.ExpandInstanceSelectorOptions(nodePools, cfg.AvailabilityZones); err != nil {
@@ -299,243 +311,52 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return cmdutils.PrintDryRunConfig(cfg, cmd.CobraCommand.OutOrStdout())
 	}
 
-	if err := nodeGroupService.Normalize(ctx, nodePools, cfg); err != nil {
-		return err
-	}
-
-	logger.Info("using Kubernetes version %s", meta.Version)
-	logger.Info("creating %s", cfg.LogString())
-
-	// TODO dry-run mode should provide a way to render config with all defaults set
-	// we should also make a call to resolve the AMI and write the result, similarly
-	// the body of the SSH key can be read
-
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
-		return err
-	}
-
-	stackManager := ctl.NewStackManager(cfg)
-	if cmd.ClusterConfigFile == "" {
-		logMsg := func(resource string) {
-			logger.Info("will create 2 separate CloudFormation stacks for cluster itself and the initial %s", resource)
-		}
-		if len(cfg.NodeGroups) == 1 {
-			logMsg("nodegroup")
-		} else if len(cfg.ManagedNodeGroups) == 1 {
-			logMsg("managed nodegroup")
-		}
-	} else {
-		logMsg := func(resource string, count int) {
-			logger.Info("will create a CloudFormation stack for cluster itself and %d %s stack(s)", count, resource)
-		}
-		logFiltered()
-
-		logMsg("nodegroup", len(cfg.NodeGroups))
-		logMsg("managed nodegroup", len(cfg.ManagedNodeGroups))
-	}
-
-	logger.Info("if you encounter any issues, check CloudFormation console or try 'eksctl utils describe-stacks --region=%s --cluster=%s'", meta.Region, meta.Name)
-
-	eks.LogEnabledFeatures(cfg)
-	postClusterCreationTasks := ctl.CreateExtraClusterConfigTasks(ctx, cfg)
-
-	var preNodegroupAddons, postNodegroupAddons *tasks.TaskTree
-	if len(cfg.Addons) > 0 {
-		preNodegroupAddons, postNodegroupAddons = addon.CreateAddonTasks(ctx, cfg, ctl, true, cmd.ProviderConfig.WaitTimeout)
-		postClusterCreationTasks.Append(preNodegroupAddons)
-	}
-
-	taskTree := stackManager.NewTasksToCreateClusterWithNodeGroups(ctx, cfg.NodeGroups, cfg.ManagedNodeGroups, postClusterCreationTasks)
-
-	logger.Info(taskTree.Describe())
-	if errs := taskTree.DoAllSync(); len(errs) > 0 {
-		logger.Warning("%d error(s) occurred and cluster hasn't been created properly, you may wish to check CloudFormation console", len(errs))
-		logger.Info("to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name)
-		for _, err := range errs {
-			ufe := &api.UnsupportedFeatureError{}
-			if errors.As(err, &ufe) {
-				logger.Critical(ufe.Message)
-			}
-			logger.Critical("%s\n", err.Error())
-		}
-		return fmt.Errorf("failed to create cluster %q", meta.Name)
-	}
-
-	logger.Info("waiting for the control plane to become ready")
-
-	// obtain cluster credentials, write kubeconfig
-
-	{ // post-creation action
-		var kubeconfigContextName string
-
-		if params.WriteKubeconfig {
-			kubectlConfig := kubeconfig.NewForKubectl(cfg, eks.GetUsername(ctl.Status.IAMRoleARN), params.AuthenticatorRoleARN, ctl.AWSProvider.Profile().Name)
-			kubeconfigContextName = kubectlConfig.CurrentContext
-
-			params.KubeconfigPath, err = kubeconfig.Write(params.KubeconfigPath, *kubectlConfig, params.SetContext)
-			if err != nil {
-				logger.Warning("unable to write kubeconfig %s, please retry with 'eksctl utils write-kubeconfig -n %s': %v", params.KubeconfigPath, meta.Name, err)
-			} else {
-				logger.Success("saved kubeconfig as %q", params.KubeconfigPath)
-			}
-		} else {
-			params.KubeconfigPath = ""
-		}
-
-		ngTasks := ctl.ClusterTasksForNodeGroups(cfg, params.InstallNeuronDevicePlugin, params.InstallNvidiaDevicePlugin)
-
-		logger.Info(ngTasks.Describe())
-		if errs := ngTasks.DoAllSync(); len(errs) > 0 {
-			logger.Warning("%d error(s) occurred and post actions have failed, you may wish to check CloudFormation console", len(errs))
-			logger.Info("to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name)
-			for _, err := range errs {
-				logger.Critical("%s\n", err.Error())
-			}
-			return fmt.Errorf("failed to create cluster %q", meta.Name)
-		}
-		logger.Success("all EKS cluster resources for %q have been created", meta.Name)
-
-		// create Kubernetes client
-		clientSet, err := ctl.NewStdClientSet(cfg)
+	if params.EstimateCost {
+		estimate, err := cost.NewEstimator(cost.NewPricer(ctl.AWSProvider.Pricing(), ctl.AWSProvider.Region())).EstimateCluster(ctx, cfg, nodePools)
 		if err != nil {
 			return err
 		}
-
-		{
-			ngCtx, cancel := context.WithTimeout(ctx, cmd.ProviderConfig.WaitTimeout)
-			defer cancel()
-			for _, ng := range cfg.NodeGroups {
-				// authorise nodes to join
-				if err := authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
-					return err
-				}
-
-				// wait for nodes to join
-				if err := eks.WaitForNodes(ngCtx, clientSet, ng); err != nil {
-					return err
-				}
-			}
-
-			for _, ng := range cfg.ManagedNodeGroups {
-				if err := eks.WaitForNodes(ngCtx, clientSet, ng); err != nil {
-					return err
-				}
-			}
-		}
-		if postNodegroupAddons != nil && postNodegroupAddons.Len() > 0 {
-			if errs := postNodegroupAddons.DoAllSync(); len(errs) > 0 {
-				logger.Warning("%d error(s) occurred while creating addons", len(errs))
-				for _, err := range errs {
-					logger.Critical("%s\n", err.Error())
-				}
-				return errors.New("failed to create addons")
-			}
-		}
-
-		// After we have the cluster config and all the nodes are done, we install Karpenter if necessary.
-		if cfg.Karpenter != nil {
-			config := kubeconfig.NewForKubectl(cfg, eks.GetUsername(ctl.Status.IAMRoleARN), params.AuthenticatorRoleARN, ctl.AWSProvider.Profile().Name)
-			kubeConfigBytes, err := runtime.Encode(clientcmdlatest.Codec, config)
-			if err != nil {
-				return errors.Wrap(err, "generating kubeconfig")
-			}
-			if err := installKarpenter(ctx, ctl, cfg, stackManager, clientSet, kubernetes.NewRESTClientGetter("karpenter", string(kubeConfigBytes))); err != nil {
-				return err
-			}
-		}
-
-		if cfg.HasGitOpsFluxConfigured() {
-			installer, err := flux.New(clientSet, cfg.GitOps)
-			logger.Info("gitops configuration detected, setting installer to Flux v2")
-			if err != nil {
-				return errors.Wrapf(err, "could not initialise Flux installer")
-			}
-
-			if err := installer.Run(); err != nil {
-				return err
-			}
-
-			//TODO why was it returning early before? I want to remove this line :thinking:
-			return nil
-		}
-
-		env, err := ctl.GetCredentialsEnv()
-		if err != nil {
-			return err
-		}
-		if err := kubectl.CheckAllCommands(params.KubeconfigPath, params.SetContext, kubeconfigContextName, env); err != nil {
-			logger.Critical("%s\n", err.Error())
-			logger.Info("cluster should be functional despite missing (or misconfigured) client binaries")
-		}
-
-		if cfg.IsFullyPrivate() && !cfg.IsControlPlaneOnOutposts() {
-			// disable public access
-			logger.Info("disabling public endpoint access for the cluster")
-			cfg.VPC.ClusterEndpoints.PublicAccess = api.Disabled()
-			if err := ctl.UpdateClusterConfigForEndpoints(ctx, cfg); err != nil {
-				return errors.Wrap(err, "error disabling public endpoint access for the cluster")
-			}
-			logger.Info("fully private cluster %q has been created. For subsequent operations, eksctl must be run from within the cluster's VPC, a peered VPC or some other means like AWS Direct Connect", cfg.Metadata.Name)
-		}
+		estimate.Log(fmt.Sprintf("cluster %q", meta.Name))
+		return nil
 	}
 
-	logger.Success("%s is ready", meta.LogString())
-
-	return printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg)
-}
-
-// installKarpenter prepares the environment for Karpenter, by creating the following resources:
-// - iam roles and profiles
-// - service account
-// - identity mapping
-// then proceeds with installing Karpenter using Helm.
-func installKarpenter(ctx context.Context, ctl *eks.ClusterProvider, cfg *api.ClusterConfig, stackManager manager.StackManager, clientSet kubeclient.Interface, restClientGetter *kubernetes.SimpleRESTClientGetter) error {
-	installer, err := createKarpenterInstaller(ctx, cfg, ctl, stackManager, clientSet, restClientGetter)
-	if err != nil {
-		return fmt.Errorf("failed to create installer: %w", err)
-	}
-	if err := installer.Create(ctx); err != nil {
-		return fmt.Errorf("failed to install Karpenter: %w", err)
+	if cmd.ClusterConfigFile != "" {
+		logFiltered()
 	}
 
-	return nil
+	var kubeconfigOptions *clusteractions.KubeconfigOptions
+	if params.WriteKubeconfig {
+		kubeconfigOptions = &clusteractions.KubeconfigOptions{
+			Path:        params.KubeconfigPath,
+			ContextName: params.KubeconfigContextName,
+			SetContext:  params.SetContext,
+		}
+	}
+	return clusteractions.Create(ctx, cfg, ctl, nodeGroupService, clusteractions.CreateOptions{
+		WaitTimeout:               cmd.ProviderConfig.WaitTimeout,
+		QuotaCheck:                preflight.QuotaCheckMode(params.QuotaCheck),
+		SpotPlacement:             preflight.SpotPlacementMode(params.SpotPlacement),
+		InstallNeuronDevicePlugin: params.InstallNeuronDevicePlugin,
+		InstallNvidiaDevicePlugin: params.InstallNvidiaDevicePlugin,
+		Kubeconfig:                kubeconfigOptions,
+		AuthenticatorRoleARN:      params.AuthenticatorRoleARN,
+		CheckKubectl:              true,
+		ConfigFileProvided:        cmd.ClusterConfigFile != "",
+		Command:                   cmd.CobraCommand.CommandPath(),
+		NewKarpenterInstaller:     createKarpenterInstaller,
+	})
 }
 
 func createOrImportVPC(ctx context.Context, cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmdutils.CreateClusterCmdParams, ctl *eks.ClusterProvider) error {
-	customNetworkingNotice := "custom VPC/subnets will be used; if resulting cluster doesn't function as expected, make sure to review the configuration of VPC/subnets"
-
-	subnetsGiven := cfg.HasAnySubnets() // this will be false when neither flags nor config has any subnets
-	if !subnetsGiven && params.KopsClusterNameForVPC == "" {
-		if !cfg.IsControlPlaneOnOutposts() {
-			userProvidedAZs, err := eks.SetAvailabilityZones(ctx, cfg, params.AvailabilityZones, ctl.AWSProvider.EC2(), ctl.AWSProvider.Region())
-			if err != nil {
-				return err
-			}
-
-			// If the availability zones were provided at random, we already did this check.
-			if userProvidedAZs {
-				if err := eks.CheckInstanceAvailability(ctx, cfg, ctl.AWSProvider.EC2()); err != nil {
-					return err
-				}
-			}
-
-			if len(cfg.LocalZones) > 0 {
-				if err := eks.ValidateLocalZones(ctx, ctl.AWSProvider.EC2(), cfg.LocalZones, ctl.AWSProvider.Region()); err != nil {
-					return err
-				}
-			}
-
-			// Skip setting subnets
-			// The default subnet config set by SetSubnets will fail validation on a subsequent run of `create cluster`
-			// because those fields indicate usage of pre-existing VPC and subnets
-			// default: create dedicated VPC
-			if params.DryRun {
-				return nil
-			}
-		}
-		return vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones, cfg.LocalZones)
+	vpcOptions := clusteractions.VPCOptions{
+		AvailabilityZones: params.AvailabilityZones,
+		DryRun:            params.DryRun,
+	}
+	if cfg.HasSubnetCIDRPlan() {
+		return clusteractions.CreateOrImportVPC(ctx, cfg, ctl, vpcOptions)
 	}
 
+	subnetsGiven := cfg.HasAnySubnets() // this will be false when neither flags nor config has any subnets
 	if params.KopsClusterNameForVPC != "" {
 		if cfg.IsControlPlaneOnOutposts() {
 			return errors.New("cannot specify --vpc-from-kops-cluster when creating a cluster on Outposts")
@@ -570,45 +391,20 @@ func createOrImportVPC(ctx context.Context, cmd *cmdutils.Cmd, cfg *api.ClusterC
 		}
 
 		logger.Success("using %s from kops cluster %q", cfg.SubnetInfo(), params.KopsClusterNameForVPC)
-		logger.Warning(customNetworkingNotice)
+		logger.Warning(clusteractions.CustomNetworkingNotice)
 		return nil
 	}
 
-	// use subnets as specified by --vpc-{private,public}-subnets flags
-
-	if len(params.AvailabilityZones) != 0 {
-		return fmt.Errorf("--vpc-private-subnets/--vpc-public-subnets and --zones %s", cmdutils.IncompatibleFlags)
-	}
-	if cmd.CobraCommand.Flag("vpc-cidr").Changed {
-		return fmt.Errorf("--vpc-private-subnets/--vpc-public-subnets and --vpc-cidr %s", cmdutils.IncompatibleFlags)
-	}
-
-	if params.DryRun {
-		if cfg.VPC.NAT != nil {
-			disableNAT := api.ClusterDisableNAT
-			cfg.VPC.NAT = &api.ClusterNAT{
-				Gateway: &disableNAT,
-			}
+	if subnetsGiven {
+		// use subnets as specified by --vpc-{private,public}-subnets flags
+		if len(params.AvailabilityZones) != 0 {
+			return fmt.Errorf("--vpc-private-subnets/--vpc-public-subnets and --zones %s", cmdutils.IncompatibleFlags)
+		}
+		if cmd.CobraCommand.Flag("vpc-cidr").Changed {
+			return fmt.Errorf("--vpc-private-subnets/--vpc-public-subnets and --vpc-cidr %s", cmdutils.IncompatibleFlags)
 		}
-		return nil
-	}
-
-	if err := vpc.ImportSubnetsFromSpec(ctx, ctl.AWSProvider, cfg); err != nil {
-		return err
-	}
-
-	if err := cfg.HasSufficientSubnets(); err != nil {
-		logger.Critical("unable to use given %s", cfg.SubnetInfo())
-		return err
-	}
-
-	if err := cfg.CanUseForPrivateNodeGroups(); err != nil {
-		return err
 	}
-
-	logger.Success("using existing %s", cfg.SubnetInfo())
-	logger.Warning(customNetworkingNotice)
-	return nil
+	return clusteractions.CreateOrImportVPC(ctx, cfg, ctl, vpcOptions)
 }
 
 func checkSubnetsGivenAsFlags(params *cmdutils.CreateClusterCmdParams) bool {
@@ -24,6 +24,7 @@ import (
 	"k8s.io/client-go/rest"
 	k8stest "k8s.io/client-go/testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/mock"
 
 	corev1 "k8s.io/api/core/v1"
@@ -92,6 +93,7 @@ var _ = Describe("create cluster", func() {
 			Entry("with vpc-public-subnets flag", "--vpc-public-subnets", "10.0.0.0/24"),
 			Entry("with vpc-from-kops-cluster flag", "--vpc-from-kops-cluster", "dummy-kops-cluster"),
 			Entry("with vpc-nat-mode flag", "--vpc-nat-mode", "Single"),
+			Entry("with service-cidr flag", "--service-cidr", "10.100.0.0/16"),
 			// kubeconfig flags
 			Entry("with write-kubeconfig flag", "--write-kubeconfig"),
 			Entry("with kubeconfig flag", "--kubeconfig", "~/.kube"),
@@ -121,6 +123,7 @@ var _ = Describe("create cluster", func() {
 			Entry("with appmesh-access flag", "--appmesh-access", "true"),
 			Entry("with alb-ingress-access flag", "--alb-ingress-access", "true"),
 			Entry("with managed flag unset", "--managed", "false"),
+			Entry("with spot-placement flag", "--spot-placement", "skip"),
 		)
 
 		DescribeTable("invalid flags or arguments",
@@ -139,6 +142,22 @@ var _ = Describe("create cluster", func() {
 				args:  []string{"cluster", "--invalid", "dummy"},
 				error: "unknown flag: --invalid",
 			}),
+			Entry("with invalid spot-placement value", invalidParamsCase{
+				args:  []string{"--spot-placement", "best"},
+				error: `invalid value "best" for --spot-placement, valid values are "recommend", "auto" and "skip"`,
+			}),
+			Entry("with interactive and config file flags", invalidParamsCase{
+				args:  []string{"--interactive", "-f", "cluster.yaml"},
+				error: "cannot use --interactive when --config-file/-f is set",
+			}),
+			Entry("with interactive and a cluster name argument", invalidParamsCase{
+				args:  []string{"--interactive", "clusterName"},
+				error: "cannot use --interactive with a name argument",
+			}),
+			Entry("with interactive and region flags", invalidParamsCase{
+				args:  []string{"--interactive", "--region", "us-west-2"},
+				error: "cannot use --region with --interactive",
+			}),
 		)
 	})
 
@@ -170,6 +189,7 @@ var _ = Describe("create cluster", func() {
 			Entry("with vpc-public-subnets flag", "--vpc-public-subnets", "10.0.0.0/24"),
 			Entry("with vpc-from-kops-cluster flag", "--vpc-from-kops-cluster", "dummy-kops-cluster"),
 			Entry("with vpc-nat-mode flag", "--vpc-nat-mode", "Single"),
+			Entry("with service-cidr flag", "--service-cidr", "10.100.0.0/16"),
 			// kubeconfig flags
 			Entry("with write-kubeconfig flag", "--write-kubeconfig"),
 			Entry("with kubeconfig flag", "--kubeconfig", "~/.kube"),
@@ -277,6 +297,7 @@ var _ = Describe("create cluster", func() {
 			ce.updateClusterConfig(clusterConfig)
 		}
 		cmd := &cmdutils.Cmd{
+			CobraCommand:  &cobra.Command{Use: "cluster"},
 			ClusterConfig: clusterConfig,
 			ProviderConfig: api.ProviderConfig{
 				WaitTimeout: time.Second * 1,
@@ -16,6 +16,8 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createIAMIdentityMappingCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createFargateProfile)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createAddonCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createNodeClassCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createNodePoolCmd)
 
 	return verbCmd
 }
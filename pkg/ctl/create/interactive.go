@@ -0,0 +1,310 @@
+package create
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
+	"github.com/weaveworks/eksctl/pkg/utils/names"
+	utilstrings "github.com/weaveworks/eksctl/pkg/utils/strings"
+)
+
+// defaultAddons are the addons created with every cluster
+var defaultAddons = []string{api.VPCCNIAddon, api.CoreDNSAddon, api.KubeProxyAddon}
+
+// wizard asks for the settings of a cluster, reading the answers from in and writing the questions to out
+type wizard struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func newWizard(in io.Reader, out io.Writer) *wizard {
+	return &wizard{
+		in:  bufio.NewReader(in),
+		out: out,
+	}
+}
+
+// wizardResult is the config of the cluster generated by the wizard, and what to do with it
+type wizardResult struct {
+	clusterConfig *api.ClusterConfig
+	configFile    string
+	create        bool
+}
+
+// run asks for the region, version, VPC, nodegroup and addons of a cluster, suggesting defaults
+func (w *wizard) run(defaultRegion string) (*wizardResult, error) {
+	cfg := &api.ClusterConfig{
+		TypeMeta: api.ClusterConfigTypeMeta(),
+		Metadata: &api.ClusterMeta{},
+	}
+	var err error
+
+	if cfg.Metadata.Name, err = w.ask("Cluster name", names.ForCluster("", ""), nil); err != nil {
+		return nil, err
+	}
+	if cfg.Metadata.Region, err = w.ask("Region", defaultRegion, func(region string) error {
+		if !utilstrings.Contains(api.SupportedRegions(), region) {
+			return fmt.Errorf("unsupported region, use one of: %s", strings.Join(api.SupportedRegions(), ", "))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if cfg.Metadata.Version, err = w.ask("Kubernetes version", api.DefaultVersion, func(version string) error {
+		if !api.IsSupportedVersion(version) {
+			return fmt.Errorf("unsupported version, use one of: %s", strings.Join(api.SupportedVersions(), ", "))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	privateNetworking, err := w.askVPC(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.askNodeGroup(cfg, privateNetworking); err != nil {
+		return nil, err
+	}
+	if err := w.askAddons(cfg); err != nil {
+		return nil, err
+	}
+
+	result := &wizardResult{clusterConfig: cfg}
+	action, err := w.askChoice("Create the cluster now, or only write its config file", []string{"create", "write"}, "write")
+	if err != nil {
+		return nil, err
+	}
+	result.create = action == "create"
+	if result.configFile, err = w.ask("Config file", cfg.Metadata.Name+".yaml", nil); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// askVPC asks whether to create a VPC or to use the subnets of an existing one, and returns whether the nodes
+// should run in private subnets
+func (w *wizard) askVPC(cfg *api.ClusterConfig) (bool, error) {
+	choice, err := w.askChoice("VPC", []string{"new", "existing"}, "new")
+	if err != nil {
+		return false, err
+	}
+	cfg.VPC = &api.ClusterVPC{}
+
+	if choice == "existing" {
+		cfg.VPC.Subnets = &api.ClusterSubnets{}
+		private, err := w.askList("Private subnet IDs (comma-separated)", nil)
+		if err != nil {
+			return false, err
+		}
+		public, err := w.askList("Public subnet IDs (comma-separated)", nil)
+		if err != nil {
+			return false, err
+		}
+		if len(private) == 0 && len(public) == 0 {
+			return false, errors.New("the subnets of an existing VPC must be set")
+		}
+		cfg.VPC.Subnets.Private = subnetMapping(private)
+		cfg.VPC.Subnets.Public = subnetMapping(public)
+		return len(private) > 0, nil
+	}
+
+	defaultCIDR := api.DefaultCIDR()
+	cidr, err := w.ask("VPC CIDR", defaultCIDR.String(), func(s string) error {
+		_, err := ipnet.ParseCIDR(s)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	if cidr != defaultCIDR.String() {
+		if cfg.VPC.CIDR, err = ipnet.ParseCIDR(cidr); err != nil {
+			return false, err
+		}
+	}
+	nat, err := w.askChoice("NAT gateway", []string{api.ClusterSingleNAT, api.ClusterHighlyAvailableNAT, api.ClusterDisableNAT}, api.ClusterSingleNAT)
+	if err != nil {
+		return false, err
+	}
+	cfg.VPC.NAT = &api.ClusterNAT{Gateway: aws.String(nat)}
+	if nat == api.ClusterDisableNAT {
+		// nodes in private subnets without a NAT gateway cannot reach the internet
+		return false, nil
+	}
+	return w.askBool("Run the nodes in private subnets", true)
+}
+
+// askNodeGroup asks for the instance type and the size of a managed nodegroup
+func (w *wizard) askNodeGroup(cfg *api.ClusterConfig, privateNetworking bool) error {
+	create, err := w.askBool("Create a managed nodegroup", true)
+	if err != nil || !create {
+		return err
+	}
+	ng := &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{ScalingConfig: &api.ScalingConfig{}}}
+	if ng.Name, err = w.ask("Nodegroup name", "ng-1", nil); err != nil {
+		return err
+	}
+	if ng.InstanceType, err = w.ask("Instance type", api.DefaultNodeType, nil); err != nil {
+		return err
+	}
+	desired, err := w.askInt("Desired number of nodes", api.DefaultNodeCount, 0)
+	if err != nil {
+		return err
+	}
+	minSize, err := w.askInt("Minimum number of nodes", desired, 0)
+	if err != nil {
+		return err
+	}
+	maxSize, err := w.askInt("Maximum number of nodes", max(desired, minSize), max(desired, minSize, 1))
+	if err != nil {
+		return err
+	}
+	if minSize > desired {
+		return fmt.Errorf("the minimum number of nodes (%d) is greater than the desired number (%d)", minSize, desired)
+	}
+	ng.DesiredCapacity, ng.MinSize, ng.MaxSize = aws.Int(desired), aws.Int(minSize), aws.Int(maxSize)
+	ng.PrivateNetworking = privateNetworking
+	cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+	return nil
+}
+
+// askAddons asks for the addons to create besides the default ones
+func (w *wizard) askAddons(cfg *api.ClusterConfig) error {
+	addons, err := w.askList(fmt.Sprintf("Addons to create besides %s (comma-separated, e.g. %s, %s)",
+		strings.Join(defaultAddons, ", "), api.AWSEBSCSIDriverAddon, api.AWSEFSCSIDriverAddon), nil)
+	if err != nil {
+		return err
+	}
+	for _, name := range addons {
+		if !utilstrings.Contains(defaultAddons, name) {
+			cfg.Addons = append(cfg.Addons, &api.Addon{Name: name})
+		}
+	}
+	if len(cfg.Addons) > 0 {
+		// the addons needing IAM permissions are given them with IAM roles for service accounts
+		cfg.IAM = &api.ClusterIAM{WithOIDC: aws.Bool(true)}
+	}
+	return nil
+}
+
+// ask asks a question until the answer is valid, the default value is used when the answer is empty
+func (w *wizard) ask(question, defaultValue string, validate func(string) error) (string, error) {
+	for {
+		if defaultValue != "" {
+			fmt.Fprintf(w.out, "%s [%s]: ", question, defaultValue)
+		} else {
+			fmt.Fprintf(w.out, "%s: ", question)
+		}
+		line, err := w.in.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return "", fmt.Errorf("reading the answer to %q: %w", question, err)
+		}
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			answer = defaultValue
+		}
+		if validate != nil {
+			if err := validate(answer); err != nil {
+				fmt.Fprintf(w.out, "invalid answer %q: %v\n", answer, err)
+				continue
+			}
+		}
+		return answer, nil
+	}
+}
+
+func (w *wizard) askChoice(question string, choices []string, defaultValue string) (string, error) {
+	return w.ask(fmt.Sprintf("%s (%s)", question, strings.Join(choices, "/")), defaultValue, func(answer string) error {
+		if !utilstrings.Contains(choices, answer) {
+			return fmt.Errorf("use one of: %s", strings.Join(choices, ", "))
+		}
+		return nil
+	})
+}
+
+func (w *wizard) askBool(question string, defaultValue bool) (bool, error) {
+	defaultAnswer := "n"
+	if defaultValue {
+		defaultAnswer = "y"
+	}
+	answer, err := w.askChoice(question, []string{"y", "n"}, defaultAnswer)
+	return answer == "y", err
+}
+
+func (w *wizard) askInt(question string, defaultValue, minValue int) (int, error) {
+	answer, err := w.ask(question, strconv.Itoa(defaultValue), func(answer string) error {
+		n, err := strconv.Atoi(answer)
+		if err != nil {
+			return errors.New("not a number")
+		}
+		if n < minValue {
+			return fmt.Errorf("must be at least %d", minValue)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(answer)
+}
+
+func (w *wizard) askList(question string, defaultValue []string) ([]string, error) {
+	answer, err := w.ask(question, strings.Join(defaultValue, ","), nil)
+	if err != nil {
+		return nil, err
+	}
+	var list []string
+	for _, item := range strings.Split(answer, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list, nil
+}
+
+func subnetMapping(subnetIDs []string) api.AZSubnetMapping {
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	mapping := api.NewAZSubnetMapping()
+	for _, id := range subnetIDs {
+		mapping[id] = api.AZSubnetSpec{ID: id}
+	}
+	return mapping
+}
+
+// runClusterWizard runs the wizard and writes the config file it generates, which is returned when the cluster
+// should be created
+func runClusterWizard(in io.Reader, out io.Writer) (string, bool, error) {
+	defaultRegion := os.Getenv("AWS_REGION")
+	if defaultRegion == "" {
+		defaultRegion = api.DefaultRegion
+	}
+	result, err := newWizard(in, out).run(defaultRegion)
+	if err != nil {
+		return "", false, err
+	}
+
+	f, err := os.OpenFile(result.configFile, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0644)
+	if err != nil {
+		return "", false, fmt.Errorf("writing config file: %w", err)
+	}
+	if err := cmdutils.PrintDryRunConfig(result.clusterConfig, f); err != nil {
+		_ = f.Close()
+		return "", false, fmt.Errorf("writing config file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", false, fmt.Errorf("writing config file: %w", err)
+	}
+	return result.configFile, result.create, nil
+}
@@ -0,0 +1,122 @@
+package create
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("create cluster --interactive", func() {
+	answers := func(lines ...string) *strings.Reader {
+		return strings.NewReader(strings.Join(lines, "\n") + "\n")
+	}
+
+	It("uses the defaults when the answers are empty", func() {
+		out := &bytes.Buffer{}
+		result, err := newWizard(answers("my-cluster", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", ""), out).run("us-west-2")
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := result.clusterConfig
+		Expect(cfg.Metadata.Name).To(Equal("my-cluster"))
+		Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+		Expect(cfg.Metadata.Version).To(Equal(api.DefaultVersion))
+		Expect(cfg.VPC.CIDR).To(BeNil())
+		Expect(*cfg.VPC.NAT.Gateway).To(Equal(api.ClusterSingleNAT))
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+		ng := cfg.ManagedNodeGroups[0]
+		Expect(ng.Name).To(Equal("ng-1"))
+		Expect(ng.InstanceType).To(Equal(api.DefaultNodeType))
+		Expect(*ng.DesiredCapacity).To(Equal(api.DefaultNodeCount))
+		Expect(*ng.MinSize).To(Equal(api.DefaultNodeCount))
+		Expect(*ng.MaxSize).To(Equal(api.DefaultNodeCount))
+		Expect(ng.PrivateNetworking).To(BeTrue())
+		Expect(cfg.Addons).To(BeEmpty())
+		Expect(cfg.IAM).To(BeNil())
+		Expect(result.create).To(BeFalse())
+		Expect(result.configFile).To(Equal("my-cluster.yaml"))
+	})
+
+	It("asks again until the answers are valid", func() {
+		out := &bytes.Buffer{}
+		result, err := newWizard(answers(
+			"my-cluster",
+			"mars-east-1", "eu-west-1",
+			"0.1", "",
+			"maybe", "new",
+			"192.168.0.0/99", "10.0.0.0/16",
+			"", "n",
+			"", "", "t3.medium", "three", "3", "1", "0", "5",
+			"aws-ebs-csi-driver, coredns",
+			"create", "",
+		), out).run("us-west-2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring(`invalid answer "mars-east-1"`))
+		Expect(out.String()).To(ContainSubstring(`invalid answer "0.1"`))
+		Expect(out.String()).To(ContainSubstring(`invalid answer "three"`))
+		Expect(out.String()).To(ContainSubstring(`invalid answer "0": must be at least 3`))
+
+		cfg := result.clusterConfig
+		Expect(cfg.Metadata.Region).To(Equal("eu-west-1"))
+		Expect(cfg.VPC.CIDR.String()).To(Equal("10.0.0.0/16"))
+		ng := cfg.ManagedNodeGroups[0]
+		Expect(ng.InstanceType).To(Equal("t3.medium"))
+		Expect(*ng.DesiredCapacity).To(Equal(3))
+		Expect(*ng.MinSize).To(Equal(1))
+		Expect(*ng.MaxSize).To(Equal(5))
+		Expect(ng.PrivateNetworking).To(BeFalse())
+		Expect(cfg.Addons).To(ConsistOf(&api.Addon{Name: api.AWSEBSCSIDriverAddon}))
+		Expect(*cfg.IAM.WithOIDC).To(BeTrue())
+		Expect(result.create).To(BeTrue())
+	})
+
+	It("uses the subnets of an existing VPC", func() {
+		result, err := newWizard(answers(
+			"my-cluster", "", "",
+			"existing", "subnet-1, subnet-2", "",
+			"n", "", "", "",
+		), &bytes.Buffer{}).run("us-west-2")
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := result.clusterConfig
+		Expect(cfg.VPC.Subnets.Private).To(HaveKeyWithValue("subnet-1", api.AZSubnetSpec{ID: "subnet-1"}))
+		Expect(cfg.VPC.Subnets.Private).To(HaveKeyWithValue("subnet-2", api.AZSubnetSpec{ID: "subnet-2"}))
+		Expect(cfg.VPC.Subnets.Public).To(BeNil())
+		Expect(cfg.VPC.NAT).To(BeNil())
+		Expect(cfg.ManagedNodeGroups).To(BeEmpty())
+	})
+
+	It("fails when the input ends before the wizard does", func() {
+		_, err := newWizard(answers("my-cluster", "us-west-2"), &bytes.Buffer{}).run("us-west-2")
+		Expect(err).To(MatchError(ContainSubstring(`reading the answer to "Kubernetes version"`)))
+	})
+
+	It("writes a config file eksctl can load", func() {
+		configFile := filepath.Join(GinkgoT().TempDir(), "cluster.yaml")
+		GinkgoT().Setenv("AWS_REGION", "eu-north-1")
+		path, create, err := runClusterWizard(answers("my-cluster", "", "", "", "", "", "", "", "", "", "", "", "", "aws-ebs-csi-driver", "", configFile), &bytes.Buffer{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(path).To(Equal(configFile))
+		Expect(create).To(BeFalse())
+
+		data, err := os.ReadFile(configFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(api.Register()).To(Succeed())
+		cfg, err := eks.ParseConfig(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Metadata.Name).To(Equal("my-cluster"))
+		Expect(cfg.Metadata.Region).To(Equal("eu-north-1"))
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+		Expect(cfg.Addons).To(HaveLen(1))
+
+		By("not overwriting an existing file")
+		_, _, err = runClusterWizard(answers("my-cluster", "", "", "", "", "", "", "", "", "", "", "", "", "", "", configFile), &bytes.Buffer{})
+		Expect(err).To(MatchError(ContainSubstring("writing config file")))
+	})
+})
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"strings"
 
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
 
@@ -14,10 +13,10 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
-	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/utils/names"
 )
 
@@ -53,7 +52,7 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 		}
 
 		ctx := context.Background()
-		ctl, err := cmd.NewProviderForExistingClusterHelper(ctx, checkNodeGroupVersion)
+		ctl, err := cmd.NewProviderForExistingClusterHelper(ctx, nodegroup.SetVersion)
 		if err != nil {
 			return fmt.Errorf("could not create cluster provider from options: %w", err)
 		}
@@ -68,7 +67,7 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 		}
 
 		manager := nodegroup.New(cmd.ClusterConfig, ctl, clientSet, selector.New(ctl.AWSProvider.Session()))
-		return manager.Create(ctx, nodegroup.CreateOpts{
+		if err := manager.Create(ctx, nodegroup.CreateOpts{
 			InstallNeuronDevicePlugin: options.InstallNeuronDevicePlugin,
 			InstallNvidiaDevicePlugin: options.InstallNvidiaDevicePlugin,
 			UpdateAuthConfigMap:       options.UpdateAuthConfigMap,
@@ -78,7 +77,16 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 			},
 			SkipOutdatedAddonsCheck: options.SkipOutdatedAddonsCheck,
 			ConfigFileProvided:      cmd.ClusterConfigFile != "",
-		}, ngFilter)
+			QuotaCheck:              preflight.QuotaCheckMode(options.QuotaCheck),
+			EstimateCost:            options.EstimateCost,
+			SpotPlacement:           preflight.SpotPlacementMode(options.SpotPlacement),
+		}, ngFilter); err != nil {
+			return err
+		}
+		if !options.DryRun && !options.EstimateCost {
+			cmdutils.RecordHistory(ctx, cmd, ctl)
+		}
+		return nil
 	})
 }
 
@@ -97,6 +105,12 @@ func createNodeGroupCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc runFn) {
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := preflight.ValidateQuotaCheckMode(preflight.QuotaCheckMode(options.QuotaCheck)); err != nil {
+			return err
+		}
+		if err := preflight.ValidateSpotPlacementMode(preflight.SpotPlacementMode(options.SpotPlacement)); err != nil {
+			return err
+		}
 		return runFunc(cmd, ng, options)
 	}
 
@@ -112,6 +126,9 @@ func createNodeGroupCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc runFn) {
 		cmdutils.AddSubnetIDs(fs, &options.SubnetIDs, "Define an optional list of subnet IDs to create the nodegroup in")
 		fs.BoolVarP(&options.DryRun, "dry-run", "", false, "Dry-run mode that skips nodegroup creation and outputs a ClusterConfig")
 		fs.BoolVarP(&options.SkipOutdatedAddonsCheck, "skip-outdated-addons-check", "", false, "whether the creation of ARM nodegroups should proceed when the cluster addons are outdated")
+		cmdutils.AddQuotaCheckFlag(fs, &options.QuotaCheck)
+		cmdutils.AddEstimateCostFlag(fs, &options.EstimateCost, "nodegroups")
+		cmdutils.AddSpotPlacementFlag(fs, &options.SpotPlacement)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("New nodegroup", func(fs *pflag.FlagSet) {
@@ -128,37 +145,3 @@ func createNodeGroupCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc runFn) {
 
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, true)
 }
-
-func checkNodeGroupVersion(ctl *eks.ClusterProvider, meta *api.ClusterMeta) error {
-	switch meta.Version {
-	case "auto":
-		break
-	case "":
-		meta.Version = "auto"
-	case "default":
-		meta.Version = api.DefaultVersion
-		logger.Info("will use default version (%s) for new nodegroup(s)", meta.Version)
-	case "latest":
-		meta.Version = api.LatestVersion
-		logger.Info("will use latest version (%s) for new nodegroup(s)", meta.Version)
-	default:
-		if !api.IsSupportedVersion(meta.Version) {
-			if api.IsDeprecatedVersion(meta.Version) {
-				return fmt.Errorf("invalid version, %s is no longer supported, supported values: auto, default, latest, %s\nsee also: https://docs.aws.amazon.com/eks/latest/userguide/kubernetes-versions.html", meta.Version, strings.Join(api.SupportedVersions(), ", "))
-			}
-			return fmt.Errorf("invalid version %s, supported values: auto, default, latest, %s", meta.Version, strings.Join(api.SupportedVersions(), ", "))
-		}
-	}
-
-	if v := ctl.ControlPlaneVersion(); v == "" {
-		return fmt.Errorf("unable to get control plane version")
-	} else if meta.Version == "auto" {
-		meta.Version = v
-		logger.Info("will use version %s for new nodegroup(s) based on control plane version", meta.Version)
-	} else if meta.Version != v {
-		hint := "--version=auto"
-		logger.Warning("will use version %s for new nodegroup(s), while control plane version is %s; to automatically inherit the version use %q", meta.Version, v, hint)
-	}
-
-	return nil
-}
@@ -1,17 +1,11 @@
 package create
 
 import (
-	"fmt"
-	"strings"
-
-	"github.com/aws/aws-sdk-go-v2/service/eks/types"
-	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
-	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
 var _ = Describe("create nodegroup", func() {
@@ -59,6 +53,7 @@ var _ = Describe("create nodegroup", func() {
 			Entry("with appmesh-access flag", "--appmesh-access", "true"),
 			Entry("with alb-ingress-access flag", "--alb-ingress-access", "true"),
 			Entry("with subnet-ids flag", "--subnet-ids", "id1,id2,id3"),
+			Entry("with spot-placement flag", "--spot-placement", "auto"),
 		)
 
 		DescribeTable("invalid flags or arguments",
@@ -81,6 +76,10 @@ var _ = Describe("create nodegroup", func() {
 				args:  []string{"--invalid", "dummy"},
 				error: "unknown flag: --invalid",
 			}),
+			Entry("with invalid spot-placement value", invalidParamsCase{
+				args:  []string{"--cluster", "foo", "--spot-placement", "best"},
+				error: `invalid value "best" for --spot-placement, valid values are "recommend", "auto" and "skip"`,
+			}),
 			Entry("with spot flag", invalidParamsCase{
 				args:  []string{"--cluster", "foo", "--spot"},
 				error: "--spot is only valid with managed nodegroups (--managed)",
@@ -171,73 +170,4 @@ var _ = Describe("create nodegroup", func() {
 			}),
 		)
 	})
-
-	type checkNodeGroupVersionInput struct {
-		ctl             *eks.ClusterProvider
-		meta            *api.ClusterMeta
-		expectedVersion string
-		expectedErr     string
-	}
-
-	providerVersion1_23 := &eks.ClusterProvider{
-		Status: &eks.ProviderStatus{
-			ClusterInfo: &eks.ClusterInfo{
-				Cluster: &types.Cluster{
-					Version: aws.String(api.Version1_23),
-				},
-			},
-		},
-	}
-
-	DescribeTable("checkNodeGroupVersion",
-		func(input checkNodeGroupVersionInput) {
-			err := checkNodeGroupVersion(input.ctl, input.meta)
-			if input.expectedErr != "" {
-				Expect(err).To(MatchError(ContainSubstring(input.expectedErr)))
-				return
-			}
-			Expect(err).NotTo(HaveOccurred())
-			Expect(input.meta.Version).To(Equal(input.expectedVersion))
-		},
-		Entry("version is left empty", checkNodeGroupVersionInput{
-			ctl:             providerVersion1_23,
-			meta:            &api.ClusterMeta{},
-			expectedVersion: api.Version1_23,
-		}),
-		Entry("version is set to auto", checkNodeGroupVersionInput{
-			ctl: providerVersion1_23,
-			meta: &api.ClusterMeta{
-				Version: "auto",
-			},
-			expectedVersion: api.Version1_23,
-		}),
-		Entry("version is set to latest", checkNodeGroupVersionInput{
-			ctl: providerVersion1_23,
-			meta: &api.ClusterMeta{
-				Version: "latest",
-			},
-			expectedVersion: api.LatestVersion,
-		}),
-		Entry("version is set to deprecated version", checkNodeGroupVersionInput{
-			meta: &api.ClusterMeta{
-				Version: api.Version1_15,
-			},
-			expectedErr: fmt.Sprintf("invalid version, %s is no longer supported", api.Version1_15),
-		}),
-		Entry("version is set to unsupported version", checkNodeGroupVersionInput{
-			meta: &api.ClusterMeta{
-				Version: "100",
-			},
-			expectedErr: fmt.Sprintf("invalid version 100, supported values: auto, default, latest, %s", strings.Join(api.SupportedVersions(), ", ")),
-		}),
-		Entry("fails to retrieve control plane version", checkNodeGroupVersionInput{
-			ctl: &eks.ClusterProvider{
-				Status: &eks.ProviderStatus{},
-			},
-			meta: &api.ClusterMeta{
-				Version: "auto",
-			},
-			expectedErr: "unable to get control plane version",
-		}),
-	)
 })
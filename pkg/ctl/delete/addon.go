@@ -24,26 +24,27 @@ func deleteAddonCmd(cmd *cmdutils.Cmd) {
 	)
 
 	cmd.ClusterConfig.Addons = []*api.Addon{{}}
-	var preserve bool
 	cmd.FlagSetGroup.InFlagSet("Addon", func(fs *pflag.FlagSet) {
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Name, "name", "", "Addon name")
-		fs.BoolVar(&preserve, "preserve", false, "Delete the addon from the API but preserve its Kubernetes resources")
+		fs.BoolVar(&cmd.ClusterConfig.Addons[0].PreserveOnDelete, "preserve", false, "Delete the addon from the API but preserve its Kubernetes resources")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		cmdutils.AddClusterFlag(fs, cmd.ClusterConfig.Metadata)
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddOwnedOnlyFlag(fs, cmd, "addons")
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return deleteAddon(cmd, preserve)
+		return deleteAddon(cmd)
 	}
 }
 
-func deleteAddon(cmd *cmdutils.Cmd, preserve bool) error {
+func deleteAddon(cmd *cmdutils.Cmd) error {
 	if err := cmdutils.NewDeleteAddonLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -73,9 +74,26 @@ func deleteAddon(cmd *cmdutils.Cmd, preserve bool) error {
 		return err
 	}
 
-	if preserve {
-		return addonManager.DeleteWithPreserve(ctx, cmd.ClusterConfig.Addons[0])
+	for _, a := range cmd.ClusterConfig.Addons {
+		if cmd.OwnedOnly {
+			owned, err := addonManager.IsOwned(ctx, a)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				logger.Info("addon %q was not created by eksctl, leaving it untouched", a.Name)
+				continue
+			}
+		}
+		if a.PreserveOnDelete {
+			err = addonManager.DeleteWithPreserve(ctx, a)
+		} else {
+			err = addonManager.Delete(ctx, a)
+		}
+		if err != nil {
+			return err
+		}
 	}
-
-	return addonManager.Delete(ctx, cmd.ClusterConfig.Addons[0])
+	cmdutils.RecordHistory(ctx, cmd, clusterProvider)
+	return nil
 }
@@ -0,0 +1,33 @@
+package delete
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("delete addon", func() {
+
+	type deleteAddonEntry struct {
+		args        []string
+		expectedErr string
+	}
+
+	DescribeTable("invalid arguments", func(e deleteAddonEntry) {
+		cmd := newDefaultCmd(append([]string{"addon"}, e.args...)...)
+		_, err := cmd.execute()
+		Expect(err).To(MatchError(ContainSubstring(e.expectedErr)))
+	},
+		Entry("missing required flag --cluster", deleteAddonEntry{
+			expectedErr: "Error: --cluster must be set",
+			args:        []string{"--name", "vpc-cni"},
+		}),
+		Entry("missing addon name", deleteAddonEntry{
+			expectedErr: "Error: must specify addon name",
+			args:        []string{"--cluster", "test", "--preserve"},
+		}),
+		Entry("setting --preserve and --config-file at the same time", deleteAddonEntry{
+			expectedErr: "Error: cannot use --preserve when --config-file/-f is set",
+			args:        []string{"--preserve", "--config-file", "../../../examples/25-addons.yaml"},
+		}),
+	)
+})
@@ -0,0 +1,75 @@
+package delete
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/weaveworks/eksctl/pkg/actions/automode"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func deleteNodeClassCmd(cmd *cmdutils.Cmd) {
+	deleteAutoModeResourceCmd(cmd, "nodeclass", "Delete Auto Mode NodeClass(es)", automode.NodeClassGVK, automode.NodeClasses)
+}
+
+func deleteNodePoolCmd(cmd *cmdutils.Cmd) {
+	deleteAutoModeResourceCmd(cmd, "nodepool", "Delete Auto Mode NodePool(s)", automode.NodePoolGVK, automode.NodePools)
+}
+
+func deleteAutoModeResourceCmd(cmd *cmdutils.Cmd, use, description string, gvk schema.GroupVersionKind, render func(*api.ClusterConfig) []*unstructured.Unstructured) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(use, description, "When a config file is given, the "+gvk.Kind+"s it defines are deleted")
+
+	var name string
+	cmd.FlagSetGroup.InFlagSet("Auto Mode", func(fs *pflag.FlagSet) {
+		fs.StringVar(&name, "name", "", "Name of the "+gvk.Kind+" to delete")
+	})
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cmd.ClusterConfig.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewDeleteAutoModeResourceLoader(cmd, &name).Load(); err != nil {
+			return err
+		}
+		return doDeleteAutoModeResource(cmd, gvk, name, render)
+	}
+}
+
+func doDeleteAutoModeResource(cmd *cmdutils.Cmd, gvk schema.GroupVersionKind, name string, render func(*api.ClusterConfig) []*unstructured.Unstructured) error {
+	var objects []*unstructured.Unstructured
+	if cmd.ClusterConfigFile != "" {
+		var err error
+		if objects, err = automode.Filter(render(cmd.ClusterConfig), gvk.Kind, name); err != nil {
+			return err
+		}
+		if len(objects) == 0 {
+			logger.Info("no %ss defined in the config file", gvk.Kind)
+			return nil
+		}
+	} else {
+		objects = []*unstructured.Unstructured{automode.NewObject(gvk, name)}
+	}
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	manager, err := automode.NewFromProvider(ctx, cmd.ClusterConfig, ctl)
+	if err != nil {
+		return err
+	}
+	return manager.Delete(objects)
+}
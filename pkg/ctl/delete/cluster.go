@@ -2,9 +2,12 @@ package delete
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/hooks"
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
@@ -12,16 +15,17 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 func deleteClusterCmd(cmd *cmdutils.Cmd) {
-	deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int) error {
-		return doDeleteCluster(cmd, force, disableNodegroupEviction, podEvictionWaitPeriod, parallel)
+	deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int, cleanupOrphans bool, retain []string) error {
+		return doDeleteCluster(cmd, force, disableNodegroupEviction, podEvictionWaitPeriod, parallel, cleanupOrphans, retain)
 	})
 }
 
-func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int) error) {
+func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int, cleanupOrphans bool, retain []string) error) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
@@ -32,10 +36,12 @@ func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd,
 		disableNodegroupEviction bool
 		podEvictionWaitPeriod    time.Duration
 		parallel                 int
+		cleanupOrphans           bool
+		retain                   []string
 	)
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, force, disableNodegroupEviction, podEvictionWaitPeriod, parallel)
+		return runFunc(cmd, force, disableNodegroupEviction, podEvictionWaitPeriod, parallel, cleanupOrphans, retain)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -49,6 +55,11 @@ func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd,
 		defaultPodEvictionWaitPeriod, _ := time.ParseDuration("10s")
 		fs.DurationVar(&podEvictionWaitPeriod, "pod-eviction-wait-period", defaultPodEvictionWaitPeriod, "Duration to wait after failing to evict a pod")
 		fs.IntVar(&parallel, "parallel", 1, "Number of nodes to drain in parallel. Max 25")
+		fs.BoolVar(&cleanupOrphans, "cleanup-orphaned-resources", false, "Delete the load balancers, network interfaces and security groups left behind in the cluster VPC by controllers running in the cluster")
+
+		fs.StringSliceVar(&retain, "retain", nil, fmt.Sprintf("Resources created by eksctl to keep when deleting the cluster, any of %s", strings.Join(cluster.RetainableResources, ", ")))
+		fs.BoolVar(&cmd.Plan, "plan", false, "List the stacks and resources that would be deleted without deleting anything")
+		cmdutils.AddOwnedOnlyFlag(fs, cmd, "a cluster")
 
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
@@ -57,10 +68,16 @@ func deleteClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd,
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, true)
 }
 
-func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int) error {
+func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int, cleanupOrphans bool, retain []string) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
+	if err := cluster.ValidateRetainedResources(retain); err != nil {
+		return err
+	}
+	if err := api.ValidateHooks(cmd.ClusterConfig.Hooks); err != nil {
+		return err
+	}
 
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
@@ -80,11 +97,41 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction boo
 		}
 	}
 
+	stackManager := ctl.NewStackManager(cfg)
+	if err := cluster.CheckDeletionProtection(ctx, stackManager, meta.Name); err != nil {
+		return err
+	}
+	if cmd.OwnedOnly {
+		if err := cluster.CheckOwned(ctx, stackManager, ctl.AWSProvider.EKS(), meta.Name); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Plan {
+		if cfg.Hooks != nil && len(cfg.Hooks.PreDelete) > 0 {
+			cmdutils.LogIntendedAction(true, "run %d preDelete hook(s)", len(cfg.Hooks.PreDelete))
+		}
+		cluster, err := cluster.New(ctx, cfg, ctl)
+		if err != nil {
+			return err
+		}
+		return cluster.PlanDelete(ctx, cmd.Wait, force, cleanupOrphans, retain)
+	}
+
 	logger.Info("deleting EKS cluster %q", meta.Name)
 	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
 		return err
 	}
 
+	if cfg.Hooks != nil && len(cfg.Hooks.PreDelete) > 0 {
+		if err := runPreDeleteHooks(ctl, cfg); err != nil {
+			if !force {
+				return err
+			}
+			logger.Warning("%v; force = true skipping", err)
+		}
+	}
+
 	cluster, err := cluster.New(ctx, cfg, ctl)
 	if err != nil {
 		return err
@@ -92,5 +139,16 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction boo
 
 	// ProviderConfig.WaitTimeout is not respected by cluster.Delete, which means the operation will never time out.
 	// When this is fixed, a deadline-based Context can be used here.
-	return cluster.Delete(ctx, 20*time.Second, podEvictionWaitPeriod, cmd.Wait, force, disableNodegroupEviction, parallel)
+	if err := cluster.Delete(ctx, 20*time.Second, podEvictionWaitPeriod, cmd.Wait, force, disableNodegroupEviction, cleanupOrphans, parallel, retain); err != nil {
+		return err
+	}
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
+}
+
+func runPreDeleteHooks(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) error {
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return fmt.Errorf("cannot run preDelete hooks: %w", err)
+	}
+	return hooks.RunForCluster(ctl, cfg, hooks.PreDelete, cfg.Hooks.PreDelete)
 }
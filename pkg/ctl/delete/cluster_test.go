@@ -15,14 +15,15 @@ const (
 
 var _ = Describe("delete cluster", func() {
 	DescribeTable("should be called to delete the cluster",
-		func(forceExpected bool, disableNodegroupEvictionExpected bool, args ...string) {
+		func(forceExpected bool, disableNodegroupEvictionExpected bool, cleanupOrphansExpected bool, args ...string) {
 			cmd := newMockEmptyCmd(args...)
 			count := 0
 			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-				deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int) error {
+				deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, force bool, disableNodegroupEviction bool, podEvictionWaitPeriod time.Duration, parallel int, cleanupOrphans bool, _ []string) error {
 					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal(clusterName))
 					Expect(force).To(Equal(forceExpected))
 					Expect(disableNodegroupEviction).To(Equal(disableNodegroupEvictionExpected))
+					Expect(cleanupOrphans).To(Equal(cleanupOrphansExpected))
 					count++
 					return nil
 				})
@@ -31,9 +32,52 @@ var _ = Describe("delete cluster", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(count).To(Equal(1))
 		},
-		Entry("with only valid cluster name", false, false, "cluster", "--name", clusterName),
-		Entry("with valid cluster name and force flag", true, false, "cluster", "--name", clusterName, "--force"),
-		Entry("with valid cluster name and disableNodeGroupEviction flag", false, true, "cluster", "--name", clusterName, "--disable-nodegroup-eviction"),
-		Entry("with valid cluster name, force & disableNodeGroupEviction flags", true, true, "cluster", "--name", clusterName, "--force", "--disable-nodegroup-eviction"),
+		Entry("with only valid cluster name", false, false, false, "cluster", "--name", clusterName),
+		Entry("with valid cluster name and force flag", true, false, false, "cluster", "--name", clusterName, "--force"),
+		Entry("with valid cluster name and disableNodeGroupEviction flag", false, true, false, "cluster", "--name", clusterName, "--disable-nodegroup-eviction"),
+		Entry("with valid cluster name, force & disableNodeGroupEviction flags", true, true, false, "cluster", "--name", clusterName, "--force", "--disable-nodegroup-eviction"),
+		Entry("with valid cluster name and cleanupOrphanedResources flag", false, false, true, "cluster", "--name", clusterName, "--cleanup-orphaned-resources"),
+	)
+
+	DescribeTable("sets plan mode only when --plan is set",
+		func(planExpected bool, args ...string) {
+			cmd := newMockEmptyCmd(args...)
+			count := 0
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+				deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, _ bool, _ bool, _ time.Duration, _ int, _ bool, _ []string) error {
+					Expect(cmd.Plan).To(Equal(planExpected))
+					count++
+					return nil
+				})
+			})
+			_, err := cmd.execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		},
+		Entry("without --plan", false, "cluster", "--name", clusterName),
+		Entry("with --plan", true, "cluster", "--name", clusterName, "--plan"),
+	)
+
+	DescribeTable("parses the resources to retain",
+		func(retainExpected []string, args ...string) {
+			cmd := newMockEmptyCmd(args...)
+			count := 0
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+				deleteClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, _ bool, _ bool, _ time.Duration, _ int, _ bool, retain []string) error {
+					if retainExpected == nil {
+						Expect(retain).To(BeEmpty())
+					} else {
+						Expect(retain).To(Equal(retainExpected))
+					}
+					count++
+					return nil
+				})
+			})
+			_, err := cmd.execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		},
+		Entry("without --retain", nil, "cluster", "--name", clusterName),
+		Entry("with --retain", []string{"vpc", "iam-oidc-provider"}, "cluster", "--name", clusterName, "--retain", "vpc,iam-oidc-provider"),
 	)
 })
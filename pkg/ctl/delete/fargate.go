@@ -46,6 +46,7 @@ func configureDeleteFargateProfileCmd(cmd *cmdutils.Cmd) *fargate.Options {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "wait for the deletion of the Fargate profile, which may take from a couple seconds to a couple minutes.")
+		cmdutils.AddOwnedOnlyFlag(fs, cmd, "a Fargate profile")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
@@ -61,6 +62,15 @@ func doDeleteFargateProfile(cmd *cmdutils.Cmd, opts *fargate.Options) error {
 
 	clusterName := cmd.ClusterConfig.Metadata.Name
 	manager := fargate.NewFromProvider(clusterName, ctl.AWSProvider, ctl.NewStackManager(cmd.ClusterConfig))
+	if cmd.OwnedOnly {
+		profile, err := manager.ReadProfile(ctx, opts.ProfileName)
+		if err != nil {
+			return err
+		}
+		if profile.Tags[api.CreatedByTag] != api.CreatedByTagValue {
+			return fmt.Errorf("refusing to delete Fargate profile %q with --owned-only, it was not created by eksctl", opts.ProfileName)
+		}
+	}
 	if cmd.Wait {
 		logger.Info(deletingFargateProfileMsg(clusterName, opts.ProfileName))
 	} else {
@@ -70,6 +80,7 @@ func doDeleteFargateProfile(cmd *cmdutils.Cmd, opts *fargate.Options) error {
 		return err
 	}
 	logger.Info("deleted Fargate profile %q on EKS cluster %q", opts.ProfileName, clusterName)
+	cmdutils.RecordHistory(ctx, cmd, ctl)
 	return nil
 }
 
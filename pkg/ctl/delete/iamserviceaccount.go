@@ -46,6 +46,7 @@ func deleteIAMServiceAccountCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *
 
 		cmdutils.AddIAMServiceAccountFilterFlags(fs, &cmd.Include, &cmd.Exclude)
 		fs.BoolVar(&onlyMissing, "only-missing", false, "Only delete iamserviceaccounts that are not defined in the given config file")
+		cmdutils.AddOwnedOnlyFlag(fs, cmd, "iamserviceaccounts")
 		cmdutils.AddApproveFlag(fs, cmd)
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
@@ -107,6 +108,12 @@ func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAM
 		}
 	}
 
+	if cmd.OwnedOnly {
+		if err := saFilter.SetOwnedOnly(ctx, stackManager, cfg); err != nil {
+			return err
+		}
+	}
+
 	saFilter.LogInfo(cfg.IAM.ServiceAccounts)
 
 	saSubset, _ := saFilter.MatchAll(cfg.IAM.ServiceAccounts)
@@ -116,5 +123,9 @@ func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAM
 	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
 		return err
 	}
-	return irsaManager.Delete(ctx, saSubset.List(), cmd.Plan, cmd.Wait)
+	if err := irsaManager.Delete(ctx, saSubset.List(), cmd.Plan, cmd.Wait); err != nil {
+		return err
+	}
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
 }
@@ -17,15 +17,16 @@ import (
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/drain"
 )
 
 func deleteNodeGroupCmd(cmd *cmdutils.Cmd) {
-	deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int) error {
-		return doDeleteNodeGroup(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, podEvictionWaitPeriod, disableEviction, parallel)
+	deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, evictionOptions drain.EvictionOptions) error {
+		return doDeleteNodeGroup(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, podEvictionWaitPeriod, disableEviction, parallel, evictionOptions)
 	})
 }
 
-func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int) error) {
+func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, evictionOptions drain.EvictionOptions) error) {
 	cfg := api.NewClusterConfig()
 	ng := api.NewNodeGroup()
 	cmd.ClusterConfig = cfg
@@ -38,13 +39,20 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 		podEvictionWaitPeriod time.Duration
 		disableEviction       bool
 		parallel              int
+		evictionOptions       drain.EvictionOptions
 	)
 
 	cmd.SetDescription("nodegroup", "Delete a nodegroup", "", "ng")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, podEvictionWaitPeriod, disableEviction, parallel)
+		if err := evictionOptions.PDBTimeoutBehavior.Validate(); err != nil {
+			return err
+		}
+		if evictionOptions.DeleteAfterFailedEvictions < 0 {
+			return fmt.Errorf("--delete-after-failed-evictions must not be negative")
+		}
+		return runFunc(cmd, ng, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing, maxGracePeriod, podEvictionWaitPeriod, disableEviction, parallel, evictionOptions)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -55,6 +63,7 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 		cmdutils.AddApproveFlag(fs, cmd)
 		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
 		fs.BoolVar(&onlyMissing, "only-missing", false, "Only delete nodegroups that are not defined in the given config file")
+		cmdutils.AddOwnedOnlyFlag(fs, cmd, "nodegroups")
 		cmdutils.AddUpdateAuthConfigMap(fs, &updateAuthConfigMap, "Remove nodegroup IAM role from aws-auth configmap")
 		fs.BoolVar(&deleteNodeGroupDrain, "drain", true, "Drain and cordon all nodes in the nodegroup before deletion")
 		defaultMaxGracePeriod, _ := time.ParseDuration("10m")
@@ -64,6 +73,12 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 		defaultDisableEviction := false
 		fs.BoolVar(&disableEviction, "disable-eviction", defaultDisableEviction, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
 		fs.IntVar(&parallel, "parallel", 1, "Number of nodes to drain in parallel. Max 25")
+		fs.DurationVar(&evictionOptions.PodGracePeriod, "pod-grace-period", 0, "Termination grace period of evicted pods, overriding the one in their spec and capped by --max-grace-period")
+		fs.DurationVar(&evictionOptions.PDBTimeout, "pdb-timeout", 0, "Duration to retry evicting a pod blocked by a PodDisruptionBudget before applying --pdb-timeout-behavior")
+		fs.StringVar((*string)(&evictionOptions.PDBTimeoutBehavior), "pdb-timeout-behavior", string(drain.PDBTimeoutBehaviorBlock), "What to do with pods still blocked by a PodDisruptionBudget after --pdb-timeout: block (keep retrying), skip (leave them on the node) or force (delete them)")
+		fs.DurationVar(&evictionOptions.NodeEvictionTimeout, "pod-eviction-timeout", 0, "Maximum duration to evict the pods of a single node before failing, 0 means until --timeout")
+		fs.IntVar(&evictionOptions.DeleteAfterFailedEvictions, "delete-after-failed-evictions", 0, "Number of failed evictions of a pod after which it is deleted instead, bypassing PodDisruptionBudgets, 0 means never")
+		fs.BoolVar(&evictionOptions.SkipPodWarnings, "skip-pod-warnings", false, "Don't log warnings about the pods being evicted, e.g. pods not managed by a controller or using local storage")
 
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
@@ -73,7 +88,7 @@ func deleteNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cm
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, true)
 }
 
-func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int) error {
+func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, evictionOptions drain.EvictionOptions) error {
 	ngFilter := filter.NewNodeGroupFilter()
 
 	if err := cmdutils.NewDeleteAndDrainNodeGroupLoader(cmd, ng, ngFilter).Load(); err != nil {
@@ -114,6 +129,12 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 		}
 	}
 
+	if cmd.OwnedOnly {
+		if err := ngFilter.SetOwnedOnly(ctx, stackManager, cfg); err != nil {
+			return err
+		}
+	}
+
 	logFiltered := cmdutils.ApplyFilter(cfg, ngFilter)
 
 	logFiltered()
@@ -141,6 +162,7 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 			PodEvictionWaitPeriod: podEvictionWaitPeriod,
 			DisableEviction:       disableEviction,
 			Parallel:              parallel,
+			EvictionOptions:       evictionOptions,
 		}
 		ctx, cancel := context.WithTimeout(ctx, cmd.ProviderConfig.WaitTimeout)
 		defer cancel()
@@ -175,5 +197,6 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 
 	cmdutils.LogPlanModeWarning(cmd.Plan && len(allNodeGroups) > 0)
 
+	cmdutils.RecordHistory(ctx, cmd, ctl)
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
 )
 
 type invalidParamsCase struct {
@@ -22,7 +23,7 @@ var _ = Describe("delete", func() {
 			cmd := newMockEmptyCmd(args...)
 			count := 0
 			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-				deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int) error {
+				deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, evictionOptions drain.EvictionOptions) error {
 					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("clusterName"))
 					Expect(ng.Name).To(Equal("ng"))
 					count++
@@ -37,6 +38,28 @@ var _ = Describe("delete", func() {
 		Entry("with deprecated flag --only", "nodegroup", "--cluster", "clusterName", "--name", "ng", "--only", "ng"),
 	)
 
+	It("parses the eviction options", func() {
+		cmd := newMockEmptyCmd("nodegroup", "--cluster", "clusterName", "--name", "ng", "--pod-grace-period", "30s", "--pod-eviction-timeout", "10m",
+			"--delete-after-failed-evictions", "5", "--skip-pod-warnings")
+		count := 0
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+			deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod, podEvictionWaitPeriod time.Duration, disableEviction bool, parallel int, evictionOptions drain.EvictionOptions) error {
+				Expect(evictionOptions).To(Equal(drain.EvictionOptions{
+					PodGracePeriod:             30 * time.Second,
+					PDBTimeoutBehavior:         drain.PDBTimeoutBehaviorBlock,
+					NodeEvictionTimeout:        10 * time.Minute,
+					DeleteAfterFailedEvictions: 5,
+					SkipPodWarnings:            true,
+				}))
+				count++
+				return nil
+			})
+		})
+		_, err := cmd.execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+	})
+
 	DescribeTable("invalid flags or arguments",
 		func(c invalidParamsCase) {
 			cmd := newDefaultCmd(c.args...)
@@ -61,5 +84,13 @@ var _ = Describe("delete", func() {
 			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--parallel", "26"},
 			error: fmt.Errorf("Error: --parallel value must be of range 1-25"),
 		}),
+		Entry("setting --delete-after-failed-evictions below 0", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--delete-after-failed-evictions", "-1"},
+			error: fmt.Errorf("Error: --delete-after-failed-evictions must not be negative"),
+		}),
+		Entry("setting an unknown --pdb-timeout-behavior", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--pdb-timeout-behavior", "evict"},
+			error: fmt.Errorf(`Error: invalid PDB timeout behavior "evict", must be one of block, skip, force`),
+		}),
 	)
 })
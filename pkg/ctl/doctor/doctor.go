@@ -0,0 +1,135 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/doctor"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+const textOutput = "text"
+
+type doctorOptions struct {
+	output string
+	bundle string
+}
+
+// Command creates the `doctor` command
+func Command(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options doctorOptions
+
+	cmd.SetDescription("doctor", "Diagnose the health of a cluster",
+		"Checks the health of the control plane, the health issues of the managed nodegroups, the events of the failed "+
+			"stacks, the identities mapped in the aws-auth ConfigMap and the access entries, the IAM OIDC provider and the "+
+			"health of the addons. Exits with a non-zero code when any check fails. With --bundle, writes what was found "+
+			"to a support bundle, with account IDs redacted, to attach to a support case.")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doDoctor(cmd, options, cmd.CobraCommand.OutOrStdout())
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&options.bundle, "bundle", "", "write a support bundle to this file, a gzipped tarball")
+		fs.StringVarP(&options.output, "output", "o", textOutput, "specifies the output format (valid option: text, json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doDoctor(cmd *cmdutils.Cmd, options doctorOptions, w io.Writer) error {
+	var printer printers.OutputPrinter
+	if options.output != textOutput {
+		var err error
+		if printer, err = printers.NewPrinter(printers.Type(options.output)); err != nil {
+			return err
+		}
+	}
+
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+	cluster := ctl.Status.ClusterInfo.Cluster
+
+	var clientSet kubernetes.Interface
+	if cluster.Status == ekstypes.ClusterStatusActive {
+		if clientSet, err = ctl.NewStdClientSet(cfg); err != nil {
+			logger.Warning("unable to create a Kubernetes client, the checks that need it are skipped: %v", err)
+			clientSet = nil
+		}
+	}
+
+	d := doctor.NewDoctor(cfg, ctl.AWSProvider, cluster, clientSet)
+	result := d.Run(ctx)
+
+	if printer != nil {
+		if err := printer.PrintObj(result, w); err != nil {
+			return err
+		}
+	} else {
+		for _, check := range result.Checks {
+			switch check.Status {
+			case preflight.StatusPass:
+				logger.Success("%s: %s", check.Kind, check.Message)
+			case preflight.StatusWarn:
+				logger.Warning("%s: %s", check.Kind, check.Message)
+			default:
+				logger.Critical("%s: %s", check.Kind, check.Message)
+			}
+		}
+	}
+
+	if options.bundle != "" {
+		if err := writeBundle(d, result, options.bundle); err != nil {
+			return fmt.Errorf("writing support bundle: %w", err)
+		}
+		logger.Info("wrote support bundle %q", options.bundle)
+	}
+
+	if !result.Healthy {
+		return exitcode.Wrap(exitcode.Validation, fmt.Errorf("cluster %q is not healthy", cfg.Metadata.Name))
+	}
+	if printer == nil {
+		logger.Success("cluster %q is healthy", cfg.Metadata.Name)
+	}
+	return nil
+}
+
+func writeBundle(d *doctor.Doctor, result *doctor.Result, path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := d.WriteBundle(f, result); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,88 @@
+package enable
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/argocd"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers/helm"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+func enableArgoCD(cmd *cmdutils.Cmd) {
+	configureAndRunArgoCD(cmd, argoCDInstall)
+}
+
+func configureAndRunArgoCD(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(
+		"argocd",
+		"Set up Argo CD - deploys Argo CD and an app-of-apps Application synced from a Git repository",
+		"",
+	)
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+
+		if cmd.NameArg != "" {
+			return cmdutils.ErrUnsupportedNameArg()
+		}
+
+		if err := cmdutils.NewArgoCDConfigLoader(cmd).Load(); err != nil {
+			return err
+		}
+
+		return runFunc(cmd)
+	}
+}
+
+func argoCDInstall(cmd *cmdutils.Cmd) error {
+	logger.Info("eksctl version %s", version.GetVersion())
+	logger.Info("will install Argo CD on cluster %s", cmd.ClusterConfig.Metadata.Name)
+	if err := argocd.CheckRepositoryCredentials(cmd.ClusterConfig.GitOps.ArgoCD); err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if ok, err := ctl.CanOperate(cmd.ClusterConfig); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cmd.ClusterConfig)
+	if err != nil {
+		return err
+	}
+	namespace := argocd.Namespace(cmd.ClusterConfig.GitOps.ArgoCD)
+	restClientGetter, err := ctl.NewRESTClientGetter(cmd.ClusterConfig, namespace)
+	if err != nil {
+		return err
+	}
+	helmInstaller, err := helm.NewInstaller(helm.Options{
+		Namespace:        namespace,
+		RESTClientGetter: restClientGetter,
+	})
+	if err != nil {
+		return err
+	}
+
+	installer, err := argocd.New(helmInstaller, clientSet, cmd.ClusterConfig.GitOps)
+	if err != nil {
+		return err
+	}
+	return installer.Run(ctx)
+}
@@ -0,0 +1,100 @@
+package enable
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
+)
+
+var _ = Describe("enable argocd", func() {
+	var mockEnableArgoCDCmd func(args ...string) *ctltest.MockCmd
+
+	BeforeEach(func() {
+		mockEnableArgoCDCmd = func(args ...string) *ctltest.MockCmd {
+			return ctltest.NewMockCmd(configureAndRunArgoCD, "enable", args...)
+		}
+	})
+
+	When("--config-file is not provided", func() {
+		It("should fail", func() {
+			cmd := mockEnableArgoCDCmd("argocd")
+			_, err := cmd.Execute()
+			Expect(err).To(MatchError("--config-file/-f <file> must be set"))
+		})
+	})
+
+	When("name arg is provided", func() {
+		It("should fail", func() {
+			cmd := mockEnableArgoCDCmd("argocd", "foo")
+			_, err := cmd.Execute()
+			Expect(err).To(MatchError("name argument is not supported"))
+		})
+	})
+
+	When("--config-file is provided", func() {
+		var (
+			configFile string
+			cfg        *api.ClusterConfig
+
+			cmd *ctltest.MockCmd
+			err error
+		)
+
+		BeforeEach(func() {
+			cfg = &api.ClusterConfig{
+				TypeMeta: api.ClusterConfigTypeMeta(),
+				Metadata: &api.ClusterMeta{
+					Name:   "cluster-1",
+					Region: "us-west-2",
+				},
+				GitOps: &api.GitOps{
+					ArgoCD: &api.ArgoCD{
+						Repository: &api.ArgoCDRepository{
+							URL:  "https://github.com/org/platform.git",
+							Path: "apps",
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			configFile = ctltest.CreateConfigFile(cfg)
+			cmd = mockEnableArgoCDCmd("argocd", "-f", configFile)
+			_, err = cmd.Execute()
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(configFile)).To(Succeed())
+		})
+
+		It("succeeds with the basic configuration", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd.Cmd.ClusterConfig.GitOps.ArgoCD.Repository.URL).To(Equal("https://github.com/org/platform.git"))
+		})
+
+		When("gitops.argocd is not provided", func() {
+			BeforeEach(func() {
+				cfg.GitOps.ArgoCD = nil
+			})
+
+			It("fails", func() {
+				Expect(err).To(MatchError("gitops.argocd must be set"))
+			})
+		})
+
+		When("gitops.argocd.repository.path is not provided", func() {
+			BeforeEach(func() {
+				cfg.GitOps.ArgoCD.Repository.Path = ""
+			})
+
+			It("fails", func() {
+				Expect(err).To(MatchError("gitops.argocd.repository.path must be set"))
+			})
+		})
+	})
+})
@@ -3,7 +3,6 @@ package get
 import (
 	"context"
 	"fmt"
-	"os"
 
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 
@@ -28,9 +27,13 @@ func getAddonCmd(cmd *cmdutils.Cmd) {
 		"addons",
 	)
 
-	var a api.Addon
+	var (
+		a         api.Addon
+		available bool
+	)
 	cmd.FlagSetGroup.InFlagSet("Addon", func(fs *pflag.FlagSet) {
 		fs.StringVar(&a.Name, "name", "", "Addon name")
+		fs.BoolVar(&available, "available", false, "List the addons that are compatible with the cluster and not installed")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -44,17 +47,19 @@ func getAddonCmd(cmd *cmdutils.Cmd) {
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return getAddon(cmd, &a, params)
+		return getAddon(cmd, &a, params, available)
 	}
 }
 
-func getAddon(cmd *cmdutils.Cmd, a *api.Addon, params *getCmdParams) error {
+func getAddon(cmd *cmdutils.Cmd, a *api.Addon, params *getCmdParams, available bool) error {
 	if err := cmdutils.NewGetAddonsLoader(cmd).Load(); err != nil {
 		return err
 	}
-	if params.output != printers.TableType {
-		//log warnings and errors to stdout
-		logger.Writer = os.Stderr
+	if available && a.Name != "" {
+		return fmt.Errorf("--available and --name cannot be used together")
+	}
+	if err := setupOutput(params.output); err != nil {
+		return err
 	}
 
 	ctx := context.Background()
@@ -82,6 +87,10 @@ func getAddon(cmd *cmdutils.Cmd, a *api.Addon, params *getCmdParams) error {
 		return err
 	}
 
+	if available {
+		return getAvailableAddons(ctx, cmd, addonManager, params)
+	}
+
 	var summaries []addon.Summary
 	if a.Name == "" {
 		summaries, err = addonManager.GetAll(ctx)
@@ -113,16 +122,34 @@ func getAddon(cmd *cmdutils.Cmd, a *api.Addon, params *getCmdParams) error {
 		return err
 	}
 
-	// if getting a particular addon, print the issue.
-	if a.Name != "" {
+	// if getting a particular addon, print the issue; they are already part of the other output formats
+	if a.Name != "" && params.output == printers.TableType {
 		for _, issue := range summaries[0].Issues {
-			fmt.Printf("Issue: %+v\n", issue)
+			fmt.Fprintf(cmd.CobraCommand.OutOrStdout(), "Issue: %+v\n", issue)
 		}
 	}
 
 	return nil
 }
 
+func getAvailableAddons(ctx context.Context, cmd *cmdutils.Cmd, addonManager *addon.Manager, params *getCmdParams) error {
+	availableAddons, err := addonManager.GetAvailable(ctx)
+	if err != nil {
+		return err
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == printers.TableType {
+		addAvailableAddonTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("addons", availableAddons, cmd.CobraCommand.OutOrStdout())
+}
+
 func addAddonSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("NAME", func(s addon.Summary) string {
 		return s.Name
@@ -142,7 +169,34 @@ func addAddonSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("UPDATE AVAILABLE", func(s addon.Summary) string {
 		return s.NewerVersion
 	})
+	printer.AddColumn("DEFAULT VERSION", func(s addon.Summary) string {
+		return s.DefaultVersion
+	})
+	printer.AddColumn("LATEST VERSION", func(s addon.Summary) string {
+		return s.LatestVersion
+	})
 	printer.AddColumn("CONFIGURATION VALUES", func(s addon.Summary) string {
 		return s.ConfigurationValues
 	})
 }
+
+func addAvailableAddonTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(a addon.AvailableAddon) string {
+		return a.Name
+	})
+	printer.AddColumn("TYPE", func(a addon.AvailableAddon) string {
+		return a.Type
+	})
+	printer.AddColumn("OWNER", func(a addon.AvailableAddon) string {
+		return a.Owner
+	})
+	printer.AddColumn("PUBLISHER", func(a addon.AvailableAddon) string {
+		return a.Publisher
+	})
+	printer.AddColumn("DEFAULT VERSION", func(a addon.AvailableAddon) string {
+		return a.DefaultVersion
+	})
+	printer.AddColumn("LATEST VERSION", func(a addon.AvailableAddon) string {
+		return a.LatestVersion
+	})
+}
@@ -32,5 +32,9 @@ var _ = Describe("get addon", func() {
 			expectedErr: "Error: cannot use --cluster when --config-file/-f is set",
 			args:        []string{"--cluster", "test", "--config-file", "../../../examples/01-simple-cluster.yaml"},
 		}),
+		Entry("setting --available and --name at the same time", getAddonEntry{
+			expectedErr: "Error: --available and --name cannot be used together",
+			args:        []string{"--cluster", "test", "--available", "--name", "kube-proxy"},
+		}),
 	)
 })
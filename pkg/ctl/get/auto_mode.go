@@ -0,0 +1,99 @@
+package get
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/weaveworks/eksctl/pkg/actions/automode"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getNodeClassCmd(cmd *cmdutils.Cmd) {
+	getAutoModeResourceCmd(cmd, "nodeclass", "Get Auto Mode NodeClass(es)", automode.NodeClassGVK, "nodeclasses")
+}
+
+func getNodePoolCmd(cmd *cmdutils.Cmd) {
+	getAutoModeResourceCmd(cmd, "nodepool", "Get Auto Mode NodePool(s)", automode.NodePoolGVK, "nodepools")
+}
+
+func getAutoModeResourceCmd(cmd *cmdutils.Cmd, use, description string, gvk schema.GroupVersionKind, alias string) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+	cmd.SetDescription(use, description, "", alias)
+
+	var (
+		name   string
+		output printers.Type
+	)
+	cmd.FlagSetGroup.InFlagSet("Auto Mode", func(fs *pflag.FlagSet) {
+		fs.StringVar(&name, "name", "", "Name of the "+gvk.Kind)
+	})
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cmd.ClusterConfig.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddOutputFlag(fs, &output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.NewGetAutoModeResourceLoader(cmd, &name).Load(); err != nil {
+			return err
+		}
+		return doGetAutoModeResource(cmd, gvk, name, output)
+	}
+}
+
+func doGetAutoModeResource(cmd *cmdutils.Cmd, gvk schema.GroupVersionKind, name string, output printers.Type) error {
+	if err := setupOutput(output); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	manager, err := automode.NewFromProvider(ctx, cmd.ClusterConfig, ctl)
+	if err != nil {
+		return err
+	}
+	resources, err := manager.Get(gvk, name)
+	if err != nil {
+		return err
+	}
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+	if output == printers.TableType {
+		addAutoModeResourceTableColumns(printer.(*printers.TablePrinter), gvk)
+	}
+	return printer.PrintObjWithKind(strings.ToLower(gvk.Kind)+"s", resources, cmd.CobraCommand.OutOrStdout())
+}
+
+func addAutoModeResourceTableColumns(printer *printers.TablePrinter, gvk schema.GroupVersionKind) {
+	printer.AddColumn("NAME", func(r automode.Resource) string {
+		return r.Name
+	})
+	if gvk == automode.NodePoolGVK {
+		printer.AddColumn("NODECLASS", func(r automode.Resource) string {
+			return r.NodeClass
+		})
+	}
+	printer.AddColumn("READY", func(r automode.Resource) string {
+		return r.Ready
+	})
+	printer.AddColumn("CREATED BY EKSCTL", func(r automode.Resource) string {
+		return strconv.FormatBool(r.CreatedByEksctl)
+	})
+}
@@ -0,0 +1,22 @@
+package get
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("get", func() {
+	Describe("nodeclass", func() {
+		It("missing required flag --cluster", func() {
+			cmd := newMockCmd("nodeclass")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("--cluster must be set")))
+		})
+
+		It("does not list in chunks", func() {
+			cmd := newMockCmd("nodepool", "--cluster", "foo", "--chunk-size", "10")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("unknown flag: --chunk-size")))
+		})
+	})
+})
@@ -3,7 +3,6 @@ package get
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -26,7 +25,10 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
-	var listAllRegions bool
+	var (
+		listAllRegions bool
+		regions        []string
+	)
 
 	params := &getCmdParams{}
 
@@ -34,14 +36,16 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doGetCluster(cmd, params, listAllRegions)
+		return doGetCluster(cmd, params, listAllRegions, regions)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
 		fs.BoolVarP(&listAllRegions, "all-regions", "A", false, "List clusters across all supported regions")
+		fs.StringSliceVar(&regions, "regions", nil, "List clusters across the given regions only, implies --all-regions")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddOwnedOnlyFlag(fs, cmd, "clusters")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 	})
@@ -49,15 +53,15 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 }
 
-func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool) error {
+func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool, regions []string) error {
 	if err := cmdutils.NewGetClusterLoader(cmd).Load(); err != nil {
 		return err
 	}
 	cfg := cmd.ClusterConfig
 	regionGiven := cfg.Metadata.Region != "" // eks.New resets this field, so we need to check if it was set in the first place
 
-	if params.output != printers.TableType {
-		logger.Writer = os.Stderr
+	if err := setupOutput(params.output); err != nil {
+		return err
 	}
 
 	ctl, err := cmd.NewCtl()
@@ -65,6 +69,10 @@ func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool)
 		return err
 	}
 
+	if len(regions) > 0 {
+		listAllRegions = true
+	}
+
 	if regionGiven && listAllRegions {
 		logger.Warning("--region=%s is ignored, as --all-regions is given", cfg.Metadata.Region)
 	}
@@ -81,20 +89,19 @@ func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool)
 		return fmt.Errorf("--all-regions is for listing all clusters, it must be used without cluster name flag/argument")
 	}
 
-	if params.output != printers.TableType {
-		// log warnings and errors to stdout
-		logger.Writer = os.Stderr
+	if cfg.Metadata.Name != "" && cmd.OwnedOnly {
+		return fmt.Errorf("--owned-only is for listing the clusters created by eksctl, it must be used without cluster name flag/argument")
 	}
 
 	ctx := context.Background()
 	if cfg.Metadata.Name == "" {
-		return getAndPrinterClusters(ctx, cmd, ctl, params, listAllRegions)
+		return getAndPrinterClusters(ctx, cmd, ctl, params, listAllRegions, regions)
 	}
 
 	return getAndPrintCluster(ctx, cmd, cfg, ctl, params)
 }
 
-func getAndPrinterClusters(ctx context.Context, cmd *cmdutils.Cmd, ctl *eks.ClusterProvider, params *getCmdParams, listAllRegions bool) error {
+func getAndPrinterClusters(ctx context.Context, cmd *cmdutils.Cmd, ctl *eks.ClusterProvider, params *getCmdParams, listAllRegions bool, regions []string) error {
 	printer, err := printers.NewPrinter(params.output)
 	if err != nil {
 		return err
@@ -104,10 +111,13 @@ func getAndPrinterClusters(ctx context.Context, cmd *cmdutils.Cmd, ctl *eks.Clus
 		addGetClustersSummaryTableColumns(printer.(*printers.TablePrinter))
 	}
 
-	clusters, err := cluster.GetClusters(ctx, ctl.AWSProvider, listAllRegions, params.chunkSize)
+	clusters, err := cluster.GetClusters(ctx, ctl.AWSProvider, listAllRegions, regions, params.chunkSize)
 	if err != nil {
 		return err
 	}
+	if cmd.OwnedOnly {
+		clusters = cluster.FilterOwned(clusters)
+	}
 
 	return printer.PrintObjWithKind("clusters", clusters, cmd.CobraCommand.OutOrStdout())
 }
@@ -134,12 +144,42 @@ func getAndPrintCluster(ctx context.Context, cmd *cmdutils.Cmd, cfg *api.Cluster
 		addGetClusterSummaryTableColumns(printer.(*printers.TablePrinter))
 	}
 
-	cluster, err := ctl.GetCluster(ctx, cfg.Metadata.Name)
+	eksCluster, err := ctl.GetCluster(ctx, cfg.Metadata.Name)
 	if err != nil {
 		return err
 	}
 
-	return printer.PrintObjWithKind("clusters", []*ekstypes.Cluster{cluster}, cmd.CobraCommand.OutOrStdout())
+	if err := printer.PrintObjWithKind("clusters", []*ekstypes.Cluster{eksCluster}, cmd.CobraCommand.OutOrStdout()); err != nil {
+		return err
+	}
+	if params.output != printers.TableType {
+		return nil
+	}
+
+	insights, err := cluster.GetUpgradeInsights(ctx, ctl.AWSProvider.EKS(), cfg.Metadata.Name, "")
+	if err != nil {
+		logger.Warning("unable to get upgrade insights: %v", err)
+		return nil
+	}
+	insightsPrinter := printers.NewTablePrinter().(*printers.TablePrinter)
+	addUpgradeInsightsTableColumns(insightsPrinter)
+	fmt.Fprintln(cmd.CobraCommand.OutOrStdout())
+	return insightsPrinter.PrintObjWithKind("upgrade insights", insights, cmd.CobraCommand.OutOrStdout())
+}
+
+func addUpgradeInsightsTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("INSIGHT", func(i cluster.Insight) string {
+		return i.Name
+	})
+	printer.AddColumn("KUBERNETES VERSION", func(i cluster.Insight) string {
+		return i.KubernetesVersion
+	})
+	printer.AddColumn("STATUS", func(i cluster.Insight) string {
+		return string(i.Status)
+	})
+	printer.AddColumn("REASON", func(i cluster.Insight) string {
+		return i.Reason
+	})
 }
 
 func addGetClusterSummaryTableColumns(printer *printers.TablePrinter) {
@@ -200,4 +240,24 @@ func addGetClusterSummaryTableColumns(printer *printers.TablePrinter) {
 		}
 		return "EKS"
 	})
+
+	now := time.Now()
+	printer.AddColumn("SUPPORT TYPE", func(c *ekstypes.Cluster) string {
+		if supportType := cluster.GetSupportPeriod(c, now).SupportType; supportType != "" {
+			return string(supportType)
+		}
+		return "-"
+	})
+	printer.AddColumn("SUPPORT PERIOD", func(c *ekstypes.Cluster) string {
+		if period := cluster.GetSupportPeriod(c, now).Period; period != "" {
+			return period
+		}
+		return "-"
+	})
+	printer.AddColumn("END OF SUPPORT", func(c *ekstypes.Cluster) string {
+		if endOfSupport := cluster.GetSupportPeriod(c, now).EndOfSupport; endOfSupport != nil {
+			return endOfSupport.Format(time.DateOnly)
+		}
+		return "-"
+	})
 }
@@ -0,0 +1,153 @@
+package get
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cost"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getCostsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("costs", "Get the current cost of a cluster, by nodegroup",
+		"Prices the control plane, the NAT gateways of the VPC of the cluster and the EC2 instances of its nodes, with "+
+			"on-demand prices and the current Spot prices", "cost")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetCosts(cmd, params)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddOutputFlag(fs, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doGetCosts(cmd *cmdutils.Cmd, params *getCmdParams) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	if err := setupOutput(params.output); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	summaries, err := nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).GetInstances(ctx)
+	if err != nil {
+		return err
+	}
+	var (
+		instances    []cost.Instance
+		fargateNodes int
+		ec2API       = ctl.AWSProvider.EC2()
+		clusterVPCID string
+		natGateways  int
+	)
+	for _, s := range summaries {
+		if s.InstanceID == "" {
+			fargateNodes++
+			continue
+		}
+		if s.InstanceType == "" {
+			continue
+		}
+		instances = append(instances, cost.Instance{
+			NodeGroup:        s.NodeGroup,
+			InstanceType:     s.InstanceType,
+			AvailabilityZone: s.AvailabilityZone,
+			Spot:             s.Lifecycle == nodegroup.InstanceLifecycleSpot,
+		})
+	}
+
+	if vpcConfig := ctl.Status.ClusterInfo.Cluster.ResourcesVpcConfig; vpcConfig != nil {
+		clusterVPCID = aws.ToString(vpcConfig.VpcId)
+	}
+	if clusterVPCID != "" {
+		if natGateways, err = cost.CountNATGateways(ctx, ec2API, clusterVPCID); err != nil {
+			return err
+		}
+	}
+
+	pricer := cost.NewPricer(ctl.AWSProvider.Pricing(), ctl.AWSProvider.Region())
+	costs, err := cost.GetClusterCosts(ctx, pricer, cost.NewSpotPricer(ec2API), natGateways, instances)
+	if err != nil {
+		return err
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output != printers.TableType {
+		return printer.PrintObj(costs, cmd.CobraCommand.OutOrStdout())
+	}
+
+	addResourceCostTableColumns(printer.(*printers.TablePrinter), costs.Currency)
+	if err := printer.PrintObjWithKind("costs", costs.Costs, cmd.CobraCommand.OutOrStdout()); err != nil {
+		return err
+	}
+	logger.Info("cluster %q costs %.2f %s per hour, %.2f %s per month", cfg.Metadata.Name,
+		costs.HourlyTotal(), costs.Currency, costs.HourlyTotal()*cost.HoursPerMonth, costs.Currency)
+	if fargateNodes > 0 {
+		logger.Info("the %d Fargate node(s) of the cluster are not included", fargateNodes)
+	}
+	return nil
+}
+
+func addResourceCostTableColumns(printer *printers.TablePrinter, currency string) {
+	printer.AddColumn("RESOURCE", func(c *cost.ResourceCost) string {
+		return valueOrDash(c.Resource)
+	})
+	printer.AddColumn("INSTANCE TYPE", func(c *cost.ResourceCost) string {
+		return valueOrDash(c.InstanceType)
+	})
+	printer.AddColumn("LIFECYCLE", func(c *cost.ResourceCost) string {
+		return valueOrDash(c.Lifecycle)
+	})
+	printer.AddColumn("COUNT", func(c *cost.ResourceCost) string {
+		return fmt.Sprintf("%d", c.Count)
+	})
+	printer.AddColumn(fmt.Sprintf("HOURLY (%s)", currency), func(c *cost.ResourceCost) string {
+		return fmt.Sprintf("%.4f", c.HourlyCost)
+	})
+	printer.AddColumn(fmt.Sprintf("MONTHLY (%s)", currency), func(c *cost.ResourceCost) string {
+		return fmt.Sprintf("%.2f", c.MonthlyCost)
+	})
+}
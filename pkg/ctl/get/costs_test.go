@@ -0,0 +1,34 @@
+package get
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("get", func() {
+	Describe("costs", func() {
+		It("missing required flag --cluster", func() {
+			cmd := newMockCmd("costs")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: --cluster must be set")))
+		})
+
+		It("setting --cluster and argument at the same time", func() {
+			cmd := newMockCmd("costs", "foo", "--cluster", "bar")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: --cluster=bar and argument foo cannot be used at the same time")))
+		})
+
+		It("does not list in chunks", func() {
+			cmd := newMockCmd("costs", "--cluster", "foo", "--chunk-size", "10")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("unknown flag: --chunk-size")))
+		})
+
+		It("invalid output format", func() {
+			cmd := newMockCmd("costs", "--cluster", "foo", "--output", "csv")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring(`unknown output printer type: expected {"yaml","json","table","jsonpath=...","go-template=..."} but got "csv"`)))
+		})
+	})
+})
@@ -2,7 +2,6 @@ package get
 
 import (
 	"context"
-	"os"
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
@@ -11,7 +10,6 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/fargate"
-	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 type options struct {
@@ -60,9 +58,8 @@ func configureGetFargateProfileCmd(cmd *cmdutils.Cmd) *options {
 }
 
 func doGetFargateProfile(cmd *cmdutils.Cmd, options *options) error {
-	if options.output != printers.TableType {
-		//log warnings and errors to stderr
-		logger.Writer = os.Stderr
+	if err := setupOutput(options.output); err != nil {
+		return err
 	}
 
 	ctx := context.Background()
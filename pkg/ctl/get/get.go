@@ -1,6 +1,9 @@
 package get
 
 import (
+	"os"
+
+	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
@@ -18,12 +21,29 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getClusterCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getNodeGroupCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getInstancesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getCostsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getHistoryCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getIdentityProvider)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getIAMServiceAccountCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getIAMIdentityMappingCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getLabelsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getFargateProfile)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getAddonCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getNodeClassCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getNodePoolCmd)
 
 	return verbCmd
 }
+
+// setupOutput returns an error for an unknown output format before any AWS API is called and, for the
+// formats other than table, logs warnings and errors to stderr so that the output can be parsed
+func setupOutput(output printers.Type) error {
+	if _, err := printers.NewPrinter(output); err != nil {
+		return err
+	}
+	if output != printers.TableType {
+		logger.Writer = os.Stderr
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package get
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/history"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+type getHistoryParams struct {
+	getCmdParams
+	store   string
+	version string
+}
+
+func getHistoryCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	params := &getHistoryParams{}
+
+	cmd.SetDescription("history", "Get the history of the ClusterConfig applied to a cluster",
+		"Lists the ClusterConfig applied to a cluster by each eksctl command that succeeded, with when and by whom, "+
+			"as recorded in the history store set by the "+history.StoreEnvVar+" environment variable. "+
+			"Use --version with --output yaml to review the ClusterConfig and the stacks of a version")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetHistory(cmd, params)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVar(&params.store, "store", os.Getenv(history.StoreEnvVar), "history store, s3://<bucket>/<prefix> or ssm:/<path> (defaults to the value of the "+history.StoreEnvVar+" environment variable)")
+		fs.StringVar(&params.version, "version", "", "only get this version of the history")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doGetHistory(cmd *cmdutils.Cmd, params *getHistoryParams) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	if params.store == "" {
+		return exitcode.Wrap(exitcode.Validation, fmt.Errorf("--store must be set, or the %s environment variable", history.StoreEnvVar))
+	}
+
+	if err := setupOutput(params.output); err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	store, err := history.NewStore(params.store, ctl.AWSProvider)
+	if err != nil {
+		return exitcode.Wrap(exitcode.Validation, err)
+	}
+
+	cfg := cmd.ClusterConfig
+	records, err := store.List(context.Background(), cfg.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	if params.version != "" {
+		records = filterHistoryVersion(records, params.version)
+		if len(records) == 0 {
+			return fmt.Errorf("no version %q in the history of cluster %q", params.version, cfg.Metadata.Name)
+		}
+	}
+	if len(records) == 0 {
+		logger.Info("no history recorded for cluster %q in %s", cfg.Metadata.Name, params.store)
+		return nil
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+	if params.output == printers.TableType {
+		addHistoryTableColumns(printer.(*printers.TablePrinter))
+	}
+	return printer.PrintObjWithKind("history", records, cmd.CobraCommand.OutOrStdout())
+}
+
+func filterHistoryVersion(records []*history.Record, version string) []*history.Record {
+	for _, r := range records {
+		if r.Version == version {
+			return []*history.Record{r}
+		}
+	}
+	return nil
+}
+
+func addHistoryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("VERSION", func(r *history.Record) string {
+		return r.Version
+	})
+	printer.AddColumn("TIME", func(r *history.Record) string {
+		return r.Time.Format(time.RFC3339)
+	})
+	printer.AddColumn("COMMAND", func(r *history.Record) string {
+		return r.Command
+	})
+	printer.AddColumn("USER", func(r *history.Record) string {
+		return valueOrDash(r.User)
+	})
+	printer.AddColumn("STACKS", func(r *history.Record) string {
+		return fmt.Sprintf("%d", len(r.Stacks))
+	})
+}
@@ -0,0 +1,28 @@
+package get
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("get", func() {
+	Describe("history", func() {
+		It("missing required flag --cluster", func() {
+			cmd := newMockCmd("history")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: --cluster must be set")))
+		})
+
+		It("setting --cluster and argument at the same time", func() {
+			cmd := newMockCmd("history", "foo", "--cluster", "bar")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: --cluster=bar and argument foo cannot be used at the same time")))
+		})
+
+		It("missing history store", func() {
+			cmd := newMockCmd("history", "--cluster", "foo", "--store", "")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: --store must be set, or the EKSCTL_HISTORY_STORE environment variable")))
+		})
+	})
+})
@@ -3,10 +3,8 @@ package get
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -51,8 +49,8 @@ func doGetIAMIdentityMapping(cmd *cmdutils.Cmd, params *getCmdParams, arn string
 
 	cfg := cmd.ClusterConfig
 
-	if params.output != printers.TableType {
-		logger.Writer = os.Stderr
+	if err := setupOutput(params.output); err != nil {
+		return err
 	}
 
 	ctl, err := cmd.NewProviderForExistingCluster(context.Background())
@@ -2,9 +2,7 @@ package get
 
 import (
 	"context"
-	"os"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -61,8 +59,8 @@ func doGetIAMServiceAccount(cmd *cmdutils.Cmd, options IAMServiceAccountOptions)
 		return err
 	}
 
-	if options.output != printers.TableType {
-		logger.Writer = os.Stderr
+	if err := setupOutput(options.output); err != nil {
+		return err
 	}
 
 	ctx := context.Background()
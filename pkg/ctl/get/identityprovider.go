@@ -2,9 +2,7 @@ package get
 
 import (
 	"context"
-	"os"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -46,9 +44,8 @@ func doGetIdentityProvider(cmd *cmdutils.Cmd, params getCmdParams, name string)
 		return err
 	}
 
-	if params.output != printers.TableType {
-		//log warnings and errors to stderr
-		logger.Writer = os.Stderr
+	if err := setupOutput(params.output); err != nil {
+		return err
 	}
 
 	ctx := context.Background()
@@ -0,0 +1,115 @@
+package get
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getInstancesCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("instances", "Get the EC2 instances of the nodes of a cluster", "", "instance")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetInstances(cmd, params)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doGetInstances(cmd *cmdutils.Cmd, params *getCmdParams) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	if err := setupOutput(params.output); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	instances, err := nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).GetInstances(ctx)
+	if err != nil {
+		return err
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == printers.TableType {
+		if len(instances) == 0 {
+			return errors.Errorf("no nodes found in cluster %q", cfg.Metadata.Name)
+		}
+		addInstanceSummaryTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("instances", instances, cmd.CobraCommand.OutOrStdout())
+}
+
+func addInstanceSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NODE", func(s *nodegroup.InstanceSummary) string {
+		return s.NodeName
+	})
+	printer.AddColumn("INSTANCE ID", func(s *nodegroup.InstanceSummary) string {
+		return valueOrDash(s.InstanceID)
+	})
+	printer.AddColumn("INSTANCE TYPE", func(s *nodegroup.InstanceSummary) string {
+		return valueOrDash(s.InstanceType)
+	})
+	printer.AddColumn("LIFECYCLE", func(s *nodegroup.InstanceSummary) string {
+		return valueOrDash(s.Lifecycle)
+	})
+	printer.AddColumn("AVAILABILITY ZONE", func(s *nodegroup.InstanceSummary) string {
+		return valueOrDash(s.AvailabilityZone)
+	})
+	printer.AddColumn("IMAGE ID", func(s *nodegroup.InstanceSummary) string {
+		return valueOrDash(s.ImageID)
+	})
+	printer.AddColumn("LAUNCH TIME", func(s *nodegroup.InstanceSummary) string {
+		if s.LaunchTime.IsZero() {
+			return "-"
+		}
+		return s.LaunchTime.Format(time.RFC3339)
+	})
+	printer.AddColumn("NODEGROUP", func(s *nodegroup.InstanceSummary) string {
+		return valueOrDash(s.NodeGroup)
+	})
+}
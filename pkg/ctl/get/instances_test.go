@@ -0,0 +1,28 @@
+package get
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("get", func() {
+	Describe("instances", func() {
+		It("missing required flag --cluster", func() {
+			cmd := newMockCmd("instances")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: --cluster must be set")))
+		})
+
+		It("setting --cluster and argument at the same time", func() {
+			cmd := newMockCmd("instances", "foo", "--cluster", "bar")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: --cluster=bar and argument foo cannot be used at the same time")))
+		})
+
+		It("invalid flag", func() {
+			cmd := newMockCmd("instances", "--invalid", "dummy")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring("Error: unknown flag: --invalid")))
+		})
+	})
+})
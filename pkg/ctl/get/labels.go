@@ -24,9 +24,10 @@ func getLabelsCmd(cmd *cmdutils.Cmd) {
 	cmd.SetDescription("labels", "Get labels for managed nodegroup", "")
 
 	var nodeGroupName string
+	params := &getCmdParams{}
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return getLabels(cmd, nodeGroupName)
+		return getLabels(cmd, nodeGroupName, params)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -34,6 +35,7 @@ func getLabelsCmd(cmd *cmdutils.Cmd) {
 		fs.StringVarP(&nodeGroupName, "nodegroup", "n", "", "Nodegroup name")
 
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 	})
@@ -42,12 +44,16 @@ func getLabelsCmd(cmd *cmdutils.Cmd) {
 
 }
 
-func getLabels(cmd *cmdutils.Cmd, nodeGroupName string) error {
+func getLabels(cmd *cmdutils.Cmd, nodeGroupName string, params *getCmdParams) error {
 	if err := cmdutils.NewGetLabelsLoader(cmd, nodeGroupName).Load(); err != nil {
 		return err
 	}
 	cfg := cmd.ClusterConfig
 
+	if err := setupOutput(params.output); err != nil {
+		return err
+	}
+
 	ctx := context.Background()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
@@ -61,11 +67,25 @@ func getLabels(cmd *cmdutils.Cmd, nodeGroupName string) error {
 		return err
 	}
 
-	printer := printers.NewTablePrinter()
-	addColumns(printer.(*printers.TablePrinter))
+	printer, err := newLabelsPrinter(params.output)
+	if err != nil {
+		return err
+	}
 	return printer.PrintObjWithKind("labels", labels, cmd.CobraCommand.OutOrStdout())
 }
 
+func newLabelsPrinter(output printers.Type) (printers.OutputPrinter, error) {
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == printers.TableType {
+		addColumns(printer.(*printers.TablePrinter))
+	}
+	return printer, nil
+}
+
 func addColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("CLUSTER", func(s label.Summary) string {
 		return s.Cluster
@@ -1,10 +1,13 @@
 package get
 
 import (
+	"bytes"
 	"os"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/label"
 )
 
 var _ = Describe("get", func() {
@@ -46,6 +49,39 @@ var _ = Describe("get", func() {
 			_, err = cmd.execute()
 			Expect(err).To(MatchError(ContainSubstring("Error: cannot use --cluster when --config-file/-f is set")))
 		})
+
+		It("fails when the output format is unknown", func() {
+			cmd := newMockCmd("labels", "--cluster", "dummy", "--nodegroup", "dummyNodeGroup", "--output", "xml")
+			_, err := cmd.execute()
+			Expect(err).To(MatchError(ContainSubstring(`unknown output printer type`)))
+		})
+
+		DescribeTable("prints the labels", func(output, expected string) {
+			summaries := []label.Summary{
+				{Cluster: "cluster-1", NodeGroup: "ng-1", Labels: map[string]string{"team": "platform"}},
+			}
+			printer, err := newLabelsPrinter(output)
+			Expect(err).NotTo(HaveOccurred())
+			out := &bytes.Buffer{}
+			Expect(printer.PrintObjWithKind("labels", summaries, out)).To(Succeed())
+			Expect(out.String()).To(Equal(expected))
+		},
+			Entry("as a table", "table", "CLUSTER\t\tNODEGROUP\tLABELS\ncluster-1\tng-1\t\tteam=platform\n"),
+			Entry("as JSON", "json", `[
+    {
+        "Cluster": "cluster-1",
+        "NodeGroup": "ng-1",
+        "Labels": {
+            "team": "platform"
+        }
+    }
+]`),
+			Entry("as YAML", "yaml", `- Cluster: cluster-1
+  Labels:
+    team: platform
+  NodeGroup: ng-1
+`),
+		)
 	})
 })
 
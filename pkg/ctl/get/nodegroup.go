@@ -2,14 +2,12 @@ package get
 
 import (
 	"context"
-	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
 
-	"github.com/kris-nova/logger"
-
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 
 	"github.com/pkg/errors"
@@ -40,6 +38,8 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		fs.Lookup("output").Usage = "specifies the output format (valid option: table, wide, json, yaml, jsonpath=<expression>, go-template=<template>)"
+		cmdutils.AddOwnedOnlyFlag(fs, cmd, "nodegroups")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 	})
@@ -47,14 +47,21 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 }
 
+// wideOutput prints the table of nodegroups with the health, update and launch template columns
+const wideOutput = "wide"
+
 func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams) error {
 	if err := cmdutils.NewGetNodegroupLoader(cmd, ng).Load(); err != nil {
 		return err
 	}
 
-	if params.output != printers.TableType {
-		//log warnings and errors to stderr
-		logger.Writer = os.Stderr
+	wide := params.output == wideOutput
+	if wide {
+		params.output = printers.TableType
+	}
+
+	if err := setupOutput(params.output); err != nil {
+		return err
 	}
 
 	ctx := context.Background()
@@ -80,14 +87,24 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 		if err != nil {
 			return err
 		}
+		if cmd.OwnedOnly {
+			summaries = nodegroup.FilterOwned(summaries)
+		}
 	} else {
 		summary, err := manager.Get(ctx, ng.Name)
 		if err != nil {
 			return err
 		}
+		if cmd.OwnedOnly && summary.StackName == "" {
+			return errors.Errorf("nodegroup %q was not created by eksctl", ng.Name)
+		}
 		summaries = append(summaries, summary)
 	}
 
+	if wide {
+		manager.AddUpdateStatus(ctx, summaries)
+	}
+
 	printer, err := printers.NewPrinter(params.output)
 	if err != nil {
 		return err
@@ -104,6 +121,9 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 			return errors.Errorf("nodegroup with name %v not found", ng.Name)
 		}
 		addSummaryTableColumns(printer.(*printers.TablePrinter))
+		if wide {
+			addWideSummaryTableColumns(printer.(*printers.TablePrinter))
+		}
 	}
 
 	return printer.PrintObjWithKind("nodegroups", summaries, cmd.CobraCommand.OutOrStdout())
@@ -144,3 +164,31 @@ func addSummaryTableColumns(printer *printers.TablePrinter) {
 		return s.NodeGroupType
 	})
 }
+
+func addWideSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("HEALTH ISSUES", func(s *nodegroup.Summary) string {
+		if len(s.HealthIssues) == 0 {
+			return "-"
+		}
+		return strings.Join(s.HealthIssues, ",")
+	})
+	printer.AddColumn("LAST UPDATE", func(s *nodegroup.Summary) string {
+		return valueOrDash(s.UpdateStatus)
+	})
+	printer.AddColumn("RELEASE VERSION", func(s *nodegroup.Summary) string {
+		return valueOrDash(s.ReleaseVersion)
+	})
+	printer.AddColumn("LATEST RELEASE VERSION", func(s *nodegroup.Summary) string {
+		return valueOrDash(s.LatestReleaseVersion)
+	})
+	printer.AddColumn("LAUNCH TEMPLATE VERSION", func(s *nodegroup.Summary) string {
+		return valueOrDash(s.LaunchTemplateVersion)
+	})
+}
+
+func valueOrDash(v string) string {
+	if v == "" {
+		return "-"
+	}
+	return v
+}
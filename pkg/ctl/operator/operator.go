@@ -0,0 +1,112 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/weaveworks/eksctl/pkg/actions/operator"
+	"github.com/weaveworks/eksctl/pkg/client"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+const defaultResyncPeriod = 10 * time.Minute
+
+type operatorOptions struct {
+	kubeconfig   string
+	namespace    string
+	resyncPeriod time.Duration
+	workers      int
+	printCRD     bool
+}
+
+// Command creates the `operator` command
+func Command(cmd *cmdutils.Cmd) {
+	var options operatorOptions
+
+	cmd.SetDescription("operator", "Reconcile the nodegroups, addons and IAM service accounts of ClusterConfig resources",
+		"Watches the ClusterConfig resources of the operator.eksctl.io API group in a Kubernetes cluster, and creates "+
+			"the nodegroups, addons and IAM service accounts of their specs missing from their EKS clusters, which must "+
+			"exist. With the operator.eksctl.io/prune annotation set to \"true\", the ones created by eksctl that are not "+
+			"in the spec are deleted. Install the CustomResourceDefinition with `eksctl operator --print-crd | kubectl apply -f -`.")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		if options.printCRD {
+			_, err := os.Stdout.Write(operator.CRD)
+			return err
+		}
+		return doOperator(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&options.kubeconfig, "kubeconfig", "", "kubeconfig of the cluster holding the ClusterConfig resources (defaults to the in-cluster configuration, or to the KUBECONFIG environment variable and ~/.kube/config)")
+		fs.StringVar(&options.namespace, "namespace", "", "namespace of the ClusterConfig resources to reconcile (defaults to all namespaces)")
+		fs.DurationVar(&options.resyncPeriod, "resync-period", defaultResyncPeriod, "period at which every ClusterConfig is reconciled again")
+		fs.IntVar(&options.workers, "workers", 1, "number of ClusterConfig resources reconciled in parallel")
+		fs.BoolVar(&options.printCRD, "print-crd", false, "print the CustomResourceDefinition of the ClusterConfig resources and exit")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("AWS client", func(fs *pflag.FlagSet) {
+		fs.StringVarP(&cmd.ProviderConfig.Profile.Name, "profile", "p", "", "AWS credentials profile to use (defaults to the value of the AWS_PROFILE environment variable)")
+		fs.StringVar(&cmd.ProviderConfig.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
+	})
+}
+
+func doOperator(cmd *cmdutils.Cmd, options operatorOptions) error {
+	if options.workers < 1 {
+		return exitcode.Wrap(exitcode.Validation, errors.New("--workers must be 1 or greater"))
+	}
+	if options.resyncPeriod <= 0 {
+		return exitcode.Wrap(exitcode.Validation, errors.New("--resync-period must be greater than 0"))
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = options.kubeconfig
+	restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	reconciler, err := operator.NewReconciler(func(region string) operator.Operations {
+		if region == "" {
+			region = cmd.ProviderConfig.Region
+		}
+		return client.New(client.Options{
+			Region:                region,
+			Profile:               cmd.ProviderConfig.Profile.Name,
+			WaitTimeout:           cmd.ProviderConfig.WaitTimeout,
+			CloudFormationRoleARN: cmd.ProviderConfig.CloudFormationRoleARN,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		// a second signal exits without waiting for the running reconciliations
+		stop()
+		logger.Info("shutting down, waiting for the running reconciliations to finish")
+	}()
+	logger.Info("reconciling ClusterConfig resources")
+	return operator.NewController(dynamicClient, options.namespace, options.resyncPeriod, reconciler).Run(ctx, options.workers)
+}
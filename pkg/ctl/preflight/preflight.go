@@ -0,0 +1,105 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+const textOutput = "text"
+
+// Command creates the `preflight` command
+func Command(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	var output string
+
+	cmd.SetDescription("preflight", "Check that a cluster can be created before creating any stack",
+		"Checks the service quotas of the VPC, NAT gateways and nodegroups, the IAM permissions of the caller by simulating "+
+			"its policies, the availability of the instance types in the availability zones of the nodegroups, and that "+
+			"neither the cluster nor its stacks exist. Exits with a non-zero code when any check fails.")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doPreflight(cmd, output, cmd.CobraCommand.OutOrStdout())
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", textOutput, "specifies the output format (valid option: text, json, yaml)")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doPreflight(cmd *cmdutils.Cmd, output string, w io.Writer) error {
+	if cmd.ClusterConfigFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file")
+	}
+
+	var printer printers.OutputPrinter
+	if output != textOutput {
+		var err error
+		if printer, err = printers.NewPrinter(printers.Type(output)); err != nil {
+			return err
+		}
+	}
+
+	if err := cmdutils.NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, &cmdutils.CreateClusterCmdParams{}).Load(); err != nil {
+		return exitcode.Wrap(exitcode.Validation, err)
+	}
+	cfg := cmd.ClusterConfig
+	switch cfg.Metadata.Version {
+	case "auto", "":
+		cfg.Metadata.Version = api.DefaultVersion
+	case "latest":
+		cfg.Metadata.Version = api.LatestVersion
+	}
+	if err := api.ValidateClusterVersion(cfg); err != nil {
+		return exitcode.Wrap(exitcode.Validation, err)
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	result := preflight.NewChecker(cfg, ctl.AWSProvider).Run(context.TODO())
+	result.ConfigFile = cmd.ClusterConfigFile
+
+	if printer != nil {
+		if err := printer.PrintObj(result, w); err != nil {
+			return err
+		}
+	} else {
+		for _, check := range result.Checks {
+			switch check.Status {
+			case preflight.StatusPass:
+				logger.Success("%s: %s", check.Kind, check.Message)
+			case preflight.StatusWarn:
+				logger.Warning("%s: %s", check.Kind, check.Message)
+			default:
+				logger.Critical("%s: %s", check.Kind, check.Message)
+			}
+		}
+	}
+
+	if !result.Passed {
+		return exitcode.Wrap(exitcode.Validation, fmt.Errorf("preflight checks of config file %q failed", cmd.ClusterConfigFile))
+	}
+	if printer == nil {
+		logger.Success("preflight checks of config file %q passed", cmd.ClusterConfigFile)
+	}
+	return nil
+}
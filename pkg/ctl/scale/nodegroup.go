@@ -9,26 +9,32 @@ import (
 	"github.com/spf13/pflag"
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
 
 func scaleNodeGroupCmd(cmd *cmdutils.Cmd) {
-	scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error {
-		return doScaleNodeGroup(cmd, ng)
+	scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroupBase, quotaCheck preflight.QuotaCheckMode) error {
+		return doScaleNodeGroup(cmd, ng, quotaCheck)
 	})
 }
 
-func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error) {
+func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroupBase, quotaCheck preflight.QuotaCheckMode) error) {
 	cfg := api.NewClusterConfig()
 	ng := cfg.NewNodeGroup().BaseNodeGroup()
 	cmd.ClusterConfig = cfg
 
+	var quotaCheck string
+
 	cmd.SetDescription("nodegroup", "Scale a nodegroup", "", "ng")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, ng)
+		if err := preflight.ValidateQuotaCheckMode(preflight.QuotaCheckMode(quotaCheck)); err != nil {
+			return err
+		}
+		return runFunc(cmd, ng, preflight.QuotaCheckMode(quotaCheck))
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -55,39 +61,40 @@ func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "wait for update to finish")
+		cmdutils.AddQuotaCheckFlag(fs, &quotaCheck)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, true)
 }
 
-func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error {
+func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase, quotaCheck preflight.QuotaCheckMode) error {
 	if ng.Name == "" && cmd.NameArg == "" {
 		if err := cmdutils.NewScaleAllNodeGroupLoader(cmd).Load(); err != nil {
 			return err
 		}
-		return scaleAllNodegroups(cmd)
+		return scaleAllNodegroups(cmd, quotaCheck)
 	}
 
 	if err := cmdutils.NewScaleNodeGroupLoader(cmd, ng).Load(); err != nil {
 		return err
 	}
-	return scaleNodegroup(cmd, ng)
+	return scaleNodegroup(cmd, ng, quotaCheck)
 }
 
-func scaleAllNodegroups(cmd *cmdutils.Cmd) error {
+func scaleAllNodegroups(cmd *cmdutils.Cmd, quotaCheck preflight.QuotaCheckMode) error {
 	allNg := cmd.ClusterConfig.AllNodeGroups()
 	for _, ng := range allNg {
 		if err := cmdutils.ValidateNumberOfNodes(ng); err != nil {
 			return err
 		}
-		if err := scaleNodegroup(cmd, ng); err != nil {
+		if err := scaleNodegroup(cmd, ng, quotaCheck); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func scaleNodegroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error {
+func scaleNodegroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase, quotaCheck preflight.QuotaCheckMode) error {
 	cfg := cmd.ClusterConfig
 	ctx := context.Background()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
@@ -99,5 +106,13 @@ func scaleNodegroup(cmd *cmdutils.Cmd, ng *api.NodeGroupBase) error {
 		return err
 	}
 
-	return nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).Scale(ctx, ng, cmd.Wait)
+	manager := nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session()))
+	if err := manager.CheckScalingQuotas(ctx, ng, quotaCheck); err != nil {
+		return err
+	}
+	if err := manager.Scale(ctx, ng, cmd.Wait); err != nil {
+		return err
+	}
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
 }
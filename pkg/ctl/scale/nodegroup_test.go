@@ -5,6 +5,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/weaveworks/eksctl/pkg/actions/preflight"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
@@ -16,7 +17,8 @@ var _ = Describe("scale", func() {
 				cmd := newMockEmptyCmd(args...)
 				count := 0
 				cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-					scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroupBase) error {
+					scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroupBase, quotaCheck preflight.QuotaCheckMode) error {
+						Expect(quotaCheck).To(Equal(preflight.QuotaCheckFail))
 						if len(ng.Name) != 0 {
 							Expect(ng.Name).To(Or(Equal("nodeGroup"), Equal("")))
 						} else {
@@ -95,6 +97,10 @@ var _ = Describe("scale", func() {
 				args:  []string{"nodegroup", "-f", "../cmdutils/test_data/scale-ng-test.yaml", "--cluster", "dummyCluster"},
 				error: fmt.Errorf("Error: cannot use --cluster when --config-file/-f is set"),
 			}),
+			Entry("invalid quota check", invalidParamsCase{
+				args:  []string{"nodegroup", "ng", "--cluster", "dummy", "--nodes", "2", "--quota-check", "ignore"},
+				error: fmt.Errorf(`Error: invalid value "ignore" for --quota-check, valid values are "fail", "warn" and "skip"`),
+			}),
 		)
 	})
 })
@@ -0,0 +1,134 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/server"
+	"github.com/weaveworks/eksctl/pkg/client"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+const defaultListenAddress = "127.0.0.1:8080"
+
+type serveOptions struct {
+	listenAddress string
+	tokenFile     string
+}
+
+// Command creates the `serve` command
+func Command(cmd *cmdutils.Cmd) {
+	var options serveOptions
+
+	cmd.SetDescription("serve", "Run an HTTP server creating, updating and deleting clusters",
+		"Runs an HTTP server accepting ClusterConfig payloads and running the operations of eksctl on them as "+
+			"asynchronous jobs, whose status is served under /v1/jobs. Jobs are kept in memory, and are lost when the "+
+			"server exits. On SIGINT or SIGTERM, the server stops accepting requests and exits once the running jobs finish.")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doServe(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&options.listenAddress, "listen", defaultListenAddress, "address the server listens on")
+		fs.StringVar(&options.tokenFile, "token-file", "", "file holding the bearer token requests must be authorised with")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("AWS client", func(fs *pflag.FlagSet) {
+		fs.StringVarP(&cmd.ProviderConfig.Profile.Name, "profile", "p", "", "AWS credentials profile to use (defaults to the value of the AWS_PROFILE environment variable)")
+		fs.StringVar(&cmd.ProviderConfig.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
+	})
+}
+
+func doServe(cmd *cmdutils.Cmd, options serveOptions) error {
+	token, err := readToken(options.tokenFile)
+	if err != nil {
+		return exitcode.Wrap(exitcode.Validation, err)
+	}
+
+	listener, err := net.Listen("tcp", options.listenAddress)
+	if err != nil {
+		return err
+	}
+	if token == "" && !isLoopback(listener.Addr()) {
+		logger.Warning("requests are not authorised as --token-file is not set, anyone reaching %s can create and delete clusters", listener.Addr())
+	}
+
+	// jobs are not cancelled on shutdown, as stopping them half-way would leave stacks to clean up
+	srv, err := server.New(context.Background(), client.New(client.Options{
+		Region:                cmd.ProviderConfig.Region,
+		Profile:               cmd.ProviderConfig.Profile.Name,
+		WaitTimeout:           cmd.ProviderConfig.WaitTimeout,
+		CloudFormationRoleARN: cmd.ProviderConfig.CloudFormationRoleARN,
+	}), token, cmd.ProviderConfig.Region)
+	if err != nil {
+		return err
+	}
+	httpServer := &http.Server{
+		Handler:           srv,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- httpServer.Serve(listener)
+	}()
+	logger.Info("listening on %s", listener.Addr())
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+	// a second signal exits without waiting for the jobs
+	stop()
+	logger.Info("shutting down, waiting for the running jobs to finish")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		logger.Warning("shutting down the server: %v", err)
+	}
+	if err := <-serveErr; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	srv.Wait()
+	return nil
+}
+
+func readToken(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %q is empty", path)
+	}
+	return token, nil
+}
+
+func isLoopback(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	return ok && tcpAddr.IP.IsLoopback()
+}
@@ -2,6 +2,7 @@ package update
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
@@ -9,6 +10,7 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	kubeclient "k8s.io/client-go/kubernetes"
 
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -23,14 +25,20 @@ func updateAddonCmd(cmd *cmdutils.Cmd) {
 		"",
 	)
 
-	var force, wait bool
+	var force, wait, restartPods bool
+	var allOpts updateAllAddonsOptions
 	cmd.ClusterConfig.Addons = []*api.Addon{{}}
 	cmd.FlagSetGroup.InFlagSet("Addon", func(fs *pflag.FlagSet) {
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Name, "name", "", "Addon name")
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].Version, "version", "", "Add-on version. Use `eksctl utils describe-addon-versions` to discover a version or set to \"latest\"")
 		fs.StringVar(&cmd.ClusterConfig.Addons[0].ServiceAccountRoleARN, "service-account-role-arn", "", "Addon serviceAccountRoleARN")
+		fs.StringVar(&cmd.ClusterConfig.Addons[0].ConfigurationValues, "configuration-values", "", "Add-on configuration values in JSON or YAML, validated against the configuration schema of the add-on version. Use `eksctl utils describe-addon-configuration` to view the schema")
 		fs.BoolVar(&force, "force", false, "Force migrates an existing self-managed add-on to an EKS managed add-on")
 		fs.BoolVar(&wait, "wait", false, "Wait for the addon update to complete")
+		fs.BoolVar(&restartPods, "restart-pods", false, "Restart the deployments and daemonsets of the addon after the update, so that its pods pick up configuration changes")
+		fs.BoolVar(&allOpts.all, "all", false, "Update all addons installed in the cluster to the version selected by --version-policy")
+		fs.StringVar(&allOpts.versionPolicy, "version-policy", addon.VersionPolicyLatestPatch, fmt.Sprintf("Version addons are updated to with --all, one of %v", addon.VersionPolicies()))
+		fs.IntVar(&allOpts.parallel, "parallel", 1, "Number of addons to update in parallel with --all")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -43,11 +51,17 @@ func updateAddonCmd(cmd *cmdutils.Cmd) {
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return updateAddon(cmd, force, wait)
+		if allOpts.all {
+			if restartPods {
+				return errors.New("--restart-pods cannot be used with --all")
+			}
+			return updateAllAddons(cmd, allOpts, force)
+		}
+		return updateAddon(cmd, force, wait, restartPods)
 	}
 }
 
-func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
+func updateAddon(cmd *cmdutils.Cmd, force, wait, restartPods bool) error {
 	if err := cmdutils.NewCreateOrUpgradeAddonLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -85,7 +99,14 @@ func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
 	logger.Info("Kubernetes version %q in use by cluster %q", *output.Cluster.Version, cmd.ClusterConfig.Metadata.Name)
 	cmd.ClusterConfig.Metadata.Version = *output.Cluster.Version
 
-	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.AWSProvider.EKS(), stackManager, oidcProviderExists, oidc, nil)
+	var clientSet kubeclient.Interface
+	if restartPods {
+		if clientSet, err = clusterProvider.NewStdClientSet(cmd.ClusterConfig); err != nil {
+			return err
+		}
+	}
+
+	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.AWSProvider.EKS(), stackManager, oidcProviderExists, oidc, clientSet)
 
 	if err != nil {
 		return err
@@ -98,7 +119,62 @@ func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
 		if err := addonManager.Update(ctx, a, cmd.ProviderConfig.WaitTimeout); err != nil {
 			return err
 		}
+		if restartPods {
+			if err := addonManager.RestartWorkloads(ctx, a); err != nil {
+				return err
+			}
+		}
 	}
 
+	cmdutils.RecordHistory(ctx, cmd, clusterProvider)
 	return nil
 }
+
+type updateAllAddonsOptions struct {
+	all           bool
+	versionPolicy string
+	parallel      int
+}
+
+func updateAllAddons(cmd *cmdutils.Cmd, opts updateAllAddonsOptions, force bool) error {
+	if err := cmdutils.NewUpdateAllAddonsLoader(cmd, opts.versionPolicy, opts.parallel).Load(); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	clusterProvider, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	oidc, err := clusterProvider.NewOpenIDConnectManager(ctx, cmd.ClusterConfig)
+	if err != nil {
+		return err
+	}
+
+	oidcProviderExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return err
+	}
+
+	cmd.ClusterConfig.Metadata.Version = clusterProvider.ControlPlaneVersion()
+	logger.Info("Kubernetes version %q in use by cluster %q", cmd.ClusterConfig.Metadata.Version, cmd.ClusterConfig.Metadata.Name)
+
+	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.AWSProvider.EKS(), clusterProvider.NewStackManager(cmd.ClusterConfig), oidcProviderExists, oidc, nil)
+	if err != nil {
+		return err
+	}
+
+	updates, err := addonManager.PlanUpdates(ctx, opts.versionPolicy)
+	if err != nil {
+		return err
+	}
+	if len(updates) == 0 {
+		logger.Info("all addons are up to date with version policy %q", opts.versionPolicy)
+		return nil
+	}
+	for _, u := range updates {
+		logger.Info("addon %s will be updated from version %s to %s", u.Name, u.CurrentVersion, u.TargetVersion)
+	}
+	return addonManager.ApplyUpdates(ctx, updates, opts.parallel, force, cmd.ProviderConfig.WaitTimeout)
+}
@@ -30,4 +30,17 @@ var _ = Describe("update addon", func() {
 			Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("\"%s\" is not valid, supported format(s) are: JSON and YAML", cfg.Addons[0].ConfigurationValues))))
 		})
 	})
+
+	DescribeTable("--all with invalid flags", func(args []string, expectedErr string) {
+		cmd := newMockCmd(append([]string{"addon", "--all"}, args...)...)
+		_, err := cmd.execute()
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("without a cluster", []string{}, "--cluster must be set"),
+		Entry("with an addon name", []string{"--cluster", "cluster-1", "--name", "coredns"}, "--all cannot be used with an addon name or --version"),
+		Entry("with a version", []string{"--cluster", "cluster-1", "--version", "latest"}, "--all cannot be used with an addon name or --version"),
+		Entry("with an invalid version policy", []string{"--cluster", "cluster-1", "--version-policy", "newest"}, `invalid value "newest" for --version-policy, must be one of [latest latest-patch default]`),
+		Entry("with no parallelism", []string{"--cluster", "cluster-1", "--parallel", "0"}, "--parallel must be at least 1"),
+		Entry("with --restart-pods", []string{"--cluster", "cluster-1", "--restart-pods"}, "--restart-pods cannot be used with --all"),
+	)
 })
@@ -19,17 +19,20 @@ import (
 const upgradeClusterTimeout = 65 * time.Minute
 
 func upgradeCluster(cmd *cmdutils.Cmd) {
-	upgradeClusterWithRunFunc(cmd, DoUpgradeCluster)
+	upgradeClusterWithRunFunc(cmd, doUpgradeCluster)
 }
 
-func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options cluster.UpgradeAllOptions) error) {
 	cfg := api.NewClusterConfig()
 	// Reset version
 	cfg.Metadata.Version = ""
 	cmd.ClusterConfig = cfg
 
 	cmd.SetDescription("cluster", "Upgrade control plane to the next version",
-		"Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing.")
+		"Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing. "+
+			"With --include-nodegroups and --include-addons, managed nodegroups and addons are upgraded after the control plane.")
+
+	var options cluster.UpgradeAllOptions
 
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 
@@ -46,19 +49,30 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeClusterTimeout)
 	})
 
+	cmd.FlagSetGroup.InFlagSet("Upgrade", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&options.IncludeNodeGroups, "include-nodegroups", false, "Upgrade managed nodegroups to the version of the control plane after upgrading it")
+		fs.BoolVar(&options.IncludeAddons, "include-addons", false, "Update addons to the default versions for the version of the control plane after upgrading it")
+		fs.BoolVar(&options.Force, "force", false, "Upgrade the control plane even if Kubernetes APIs removed in the target version are in use, or nodegroups would violate the supported version skew")
+		fs.StringVar(&options.CheckpointFile, "checkpoint-file", "", "File recording the completed steps of an upgrade with --include-nodegroups or --include-addons, used to resume it (default \"~/.eksctl/upgrades/<region>-<cluster>.json\")")
+	})
+
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
 
 		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 			return err
 		}
-		return runFunc(cmd)
+		return runFunc(cmd, options)
 	}
 }
 
 // DoUpgradeCluster made public so that it can be shared with update/cluster.go until this is deprecated
 // TODO Once `eksctl update cluster` is officially deprecated this can be made package private again
 func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
+	return doUpgradeCluster(cmd, cluster.UpgradeAllOptions{})
+}
+
+func doUpgradeCluster(cmd *cmdutils.Cmd, options cluster.UpgradeAllOptions) error {
 	ctx := context.Background()
 	ctl, err := cmd.NewProviderForExistingCluster(ctx)
 	if err != nil {
@@ -79,5 +93,24 @@ func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	return c.Upgrade(ctx, cmd.Plan)
+	if !options.IncludeNodeGroups && !options.IncludeAddons {
+		if err := c.Upgrade(ctx, cmd.Plan, options.Force); err != nil {
+			return err
+		}
+		cmdutils.RecordHistory(ctx, cmd, ctl)
+		return nil
+	}
+
+	if options.CheckpointFile == "" {
+		if options.CheckpointFile, err = cluster.DefaultCheckpointFile(cfg.Metadata); err != nil {
+			return err
+		}
+	}
+	options.DryRun = cmd.Plan
+	options.AddonWaitTimeout = cmd.ProviderConfig.WaitTimeout
+	if err := cluster.UpgradeAll(ctx, c, cfg, ctl, options); err != nil {
+		return err
+	}
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
 }
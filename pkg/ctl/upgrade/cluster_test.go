@@ -7,14 +7,22 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
 )
 
 var _ = Describe("upgrade cluster", func() {
+	var options cluster.UpgradeAllOptions
 
 	newMockUpgradeClusterCmd := func(args ...string) *ctltest.MockCmd {
-		return ctltest.NewMockCmd(upgradeClusterWithRunFunc, "upgrade", args...)
+		return ctltest.NewMockCmd(func(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+			upgradeClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, o cluster.UpgradeAllOptions) error {
+				options = o
+				return runFunc(cmd)
+			})
+		}, "upgrade", args...)
 	}
 
 	Describe("without a config file", func() {
@@ -71,6 +79,29 @@ var _ = Describe("upgrade cluster", func() {
 			Expect(cmd.Cmd.Plan).To(BeFalse())
 			Expect(cmd.Cmd.ProviderConfig.WaitTimeout).To(Equal(123 * time.Minute))
 		})
+
+		It("accepts the flags of a full upgrade", func() {
+			cmd := newMockUpgradeClusterCmd("cluster",
+				"--name", "clus-1",
+				"--include-nodegroups",
+				"--include-addons",
+				"--checkpoint-file", "checkpoint.json",
+			)
+			_, err := cmd.Execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options).To(Equal(cluster.UpgradeAllOptions{
+				IncludeNodeGroups: true,
+				IncludeAddons:     true,
+				CheckpointFile:    "checkpoint.json",
+			}))
+		})
+
+		It("accepts the --force flag", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--force")
+			_, err := cmd.Execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options.Force).To(BeTrue())
+		})
 	})
 
 	Describe("with a config file", func() {
@@ -81,5 +81,9 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options nodegroup.UpgradeOptions) error
 		return err
 	}
 
-	return nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).Upgrade(ctx, options)
+	if err := nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).Upgrade(ctx, options); err != nil {
+		return err
+	}
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
 }
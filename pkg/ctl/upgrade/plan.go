@@ -0,0 +1,183 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func upgradePlan(cmd *cmdutils.Cmd) {
+	upgradePlanWithRunFunc(cmd, doUpgradePlan)
+}
+
+func upgradePlanWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, output printers.Type) error) {
+	cfg := api.NewClusterConfig()
+	// Reset version
+	cfg.Metadata.Version = ""
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("plan", "Preview the upgrade of a cluster to the next version",
+		"Report the target version of the control plane, the version gaps of nodegroups and addons, and the usage of Kubernetes APIs removed in the target version, without changing anything.")
+
+	var output printers.Type
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddVersionFlag(fs, cfg.Metadata, "")
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		return runFunc(cmd, output)
+	}
+}
+
+func doUpgradePlan(cmd *cmdutils.Cmd, output printers.Type) error {
+	if output != printers.TableType {
+		//log warnings and errors to stdout
+		logger.Writer = os.Stderr
+	}
+
+	ctx := context.Background()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	plan, err := cluster.PlanUpgrade(ctx, cmd.ClusterConfig, ctl)
+	if err != nil {
+		return err
+	}
+
+	if output != printers.TableType {
+		printer, err := printers.NewPrinter(output)
+		if err != nil {
+			return err
+		}
+		return printer.PrintObj(plan, cmd.CobraCommand.OutOrStdout())
+	}
+	return printUpgradePlanTables(plan, cmd.CobraCommand.OutOrStdout())
+}
+
+func printUpgradePlanTables(plan *cluster.UpgradePlan, w io.Writer) error {
+	controlPlane := printers.NewTablePrinter().(*printers.TablePrinter)
+	controlPlane.AddColumn("CLUSTER", func(p cluster.UpgradePlan) string {
+		return p.ClusterName
+	})
+	controlPlane.AddColumn("CURRENT VERSION", func(p cluster.UpgradePlan) string {
+		return p.CurrentVersion
+	})
+	controlPlane.AddColumn("TARGET VERSION", func(p cluster.UpgradePlan) string {
+		return p.TargetVersion
+	})
+	controlPlane.AddColumn("UPGRADE REQUIRED", func(p cluster.UpgradePlan) string {
+		return strconv.FormatBool(p.ControlPlaneUpgradeRequired)
+	})
+	if err := controlPlane.PrintObjWithKind("clusters", []cluster.UpgradePlan{*plan}, w); err != nil {
+		return err
+	}
+
+	nodeGroups := printers.NewTablePrinter().(*printers.TablePrinter)
+	nodeGroups.AddColumn("NODEGROUP", func(g nodegroup.VersionGap) string {
+		return g.Name
+	})
+	nodeGroups.AddColumn("TYPE", func(g nodegroup.VersionGap) string {
+		return string(g.NodeGroupType)
+	})
+	nodeGroups.AddColumn("VERSION", func(g nodegroup.VersionGap) string {
+		return g.Version
+	})
+	nodeGroups.AddColumn("TARGET VERSION", func(g nodegroup.VersionGap) string {
+		return g.TargetVersion
+	})
+	nodeGroups.AddColumn("RELEASE VERSION", func(g nodegroup.VersionGap) string {
+		return g.ReleaseVersion
+	})
+	nodeGroups.AddColumn("LATEST RELEASE VERSION", func(g nodegroup.VersionGap) string {
+		return g.LatestReleaseVersion
+	})
+	nodeGroups.AddColumn("UPGRADE REQUIRED", func(g nodegroup.VersionGap) string {
+		return strconv.FormatBool(g.UpgradeRequired)
+	})
+	fmt.Fprintln(w)
+	if err := nodeGroups.PrintObjWithKind("nodegroups", plan.NodeGroups, w); err != nil {
+		return err
+	}
+
+	addons := printers.NewTablePrinter().(*printers.TablePrinter)
+	addons.AddColumn("ADDON", func(u addon.PlannedUpdate) string {
+		return u.Name
+	})
+	addons.AddColumn("VERSION", func(u addon.PlannedUpdate) string {
+		return u.CurrentVersion
+	})
+	addons.AddColumn("TARGET VERSION", func(u addon.PlannedUpdate) string {
+		return u.TargetVersion
+	})
+	fmt.Fprintln(w)
+	if err := addons.PrintObjWithKind("addon updates", plan.Addons, w); err != nil {
+		return err
+	}
+
+	deprecatedAPIs := printers.NewTablePrinter().(*printers.TablePrinter)
+	deprecatedAPIs.AddColumn("DEPRECATED API", func(d cluster.DeprecatedAPIUsage) string {
+		return d.GroupVersion()
+	})
+	deprecatedAPIs.AddColumn("RESOURCE", func(d cluster.DeprecatedAPIUsage) string {
+		return d.Resource
+	})
+	deprecatedAPIs.AddColumn("REMOVED IN", func(d cluster.DeprecatedAPIUsage) string {
+		return d.RemovedRelease
+	})
+	fmt.Fprintln(w)
+	if err := deprecatedAPIs.PrintObjWithKind("deprecated API usages", plan.DeprecatedAPIs, w); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	return printDeprecatedObjectsTable(plan.DeprecatedObjects, w)
+}
+
+func printDeprecatedObjectsTable(objects []cluster.DeprecatedObject, w io.Writer) error {
+	printer := printers.NewTablePrinter().(*printers.TablePrinter)
+	printer.AddColumn("KIND", func(o cluster.DeprecatedObject) string {
+		return o.Kind
+	})
+	printer.AddColumn("NAMESPACE", func(o cluster.DeprecatedObject) string {
+		return o.Namespace
+	})
+	printer.AddColumn("NAME", func(o cluster.DeprecatedObject) string {
+		return o.Name
+	})
+	printer.AddColumn("API VERSION", func(o cluster.DeprecatedObject) string {
+		return o.APIVersion
+	})
+	printer.AddColumn("REPLACEMENT", func(o cluster.DeprecatedObject) string {
+		return o.ReplacementAPIVersion
+	})
+	printer.AddColumn("REMOVED IN", func(o cluster.DeprecatedObject) string {
+		return o.RemovedRelease
+	})
+	return printer.PrintObjWithKind("objects using removed APIs", objects, w)
+}
@@ -0,0 +1,49 @@
+package upgrade
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+var _ = Describe("upgrade plan", func() {
+	var output printers.Type
+
+	newMockUpgradePlanCmd := func(args ...string) *ctltest.MockCmd {
+		return ctltest.NewMockCmd(func(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+			upgradePlanWithRunFunc(cmd, func(cmd *cmdutils.Cmd, o printers.Type) error {
+				output = o
+				return runFunc(cmd)
+			})
+		}, "upgrade", args...)
+	}
+
+	It("requires a cluster name", func() {
+		cmd := newMockUpgradePlanCmd("plan")
+		_, err := cmd.Execute()
+		Expect(err).To(MatchError(ContainSubstring("--cluster must be set")))
+	})
+
+	It("loads all flags correctly", func() {
+		cmd := newMockUpgradePlanCmd("plan", "--cluster", "clus-1", "--region", "us-west-2", "--version", "1.27", "--output", "json")
+		_, err := cmd.Execute()
+		Expect(err).NotTo(HaveOccurred())
+
+		cfg := cmd.Cmd.ClusterConfig
+		Expect(cfg.Metadata.Name).To(Equal("clus-1"))
+		Expect(cmd.Cmd.ProviderConfig.Region).To(Equal("us-west-2"))
+		Expect(cfg.Metadata.Version).To(Equal("1.27"))
+		Expect(output).To(Equal(printers.JSONType))
+	})
+
+	It("prints tables by default", func() {
+		cmd := newMockUpgradePlanCmd("plan", "clus-1")
+		_, err := cmd.Execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cmd.Cmd.ClusterConfig.Metadata.Name).To(Equal("clus-1"))
+		Expect(output).To(Equal(printers.TableType))
+	})
+})
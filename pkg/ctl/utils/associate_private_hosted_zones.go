@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/hostedzones"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func associatePrivateHostedZonesCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("associate-private-hosted-zones", "Associate Route 53 private hosted zones with the cluster VPC",
+		"Associates private hosted zones with the cluster VPC so that their records can be resolved from the cluster; "+
+			"hosted zones owned by another account are authorized using a role in that account")
+
+	var (
+		hostedZoneIDs        []string
+		authorizationRoleARN string
+	)
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doAssociatePrivateHostedZones(cmd, hostedZoneIDs, authorizationRoleARN)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Hosted zones", func(fs *pflag.FlagSet) {
+		fs.StringSliceVar(&hostedZoneIDs, "hosted-zone-ids", nil, "IDs of the private hosted zones to associate with the cluster VPC")
+		fs.StringVar(&authorizationRoleARN, "authorization-role-arn", "",
+			"IAM role in the account that owns the hosted zones, used to authorize the association for hosted zones owned by another account")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doAssociatePrivateHostedZones(cmd *cmdutils.Cmd, hostedZoneIDs []string, authorizationRoleARN string) error {
+	if err := cmdutils.NewUtilsAssociatePrivateHostedZonesLoader(cmd, hostedZoneIDs, authorizationRoleARN).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clusterVPCConfig, err := ctl.GetCurrentClusterVPCConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "associate private hosted zones with VPC %q of cluster %q in %q",
+		clusterVPCConfig.VPCID, meta.Name, meta.Region)
+
+	manager := hostedzones.NewManager(ctl.AWSProvider.Route53(), ctl.AWSProvider.STS(), meta.Region, ctl.AWSProvider.RoleSession())
+	if err := manager.Associate(ctx, clusterVPCConfig.VPCID, cfg.VPC.PrivateHostedZoneAssociations, cmd.Plan); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func checkAPIDeprecationsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	// Reset version
+	cfg.Metadata.Version = ""
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("check-api-deprecations", "Check the usage of Kubernetes APIs removed in the next version",
+		"Report the Kubernetes APIs removed in the version the cluster would be upgraded to that have been requested from the API server, "+
+			"and the objects last applied with them. Exits with an error if any usage is found.")
+
+	var output printers.Type
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddVersionFlag(fs, cfg.Metadata, "target Kubernetes version, defaults to the next version")
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+
+		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		return doCheckAPIDeprecations(cmd, output)
+	}
+}
+
+func doCheckAPIDeprecations(cmd *cmdutils.Cmd, output printers.Type) error {
+	if output != printers.TableType {
+		//log warnings and errors to stdout
+		logger.Writer = os.Stderr
+	}
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+
+	report, err := cluster.CheckRemovedAPIs(ctx, cmd.ClusterConfig, ctl)
+	if err != nil {
+		return err
+	}
+
+	if output != printers.TableType {
+		printer, err := printers.NewPrinter(output)
+		if err != nil {
+			return err
+		}
+		if err := printer.PrintObj(report, cmd.CobraCommand.OutOrStdout()); err != nil {
+			return err
+		}
+	} else if err := printRemovedAPIsReport(report, cmd.CobraCommand.OutOrStdout()); err != nil {
+		return err
+	}
+
+	if !report.Empty() {
+		return fmt.Errorf("found usage of Kubernetes APIs removed in version %s", report.TargetVersion)
+	}
+	logger.Info("no usage of Kubernetes APIs removed in version %s found", report.TargetVersion)
+	return nil
+}
+
+func printRemovedAPIsReport(report *cluster.RemovedAPIsReport, w io.Writer) error {
+	deprecatedAPIs := printers.NewTablePrinter().(*printers.TablePrinter)
+	deprecatedAPIs.AddColumn("DEPRECATED API", func(d cluster.DeprecatedAPIUsage) string {
+		return d.GroupVersion()
+	})
+	deprecatedAPIs.AddColumn("RESOURCE", func(d cluster.DeprecatedAPIUsage) string {
+		return d.Resource
+	})
+	deprecatedAPIs.AddColumn("REMOVED IN", func(d cluster.DeprecatedAPIUsage) string {
+		return d.RemovedRelease
+	})
+	if err := deprecatedAPIs.PrintObjWithKind("deprecated API usages", report.DeprecatedAPIs, w); err != nil {
+		return err
+	}
+
+	objects := printers.NewTablePrinter().(*printers.TablePrinter)
+	objects.AddColumn("KIND", func(o cluster.DeprecatedObject) string {
+		return o.Kind
+	})
+	objects.AddColumn("NAMESPACE", func(o cluster.DeprecatedObject) string {
+		return o.Namespace
+	})
+	objects.AddColumn("NAME", func(o cluster.DeprecatedObject) string {
+		return o.Name
+	})
+	objects.AddColumn("API VERSION", func(o cluster.DeprecatedObject) string {
+		return o.APIVersion
+	})
+	objects.AddColumn("REPLACEMENT", func(o cluster.DeprecatedObject) string {
+		return o.ReplacementAPIVersion
+	})
+	objects.AddColumn("REMOVED IN", func(o cluster.DeprecatedObject) string {
+		return o.RemovedRelease
+	})
+	fmt.Fprintln(w)
+	return objects.PrintObjWithKind("objects using removed APIs", report.Objects, w)
+}
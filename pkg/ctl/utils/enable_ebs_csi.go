@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/ebscsi"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func enableEBSCSICmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("enable-ebs-csi", "Install the Amazon EBS CSI driver addon",
+		"Installs the aws-ebs-csi-driver addon with an IAM role for its controller, optionally allowing it to use a KMS key "+
+			"for encrypted volumes and making gp3 the default StorageClass")
+
+	var options ebscsi.Options
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doEnableEBSCSI(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("EBS CSI driver", func(fs *pflag.FlagSet) {
+		fs.StringVar(&options.KMSKeyID, "kms-key-id", "", "ID, ARN or alias of the KMS key used to encrypt volumes; the key is validated and the controller role is allowed to use it")
+		fs.BoolVar(&options.DefaultStorageClassGP3, "default-storage-class-gp3", false, "Create a gp3 StorageClass and make it the default StorageClass instead of the current one")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doEnableEBSCSI(cmd *cmdutils.Cmd, options ebscsi.Options) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	meta.Version = ctl.ControlPlaneVersion()
+
+	parsedARN, err := arn.Parse(cfg.Status.ARN)
+	if err != nil {
+		return fmt.Errorf("unexpected invalid ARN: %q: %w", cfg.Status.ARN, err)
+	}
+
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	providerExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return err
+	}
+	if !providerExists {
+		return fmt.Errorf("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", meta.Region, meta.Name)
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	stackManager := ctl.NewStackManager(cfg)
+	addonManager, err := addon.New(cfg, ctl.AWSProvider.EKS(), stackManager, providerExists, oidc, clientSet)
+	if err != nil {
+		return err
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "install the Amazon EBS CSI driver on cluster %q in %q", meta.Name, meta.Region)
+
+	options.AccountID = parsedARN.AccountID
+	options.WaitTimeout = cmd.ProviderConfig.WaitTimeout
+	options.Plan = cmd.Plan
+	enabler := ebscsi.NewEnabler(cfg, stackManager, ctl.AWSProvider.KMS(), addonManager, oidc, clientSet)
+	if err := enabler.Enable(ctx, options); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
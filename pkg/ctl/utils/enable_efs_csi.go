@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/efscsi"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func enableEFSCSICmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("enable-efs-csi", "Create an EFS file system and install the Amazon EFS CSI driver addon",
+		"Creates an EFS file system with a mount target in each availability zone of the cluster that the nodes are allowed "+
+			"to mount, installs the aws-efs-csi-driver addon with an IAM role for its controller and prints a StorageClass "+
+			"that provisions volumes on the file system")
+
+	var options efscsi.Options
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doEnableEFSCSI(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("EFS CSI driver", func(fs *pflag.FlagSet) {
+		fs.StringVar(&options.KMSKeyID, "kms-key-id", "", "ID or ARN of the KMS key used to encrypt the file system, defaults to the AWS managed key for EFS")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doEnableEFSCSI(cmd *cmdutils.Cmd, options efscsi.Options) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	meta.Version = ctl.ControlPlaneVersion()
+
+	stackManager := ctl.NewStackManager(cfg)
+	stack, err := stackManager.DescribeClusterStack(ctx)
+	if err != nil {
+		return fmt.Errorf("error describing cluster stack: %w", err)
+	}
+	if err := ctl.LoadClusterVPC(ctx, cfg, stack); err != nil {
+		return fmt.Errorf("getting VPC configuration for cluster %q: %w", meta.Name, err)
+	}
+
+	// self-managed nodegroups use the shared node security group, managed nodegroups use the cluster security group
+	if cfg.VPC.SharedNodeSecurityGroup != "" {
+		options.NodeSecurityGroupIDs = append(options.NodeSecurityGroupIDs, cfg.VPC.SharedNodeSecurityGroup)
+	}
+	if vpcConfig := ctl.Status.ClusterInfo.Cluster.ResourcesVpcConfig; vpcConfig != nil && vpcConfig.ClusterSecurityGroupId != nil {
+		options.NodeSecurityGroupIDs = append(options.NodeSecurityGroupIDs, *vpcConfig.ClusterSecurityGroupId)
+	}
+
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	providerExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return err
+	}
+	if !providerExists {
+		return fmt.Errorf("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", meta.Region, meta.Name)
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	addonManager, err := addon.New(cfg, ctl.AWSProvider.EKS(), stackManager, providerExists, oidc, clientSet)
+	if err != nil {
+		return err
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "create an EFS file system and install the Amazon EFS CSI driver on cluster %q in %q", meta.Name, meta.Region)
+
+	options.WaitTimeout = cmd.ProviderConfig.WaitTimeout
+	options.Plan = cmd.Plan
+	enabler := efscsi.NewEnabler(cfg, stackManager, addonManager, oidc, clientSet)
+	storageClass, err := enabler.Enable(ctx, options)
+	if err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	if storageClass == nil {
+		return nil
+	}
+
+	logger.Info("apply the following StorageClass to provision volumes on the file system")
+	return printers.NewYAMLPrinter().PrintObj(storageClass, os.Stdout)
+}
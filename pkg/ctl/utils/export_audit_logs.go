@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/auditlogs"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func exportAuditLogsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("export-audit-logs", "Export the audit logs of a cluster to S3 or OpenSearch",
+		"Subscribes a Firehose delivery stream to the audit events in the CloudWatch log group of the cluster. "+
+			"The delivery stream is created along with the IAM roles for CloudWatch Logs and Firehose unless an existing one is given. "+
+			"Audit logging must be enabled on the cluster.")
+
+	var options auditlogs.Options
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doExportAuditLogs(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Destination", func(fs *pflag.FlagSet) {
+		d := &options.Destination
+		fs.StringVar(&d.DeliveryStreamARN, "delivery-stream-arn", "", "ARN of an existing Firehose delivery stream to send the audit events to")
+		fs.StringVar(&d.S3BucketARN, "s3-bucket-arn", "", "ARN of the S3 bucket to write the audit events to, or to back up the events that fail to be indexed in OpenSearch")
+		fs.StringVar(&d.S3Prefix, "s3-prefix", "", "prefix of the keys of the objects written to the S3 bucket")
+		fs.StringVar(&d.OpenSearchDomainARN, "opensearch-domain-arn", "", "ARN of the OpenSearch domain to index the audit events in")
+		fs.StringVar(&d.OpenSearchIndexName, "opensearch-index", auditlogs.DefaultOpenSearchIndexName, "name of the OpenSearch index")
+		fs.StringVar(&options.FilterPattern, "filter-pattern", auditlogs.DefaultFilterPattern, "CloudWatch Logs filter pattern selecting the log events to export")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doExportAuditLogs(cmd *cmdutils.Cmd, options auditlogs.Options) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	if err := options.Validate(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "export the audit logs of cluster %q in %q", meta.Name, meta.Region)
+
+	options.Plan = cmd.Plan
+	deliveryStreamARN, err := auditlogs.NewExporter(cfg, ctl.NewStackManager(cfg), ctl).Export(ctx, options)
+	if err != nil {
+		return err
+	}
+	if !cmd.Plan {
+		cmdutils.LogCompletedAction(false, "audit logs of cluster %q are exported to delivery stream %q", meta.Name, deliveryStreamARN)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
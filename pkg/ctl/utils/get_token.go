@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthv1beta1 "k8s.io/client-go/pkg/apis/clientauthentication/v1beta1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/credentials"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/eks/auth"
+)
+
+const (
+	tokenOutputExecCredential = "exec-credential"
+	tokenOutputRaw            = "raw"
+)
+
+type getTokenOptions struct {
+	roleARN string
+	output  string
+	expiry  bool
+	cache   bool
+}
+
+func getTokenCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &getTokenOptions{}
+
+	cmd.SetDescription("get-token", "Get a token to authenticate to a cluster",
+		"Prints an ExecCredential holding a token to authenticate to the cluster, for use as the exec credential plugin of a kubeconfig")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doGetToken(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringVar(&options.roleARN, "role-arn", "", "AWS IAM role to assume to get the token")
+		fs.StringVarP(&options.output, "output", "o", tokenOutputExecCredential, fmt.Sprintf("specifies the output format (valid options: %s, %s for the bare token)", tokenOutputExecCredential, tokenOutputRaw))
+		fs.BoolVar(&options.expiry, "expiry", false, fmt.Sprintf("with --output %s, print the expiration time of the token on a second line", tokenOutputRaw))
+		fs.BoolVar(&options.cache, "cache", false, fmt.Sprintf("cache the token on disk, per cluster and role, until it expires (the directory defaults to the value of the %s environment variable, or ~/.eksctl/cache/tokens)", auth.TokenCacheDirEnvName))
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doGetToken(cmd *cmdutils.Cmd, options *getTokenOptions) error {
+	// stdout is read by kubectl, it must only hold the ExecCredential
+	logger.Writer = os.Stderr
+
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	switch options.output {
+	case tokenOutputExecCredential, tokenOutputRaw:
+	default:
+		return fmt.Errorf("invalid output %q, valid options: %s, %s", options.output, tokenOutputExecCredential, tokenOutputRaw)
+	}
+	if options.expiry && options.output != tokenOutputRaw {
+		return fmt.Errorf("--expiry can only be used with --output %s, the ExecCredential always holds the expiration of the token", tokenOutputRaw)
+	}
+
+	clusterID := cmd.ClusterConfig.Metadata.Name
+	var tokenCache *auth.TokenCache
+	cacheKey := newTokenCacheKey(cmd, options.roleARN)
+	if options.cache {
+		dir, err := auth.GetTokenCacheDir()
+		if err != nil {
+			return fmt.Errorf("getting token cache directory: %w", err)
+		}
+		tokenCache = auth.NewTokenCache(dir, &credentials.RealClock{})
+		// a cached token saves the calls to STS, which throttles many shells getting tokens at once
+		if token, ok := tokenCache.Get(cacheKey); ok {
+			logger.Debug("using cached token for cluster %q", clusterID)
+			return printToken(cmd.CobraCommand.OutOrStdout(), token, options)
+		}
+	}
+
+	if options.roleARN != "" {
+		cmd.ProviderConfig.AssumeRoles = append(cmd.ProviderConfig.AssumeRoles, api.AWSAssumeRole{RoleARN: options.roleARN})
+	}
+
+	ctx := context.Background()
+	ctl, err := eks.New(ctx, &cmd.ProviderConfig, nil)
+	if err != nil {
+		return err
+	}
+
+	token, err := auth.NewGenerator(ctl.AWSProvider.STSPresigner(), &credentials.RealClock{}).GetWithSTS(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if tokenCache != nil {
+		if err := tokenCache.Put(cacheKey, token); err != nil {
+			logger.Warning("caching token for cluster %q: %v", clusterID, err)
+		}
+	}
+
+	return printToken(cmd.CobraCommand.OutOrStdout(), token, options)
+}
+
+// newTokenCacheKey returns the key of the cached token of the cluster of cmd. The access key ID of the environment
+// is read rather than resolving the credentials, as that may call STS, which the cache saves
+func newTokenCacheKey(cmd *cmdutils.Cmd, roleARN string) auth.TokenCacheKey {
+	return auth.TokenCacheKey{
+		ClusterID:   cmd.ClusterConfig.Metadata.Name,
+		Region:      cmd.ProviderConfig.Region,
+		Profile:     cmd.ProviderConfig.Profile.Name,
+		AccessKeyID: os.Getenv("AWS_ACCESS_KEY_ID"),
+		RoleARN:     roleARN,
+		SessionName: cmd.ProviderConfig.RoleSession.Name,
+	}
+}
+
+func printToken(w io.Writer, token auth.Token, options *getTokenOptions) error {
+	if options.output == tokenOutputRaw {
+		if options.expiry {
+			_, err := fmt.Fprintf(w, "%s\n%s\n", token.Token, token.Expiration.UTC().Format(time.RFC3339))
+			return err
+		}
+		_, err := fmt.Fprintln(w, token.Token)
+		return err
+	}
+	return json.NewEncoder(w).Encode(newExecCredential(token))
+}
+
+func newExecCredential(token auth.Token) *clientauthv1beta1.ExecCredential {
+	expiration := metav1.NewTime(token.Expiration.UTC())
+	return &clientauthv1beta1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clientauthv1beta1.SchemeGroupVersion.String(),
+			Kind:       "ExecCredential",
+		},
+		Status: &clientauthv1beta1.ExecCredentialStatus{
+			Token:               token.Token,
+			ExpirationTimestamp: &expiration,
+		},
+	}
+}
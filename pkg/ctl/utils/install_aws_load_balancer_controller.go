@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/loadbalancercontroller"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers/helm"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+func installAWSLoadBalancerControllerCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("install-aws-load-balancer-controller", "Install the AWS Load Balancer Controller",
+		"Installs the AWS Load Balancer Controller with an IAM service account and tags the cluster subnets "+
+			"for internet-facing and internal load balancers")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doInstallAWSLoadBalancerController(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doInstallAWSLoadBalancerController(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	parsedARN, err := arn.Parse(cfg.Status.ARN)
+	if err != nil {
+		return fmt.Errorf("unexpected invalid ARN: %q: %w", cfg.Status.ARN, err)
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	stack, err := stackManager.DescribeClusterStack(ctx)
+	if err != nil {
+		return fmt.Errorf("error describing cluster stack: %w", err)
+	}
+	if err := ctl.LoadClusterVPC(ctx, cfg, stack); err != nil {
+		return fmt.Errorf("getting VPC configuration for cluster %q: %w", meta.Name, err)
+	}
+
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	providerExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return err
+	}
+	if !providerExists {
+		return fmt.Errorf("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", meta.Region, meta.Name)
+	}
+
+	restClientGetter, err := ctl.NewRESTClientGetter(cfg, loadbalancercontroller.Namespace)
+	if err != nil {
+		return err
+	}
+	helmInstaller, err := helm.NewInstaller(helm.Options{
+		Namespace:        loadbalancercontroller.Namespace,
+		RESTClientGetter: restClientGetter,
+	})
+	if err != nil {
+		return err
+	}
+	clientSetGetter := &kubernetes.CallbackClientSet{
+		Callback: func() (kubernetes.Interface, error) {
+			return ctl.NewStdClientSet(cfg)
+		},
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "install the AWS Load Balancer Controller on cluster %q in %q", meta.Name, meta.Region)
+
+	installer := loadbalancercontroller.NewInstaller(cfg, stackManager, ctl.AWSProvider.EC2(), helmInstaller, oidc, clientSetGetter)
+	if err := installer.Install(ctx, loadbalancercontroller.Options{
+		AccountID: parsedARN.AccountID,
+		Plan:      cmd.Plan,
+	}); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
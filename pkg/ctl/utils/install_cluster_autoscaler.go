@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/clusterautoscaler"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers/helm"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+func installClusterAutoscalerCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("install-cluster-autoscaler", "Install Cluster Autoscaler",
+		"Installs Cluster Autoscaler with an IAM service account, tags the Auto Scaling groups of all nodegroups for auto-discovery "+
+			"and picks the Cluster Autoscaler version matching the Kubernetes version of the cluster")
+
+	var imageTag string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doInstallClusterAutoscaler(cmd, imageTag)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		fs.StringVar(&imageTag, "version", "", "Cluster Autoscaler version (image tag) to install, defaults to the release matching the Kubernetes version of the cluster")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doInstallClusterAutoscaler(cmd *cmdutils.Cmd, imageTag string) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	providerExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return err
+	}
+	if !providerExists {
+		return fmt.Errorf("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", meta.Region, meta.Name)
+	}
+
+	restClientGetter, err := ctl.NewRESTClientGetter(cfg, clusterautoscaler.Namespace)
+	if err != nil {
+		return err
+	}
+	helmInstaller, err := helm.NewInstaller(helm.Options{
+		Namespace:        clusterautoscaler.Namespace,
+		RESTClientGetter: restClientGetter,
+	})
+	if err != nil {
+		return err
+	}
+	clientSetGetter := &kubernetes.CallbackClientSet{
+		Callback: func() (kubernetes.Interface, error) {
+			return ctl.NewStdClientSet(cfg)
+		},
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "install Cluster Autoscaler on cluster %q in %q", meta.Name, meta.Region)
+
+	installer := clusterautoscaler.NewInstaller(cfg, ctl.NewStackManager(cfg), ctl.AWSProvider.ASG(), helmInstaller, oidc, clientSetGetter)
+	if err := installer.Install(ctx, clusterautoscaler.Options{
+		KubernetesVersion: ctl.ControlPlaneVersion(),
+		ImageTag:          imageTag,
+		Plan:              cmd.Plan,
+	}); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodeterminationhandler"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/karpenter/providers/helm"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+func installNodeTerminationHandlerCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("install-node-termination-handler", "Install AWS Node Termination Handler for self-managed Spot nodegroups",
+		"Installs AWS Node Termination Handler in queue mode with an SQS queue and EventBridge rules for interruption events, "+
+			"and adds a termination lifecycle hook to the Auto Scaling groups of the self-managed nodegroups using Spot Instances")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doInstallNodeTerminationHandler(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doInstallNodeTerminationHandler(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	oidc, err := ctl.NewOpenIDConnectManager(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	providerExists, err := oidc.CheckProviderExists(ctx)
+	if err != nil {
+		return err
+	}
+	if !providerExists {
+		return fmt.Errorf("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", meta.Region, meta.Name)
+	}
+
+	restClientGetter, err := ctl.NewRESTClientGetter(cfg, nodeterminationhandler.Namespace)
+	if err != nil {
+		return err
+	}
+	helmInstaller, err := helm.NewInstaller(helm.Options{
+		Namespace:        nodeterminationhandler.Namespace,
+		RESTClientGetter: restClientGetter,
+	})
+	if err != nil {
+		return err
+	}
+	clientSetGetter := &kubernetes.CallbackClientSet{
+		Callback: func() (kubernetes.Interface, error) {
+			return ctl.NewStdClientSet(cfg)
+		},
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "install AWS Node Termination Handler on cluster %q in %q", meta.Name, meta.Region)
+
+	installer := nodeterminationhandler.NewInstaller(cfg, ctl.NewStackManager(cfg), ctl.AWSProvider.ASG(), ctl.AWSProvider.EC2(), helmInstaller, oidc, clientSetGetter)
+	if err := installer.Install(ctx, nodeterminationhandler.Options{
+		Plan: cmd.Plan,
+	}); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
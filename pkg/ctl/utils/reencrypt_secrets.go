@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const reencryptSecretsTimeout = 1 * time.Hour
+
+type reencryptSecretsOptions struct {
+	batchSize     int64
+	batchInterval time.Duration
+	since         string
+}
+
+func reencryptSecretsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("reencrypt-secrets", "Re-encrypt all secrets with the current KMS key",
+		"Updates all Secret resources in batches so that they are encrypted again, e.g. after the KMS key used for envelope encryption "+
+			"of the cluster has been rotated. An interrupted run can be resumed with --since.")
+
+	var options reencryptSecretsOptions
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doReencryptSecrets(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, reencryptSecretsTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Re-encryption", func(fs *pflag.FlagSet) {
+		fs.Int64Var(&options.batchSize, "batch-size", 100, "number of secrets updated at a time")
+		fs.DurationVar(&options.batchInterval, "batch-interval", 0, "time to wait between batches, to limit the load on the API server and KMS")
+		fs.StringVar(&options.since, "since", "", "skip the secrets already re-encrypted at or after this time (RFC3339), to resume an interrupted run")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doReencryptSecrets(cmd *cmdutils.Cmd, options reencryptSecretsOptions) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	if options.batchSize <= 0 {
+		return errors.New("--batch-size must be greater than 0")
+	}
+	var since time.Time
+	if options.since != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, options.since); err != nil {
+			return fmt.Errorf("invalid value %q for --since, must be an RFC3339 time: %w", options.since, err)
+		}
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+	if !hasSecretsEncryption(ctl.Status.ClusterInfo.Cluster.EncryptionConfig) {
+		return fmt.Errorf("KMS encryption is not enabled on cluster %q, try 'eksctl utils enable-secrets-encryption --cluster=%s --key-arn=<key>'", meta.Name, meta.Name)
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "re-encrypt all secrets in cluster %q in batches of %d", meta.Name, options.batchSize)
+	if cmd.Plan {
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctl.AWSProvider.WaitTimeout())
+	defer cancel()
+
+	// secrets refreshed from now on are skipped when resuming
+	resumeSince := time.Now()
+	if !since.IsZero() {
+		resumeSince = since
+	}
+	progress, err := kubernetes.RefreshSecretsWithOptions(ctx, clientSet.CoreV1(), kubernetes.RefreshSecretsOptions{
+		BatchSize:     options.batchSize,
+		BatchInterval: options.batchInterval,
+		Since:         since,
+		Progress: func(p kubernetes.RefreshSecretsProgress) {
+			logger.Info("processed %d/%d secrets (%d re-encrypted, %d already re-encrypted)", p.Processed(), p.Total, p.Refreshed, p.Skipped)
+		},
+	})
+	if err != nil {
+		logger.Warning("re-encrypted %d secrets before failing, to resume run the command again with --since=%s", progress.Refreshed, resumeSince.UTC().Format(time.RFC3339))
+		return errors.Wrap(err, "error re-encrypting secrets")
+	}
+	cmdutils.LogCompletedAction(false, "re-encrypted %d secrets in cluster %q", progress.Refreshed, meta.Name)
+	return nil
+}
+
+func hasSecretsEncryption(encryptionConfig []ekstypes.EncryptionConfig) bool {
+	for _, e := range encryptionConfig {
+		for _, r := range e.Resources {
+			if r == "secrets" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
+)
+
+func rollNodeGroupCmd(cmd *cmdutils.Cmd) {
+	rollNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, input *nodegroup.RollInput) error {
+		return doRollNodeGroup(cmd, input)
+	})
+}
+
+func rollNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, input *nodegroup.RollInput) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("roll-nodegroup", "Replace every instance of a nodegroup",
+		"Replaces the instances of a nodegroup one at a time: each node is cordoned and drained, its instance terminated, "+
+			"and the Auto Scaling group is waited on until a replacement has joined the cluster. This picks up changes to the launch template "+
+			"without changing the Kubernetes version, and replaces nodes in a bad state.")
+
+	input := &nodegroup.RollInput{}
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		if err := input.EvictionOptions.PDBTimeoutBehavior.Validate(); err != nil {
+			return err
+		}
+		return runFunc(cmd, input)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringVarP(&input.NodeGroupName, "name", "n", "", "Name of the nodegroup to roll")
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Drain", func(fs *pflag.FlagSet) {
+		defaultMaxGracePeriod, _ := time.ParseDuration("10m")
+		fs.DurationVar(&input.MaxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period")
+		defaultPodEvictionWaitPeriod, _ := time.ParseDuration("10s")
+		fs.DurationVar(&input.PodEvictionWaitPeriod, "pod-eviction-wait-period", defaultPodEvictionWaitPeriod, "Duration to wait after failing to evict a pod")
+		fs.BoolVar(&input.DisableEviction, "disable-eviction", false, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		fs.DurationVar(&input.EvictionOptions.PodGracePeriod, "pod-grace-period", 0, "Termination grace period of evicted pods, overriding the one in their spec and capped by --max-grace-period")
+		fs.DurationVar(&input.EvictionOptions.PDBTimeout, "pdb-timeout", 0, "Duration to retry evicting a pod blocked by a PodDisruptionBudget before applying --pdb-timeout-behavior")
+		fs.StringVar((*string)(&input.EvictionOptions.PDBTimeoutBehavior), "pdb-timeout-behavior", string(drain.PDBTimeoutBehaviorBlock), "What to do with pods still blocked by a PodDisruptionBudget after --pdb-timeout: block (keep retrying), skip (leave them on the node) or force (delete them)")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doRollNodeGroup(cmd *cmdutils.Cmd, input *nodegroup.RollInput) error {
+	if err := cmdutils.NewUtilsRollNodeGroupLoader(cmd, input.NodeGroupName).Load(); err != nil {
+		return err
+	}
+	cfg := cmd.ClusterConfig
+
+	ctx, cancel := context.WithTimeout(context.Background(), cmd.ProviderConfig.WaitTimeout)
+	defer cancel()
+
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if cfg.IsControlPlaneOnOutposts() {
+		return errUnsupportedLocalCluster
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	input.Plan = cmd.Plan
+	if err := nodegroup.New(cfg, ctl, clientSet, selector.New(ctl.AWSProvider.Session())).Roll(ctx, input); err != nil {
+		return err
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
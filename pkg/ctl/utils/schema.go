@@ -2,21 +2,40 @@ package utils
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
 
+// schemas holds the JSON Schema of ClusterConfig for each supported API version
+var schemas = map[string]string{
+	api.CurrentGroupVersion: api.SchemaJSON,
+}
+
 func schemaCmd(cmd *cmdutils.Cmd) {
+	var version string
+
 	cmd.SetDescription("schema", "Output the ClusterConfig JSON Schema", "")
 
-	cmd.CobraCommand.Run = func(_ *cobra.Command, args []string) {
-		doSchemaCmd(cmd)
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		return doSchemaCmd(version, cmd.CobraCommand.OutOrStdout())
 	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&version, "version", api.CurrentGroupVersion, "API version of the ClusterConfig schema")
+	})
 }
 
-func doSchemaCmd(cmd *cmdutils.Cmd) {
-	fmt.Printf("%s", api.SchemaJSON)
+func doSchemaCmd(version string, w io.Writer) error {
+	schema, ok := schemas[version]
+	if !ok {
+		return fmt.Errorf("unsupported API version %q, supported versions: %s", version, api.CurrentGroupVersion)
+	}
+	_, err := fmt.Fprint(w, schema)
+	return err
 }
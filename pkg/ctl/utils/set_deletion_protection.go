@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func setDeletionProtectionCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("set-deletion-protection", "Enable or disable deletion protection of a cluster",
+		"Enables or disables termination protection on the cluster stack. `eksctl delete cluster` refuses to delete a cluster with deletion protection enabled.")
+
+	var enabled bool
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doSetDeletionProtection(cmd, enabled)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Deletion protection", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&enabled, "deletion-protection", false, "Protect the cluster from being deleted")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doSetDeletionProtection(cmd *cmdutils.Cmd, enabled bool) error {
+	if err := cmdutils.NewUtilsSetDeletionProtectionLoader(cmd, enabled).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	stackManager := ctl.NewStackManager(cfg)
+	stack, err := stackManager.DescribeClusterStackIfExists(ctx)
+	if err != nil {
+		return err
+	}
+	if stack == nil {
+		return fmt.Errorf("deletion protection is only supported for clusters created by eksctl, no stack found for cluster %q", meta.Name)
+	}
+
+	desired := api.IsEnabled(meta.DeletionProtection)
+	if aws.ToBool(stack.EnableTerminationProtection) == desired {
+		logger.Success("deletion protection of cluster %q in %q is already up to date (enabled: %t)", meta.Name, meta.Region, desired)
+		return nil
+	}
+
+	cmdutils.LogIntendedAction(
+		cmd.Plan, "update deletion protection of cluster %q in %q (enabled: %t)", meta.Name, meta.Region, desired)
+
+	if !cmd.Plan {
+		if err := stackManager.UpdateStackTerminationProtection(ctx, aws.ToString(stack.StackName), desired); err != nil {
+			return err
+		}
+		cmdutils.LogCompletedAction(
+			false,
+			"deletion protection of cluster %q in %q has been updated (enabled: %t)", meta.Name, meta.Region, desired)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
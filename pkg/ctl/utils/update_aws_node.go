@@ -66,5 +66,6 @@ func doUpdateAWSNode(cmd *cmdutils.Cmd) error {
 
 	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
 
+	cmdutils.RecordHistory(ctx, cmd, ctl)
 	return nil
 }
@@ -2,10 +2,13 @@ package utils
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
@@ -14,13 +17,17 @@ import (
 var (
 	private bool
 	public  bool
+
+	addPublicAccessCIDRs    []string
+	removePublicAccessCIDRs []string
 )
 
 func updateClusterEndpointsCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
-	cmd.SetDescription("update-cluster-endpoints", "Update Kubernetes API endpoint access configuration", "")
+	cmd.SetDescription("update-cluster-endpoints", "Update Kubernetes API endpoint access configuration",
+		"Public access CIDRs can be replaced, or CIDRs added to and removed from the current ones; without --approve, the changes are only shown")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
 		return doUpdateClusterEndpoints(cmd, private, public)
@@ -38,12 +45,15 @@ func updateClusterEndpointsCmd(cmd *cmdutils.Cmd) {
 		func(fs *pflag.FlagSet) {
 			fs.BoolVar(&private, "private-access", false, "access for private (VPC) clients")
 			fs.BoolVar(&public, "public-access", false, "access for public clients")
+			fs.StringSliceVar(&cfg.VPC.PublicAccessCIDRs, "public-access-cidrs", nil, "CIDR blocks allowed to access the public endpoint, replacing the current ones")
+			fs.StringSliceVar(&addPublicAccessCIDRs, "add-public-access-cidrs", nil, "CIDR blocks to add to the ones allowed to access the public endpoint")
+			fs.StringSliceVar(&removePublicAccessCIDRs, "remove-public-access-cidrs", nil, "CIDR blocks to remove from the ones allowed to access the public endpoint")
 		})
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 }
 
 func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool) error {
-	if err := cmdutils.NewUtilsEnableEndpointAccessLoader(cmd, newPrivate, newPublic).Load(); err != nil {
+	if err := cmdutils.NewUtilsEnableEndpointAccessLoader(cmd, newPrivate, newPublic, addPublicAccessCIDRs, removePublicAccessCIDRs).Load(); err != nil {
 		return err
 	}
 
@@ -86,8 +96,21 @@ func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool
 		newPublic = *cfg.VPC.ClusterEndpoints.PublicAccess
 	}
 
+	curCIDRs := clusterVPCConfig.PublicAccessCIDRs
+	newCIDRs := curCIDRs
+	if cfg.VPC.PublicAccessCIDRs != nil {
+		newCIDRs = cfg.VPC.PublicAccessCIDRs
+	}
+	newCIDRs = applyPublicAccessCIDRChanges(newCIDRs, cfg.VPC.ClusterEndpoints.AddPublicAccessCIDRs, cfg.VPC.ClusterEndpoints.RemovePublicAccessCIDRs)
+	if len(newCIDRs) == 0 {
+		return errors.New("at least one public access CIDR must remain; disable public access instead of removing all CIDRs")
+	}
+
+	endpointsChanged := newPrivate != curPrivate || newPublic != curPublic
+	cidrsChanged := !cidrsEqual(curCIDRs, newCIDRs)
+
 	// Nothing changed?
-	if newPrivate == curPrivate && newPublic == curPublic {
+	if !endpointsChanged && !cidrsChanged {
 		logger.Success("Kubernetes API endpoint access for cluster %q in %q is already up to date",
 			meta.Name, meta.Region)
 		return nil
@@ -95,10 +118,20 @@ func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool
 
 	cfg.VPC.ClusterEndpoints.PrivateAccess = &newPrivate
 	cfg.VPC.ClusterEndpoints.PublicAccess = &newPublic
+	cfg.VPC.PublicAccessCIDRs = newCIDRs
 
-	cmdutils.LogIntendedAction(
-		cmd.Plan, "update Kubernetes API endpoint access for cluster %q in %q to: privateAccess=%v, publicAccess=%v",
-		meta.Name, meta.Region, newPrivate, newPublic)
+	if endpointsChanged {
+		cmdutils.LogIntendedAction(
+			cmd.Plan, "update Kubernetes API endpoint access for cluster %q in %q to: privateAccess=%v, publicAccess=%v",
+			meta.Name, meta.Region, newPrivate, newPublic)
+	}
+	if cidrsChanged {
+		logger.Info("current public access CIDRs: %v", curCIDRs)
+		logListDiff("public access CIDRs", curCIDRs, newCIDRs)
+		cmdutils.LogIntendedAction(
+			cmd.Plan, "update Public Endpoint Restrictions for cluster %q in %q to: %v",
+			meta.Name, meta.Region, newCIDRs)
+	}
 
 	if err := cfg.ValidateClusterEndpointConfig(); err != nil {
 		return err
@@ -110,16 +143,54 @@ func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool
 	}
 
 	if !cmd.Plan {
-		if err := ctl.UpdateClusterConfigForEndpoints(ctx, cfg); err != nil {
-			return err
+		if endpointsChanged {
+			if err := ctl.UpdateClusterConfigForEndpoints(ctx, cfg); err != nil {
+				return err
+			}
+			cmdutils.LogCompletedAction(
+				false,
+				"the Kubernetes API endpoint access for cluster %q in %q has been updated to: "+
+					"privateAccess=%v, publicAccess=%v",
+				meta.Name, meta.Region, newPrivate, newPublic)
+		}
+		if cidrsChanged {
+			if err := ctl.UpdatePublicAccessCIDRs(ctx, cfg); err != nil {
+				return fmt.Errorf("error updating CIDRs for public access: %w", err)
+			}
+			cmdutils.LogCompletedAction(
+				false,
+				"Public Endpoint Restrictions for cluster %q in %q have been updated to: %v",
+				meta.Name, meta.Region, newCIDRs)
 		}
-		cmdutils.LogCompletedAction(
-			false,
-			"the Kubernetes API endpoint access for cluster %q in %q has been updated to: "+
-				"privateAccess=%v, publicAccess=%v",
-			meta.Name, meta.Region, newPrivate, newPublic)
 	}
 	cmdutils.LogPlanModeWarning(cmd.Plan)
 
+	cmdutils.RecordHistory(ctx, cmd, ctl)
 	return nil
 }
+
+// applyPublicAccessCIDRChanges returns cidrs with toAdd added and toRemove removed, keeping the original order
+func applyPublicAccessCIDRChanges(cidrs, toAdd, toRemove []string) []string {
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return cidrs
+	}
+	current := sets.NewString(cidrs...)
+	removed := sets.NewString(toRemove...)
+	for _, cidr := range removed.Difference(current).List() {
+		logger.Warning("CIDR %q is not in the public access CIDRs, nothing to remove", cidr)
+	}
+
+	var result []string
+	for _, cidr := range cidrs {
+		if !removed.Has(cidr) {
+			result = append(result, cidr)
+		}
+	}
+	for _, cidr := range toAdd {
+		if !current.Has(cidr) {
+			result = append(result, cidr)
+			current.Insert(cidr)
+		}
+	}
+	return result
+}
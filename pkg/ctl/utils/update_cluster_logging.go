@@ -13,6 +13,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
@@ -20,13 +21,17 @@ func enableLoggingCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
-	cmd.SetDescription("update-cluster-logging", "Update cluster logging configuration", "")
+	cmd.SetDescription("update-cluster-logging", "Update cluster logging configuration",
+		"Enables or disables individual control plane log types and sets the retention and KMS key of their CloudWatch log group. "+
+			"Only the settings that differ from the current configuration are updated.")
 
 	var typesEnabled []string
 	var typesDisabled []string
+	var logRetentionInDays int
+	var logGroupKMSKeyID string
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doEnableLogging(cmd, typesEnabled, typesDisabled)
+		return doEnableLogging(cmd, typesEnabled, typesDisabled, logRetentionInDays, logGroupKMSKeyID)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -45,15 +50,21 @@ func enableLoggingCmd(cmd *cmdutils.Cmd) {
 
 	})
 
+	cmd.FlagSetGroup.InFlagSet("Log group", func(fs *pflag.FlagSet) {
+		fs.IntVar(&logRetentionInDays, "log-retention-days", 0, fmt.Sprintf("Number of days to retain the logs for. Supported values: %v", api.LogRetentionInDaysValues))
+		fs.StringVar(&logGroupKMSKeyID, "log-group-kms-key-arn", "", "ARN of the KMS key to encrypt the logs with")
+	})
+
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 }
 
-func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDisable []string) error {
-	if err := cmdutils.NewUtilsEnableLoggingLoader(cmd).Load(); err != nil {
+func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable, logTypesToDisable []string, logRetentionInDays int, logGroupKMSKeyID string) error {
+	if err := cmdutils.NewUtilsEnableLoggingLoader(cmd, logRetentionInDays, logGroupKMSKeyID).Load(); err != nil {
 		return err
 	}
 
-	if !cmd.ClusterConfig.HasClusterCloudWatchLogging() {
+	onlyLogGroupFlags := len(logTypesToEnable) == 0 && len(logTypesToDisable) == 0 && (logRetentionInDays != 0 || logGroupKMSKeyID != "")
+	if !cmd.ClusterConfig.HasClusterCloudWatchLogging() && !onlyLogGroupFlags {
 		if err := validateLoggingFlags(logTypesToEnable, logTypesToDisable); err != nil {
 			return err
 		}
@@ -84,34 +95,37 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 	}
 
 	cfg.CloudWatch.ClusterLogging.EnableTypes = willBeEnabled.List()
-	willBeDisabled := sets.NewString(api.SupportedCloudWatchClusterLogTypes()...).Difference(willBeEnabled)
-	updateRequired := !currentlyEnabled.Equal(willBeEnabled)
-
-	if err = printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := api.ValidateCloudWatchLogging(cfg); err != nil {
 		return err
 	}
 
-	if updateRequired {
-		describeTypesToEnable := "no types to enable"
-		if len(willBeEnabled.List()) > 0 {
-			describeTypesToEnable = fmt.Sprintf("enable types: %s", strings.Join(willBeEnabled.List(), ", "))
+	var currentLogGroup *eks.ClusterLogGroup
+	if cfg.CloudWatch.ClusterLogging.LogRetentionInDays != 0 || cfg.CloudWatch.ClusterLogging.LogGroupKMSKeyID != "" {
+		if currentLogGroup, err = ctl.GetCurrentClusterLogGroup(ctx, meta.Name); err != nil {
+			return err
 		}
+	}
 
-		describeTypesToDisable := "no types to disable"
-		if len(willBeDisabled.List()) > 0 {
-			describeTypesToDisable = fmt.Sprintf("disable types: %s", strings.Join(willBeDisabled.List(), ", "))
-		}
+	if err = printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+		return err
+	}
+
+	typesUpdateRequired := !currentlyEnabled.Equal(willBeEnabled)
+	diff := loggingConfigDiff(currentlyEnabled, willBeEnabled, currentLogGroup, cfg.CloudWatch.ClusterLogging)
+	updateRequired := len(diff) > 0
 
-		cmdutils.LogIntendedAction(cmd.Plan, "update CloudWatch logging for cluster %q in %q (%s & %s)",
-			meta.Name, meta.Region, describeTypesToEnable, describeTypesToDisable,
-		)
-		if period := cfg.CloudWatch.ClusterLogging.LogRetentionInDays; period > 0 {
-			cmdutils.LogIntendedAction(cmd.Plan, "update CloudWatch logging for log retention period set to %d",
-				period,
-			)
+	if updateRequired {
+		cmdutils.LogIntendedAction(cmd.Plan, "update CloudWatch logging for cluster %q in %q", meta.Name, meta.Region)
+		for _, change := range diff {
+			logger.Info("  %s", change)
 		}
 		if !cmd.Plan {
-			if err := ctl.UpdateClusterConfigForLogging(ctx, cfg); err != nil {
+			if typesUpdateRequired {
+				err = ctl.UpdateClusterConfigForLogging(ctx, cfg)
+			} else {
+				err = ctl.UpdateClusterLogGroup(ctx, cfg)
+			}
+			if err != nil {
 				return err
 			}
 		}
@@ -121,9 +135,41 @@ func doEnableLogging(cmd *cmdutils.Cmd, logTypesToEnable []string, logTypesToDis
 
 	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
 
+	cmdutils.RecordHistory(ctx, cmd, ctl)
 	return nil
 }
 
+// loggingConfigDiff describes the changes from the current to the desired logging configuration, one per line
+func loggingConfigDiff(currentlyEnabled, willBeEnabled sets.String, currentLogGroup *eks.ClusterLogGroup, desired *api.ClusterCloudWatchLogging) []string {
+	var diff []string
+	for _, logType := range willBeEnabled.Difference(currentlyEnabled).List() {
+		diff = append(diff, fmt.Sprintf("+ enable log type %s", logType))
+	}
+	for _, logType := range currentlyEnabled.Difference(willBeEnabled).List() {
+		diff = append(diff, fmt.Sprintf("- disable log type %s", logType))
+	}
+
+	current := eks.ClusterLogGroup{}
+	if currentLogGroup != nil {
+		current = *currentLogGroup
+	}
+	if desired.LogRetentionInDays != 0 && desired.LogRetentionInDays != current.RetentionInDays {
+		currentRetention := "never expire"
+		if current.RetentionInDays != 0 {
+			currentRetention = fmt.Sprintf("%d days", current.RetentionInDays)
+		}
+		diff = append(diff, fmt.Sprintf("~ log retention: %s -> %d days", currentRetention, desired.LogRetentionInDays))
+	}
+	if desired.LogGroupKMSKeyID != "" && desired.LogGroupKMSKeyID != current.KMSKeyID {
+		currentKey := "none"
+		if current.KMSKeyID != "" {
+			currentKey = current.KMSKeyID
+		}
+		diff = append(diff, fmt.Sprintf("~ log group KMS key: %s -> %s", currentKey, desired.LogGroupKMSKeyID))
+	}
+	return diff
+}
+
 func validateLoggingFlags(toEnable, toDisable []string) error {
 	// At least enable-types or disable-types should be provided
 	if len(toEnable) == 0 && len(toDisable) == 0 {
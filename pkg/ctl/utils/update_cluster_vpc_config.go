@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+func updateClusterVPCConfigCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-cluster-vpc-config", "Update the subnets and security groups of the control plane",
+		"Subnets can be added to let the control plane use new availability zones, and the security groups attached to the control plane network interfaces can be changed")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateClusterVPCConfig(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("VPC configuration", func(fs *pflag.FlagSet) {
+		fs.StringSliceVar(&cfg.VPC.ControlPlaneSubnetIDs, "control-plane-subnet-ids", nil, "Subnets for the control plane network interfaces, replacing the current ones")
+		fs.StringSliceVar(&cfg.VPC.ControlPlaneSecurityGroupIDs, "control-plane-security-group-ids", nil, "Security groups for the control plane network interfaces, replacing the current ones")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doUpdateClusterVPCConfig(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewUtilsUpdateClusterVPCConfigLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if cfg.IsControlPlaneOnOutposts() {
+		return errUnsupportedLocalCluster
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	clusterVPCConfig, err := ctl.GetCurrentClusterVPCConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("current control plane subnets: %v, security groups: %v", clusterVPCConfig.SubnetIDs, clusterVPCConfig.SecurityGroupIDs)
+
+	subnetsChanged := len(cfg.VPC.ControlPlaneSubnetIDs) > 0 && !idsEqual(clusterVPCConfig.SubnetIDs, cfg.VPC.ControlPlaneSubnetIDs)
+	securityGroupsChanged := len(cfg.VPC.ControlPlaneSecurityGroupIDs) > 0 && !idsEqual(clusterVPCConfig.SecurityGroupIDs, cfg.VPC.ControlPlaneSecurityGroupIDs)
+	if !subnetsChanged && !securityGroupsChanged {
+		logger.Success("control plane VPC configuration for cluster %q in %q is already up to date", meta.Name, meta.Region)
+		return nil
+	}
+
+	if subnetsChanged {
+		if err := vpc.ValidateControlPlaneSubnets(ctx, ctl.AWSProvider.EC2(), clusterVPCConfig.VPCID, cfg.VPC.ControlPlaneSubnetIDs); err != nil {
+			return errors.Wrap(err, "invalid control plane subnets")
+		}
+		logListDiff("subnets", clusterVPCConfig.SubnetIDs, cfg.VPC.ControlPlaneSubnetIDs)
+	} else {
+		cfg.VPC.ControlPlaneSubnetIDs = nil
+	}
+	if securityGroupsChanged {
+		if err := vpc.ValidateControlPlaneSecurityGroups(ctx, ctl.AWSProvider.EC2(), clusterVPCConfig.VPCID, cfg.VPC.ControlPlaneSecurityGroupIDs); err != nil {
+			return errors.Wrap(err, "invalid control plane security groups")
+		}
+		logListDiff("security groups", clusterVPCConfig.SecurityGroupIDs, cfg.VPC.ControlPlaneSecurityGroupIDs)
+	} else {
+		cfg.VPC.ControlPlaneSecurityGroupIDs = nil
+	}
+
+	cmdutils.LogIntendedAction(
+		cmd.Plan, "update control plane VPC configuration for cluster %q in %q", meta.Name, meta.Region)
+
+	if !cmd.Plan {
+		if err := ctl.UpdateClusterVPCConfig(ctx, cfg); err != nil {
+			return errors.Wrap(err, "error updating control plane VPC configuration")
+		}
+		cmdutils.LogCompletedAction(
+			false,
+			"control plane VPC configuration for cluster %q in %q has been updated", meta.Name, meta.Region)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
+}
+
+func idsEqual(currentValues, newValues []string) bool {
+	return sets.NewString(currentValues...).Equal(sets.NewString(newValues...))
+}
+
+func logListDiff(resource string, currentValues, newValues []string) {
+	current, desired := sets.NewString(currentValues...), sets.NewString(newValues...)
+	if added := desired.Difference(current); added.Len() > 0 {
+		logger.Info("%s to add: %v", resource, added.List())
+	}
+	if removed := current.Difference(desired); removed.Len() > 0 {
+		logger.Info("%s to remove: %v", resource, removed.List())
+	}
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+func updateControlPlaneSecurityGroupIngressCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-control-plane-security-group-ingress", "Update the ingress rules of the control plane security group",
+		"Adds, updates and removes the ingress rules of the control plane security group created by eksctl to match vpc.controlPlaneSecurityGroupIngress; "+
+			"the rules are managed in the cluster stack")
+
+	var ingressCIDRs []string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateControlPlaneSecurityGroupIngress(cmd, ingressCIDRs)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Ingress rules", func(fs *pflag.FlagSet) {
+		fs.StringSliceVar(&ingressCIDRs, "ingress-cidrs", nil,
+			"CIDRs allowed to reach the control plane on port 443; rules for CIDRs not in this list are removed")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doUpdateControlPlaneSecurityGroupIngress(cmd *cmdutils.Cmd, ingressCIDRs []string) error {
+	if err := cmdutils.NewUtilsUpdateControlPlaneSecurityGroupIngressLoader(cmd, ingressCIDRs).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if cfg.IsControlPlaneOnOutposts() {
+		return errUnsupportedLocalCluster
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	clusterStack, err := stackManager.DescribeClusterStack(ctx)
+	if err != nil {
+		return err
+	}
+	if err := vpc.UseFromClusterStack(ctx, ctl.AWSProvider, clusterStack, cfg); err != nil {
+		return fmt.Errorf("getting VPC configuration for cluster %q: %w", meta.Name, err)
+	}
+
+	for _, rule := range cfg.VPC.ControlPlaneSecurityGroupIngress {
+		logger.Info("desired control plane ingress rule: %s on port %d", rule.CIDR, rule.Port)
+	}
+	cmdutils.LogIntendedAction(cmd.Plan, "update control plane security group ingress rules for cluster %q in %q",
+		meta.Name, meta.Region)
+
+	updateRequired, err := stackManager.UpdateControlPlaneSecurityGroupIngress(ctx, cmd.Plan)
+	if err != nil {
+		return err
+	}
+	if updateRequired && !cmd.Plan {
+		cmdutils.LogCompletedAction(false, "control plane security group ingress rules for cluster %q in %q have been updated", meta.Name, meta.Region)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
+}
@@ -73,5 +73,6 @@ func doUpdateCoreDNS(cmd *cmdutils.Cmd) error {
 
 	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
 
+	cmdutils.RecordHistory(ctx, cmd, ctl)
 	return nil
 }
@@ -73,5 +73,6 @@ func doUpdateKubeProxy(cmd *cmdutils.Cmd) error {
 
 	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
 
+	cmdutils.RecordHistory(ctx, cmd, ctl)
 	return nil
 }
@@ -77,5 +77,6 @@ func doUpdateLegacySubnetSettings(cmd *cmdutils.Cmd) error {
 	}
 
 	logger.Success("public subnets up to date")
+	cmdutils.RecordHistory(ctx, cmd, ctl)
 	return nil
 }
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateSupportTypeCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-support-type", "Update the support type of the upgrade policy of a cluster",
+		"Sets whether the cluster enters extended support, which is billed, or is upgraded automatically at the end of standard support for its Kubernetes version")
+
+	var supportType string
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateSupportType(cmd, supportType)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Upgrade policy", func(fs *pflag.FlagSet) {
+		fs.StringVar(&supportType, "support-type", "", "Support type of the cluster (valid options: STANDARD, EXTENDED)")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doUpdateSupportType(cmd *cmdutils.Cmd, supportType string) error {
+	if err := cmdutils.NewUtilsUpdateSupportTypeLoader(cmd, supportType).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if cfg.IsControlPlaneOnOutposts() {
+		return errUnsupportedLocalCluster
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	current, err := ctl.GetCurrentSupportType(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	desired := cfg.UpgradePolicy.SupportType
+	if current == desired {
+		logger.Success("support type of cluster %q in %q is already %s", meta.Name, meta.Region, desired)
+		return nil
+	}
+
+	cmdutils.LogIntendedAction(
+		cmd.Plan, "update support type of cluster %q in %q from %s to %s", meta.Name, meta.Region, current, desired)
+
+	if !cmd.Plan {
+		if err := ctl.UpdateClusterUpgradePolicy(ctx, cfg); err != nil {
+			return errors.Wrap(err, "error updating upgrade policy")
+		}
+		cmdutils.LogCompletedAction(
+			false,
+			"support type of cluster %q in %q has been updated to %s", meta.Name, meta.Region, desired)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/tags"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateTagsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-tags", "Update the tags of a cluster and of its resources",
+		"Adds or updates tags on the EKS cluster, on all the CloudFormation stacks eksctl created for it and the resources they hold, "+
+			"and on the Auto Scaling groups of the nodegroups, with the tags propagated to their launch templates and instances. "+
+			"Tags not given are left as they are.")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateTags(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddStringToStringVarPFlag(fs, &cfg.Metadata.Tags, "tags", "", map[string]string{}, "Tags to add or update, e.g. cost-center=platform,team=infra")
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doUpdateTags(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewUtilsUpdateTagsLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	if err := tags.ValidateTags(meta.Tags); err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	manager := tags.New(meta.Name, ctl.NewStackManager(cfg), ctl.AWSProvider.EKS(), ctl.AWSProvider.ASG(), ctl.AWSProvider.EC2())
+	if err := manager.Update(ctx, cfg.Status.ARN, meta.Tags, cmd.Plan); err != nil {
+		return err
+	}
+
+	cmdutils.LogCompletedAction(cmd.Plan, "tags of cluster %q in %q have been updated", meta.Name, meta.Region)
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
+}
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+func updateVPCEndpointsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-vpc-endpoints", "Add or remove VPC endpoints of a fully-private cluster",
+		"Updates the VPC endpoints in the VPC created by eksctl for a fully-private cluster to match the required endpoint services and privateCluster.additionalEndpointServices")
+
+	var additionalEndpointServices []string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateVPCEndpoints(cmd, additionalEndpointServices)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Endpoint services", func(fs *pflag.FlagSet) {
+		fs.StringSliceVar(&additionalEndpointServices, "additional-endpoint-services", nil,
+			"Additional endpoint services to enable; endpoints for services not in this list, other than the required ones, are removed")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doUpdateVPCEndpoints(cmd *cmdutils.Cmd, additionalEndpointServices []string) error {
+	if err := cmdutils.NewUtilsUpdateVPCEndpointsLoader(cmd, additionalEndpointServices).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if cfg.IsControlPlaneOnOutposts() {
+		return errUnsupportedLocalCluster
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	clusterStack, err := stackManager.DescribeClusterStack(ctx)
+	if err != nil {
+		return err
+	}
+	if err := vpc.UseFromClusterStack(ctx, ctl.AWSProvider, clusterStack, cfg); err != nil {
+		return fmt.Errorf("getting VPC configuration for cluster %q: %w", meta.Name, err)
+	}
+	if !cfg.IsFullyPrivate() {
+		return errors.New("VPC endpoints are only managed by eksctl for fully-private clusters")
+	}
+
+	// the logs endpoint is required when control plane logging is enabled, which is not set without a config file
+	if !cfg.HasClusterCloudWatchLogging() {
+		enabled, _, err := ctl.GetCurrentClusterConfigForLogging(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		if enabled.Len() > 0 {
+			if cfg.CloudWatch == nil {
+				cfg.CloudWatch = &api.ClusterCloudWatch{}
+			}
+			if cfg.CloudWatch.ClusterLogging == nil {
+				cfg.CloudWatch.ClusterLogging = &api.ClusterCloudWatchLogging{}
+			}
+			cfg.CloudWatch.ClusterLogging.EnableTypes = enabled.List()
+		}
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "update VPC endpoints for cluster %q in %q with additional endpoint services %v",
+		meta.Name, meta.Region, cfg.PrivateCluster.AdditionalEndpointServices)
+
+	updateRequired, err := stackManager.UpdateClusterVPCEndpoints(ctx, cmd.Plan)
+	if err != nil {
+		return err
+	}
+	if updateRequired && !cmd.Plan {
+		cmdutils.LogCompletedAction(false, "VPC endpoints for cluster %q in %q have been updated", meta.Name, meta.Region)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
+}
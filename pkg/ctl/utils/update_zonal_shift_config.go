@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateZonalShiftConfigCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-zonal-shift-config", "Update the zonal shift configuration of a cluster",
+		"Registers or deregisters the cluster with Amazon Application Recovery Controller (ARC) zonal shift")
+
+	var enabled bool
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateZonalShiftConfig(cmd, enabled)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("Zonal shift", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&enabled, "enable-zonal-shift", false, "Register the cluster with ARC zonal shift")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
+}
+
+func doUpdateZonalShiftConfig(cmd *cmdutils.Cmd, enabled bool) error {
+	if err := cmdutils.NewUtilsUpdateZonalShiftConfigLoader(cmd, enabled).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctx := context.TODO()
+	ctl, err := cmd.NewProviderForExistingCluster(ctx)
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if cfg.IsControlPlaneOnOutposts() {
+		return errUnsupportedLocalCluster
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	currentlyEnabled, err := ctl.GetCurrentZonalShiftConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	desired := api.IsEnabled(cfg.ZonalShiftConfig.Enabled)
+	if currentlyEnabled == desired {
+		logger.Success("zonal shift configuration for cluster %q in %q is already up to date (enabled: %t)", meta.Name, meta.Region, desired)
+		return nil
+	}
+
+	cmdutils.LogIntendedAction(
+		cmd.Plan, "update zonal shift configuration for cluster %q in %q (enabled: %t)", meta.Name, meta.Region, desired)
+
+	if !cmd.Plan {
+		if err := ctl.UpdateClusterZonalShiftConfig(ctx, cfg); err != nil {
+			return errors.Wrap(err, "error updating zonal shift configuration")
+		}
+		cmdutils.LogCompletedAction(
+			false,
+			"zonal shift configuration for cluster %q in %q has been updated (enabled: %t)", meta.Name, meta.Region, desired)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	cmdutils.RecordHistory(ctx, cmd, ctl)
+	return nil
+}
@@ -15,21 +15,39 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	verbCmd := cmdutils.NewVerbCmd("utils", "Various utils", "")
 
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, writeKubeconfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getTokenCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeStacksCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateKubeProxyCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateAWSNodeCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateCoreDNSCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateLegacySubnetSettings)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableLoggingCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, exportAuditLogsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, associateIAMOIDCProviderCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installWindowsVPCController)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateClusterEndpointsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, publicAccessCIDRsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateClusterVPCConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateControlPlaneSecurityGroupIngressCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateVPCEndpointsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, associatePrivateHostedZonesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateZonalShiftConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateSupportTypeCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, setDeletionProtectionCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateTagsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installClusterAutoscalerCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installNodeTerminationHandlerCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installAWSLoadBalancerControllerCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableEBSCSICmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableEFSCSICmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableSecretsEncryptionCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, reencryptSecretsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, schemaCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, nodeGroupHealthCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, rollNodeGroupCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeAddonVersionsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeAddonConfigurationCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, checkAPIDeprecationsCmd)
 
 	return verbCmd
 }
@@ -3,14 +3,24 @@ package utils
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/credentials"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/eks/auth"
 )
 
 func TestValidateLoggingFlags(t *testing.T) {
@@ -106,6 +116,60 @@ func TestValidateLoggingFlags(t *testing.T) {
 
 }
 
+func TestLoggingConfigDiff(t *testing.T) {
+	diffTests := []struct {
+		currentlyEnabled []string
+		willBeEnabled    []string
+		currentLogGroup  *eks.ClusterLogGroup
+		desired          api.ClusterCloudWatchLogging
+		expected         []string
+	}{
+		{
+			currentlyEnabled: []string{"api", "audit"},
+			willBeEnabled:    []string{"api", "audit"},
+		},
+		{
+			currentlyEnabled: []string{"api", "audit"},
+			willBeEnabled:    []string{"api", "scheduler"},
+			expected:         []string{"+ enable log type scheduler", "- disable log type audit"},
+		},
+		{
+			currentlyEnabled: []string{"api"},
+			willBeEnabled:    []string{"api"},
+			currentLogGroup:  &eks.ClusterLogGroup{RetentionInDays: 30, KMSKeyID: "arn:aws:kms:us-west-2:000000000000:key/1"},
+			desired:          api.ClusterCloudWatchLogging{LogRetentionInDays: 30, LogGroupKMSKeyID: "arn:aws:kms:us-west-2:000000000000:key/1"},
+		},
+		{
+			currentlyEnabled: []string{"api"},
+			willBeEnabled:    []string{"api"},
+			desired:          api.ClusterCloudWatchLogging{LogRetentionInDays: 7, LogGroupKMSKeyID: "arn:aws:kms:us-west-2:000000000000:key/1"},
+			expected: []string{
+				"~ log retention: never expire -> 7 days",
+				"~ log group KMS key: none -> arn:aws:kms:us-west-2:000000000000:key/1",
+			},
+		},
+		{
+			willBeEnabled:   []string{"audit"},
+			currentLogGroup: &eks.ClusterLogGroup{RetentionInDays: 30, KMSKeyID: "arn:aws:kms:us-west-2:000000000000:key/1"},
+			desired:         api.ClusterCloudWatchLogging{LogRetentionInDays: 90, LogGroupKMSKeyID: "arn:aws:kms:us-west-2:000000000000:key/2"},
+			expected: []string{
+				"+ enable log type audit",
+				"~ log retention: 30 days -> 90 days",
+				"~ log group KMS key: arn:aws:kms:us-west-2:000000000000:key/1 -> arn:aws:kms:us-west-2:000000000000:key/2",
+			},
+		},
+	}
+
+	for i, tt := range diffTests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			diff := loggingConfigDiff(sets.NewString(tt.currentlyEnabled...), sets.NewString(tt.willBeEnabled...), tt.currentLogGroup, &tt.desired)
+			if !reflect.DeepEqual(diff, tt.expected) {
+				t.Errorf("expected diff %q; got %q", tt.expected, diff)
+			}
+		})
+	}
+}
+
 var _ = Describe("utils", func() {
 	Describe("invalid-resource", func() {
 		It("with no flag", func() {
@@ -130,6 +194,94 @@ var _ = Describe("utils", func() {
 			Expect(err.Error()).To(ContainSubstring("usage"))
 		})
 	})
+
+	DescribeTable("applyPublicAccessCIDRChanges", func(cidrs, toAdd, toRemove, expected []string) {
+		Expect(applyPublicAccessCIDRChanges(cidrs, toAdd, toRemove)).To(Equal(expected))
+	},
+		Entry("no changes", []string{"1.1.1.1/32"}, nil, nil, []string{"1.1.1.1/32"}),
+		Entry("adds CIDRs", []string{"1.1.1.1/32"}, []string{"2.2.2.0/24"}, nil, []string{"1.1.1.1/32", "2.2.2.0/24"}),
+		Entry("does not duplicate existing CIDRs", []string{"1.1.1.1/32"}, []string{"1.1.1.1/32", "2.2.2.0/24"}, nil, []string{"1.1.1.1/32", "2.2.2.0/24"}),
+		Entry("removes CIDRs", []string{"1.1.1.1/32", "2.2.2.0/24"}, nil, []string{"1.1.1.1/32"}, []string{"2.2.2.0/24"}),
+		Entry("ignores CIDRs that are not present", []string{"1.1.1.1/32"}, nil, []string{"3.3.3.0/24"}, []string{"1.1.1.1/32"}),
+		Entry("adds and removes CIDRs", []string{"0.0.0.0/0"}, []string{"1.1.1.1/32"}, []string{"0.0.0.0/0"}, []string{"1.1.1.1/32"}),
+	)
+
+	Describe("schema", func() {
+		It("prints the schema of the current API version", func() {
+			out, err := newMockCmd("schema").execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(api.SchemaJSON))
+		})
+		It("prints the schema of the given API version", func() {
+			out, err := newMockCmd("schema", "--version", "v1alpha5").execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(api.SchemaJSON))
+		})
+		It("fails for an unsupported API version", func() {
+			_, err := newMockCmd("schema", "--version", "v1alpha4").execute()
+			Expect(err).To(MatchError(ContainSubstring(`unsupported API version "v1alpha4", supported versions: v1alpha5`)))
+		})
+	})
+
+	DescribeTable("roll-nodegroup with invalid flags", func(expectedErr string, args ...string) {
+		cmd := newMockCmd(append([]string{"roll-nodegroup"}, args...)...)
+		_, err := cmd.execute()
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("missing --cluster", "--cluster must be set", "--name", "ng-1"),
+		Entry("missing --name", "--name must be set", "--cluster", "my-cluster"),
+		Entry("unknown --pdb-timeout-behavior", `invalid PDB timeout behavior "evict"`, "--cluster", "my-cluster", "--name", "ng-1", "--pdb-timeout-behavior", "evict"),
+	)
+
+	DescribeTable("get-token with invalid flags", func(expectedErr string, args ...string) {
+		_, err := newMockCmd(append([]string{"get-token"}, args...)...).execute()
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("missing --cluster", "--cluster must be set"),
+		Entry("unknown --output", `invalid output "yaml"`, "--cluster", "dev", "--output", "yaml"),
+		Entry("--expiry without raw output", "--expiry can only be used with --output raw", "--cluster", "dev", "--expiry"),
+	)
+
+	Context("get-token with a cached token", func() {
+		var expiration time.Time
+
+		BeforeEach(func() {
+			dir := GinkgoT().TempDir()
+			GinkgoT().Setenv(auth.TokenCacheDirEnvName, dir)
+			GinkgoT().Setenv("AWS_PROFILE", "")
+			GinkgoT().Setenv("AWS_ACCESS_KEY_ID", "")
+			expiration = time.Now().Add(10 * time.Minute).UTC().Truncate(time.Second)
+			cache := auth.NewTokenCache(dir, &credentials.RealClock{})
+			Expect(cache.Put(auth.TokenCacheKey{ClusterID: "dev", Region: "us-west-2"}, auth.Token{Token: "k8s-aws-v1.cached", Expiration: expiration})).To(Succeed())
+		})
+
+		It("prints the cached token as an ExecCredential", func() {
+			out, err := newMockCmd("get-token", "--cluster", "dev", "--region", "us-west-2", "--cache").execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(MatchJSON(fmt.Sprintf(`{"kind":"ExecCredential","apiVersion":"client.authentication.k8s.io/v1beta1","spec":{"interactive":false},"status":{"token":"k8s-aws-v1.cached","expirationTimestamp":%q}}`, expiration.Format(time.RFC3339))))
+		})
+
+		It("prints the cached token and its expiration with --output raw --expiry", func() {
+			out, err := newMockCmd("get-token", "--cluster", "dev", "--region", "us-west-2", "--cache", "--output", "raw", "--expiry").execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal("k8s-aws-v1.cached\n" + expiration.Format(time.RFC3339) + "\n"))
+		})
+
+		It("does not use the token cached for other credentials of the environment", func() {
+			cmd := &cmdutils.Cmd{
+				ClusterConfig:  api.NewClusterConfig(),
+				ProviderConfig: api.ProviderConfig{Region: "us-west-2"},
+			}
+			cmd.ClusterConfig.Metadata.Name = "dev"
+			cache := auth.NewTokenCache(os.Getenv(auth.TokenCacheDirEnvName), &credentials.RealClock{})
+			_, ok := cache.Get(newTokenCacheKey(cmd, ""))
+			Expect(ok).To(BeTrue())
+
+			GinkgoT().Setenv("AWS_ACCESS_KEY_ID", "AKIAEXAMPLE")
+			_, ok = cache.Get(newTokenCacheKey(cmd, ""))
+			Expect(ok).To(BeFalse())
+		})
+	})
 })
 
 func newMockCmd(args ...string) *mockVerbCmd {
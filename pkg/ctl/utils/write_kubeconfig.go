@@ -8,45 +8,66 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 )
 
+type writeKubeconfigOptions struct {
+	outputPath           string
+	authenticator        kubeconfig.AuthenticatorOptions
+	contextName          string
+	setContext, autoPath bool
+
+	all        bool
+	allRegions bool
+	regions    []string
+}
+
 func writeKubeconfigCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
-	var (
-		outputPath           string
-		authenticatorRoleARN string
-		setContext, autoPath bool
-	)
+	options := &writeKubeconfigOptions{}
 
 	cmd.SetDescription("write-kubeconfig", "Write kubeconfig file for a given cluster", "")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doWriteKubeconfigCmd(cmd, outputPath, authenticatorRoleARN, setContext, autoPath)
+		if options.all {
+			return doWriteKubeconfigForAllClusters(cmd, options)
+		}
+		return doWriteKubeconfigCmd(cmd, options)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		cmdutils.AddClusterFlagWithDeprecated(fs, cfg.Metadata)
+		fs.BoolVar(&options.all, "all", false, "write the kubeconfig of every cluster in the region, or in the regions given by --all-regions or --regions, in one pass")
+		fs.BoolVar(&options.allRegions, "all-regions", false, "with --all, write the kubeconfig of the clusters across all supported regions")
+		fs.StringSliceVar(&options.regions, "regions", nil, "with --all, write the kubeconfig of the clusters across the given regions only, implies --all-regions")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("Output kubeconfig", func(fs *pflag.FlagSet) {
-		cmdutils.AddCommonFlagsForKubeconfig(fs, &outputPath, &authenticatorRoleARN, &setContext, &autoPath, "<name>")
+		cmdutils.AddCommonFlagsForKubeconfig(fs, &options.outputPath, &options.authenticator.RoleARN, &options.setContext, &options.autoPath, "<name>")
+		cmdutils.AddKubeconfigContextNameFlag(fs, &options.contextName)
+		fs.StringVar(&options.authenticator.Command, "authenticator", "", fmt.Sprintf("command getting the token of the exec credential plugin, valid options: %s, %s (aws eks get-token), %s (eksctl utils get-token) (defaults to the first of %s and %s found)",
+			kubeconfig.AWSIAMAuthenticator, kubeconfig.AWSEKSAuthenticator, kubeconfig.EksctlAuthenticator, kubeconfig.AWSIAMAuthenticator, kubeconfig.AWSEKSAuthenticator))
+		fs.StringVar(&options.authenticator.SessionName, "authenticator-role-session-name", "", "session name of the role set by --authenticator-role-arn")
+		fs.StringVar(&options.authenticator.Profile, "authenticator-profile", "", "AWS credentials profile used by the authenticator (defaults to the profile used by eksctl)")
+		fs.BoolVar(&options.authenticator.Cache, "authenticator-cache", false, fmt.Sprintf("cache the credentials or the token of the authenticator on disk until they expire, only supported by %s and %s", kubeconfig.AWSIAMAuthenticator, kubeconfig.EksctlAuthenticator))
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd, &cmd.ProviderConfig, false)
 }
 
-func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setContext, autoPath bool) error {
+func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, options *writeKubeconfigOptions) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -66,13 +87,27 @@ func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setCont
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
 	}
 
-	if autoPath {
+	if options.allRegions || len(options.regions) > 0 {
+		return errors.New("--all-regions and --regions can only be used with --all")
+	}
+
+	outputPath := options.outputPath
+	if options.autoPath {
 		if outputPath != kubeconfig.DefaultPath() {
 			return fmt.Errorf("--kubeconfig and --auto-kubeconfig %s", cmdutils.IncompatibleFlags)
 		}
 		outputPath = kubeconfig.AutoPath(cfg.Metadata.Name)
 	}
 
+	if err := kubeconfig.ValidateContextNameTemplate(options.contextName); err != nil {
+		return err
+	}
+
+	options.authenticator.SetDefaults()
+	if err := options.authenticator.Validate(); err != nil {
+		return err
+	}
+
 	ctl, err := cmd.NewProviderForExistingCluster(context.Background())
 	if err != nil {
 		return err
@@ -82,8 +117,11 @@ func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setCont
 		return err
 	}
 
-	kubectlConfig := kubeconfig.NewForKubectl(cfg, eks.GetUsername(ctl.Status.IAMRoleARN), roleARN, ctl.AWSProvider.Profile().Name)
-	filename, err := kubeconfig.Write(outputPath, *kubectlConfig, setContext)
+	kubectlConfig, err := newKubectlConfig(ctl, cfg, options)
+	if err != nil {
+		return err
+	}
+	filename, err := kubeconfig.Write(outputPath, *kubectlConfig, options.setContext)
 	if err != nil {
 		return errors.Wrap(err, "writing kubeconfig")
 	}
@@ -92,3 +130,115 @@ func doWriteKubeconfigCmd(cmd *cmdutils.Cmd, outputPath, roleARN string, setCont
 
 	return nil
 }
+
+func doWriteKubeconfigForAllClusters(cmd *cmdutils.Cmd, options *writeKubeconfigOptions) error {
+	if err := cmdutils.NewGetClusterLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	if cmd.ClusterConfig.Metadata.Name != "" || cmd.NameArg != "" {
+		return errors.New("--all is for writing the kubeconfig of all clusters, it must be used without cluster name flag/argument")
+	}
+
+	if options.autoPath {
+		return fmt.Errorf("--all and --auto-kubeconfig %s", cmdutils.IncompatibleFlags)
+	}
+
+	if err := kubeconfig.ValidateContextNameTemplate(options.contextName); err != nil {
+		return err
+	}
+
+	options.authenticator.SetDefaults()
+	if err := options.authenticator.Validate(); err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	listAllRegions := options.allRegions || len(options.regions) > 0
+	clusters, err := cluster.GetClusters(ctx, ctl.AWSProvider, listAllRegions, options.regions, 100)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		logger.Info("no clusters found")
+		return nil
+	}
+
+	var (
+		configs          []*clientcmdapi.Config
+		providers        = map[string]*eks.ClusterProvider{}
+		clusterByContext = map[string]string{}
+	)
+	for _, c := range clusters {
+		provider, ok := providers[c.Region]
+		if !ok {
+			// keep the other AWS settings of the command, e.g. the endpoint overrides or the role to assume
+			providerConfig := cmd.ProviderConfig
+			providerConfig.Region = c.Region
+			provider, err = eks.New(ctx, &providerConfig, nil)
+			if err != nil {
+				return fmt.Errorf("creating provider in %q region: %w", c.Region, err)
+			}
+			providers[c.Region] = provider
+		}
+
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.Metadata.Name = c.Name
+		clusterConfig.Metadata.Region = c.Region
+		clusterConfig.Metadata.AccountID = cmd.ClusterConfig.Metadata.AccountID
+		if err := provider.RefreshClusterStatus(ctx, clusterConfig); err != nil {
+			logger.Warning("skipping cluster %s: %v", clusterConfig.Metadata.LogString(), err)
+			continue
+		}
+		if ok, err := provider.CanOperate(clusterConfig); !ok {
+			logger.Warning("skipping cluster %s: %v", clusterConfig.Metadata.LogString(), err)
+			continue
+		}
+
+		kubectlConfig, err := newKubectlConfig(provider, clusterConfig, options)
+		if err != nil {
+			return err
+		}
+		if other, ok := clusterByContext[kubectlConfig.CurrentContext]; ok {
+			return fmt.Errorf("clusters %s and %s have the same kubeconfig context name %q, add placeholders to --kubeconfig-context-name to tell them apart", other, clusterConfig.Metadata.LogString(), kubectlConfig.CurrentContext)
+		}
+		clusterByContext[kubectlConfig.CurrentContext] = clusterConfig.Metadata.LogString()
+		configs = append(configs, kubectlConfig)
+	}
+
+	if len(configs) == 0 {
+		return errors.New("none of the clusters found can be operated")
+	}
+
+	// the current context is left as it is, as there is no single cluster to set it to
+	filename, err := kubeconfig.Write(options.outputPath, *kubeconfig.Merge(configs...), false)
+	if err != nil {
+		return errors.Wrap(err, "writing kubeconfig")
+	}
+
+	logger.Success("saved kubeconfig of %d cluster(s) as %q", len(configs), filename)
+
+	return nil
+}
+
+func newKubectlConfig(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, options *writeKubeconfigOptions) (*clientcmdapi.Config, error) {
+	username := eks.GetUsername(ctl.Status.IAMRoleARN)
+	authenticator := options.authenticator
+	if authenticator.Profile == "" {
+		authenticator.Profile = ctl.AWSProvider.Profile().Name
+	}
+	kubectlConfig := kubeconfig.NewForKubectlWithOptions(cfg, username, authenticator)
+	if options.contextName != "" {
+		name, err := kubeconfig.ContextName(options.contextName, cfg.Metadata, username)
+		if err != nil {
+			return nil, err
+		}
+		kubeconfig.RenameContext(kubectlConfig, name)
+	}
+	return kubectlConfig, nil
+}
@@ -0,0 +1,154 @@
+package validate
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+const textOutput = "text"
+
+const (
+	// StageSchema is the stage of the errors found while loading the config file, e.g. unknown fields or
+	// values of the wrong type
+	StageSchema = "schema"
+	// StageSemantic is the stage of the errors found while validating the loaded config, e.g. mutually
+	// exclusive fields, unsupported versions or invalid CIDRs
+	StageSemantic = "semantic"
+)
+
+// Error is an error found in a config file
+type Error struct {
+	Stage   string
+	Message string
+}
+
+// Result is the result of validating a config file
+type Result struct {
+	ConfigFile string
+	Valid      bool
+	Errors     []Error
+}
+
+func (r *Result) addError(stage string, err error) {
+	r.Valid = false
+	r.Errors = append(r.Errors, Error{Stage: stage, Message: err.Error()})
+}
+
+// Command creates the `validate` command
+func Command(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	var output string
+
+	cmd.SetDescription("validate", "Validate a config file without making any changes",
+		"Runs the same schema and semantic validation as `create cluster` against a config file, without calling any AWS APIs. "+
+			"Exits with a non-zero code when the config file is invalid.")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		return doValidate(cmd, output, cmd.CobraCommand.OutOrStdout())
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", textOutput, "specifies the output format (valid option: text, json, yaml)")
+	})
+}
+
+func doValidate(cmd *cmdutils.Cmd, output string, w io.Writer) error {
+	if cmd.ClusterConfigFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file")
+	}
+
+	var printer printers.OutputPrinter
+	if output != textOutput {
+		var err error
+		if printer, err = printers.NewPrinter(printers.Type(output)); err != nil {
+			return err
+		}
+	}
+
+	result := validateConfigFile(cmd)
+
+	if printer != nil {
+		if err := printer.PrintObj(result, w); err != nil {
+			return err
+		}
+	} else {
+		for _, e := range result.Errors {
+			logger.Critical("%s: %s", e.Stage, e.Message)
+		}
+	}
+
+	if !result.Valid {
+		return exitcode.Wrap(exitcode.Validation, fmt.Errorf("config file %q is invalid", cmd.ClusterConfigFile))
+	}
+	if printer == nil {
+		logger.Success("config file %q is valid", cmd.ClusterConfigFile)
+	}
+	return nil
+}
+
+func validateConfigFile(cmd *cmdutils.Cmd) *Result {
+	result := &Result{
+		ConfigFile: cmd.ClusterConfigFile,
+		Valid:      true,
+	}
+
+	loader := cmdutils.NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, &cmdutils.CreateClusterCmdParams{})
+	if err := loader.Load(); err != nil {
+		// the loader leaves the cluster config unset when the config file cannot be parsed
+		if cmd.ClusterConfig == nil {
+			result.addError(StageSchema, err)
+		} else {
+			result.addError(StageSemantic, err)
+		}
+		return result
+	}
+
+	cfg := cmd.ClusterConfig
+	switch cfg.Metadata.Version {
+	case "auto":
+		cfg.Metadata.Version = api.DefaultVersion
+	case "latest":
+		cfg.Metadata.Version = api.LatestVersion
+	}
+	if err := api.ValidateClusterVersion(cfg); err != nil {
+		result.addError(StageSemantic, err)
+		return result
+	}
+	if cfg.Metadata.Version == "" {
+		cfg.Metadata.Version = api.DefaultVersion
+	}
+
+	api.SetClusterConfigDefaults(cfg)
+	if err := api.ValidateClusterConfig(cfg); err != nil {
+		result.addError(StageSemantic, err)
+	}
+
+	outposts := cfg.IsControlPlaneOnOutposts()
+	for i, ng := range cfg.NodeGroups {
+		if err := api.ValidateNodeGroup(i, ng, cfg); err != nil {
+			result.addError(StageSemantic, err)
+			continue
+		}
+		api.SetNodeGroupDefaults(ng, cfg.Metadata, outposts)
+	}
+	for i, ng := range cfg.ManagedNodeGroups {
+		api.SetManagedNodeGroupDefaults(ng, cfg.Metadata, outposts)
+		if err := api.ValidateManagedNodeGroup(i, ng); err != nil {
+			result.addError(StageSemantic, err)
+		}
+	}
+	return result
+}
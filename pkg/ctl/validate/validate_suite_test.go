@@ -0,0 +1,11 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestCtlValidate(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("validate", func() {
+	execute := func(args ...string) (*Result, error) {
+		rootCmd := &cobra.Command{SilenceUsage: true, SilenceErrors: true}
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), rootCmd, Command)
+		out := new(bytes.Buffer)
+		rootCmd.SetOut(out)
+		rootCmd.SetErr(new(bytes.Buffer))
+		rootCmd.SetArgs(append([]string{"validate", "--output", "json"}, args...))
+		err := rootCmd.Execute()
+
+		var result Result
+		Expect(json.Unmarshal(out.Bytes(), &result)).To(Succeed())
+		return &result, err
+	}
+
+	It("accepts a valid config file", func() {
+		result, err := execute("-f", "testdata/valid.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Valid).To(BeTrue())
+		Expect(result.Errors).To(BeEmpty())
+	})
+
+	It("applies the options of the config file before validating it", func() {
+		result, err := execute("-f", "testdata/valid.yaml", "--set", "metadata.version=1.10")
+		Expect(err).To(MatchError(`config file "testdata/valid.yaml" is invalid`))
+		Expect(result.Errors).To(ConsistOf(HaveField("Message", ContainSubstring("1.10 is no longer supported"))))
+	})
+
+	DescribeTable("invalid config files", func(configFile string, expectedErrors ...Error) {
+		result, err := execute("-f", configFile)
+		Expect(err).To(MatchError(ContainSubstring("is invalid")))
+		Expect(result.Valid).To(BeFalse())
+		Expect(result.ConfigFile).To(Equal(configFile))
+		Expect(result.Errors).To(Equal(expectedErrors))
+	},
+		Entry("unknown field", "testdata/unknown-field.yaml", Error{
+			Stage:   StageSchema,
+			Message: `loading config file "testdata/unknown-field.yaml": error unmarshaling JSON: while decoding JSON: json: unknown field "instanceTyp"`,
+		}),
+		Entry("mutually exclusive fields", "testdata/invalid-vpc.yaml", Error{
+			Stage:   StageSemantic,
+			Message: "vpc.subnets and availabilityZones cannot be set at the same time",
+		}),
+		Entry("invalid nodegroups", "testdata/invalid-nodegroups.yaml", Error{
+			Stage:   StageSemantic,
+			Message: "nodeGroups[0].maxPodsPerNode cannot be negative",
+		}, Error{
+			Stage:   StageSemantic,
+			Message: "cannot use --nodes-min=5 and --nodes-max=2 at the same time",
+		}),
+	)
+
+	It("requires a config file", func() {
+		rootCmd := &cobra.Command{}
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), rootCmd, Command)
+		rootCmd.SetOut(new(bytes.Buffer))
+		rootCmd.SetErr(new(bytes.Buffer))
+		rootCmd.SetArgs([]string{"validate"})
+		Expect(rootCmd.Execute()).To(MatchError("--config-file must be set"))
+	})
+})
@@ -0,0 +1,128 @@
+package drain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+)
+
+// PDBTimeoutBehavior controls what happens to a pod whose eviction has been refused because of a
+// PodDisruptionBudget for longer than the PDB timeout
+type PDBTimeoutBehavior string
+
+const (
+	// PDBTimeoutBehaviorBlock keeps retrying the eviction until the drain times out
+	PDBTimeoutBehaviorBlock PDBTimeoutBehavior = "block"
+	// PDBTimeoutBehaviorSkip leaves the pod on the node and carries on with the drain
+	PDBTimeoutBehaviorSkip PDBTimeoutBehavior = "skip"
+	// PDBTimeoutBehaviorForce deletes the pod, bypassing the PodDisruptionBudget
+	PDBTimeoutBehaviorForce PDBTimeoutBehavior = "force"
+)
+
+// PDBTimeoutBehaviors lists the supported PDB timeout behaviors
+var PDBTimeoutBehaviors = []PDBTimeoutBehavior{PDBTimeoutBehaviorBlock, PDBTimeoutBehaviorSkip, PDBTimeoutBehaviorForce}
+
+// Validate returns an error if b is not one of PDBTimeoutBehaviors, an empty value means block
+func (b PDBTimeoutBehavior) Validate() error {
+	if b == "" {
+		return nil
+	}
+	var values []string
+	for _, behavior := range PDBTimeoutBehaviors {
+		if b == behavior {
+			return nil
+		}
+		values = append(values, string(behavior))
+	}
+	return fmt.Errorf("invalid PDB timeout behavior %q, must be one of %s", b, strings.Join(values, ", "))
+}
+
+// EvictionOptions controls how pods are evicted from the nodes being drained
+type EvictionOptions struct {
+	// PodGracePeriod overrides the termination grace period of evicted pods when set,
+	// it is still capped by the max grace period
+	PodGracePeriod time.Duration
+	// PDBTimeout is how long the eviction of a pod is retried while a PodDisruptionBudget
+	// refuses it, before PDBTimeoutBehavior applies
+	PDBTimeout time.Duration
+	// PDBTimeoutBehavior is what happens to a pod still blocked by a PodDisruptionBudget after PDBTimeout
+	PDBTimeoutBehavior PDBTimeoutBehavior
+	// NodeEvictionTimeout is how long the pods of a single node are evicted for before the drain fails,
+	// zero means until the drain itself times out
+	NodeEvictionTimeout time.Duration
+	// DeleteAfterFailedEvictions is the number of failed evictions of a pod after which it is deleted instead,
+	// as with disabled evictions, zero means it is never deleted
+	DeleteAfterFailedEvictions int
+	// SkipPodWarnings logs the warnings about the pods being evicted, e.g. pods not managed by a controller,
+	// at debug level only
+	SkipPodWarnings bool
+}
+
+// progressReportInterval is how often the pods pending eviction are reported
+var progressReportInterval = 30 * time.Second
+
+// drainProgress keeps track of the number of pods pending eviction on each node being drained
+type drainProgress struct {
+	mu      sync.Mutex
+	pending map[string]int
+}
+
+func newDrainProgress() *drainProgress {
+	return &drainProgress{
+		pending: map[string]int{},
+	}
+}
+
+func (p *drainProgress) update(node string, pods int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pending[node] = pods
+}
+
+func (p *drainProgress) done(node string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.pending, node)
+}
+
+func (p *drainProgress) report() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.pending) == 0 {
+		return
+	}
+	var (
+		nodes []string
+		total int
+	)
+	for node, pods := range p.pending {
+		nodes = append(nodes, fmt.Sprintf("%s (%d)", node, pods))
+		total += pods
+	}
+	sort.Strings(nodes)
+	logger.Info("waiting for %d pod(s) to be evicted from %d node(s): %s", total, len(p.pending), strings.Join(nodes, ", "))
+}
+
+// start reports the pods pending eviction every interval until the returned func is called
+func (p *drainProgress) start(interval time.Duration) func() {
+	ticker := time.NewTicker(interval)
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				p.report()
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(stop)
+	}
+}
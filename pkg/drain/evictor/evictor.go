@@ -45,6 +45,7 @@ type Evictor struct {
 	DryRun bool
 
 	maxGracePeriodSeconds int
+	gracePeriodSeconds    int64
 
 	ignoreAllDaemonSets bool
 	ignoreDaemonSets    []metav1.ObjectMeta
@@ -55,7 +56,7 @@ type Evictor struct {
 	UseEvictions          bool
 }
 
-func New(clientSet kubernetes.Interface, maxGracePeriod time.Duration, ignoreDaemonSets []metav1.ObjectMeta, disableEviction bool) *Evictor {
+func New(clientSet kubernetes.Interface, maxGracePeriod, gracePeriod time.Duration, ignoreDaemonSets []metav1.ObjectMeta, disableEviction bool) *Evictor {
 	return &Evictor{
 		client: clientSet,
 		// TODO: force, DeleteLocalData & IgnoreAllDaemonSets shouldn't
@@ -67,6 +68,7 @@ func New(clientSet kubernetes.Interface, maxGracePeriod time.Duration, ignoreDae
 		deleteLocalData:       true,
 		ignoreAllDaemonSets:   true,
 		maxGracePeriodSeconds: int(maxGracePeriod.Seconds()),
+		gracePeriodSeconds:    int64(gracePeriod.Seconds()),
 		ignoreDaemonSets:      ignoreDaemonSets,
 		disableEviction:       disableEviction,
 	}
@@ -111,9 +113,13 @@ func (d *Evictor) makeDeleteOptions(pod corev1.Pod) metav1.DeleteOptions {
 	deleteOptions := metav1.DeleteOptions{}
 
 	gracePeriodSeconds := int64(corev1.DefaultTerminationGracePeriodSeconds)
-	if pod.Spec.TerminationGracePeriodSeconds != nil {
-		if *pod.Spec.TerminationGracePeriodSeconds < int64(d.maxGracePeriodSeconds) {
-			gracePeriodSeconds = *pod.Spec.TerminationGracePeriodSeconds
+	podGracePeriodSeconds := pod.Spec.TerminationGracePeriodSeconds
+	if d.gracePeriodSeconds > 0 {
+		podGracePeriodSeconds = &d.gracePeriodSeconds
+	}
+	if podGracePeriodSeconds != nil {
+		if *podGracePeriodSeconds < int64(d.maxGracePeriodSeconds) {
+			gracePeriodSeconds = *podGracePeriodSeconds
 		} else {
 			gracePeriodSeconds = int64(d.maxGracePeriodSeconds)
 		}
@@ -129,7 +135,7 @@ func (d *Evictor) EvictOrDeletePod(pod corev1.Pod) error {
 	if d.UseEvictions {
 		return d.evictPod(pod)
 	}
-	return d.deletePod(pod)
+	return d.DeletePod(pod)
 }
 
 // evictPod will evict the give Pod, or return an error if it couldn't
@@ -150,8 +156,8 @@ func (d *Evictor) evictPod(pod corev1.Pod) error {
 	return d.client.PolicyV1beta1().Evictions(eviction.Namespace).Evict(context.TODO(), eviction)
 }
 
-// deletePod will Delete the given Pod, or return an error if it couldn't
-func (d *Evictor) deletePod(pod corev1.Pod) error {
+// DeletePod will Delete the given Pod, bypassing any PodDisruptionBudget, or return an error if it couldn't
+func (d *Evictor) DeletePod(pod corev1.Pod) error {
 	return d.client.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, d.makeDeleteOptions(pod))
 }
 
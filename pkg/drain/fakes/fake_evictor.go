@@ -20,6 +20,17 @@ type FakeEvictor struct {
 	canUseEvictionsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeletePodStub        func(v1.Pod) error
+	deletePodMutex       sync.RWMutex
+	deletePodArgsForCall []struct {
+		arg1 v1.Pod
+	}
+	deletePodReturns struct {
+		result1 error
+	}
+	deletePodReturnsOnCall map[int]struct {
+		result1 error
+	}
 	EvictOrDeletePodStub        func(v1.Pod) error
 	evictOrDeletePodMutex       sync.RWMutex
 	evictOrDeletePodArgsForCall []struct {
@@ -101,6 +112,67 @@ func (fake *FakeEvictor) CanUseEvictionsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeEvictor) DeletePod(arg1 v1.Pod) error {
+	fake.deletePodMutex.Lock()
+	ret, specificReturn := fake.deletePodReturnsOnCall[len(fake.deletePodArgsForCall)]
+	fake.deletePodArgsForCall = append(fake.deletePodArgsForCall, struct {
+		arg1 v1.Pod
+	}{arg1})
+	stub := fake.DeletePodStub
+	fakeReturns := fake.deletePodReturns
+	fake.recordInvocation("DeletePod", []interface{}{arg1})
+	fake.deletePodMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEvictor) DeletePodCallCount() int {
+	fake.deletePodMutex.RLock()
+	defer fake.deletePodMutex.RUnlock()
+	return len(fake.deletePodArgsForCall)
+}
+
+func (fake *FakeEvictor) DeletePodCalls(stub func(v1.Pod) error) {
+	fake.deletePodMutex.Lock()
+	defer fake.deletePodMutex.Unlock()
+	fake.DeletePodStub = stub
+}
+
+func (fake *FakeEvictor) DeletePodArgsForCall(i int) v1.Pod {
+	fake.deletePodMutex.RLock()
+	defer fake.deletePodMutex.RUnlock()
+	argsForCall := fake.deletePodArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEvictor) DeletePodReturns(result1 error) {
+	fake.deletePodMutex.Lock()
+	defer fake.deletePodMutex.Unlock()
+	fake.DeletePodStub = nil
+	fake.deletePodReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEvictor) DeletePodReturnsOnCall(i int, result1 error) {
+	fake.deletePodMutex.Lock()
+	defer fake.deletePodMutex.Unlock()
+	fake.DeletePodStub = nil
+	if fake.deletePodReturnsOnCall == nil {
+		fake.deletePodReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deletePodReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeEvictor) EvictOrDeletePod(arg1 v1.Pod) error {
 	fake.evictOrDeletePodMutex.Lock()
 	ret, specificReturn := fake.evictOrDeletePodReturnsOnCall[len(fake.evictOrDeletePodArgsForCall)]
@@ -231,6 +303,8 @@ func (fake *FakeEvictor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.canUseEvictionsMutex.RLock()
 	defer fake.canUseEvictionsMutex.RUnlock()
+	fake.deletePodMutex.RLock()
+	defer fake.deletePodMutex.RUnlock()
 	fake.evictOrDeletePodMutex.RLock()
 	defer fake.evictOrDeletePodMutex.RUnlock()
 	fake.getPodsForEvictionMutex.RLock()
@@ -35,6 +35,7 @@ const retryDelay = 5 * time.Second
 type Evictor interface {
 	CanUseEvictions() error
 	EvictOrDeletePod(pod corev1.Pod) error
+	DeletePod(pod corev1.Pod) error
 	GetPodsForEviction(nodeName string) (*evictor.PodDeleteList, []error)
 }
 
@@ -46,9 +47,10 @@ type NodeGroupDrainer struct {
 	podEvictionWaitPeriod time.Duration
 	undo                  bool
 	parallel              int
+	evictionOptions       EvictionOptions
 }
 
-func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, maxGracePeriod, nodeDrainWaitPeriod time.Duration, podEvictionWaitPeriod time.Duration, undo, disableEviction bool, parallel int) NodeGroupDrainer {
+func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, maxGracePeriod, nodeDrainWaitPeriod time.Duration, podEvictionWaitPeriod time.Duration, undo, disableEviction bool, parallel int, evictionOptions EvictionOptions) NodeGroupDrainer {
 	ignoreDaemonSets := []metav1.ObjectMeta{
 		{
 			Namespace: "kube-system",
@@ -76,13 +78,14 @@ func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, m
 	}
 
 	return NodeGroupDrainer{
-		evictor:               evictor.New(clientSet, maxGracePeriod, ignoreDaemonSets, disableEviction),
+		evictor:               evictor.New(clientSet, maxGracePeriod, evictionOptions.PodGracePeriod, ignoreDaemonSets, disableEviction),
 		clientSet:             clientSet,
 		ng:                    ng,
 		nodeDrainWaitPeriod:   nodeDrainWaitPeriod,
 		podEvictionWaitPeriod: podEvictionWaitPeriod,
 		undo:                  undo,
 		parallel:              parallel,
+		evictionOptions:       evictionOptions,
 	}
 }
 
@@ -110,6 +113,10 @@ func (n *NodeGroupDrainer) Drain(ctx context.Context, sem *semaphore.Weighted) e
 
 	drainedNodes := cmap.New()
 
+	progress := newDrainProgress()
+	stopReporting := progress.start(progressReportInterval)
+	defer stopReporting()
+
 	var evictErr error
 	// loop until all nodes are drained to handle accidental scale-up
 	// or any other changes in the ASG
@@ -154,7 +161,7 @@ func (n *NodeGroupDrainer) Drain(ctx context.Context, sem *semaphore.Weighted) e
 
 					drainedNodes.Set(node, nil)
 					logger.Debug("starting drain of node %s", node)
-					if err := n.evictPods(ctx, node); err != nil {
+					if err := n.evictPods(ctx, node, progress); err != nil {
 						logger.Warning("pod eviction error (%q) on node %s", err, node)
 						time.Sleep(retryDelay)
 						return err
@@ -176,6 +183,63 @@ func (n *NodeGroupDrainer) Drain(ctx context.Context, sem *semaphore.Weighted) e
 	}
 }
 
+// DrainNode cordons a single node of the nodegroup and evicts its pods
+func (n *NodeGroupDrainer) DrainNode(ctx context.Context, nodeName string) error {
+	if err := n.evictor.CanUseEvictions(); err != nil {
+		return errors.Wrap(err, "checking if cluster implements policy API")
+	}
+
+	node, err := n.clientSet.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	n.toggleCordon(true, &corev1.NodeList{Items: []corev1.Node{*node}})
+
+	progress := newDrainProgress()
+	stopReporting := progress.start(progressReportInterval)
+	defer stopReporting()
+
+	if err := n.evictPods(ctx, nodeName, progress); err != nil {
+		return err
+	}
+	logger.Success("drained node %q", nodeName)
+	return nil
+}
+
+// CordonNodeGroup marks all nodes of a nodegroup unschedulable, or schedulable again when cordon is false,
+// and returns the number of nodes that were updated
+func CordonNodeGroup(ctx context.Context, clientSet kubernetes.Interface, ng eks.KubeNodeGroup, cordon bool) (int, error) {
+	nodes, err := clientSet.CoreV1().Nodes().List(ctx, ng.ListOptions())
+	if err != nil {
+		return 0, err
+	}
+
+	if len(nodes.Items) == 0 {
+		logger.Warning("no nodes found in nodegroup %q (label selector: %q)", ng.NameString(), ng.ListOptions().LabelSelector)
+		return 0, nil
+	}
+
+	updated := 0
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		c := NewCordonHelper(node, cordon)
+		if !c.IsUpdateRequired() {
+			logger.Debug("no need to %s node %q", cordonStatus(cordon), node.Name)
+			continue
+		}
+		err, patchErr := c.PatchOrReplace(clientSet)
+		if patchErr != nil {
+			logger.Warning(patchErr.Error())
+		}
+		if err != nil {
+			return updated, errors.Wrapf(err, "failed to %s node %q", cordonStatus(cordon), node.Name)
+		}
+		logger.Info("%s node %q", cordonStatus(cordon), node.Name)
+		updated++
+	}
+	return updated, nil
+}
+
 func mapToList(m map[string]interface{}) []string {
 	var list []string
 	for key := range m {
@@ -202,10 +266,24 @@ func (n *NodeGroupDrainer) toggleCordon(cordon bool, nodes *corev1.NodeList) {
 	}
 }
 
-func (n *NodeGroupDrainer) evictPods(ctx context.Context, node string) error {
+func (n *NodeGroupDrainer) evictPods(ctx context.Context, node string, progress *drainProgress) error {
+	defer progress.done(node)
+
+	if n.evictionOptions.NodeEvictionTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, n.evictionOptions.NodeEvictionTimeout)
+		defer cancel()
+	}
+
+	// number of failed evictions of each pod
+	failures := map[string]int{}
+	// pods whose eviction is refused because of a PodDisruptionBudget, and since when
+	blockedSince := map[string]time.Time{}
+	// pods left on the node after being blocked for longer than the PDB timeout
+	skipped := sets.NewString()
+
 	// Loop until context times out.  We want to continually try to remove pods
 	// from the node as their eviction status changes.
-	previousReportTime := time.Now()
 	for {
 		select {
 		case <-ctx.Done():
@@ -215,29 +293,55 @@ func (n *NodeGroupDrainer) evictPods(ctx context.Context, node string) error {
 			if len(errs) > 0 {
 				return fmt.Errorf("errs: %v", errs) // TODO: improve formatting
 			}
-			if list == nil || len(list.Pods()) == 0 {
+			if list == nil {
 				return nil
 			}
-			pods := list.Pods()
 			if w := list.Warnings(); w != "" {
-				logger.Warning(w)
+				if n.evictionOptions.SkipPodWarnings {
+					logger.Debug(w)
+				} else {
+					logger.Warning(w)
+				}
 			}
-			// This log message is important but can be noisy.  It's useful to only
-			// update on a node every minute.
-			if time.Since(previousReportTime) > time.Minute*1 && len(pods) > 0 {
-				logger.Warning("%d pods are unevictable from node %s", len(pods), node)
-				previousReportTime = time.Now()
+			var pods []corev1.Pod
+			for _, pod := range list.Pods() {
+				if !skipped.Has(podKey(pod)) {
+					pods = append(pods, pod)
+				}
+			}
+			if len(pods) == 0 {
+				return nil
 			}
-			logger.Debug("%d pods to be evicted from %s", pods, node)
+			progress.update(node, len(pods))
+			logger.Debug("%d pods to be evicted from %s", len(pods), node)
 			failedEvictions := false
 			for _, pod := range pods {
-				if err := n.evictor.EvictOrDeletePod(pod); err != nil {
-					if !isEvictionErrorRecoverable(err) {
-						return errors.Wrapf(err, "unrecoverable error evicting pod: %s/%s", pod.Namespace, pod.Name)
+				err := n.evictor.EvictOrDeletePod(pod)
+				if err == nil {
+					continue
+				}
+				if !isEvictionErrorRecoverable(err) {
+					return errors.Wrapf(err, "unrecoverable error evicting pod: %s/%s", pod.Namespace, pod.Name)
+				}
+				logger.Debug("recoverable pod eviction failure: %q", err)
+				// IsTooManyRequests is what a PodDisruptionBudget refusing the eviction returns
+				if apierrors.IsTooManyRequests(err) {
+					released, err := n.releaseBlockedPod(node, pod, blockedSince, skipped)
+					if err != nil {
+						return err
+					}
+					if released {
+						continue
 					}
-					logger.Debug("recoverable pod eviction failure: %q", err)
-					failedEvictions = true
 				}
+				deleted, err := n.deleteAfterFailedEvictions(node, pod, failures)
+				if err != nil {
+					return err
+				}
+				if deleted {
+					continue
+				}
+				failedEvictions = true
 			}
 			if failedEvictions {
 				time.Sleep(n.podEvictionWaitPeriod)
@@ -246,6 +350,58 @@ func (n *NodeGroupDrainer) evictPods(ctx context.Context, node string) error {
 	}
 }
 
+// releaseBlockedPod applies the PDB timeout behavior to a pod whose eviction was refused because of a
+// PodDisruptionBudget, and returns true if the pod no longer needs to be evicted
+func (n *NodeGroupDrainer) releaseBlockedPod(node string, pod corev1.Pod, blockedSince map[string]time.Time, skipped sets.String) (bool, error) {
+	key := podKey(pod)
+	since, ok := blockedSince[key]
+	if !ok {
+		since = time.Now()
+		blockedSince[key] = since
+	}
+	if time.Since(since) < n.evictionOptions.PDBTimeout {
+		return false, nil
+	}
+
+	switch n.evictionOptions.PDBTimeoutBehavior {
+	case PDBTimeoutBehaviorSkip:
+		logger.Warning("skipping pod %s on node %s, its eviction has been blocked by a PodDisruptionBudget for over %v", key, node, n.evictionOptions.PDBTimeout)
+		skipped.Insert(key)
+		return true, nil
+	case PDBTimeoutBehaviorForce:
+		logger.Warning("deleting pod %s on node %s, its eviction has been blocked by a PodDisruptionBudget for over %v", key, node, n.evictionOptions.PDBTimeout)
+		if err := n.evictor.DeletePod(pod); err != nil && !apierrors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "deleting pod %s", key)
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// deleteAfterFailedEvictions deletes a pod once its eviction has failed as many times as the configured threshold,
+// and returns true if it was deleted
+func (n *NodeGroupDrainer) deleteAfterFailedEvictions(node string, pod corev1.Pod, failures map[string]int) (bool, error) {
+	threshold := n.evictionOptions.DeleteAfterFailedEvictions
+	if threshold <= 0 {
+		return false, nil
+	}
+	key := podKey(pod)
+	failures[key]++
+	if failures[key] < threshold {
+		return false, nil
+	}
+	logger.Warning("deleting pod %s on node %s after %d failed evictions", key, node, failures[key])
+	if err := n.evictor.DeletePod(pod); err != nil && !apierrors.IsNotFound(err) {
+		return false, errors.Wrapf(err, "deleting pod %s", key)
+	}
+	return true, nil
+}
+
+func podKey(pod corev1.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
 func cordonStatus(desired bool) string {
 	if desired {
 		return "cordon"
@@ -83,7 +83,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("does not error", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, 0, false, false, 1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, 0, false, false, 1, drain.EvictionOptions{})
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain(ctx, sem)
@@ -127,7 +127,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("times out and errors", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, 0, time.Second, 0, false, false, 1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, 0, time.Second, 0, false, false, 1, drain.EvictionOptions{})
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
@@ -144,7 +144,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("errors", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, 0, false, false, 1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, 0, false, false, 1, drain.EvictionOptions{})
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -190,7 +190,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("does not error", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, time.Second*0, false, true, 1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, time.Second*0, false, true, 1, drain.EvictionOptions{})
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			Expect(nodeGroupDrainer.Drain(ctx, sem)).To(Succeed())
@@ -220,7 +220,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("uncordons all the nodes", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, time.Second*0, true, false, 1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, time.Second*0, true, false, 1, drain.EvictionOptions{})
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain(ctx, sem)
@@ -274,7 +274,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("does not error", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, 0, false, false, 1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, 0, false, false, 1, drain.EvictionOptions{})
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			Expect(nodeGroupDrainer.Drain(ctx, sem)).To(Succeed())
@@ -324,7 +324,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("returns an error", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, 0, false, false, 1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, 0, false, false, 1, drain.EvictionOptions{})
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			err := nodeGroupDrainer.Drain(ctx, sem)
@@ -388,7 +388,7 @@ var _ = Describe("Drain", func() {
 		})
 
 		It("it attempts to drain all pods", func() {
-			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, time.Second*0, false, false, 1)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, time.Second*0, false, false, 1, drain.EvictionOptions{})
 			nodeGroupDrainer.SetDrainer(fakeEvictor)
 
 			_ = nodeGroupDrainer.Drain(ctx, sem)
@@ -398,4 +398,162 @@ var _ = Describe("Drain", func() {
 			Expect(fakeEvictor.EvictOrDeletePodArgsForCall(1)).To(Equal(pods[1]))
 		})
 	})
+
+	When("an eviction is blocked by a PodDisruptionBudget", func() {
+		var pod corev1.Pod
+
+		BeforeEach(func() {
+			pod = corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod-1",
+					Namespace: "ns-1",
+				},
+			}
+
+			fakeEvictor.GetPodsForEvictionReturns(&evictor.PodDeleteList{
+				Items: []evictor.PodDelete{
+					{
+						Pod: pod,
+						Status: evictor.PodDeleteStatus{
+							Delete: true,
+						},
+					},
+				},
+			}, nil)
+			fakeEvictor.EvictOrDeletePodReturns(apierrors.NewTooManyRequestsError("Cannot evict pod as it would violate the pod's disruption budget."))
+
+			_, err := fakeClientSet.CoreV1().Nodes().Create(context.Background(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("keeps retrying the eviction when blocking", func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, 2*time.Second)
+			defer cancel()
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, 0, 0, false, false, 1, drain.EvictionOptions{
+				PDBTimeoutBehavior: drain.PDBTimeoutBehaviorBlock,
+			})
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			err := nodeGroupDrainer.Drain(ctx, sem)
+			Expect(err).To(MatchError(ContainSubstring("timed out")))
+			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(BeNumerically(">", 1))
+			Expect(fakeEvictor.DeletePodCallCount()).To(BeZero())
+		})
+
+		It("leaves the pod on the node when skipping", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, 0, 0, false, false, 1, drain.EvictionOptions{
+				PDBTimeoutBehavior: drain.PDBTimeoutBehaviorSkip,
+			})
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.Drain(ctx, sem)).To(Succeed())
+			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(Equal(1))
+			Expect(fakeEvictor.DeletePodCallCount()).To(BeZero())
+		})
+
+		It("deletes the pod when forcing", func() {
+			fakeEvictor.GetPodsForEvictionReturnsOnCall(1, &evictor.PodDeleteList{}, nil)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, 0, 0, false, false, 1, drain.EvictionOptions{
+				PDBTimeoutBehavior: drain.PDBTimeoutBehaviorForce,
+			})
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.Drain(ctx, sem)).To(Succeed())
+			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(Equal(1))
+			Expect(fakeEvictor.DeletePodCallCount()).To(Equal(1))
+			Expect(fakeEvictor.DeletePodArgsForCall(0)).To(Equal(pod))
+		})
+
+		It("deletes the pod after the configured number of failed evictions", func() {
+			fakeEvictor.GetPodsForEvictionReturnsOnCall(2, &evictor.PodDeleteList{}, nil)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, 0, 0, false, false, 1, drain.EvictionOptions{
+				DeleteAfterFailedEvictions: 2,
+			})
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.Drain(ctx, sem)).To(Succeed())
+			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(Equal(2))
+			Expect(fakeEvictor.DeletePodCallCount()).To(Equal(1))
+			Expect(fakeEvictor.DeletePodArgsForCall(0)).To(Equal(pod))
+		})
+
+		It("fails once the pods of a node could not be evicted within the node eviction timeout", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, 0, 10*time.Millisecond, false, false, 1, drain.EvictionOptions{
+				NodeEvictionTimeout: 100 * time.Millisecond,
+			})
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			err := nodeGroupDrainer.Drain(ctx, sem)
+			Expect(err).To(MatchError(ContainSubstring(`timed out waiting for node "node-1" to be drained`)))
+			Expect(fakeEvictor.DeletePodCallCount()).To(BeZero())
+		})
+
+		It("retries the eviction until the PDB timeout elapses", func() {
+			fakeEvictor.GetPodsForEvictionReturnsOnCall(3, &evictor.PodDeleteList{}, nil)
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, 0, 100*time.Millisecond, false, false, 1, drain.EvictionOptions{
+				PDBTimeout:         150 * time.Millisecond,
+				PDBTimeoutBehavior: drain.PDBTimeoutBehaviorForce,
+			})
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+			Expect(nodeGroupDrainer.Drain(ctx, sem)).To(Succeed())
+			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(BeNumerically(">", 1))
+			Expect(fakeEvictor.DeletePodCallCount()).To(Equal(1))
+		})
+	})
+
+	When("cordoning a nodegroup", func() {
+		BeforeEach(func() {
+			for _, node := range []corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}, Spec: corev1.NodeSpec{Unschedulable: true}},
+			} {
+				_, err := fakeClientSet.CoreV1().Nodes().Create(context.Background(), &node, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		expectUnschedulable := func(unschedulable bool) {
+			nodes, err := fakeClientSet.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			for _, node := range nodes.Items {
+				Expect(node.Spec.Unschedulable).To(Equal(unschedulable), node.Name)
+			}
+		}
+
+		It("marks only schedulable nodes unschedulable", func() {
+			updated, err := drain.CordonNodeGroup(ctx, fakeClientSet, &mockNG, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(1))
+			expectUnschedulable(true)
+		})
+
+		It("marks only unschedulable nodes schedulable when uncordoning", func() {
+			updated, err := drain.CordonNodeGroup(ctx, fakeClientSet, &mockNG, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(1))
+			expectUnschedulable(false)
+		})
+	})
+
+	DescribeTable("validating the PDB timeout behavior",
+		func(behavior drain.PDBTimeoutBehavior, valid bool) {
+			err := behavior.Validate()
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(`invalid PDB timeout behavior "evict", must be one of block, skip, force`))
+			}
+		},
+		Entry("unset", drain.PDBTimeoutBehavior(""), true),
+		Entry("block", drain.PDBTimeoutBehaviorBlock, true),
+		Entry("skip", drain.PDBTimeoutBehaviorSkip, true),
+		Entry("force", drain.PDBTimeoutBehaviorForce, true),
+		Entry("unknown", drain.PDBTimeoutBehavior("evict"), false),
+	)
 })
@@ -1,9 +1,12 @@
 package eks
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -30,19 +33,23 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/afero"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	k8sclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/yaml"
 
 	"github.com/weaveworks/eksctl/pkg/ami"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/audit"
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 	"github.com/weaveworks/eksctl/pkg/az"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	ekscreds "github.com/weaveworks/eksctl/pkg/credentials"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 	"github.com/weaveworks/eksctl/pkg/utils/nodes"
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -111,6 +118,9 @@ func (p ProviderServices) Region() string { return p.spec.Region }
 // Profile returns the provider-level AWS profile.
 func (p ProviderServices) Profile() api.Profile { return p.spec.Profile }
 
+// RoleSession returns the session of the roles eksctl assumes
+func (p ProviderServices) RoleSession() api.AWSRoleSessionConfig { return p.spec.RoleSession }
+
 // WaitTimeout returns provider-level duration after which any wait operation has to timeout
 func (p ProviderServices) WaitTimeout() time.Duration { return p.spec.WaitTimeout }
 
@@ -147,23 +157,41 @@ func New(ctx context.Context, spec *api.ProviderConfig, clusterSpec *api.Cluster
 	c := &ClusterProvider{
 		AWSProvider: provider,
 	}
+	sso := loadSSOProfile(ctx, spec.Profile.Name)
+	var credentialsV2 *credentialsV2Provider
+	if sso != nil {
+		if err := sso.ensureSession(spec.WaitTimeout); err != nil {
+			return nil, err
+		}
+		if sso.session != "" {
+			credentialsV2 = &credentialsV2Provider{}
+		}
+	}
+	if len(spec.AssumeRoles) > 0 || spec.RoleSession.IsSet() {
+		credentialsV2 = &credentialsV2Provider{}
+	}
+
 	// Create a new session and save credentials for possible
 	// later re-use if overriding sessions due to custom URL
-	s := c.newSession(spec)
+	s := c.newSession(spec, credentialsV2)
 
 	cacheCredentials := os.Getenv(ekscreds.EksctlGlobalEnableCachingEnvName) != ""
 	var (
 		credentialsCacheFilePath string
 		err                      error
 	)
-	if cacheCredentials {
-		if s.Config == nil {
-			return nil, errors.New("expected Session.Config to be non-nil")
-		}
+	// the credentials of roles requiring MFA are always cached, not to prompt for a code on every command
+	if cacheCredentials || api.RequireMFA(spec.AssumeRoles) {
 		credentialsCacheFilePath, err = ekscreds.GetCacheFilePath()
 		if err != nil {
 			return nil, fmt.Errorf("error getting cache file path: %w", err)
 		}
+	}
+	// the credentials of assumed roles are cached with the AWS SDK v2 config, the AWS SDK v1 session uses them
+	if cacheCredentials && len(spec.AssumeRoles) == 0 {
+		if s.Config == nil {
+			return nil, errors.New("expected Session.Config to be non-nil")
+		}
 		if cachedProvider, err := ekscreds.NewFileCacheProvider(spec.Profile.Name, s.Config.Credentials, &ekscreds.RealClock{}, afero.NewOsFs(), func(path string) ekscreds.Flock {
 			return flock.New(path)
 		}, credentialsCacheFilePath); err == nil {
@@ -175,13 +203,21 @@ func New(ctx context.Context, spec *api.ProviderConfig, clusterSpec *api.Cluster
 
 	provider.session = s
 
-	cfg, err := newV2Config(spec, c.AWSProvider.Region(), credentialsCacheFilePath)
+	if spec.UseFIPSEndpoints && !api.IsFIPSRegion(spec.Region) {
+		return nil, exitcode.Wrap(exitcode.Validation, fmt.Errorf("FIPS endpoints are not available in region %s - use one of: %s", spec.Region, strings.Join(api.FIPSRegions(), ", ")))
+	}
+
+	cfg, retry, err := newV2Config(spec, c.AWSProvider.Region(), credentialsCacheFilePath)
 	if err != nil {
 		return nil, err
 	}
+	if credentialsV2 != nil {
+		credentialsV2.credentials = cfg.Credentials
+	}
 
 	provider.ServicesV2 = &ServicesV2{
 		config: cfg,
+		retry:  retry,
 	}
 
 	c.Status = &ProviderStatus{
@@ -201,12 +237,16 @@ func New(ctx context.Context, spec *api.ProviderConfig, clusterSpec *api.Cluster
 
 	stsOutput, err := c.checkAuth(ctx)
 	if err != nil {
+		if sso != nil && isSSOTokenError(err) {
+			return nil, sso.expiredError()
+		}
 		return nil, err
 	}
 
 	// c.Status.IAMRoleARN is later needed by the kubeProvider
 	c.Status.IAMRoleARN = *stsOutput.Arn
 	logger.Debug("role ARN for the current session is %q", c.Status.IAMRoleARN)
+	audit.SetCaller(c.AWSProvider, c.Status.IAMRoleARN, awsv2.ToString(stsOutput.Account))
 
 	if clusterSpec != nil {
 		clusterSpec.Metadata.AccountID = *stsOutput.Account
@@ -223,8 +263,13 @@ func New(ctx context.Context, spec *api.ProviderConfig, clusterSpec *api.Cluster
 	return c, nil
 }
 
-// ParseConfig parses data into a ClusterConfig
+// ParseConfig parses data into a ClusterConfig, merging its nodeGroupDefaults into its nodegroups
 func ParseConfig(data []byte) (*api.ClusterConfig, error) {
+	data, err := applyNodeGroupDefaults(data)
+	if err != nil {
+		return nil, err
+	}
+
 	// strict mode is not available in runtime.Decode, so we use the parser
 	// directly; we don't store the resulting object, this is just the means
 	// of detecting any unknown keys
@@ -246,24 +291,140 @@ func ParseConfig(data []byte) (*api.ClusterConfig, error) {
 	return cfg, nil
 }
 
-// LoadConfigFromFile loads ClusterConfig from configFile
+// LoadConfigOptions holds the options for loading a ClusterConfig from a config file
+type LoadConfigOptions struct {
+	// ClusterName selects a cluster of a config file holding several clusters
+	ClusterName string
+	// Overlays are the paths of the files merged into the ClusterConfig, in order
+	Overlays []string
+	// ExpandEnv replaces the references to environment variables in the config file and overlays,
+	// e.g. ${VAR}, before they are parsed
+	ExpandEnv bool
+	// SetValues are the values of the form path.to.field=value set after the overlays are merged
+	SetValues []string
+}
+
+// LoadConfigFromFile loads ClusterConfig from configFile, which must hold a single cluster
 func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
+	return LoadClusterConfigFromFile(configFile, LoadConfigOptions{})
+}
+
+// LoadClusterConfigFromFile loads a ClusterConfig from configFile, which may hold several clusters as documents
+// separated by "---", one of which is then selected by options.ClusterName, and merges the overlays into it.
+// The nodeGroupDefaults of the resulting document are then merged into its nodegroups
+func LoadClusterConfigFromFile(configFile string, options LoadConfigOptions) (*api.ClusterConfig, error) {
 	data, err := readConfig(configFile)
 	if err != nil {
 		return nil, errors.Wrapf(err, "reading config file %q", configFile)
 	}
-	clusterConfig, err := ParseConfig(data)
+	if options.ExpandEnv {
+		if data, err = expandEnv(data, os.LookupEnv); err != nil {
+			return nil, errors.Wrapf(err, "loading config file %q", configFile)
+		}
+	}
+	documents, err := splitDocuments(data)
 	if err != nil {
 		return nil, errors.Wrapf(err, "loading config file %q", configFile)
 	}
+	idx, err := selectDocument(documents, options.ClusterName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading config file %q", configFile)
+	}
+
+	document := documents[idx]
+	for _, overlayFile := range options.Overlays {
+		overlay, err := readConfig(overlayFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading overlay file %q", overlayFile)
+		}
+		if options.ExpandEnv {
+			if overlay, err = expandEnv(overlay, os.LookupEnv); err != nil {
+				return nil, errors.Wrapf(err, "applying overlay file %q", overlayFile)
+			}
+		}
+		if document, err = applyOverlay(document, overlay); err != nil {
+			return nil, errors.Wrapf(err, "applying overlay file %q", overlayFile)
+		}
+	}
+
+	if len(options.SetValues) > 0 {
+		if document, err = applySetValues(document, options.SetValues); err != nil {
+			return nil, errors.Wrap(err, "applying --set values")
+		}
+	}
+
+	clusterConfig, err := ParseConfig(document)
+	if err != nil {
+		if len(documents) > 1 {
+			err = errors.Wrapf(err, "document %d", idx+1)
+		}
+		return nil, errors.Wrapf(err, "loading config file %q", configFile)
+	}
 	return clusterConfig, nil
+}
+
+// splitDocuments splits data into its YAML documents, leaving out empty ones
+func splitDocuments(data []byte) ([][]byte, error) {
+	var documents [][]byte
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		document, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// skip empty documents, e.g. a leading comment followed by "---"
+		var obj map[string]interface{}
+		if err := yaml.Unmarshal(document, &obj); err != nil || obj != nil {
+			documents = append(documents, document)
+		}
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("no ClusterConfig found")
+	}
+	return documents, nil
+}
 
+// selectDocument returns the index of the document of the cluster named clusterName,
+// clusterName may only be empty when there is a single document
+func selectDocument(documents [][]byte, clusterName string) (int, error) {
+	if clusterName == "" && len(documents) == 1 {
+		return 0, nil
+	}
+
+	var names []string
+	for i, document := range documents {
+		var obj struct {
+			Metadata *struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}
+		if err := yaml.Unmarshal(document, &obj); err != nil {
+			return 0, errors.Wrapf(err, "document %d", i+1)
+		}
+		if obj.Metadata == nil {
+			continue
+		}
+		if clusterName != "" && obj.Metadata.Name == clusterName {
+			return i, nil
+		}
+		names = append(names, obj.Metadata.Name)
+	}
+	if clusterName == "" {
+		return 0, fmt.Errorf("found %d clusters (%s), --cluster-name must be set to select one", len(documents), strings.Join(names, ", "))
+	}
+	return 0, fmt.Errorf("cluster %q not found, must be one of %s", clusterName, strings.Join(names, ", "))
 }
 
 func readConfig(configFile string) ([]byte, error) {
 	if configFile == "-" {
 		return io.ReadAll(os.Stdin)
 	}
+	if data, ok, err := evaluateConfig(configFile); ok {
+		return data, err
+	}
 	return os.ReadFile(configFile)
 }
 
@@ -294,7 +455,7 @@ func (c *ClusterProvider) GetCredentialsEnv() ([]string, error) {
 func (c *ClusterProvider) checkAuth(ctx context.Context) (*sts.GetCallerIdentityOutput, error) {
 	output, err := c.AWSProvider.STS().GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
-		return nil, errors.Wrap(err, "checking AWS STS access – cannot get role ARN for current session")
+		return nil, exitcode.Wrap(exitcode.Auth, errors.Wrap(err, "checking AWS STS access – cannot get role ARN for current session"))
 	}
 	if output == nil || output.Arn == nil {
 		return nil, fmt.Errorf("unexpected response from AWS STS")
@@ -485,16 +646,27 @@ func ValidateLocalZones(ctx context.Context, ec2API awsapi.EC2, localZones []str
 	return nil
 }
 
-func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session {
+// newSession creates the session of the AWS SDK v1, credentialsV2, if not nil, provide its credentials
+// as it does not support profiles with an sso_session nor the roles assumed by eksctl and their session
+func (c *ClusterProvider) newSession(spec *api.ProviderConfig, credentialsV2 *credentialsV2Provider) *session.Session {
 	// we might want to use bits from kops, although right now it seems like too many things we
 	// don't want yet
 	// https://github.com/kubernetes/kops/blob/master/upup/pkg/fi/cloudup/awsup/aws_cloud.go#L179
-	config := aws.NewConfig().WithCredentialsChainVerboseErrors(true)
+	config := aws.NewConfig().WithCredentialsChainVerboseErrors(true).WithHTTPClient(&http.Client{
+		Transport: proxy.NewTransport(),
+	})
 
 	if c.AWSProvider.Region() != "" {
 		config = config.WithRegion(c.AWSProvider.Region()).WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint)
 	}
 
+	if spec.UseFIPSEndpoints {
+		config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+	}
+	if credentialsV2 != nil {
+		config = config.WithCredentials(credentials.NewCredentials(credentialsV2))
+	}
+
 	config = request.WithRetryer(config, newLoggingRetryer())
 	if logger.Level >= api.AWSDebugLevel {
 		config = config.WithLogLevel(aws.LogDebug |
@@ -513,10 +685,10 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		SharedConfigState:       session.SharedConfigEnable,
 		Profile:                 spec.Profile.Name,
 		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+		// set on the options rather than on the global default of stscreds, as sessions may be created concurrently
+		AssumeRoleDuration: 30 * time.Minute,
 	}
 
-	stscreds.DefaultDuration = 30 * time.Minute
-
 	s := session.Must(session.NewSessionWithOptions(opts))
 
 	s.Handlers.Build.PushFrontNamed(request.NamedHandler{
@@ -533,16 +705,20 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 			// if session config doesn't have region set, make recursive call forcing default region
 			logger.Debug("no region specified in flags or config, setting to %s", api.DefaultRegion)
 			spec.Region = api.DefaultRegion
-			return c.newSession(spec)
+			return c.newSession(spec, credentialsV2)
 		}
 	}
 
 	return s
 }
 
-// NewStackManager returns a new stack manager
+// NewStackManager returns a new stack manager, tagging the stacks it creates with the caller identity as their owner
 func (c *ClusterProvider) NewStackManager(spec *api.ClusterConfig) manager.StackManager {
-	return manager.NewStackCollection(c.AWSProvider, spec)
+	var owner string
+	if c.Status != nil {
+		owner = c.Status.IAMRoleARN
+	}
+	return manager.NewStackCollectionWithOwner(c.AWSProvider, spec, owner)
 }
 
 // LoadClusterIntoSpecFromStack uses stack information to load the cluster
@@ -0,0 +1,47 @@
+package eks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/kris-nova/logger"
+)
+
+// LogAPICalls enables logging each AWS API call with its duration, request ID and number of retries, so that
+// throttling and permission errors can be found in CloudTrail without logging the requests and responses
+var LogAPICalls bool
+
+// addAPICallLogger adds a middleware logging each call made by an AWS SDK client
+func addAPICallLogger(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("eksctl/LogAPICall", func(
+		ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		started := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		logger.Info("%s", describeAPICall(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), time.Since(started), metadata, err))
+		return out, metadata, err
+	}), middleware.After)
+}
+
+func describeAPICall(service, operation string, duration time.Duration, metadata middleware.Metadata, err error) string {
+	requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata)
+	var requestErr interface{ ServiceRequestID() string }
+	if !ok && errors.As(err, &requestErr) {
+		requestID = requestErr.ServiceRequestID()
+	}
+	retries := 0
+	if attempts, ok := retry.GetAttemptResults(metadata); ok && len(attempts.Results) > 0 {
+		retries = len(attempts.Results) - 1
+	}
+
+	msg := fmt.Sprintf("AWS API call %s.%s: duration=%s requestID=%s retries=%d", service, operation, duration.Round(time.Millisecond), requestID, retries)
+	if err != nil {
+		msg += fmt.Sprintf(" error=%q", err.Error())
+	}
+	return msg
+}
@@ -0,0 +1,27 @@
+package eks_test
+
+import (
+	"errors"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("AWS API call logging", func() {
+	It("describes a call with its duration and request ID", func() {
+		var metadata middleware.Metadata
+		awsmiddleware.SetRequestIDMetadata(&metadata, "1f2e3d4c")
+		Expect(eks.DescribeAPICall("CloudFormation", "DescribeStacks", 1234567*time.Microsecond, metadata, nil)).
+			To(Equal("AWS API call CloudFormation.DescribeStacks: duration=1.235s requestID=1f2e3d4c retries=0"))
+	})
+
+	It("describes a failed call with its error", func() {
+		Expect(eks.DescribeAPICall("IAM", "CreateRole", 80*time.Millisecond, middleware.Metadata{}, errors.New("AccessDenied"))).
+			To(Equal(`AWS API call IAM.CreateRole: duration=80ms requestID= retries=0 error="AccessDenied"`))
+	})
+})
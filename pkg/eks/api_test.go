@@ -3,6 +3,8 @@ package eks_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/exec"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -73,6 +75,222 @@ var _ = Describe("eksctl API", func() {
 			Expect(err.Error()).To(HavePrefix(`loading config file "testdata/old-version.json": no kind "ClusterConfig" is registered for version "eksctl.io/v1alpha3" in scheme`))
 		})
 
+		It("should load the selected cluster of a config file holding several clusters", func() {
+			cfg, err := LoadClusterConfigFromFile("testdata/multi-cluster.yaml", LoadConfigOptions{ClusterName: "cluster-2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Metadata.Name).To(Equal("cluster-2"))
+			Expect(cfg.Metadata.Region).To(Equal("eu-north-1"))
+			Expect(cfg.ManagedNodeGroups).To(HaveLen(1))
+		})
+
+		It("should error when no cluster is selected in a config file holding several clusters", func() {
+			_, err := LoadConfigFromFile("testdata/multi-cluster.yaml")
+			Expect(err).To(MatchError(`loading config file "testdata/multi-cluster.yaml": found 2 clusters (cluster-1, cluster-2), --cluster-name must be set to select one`))
+		})
+
+		It("should error when the selected cluster is not in the config file", func() {
+			_, err := LoadClusterConfigFromFile("../../examples/01-simple-cluster.yaml", LoadConfigOptions{ClusterName: "cluster-2"})
+			Expect(err).To(MatchError(`loading config file "../../examples/01-simple-cluster.yaml": cluster "cluster-2" not found, must be one of cluster-1`))
+		})
+
+		It("should merge overlays into the config file", func() {
+			cfg, err := LoadClusterConfigFromFile("testdata/multi-cluster.yaml", LoadConfigOptions{
+				ClusterName: "cluster-1",
+				Overlays:    []string{"testdata/overlay-prod.yaml"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Metadata.Name).To(Equal("cluster-1"))
+			Expect(cfg.Metadata.Region).To(Equal("eu-west-1"))
+			Expect(cfg.Metadata.Tags).To(Equal(map[string]string{"environment": "prod"}))
+			Expect(cfg.NodeGroups).To(HaveLen(2))
+			Expect(cfg.NodeGroups[0].Name).To(Equal("ng-1"))
+			Expect(cfg.NodeGroups[0].InstanceType).To(Equal("m5.large"))
+			Expect(*cfg.NodeGroups[0].DesiredCapacity).To(Equal(6))
+			Expect(cfg.NodeGroups[1].Name).To(Equal("ng-2"))
+			Expect(cfg.NodeGroups[1].InstanceType).To(Equal("m5.xlarge"))
+		})
+
+		It("should reject unknown fields in an overlay", func() {
+			_, err := LoadClusterConfigFromFile("../../examples/01-simple-cluster.yaml", LoadConfigOptions{
+				Overlays: []string{"testdata/overlay-bad-field.yaml"},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`loading config file "../../examples/01-simple-cluster.yaml": error unmarshaling JSON: while decoding JSON: json: unknown field "zone"`))
+		})
+
+		It("should error when cannot read an overlay", func() {
+			_, err := LoadClusterConfigFromFile("../../examples/01-simple-cluster.yaml", LoadConfigOptions{
+				Overlays: []string{"testdata/nothing.yaml"},
+			})
+			Expect(err).To(MatchError(`reading overlay file "testdata/nothing.yaml": open testdata/nothing.yaml: no such file or directory`))
+		})
+
+		It("should replace the references to environment variables", func() {
+			GinkgoT().Setenv("CLUSTER_NAME", "cluster-env")
+			GinkgoT().Setenv("VPC_ID", "vpc-123")
+			cfg, err := LoadClusterConfigFromFile("testdata/env-vars.yaml", LoadConfigOptions{ExpandEnv: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Metadata.Name).To(Equal("cluster-env"))
+			Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+			Expect(cfg.VPC.ID).To(Equal("vpc-123"))
+			Expect(cfg.NodeGroups[0].PreBootstrapCommands).To(Equal([]string{"echo ${HOME}"}))
+		})
+
+		It("should error when required environment variables are not set", func() {
+			GinkgoT().Setenv("VPC_ID", "")
+			_, err := LoadClusterConfigFromFile("testdata/env-vars.yaml", LoadConfigOptions{ExpandEnv: true})
+			Expect(err).To(MatchError(`loading config file "testdata/env-vars.yaml": expanding environment variables: CLUSTER_NAME: must be set, VPC_ID: the VPC of the account must be set`))
+		})
+
+		It("should not replace the references to environment variables unless enabled", func() {
+			cfg, err := LoadConfigFromFile("testdata/env-vars.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Metadata.Name).To(Equal("${CLUSTER_NAME}"))
+		})
+
+		It("should set the values of fields of the config file", func() {
+			cfg, err := LoadClusterConfigFromFile("testdata/multi-cluster.yaml", LoadConfigOptions{
+				ClusterName: "cluster-1",
+				Overlays:    []string{"testdata/overlay-prod.yaml"},
+				SetValues: []string{
+					"metadata.version=1.30",
+					"metadata.tags.team=platform",
+					`metadata.tags.costCenter="1234"`,
+					"metadata.tags.project=42",
+					"nodeGroups[ng-2].desiredCapacity=4",
+					"nodeGroups[0].privateNetworking=true",
+					"nodeGroups[ng-2].instancesDistribution.maxPrice=0.05",
+					`availabilityZones=["eu-west-1a","eu-west-1b"]`,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Metadata.Version).To(Equal("1.30"))
+			Expect(cfg.Metadata.Tags).To(Equal(map[string]string{"environment": "prod", "team": "platform", "costCenter": "1234", "project": "42"}))
+			Expect(*cfg.NodeGroups[1].InstancesDistribution.MaxPrice).To(Equal(0.05))
+			Expect(*cfg.NodeGroups[0].DesiredCapacity).To(Equal(6))
+			Expect(cfg.NodeGroups[0].PrivateNetworking).To(BeTrue())
+			Expect(*cfg.NodeGroups[1].DesiredCapacity).To(Equal(4))
+			Expect(cfg.AvailabilityZones).To(Equal([]string{"eu-west-1a", "eu-west-1b"}))
+		})
+
+		DescribeTable("should reject invalid --set values", func(value, expectedErr string) {
+			_, err := LoadClusterConfigFromFile("../../examples/01-simple-cluster.yaml", LoadConfigOptions{
+				SetValues: []string{value},
+			})
+			Expect(err).To(MatchError(expectedErr))
+		},
+			Entry("without a value", "metadata.version", `applying --set values: invalid value "metadata.version", must be of the form path.to.field=value`),
+			Entry("with a malformed path", "nodeGroups[0.name=ng", `applying --set values: invalid path "nodeGroups[0.name": malformed list selector in "nodeGroups[0"`),
+			Entry("with an unknown item", "nodeGroups[ng-9].desiredCapacity=1", `applying --set values: setting "nodeGroups[ng-9].desiredCapacity": no item named "ng-9" found`),
+			Entry("with an index out of range", "nodeGroups[3].desiredCapacity=1", `applying --set values: setting "nodeGroups[3].desiredCapacity": index 3 out of range, the list has 1 item(s)`),
+			Entry("with a missing list", "managedNodeGroups[0].desiredCapacity=1", `applying --set values: setting "managedNodeGroups[0].desiredCapacity": list "managedNodeGroups" not found`),
+			Entry("with a value of the wrong type", "nodeGroups[0].desiredCapacity=many", `applying --set values: invalid value for "nodeGroups[0].desiredCapacity": "many" is not an integer`),
+		)
+
+		It("should merge the nodegroup defaults into the nodegroups", func() {
+			cfg, err := LoadConfigFromFile("testdata/nodegroup-defaults.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.NodeGroups).To(HaveLen(3))
+
+			ng1 := cfg.NodeGroups[0]
+			Expect(ng1.InstanceType).To(Equal("m5.large"))
+			Expect(*ng1.VolumeSize).To(Equal(100))
+			Expect(ng1.PrivateNetworking).To(BeTrue())
+			Expect(ng1.Labels).To(Equal(map[string]string{"team": "platform"}))
+			Expect(*ng1.DesiredCapacity).To(Equal(2))
+
+			ng2 := cfg.NodeGroups[1]
+			Expect(ng2.InstanceType).To(Equal("c5.xlarge"))
+			Expect(ng2.Labels).To(Equal(map[string]string{"team": "platform", "workload": "batch"}))
+
+			Expect(cfg.NodeGroups[2].VolumeSize).To(BeNil())
+
+			mng := cfg.ManagedNodeGroups[0]
+			Expect(mng.InstanceType).To(Equal("m5.large"))
+			Expect(*mng.VolumeSize).To(Equal(100))
+			Expect(*mng.DesiredCapacity).To(Equal(3))
+		})
+
+		It("should apply --set values to the nodegroup defaults", func() {
+			cfg, err := LoadClusterConfigFromFile("testdata/nodegroup-defaults.yaml", LoadConfigOptions{
+				SetValues: []string{"nodeGroupDefaults.volumeSize=200"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*cfg.NodeGroups[0].VolumeSize).To(Equal(200))
+			Expect(*cfg.ManagedNodeGroups[0].VolumeSize).To(Equal(200))
+		})
+
+		It("should merge the nodegroup defaults when parsing a config", func() {
+			data, err := os.ReadFile("testdata/nodegroup-defaults.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			cfg, err := ParseConfig(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.NodeGroupDefaults).To(BeNil())
+			Expect(cfg.NodeGroups[0].InstanceType).To(Equal("m5.large"))
+			Expect(cfg.ManagedNodeGroups[0].InstanceType).To(Equal("m5.large"))
+		})
+
+		It("should reject a name in the nodegroup defaults", func() {
+			_, err := LoadClusterConfigFromFile("testdata/nodegroup-defaults.yaml", LoadConfigOptions{
+				SetValues: []string{"nodeGroupDefaults.name=ng"},
+			})
+			Expect(err).To(MatchError(`loading config file "testdata/nodegroup-defaults.yaml": nodeGroupDefaults.name cannot be set`))
+		})
+
+		Context("config files written in a configuration language", func() {
+			var (
+				commandName string
+				commandArgs []string
+			)
+
+			fakeCommand := func(output string) ExecCommandFunc {
+				return func(name string, arg ...string) *exec.Cmd {
+					commandName, commandArgs = name, arg
+					return exec.Command("echo", output)
+				}
+			}
+
+			AfterEach(func() {
+				SetExecCommand(exec.Command)
+			})
+
+			It("should evaluate Jsonnet files", func() {
+				SetExecCommand(fakeCommand(`{"apiVersion": "eksctl.io/v1alpha5", "kind": "ClusterConfig", "metadata": {"name": "cluster-1", "region": "us-west-2"}, "nodeGroups": [{"name": "ng-1", "desiredCapacity": 2}, {"name": "ng-2", "desiredCapacity": 3}]}`))
+				cfg, err := LoadConfigFromFile("testdata/cluster.jsonnet")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(commandName).To(Equal("jsonnet"))
+				Expect(commandArgs).To(Equal([]string{"testdata/cluster.jsonnet"}))
+				Expect(cfg.Metadata.Name).To(Equal("cluster-1"))
+				Expect(cfg.NodeGroups).To(HaveLen(2))
+				Expect(*cfg.NodeGroups[1].DesiredCapacity).To(Equal(3))
+			})
+
+			It("should evaluate CUE files", func() {
+				SetExecCommand(fakeCommand(`{"apiVersion": "eksctl.io/v1alpha5", "kind": "ClusterConfig", "metadata": {"name": "cluster-1", "region": "us-west-2"}}`))
+				cfg, err := LoadConfigFromFile("cluster.cue")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(commandName).To(Equal("cue"))
+				Expect(commandArgs).To(Equal([]string{"export", "--out", "json", "cluster.cue"}))
+				Expect(cfg.Metadata.Region).To(Equal("us-west-2"))
+			})
+
+			It("should return the errors of the evaluation", func() {
+				SetExecCommand(func(name string, arg ...string) *exec.Cmd {
+					return exec.Command("sh", "-c", "echo 'RUNTIME ERROR: field does not exist: nodeGroup' >&2; exit 1")
+				})
+				_, err := LoadConfigFromFile("testdata/cluster.jsonnet")
+				Expect(err).To(MatchError(`reading config file "testdata/cluster.jsonnet": evaluating with jsonnet: RUNTIME ERROR: field does not exist: nodeGroup`))
+			})
+
+			It("should return an error when the evaluator is not installed", func() {
+				SetExecCommand(func(name string, arg ...string) *exec.Cmd {
+					return exec.Command("eksctl-evaluator-not-installed")
+				})
+				_, err := LoadConfigFromFile("testdata/cluster.jsonnet")
+				Expect(err).To(MatchError(`reading config file "testdata/cluster.jsonnet": jsonnet must be installed to evaluate .jsonnet files`))
+			})
+		})
+
 		It("should error when cannot read a file", func() {
 			_, err := LoadConfigFromFile("../../examples/nothing.xml")
 			Expect(err).To(HaveOccurred())
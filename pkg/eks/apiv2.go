@@ -3,11 +3,13 @@ package eks
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	middlewarev2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -25,10 +27,13 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/credentials"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/tracing"
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
-func newV2Config(pc *api.ProviderConfig, region string, credentialsCacheFilePath string) (aws.Config, error) {
+func newV2Config(pc *api.ProviderConfig, region string, credentialsCacheFilePath string) (aws.Config, retryOptions, error) {
 	var options []func(options *config.LoadOptions) error
 
 	// TODO default region
@@ -42,44 +47,74 @@ func newV2Config(pc *api.ProviderConfig, region string, credentialsCacheFilePath
 	}
 	options = append(options, config.WithClientLogMode(clientLogMode))
 
-	if endpointResolver := makeEndpointResolverFunc(); endpointResolver != nil {
+	if endpointResolver := makeEndpointResolverFunc(pc.Endpoints); endpointResolver != nil {
 		options = append(options, config.WithEndpointResolverWithOptions(endpointResolver))
 	}
 
+	if pc.UseFIPSEndpoints {
+		options = append(options, config.WithUseFIPSEndpoint(aws.FIPSEndpointStateEnabled))
+	}
+
 	if !pc.Profile.SourceIsEnvVar {
 		options = append(options, config.WithSharedConfigProfile(pc.Profile.Name))
 	}
 
+	apiOptions := []func(stack *middleware.Stack) error{
+		middlewarev2.AddUserAgentKeyValue("eksctl", version.String()),
+		tracing.AddAWSMiddleware,
+		metrics.AddAWSMiddleware,
+	}
+	if LogAPICalls {
+		apiOptions = append(apiOptions, addAPICallLogger)
+	}
+
 	cfg, err := config.LoadDefaultConfig(context.TODO(), append(options,
-		config.WithRetryer(func() aws.Retryer {
-			return NewRetryerV2()
-		}),
+		config.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions(func(t *http.Transport) {
+			t.Proxy = proxy.FromEnvironment
+		})),
 		config.WithAssumeRoleCredentialOptions(func(o *stscreds.AssumeRoleOptions) {
 			o.TokenProvider = stscreds.StdinTokenProvider
 			o.Duration = 30 * time.Minute
+			pc.RoleSession.ApplyTo(o)
 		}),
-		config.WithAPIOptions([]func(stack *middleware.Stack) error{
-			middlewarev2.AddUserAgentKeyValue("eksctl", version.String()),
-		}),
+		config.WithAPIOptions(apiOptions),
 	)...)
 
 	if err != nil {
-		return cfg, err
+		return cfg, retryOptions{}, err
+	}
+
+	retry, err := resolveRetryOptions(pc.Retry, cfg)
+	if err != nil {
+		return cfg, retry, err
+	}
+	cfg.Retryer = func() aws.Retryer {
+		return newRetryerV2(retry, aws.RetryModeStandard)
+	}
+	// the retryer already applies them, clients would otherwise wrap it with their own
+	cfg.RetryMode = ""
+	cfg.RetryMaxAttempts = 0
+
+	if len(pc.AssumeRoles) > 0 {
+		cfg.Credentials = assumeRoles(cfg, pc.AssumeRoles, pc.RoleSession)
 	}
+
 	if credentialsCacheFilePath != "" {
 		// TODO: extract the underlying CredentialsProvider from cfg.Credentials and use it.
-		fileCache, err := credentials.NewFileCacheV2(cfg.Credentials, pc.Profile.Name, afero.NewOsFs(), func(path string) credentials.Flock {
+		fileCache, err := credentials.NewFileCacheV2(cfg.Credentials, credentialsCacheKey(pc), afero.NewOsFs(), func(path string) credentials.Flock {
 			return flock.New(path)
 		}, &credentials.RealClock{}, credentialsCacheFilePath)
 		if err != nil {
-			return cfg, fmt.Errorf("error creating credentials cache: %w", err)
+			return cfg, retry, fmt.Errorf("error creating credentials cache: %w", err)
 		}
 		cfg.Credentials = aws.NewCredentialsCache(fileCache)
 	}
-	return cfg, nil
+	return cfg, retry, nil
 }
 
-func makeEndpointResolverFunc() aws.EndpointResolverWithOptionsFunc {
+// makeEndpointResolverFunc returns a resolver of the endpoints set in the config file, or with the
+// AWS_<SERVICE>_ENDPOINT environment variables, or nil if none is set
+func makeEndpointResolverFunc(configured api.AWSEndpoints) aws.EndpointResolverWithOptionsFunc {
 	serviceIDEnvMap := map[string]string{
 		cloudformation.ServiceID:         "AWS_CLOUDFORMATION_ENDPOINT",
 		eks.ServiceID:                    "AWS_EKS_ENDPOINT",
@@ -90,30 +125,36 @@ func makeEndpointResolverFunc() aws.EndpointResolverWithOptionsFunc {
 		iam.ServiceID:                    "AWS_IAM_ENDPOINT",
 		cloudtrail.ServiceID:             "AWS_CLOUDTRAIL_ENDPOINT",
 	}
+	serviceIDConfigMap := map[string]string{
+		cloudformation.ServiceID: configured.CloudFormation,
+		eks.ServiceID:            configured.EKS,
+		ec2.ServiceID:            configured.EC2,
+		sts.ServiceID:            configured.STS,
+		iam.ServiceID:            configured.IAM,
+	}
 
-	hasCustomEndpoint := false
+	serviceEndpoints := map[string]string{}
 	for service, envName := range serviceIDEnvMap {
-		if endpoint, ok := os.LookupEnv(envName); ok {
-			logger.Debug(
-				"Setting %s endpoint to %s", service, endpoint)
-			hasCustomEndpoint = true
+		if endpoint := serviceIDConfigMap[service]; endpoint != "" {
+			serviceEndpoints[service] = endpoint
+		} else if endpoint, ok := os.LookupEnv(envName); ok {
+			serviceEndpoints[service] = endpoint
 		}
 	}
 
-	if !hasCustomEndpoint {
+	if len(serviceEndpoints) == 0 {
 		return nil
 	}
+	for service, endpoint := range serviceEndpoints {
+		logger.Debug("Setting %s endpoint to %s", service, endpoint)
+	}
 
 	return func(service, region string, options ...interface{}) (aws.Endpoint, error) {
-		if envName, ok := serviceIDEnvMap[service]; ok {
-			if ok {
-				if endpoint, ok := os.LookupEnv(envName); ok {
-					return aws.Endpoint{
-						URL:           endpoint,
-						SigningRegion: region,
-					}, nil
-				}
-			}
+		if endpoint, ok := serviceEndpoints[service]; ok {
+			return aws.Endpoint{
+				URL:           endpoint,
+				SigningRegion: region,
+			}, nil
 		}
 		return aws.Endpoint{}, &aws.EndpointNotFoundError{}
 	}
@@ -0,0 +1,83 @@
+package eks_test
+
+import (
+	"context"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+var _ = Describe("AWS endpoints", func() {
+	setEnv := func(name, value string) {
+		if val, ok := os.LookupEnv(name); ok {
+			DeferCleanup(os.Setenv, name, val)
+		} else {
+			DeferCleanup(os.Unsetenv, name)
+		}
+		Expect(os.Setenv(name, value)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		for _, name := range []string{"AWS_CLOUDFORMATION_ENDPOINT", "AWS_EKS_ENDPOINT", "AWS_EC2_ENDPOINT", "AWS_ELB_ENDPOINT",
+			"AWS_ELBV2_ENDPOINT", "AWS_STS_ENDPOINT", "AWS_IAM_ENDPOINT", "AWS_CLOUDTRAIL_ENDPOINT"} {
+			if val, ok := os.LookupEnv(name); ok {
+				Expect(os.Unsetenv(name)).To(Succeed())
+				DeferCleanup(os.Setenv, name, val)
+			}
+		}
+	})
+
+	It("uses the default endpoints when none is set", func() {
+		Expect(eks.MakeEndpointResolverFunc(api.AWSEndpoints{})).To(BeNil())
+	})
+
+	It("uses the endpoints of the config file over the environment", func() {
+		setEnv("AWS_EKS_ENDPOINT", "https://eks.example.com")
+		setEnv("AWS_EC2_ENDPOINT", "https://ec2.example.com")
+
+		resolve := eks.MakeEndpointResolverFunc(api.AWSEndpoints{
+			EKS:            "http://localhost:4566",
+			CloudFormation: "http://localhost:4566",
+		})
+		Expect(resolve).NotTo(BeNil())
+
+		for service, url := range map[string]string{
+			"EKS":            "http://localhost:4566",
+			"CloudFormation": "http://localhost:4566",
+			"EC2":            "https://ec2.example.com",
+		} {
+			endpoint, err := resolve(service, "us-west-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoint).To(Equal(aws.Endpoint{
+				URL:           url,
+				SigningRegion: "us-west-2",
+			}))
+		}
+
+		_, err := resolve("IAM", "us-west-2")
+		Expect(err).To(BeAssignableToTypeOf(&aws.EndpointNotFoundError{}))
+	})
+})
+
+var _ = Describe("FIPS endpoints", func() {
+	It("rejects a region without FIPS endpoints", func() {
+		_, err := eks.New(context.Background(), &api.ProviderConfig{
+			Region:           api.RegionEUWest1,
+			UseFIPSEndpoints: true,
+		}, nil)
+		Expect(err).To(MatchError("FIPS endpoints are not available in region eu-west-1 - use one of: us-west-1, us-west-2, us-east-1, us-east-2, us-gov-west-1, us-gov-east-1"))
+		Expect(exitcode.FromError(err)).To(Equal(exitcode.Validation))
+	})
+
+	It("knows the regions with FIPS endpoints", func() {
+		Expect(api.IsFIPSRegion(api.RegionUSGovWest1)).To(BeTrue())
+		Expect(api.IsFIPSRegion(api.RegionUSEast1)).To(BeTrue())
+		Expect(api.IsFIPSRegion(api.RegionCNNorth1)).To(BeFalse())
+	})
+})
@@ -0,0 +1,64 @@
+package eks
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// readMFACode prompts for the code of the MFA device identified by serial
+var readMFACode = func(serial string) (string, error) {
+	fmt.Fprintf(os.Stderr, "MFA code for %s: ", serial)
+	code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("reading MFA code for %s: %w", serial, err)
+	}
+	return strings.TrimSpace(code), nil
+}
+
+// newAssumeRoleClient returns the client assuming a role with the credentials of cfg
+var newAssumeRoleClient = func(cfg aws.Config) stscreds.AssumeRoleAPIClient {
+	return sts.NewFromConfig(cfg)
+}
+
+// assumeRoles returns the credentials of the last of roles, each of them assumed with the credentials of
+// the previous one, the first one with the credentials of cfg, in session
+func assumeRoles(cfg aws.Config, roles []api.AWSAssumeRole, session api.AWSRoleSessionConfig) aws.CredentialsProvider {
+	credentials := cfg.Credentials
+	for _, role := range roles {
+		role := role
+		stsConfig := cfg.Copy()
+		stsConfig.Credentials = credentials
+		credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(newAssumeRoleClient(stsConfig), role.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			session.ApplyTo(o)
+			o.Duration = role.DurationOrDefault()
+			if role.ExternalID != "" {
+				o.ExternalID = aws.String(role.ExternalID)
+			}
+			if role.MFASerial != "" {
+				o.SerialNumber = aws.String(role.MFASerial)
+				o.TokenProvider = func() (string, error) {
+					return readMFACode(role.MFASerial)
+				}
+			}
+		}))
+	}
+	return credentials
+}
+
+// credentialsCacheKey returns the key of the credentials of pc in the credentials cache, the profile
+// followed by the roles assumed with its credentials, if any
+func credentialsCacheKey(pc *api.ProviderConfig) string {
+	key := pc.Profile.Name
+	for _, role := range pc.AssumeRoles {
+		key += "->" + role.RoleARN
+	}
+	return key
+}
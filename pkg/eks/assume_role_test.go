@@ -0,0 +1,164 @@
+package eks_test
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+type assumeRoleCall struct {
+	accessKeyID  string
+	roleARN      string
+	externalID   string
+	serialNumber string
+	tokenCode    string
+	duration     int32
+}
+
+// fakeAssumeRoleClient records the calls to AssumeRole, returning credentials whose access key ID is the name of the role
+type fakeAssumeRoleClient struct {
+	credentials aws.CredentialsProvider
+	calls       *[]assumeRoleCall
+	inputs      *[]*sts.AssumeRoleInput
+}
+
+func (c *fakeAssumeRoleClient) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, _ ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	creds, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	*c.calls = append(*c.calls, assumeRoleCall{
+		accessKeyID:  creds.AccessKeyID,
+		roleARN:      aws.ToString(params.RoleArn),
+		externalID:   aws.ToString(params.ExternalId),
+		serialNumber: aws.ToString(params.SerialNumber),
+		tokenCode:    aws.ToString(params.TokenCode),
+		duration:     aws.ToInt32(params.DurationSeconds),
+	})
+	*c.inputs = append(*c.inputs, params)
+	roleARN := aws.ToString(params.RoleArn)
+	return &sts.AssumeRoleOutput{
+		Credentials: &ststypes.Credentials{
+			AccessKeyId:     aws.String(roleARN[strings.LastIndex(roleARN, "/")+1:]),
+			SecretAccessKey: aws.String("secret"),
+			SessionToken:    aws.String("token"),
+			Expiration:      aws.Time(time.Now().Add(time.Hour)),
+		},
+	}, nil
+}
+
+var _ = Describe("Assume role chains", func() {
+	var (
+		calls  []assumeRoleCall
+		inputs []*sts.AssumeRoleInput
+	)
+
+	BeforeEach(func() {
+		calls = nil
+		inputs = nil
+		eks.SetNewAssumeRoleClient(func(cfg aws.Config) stscreds.AssumeRoleAPIClient {
+			return &fakeAssumeRoleClient{
+				credentials: cfg.Credentials,
+				calls:       &calls,
+				inputs:      &inputs,
+			}
+		})
+		eks.SetReadMFACode(func(serial string) (string, error) {
+			return "123456", nil
+		})
+	})
+
+	It("assumes each role with the credentials of the previous one", func() {
+		credentials := eks.AssumeRoles(aws.Config{
+			Region:      "us-west-2",
+			Credentials: credentials.NewStaticCredentialsProvider("base", "secret", ""),
+		}, []api.AWSAssumeRole{
+			{
+				RoleARN:   "arn:aws:iam::111122223333:role/intermediate",
+				MFASerial: "arn:aws:iam::111122223333:mfa/alice",
+			},
+			{
+				RoleARN:    "arn:aws:iam::444455556666:role/target",
+				ExternalID: "external",
+				Duration:   "15m",
+			},
+		}, api.AWSRoleSessionConfig{})
+
+		creds, err := credentials.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(creds.AccessKeyID).To(Equal("target"))
+		Expect(calls).To(Equal([]assumeRoleCall{
+			{
+				accessKeyID:  "base",
+				roleARN:      "arn:aws:iam::111122223333:role/intermediate",
+				serialNumber: "arn:aws:iam::111122223333:mfa/alice",
+				tokenCode:    "123456",
+				duration:     3600,
+			},
+			{
+				accessKeyID: "intermediate",
+				roleARN:     "arn:aws:iam::444455556666:role/target",
+				externalID:  "external",
+				duration:    900,
+			},
+		}))
+
+		By("reusing the credentials until they expire")
+		_, err = credentials.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(calls).To(HaveLen(2))
+	})
+
+	It("assumes the roles in the session", func() {
+		credentials := eks.AssumeRoles(aws.Config{
+			Region:      "us-west-2",
+			Credentials: credentials.NewStaticCredentialsProvider("base", "secret", ""),
+		}, []api.AWSAssumeRole{
+			{RoleARN: "arn:aws:iam::111122223333:role/intermediate"},
+			{RoleARN: "arn:aws:iam::444455556666:role/target"},
+		}, api.AWSRoleSessionConfig{
+			Name:           "deploy-42",
+			SourceIdentity: "alice",
+			Tags: map[string]string{
+				"pipeline": "deploy",
+				"commit":   "abc123",
+			},
+		})
+
+		_, err := credentials.Retrieve(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inputs).To(HaveLen(2))
+		for _, input := range inputs {
+			Expect(input.RoleSessionName).To(Equal(aws.String("deploy-42")))
+			Expect(input.SourceIdentity).To(Equal(aws.String("alice")))
+			Expect(input.Tags).To(Equal([]ststypes.Tag{
+				{Key: aws.String("commit"), Value: aws.String("abc123")},
+				{Key: aws.String("pipeline"), Value: aws.String("deploy")},
+			}))
+		}
+	})
+
+	It("caches the credentials of the chain apart from those of the profile", func() {
+		Expect(eks.CredentialsCacheKey(&api.ProviderConfig{
+			Profile: api.Profile{Name: "base"},
+		})).To(Equal("base"))
+		Expect(eks.CredentialsCacheKey(&api.ProviderConfig{
+			Profile: api.Profile{Name: "base"},
+			AssumeRoles: []api.AWSAssumeRole{
+				{RoleARN: "arn:aws:iam::111122223333:role/intermediate"},
+				{RoleARN: "arn:aws:iam::444455556666:role/target"},
+			},
+		})).To(Equal("base->arn:aws:iam::111122223333:role/intermediate->arn:aws:iam::444455556666:role/target"))
+	})
+})
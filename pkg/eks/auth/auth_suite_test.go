@@ -0,0 +1,11 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestAuth(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
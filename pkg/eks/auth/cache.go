@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/weaveworks/eksctl/pkg/credentials"
+)
+
+// TokenCacheDirEnvName defines an environment property to configure where the cached tokens live
+const TokenCacheDirEnvName = "EKSCTL_TOKEN_CACHE_DIR"
+
+// tokenCacheLeeway is how long before its expiration a cached token is not used anymore, not to
+// hand out a token expiring while kubectl uses it
+const tokenCacheLeeway = time.Minute
+
+// TokenCacheKey identifies the cached token of a cluster, for the identity getting it
+type TokenCacheKey struct {
+	ClusterID string
+	Region    string
+	Profile   string
+	// AccessKeyID is the access key ID of the credentials of the environment, which take precedence over the
+	// profile, so that the tokens of different static credentials are kept apart
+	AccessKeyID string
+	RoleARN     string
+	SessionName string
+}
+
+// fileName returns the name of the file of the token, a hash of the key not to leak it in the name
+func (k TokenCacheKey) fileName() string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{k.ClusterID, k.Region, k.Profile, k.AccessKeyID, k.RoleARN, k.SessionName}, "\x00")))
+	return hex.EncodeToString(sum[:]) + ".json"
+}
+
+// TokenCache caches tokens on disk, one file per cluster and identity, until they expire
+type TokenCache struct {
+	dir   string
+	clock credentials.Clock
+}
+
+// NewTokenCache returns a TokenCache keeping the tokens in dir
+func NewTokenCache(dir string, clock credentials.Clock) *TokenCache {
+	return &TokenCache{
+		dir:   dir,
+		clock: clock,
+	}
+}
+
+// GetTokenCacheDir returns the directory of the cached tokens, set by EKSCTL_TOKEN_CACHE_DIR, or
+// ~/.eksctl/cache/tokens
+func GetTokenCacheDir() (string, error) {
+	if dir := os.Getenv(TokenCacheDirEnvName); dir != "" {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".eksctl", "cache", "tokens"), nil
+}
+
+// Get returns the cached token of key, if it does not expire within a minute
+func (c *TokenCache) Get(key TokenCacheKey) (Token, bool) {
+	data, err := os.ReadFile(filepath.Join(c.dir, key.fileName()))
+	if err != nil {
+		return Token{}, false
+	}
+	var token Token
+	if err := json.Unmarshal(data, &token); err != nil || token.Token == "" {
+		return Token{}, false
+	}
+	if !c.clock.Now().Add(tokenCacheLeeway).Before(token.Expiration) {
+		return Token{}, false
+	}
+	return token, true
+}
+
+// Put caches token for key, the file is replaced atomically so that concurrent readers never see
+// a partially written token
+func (c *TokenCache) Put(key TokenCacheKey, token Token) error {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(c.dir, ".token-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(c.dir, key.fileName()))
+}
@@ -0,0 +1,90 @@
+package auth_test
+
+import (
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/credentials/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks/auth"
+)
+
+var _ = Describe("TokenCache", func() {
+	var (
+		dir   string
+		clock *fakes.FakeClock
+		cache *auth.TokenCache
+		key   auth.TokenCacheKey
+		now   time.Time
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		now = time.Date(2022, 5, 3, 9, 12, 33, 0, time.UTC)
+		clock = &fakes.FakeClock{}
+		clock.NowReturns(now)
+		cache = auth.NewTokenCache(dir, clock)
+		key = auth.TokenCacheKey{ClusterID: "dev", Region: "us-west-2", RoleARN: "arn:aws:iam::123456789012:role/admin"}
+	})
+
+	It("returns the cached token until a minute before it expires", func() {
+		token := auth.Token{Token: "k8s-aws-v1.token", Expiration: now.Add(10 * time.Minute)}
+		Expect(cache.Put(key, token)).To(Succeed())
+
+		cached, ok := cache.Get(key)
+		Expect(ok).To(BeTrue())
+		Expect(cached.Token).To(Equal(token.Token))
+		Expect(cached.Expiration.Equal(token.Expiration)).To(BeTrue())
+
+		clock.NowReturns(now.Add(9 * time.Minute))
+		_, ok = cache.Get(key)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("keeps the tokens of other clusters and roles apart", func() {
+		Expect(cache.Put(key, auth.Token{Token: "k8s-aws-v1.token", Expiration: now.Add(10 * time.Minute)})).To(Succeed())
+
+		otherRole := key
+		otherRole.RoleARN = "arn:aws:iam::123456789012:role/viewer"
+		_, ok := cache.Get(otherRole)
+		Expect(ok).To(BeFalse())
+
+		otherCluster := key
+		otherCluster.ClusterID = "prod"
+		_, ok = cache.Get(otherCluster)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("keeps the tokens of other static credentials apart", func() {
+		key.AccessKeyID = "AKIAEXAMPLE1"
+		Expect(cache.Put(key, auth.Token{Token: "k8s-aws-v1.token", Expiration: now.Add(10 * time.Minute)})).To(Succeed())
+
+		otherCredentials := key
+		otherCredentials.AccessKeyID = "AKIAEXAMPLE2"
+		_, ok := cache.Get(otherCredentials)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("writes the tokens readable by their owner only", func() {
+		Expect(cache.Put(key, auth.Token{Token: "k8s-aws-v1.token", Expiration: now.Add(10 * time.Minute)})).To(Succeed())
+
+		entries, err := os.ReadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		info, err := entries[0].Info()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("ignores corrupted tokens", func() {
+		Expect(cache.Put(key, auth.Token{Token: "k8s-aws-v1.token", Expiration: now.Add(10 * time.Minute)})).To(Succeed())
+		entries, err := os.ReadDir(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(dir+"/"+entries[0].Name(), []byte("{"), 0600)).To(Succeed())
+
+		_, ok := cache.Get(key)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -13,12 +13,14 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 	"k8s.io/client-go/transport"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -27,7 +29,9 @@ import (
 	"github.com/weaveworks/eksctl/pkg/credentials"
 	"github.com/weaveworks/eksctl/pkg/eks/auth"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/tracing"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
 )
 
 // Client stores information about the client config
@@ -66,6 +70,8 @@ func (c *Client) new(tokenSource oauth2.TokenSource) (*Client, error) {
 		return nil, errors.Wrap(err, "failed to create API client configuration from client config")
 	}
 	rawConfig.WrapTransport = transport.TokenSourceWrapTransport(transport.NewCachedTokenSource(tokenSource))
+	rawConfig.Wrap(tracing.WrapTransport)
+	rawConfig.Proxy = proxy.FromEnvironment
 
 	c.rawConfig = rawConfig
 	c.rawConfig.QPS = float32(25)
@@ -117,6 +123,16 @@ func (c *KubernetesProvider) NewRawClient(clusterInfo kubeconfig.ClusterInfo) (*
 	return kubewrapper.NewRawClient(clientSet, client.rawConfig)
 }
 
+// NewRESTClientGetter creates a REST client getter for the cluster, as used by Helm, with the given default namespace.
+func (c *ClusterProvider) NewRESTClientGetter(spec *api.ClusterConfig, namespace string) (*kubewrapper.SimpleRESTClientGetter, error) {
+	config := kubeconfig.NewForKubectl(spec, GetUsername(c.Status.IAMRoleARN), "", c.AWSProvider.Profile().Name)
+	kubeConfigBytes, err := runtime.Encode(clientcmdlatest.Codec, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating kubeconfig")
+	}
+	return kubewrapper.NewRESTClientGetter(namespace, string(kubeConfigBytes)), nil
+}
+
 // ServerVersion will use discovery API to fetch version of Kubernetes control plane
 func (c *KubernetesProvider) ServerVersion(rawClient *kubewrapper.RawClient) (string, error) {
 	return rawClient.ServerVersion()
@@ -209,7 +225,7 @@ func WaitForNodes(ctx context.Context, clientSet kubernetes.Interface, ng KubeNo
 
 			if event.Object != nil && event.Type != watch.Deleted {
 				if node, ok := event.Object.(*corev1.Node); ok {
-					if isNodeReady(node) {
+					if IsNodeReady(node) {
 						readyNodes.Insert(node.Name)
 						counter = readyNodes.Len()
 						logger.Debug("node %q is ready in %q", node.Name, ng.NameString())
@@ -182,15 +182,19 @@ func (c *ClusterProvider) NewOpenIDConnectManager(ctx context.Context, spec *api
 	}
 
 	return iamoidc.NewOpenIDConnectManager(c.AWSProvider.IAM(), c.AWSProvider.CloudFormation(), parsedARN.AccountID,
-		*c.Status.ClusterInfo.Cluster.Identity.Oidc.Issuer, parsedARN.Partition, sharedTags(c.Status.ClusterInfo.Cluster), tprint)
+		*c.Status.ClusterInfo.Cluster.Identity.Oidc.Issuer, parsedARN.Partition, sharedTags(c.Status.ClusterInfo.Cluster, c.Status.IAMRoleARN), tprint)
 }
 
-func sharedTags(cluster *ekstypes.Cluster) map[string]string {
-	return map[string]string{
+func sharedTags(cluster *ekstypes.Cluster, owner string) map[string]string {
+	tags := map[string]string{
 		api.ClusterNameTag:   *cluster.Name,
 		api.EksctlVersionTag: version.GetVersion(),
+		api.CreatedByTag:     api.CreatedByTagValue,
 	}
-
+	if owner != "" {
+		tags[api.OwnerTag] = owner
+	}
+	return tags
 }
 
 // LoadClusterVPC loads the VPC configuration.
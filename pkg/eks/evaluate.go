@@ -0,0 +1,43 @@
+package eks
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ExecCommandFunc creates the command that evaluates a config file
+type ExecCommandFunc func(name string, arg ...string) *exec.Cmd
+
+var execCommand ExecCommandFunc = exec.Command
+
+// configEvaluators holds, for each extension of the config files written in a configuration language,
+// the command that evaluates a file to JSON, which must be installed separately
+var configEvaluators = map[string][]string{
+	".jsonnet": {"jsonnet"},
+	".cue":     {"cue", "export", "--out", "json"},
+}
+
+// evaluateConfig evaluates a Jsonnet or CUE config file to JSON, it returns false when configFile
+// is not written in one of these languages
+func evaluateConfig(configFile string) ([]byte, bool, error) {
+	evaluator, ok := configEvaluators[strings.ToLower(filepath.Ext(configFile))]
+	if !ok {
+		return nil, false, nil
+	}
+
+	out, err := execCommand(evaluator[0], append(evaluator[1:], configFile)...).Output()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, true, fmt.Errorf("%s must be installed to evaluate %s files", evaluator[0], filepath.Ext(configFile))
+		}
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, true, fmt.Errorf("evaluating with %s: %s", evaluator[0], strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, true, fmt.Errorf("evaluating with %s: %w", evaluator[0], err)
+	}
+	return out, true, nil
+}
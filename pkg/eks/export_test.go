@@ -0,0 +1,57 @@
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+func SetExecCommand(f ExecCommandFunc) {
+	execCommand = f
+}
+
+var DescribeAPICall = describeAPICall
+
+func NewRetryerFromConfig(r api.AWSRetryConfig, cfg aws.Config, defaultMode aws.RetryMode) (*RetryerV2, error) {
+	o, err := resolveRetryOptions(r, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newRetryerV2(o, defaultMode), nil
+}
+
+var MakeEndpointResolverFunc = makeEndpointResolverFunc
+
+func SetConfirmSSOLogin(f func(command string) bool) {
+	confirmSSOLogin = f
+}
+
+// EnsureSSOSession returns false if the credentials of profile are not issued by SSO
+func EnsureSSOSession(ctx context.Context, profile string, timeout time.Duration) (bool, error) {
+	sso := loadSSOProfile(ctx, profile)
+	if sso == nil {
+		return false, nil
+	}
+	return true, sso.ensureSession(timeout)
+}
+
+func NewCredentialsV2Provider(provider aws.CredentialsProvider) credentials.Provider {
+	return &credentialsV2Provider{credentials: provider}
+}
+
+var AssumeRoles = assumeRoles
+
+func SetReadMFACode(f func(serial string) (string, error)) {
+	readMFACode = f
+}
+
+func SetNewAssumeRoleClient(f func(cfg aws.Config) stscreds.AssumeRoleAPIClient) {
+	newAssumeRoleClient = f
+}
+
+var CredentialsCacheKey = credentialsCacheKey
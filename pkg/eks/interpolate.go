@@ -0,0 +1,47 @@
+package eks
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// envVarPattern matches $$, ${VAR}, ${VAR:-default} and ${VAR:?message}
+var envVarPattern = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)(?:(:-|:\?)([^}]*))?\}`)
+
+// expandEnv replaces the references to environment variables in data: ${VAR} is replaced with the value of VAR,
+// which must be set, ${VAR:-default} with default when VAR is unset or empty, and ${VAR:?message} fails with
+// message when VAR is unset or empty. $$ is replaced with a literal $, e.g. for the variables of shell commands
+func expandEnv(data []byte, lookupEnv func(string) (string, bool)) ([]byte, error) {
+	var missing []string
+	expanded := envVarPattern.ReplaceAllFunc(data, func(match []byte) []byte {
+		if string(match) == "$$" {
+			return []byte("$")
+		}
+		groups := envVarPattern.FindSubmatch(match)
+		name, operator, word := string(groups[1]), string(groups[2]), string(groups[3])
+		value, ok := lookupEnv(name)
+		switch operator {
+		case ":-":
+			if !ok || value == "" {
+				return []byte(word)
+			}
+		case ":?":
+			if !ok || value == "" {
+				if word == "" {
+					word = "must be set"
+				}
+				missing = append(missing, fmt.Sprintf("%s: %s", name, word))
+			}
+		default:
+			if !ok {
+				missing = append(missing, fmt.Sprintf("%s: must be set", name))
+			}
+		}
+		return []byte(value)
+	})
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("expanding environment variables: %s", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
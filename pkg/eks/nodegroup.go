@@ -26,7 +26,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
-func isNodeReady(node *corev1.Node) bool {
+// IsNodeReady returns true if the node has a Ready condition set to true
+func IsNodeReady(node *corev1.Node) bool {
 	for _, c := range node.Status.Conditions {
 		if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
 			return true
@@ -44,7 +45,7 @@ func GetNodes(clientSet kubernetes.Interface, ng KubeNodeGroup) (int, error) {
 	counter := 0
 	for _, node := range nodes.Items {
 		ready := "not ready"
-		if isNodeReady(&node) {
+		if IsNodeReady(&node) {
 			ready = "ready"
 			counter++
 		}
@@ -104,6 +105,19 @@ type KubeNodeGroup interface {
 	GetAMIFamily() string
 }
 
+// ToKubeNodeGroups combines managed and unmanaged nodegroups and returns a slice of KubeNodeGroup containing
+// both types of nodegroups
+func ToKubeNodeGroups(clusterConfig *api.ClusterConfig) []KubeNodeGroup {
+	var kubeNodeGroups []KubeNodeGroup
+	for _, ng := range clusterConfig.NodeGroups {
+		kubeNodeGroups = append(kubeNodeGroups, ng)
+	}
+	for _, ng := range clusterConfig.ManagedNodeGroups {
+		kubeNodeGroups = append(kubeNodeGroups, ng)
+	}
+	return kubeNodeGroups
+}
+
 // GetNodeGroupIAM retrieves the IAM configuration of the given nodegroup
 func (c *ClusterProvider) GetNodeGroupIAM(ctx context.Context, stackManager manager.StackManager, ng *api.NodeGroup) error {
 	stacks, err := stackManager.ListNodeGroupStacks(ctx)
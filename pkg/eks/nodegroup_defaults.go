@@ -0,0 +1,69 @@
+package eks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// nodeGroupDefaultsField is the field of a config file holding the settings shared by its nodegroups
+const nodeGroupDefaultsField = "nodeGroupDefaults"
+
+// applyNodeGroupDefaults merges the settings of the nodeGroupDefaults field of document into each nodegroup and
+// managed nodegroup, removes the field and returns the result as JSON. The settings of a nodegroup take precedence
+// and are merged like overlays, so e.g. labels are added to the default ones and null unsets a default setting.
+// document is returned unchanged when it has no nodeGroupDefaults
+func applyNodeGroupDefaults(document []byte) ([]byte, error) {
+	var obj map[string]interface{}
+	if err := yaml.Unmarshal(document, &obj); err != nil {
+		return nil, err
+	}
+	value, ok := obj[nodeGroupDefaultsField]
+	if !ok {
+		return document, nil
+	}
+	delete(obj, nodeGroupDefaultsField)
+	if value == nil {
+		return json.Marshal(obj)
+	}
+	defaults, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", nodeGroupDefaultsField)
+	}
+	if _, ok := defaults["name"]; ok {
+		return nil, fmt.Errorf("%s.name cannot be set", nodeGroupDefaultsField)
+	}
+
+	for _, field := range []string{"nodeGroups", "managedNodeGroups"} {
+		nodeGroups, ok := obj[field].([]interface{})
+		if !ok {
+			continue
+		}
+		for i, ng := range nodeGroups {
+			ngObj, ok := ng.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s[%d] must be an object", field, i)
+			}
+			merged, err := copyObject(defaults)
+			if err != nil {
+				return nil, err
+			}
+			nodeGroups[i] = mergeObjects(merged, ngObj)
+		}
+	}
+	return json.Marshal(obj)
+}
+
+// copyObject returns a deep copy of obj, so that it can be merged into without modifying obj
+func copyObject(obj map[string]interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var objCopy map[string]interface{}
+	if err := json.Unmarshal(data, &objCopy); err != nil {
+		return nil, err
+	}
+	return objCopy, nil
+}
@@ -0,0 +1,98 @@
+package eks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// applyOverlay merges the overlay, a partial ClusterConfig, into document and returns the result as JSON.
+// Objects are merged recursively and lists of objects that all have a name, such as nodegroups, are merged
+// by name, appending the objects missing from document. Any other value of the overlay replaces the one
+// in document, and null removes it
+func applyOverlay(document, overlay []byte) ([]byte, error) {
+	var base map[string]interface{}
+	if err := yaml.Unmarshal(document, &base); err != nil {
+		return nil, err
+	}
+	var patch map[string]interface{}
+	if err := yaml.Unmarshal(overlay, &patch); err != nil {
+		return nil, err
+	}
+	if patch == nil {
+		return nil, fmt.Errorf("overlay is empty")
+	}
+	return json.Marshal(mergeObjects(base, patch))
+}
+
+func mergeObjects(base, patch map[string]interface{}) map[string]interface{} {
+	if base == nil {
+		base = map[string]interface{}{}
+	}
+	for key, patchValue := range patch {
+		if patchValue == nil {
+			delete(base, key)
+			continue
+		}
+		base[key] = mergeValues(base[key], patchValue)
+	}
+	return base
+}
+
+func mergeValues(base, patch interface{}) interface{} {
+	switch patch := patch.(type) {
+	case map[string]interface{}:
+		if base, ok := base.(map[string]interface{}); ok {
+			return mergeObjects(base, patch)
+		}
+	case []interface{}:
+		if base, ok := base.([]interface{}); ok {
+			if merged, ok := mergeNamedLists(base, patch); ok {
+				return merged
+			}
+		}
+	}
+	return patch
+}
+
+// mergeNamedLists merges the objects of patch into those of base with the same name, it returns false
+// if patch is empty or any item of either list is not an object with a name
+func mergeNamedLists(base, patch []interface{}) ([]interface{}, bool) {
+	if len(patch) == 0 {
+		return nil, false
+	}
+	indexByName := map[string]int{}
+	for i, item := range base {
+		name, ok := itemName(item)
+		if !ok {
+			return nil, false
+		}
+		indexByName[name] = i
+	}
+	for _, item := range patch {
+		if _, ok := itemName(item); !ok {
+			return nil, false
+		}
+	}
+
+	merged := append([]interface{}{}, base...)
+	for _, item := range patch {
+		name, _ := itemName(item)
+		if i, ok := indexByName[name]; ok {
+			merged[i] = mergeObjects(merged[i].(map[string]interface{}), item.(map[string]interface{}))
+			continue
+		}
+		merged = append(merged, item)
+	}
+	return merged, true
+}
+
+func itemName(item interface{}) (string, bool) {
+	obj, ok := item.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	name, ok := obj["name"].(string)
+	return name, ok && name != ""
+}
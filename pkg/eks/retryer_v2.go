@@ -1,7 +1,11 @@
 package eks
 
 import (
+	"fmt"
+	"math/rand"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 
@@ -10,32 +14,103 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
+// retryBaseBackoffEnvName is the environment variable setting the base backoff of the retries of AWS API calls
+const retryBaseBackoffEnvName = "EKSCTL_RETRY_BASE_BACKOFF"
+
 // RetryerV2 implements aws.Retryer
 type RetryerV2 struct {
-	aws.Retryer
+	aws.RetryerV2
 }
 
 // NewRetryerV2 returns a new *RetryerV2
 func NewRetryerV2() *RetryerV2 {
-	standard := retry.AddWithMaxAttempts(retry.NewStandard(func(o *retry.StandardOptions) {
-		o.MaxAttempts = maxRetries
-	}), maxRetries)
+	return newRetryerV2(retryOptions{maxAttempts: maxRetries}, aws.RetryModeStandard)
+}
+
+// retryOptions are the retry options of the AWS clients, once resolved from flags, the config file and the environment
+type retryOptions struct {
+	// mode is empty when it is not set, leaving each client to its default mode
+	mode        aws.RetryMode
+	maxAttempts int
+	// baseBackoff is zero when it is not set, using the default backoff of the SDK
+	baseBackoff time.Duration
+}
+
+// resolveRetryOptions resolves the retry options set with flags or in the config file, falling back to the
+// AWS_RETRY_MODE and AWS_MAX_ATTEMPTS environment variables or the AWS config file, as loaded in cfg, and to
+// EKSCTL_RETRY_BASE_BACKOFF
+func resolveRetryOptions(r api.AWSRetryConfig, cfg aws.Config) (retryOptions, error) {
+	o := retryOptions{
+		mode:        aws.RetryMode(r.Mode),
+		maxAttempts: r.MaxAttempts,
+		baseBackoff: r.BaseBackoffDuration(),
+	}
+	if o.mode == "" {
+		o.mode = cfg.RetryMode
+	}
+	if o.maxAttempts == 0 {
+		o.maxAttempts = cfg.RetryMaxAttempts
+	}
+	if o.maxAttempts == 0 {
+		o.maxAttempts = maxRetries
+	}
+	if val, ok := os.LookupEnv(retryBaseBackoffEnvName); ok && o.baseBackoff == 0 {
+		baseBackoff, err := time.ParseDuration(val)
+		if err != nil || baseBackoff <= 0 {
+			return o, fmt.Errorf("invalid value %q for %s: must be a positive duration", val, retryBaseBackoffEnvName)
+		}
+		o.baseBackoff = baseBackoff
+	}
+	return o, nil
+}
+
+// newRetryerV2 returns a retryer for the options, in defaultMode if they do not set the mode
+func newRetryerV2(o retryOptions, defaultMode aws.RetryMode) *RetryerV2 {
+	standardOptions := func(so *retry.StandardOptions) {
+		if o.maxAttempts != 0 {
+			so.MaxAttempts = o.maxAttempts
+		}
+		if o.baseBackoff != 0 {
+			so.Backoff = &exponentialBackoff{
+				base: o.baseBackoff,
+				max:  retry.DefaultMaxBackoff,
+			}
+		}
+	}
+
+	mode := o.mode
+	if mode == "" {
+		mode = defaultMode
+	}
+	var retryer aws.RetryerV2
+	if mode == aws.RetryModeAdaptive {
+		retryer = retry.NewAdaptiveMode(func(ao *retry.AdaptiveModeOptions) {
+			ao.StandardOptions = append(ao.StandardOptions, standardOptions)
+		})
+	} else {
+		retryer = retry.NewStandard(standardOptions)
+	}
 
 	return &RetryerV2{
-		Retryer: standard,
+		RetryerV2: retryer,
 	}
 }
 
 // IsErrorRetryable implements aws.Retryer
 func (r *RetryerV2) IsErrorRetryable(err error) bool {
-	if !r.Retryer.IsErrorRetryable(err) {
+	if !r.RetryerV2.IsErrorRetryable(err) {
 		return false
 	}
 
 	var oe *smithy.OperationError
-	return errors.As(err, &oe) && oe.Err != nil && isErrorRetryable(oe.Err)
+	if errors.As(err, &oe) && oe.Err != nil {
+		err = oe.Err
+	}
+	return isErrorRetryable(err)
 }
 
 func isErrorRetryable(err error) bool {
@@ -47,3 +122,21 @@ func isErrorRetryable(err error) bool {
 	}
 	return true
 }
+
+// exponentialBackoff implements retry.BackoffDelayer, the delay before the nth retry is a random duration up to
+// base * 2^n, capped at max
+type exponentialBackoff struct {
+	base time.Duration
+	max  time.Duration
+}
+
+// BackoffDelay implements retry.BackoffDelayer
+func (b *exponentialBackoff) BackoffDelay(attempt int, _ error) (time.Duration, error) {
+	maxDelay := b.max
+	if attempt < 32 {
+		if delay := b.base << uint(attempt); delay > 0 && delay < b.max {
+			maxDelay = delay
+		}
+	}
+	return time.Duration(rand.Float64() * float64(maxDelay)), nil
+}
@@ -0,0 +1,99 @@
+package eks_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("AWS retryer", func() {
+	throttlingErr := &smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"}
+
+	BeforeEach(func() {
+		if val, ok := os.LookupEnv("EKSCTL_RETRY_BASE_BACKOFF"); ok {
+			Expect(os.Unsetenv("EKSCTL_RETRY_BASE_BACKOFF")).To(Succeed())
+			DeferCleanup(os.Setenv, "EKSCTL_RETRY_BASE_BACKOFF", val)
+		}
+	})
+
+	It("defaults to 13 attempts in the default mode", func() {
+		retryer, err := eks.NewRetryerFromConfig(api.AWSRetryConfig{}, aws.Config{}, aws.RetryModeAdaptive)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retryer.MaxAttempts()).To(Equal(13))
+		Expect(retryer.RetryerV2).To(BeAssignableToTypeOf(&retry.AdaptiveMode{}))
+	})
+
+	It("retries throttled calls", func() {
+		retryer, err := eks.NewRetryerFromConfig(api.AWSRetryConfig{}, aws.Config{}, aws.RetryModeStandard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retryer.IsErrorRetryable(throttlingErr)).To(BeTrue())
+		Expect(retryer.IsErrorRetryable(&smithy.OperationError{ServiceID: "EC2", OperationName: "DescribeVpcs", Err: throttlingErr})).To(BeTrue())
+		Expect(retryer.IsErrorRetryable(&smithy.GenericAPIError{Code: "ValidationError"})).To(BeFalse())
+	})
+
+	It("uses the mode and max attempts of the AWS config when they are not set", func() {
+		retryer, err := eks.NewRetryerFromConfig(api.AWSRetryConfig{}, aws.Config{
+			RetryMode:        aws.RetryModeAdaptive,
+			RetryMaxAttempts: 5,
+		}, aws.RetryModeStandard)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retryer.MaxAttempts()).To(Equal(5))
+		Expect(retryer.RetryerV2).To(BeAssignableToTypeOf(&retry.AdaptiveMode{}))
+	})
+
+	It("prefers the options that are set over the AWS config", func() {
+		retryer, err := eks.NewRetryerFromConfig(api.AWSRetryConfig{
+			Mode:        "standard",
+			MaxAttempts: 20,
+		}, aws.Config{
+			RetryMode:        aws.RetryModeAdaptive,
+			RetryMaxAttempts: 5,
+		}, aws.RetryModeAdaptive)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(retryer.MaxAttempts()).To(Equal(20))
+		Expect(retryer.RetryerV2).To(BeAssignableToTypeOf(&retry.Standard{}))
+	})
+
+	It("backs off exponentially from the base backoff up to 20s", func() {
+		retryer, err := eks.NewRetryerFromConfig(api.AWSRetryConfig{BaseBackoff: "100ms"}, aws.Config{}, aws.RetryModeStandard)
+		Expect(err).NotTo(HaveOccurred())
+		for attempt, maxDelay := range map[int]time.Duration{
+			1:  200 * time.Millisecond,
+			3:  800 * time.Millisecond,
+			10: 20 * time.Second,
+			40: 20 * time.Second,
+		} {
+			delay, err := retryer.RetryDelay(attempt, throttlingErr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(delay).To(BeNumerically("<=", maxDelay))
+			Expect(delay).To(BeNumerically(">=", 0))
+		}
+	})
+
+	It("reads the base backoff from EKSCTL_RETRY_BASE_BACKOFF", func() {
+		Expect(os.Setenv("EKSCTL_RETRY_BASE_BACKOFF", "10ms")).To(Succeed())
+		DeferCleanup(os.Unsetenv, "EKSCTL_RETRY_BASE_BACKOFF")
+
+		retryer, err := eks.NewRetryerFromConfig(api.AWSRetryConfig{}, aws.Config{}, aws.RetryModeStandard)
+		Expect(err).NotTo(HaveOccurred())
+		delay, err := retryer.RetryDelay(1, throttlingErr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delay).To(BeNumerically("<=", 20*time.Millisecond))
+	})
+
+	It("rejects an invalid EKSCTL_RETRY_BASE_BACKOFF", func() {
+		Expect(os.Setenv("EKSCTL_RETRY_BASE_BACKOFF", "soon")).To(Succeed())
+		DeferCleanup(os.Unsetenv, "EKSCTL_RETRY_BASE_BACKOFF")
+
+		_, err := eks.NewRetryerFromConfig(api.AWSRetryConfig{}, aws.Config{}, aws.RetryModeStandard)
+		Expect(err).To(MatchError(`invalid value "soon" for EKSCTL_RETRY_BASE_BACKOFF: must be a positive duration`))
+	})
+})
@@ -4,14 +4,18 @@ import (
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/outposts"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
@@ -23,6 +27,7 @@ import (
 // The SDK clients are initialized lazily and guarded by a mutex.
 type ServicesV2 struct {
 	config aws.Config
+	retry  retryOptions
 
 	// mu guards initialization of SDK clients.
 	// All service methods should ensure that their initialization is guarded by mu.
@@ -37,6 +42,11 @@ type ServicesV2 struct {
 	ec2                    *ec2.Client
 	eks                    *eks.Client
 	outposts               *outposts.Client
+	route53                *route53.Client
+	kms                    *kms.Client
+	servicequotas          *servicequotas.Client
+	pricing                *pricing.Client
+	s3                     *s3.Client
 }
 
 // STS implements the AWS STS service.
@@ -76,14 +86,8 @@ func (s *ServicesV2) CloudFormation() awsapi.CloudFormation {
 	if s.cloudformation == nil {
 		s.cloudformation = cloudformation.NewFromConfig(s.config, func(o *cloudformation.Options) {
 			// Use adaptive mode for retrying CloudFormation requests to mimic
-			// the logic used for AWS SDK v1.
-			o.Retryer = retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
-				o.StandardOptions = []func(*retry.StandardOptions){
-					func(so *retry.StandardOptions) {
-						so.MaxAttempts = maxRetries
-					},
-				}
-			})
+			// the logic used for AWS SDK v1, unless another mode is set.
+			o.Retryer = newRetryerV2(s.retry, aws.RetryModeAdaptive)
 		})
 	}
 	return s.cloudformation
@@ -158,3 +162,63 @@ func (s *ServicesV2) Outposts() awsapi.Outposts {
 	}
 	return s.outposts
 }
+
+// Route53 returns the AWS Route53 service.
+func (s *ServicesV2) Route53() awsapi.Route53 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.route53 == nil {
+		s.route53 = route53.NewFromConfig(s.config)
+	}
+	return s.route53
+}
+
+// KMS returns the AWS KMS service.
+func (s *ServicesV2) KMS() awsapi.KMS {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.kms == nil {
+		s.kms = kms.NewFromConfig(s.config)
+	}
+	return s.kms
+}
+
+// ServiceQuotas returns the AWS Service Quotas service.
+func (s *ServicesV2) ServiceQuotas() awsapi.ServiceQuotas {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.servicequotas == nil {
+		s.servicequotas = servicequotas.NewFromConfig(s.config)
+	}
+	return s.servicequotas
+}
+
+// Pricing returns the AWS Price List service, whose endpoints are only available in a few regions.
+func (s *ServicesV2) Pricing() awsapi.Pricing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pricing == nil {
+		s.pricing = pricing.NewFromConfig(s.config, func(o *pricing.Options) {
+			o.Region = pricingRegion(s.config.Region)
+		})
+	}
+	return s.pricing
+}
+
+// S3 returns the AWS S3 service.
+func (s *ServicesV2) S3() awsapi.S3 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.s3 == nil {
+		s.s3 = s3.NewFromConfig(s.config)
+	}
+	return s.s3
+}
+
+// pricingRegion returns the region of the Price List endpoint of the partition of region
+func pricingRegion(region string) string {
+	if api.Partition(region) == api.PartitionChina {
+		return api.RegionCNNorthwest1
+	}
+	return api.RegionUSEast1
+}
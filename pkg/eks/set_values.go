@@ -0,0 +1,245 @@
+package eks
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// applySetValues sets the values of the fields of document from values of the form path.to.field=value and
+// returns the result as JSON. Path elements are separated by dots, [N] selects the Nth item of a list and [name]
+// the item of a list with that name, e.g. managedNodeGroups[ng-1].desiredCapacity=3. Values are converted to the
+// type of the field of ClusterConfig they set, values of other fields are decoded as JSON when possible and kept as
+// strings otherwise
+func applySetValues(document []byte, values []string) ([]byte, error) {
+	var obj map[string]interface{}
+	if err := yaml.Unmarshal(document, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		obj = map[string]interface{}{}
+	}
+	for _, value := range values {
+		path, rawValue, ok := strings.Cut(value, "=")
+		if !ok || path == "" {
+			return nil, fmt.Errorf("invalid value %q, must be of the form path.to.field=value", value)
+		}
+		elements, err := parseSetPath(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q: %w", path, err)
+		}
+		parsedValue, err := parseSetValue(rawValue, setValueType(elements))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %w", path, err)
+		}
+		if err := setValue(obj, elements, parsedValue); err != nil {
+			return nil, fmt.Errorf("setting %q: %w", path, err)
+		}
+	}
+	return json.Marshal(obj)
+}
+
+// setPathElement is either the key of an object or the selector of a list item, by index or by name
+type setPathElement struct {
+	key      string
+	selector string
+	isList   bool
+}
+
+func parseSetPath(path string) ([]setPathElement, error) {
+	var elements []setPathElement
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		var selectors []string
+		if idx := strings.Index(part, "["); idx != -1 {
+			key = part[:idx]
+			rest := part[idx:]
+			for rest != "" {
+				end := strings.Index(rest, "]")
+				if rest[0] != '[' || end == -1 || end == 1 {
+					return nil, fmt.Errorf("malformed list selector in %q", part)
+				}
+				selectors = append(selectors, rest[1:end])
+				rest = rest[end+1:]
+			}
+		}
+		if key == "" {
+			return nil, fmt.Errorf("empty field name in %q", part)
+		}
+		elements = append(elements, setPathElement{key: key})
+		for _, selector := range selectors {
+			elements = append(elements, setPathElement{selector: selector, isList: true})
+		}
+	}
+	return elements, nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// parseSetValue converts rawValue to the type t of the field it sets, t is nil when the type of the field is unknown
+func parseSetValue(rawValue string, t reflect.Type) (interface{}, error) {
+	if rawValue == "null" {
+		return nil, nil
+	}
+	if t != nil && !reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		switch t.Kind() {
+		case reflect.String:
+			// a quoted value is unquoted, any other value is the string itself
+			var value string
+			if err := json.Unmarshal([]byte(rawValue), &value); err == nil {
+				return value, nil
+			}
+			return rawValue, nil
+		case reflect.Bool:
+			value, err := strconv.ParseBool(rawValue)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a boolean", rawValue)
+			}
+			return value, nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			value, err := strconv.ParseInt(rawValue, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an integer", rawValue)
+			}
+			return value, nil
+		case reflect.Float32, reflect.Float64:
+			value, err := strconv.ParseFloat(rawValue, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a number", rawValue)
+			}
+			return value, nil
+		}
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(rawValue), &value); err != nil {
+		return rawValue, nil
+	}
+	return value, nil
+}
+
+// setValueType returns the type of the field of ClusterConfig selected by elements, or nil when it is unknown
+func setValueType(elements []setPathElement) reflect.Type {
+	t := reflect.TypeOf(api.ClusterConfig{})
+	for i, element := range elements {
+		t = indirectType(t)
+		switch {
+		case element.isList:
+			if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+				return nil
+			}
+			t = t.Elem()
+		case i == 0 && element.key == nodeGroupDefaultsField:
+			// the nodegroup defaults are merged into the nodegroups, see applyNodeGroupDefaults
+			t = reflect.TypeOf(api.NodeGroup{})
+		case t.Kind() == reflect.Map:
+			t = t.Elem()
+		case t.Kind() == reflect.Struct:
+			field, ok := fieldTypeByJSONName(t, element.key)
+			if !ok {
+				return nil
+			}
+			t = field
+		default:
+			return nil
+		}
+	}
+	return indirectType(t)
+}
+
+func fieldTypeByJSONName(t reflect.Type, name string) (reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tagName, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tagName == "-" {
+			continue
+		}
+		if tagName == "" && field.Anonymous {
+			if embedded := indirectType(field.Type); embedded.Kind() == reflect.Struct {
+				if fieldType, ok := fieldTypeByJSONName(embedded, name); ok {
+					return fieldType, true
+				}
+			}
+			continue
+		}
+		if tagName == "" {
+			tagName = field.Name
+		}
+		if tagName == name {
+			return field.Type, true
+		}
+	}
+	return nil, false
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+func setValue(obj map[string]interface{}, elements []setPathElement, value interface{}) error {
+	var (
+		parent  interface{} = obj
+		current interface{}
+	)
+	for i, element := range elements {
+		last := i == len(elements)-1
+		if !element.isList {
+			m, ok := parent.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%q is not an object", element.key)
+			}
+			if last {
+				m[element.key] = value
+				return nil
+			}
+			current, ok = m[element.key]
+			if !ok || current == nil {
+				if elements[i+1].isList {
+					return fmt.Errorf("list %q not found", element.key)
+				}
+				current = map[string]interface{}{}
+				m[element.key] = current
+			}
+		} else {
+			list, ok := parent.([]interface{})
+			if !ok {
+				return fmt.Errorf("[%s] does not select a list item", element.selector)
+			}
+			idx, err := findListItem(list, element.selector)
+			if err != nil {
+				return err
+			}
+			if last {
+				list[idx] = value
+				return nil
+			}
+			current = list[idx]
+		}
+		parent = current
+	}
+	return nil
+}
+
+func findListItem(list []interface{}, selector string) (int, error) {
+	if idx, err := strconv.Atoi(selector); err == nil {
+		if idx < 0 || idx >= len(list) {
+			return 0, fmt.Errorf("index %d out of range, the list has %d item(s)", idx, len(list))
+		}
+		return idx, nil
+	}
+	for i, item := range list {
+		if name, ok := itemName(item); ok && name == selector {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no item named %q found", selector)
+}
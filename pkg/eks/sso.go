@@ -0,0 +1,206 @@
+package eks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/kris-nova/logger"
+	"golang.org/x/term"
+
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+// confirmSSOLogin asks whether to log in with command to start a new SSO session
+var confirmSSOLogin = func(command string) bool {
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stderr.Fd())) {
+		return false
+	}
+	fmt.Fprintf(os.Stderr, "Log in with `%s` now? [y/N] ", command)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// ssoProfile is the AWS IAM Identity Center (SSO) configuration of a profile, or of the profile its
+// credentials are sourced from
+type ssoProfile struct {
+	// profile is the name of the profile
+	profile string
+	// session is the name of the sso_session, it is empty for legacy profiles setting sso_start_url
+	session  string
+	startURL string
+}
+
+// loadSSOProfile returns the SSO configuration of profile, or nil if its credentials are not issued by SSO
+func loadSSOProfile(ctx context.Context, profile string) *ssoProfile {
+	if profile == "" {
+		if os.Getenv("AWS_ACCESS_KEY_ID") != "" {
+			// the credentials in the environment take precedence over the default profile
+			return nil
+		}
+		profile = "default"
+	}
+	sharedConfig, err := config.LoadSharedConfigProfile(ctx, profile, func(o *config.LoadSharedConfigOptions) {
+		// unlike config.LoadDefaultConfig, it does not read the paths of the files from the environment
+		if path := os.Getenv("AWS_CONFIG_FILE"); path != "" {
+			o.ConfigFiles = []string{path}
+		}
+		if path := os.Getenv("AWS_SHARED_CREDENTIALS_FILE"); path != "" {
+			o.CredentialsFiles = []string{path}
+		}
+	})
+	if err != nil {
+		return nil
+	}
+	for c := &sharedConfig; c != nil; c = c.Source {
+		if c.SSOSession != nil {
+			return &ssoProfile{
+				profile:  profile,
+				session:  c.SSOSessionName,
+				startURL: c.SSOSession.SSOStartURL,
+			}
+		}
+		if c.SSOStartURL != "" {
+			return &ssoProfile{
+				profile:  profile,
+				startURL: c.SSOStartURL,
+			}
+		}
+	}
+	return nil
+}
+
+// loginCommand returns the command starting a new SSO session
+func (p *ssoProfile) loginCommand() []string {
+	command := []string{"aws", "sso", "login"}
+	if p.profile != "default" {
+		command = append(command, "--profile", p.profile)
+	}
+	return command
+}
+
+// cachedSSOToken holds the fields of the access token of an SSO session, as cached by the AWS CLI
+type cachedSSOToken struct {
+	ExpiresAt    string `json:"expiresAt"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// tokenExpiration returns when the cached access token of the session expires, or the zero time if it
+// is refreshed when it expires. It returns an error wrapping os.ErrNotExist if no session was started
+func (p *ssoProfile) tokenExpiration() (time.Time, error) {
+	key := p.session
+	if key == "" {
+		key = p.startURL
+	}
+	path, err := ssocreds.StandardCachedTokenFilepath(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var token cachedSSOToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return time.Time{}, fmt.Errorf("parsing cached SSO token %s: %w", path, err)
+	}
+	if token.RefreshToken != "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, token.ExpiresAt)
+}
+
+// ensureSession checks that the SSO session has not expired, offering to start a new one when it has,
+// and warns when it expires within timeout, as operations still running then fail
+func (p *ssoProfile) ensureSession(timeout time.Duration) error {
+	expiration, err := p.tokenExpiration()
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return p.login()
+	case err != nil:
+		logger.Debug("unable to read the SSO session of profile %q: %v", p.profile, err)
+	case expiration.IsZero():
+	case expiration.Before(time.Now()):
+		return p.login()
+	case expiration.Before(time.Now().Add(timeout)):
+		logger.Warning("the SSO session of profile %q expires at %s, operations still running then will fail; run `%s` to start a new session beforehand",
+			p.profile, expiration.Local().Format(time.RFC1123), strings.Join(p.loginCommand(), " "))
+	}
+	return nil
+}
+
+// login starts a new SSO session with the AWS CLI, if the user agrees to it, or returns an error telling
+// how to start it
+func (p *ssoProfile) login() error {
+	command := p.loginCommand()
+	logger.Warning("the SSO session of profile %q has expired", p.profile)
+	if confirmSSOLogin(strings.Join(command, " ")) {
+		cmd := execCommand(command[0], command[1:]...)
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		err := cmd.Run()
+		if err == nil {
+			return nil
+		}
+		logger.Warning("logging in: %v", err)
+	}
+	return p.expiredError()
+}
+
+func (p *ssoProfile) expiredError() error {
+	return exitcode.Wrap(exitcode.Auth, fmt.Errorf("the SSO session of profile %q has expired, run `%s` to start a new session", p.profile, strings.Join(p.loginCommand(), " ")))
+}
+
+// isSSOTokenError returns true if err is caused by an SSO session that expired or was not started
+func isSSOTokenError(err error) bool {
+	var tokenErr *ssocreds.InvalidTokenError
+	return errors.As(err, &tokenErr)
+}
+
+// credentialsV2Provider provides the credentials of the AWS SDK v2 to the AWS SDK v1, whose session does not
+// support profiles with an sso_session nor the roles assumed by eksctl
+type credentialsV2Provider struct {
+	mu          sync.Mutex
+	credentials awsv2.CredentialsProvider
+	expires     time.Time
+}
+
+// Retrieve implements credentials.Provider
+func (p *credentialsV2Provider) Retrieve() (credentials.Value, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.credentials == nil {
+		return credentials.Value{}, errors.New("the credentials of the AWS SDK v2 are not loaded yet")
+	}
+	creds, err := p.credentials.Retrieve(context.TODO())
+	if err != nil {
+		return credentials.Value{}, err
+	}
+	p.expires = time.Time{}
+	if creds.CanExpire {
+		p.expires = creds.Expires
+	}
+	return credentials.Value{
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		ProviderName:    creds.Source,
+	}, nil
+}
+
+// IsExpired implements credentials.Provider
+func (p *credentialsV2Provider) IsExpired() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.credentials == nil || (!p.expires.IsZero() && !time.Now().Before(p.expires))
+}
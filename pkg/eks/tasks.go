@@ -6,11 +6,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
-
+	"github.com/weaveworks/eksctl/pkg/actions/hostedzones"
 	"github.com/weaveworks/eksctl/pkg/actions/iamidentitymapping"
 	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
+	"github.com/weaveworks/eksctl/pkg/actions/rbac"
 
 	"github.com/weaveworks/eksctl/pkg/windows"
 
@@ -24,7 +23,6 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/actions/irsa"
 	"github.com/weaveworks/eksctl/pkg/addons"
-	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/fargate"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	instanceutils "github.com/weaveworks/eksctl/pkg/utils/instance"
@@ -97,7 +95,7 @@ func (v *VPCControllerTask) Do(errCh chan error) error {
 		return err
 	}
 
-	stackCollection := manager.NewStackCollection(v.ClusterProvider.AWSProvider, v.ClusterConfig)
+	stackCollection := v.ClusterProvider.NewStackManager(v.ClusterConfig)
 
 	clientSet, err := v.ClusterProvider.NewStdClientSet(v.ClusterConfig)
 	if err != nil {
@@ -246,26 +244,59 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg
 	})
 
 	if cfg.HasClusterCloudWatchLogging() {
-		if logRetentionDays := cfg.CloudWatch.ClusterLogging.LogRetentionInDays; logRetentionDays != 0 {
+		if cfg.CloudWatch.ClusterLogging.LogRetentionInDays != 0 || cfg.CloudWatch.ClusterLogging.LogGroupKMSKeyID != "" {
 			newTasks.Append(&clusterConfigTask{
-				info: "update CloudWatch log retention",
+				info: "update CloudWatch log group",
 				spec: cfg,
 				call: func(clusterConfig *api.ClusterConfig) error {
-					_, err := c.AWSProvider.CloudWatchLogs().PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
-						// The format for log group name is documented here: https://docs.aws.amazon.com/eks/latest/userguide/control-plane-logs.html
-						LogGroupName:    aws.String(fmt.Sprintf("/aws/eks/%s/cluster", cfg.Metadata.Name)),
-						RetentionInDays: aws.Int32(int32(logRetentionDays)),
-					})
-					if err != nil {
-						return errors.Wrap(err, "error updating log retention settings")
-					}
-					logger.Info("set log retention to %d days for CloudWatch logging", logRetentionDays)
-					return nil
+					return c.UpdateClusterLogGroup(ctx, clusterConfig)
 				},
 			})
 		}
 	}
 
+	if cfg.IsAutoModeEnabled() {
+		newTasks.Append(&clusterConfigTask{
+			info: "enable Auto Mode",
+			spec: cfg,
+			call: func(clusterConfig *api.ClusterConfig) error {
+				if err := c.UpdateClusterConfigForAutoMode(ctx, clusterConfig); err != nil {
+					return errors.Wrap(err, "error enabling Auto Mode")
+				}
+				logger.Info("enabled Auto Mode for cluster %q", clusterConfig.Metadata.Name)
+				return nil
+			},
+		})
+	}
+
+	if cfg.ZonalShiftConfig != nil && api.IsEnabled(cfg.ZonalShiftConfig.Enabled) {
+		newTasks.Append(&clusterConfigTask{
+			info: "enable zonal shift",
+			spec: cfg,
+			call: func(clusterConfig *api.ClusterConfig) error {
+				if err := c.UpdateClusterZonalShiftConfig(ctx, clusterConfig); err != nil {
+					return errors.Wrap(err, "error enabling zonal shift")
+				}
+				logger.Info("registered cluster %q with ARC zonal shift", clusterConfig.Metadata.Name)
+				return nil
+			},
+		})
+	}
+
+	if cfg.UpgradePolicy != nil && cfg.UpgradePolicy.SupportType != "" {
+		newTasks.Append(&clusterConfigTask{
+			info: "set upgrade policy",
+			spec: cfg,
+			call: func(clusterConfig *api.ClusterConfig) error {
+				if err := c.UpdateClusterUpgradePolicy(ctx, clusterConfig); err != nil {
+					return errors.Wrap(err, "error setting upgrade policy")
+				}
+				logger.Info("set support type of cluster %q to %s", clusterConfig.Metadata.Name, clusterConfig.UpgradePolicy.SupportType)
+				return nil
+			},
+		})
+	}
+
 	if cfg.IsFargateEnabled() {
 		manager := fargate.NewFromProvider(cfg.Metadata.Name, c.AWSProvider, c.NewStackManager(cfg))
 		newTasks.Append(&fargateProfilesTask{
@@ -285,6 +316,11 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg
 		newTasks.Append(identityproviders.NewAssociateProvidersTask(ctx, *cfg.Metadata, cfg.IdentityProviders, c.AWSProvider.EKS()))
 	}
 
+	if cfg.VPC != nil && len(cfg.VPC.PrivateHostedZoneAssociations) > 0 {
+		manager := hostedzones.NewManager(c.AWSProvider.Route53(), c.AWSProvider.STS(), c.AWSProvider.Region(), c.AWSProvider.RoleSession())
+		newTasks.Append(hostedzones.NewAssociateTask(ctx, manager, cfg))
+	}
+
 	if len(cfg.IAMIdentityMappings) > 0 {
 		newTasks.Append(&tasks.GenericTask{
 			Description: "create IAM identity mappings",
@@ -313,6 +349,40 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(ctx context.Context, cfg
 		})
 	}
 
+	if cfg.HasRBAC() {
+		newTasks.Append(&tasks.GenericTask{
+			Description: "create RBAC cluster roles and bindings",
+			Doer: func() error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return errors.Wrap(err, "error creating Clientset")
+				}
+				if err := rbac.NewApplier(clientSet).Apply(ctx, cfg.RBAC); err != nil {
+					return err
+				}
+
+				mappings := rbac.IdentityMappings(cfg.RBAC)
+				if len(mappings) == 0 {
+					return nil
+				}
+				rawClient, err := c.NewRawClient(cfg)
+				if err != nil {
+					return errors.Wrap(err, "error creating rawClient")
+				}
+				m, err := iamidentitymapping.New(cfg, clientSet, rawClient, cfg.Metadata.Region)
+				if err != nil {
+					return errors.Wrap(err, "error initialising iamidentitymapping")
+				}
+				for _, mapping := range mappings {
+					if err := m.Create(ctx, mapping); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		})
+	}
+
 	if cfg.HasWindowsNodeGroup() {
 		newTasks.Append(&WindowsIPAMTask{
 			Info: "enable Windows IP address management",
@@ -24,6 +24,9 @@ import (
 type ClusterVPCConfig struct {
 	ClusterEndpoints  *api.ClusterEndpoints
 	PublicAccessCIDRs []string
+	VPCID             string
+	SubnetIDs         []string
+	SecurityGroupIDs  []string
 }
 
 // GetCurrentClusterConfigForLogging fetches current cluster logging configuration as two sets - enabled and disabled types
@@ -106,10 +109,74 @@ func (c *ClusterProvider) UpdateClusterConfigForLogging(ctx context.Context, cfg
 		cfg.Metadata.Name, cfg.Metadata.Region, describeEnabledTypes, describeDisabledTypes,
 	)
 
+	return c.UpdateClusterLogGroup(ctx, cfg)
+}
+
+// ClusterLogGroupName returns the name of the CloudWatch log group of the control plane logs of the cluster
+func ClusterLogGroupName(clusterName string) string {
+	// The format for log group name is documented here: https://docs.aws.amazon.com/eks/latest/userguide/control-plane-logs.html
+	return fmt.Sprintf("/aws/eks/%s/cluster", clusterName)
+}
+
+// ClusterLogGroup is the configuration of the CloudWatch log group of the control plane logs
+type ClusterLogGroup struct {
+	// RetentionInDays is 0 if the logs never expire
+	RetentionInDays int
+	KMSKeyID        string
+}
+
+// GetCurrentClusterLogGroup fetches the configuration of the log group of the control plane logs,
+// returning nil if the log group does not exist yet
+func (c *ClusterProvider) GetCurrentClusterLogGroup(ctx context.Context, clusterName string) (*ClusterLogGroup, error) {
+	logGroupName := ClusterLogGroupName(clusterName)
+	output, err := c.AWSProvider.CloudWatchLogs().DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(logGroupName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describing log group %q: %w", logGroupName, err)
+	}
+	for _, lg := range output.LogGroups {
+		if aws.ToString(lg.LogGroupName) == logGroupName {
+			return &ClusterLogGroup{
+				RetentionInDays: int(aws.ToInt32(lg.RetentionInDays)),
+				KMSKeyID:        aws.ToString(lg.KmsKeyId),
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateClusterLogGroup sets the retention and the KMS key of the log group of the control plane logs, creating the
+// log group with the KMS key if it does not exist yet
+func (c *ClusterProvider) UpdateClusterLogGroup(ctx context.Context, cfg *api.ClusterConfig) error {
+	logGroupName := ClusterLogGroupName(cfg.Metadata.Name)
+	if kmsKeyID := cfg.CloudWatch.ClusterLogging.LogGroupKMSKeyID; kmsKeyID != "" {
+		current, err := c.GetCurrentClusterLogGroup(ctx, cfg.Metadata.Name)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			if _, err := c.AWSProvider.CloudWatchLogs().CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+				LogGroupName: aws.String(logGroupName),
+				KmsKeyId:     aws.String(kmsKeyID),
+			}); err != nil {
+				return fmt.Errorf("error creating log group %q: %w", logGroupName, err)
+			}
+			logger.Success("created log group %q encrypted with KMS key %q", logGroupName, kmsKeyID)
+		} else if current.KMSKeyID != kmsKeyID {
+			if _, err := c.AWSProvider.CloudWatchLogs().AssociateKmsKey(ctx, &cloudwatchlogs.AssociateKmsKeyInput{
+				LogGroupName: aws.String(logGroupName),
+				KmsKeyId:     aws.String(kmsKeyID),
+			}); err != nil {
+				return fmt.Errorf("error associating KMS key with log group %q: %w", logGroupName, err)
+			}
+			logger.Success("configured KMS key %q for CloudWatch logging", kmsKeyID)
+		}
+	}
+
 	if logRetentionInDays := cfg.CloudWatch.ClusterLogging.LogRetentionInDays; logRetentionInDays > 0 {
 		if _, err := c.AWSProvider.CloudWatchLogs().PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
-			// The format for log group name is documented here: https://docs.aws.amazon.com/eks/latest/userguide/control-plane-logs.html
-			LogGroupName:    aws.String(fmt.Sprintf("/aws/eks/%s/cluster", cfg.Metadata.Name)),
+			LogGroupName:    aws.String(logGroupName),
 			RetentionInDays: aws.Int32(int32(logRetentionInDays)),
 		}); err != nil {
 			return fmt.Errorf("error updating log retention settings: %w", err)
@@ -133,6 +200,9 @@ func (c *ClusterProvider) GetCurrentClusterVPCConfig(ctx context.Context, spec *
 			PublicAccess:  &vpcConfig.EndpointPublicAccess,
 		},
 		PublicAccessCIDRs: vpcConfig.PublicAccessCidrs,
+		VPCID:             aws.ToString(vpcConfig.VpcId),
+		SubnetIDs:         vpcConfig.SubnetIds,
+		SecurityGroupIDs:  vpcConfig.SecurityGroupIds,
 	}, nil
 }
 
@@ -170,6 +240,141 @@ func (c *ClusterProvider) UpdatePublicAccessCIDRs(ctx context.Context, clusterCo
 	return c.waitForUpdateToSucceed(ctx, clusterConfig.Metadata.Name, output.Update)
 }
 
+// UpdateClusterVPCConfig calls eks.UpdateClusterConfig and updates the subnets and security groups of the control plane
+func (c *ClusterProvider) UpdateClusterVPCConfig(ctx context.Context, clusterConfig *api.ClusterConfig) error {
+	input := &eks.UpdateClusterConfigInput{
+		Name: &clusterConfig.Metadata.Name,
+		ResourcesVpcConfig: &ekstypes.VpcConfigRequest{
+			SubnetIds:        clusterConfig.VPC.ControlPlaneSubnetIDs,
+			SecurityGroupIds: clusterConfig.VPC.ControlPlaneSecurityGroupIDs,
+		},
+	}
+	output, err := c.AWSProvider.EKS().UpdateClusterConfig(ctx, input)
+	if err != nil {
+		return err
+	}
+	return c.waitForUpdateToSucceed(ctx, clusterConfig.Metadata.Name, output.Update)
+}
+
+// GetCurrentZonalShiftConfig reports whether the cluster is registered with ARC zonal shift
+func (c *ClusterProvider) GetCurrentZonalShiftConfig(ctx context.Context, spec *api.ClusterConfig) (bool, error) {
+	if ok, err := c.CanOperateWithRefresh(ctx, spec); !ok {
+		return false, errors.Wrap(err, "unable to retrieve current zonal shift configuration")
+	}
+
+	zonalShiftConfig := c.Status.ClusterInfo.Cluster.ZonalShiftConfig
+	return zonalShiftConfig != nil && aws.ToBool(zonalShiftConfig.Enabled), nil
+}
+
+// UpdateClusterZonalShiftConfig calls eks.UpdateClusterConfig and updates the zonal shift configuration
+func (c *ClusterProvider) UpdateClusterZonalShiftConfig(ctx context.Context, clusterConfig *api.ClusterConfig) error {
+	input := &eks.UpdateClusterConfigInput{
+		Name: &clusterConfig.Metadata.Name,
+		ZonalShiftConfig: &ekstypes.ZonalShiftConfigRequest{
+			Enabled: aws.Bool(api.IsEnabled(clusterConfig.ZonalShiftConfig.Enabled)),
+		},
+	}
+	output, err := c.AWSProvider.EKS().UpdateClusterConfig(ctx, input)
+	if err != nil {
+		return err
+	}
+	return c.waitForUpdateToSucceed(ctx, clusterConfig.Metadata.Name, output.Update)
+}
+
+// GetCurrentSupportType returns the support type of the upgrade policy of the cluster
+func (c *ClusterProvider) GetCurrentSupportType(ctx context.Context, spec *api.ClusterConfig) (api.SupportType, error) {
+	if ok, err := c.CanOperateWithRefresh(ctx, spec); !ok {
+		return "", errors.Wrap(err, "unable to retrieve current upgrade policy")
+	}
+
+	upgradePolicy := c.Status.ClusterInfo.Cluster.UpgradePolicy
+	if upgradePolicy == nil {
+		return "", nil
+	}
+	return api.SupportType(upgradePolicy.SupportType), nil
+}
+
+// UpdateClusterUpgradePolicy calls eks.UpdateClusterConfig and updates the support type of the upgrade policy
+func (c *ClusterProvider) UpdateClusterUpgradePolicy(ctx context.Context, clusterConfig *api.ClusterConfig) error {
+	input := &eks.UpdateClusterConfigInput{
+		Name: &clusterConfig.Metadata.Name,
+		UpgradePolicy: &ekstypes.UpgradePolicyRequest{
+			SupportType: ekstypes.SupportType(clusterConfig.UpgradePolicy.SupportType),
+		},
+	}
+	output, err := c.AWSProvider.EKS().UpdateClusterConfig(ctx, input)
+	if err != nil {
+		return err
+	}
+	return c.waitForUpdateToSucceed(ctx, clusterConfig.Metadata.Name, output.Update)
+}
+
+// IsAutoModeEnabled determines if EKS Auto Mode is enabled on the cluster
+func (c *ClusterProvider) IsAutoModeEnabled(ctx context.Context, spec *api.ClusterConfig) (bool, error) {
+	if ok, err := c.CanOperateWithRefresh(ctx, spec); !ok {
+		return false, errors.Wrap(err, "unable to retrieve current Auto Mode configuration")
+	}
+
+	computeConfig := c.Status.ClusterInfo.Cluster.ComputeConfig
+	return computeConfig != nil && aws.ToBool(computeConfig.Enabled), nil
+}
+
+// UpdateClusterConfigForAutoMode calls eks.UpdateClusterConfig and enables the compute, block storage and load balancing
+// capabilities of EKS Auto Mode. Auto Mode relies on access entries, so the authentication mode is switched to
+// API_AND_CONFIG_MAP first if the cluster only uses the aws-auth ConfigMap
+func (c *ClusterProvider) UpdateClusterConfigForAutoMode(ctx context.Context, clusterConfig *api.ClusterConfig) error {
+	clusterName := aws.String(clusterConfig.Metadata.Name)
+	clusterOutput, err := c.AWSProvider.EKS().DescribeCluster(ctx, &eks.DescribeClusterInput{
+		Name: clusterName,
+	})
+	if err != nil {
+		return errors.Wrap(err, "error describing cluster")
+	}
+
+	if accessConfig := clusterOutput.Cluster.AccessConfig; accessConfig == nil || accessConfig.AuthenticationMode == ekstypes.AuthenticationModeConfigMap {
+		logger.Info("updating authentication mode to %s", ekstypes.AuthenticationModeApiAndConfigMap)
+		output, err := c.AWSProvider.EKS().UpdateClusterConfig(ctx, &eks.UpdateClusterConfigInput{
+			Name: clusterName,
+			AccessConfig: &ekstypes.UpdateAccessConfigRequest{
+				AuthenticationMode: ekstypes.AuthenticationModeApiAndConfigMap,
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "error updating authentication mode")
+		}
+		if err := c.waitForUpdateToSucceed(ctx, clusterConfig.Metadata.Name, output.Update); err != nil {
+			return err
+		}
+	}
+
+	autoModeConfig := clusterConfig.AutoModeConfig
+	computeConfig := &ekstypes.ComputeConfigRequest{
+		Enabled: aws.Bool(true),
+	}
+	if autoModeConfig.HasAutoModeNodePools() {
+		computeConfig.NodePools = *autoModeConfig.NodePools
+		computeConfig.NodeRoleArn = aws.String(autoModeConfig.NodeRoleARN)
+	}
+	output, err := c.AWSProvider.EKS().UpdateClusterConfig(ctx, &eks.UpdateClusterConfigInput{
+		Name:          clusterName,
+		ComputeConfig: computeConfig,
+		StorageConfig: &ekstypes.StorageConfigRequest{
+			BlockStorage: &ekstypes.BlockStorage{
+				Enabled: aws.Bool(true),
+			},
+		},
+		KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+			ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{
+				Enabled: aws.Bool(true),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.waitForUpdateToSucceed(ctx, clusterConfig.Metadata.Name, output.Update)
+}
+
 // EnableKMSEncryption enables KMS encryption for the specified cluster
 func (c *ClusterProvider) EnableKMSEncryption(ctx context.Context, clusterConfig *api.ClusterConfig) error {
 	clusterName := aws.String(clusterConfig.Metadata.Name)
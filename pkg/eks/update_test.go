@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	. "github.com/onsi/ginkgo/v2"
@@ -180,4 +181,249 @@ var _ = Describe("EKS API wrapper", func() {
 			Expect(ctl.UpdateClusterConfigForLogging(context.Background(), cfg)).To(Succeed())
 		})
 	})
+	Describe("can update the CloudWatch log group of the control plane logs", func() {
+		const kmsKeyID = "arn:aws:kms:us-west-2:000000000000:key/12345678-1234-1234-1234-123456789012"
+		var (
+			ctl          *ClusterProvider
+			cfg          *api.ClusterConfig
+			p            *mockprovider.MockProvider
+			logGroupName string
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				AWSProvider: p,
+				Status:      &ProviderStatus{},
+			}
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "testcluster"
+			cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"audit"}
+			cfg.CloudWatch.ClusterLogging.LogGroupKMSKeyID = kmsKeyID
+			logGroupName = ClusterLogGroupName(cfg.Metadata.Name)
+		})
+
+		mockDescribeLogGroups := func(logGroups ...cwltypes.LogGroup) {
+			p.MockCloudWatchLogs().On("DescribeLogGroups", mock.Anything, &cloudwatchlogs.DescribeLogGroupsInput{
+				LogGroupNamePrefix: aws.String(logGroupName),
+			}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: logGroups}, nil)
+		}
+
+		It("should create the log group with the KMS key if it does not exist", func() {
+			mockDescribeLogGroups()
+			p.MockCloudWatchLogs().On("CreateLogGroup", mock.Anything, &cloudwatchlogs.CreateLogGroupInput{
+				LogGroupName: aws.String(logGroupName),
+				KmsKeyId:     aws.String(kmsKeyID),
+			}).Return(&cloudwatchlogs.CreateLogGroupOutput{}, nil)
+
+			Expect(ctl.UpdateClusterLogGroup(context.Background(), cfg)).To(Succeed())
+			p.MockCloudWatchLogs().AssertNotCalled(GinkgoT(), "AssociateKmsKey", mock.Anything, mock.Anything)
+		})
+
+		It("should associate the KMS key and set the retention of an existing log group", func() {
+			mockDescribeLogGroups(cwltypes.LogGroup{
+				LogGroupName:    aws.String(logGroupName),
+				RetentionInDays: aws.Int32(7),
+			})
+			p.MockCloudWatchLogs().On("AssociateKmsKey", mock.Anything, &cloudwatchlogs.AssociateKmsKeyInput{
+				LogGroupName: aws.String(logGroupName),
+				KmsKeyId:     aws.String(kmsKeyID),
+			}).Return(&cloudwatchlogs.AssociateKmsKeyOutput{}, nil)
+			p.MockCloudWatchLogs().On("PutRetentionPolicy", mock.Anything, &cloudwatchlogs.PutRetentionPolicyInput{
+				LogGroupName:    aws.String(logGroupName),
+				RetentionInDays: aws.Int32(30),
+			}).Return(&cloudwatchlogs.PutRetentionPolicyOutput{}, nil)
+			cfg.CloudWatch.ClusterLogging.LogRetentionInDays = 30
+
+			Expect(ctl.UpdateClusterLogGroup(context.Background(), cfg)).To(Succeed())
+			p.MockCloudWatchLogs().AssertNotCalled(GinkgoT(), "CreateLogGroup", mock.Anything, mock.Anything)
+		})
+
+		It("should not associate the KMS key again", func() {
+			mockDescribeLogGroups(cwltypes.LogGroup{
+				LogGroupName: aws.String(logGroupName),
+				KmsKeyId:     aws.String(kmsKeyID),
+			})
+
+			Expect(ctl.UpdateClusterLogGroup(context.Background(), cfg)).To(Succeed())
+			p.MockCloudWatchLogs().AssertNotCalled(GinkgoT(), "AssociateKmsKey", mock.Anything, mock.Anything)
+		})
+	})
+	Describe("can update the control plane VPC configuration", func() {
+		var (
+			ctl            *ClusterProvider
+			cfg            *api.ClusterConfig
+			p              *mockprovider.MockProvider
+			sentVPCRequest *ekstypes.VpcConfigRequest
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				AWSProvider: p,
+				Status:      &ProviderStatus{},
+			}
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "testcluster"
+
+			p.MockEKS().On("UpdateClusterConfig", mock.Anything, mock.MatchedBy(func(input *awseks.UpdateClusterConfigInput) bool {
+				sentVPCRequest = input.ResourcesVpcConfig
+				return true
+			})).Return(&awseks.UpdateClusterConfigOutput{
+				Update: &ekstypes.Update{
+					Id:   aws.String("u123"),
+					Type: ekstypes.UpdateTypeConfigUpdate,
+				},
+			}, nil)
+			p.MockEKS().On("DescribeUpdate", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.DescribeUpdateOutput{
+				Update: &ekstypes.Update{
+					Id:     aws.String("u123"),
+					Type:   ekstypes.UpdateTypeConfigUpdate,
+					Status: ekstypes.UpdateStatusSuccessful,
+				},
+			}, nil)
+		})
+
+		It("should only send the subnets when security groups are not set", func() {
+			cfg.VPC.ControlPlaneSubnetIDs = []string{"subnet-1", "subnet-2", "subnet-3"}
+			Expect(ctl.UpdateClusterVPCConfig(context.Background(), cfg)).To(Succeed())
+			Expect(sentVPCRequest.SubnetIds).To(Equal([]string{"subnet-1", "subnet-2", "subnet-3"}))
+			Expect(sentVPCRequest.SecurityGroupIds).To(BeNil())
+			Expect(sentVPCRequest.EndpointPublicAccess).To(BeNil())
+		})
+
+		It("should send the subnets and the security groups", func() {
+			cfg.VPC.ControlPlaneSubnetIDs = []string{"subnet-1", "subnet-2"}
+			cfg.VPC.ControlPlaneSecurityGroupIDs = []string{"sg-1"}
+			Expect(ctl.UpdateClusterVPCConfig(context.Background(), cfg)).To(Succeed())
+			Expect(sentVPCRequest.SubnetIds).To(Equal([]string{"subnet-1", "subnet-2"}))
+			Expect(sentVPCRequest.SecurityGroupIds).To(Equal([]string{"sg-1"}))
+		})
+	})
+
+	Describe("can update the zonal shift configuration", func() {
+		var (
+			ctl                   *ClusterProvider
+			cfg                   *api.ClusterConfig
+			p                     *mockprovider.MockProvider
+			sentZonalShiftRequest *ekstypes.ZonalShiftConfigRequest
+			sentVPCRequest        *ekstypes.VpcConfigRequest
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				AWSProvider: p,
+				Status:      &ProviderStatus{},
+			}
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "testcluster"
+
+			p.MockEKS().On("UpdateClusterConfig", mock.Anything, mock.MatchedBy(func(input *awseks.UpdateClusterConfigInput) bool {
+				sentZonalShiftRequest = input.ZonalShiftConfig
+				sentVPCRequest = input.ResourcesVpcConfig
+				return true
+			})).Return(&awseks.UpdateClusterConfigOutput{
+				Update: &ekstypes.Update{
+					Id:   aws.String("u123"),
+					Type: ekstypes.UpdateTypeZonalShiftConfigUpdate,
+				},
+			}, nil)
+			p.MockEKS().On("DescribeUpdate", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.DescribeUpdateOutput{
+				Update: &ekstypes.Update{
+					Id:     aws.String("u123"),
+					Type:   ekstypes.UpdateTypeZonalShiftConfigUpdate,
+					Status: ekstypes.UpdateStatusSuccessful,
+				},
+			}, nil)
+		})
+
+		It("should enable zonal shift", func() {
+			cfg.ZonalShiftConfig = &api.ZonalShiftConfig{Enabled: api.Enabled()}
+			Expect(ctl.UpdateClusterZonalShiftConfig(context.Background(), cfg)).To(Succeed())
+			Expect(*sentZonalShiftRequest.Enabled).To(BeTrue())
+			Expect(sentVPCRequest).To(BeNil())
+		})
+
+		It("should disable zonal shift", func() {
+			cfg.ZonalShiftConfig = &api.ZonalShiftConfig{Enabled: api.Disabled()}
+			Expect(ctl.UpdateClusterZonalShiftConfig(context.Background(), cfg)).To(Succeed())
+			Expect(*sentZonalShiftRequest.Enabled).To(BeFalse())
+		})
+	})
+
+	Describe("can enable Auto Mode", func() {
+		var (
+			ctl          *ClusterProvider
+			cfg          *api.ClusterConfig
+			p            *mockprovider.MockProvider
+			sentRequests []*awseks.UpdateClusterConfigInput
+		)
+
+		mockDescribeCluster := func(authenticationMode ekstypes.AuthenticationMode) {
+			p.MockEKS().On("DescribeCluster", mock.Anything, mock.Anything).Return(&awseks.DescribeClusterOutput{
+				Cluster: &ekstypes.Cluster{
+					AccessConfig: &ekstypes.AccessConfigResponse{
+						AuthenticationMode: authenticationMode,
+					},
+				},
+			}, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				AWSProvider: p,
+				Status:      &ProviderStatus{},
+			}
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "testcluster"
+			cfg.AutoModeConfig = &api.AutoModeConfig{
+				Enabled:     api.Enabled(),
+				NodeRoleARN: "arn:aws:iam::111122223333:role/auto-mode-nodes",
+				NodePools:   &[]string{api.AutoModeNodePoolGeneralPurpose, api.AutoModeNodePoolSystem},
+			}
+			sentRequests = nil
+
+			p.MockEKS().On("UpdateClusterConfig", mock.Anything, mock.MatchedBy(func(input *awseks.UpdateClusterConfigInput) bool {
+				sentRequests = append(sentRequests, input)
+				return true
+			})).Return(&awseks.UpdateClusterConfigOutput{
+				Update: &ekstypes.Update{
+					Id:   aws.String("u123"),
+					Type: ekstypes.UpdateTypeAutoModeUpdate,
+				},
+			}, nil)
+			p.MockEKS().On("DescribeUpdate", mock.Anything, mock.Anything, mock.Anything).Return(&awseks.DescribeUpdateOutput{
+				Update: &ekstypes.Update{
+					Id:     aws.String("u123"),
+					Type:   ekstypes.UpdateTypeAutoModeUpdate,
+					Status: ekstypes.UpdateStatusSuccessful,
+				},
+			}, nil)
+		})
+
+		It("should switch the authentication mode before enabling Auto Mode", func() {
+			mockDescribeCluster(ekstypes.AuthenticationModeConfigMap)
+			Expect(ctl.UpdateClusterConfigForAutoMode(context.Background(), cfg)).To(Succeed())
+			Expect(sentRequests).To(HaveLen(2))
+			Expect(sentRequests[0].AccessConfig.AuthenticationMode).To(Equal(ekstypes.AuthenticationModeApiAndConfigMap))
+			Expect(sentRequests[0].ComputeConfig).To(BeNil())
+
+			autoModeRequest := sentRequests[1]
+			Expect(*autoModeRequest.ComputeConfig.Enabled).To(BeTrue())
+			Expect(autoModeRequest.ComputeConfig.NodePools).To(Equal([]string{"general-purpose", "system"}))
+			Expect(*autoModeRequest.ComputeConfig.NodeRoleArn).To(Equal("arn:aws:iam::111122223333:role/auto-mode-nodes"))
+			Expect(*autoModeRequest.StorageConfig.BlockStorage.Enabled).To(BeTrue())
+			Expect(*autoModeRequest.KubernetesNetworkConfig.ElasticLoadBalancing.Enabled).To(BeTrue())
+		})
+
+		It("should keep the authentication mode when access entries are already enabled", func() {
+			mockDescribeCluster(ekstypes.AuthenticationModeApi)
+			Expect(ctl.UpdateClusterConfigForAutoMode(context.Background(), cfg)).To(Succeed())
+			Expect(sentRequests).To(HaveLen(1))
+			Expect(sentRequests[0].AccessConfig).To(BeNil())
+			Expect(*sentRequests[0].ComputeConfig.Enabled).To(BeTrue())
+		})
+	})
 })
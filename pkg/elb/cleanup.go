@@ -178,6 +178,32 @@ func Cleanup(ctx context.Context, ec2API awsapi.EC2, elbAPI DescribeLoadBalancer
 	return nil
 }
 
+// DescribeLoadBalancerObjects describes the Kubernetes Services and Ingresses whose load balancers are deleted by Cleanup
+func DescribeLoadBalancerObjects(kubernetesCS kubernetes.Interface, clusterConfig *api.ClusterConfig) ([]string, error) {
+	services, err := kubernetesCS.CoreV1().Services(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list Kubernetes Services: %w", err)
+	}
+	ingresses, err := listIngress(kubernetesCS, clusterConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list Kubernetes Ingresses: %w", err)
+	}
+
+	var objects []string
+	for _, s := range services.Items {
+		if s.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			objects = append(objects, fmt.Sprintf("Service %s/%s", s.Namespace, s.Name))
+		}
+	}
+	for _, i := range ingresses {
+		if i.GetIngressClass() == "alb" && len(i.GetLoadBalancersHosts()) > 0 {
+			metadata := i.GetMetadata()
+			objects = append(objects, fmt.Sprintf("Ingress %s/%s", metadata.Namespace, metadata.Name))
+		}
+	}
+	return objects, nil
+}
+
 func getServiceLoadBalancer(ctx context.Context, ec2API awsapi.EC2, elbAPI DescribeLoadBalancersAPI, elbv2API DescribeLoadBalancersAPIV2,
 	clusterName string, service *corev1.Service) (*loadBalancer, error) {
 	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
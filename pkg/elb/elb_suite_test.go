@@ -0,0 +1,11 @@
+package elb
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestELB(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
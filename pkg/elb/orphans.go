@@ -0,0 +1,366 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/smithy-go"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+const (
+	// vpcCNIClusterTagKey is set by the VPC CNI on the ENIs it creates
+	vpcCNIClusterTagKey = "cluster.k8s.amazonaws.com/name"
+	// security groups with any of these tags are owned by CloudFormation or EKS and are deleted along with them
+	cloudFormationStackTagKey = "aws:cloudformation:stack-name"
+	eksClusterTagKey          = "aws:eks:cluster-name"
+
+	// maxTagsDescribed is the maximum number of load balancers DescribeTags accepts
+	maxTagsDescribed = 20
+)
+
+var (
+	orphanedSecurityGroupRetryInterval = 5 * time.Second
+	orphanedSecurityGroupTimeout       = 5 * time.Minute
+)
+
+// OrphanedResources are the AWS resources created by controllers running in a cluster, such as the AWS cloud provider,
+// the AWS Load Balancer Controller and the VPC CNI, that were left behind in the cluster VPC and block its deletion
+type OrphanedResources struct {
+	ClassicLoadBalancers []elbtypes.LoadBalancerDescription
+	LoadBalancers        []elbv2types.LoadBalancer
+	NetworkInterfaces    []ec2types.NetworkInterface
+	SecurityGroups       []ec2types.SecurityGroup
+}
+
+// Len returns the number of orphaned resources
+func (o *OrphanedResources) Len() int {
+	return len(o.ClassicLoadBalancers) + len(o.LoadBalancers) + len(o.NetworkInterfaces) + len(o.SecurityGroups)
+}
+
+// Describe returns a line for each orphaned resource, in the order they are deleted
+func (o *OrphanedResources) Describe() []string {
+	var lines []string
+	for _, lb := range o.ClassicLoadBalancers {
+		lines = append(lines, fmt.Sprintf("classic load balancer %q", aws.ToString(lb.LoadBalancerName)))
+	}
+	for _, lb := range o.LoadBalancers {
+		lines = append(lines, fmt.Sprintf("%s load balancer %q", lb.Type, aws.ToString(lb.LoadBalancerName)))
+	}
+	for _, eni := range o.NetworkInterfaces {
+		lines = append(lines, fmt.Sprintf("network interface %q (%s)", aws.ToString(eni.NetworkInterfaceId), aws.ToString(eni.Description)))
+	}
+	for _, sg := range o.SecurityGroups {
+		lines = append(lines, fmt.Sprintf("security group %q (%s)", aws.ToString(sg.GroupId), aws.ToString(sg.GroupName)))
+	}
+	return lines
+}
+
+// FindOrphanedResources finds the load balancers, detached network interfaces and security groups in vpcID that were
+// created for clusterName by controllers running in the cluster
+func FindOrphanedResources(ctx context.Context, ec2API awsapi.EC2, elbAPI awsapi.ELB, elbv2API awsapi.ELBV2, clusterName, vpcID string) (*OrphanedResources, error) {
+	var (
+		orphans OrphanedResources
+		err     error
+	)
+	if orphans.ClassicLoadBalancers, err = findOrphanedClassicLoadBalancers(ctx, elbAPI, clusterName, vpcID); err != nil {
+		return nil, fmt.Errorf("finding classic load balancers: %w", err)
+	}
+	if orphans.LoadBalancers, err = findOrphanedLoadBalancers(ctx, elbv2API, clusterName, vpcID); err != nil {
+		return nil, fmt.Errorf("finding load balancers: %w", err)
+	}
+	if orphans.NetworkInterfaces, err = findOrphanedNetworkInterfaces(ctx, ec2API, clusterName, vpcID); err != nil {
+		return nil, fmt.Errorf("finding network interfaces: %w", err)
+	}
+	if orphans.SecurityGroups, err = findOrphanedSecurityGroups(ctx, ec2API, clusterName, vpcID); err != nil {
+		return nil, fmt.Errorf("finding security groups: %w", err)
+	}
+	return &orphans, nil
+}
+
+// DeleteOrphanedResources deletes the load balancers first, so that the security groups they use are released, then
+// the network interfaces and finally the security groups, removing any rule of other security groups referencing them
+func DeleteOrphanedResources(ctx context.Context, ec2API awsapi.EC2, elbAPI awsapi.ELB, elbv2API awsapi.ELBV2, orphans *OrphanedResources) error {
+	for _, lb := range orphans.ClassicLoadBalancers {
+		logger.Info("deleting classic load balancer %q", aws.ToString(lb.LoadBalancerName))
+		if _, err := elbAPI.DeleteLoadBalancer(ctx, &elasticloadbalancing.DeleteLoadBalancerInput{
+			LoadBalancerName: lb.LoadBalancerName,
+		}); err != nil {
+			return errors.Wrapf(err, "deleting classic load balancer %q", aws.ToString(lb.LoadBalancerName))
+		}
+	}
+	for _, lb := range orphans.LoadBalancers {
+		logger.Info("deleting %s load balancer %q", lb.Type, aws.ToString(lb.LoadBalancerName))
+		if _, err := elbv2API.DeleteLoadBalancer(ctx, &elasticloadbalancingv2.DeleteLoadBalancerInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+		}); err != nil {
+			return errors.Wrapf(err, "deleting load balancer %q", aws.ToString(lb.LoadBalancerName))
+		}
+	}
+
+	for _, eni := range orphans.NetworkInterfaces {
+		logger.Info("deleting network interface %q", aws.ToString(eni.NetworkInterfaceId))
+		if _, err := ec2API.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: eni.NetworkInterfaceId,
+		}); err != nil {
+			return errors.Wrapf(err, "deleting network interface %q", aws.ToString(eni.NetworkInterfaceId))
+		}
+	}
+
+	for _, sg := range orphans.SecurityGroups {
+		if err := revokeSecurityGroupReferences(ctx, ec2API, aws.ToString(sg.GroupId)); err != nil {
+			return err
+		}
+	}
+	for _, sg := range orphans.SecurityGroups {
+		logger.Info("deleting security group %q (%s)", aws.ToString(sg.GroupId), aws.ToString(sg.GroupName))
+		if err := deleteSecurityGroupWhenReleased(ctx, ec2API, sg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findOrphanedClassicLoadBalancers(ctx context.Context, elbAPI awsapi.ELB, clusterName, vpcID string) ([]elbtypes.LoadBalancerDescription, error) {
+	loadBalancers := map[string]elbtypes.LoadBalancerDescription{}
+	paginator := elasticloadbalancing.NewDescribeLoadBalancersPaginator(elbAPI, &elasticloadbalancing.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range output.LoadBalancerDescriptions {
+			if aws.ToString(lb.VPCId) == vpcID {
+				loadBalancers[aws.ToString(lb.LoadBalancerName)] = lb
+			}
+		}
+	}
+
+	var orphans []elbtypes.LoadBalancerDescription
+	for _, names := range chunk(keys(loadBalancers), maxTagsDescribed) {
+		output, err := elbAPI.DescribeTags(ctx, &elasticloadbalancing.DescribeTagsInput{
+			LoadBalancerNames: names,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, desc := range output.TagDescriptions {
+			tags := map[string]string{}
+			for _, t := range desc.Tags {
+				tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+			}
+			if isOwnedByCluster(tags, clusterName) {
+				orphans = append(orphans, loadBalancers[aws.ToString(desc.LoadBalancerName)])
+			}
+		}
+	}
+	return orphans, nil
+}
+
+func findOrphanedLoadBalancers(ctx context.Context, elbv2API awsapi.ELBV2, clusterName, vpcID string) ([]elbv2types.LoadBalancer, error) {
+	loadBalancers := map[string]elbv2types.LoadBalancer{}
+	paginator := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(elbv2API, &elasticloadbalancingv2.DescribeLoadBalancersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range output.LoadBalancers {
+			if aws.ToString(lb.VpcId) == vpcID {
+				loadBalancers[aws.ToString(lb.LoadBalancerArn)] = lb
+			}
+		}
+	}
+
+	var orphans []elbv2types.LoadBalancer
+	for _, arns := range chunk(keys(loadBalancers), maxTagsDescribed) {
+		output, err := elbv2API.DescribeTags(ctx, &elasticloadbalancingv2.DescribeTagsInput{
+			ResourceArns: arns,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, desc := range output.TagDescriptions {
+			tags := map[string]string{}
+			for _, t := range desc.Tags {
+				tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+			}
+			if isOwnedByCluster(tags, clusterName) {
+				orphans = append(orphans, loadBalancers[aws.ToString(desc.ResourceArn)])
+			}
+		}
+	}
+	return orphans, nil
+}
+
+func findOrphanedNetworkInterfaces(ctx context.Context, ec2API awsapi.EC2, clusterName, vpcID string) ([]ec2types.NetworkInterface, error) {
+	var orphans []ec2types.NetworkInterface
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(ec2API, &ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{vpcID},
+			},
+			{
+				Name:   aws.String("status"),
+				Values: []string{"available"},
+			},
+			{
+				Name:   aws.String("tag:" + vpcCNIClusterTagKey),
+				Values: []string{clusterName},
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		orphans = append(orphans, output.NetworkInterfaces...)
+	}
+	return orphans, nil
+}
+
+func findOrphanedSecurityGroups(ctx context.Context, ec2API awsapi.EC2, clusterName, vpcID string) ([]ec2types.SecurityGroup, error) {
+	var orphans []ec2types.SecurityGroup
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(ec2API, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{vpcID},
+			},
+			{
+				Name:   aws.String("tag-key"),
+				Values: []string{tagNameKubernetesClusterPrefix + clusterName, elbv2ClusterTagKey},
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, sg := range output.SecurityGroups {
+			tags := map[string]string{}
+			for _, t := range sg.Tags {
+				tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+			}
+			if _, ok := tags[cloudFormationStackTagKey]; ok {
+				continue
+			}
+			if _, ok := tags[eksClusterTagKey]; ok {
+				continue
+			}
+			if isOwnedByCluster(tags, clusterName) {
+				orphans = append(orphans, sg)
+			}
+		}
+	}
+	return orphans, nil
+}
+
+// isOwnedByCluster reports whether the tags were set by the AWS cloud provider or the AWS Load Balancer Controller
+// of clusterName on a resource they created
+func isOwnedByCluster(tags map[string]string, clusterName string) bool {
+	return tags[tagNameKubernetesClusterPrefix+clusterName] == "owned" || tags[elbv2ClusterTagKey] == clusterName
+}
+
+// revokeSecurityGroupReferences revokes the ingress rules of other security groups that allow traffic from groupID,
+// such as the rules the AWS Load Balancer Controller adds to the node security groups
+func revokeSecurityGroupReferences(ctx context.Context, ec2API awsapi.EC2, groupID string) error {
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(ec2API, &ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("ip-permission.group-id"),
+				Values: []string{groupID},
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "finding security groups referencing %q", groupID)
+		}
+		for _, sg := range output.SecurityGroups {
+			var permissions []ec2types.IpPermission
+			for _, p := range sg.IpPermissions {
+				for _, pair := range p.UserIdGroupPairs {
+					if aws.ToString(pair.GroupId) == groupID {
+						permissions = append(permissions, ec2types.IpPermission{
+							IpProtocol:       p.IpProtocol,
+							FromPort:         p.FromPort,
+							ToPort:           p.ToPort,
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{pair},
+						})
+					}
+				}
+			}
+			if len(permissions) == 0 {
+				continue
+			}
+			logger.Debug("revoking %d ingress rule(s) of security group %q referencing %q", len(permissions), aws.ToString(sg.GroupId), groupID)
+			if _, err := ec2API.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: permissions,
+			}); err != nil {
+				return errors.Wrapf(err, "revoking ingress rules of security group %q referencing %q", aws.ToString(sg.GroupId), groupID)
+			}
+		}
+	}
+	return nil
+}
+
+// deleteSecurityGroupWhenReleased retries deleting the security group while it is still in use by the network
+// interfaces of a load balancer being deleted
+func deleteSecurityGroupWhenReleased(ctx context.Context, ec2API awsapi.EC2, sg ec2types.SecurityGroup) error {
+	ctx, cancel := context.WithTimeout(ctx, orphanedSecurityGroupTimeout)
+	defer cancel()
+
+	for {
+		err := deleteSecurityGroup(ctx, ec2API, sg)
+		var ae smithy.APIError
+		if err == nil || !errors.As(err, &ae) || ae.ErrorCode() != "DependencyViolation" {
+			return errors.Wrapf(err, "deleting security group %q", aws.ToString(sg.GroupId))
+		}
+		logger.Debug("security group %q is still in use, retrying in %v", aws.ToString(sg.GroupId), orphanedSecurityGroupRetryInterval)
+
+		timer := time.NewTimer(orphanedSecurityGroupRetryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrapf(err, "timed out waiting for security group %q to be released", aws.ToString(sg.GroupId))
+		case <-timer.C:
+		}
+	}
+}
+
+func keys[V any](m map[string]V) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	return ret
+}
+
+func chunk(values []string, size int) [][]string {
+	var chunks [][]string
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
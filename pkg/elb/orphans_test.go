@@ -0,0 +1,180 @@
+package elb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Orphaned resources", func() {
+	const (
+		clusterName = "test-cluster"
+		vpcID       = "vpc-1"
+	)
+
+	var p *mockprovider.MockProvider
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		p.MockELB().On("DescribeLoadBalancers", mock.Anything, mock.Anything, mock.Anything).Return(&elasticloadbalancing.DescribeLoadBalancersOutput{
+			LoadBalancerDescriptions: []elbtypes.LoadBalancerDescription{
+				{LoadBalancerName: aws.String("service-elb"), VPCId: aws.String(vpcID)},
+				{LoadBalancerName: aws.String("other-cluster-elb"), VPCId: aws.String(vpcID)},
+				{LoadBalancerName: aws.String("other-vpc-elb"), VPCId: aws.String("vpc-2")},
+			},
+		}, nil)
+		p.MockELB().On("DescribeTags", mock.Anything, mock.MatchedBy(func(input *elasticloadbalancing.DescribeTagsInput) bool {
+			// load balancers in other VPCs are not described
+			return len(input.LoadBalancerNames) == 2
+		})).Return(&elasticloadbalancing.DescribeTagsOutput{
+			TagDescriptions: []elbtypes.TagDescription{
+				{
+					LoadBalancerName: aws.String("service-elb"),
+					Tags:             []elbtypes.Tag{{Key: aws.String("kubernetes.io/cluster/" + clusterName), Value: aws.String("owned")}},
+				},
+				{
+					LoadBalancerName: aws.String("other-cluster-elb"),
+					Tags:             []elbtypes.Tag{{Key: aws.String("kubernetes.io/cluster/other"), Value: aws.String("owned")}},
+				},
+			},
+		}, nil)
+
+		p.MockELBV2().On("DescribeLoadBalancers", mock.Anything, mock.Anything, mock.Anything).Return(&elasticloadbalancingv2.DescribeLoadBalancersOutput{
+			LoadBalancers: []elbv2types.LoadBalancer{
+				{LoadBalancerName: aws.String("k8s-nlb"), LoadBalancerArn: aws.String("arn:nlb"), Type: elbv2types.LoadBalancerTypeEnumNetwork, VpcId: aws.String(vpcID)},
+				{LoadBalancerName: aws.String("user-alb"), LoadBalancerArn: aws.String("arn:alb"), Type: elbv2types.LoadBalancerTypeEnumApplication, VpcId: aws.String(vpcID)},
+			},
+		}, nil)
+		p.MockELBV2().On("DescribeTags", mock.Anything, mock.Anything).Return(&elasticloadbalancingv2.DescribeTagsOutput{
+			TagDescriptions: []elbv2types.TagDescription{
+				{
+					ResourceArn: aws.String("arn:nlb"),
+					Tags:        []elbv2types.Tag{{Key: aws.String("elbv2.k8s.aws/cluster"), Value: aws.String(clusterName)}},
+				},
+				{
+					ResourceArn: aws.String("arn:alb"),
+				},
+			},
+		}, nil)
+
+		p.MockEC2().On("DescribeNetworkInterfaces", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeNetworkInterfacesInput) bool {
+			return len(input.Filters) == 3
+		}), mock.Anything).Return(&ec2.DescribeNetworkInterfacesOutput{
+			NetworkInterfaces: []ec2types.NetworkInterface{
+				{NetworkInterfaceId: aws.String("eni-1"), Description: aws.String("aws-K8S-i-1")},
+			},
+		}, nil)
+
+		p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeSecurityGroupsInput) bool {
+			return aws.ToString(input.Filters[0].Name) == "vpc-id"
+		}), mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []ec2types.SecurityGroup{
+				{
+					GroupId:   aws.String("sg-lbc"),
+					GroupName: aws.String("k8s-traffic-test-cluster"),
+					Tags:      []ec2types.Tag{{Key: aws.String("elbv2.k8s.aws/cluster"), Value: aws.String(clusterName)}},
+				},
+				{
+					GroupId:   aws.String("sg-eksctl"),
+					GroupName: aws.String("eksctl-test-cluster-cluster-ClusterSharedNodeSecurityGroup"),
+					Tags: []ec2types.Tag{
+						{Key: aws.String("kubernetes.io/cluster/" + clusterName), Value: aws.String("owned")},
+						{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("eksctl-test-cluster-cluster")},
+					},
+				},
+				{
+					GroupId:   aws.String("sg-eks"),
+					GroupName: aws.String("eks-cluster-sg-test-cluster"),
+					Tags: []ec2types.Tag{
+						{Key: aws.String("kubernetes.io/cluster/" + clusterName), Value: aws.String("owned")},
+						{Key: aws.String("aws:eks:cluster-name"), Value: aws.String(clusterName)},
+					},
+				},
+			},
+		}, nil)
+	})
+
+	It("finds the resources created by controllers of the cluster in its VPC", func() {
+		orphans, err := FindOrphanedResources(context.Background(), p.EC2(), p.ELB(), p.ELBV2(), clusterName, vpcID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orphans.Len()).To(Equal(4))
+		Expect(orphans.Describe()).To(Equal([]string{
+			`classic load balancer "service-elb"`,
+			`network load balancer "k8s-nlb"`,
+			`network interface "eni-1" (aws-K8S-i-1)`,
+			`security group "sg-lbc" (k8s-traffic-test-cluster)`,
+		}))
+	})
+
+	It("deletes the load balancers, network interfaces and security groups, revoking rules referencing the security groups", func() {
+		orphans, err := FindOrphanedResources(context.Background(), p.EC2(), p.ELB(), p.ELBV2(), clusterName, vpcID)
+		Expect(err).NotTo(HaveOccurred())
+
+		p.MockELB().On("DeleteLoadBalancer", mock.Anything, &elasticloadbalancing.DeleteLoadBalancerInput{
+			LoadBalancerName: aws.String("service-elb"),
+		}).Return(&elasticloadbalancing.DeleteLoadBalancerOutput{}, nil).Once()
+		p.MockELBV2().On("DeleteLoadBalancer", mock.Anything, &elasticloadbalancingv2.DeleteLoadBalancerInput{
+			LoadBalancerArn: aws.String("arn:nlb"),
+		}).Return(&elasticloadbalancingv2.DeleteLoadBalancerOutput{}, nil).Once()
+		p.MockEC2().On("DeleteNetworkInterface", mock.Anything, &ec2.DeleteNetworkInterfaceInput{
+			NetworkInterfaceId: aws.String("eni-1"),
+		}).Return(&ec2.DeleteNetworkInterfaceOutput{}, nil).Once()
+		p.MockEC2().On("DescribeSecurityGroups", mock.Anything, mock.MatchedBy(func(input *ec2.DescribeSecurityGroupsInput) bool {
+			return aws.ToString(input.Filters[0].Name) == "ip-permission.group-id"
+		}), mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []ec2types.SecurityGroup{
+				{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []ec2types.IpPermission{
+						{
+							IpProtocol:       aws.String("tcp"),
+							FromPort:         aws.Int32(80),
+							ToPort:           aws.Int32(80),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{{GroupId: aws.String("sg-lbc")}, {GroupId: aws.String("sg-other")}},
+						},
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("10.0.0.0/16")}},
+						},
+					},
+				},
+			},
+		}, nil)
+		p.MockEC2().On("RevokeSecurityGroupIngress", mock.Anything, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId: aws.String("sg-node"),
+			IpPermissions: []ec2types.IpPermission{
+				{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int32(80),
+					ToPort:           aws.Int32(80),
+					UserIdGroupPairs: []ec2types.UserIdGroupPair{{GroupId: aws.String("sg-lbc")}},
+				},
+			},
+		}).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil).Once()
+		p.MockEC2().On("DeleteSecurityGroup", mock.Anything, &ec2.DeleteSecurityGroupInput{
+			GroupId: aws.String("sg-lbc"),
+		}).Return(&ec2.DeleteSecurityGroupOutput{}, nil).Once()
+
+		Expect(DeleteOrphanedResources(context.Background(), p.EC2(), p.ELB(), p.ELBV2(), orphans)).To(Succeed())
+		p.MockELB().AssertExpectations(GinkgoT())
+		p.MockELBV2().AssertExpectations(GinkgoT())
+		p.MockEC2().AssertExpectations(GinkgoT())
+	})
+
+	It("chunks values", func() {
+		Expect(chunk([]string{"a", "b", "c"}, 2)).To(Equal([][]string{{"a", "b"}, {"c"}}))
+		Expect(chunk(nil, 2)).To(BeEmpty())
+	})
+})
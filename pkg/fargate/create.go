@@ -57,7 +57,7 @@ func (c *Client) CreateProfile(ctx context.Context, profile *api.FargateProfile,
 		return errors.New("invalid Fargate profile: nil")
 	}
 	logger.Debug("Fargate profile: create request input: %#v", profile)
-	out, err := c.api.CreateFargateProfile(ctx, createRequest(c.clusterName, profile))
+	out, err := c.api.CreateFargateProfile(ctx, createRequest(c.clusterName, profile, c.owner()))
 	logger.Debug("Fargate profile: create request: received: %#v", out)
 	if err != nil {
 		var ipe *ekstypes.InvalidParameterException
@@ -72,14 +72,22 @@ func (c *Client) CreateProfile(ctx context.Context, profile *api.FargateProfile,
 	return nil
 }
 
-func createRequest(clusterName string, profile *api.FargateProfile) *eks.CreateFargateProfileInput {
+// owner returns the ARN of the AWS identity creating the profiles, it is empty when unknown
+func (c *Client) owner() string {
+	if c.stackManager == nil {
+		return ""
+	}
+	return c.stackManager.Owner()
+}
+
+func createRequest(clusterName string, profile *api.FargateProfile, owner string) *eks.CreateFargateProfileInput {
 	request := &eks.CreateFargateProfileInput{
 		ClusterName:         &clusterName,
 		FargateProfileName:  &profile.Name,
 		Selectors:           toSelectorPointers(profile.Selectors),
 		PodExecutionRoleArn: strings.NilIfEmpty(profile.PodExecutionRoleARN),
 		Subnets:             profile.Subnets,
-		Tags:                profile.Tags,
+		Tags:                api.WithOwnershipTags(profile.Tags, owner),
 	}
 	logger.Debug("Fargate profile: create request: sending: %#v", request)
 	return request
@@ -31,7 +31,7 @@ var retryPolicy = retry.NewTimingOutExponentialBackoff(5 * time.Minute)
 
 var _ = Describe("fargate", func() {
 	Describe("Client", func() {
-		var neverCalledStackManager *manager.StackCollection
+		var neverCalledStackManager manager.StackManager
 		Describe("CreateProfile", func() {
 			It("fails fast if the provided profile is nil", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, &mocksv2.EKS{}, &retryPolicy, neverCalledStackManager)
@@ -55,6 +55,20 @@ var _ = Describe("fargate", func() {
 				Expect(err).To(Not(HaveOccurred()))
 			})
 
+			It("tags the profile with the identity creating it as its owner", func() {
+				mockClient := mocksv2.EKS{}
+				expectedInput := testCreateFargateProfileInput()
+				expectedInput.Tags[api.OwnerTag] = "arn:aws:iam::123456789012:user/alice"
+				mockClient.Mock.On("CreateFargateProfile", mock.Anything, expectedInput).
+					Return(&eks.CreateFargateProfileOutput{}, nil)
+				stackManager := &fakes.FakeStackManager{}
+				stackManager.OwnerReturns("arn:aws:iam::123456789012:user/alice")
+
+				client := fargate.NewWithRetryPolicy(clusterName, &mockClient, &retryPolicy, stackManager)
+				err := client.CreateProfile(context.Background(), testFargateProfile(), false)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("fails by wrapping the root error with some additional context for clarity", func() {
 				client := fargate.NewWithRetryPolicy(clusterName, mockForFailureOnCreateFargateProfile(), &retryPolicy, neverCalledStackManager)
 				waitForCreation := false
@@ -344,7 +358,8 @@ func testCreateFargateProfileInput() *eks.CreateFargateProfileInput {
 			},
 		},
 		Tags: map[string]string{
-			"env": "test",
+			"env":            "test",
+			api.CreatedByTag: api.CreatedByTagValue,
 		},
 	}
 }
@@ -362,6 +377,9 @@ func createEksProfileWithoutTag() *eks.CreateFargateProfileInput {
 				},
 			},
 		},
+		Tags: map[string]string{
+			api.CreatedByTag: api.CreatedByTagValue,
+		},
 	}
 }
 
@@ -41,7 +41,7 @@ var _ = Describe("fargate", func() {
 			out := bytes.NewBufferString("")
 			err := fargate.PrintProfiles(profiles, out, "foo")
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("unknown output printer type: expected {\"yaml\",\"json\",\"table\"} but got \"foo\""))
+			Expect(err.Error()).To(Equal("unknown output printer type: expected {\"yaml\",\"json\",\"table\",\"jsonpath=...\",\"go-template=...\"} but got \"foo\""))
 		})
 	})
 })
@@ -0,0 +1,138 @@
+// Package history keeps the ClusterConfig applied by each eksctl command run on a cluster, with the CloudFormation
+// stacks of the cluster after it ran, in S3 or SSM Parameter Store, to review what was applied when and by whom
+package history
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/kris-nova/logger"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// StoreEnvVar is the environment variable setting the store the commands record their history to, e.g.
+// s3://my-bucket/eksctl or ssm:/eksctl/history
+const StoreEnvVar = "EKSCTL_HISTORY_STORE"
+
+// Record is the ClusterConfig applied to a cluster by a command
+type Record struct {
+	// Version identifies the record in its store, it is set when the records are listed
+	Version string `json:"version,omitempty"`
+	Cluster string `json:"cluster"`
+	Region  string `json:"region"`
+	// Command is the eksctl command that applied ClusterConfig, e.g. eksctl create nodegroup
+	Command string `json:"command"`
+	// User is the ARN of the AWS identity that ran the command
+	User          string             `json:"user"`
+	Time          time.Time          `json:"time"`
+	ClusterConfig *api.ClusterConfig `json:"clusterConfig"`
+	// Stacks are the CloudFormation stacks of the cluster once the command succeeded
+	Stacks []Stack `json:"stacks"`
+}
+
+// Stack is a CloudFormation stack created by eksctl
+type Stack struct {
+	Name   string `json:"name"`
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// Store keeps the records of the clusters
+type Store interface {
+	// Put adds a record to the history of its cluster
+	Put(ctx context.Context, record *Record) error
+	// List returns the history of a cluster, the most recent record first
+	List(ctx context.Context, clusterName string) ([]*Record, error)
+}
+
+// NewStore returns the store at location, either s3://<bucket>/<prefix> or ssm:/<path>
+func NewStore(location string, provider api.ClusterProvider) (Store, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, invalidLocationError(location)
+	}
+	switch u.Scheme {
+	case "s3":
+		if u.Host == "" {
+			return nil, invalidLocationError(location)
+		}
+		return NewS3Store(provider.S3(), u.Host, strings.Trim(u.Path, "/")), nil
+	case "ssm":
+		path := strings.TrimRight(u.Path, "/")
+		if path == "" || u.Host != "" {
+			return nil, invalidLocationError(location)
+		}
+		return NewSSMStore(provider.SSM(), path), nil
+	default:
+		return nil, invalidLocationError(location)
+	}
+}
+
+func invalidLocationError(location string) error {
+	return fmt.Errorf("invalid history store %q, expected s3://<bucket>/<prefix> or ssm:/<path>", location)
+}
+
+// NewRecord returns the record of command applying cfg to its cluster, with the stacks of the cluster, including
+// its auxiliary stacks, e.g. the EFS stack
+func NewRecord(ctx context.Context, stackManager manager.StackManager, cfg *api.ClusterConfig, command, user string) (*Record, error) {
+	stacks, err := stackManager.ListStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	auxiliaryStacks, err := stackManager.ListAuxiliaryStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stacks = append(stacks, auxiliaryStacks...)
+	record := &Record{
+		Cluster:       cfg.Metadata.Name,
+		Region:        cfg.Metadata.Region,
+		Command:       command,
+		User:          user,
+		Time:          time.Now().UTC(),
+		ClusterConfig: cfg,
+		Stacks:        []Stack{},
+	}
+	for _, s := range stacks {
+		if s.StackStatus == cfntypes.StackStatusDeleteComplete {
+			continue
+		}
+		record.Stacks = append(record.Stacks, Stack{
+			Name:   aws.ToString(s.StackName),
+			ID:     aws.ToString(s.StackId),
+			Status: string(s.StackStatus),
+		})
+	}
+	return record, nil
+}
+
+// RecordCommand adds the ClusterConfig applied by command, which succeeded, to the store set by StoreEnvVar, if any.
+// Failing to record it is only logged, as the changes to the cluster were made
+func RecordCommand(ctx context.Context, provider api.ClusterProvider, stackManager manager.StackManager, cfg *api.ClusterConfig, command, user string) {
+	location := os.Getenv(StoreEnvVar)
+	if location == "" {
+		return
+	}
+	store, err := NewStore(location, provider)
+	if err != nil {
+		logger.Warning("not recording the history of cluster %q: %v", cfg.Metadata.Name, err)
+		return
+	}
+	record, err := NewRecord(ctx, stackManager, cfg, command, user)
+	if err == nil {
+		err = store.Put(ctx, record)
+	}
+	if err != nil {
+		logger.Warning("failed to record the history of cluster %q in %s: %v", cfg.Metadata.Name, location, err)
+		return
+	}
+	logger.Info("recorded the ClusterConfig applied to cluster %q in %s", cfg.Metadata.Name, location)
+}
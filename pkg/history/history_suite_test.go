@@ -0,0 +1,11 @@
+package history_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestHistory(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
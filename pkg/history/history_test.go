@@ -0,0 +1,199 @@
+package history_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfntypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/history"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("History", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "dev"
+		cfg.Metadata.Region = "us-west-2"
+	})
+
+	newRecord := func(t time.Time) *history.Record {
+		return &history.Record{
+			Cluster:       "dev",
+			Region:        "us-west-2",
+			Command:       "eksctl create nodegroup",
+			User:          "arn:aws:iam::123456789012:user/alice",
+			Time:          t,
+			ClusterConfig: cfg,
+			Stacks:        []history.Stack{{Name: "eksctl-dev-cluster", ID: "id", Status: "CREATE_COMPLETE"}},
+		}
+	}
+
+	DescribeTable("NewStore rejects invalid locations", func(location string) {
+		_, err := history.NewStore(location, p)
+		Expect(err).To(MatchError(ContainSubstring("invalid history store")))
+	},
+		Entry("no scheme", "my-bucket"),
+		Entry("unknown scheme", "gs://my-bucket/eksctl"),
+		Entry("S3 without bucket", "s3:///eksctl"),
+		Entry("SSM without path", "ssm:"),
+		Entry("SSM with host", "ssm://eksctl/history"),
+	)
+
+	DescribeTable("NewStore accepts valid locations", func(location string, expected interface{}) {
+		store, err := history.NewStore(location, p)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store).To(BeAssignableToTypeOf(expected))
+	},
+		Entry("S3", "s3://my-bucket/eksctl", &history.S3Store{}),
+		Entry("S3 without prefix", "s3://my-bucket", &history.S3Store{}),
+		Entry("SSM", "ssm:/eksctl/history", &history.SSMStore{}),
+	)
+
+	Describe("NewRecord", func() {
+		It("records the stacks of the cluster that are not deleted", func() {
+			stackManager := &fakes.FakeStackManager{}
+			stackManager.ListStacksReturns([]*cfntypes.Stack{
+				{StackName: aws.String("eksctl-dev-cluster"), StackId: aws.String("id-1"), StackStatus: cfntypes.StackStatusCreateComplete},
+				{StackName: aws.String("eksctl-dev-nodegroup-old"), StackId: aws.String("id-2"), StackStatus: cfntypes.StackStatusDeleteComplete},
+				{StackName: aws.String("eksctl-dev-nodegroup-ng"), StackId: aws.String("id-3"), StackStatus: cfntypes.StackStatusUpdateComplete},
+			}, nil)
+			stackManager.ListAuxiliaryStacksReturns([]*cfntypes.Stack{
+				{StackName: aws.String("eksctl-dev-efs"), StackId: aws.String("id-4"), StackStatus: cfntypes.StackStatusCreateComplete},
+			}, nil)
+
+			record, err := history.NewRecord(context.Background(), stackManager, cfg, "eksctl create nodegroup", "arn:aws:iam::123456789012:user/alice")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(record.Cluster).To(Equal("dev"))
+			Expect(record.Region).To(Equal("us-west-2"))
+			Expect(record.Command).To(Equal("eksctl create nodegroup"))
+			Expect(record.User).To(Equal("arn:aws:iam::123456789012:user/alice"))
+			Expect(record.ClusterConfig).To(Equal(cfg))
+			Expect(record.Stacks).To(Equal([]history.Stack{
+				{Name: "eksctl-dev-cluster", ID: "id-1", Status: "CREATE_COMPLETE"},
+				{Name: "eksctl-dev-nodegroup-ng", ID: "id-3", Status: "UPDATE_COMPLETE"},
+				{Name: "eksctl-dev-efs", ID: "id-4", Status: "CREATE_COMPLETE"},
+			}))
+		})
+
+		It("returns an error when the stacks cannot be listed", func() {
+			stackManager := &fakes.FakeStackManager{}
+			stackManager.ListStacksReturns(nil, errors.New("throttled"))
+			_, err := history.NewRecord(context.Background(), stackManager, cfg, "eksctl create cluster", "")
+			Expect(err).To(MatchError("throttled"))
+		})
+	})
+
+	Describe("S3Store", func() {
+		It("puts each record in its own object named after its time", func() {
+			record := newRecord(time.Date(2022, 5, 3, 10, 4, 5, 0, time.UTC))
+			p.MockS3().On("PutObject", mock.Anything, mock.MatchedBy(func(input *s3.PutObjectInput) bool {
+				return *input.Bucket == "my-bucket" && *input.Key == "eksctl/dev/20220503T100405.000Z.json"
+			})).Return(&s3.PutObjectOutput{}, nil)
+
+			store := history.NewS3Store(p.S3(), "my-bucket", "eksctl")
+			Expect(store.Put(context.Background(), record)).To(Succeed())
+			p.MockS3().AssertExpectations(GinkgoT())
+		})
+
+		It("lists the records, the most recent first", func() {
+			older := newRecord(time.Date(2022, 5, 3, 10, 4, 5, 0, time.UTC))
+			newer := newRecord(time.Date(2022, 5, 4, 10, 4, 5, 0, time.UTC))
+			objects := map[string]*history.Record{
+				"eksctl/dev/20220503T100405.000Z.json": older,
+				"eksctl/dev/20220504T100405.000Z.json": newer,
+			}
+			p.MockS3().On("ListObjectsV2", mock.Anything, mock.MatchedBy(func(input *s3.ListObjectsV2Input) bool {
+				return *input.Bucket == "my-bucket" && *input.Prefix == "eksctl/dev/"
+			}), mock.Anything).Return(&s3.ListObjectsV2Output{
+				Contents: []s3types.Object{
+					{Key: aws.String("eksctl/dev/20220503T100405.000Z.json")},
+					{Key: aws.String("eksctl/dev/README")},
+					{Key: aws.String("eksctl/dev/20220504T100405.000Z.json")},
+				},
+			}, nil)
+			p.MockS3().On("GetObject", mock.Anything, mock.Anything).Return(func(_ context.Context, input *s3.GetObjectInput, _ ...func(*s3.Options)) *s3.GetObjectOutput {
+				data, err := json.Marshal(objects[*input.Key])
+				Expect(err).NotTo(HaveOccurred())
+				return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}
+			}, nil)
+
+			store := history.NewS3Store(p.S3(), "my-bucket", "eksctl")
+			records, err := store.List(context.Background(), "dev")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].Version).To(Equal("20220504T100405.000Z"))
+			Expect(records[0].Time).To(Equal(newer.Time))
+			Expect(records[1].Version).To(Equal("20220503T100405.000Z"))
+			Expect(records[1].Time).To(Equal(older.Time))
+			Expect(records[1].ClusterConfig.Metadata.Name).To(Equal("dev"))
+		})
+	})
+
+	Describe("SSMStore", func() {
+		It("lists the records it put, the most recent version first", func() {
+			var values []string
+			p.MockSSM().On("PutParameter", mock.Anything, mock.MatchedBy(func(input *ssm.PutParameterInput) bool {
+				return *input.Name == "/eksctl/history/dev" && *input.Overwrite
+			})).Run(func(args mock.Arguments) {
+				values = append(values, *args.Get(1).(*ssm.PutParameterInput).Value)
+			}).Return(&ssm.PutParameterOutput{}, nil)
+
+			store := history.NewSSMStore(p.SSM(), "/eksctl/history")
+			older := newRecord(time.Date(2022, 5, 3, 10, 4, 5, 0, time.UTC))
+			newer := newRecord(time.Date(2022, 5, 4, 10, 4, 5, 0, time.UTC))
+			Expect(store.Put(context.Background(), older)).To(Succeed())
+			Expect(store.Put(context.Background(), newer)).To(Succeed())
+			Expect(values).To(HaveLen(2))
+
+			// versions 9 and 10 check the records are sorted by version as a number
+			p.MockSSM().On("GetParameterHistory", mock.Anything, mock.MatchedBy(func(input *ssm.GetParameterHistoryInput) bool {
+				return *input.Name == "/eksctl/history/dev"
+			}), mock.Anything).Return(&ssm.GetParameterHistoryOutput{
+				Parameters: []ssmtypes.ParameterHistory{
+					{Version: 9, Value: aws.String(values[0])},
+					{Version: 10, Value: aws.String(values[1])},
+				},
+			}, nil)
+
+			records, err := store.List(context.Background(), "dev")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].Version).To(Equal("10"))
+			Expect(records[0].Time).To(Equal(newer.Time))
+			Expect(records[1].Version).To(Equal("9"))
+			Expect(records[1].Time).To(Equal(older.Time))
+			Expect(records[1].Stacks).To(Equal(older.Stacks))
+		})
+
+		It("returns no records for a cluster without history", func() {
+			p.MockSSM().On("GetParameterHistory", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, &ssmtypes.ParameterNotFound{})
+
+			store := history.NewSSMStore(p.SSM(), "/eksctl/history")
+			records, err := store.List(context.Background(), "dev")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// s3VersionFormat names the object of each record after its time, so that the objects sort like the records
+const s3VersionFormat = "20060102T150405.000Z"
+
+// S3Store keeps each record as a JSON object named <prefix>/<cluster>/<version>.json
+type S3Store struct {
+	s3API  awsapi.S3
+	bucket string
+	prefix string
+}
+
+// NewS3Store returns an S3Store keeping the records in bucket under prefix
+func NewS3Store(s3API awsapi.S3, bucket, prefix string) *S3Store {
+	return &S3Store{
+		s3API:  s3API,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+// Put implements Store
+func (s *S3Store) Put(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	key := path.Join(s.prefix, record.Cluster, record.Time.UTC().Format(s3VersionFormat)+".json")
+	if _, err := s.s3API.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      &s.bucket,
+		Key:         &key,
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	}); err != nil {
+		return fmt.Errorf("writing s3://%s/%s: %w", s.bucket, key, err)
+	}
+	return nil
+}
+
+// List implements Store
+func (s *S3Store) List(ctx context.Context, clusterName string) ([]*Record, error) {
+	clusterPrefix := path.Join(s.prefix, clusterName) + "/"
+	paginator := s3.NewListObjectsV2Paginator(s.s3API, &s3.ListObjectsV2Input{
+		Bucket: &s.bucket,
+		Prefix: &clusterPrefix,
+	})
+	var records []*Record
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing s3://%s/%s: %w", s.bucket, clusterPrefix, err)
+		}
+		for _, object := range output.Contents {
+			key := aws.ToString(object.Key)
+			if !strings.HasSuffix(key, ".json") {
+				continue
+			}
+			record, err := s.get(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			record.Version = strings.TrimSuffix(path.Base(key), ".json")
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Version > records[j].Version
+	})
+	return records, nil
+}
+
+func (s *S3Store) get(ctx context.Context, key string) (*Record, error) {
+	output, err := s.s3API.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading s3://%s/%s: %w", s.bucket, key, err)
+	}
+	defer output.Body.Close()
+	var record Record
+	if err := json.NewDecoder(output.Body).Decode(&record); err != nil {
+		return nil, fmt.Errorf("decoding s3://%s/%s: %w", s.bucket, key, err)
+	}
+	return &record, nil
+}
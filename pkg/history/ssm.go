@@ -0,0 +1,124 @@
+package history
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// SSMStore keeps the records of a cluster as the versions of the parameter <path>/<cluster>, whose value is the
+// record as gzipped and base64 encoded JSON. Parameter Store keeps the last 100 versions of a parameter
+type SSMStore struct {
+	ssmAPI awsapi.SSM
+	path   string
+}
+
+// NewSSMStore returns an SSMStore keeping the records under path
+func NewSSMStore(ssmAPI awsapi.SSM, path string) *SSMStore {
+	return &SSMStore{
+		ssmAPI: ssmAPI,
+		path:   path,
+	}
+}
+
+// Put implements Store
+func (s *SSMStore) Put(ctx context.Context, record *Record) error {
+	value, err := encodeRecord(record)
+	if err != nil {
+		return err
+	}
+	name := s.parameterName(record.Cluster)
+	if _, err := s.ssmAPI.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:      &name,
+		Value:     &value,
+		Type:      ssmtypes.ParameterTypeString,
+		Overwrite: aws.Bool(true),
+		// parameters over 4KB need the advanced tier, which Intelligent-Tiering only uses for them
+		Tier: ssmtypes.ParameterTierIntelligentTiering,
+	}); err != nil {
+		return fmt.Errorf("writing SSM parameter %s: %w", name, err)
+	}
+	return nil
+}
+
+// List implements Store
+func (s *SSMStore) List(ctx context.Context, clusterName string) ([]*Record, error) {
+	name := s.parameterName(clusterName)
+	paginator := ssm.NewGetParameterHistoryPaginator(s.ssmAPI, &ssm.GetParameterHistoryInput{
+		Name: &name,
+	})
+	var records []*Record
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			var notFound *ssmtypes.ParameterNotFound
+			if errors.As(err, &notFound) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("reading the history of SSM parameter %s: %w", name, err)
+		}
+		for _, p := range output.Parameters {
+			record, err := decodeRecord(aws.ToString(p.Value))
+			if err != nil {
+				return nil, fmt.Errorf("decoding version %d of SSM parameter %s: %w", p.Version, name, err)
+			}
+			record.Version = strconv.FormatInt(p.Version, 10)
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return parameterVersion(records[i]) > parameterVersion(records[j])
+	})
+	return records, nil
+}
+
+func (s *SSMStore) parameterName(clusterName string) string {
+	return s.path + "/" + clusterName
+}
+
+// parameterVersion returns the SSM parameter version of a listed record
+func parameterVersion(record *Record) int64 {
+	version, _ := strconv.ParseInt(record.Version, 10, 64)
+	return version
+}
+
+func encodeRecord(record *Record) (string, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(record); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decodeRecord(value string) (*Record, error) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	var record Record
+	if err := json.NewDecoder(zr).Decode(&record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
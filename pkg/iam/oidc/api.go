@@ -19,6 +19,7 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/awsapi"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
 	cf "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
 	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
@@ -312,7 +313,7 @@ func (m *OpenIDConnectManager) getIssuerCAThumbprint() error {
 				InsecureSkipVerify: m.insecureSkipVerify,
 				MinVersion:         tls.VersionTLS12,
 			},
-			Proxy: http.ProxyFromEnvironment,
+			Proxy: proxy.FromEnvironment,
 		},
 	}
 
@@ -0,0 +1,147 @@
+package info
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
+)
+
+// awsCallTimeout bounds each network call, resolving the credentials and calling STS, so that eksctl info
+// reports an unreachable endpoint instead of hanging
+const awsCallTimeout = 5 * time.Second
+
+// AWSInfo holds the AWS identity, region and configuration files eksctl resolves, without flags or a config file
+type AWSInfo struct {
+	// Identity is the identity of the credentials, as returned by sts get-caller-identity
+	Identity *Identity `json:",omitempty"`
+	// IdentityError is why the identity could not be obtained
+	IdentityError string `json:",omitempty"`
+	// CredentialsSource is the provider of the credentials, e.g. the environment or a shared credentials file
+	CredentialsSource string `json:",omitempty"`
+	Profile           string
+	ProfileSource     string
+	Region            string
+	RegionSource      string
+	// RegionResolutionOrder lists where eksctl looks for the region, from the first one that is used
+	RegionResolutionOrder []string
+	ConfigFile            File
+	CredentialsFile       File
+}
+
+// Identity is an AWS identity
+type Identity struct {
+	Account string
+	ARN     string
+	UserID  string
+}
+
+// File is a configuration file and where its path comes from
+type File struct {
+	Path   string
+	Source string
+	Exists bool
+}
+
+// getAWSInfo returns where the profile, region and configuration files come from, and the identity of the
+// credentials
+func getAWSInfo(ctx context.Context) AWSInfo {
+	info := AWSInfo{
+		ConfigFile:      sharedFile("AWS_CONFIG_FILE", config.DefaultSharedConfigFilename()),
+		CredentialsFile: sharedFile("AWS_SHARED_CREDENTIALS_FILE", config.DefaultSharedCredentialsFilename()),
+	}
+	info.Profile, info.ProfileSource = resolveProfile()
+	info.Region, info.RegionSource = resolveRegion(ctx, info.Profile, info.ConfigFile, info.CredentialsFile)
+	info.RegionResolutionOrder = []string{
+		"--region flag",
+		"metadata.region of the config file",
+		"AWS_REGION environment variable",
+		"AWS_DEFAULT_REGION environment variable",
+		fmt.Sprintf("region of profile %q in %s", info.Profile, info.ConfigFile.Path),
+		fmt.Sprintf("default region %s", api.DefaultRegion),
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithRegion(info.Region),
+		config.WithHTTPClient(awshttp.NewBuildableClient().WithTimeout(awsCallTimeout).WithTransportOptions(func(t *http.Transport) {
+			t.Proxy = proxy.FromEnvironment
+		})),
+	)
+	if err != nil {
+		info.IdentityError = err.Error()
+		return info
+	}
+	credentialsCtx, cancel := context.WithTimeout(ctx, awsCallTimeout)
+	defer cancel()
+	credentials, err := cfg.Credentials.Retrieve(credentialsCtx)
+	if err != nil {
+		info.IdentityError = err.Error()
+		return info
+	}
+	info.CredentialsSource = credentials.Source
+
+	stsCtx, cancel := context.WithTimeout(ctx, awsCallTimeout)
+	defer cancel()
+	output, err := sts.NewFromConfig(cfg).GetCallerIdentity(stsCtx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		info.IdentityError = err.Error()
+		return info
+	}
+	info.Identity = &Identity{
+		Account: aws.ToString(output.Account),
+		ARN:     aws.ToString(output.Arn),
+		UserID:  aws.ToString(output.UserId),
+	}
+	return info
+}
+
+// resolveProfile returns the profile the AWS SDK uses and where it comes from. AWS_DEFAULT_PROFILE is not
+// reported, as the AWS SDK for Go v2 ignores it
+func resolveProfile() (string, string) {
+	if profile := os.Getenv("AWS_PROFILE"); profile != "" {
+		return profile, "AWS_PROFILE environment variable"
+	}
+	return "default", "default profile"
+}
+
+// resolveRegion returns the region the AWS SDK uses, or the default region of eksctl, and where it comes from
+func resolveRegion(ctx context.Context, profile string, configFile, credentialsFile File) (string, string) {
+	for _, name := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if region := os.Getenv(name); region != "" {
+			return region, name + " environment variable"
+		}
+	}
+	sharedConfig, err := config.LoadSharedConfigProfile(ctx, profile, func(o *config.LoadSharedConfigOptions) {
+		o.ConfigFiles = []string{configFile.Path}
+		o.CredentialsFiles = []string{credentialsFile.Path}
+	})
+	if err == nil && sharedConfig.Region != "" {
+		return sharedConfig.Region, fmt.Sprintf("profile %q in %s", profile, configFile.Path)
+	}
+	return api.DefaultRegion, "default region of eksctl"
+}
+
+// sharedFile returns a shared configuration file of the AWS SDK, whose path is set with the environment variable
+// name or is defaultPath
+func sharedFile(name, defaultPath string) File {
+	file := File{
+		Path:   defaultPath,
+		Source: "default path",
+	}
+	if path := os.Getenv(name); path != "" {
+		file.Path = path
+		file.Source = name + " environment variable"
+	}
+	_, err := os.Stat(file.Path)
+	file.Exists = err == nil
+	return file
+}
@@ -0,0 +1,123 @@
+package info
+
+import (
+	"net/url"
+	"os"
+	"os/exec"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// kubeconfigTimeout bounds the time spent reaching the API server of the current context
+const kubeconfigTimeout = 5 * time.Second
+
+// ProxyInfo holds the proxy settings of the environment, with the credentials in the URLs redacted
+type ProxyInfo struct {
+	HTTPSProxy string `json:",omitempty"`
+	HTTPProxy  string `json:",omitempty"`
+	NoProxy    string `json:",omitempty"`
+}
+
+// KubeconfigInfo holds the current context of the kubeconfig and whether it works
+type KubeconfigInfo struct {
+	// Paths are the kubeconfig files, from KUBECONFIG or the default path
+	Paths          []string
+	CurrentContext string `json:",omitempty"`
+	Cluster        string `json:",omitempty"`
+	Server         string `json:",omitempty"`
+	// Authenticator is the command the current user runs to get a token, if any
+	Authenticator string `json:",omitempty"`
+	// ServerVersion is the version of the API server, when it can be reached
+	ServerVersion string `json:",omitempty"`
+	Healthy       bool
+	// Error is why the current context is not healthy
+	Error string `json:",omitempty"`
+}
+
+// getProxyInfo returns the proxy settings of the environment
+func getProxyInfo() ProxyInfo {
+	return ProxyInfo{
+		HTTPSProxy: redactURL(getEnv("HTTPS_PROXY", "https_proxy")),
+		HTTPProxy:  redactURL(getEnv("HTTP_PROXY", "http_proxy")),
+		NoProxy:    getEnv("NO_PROXY", "no_proxy"),
+	}
+}
+
+// getEnv returns the value of the first set environment variable of names
+func getEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// redactURL redacts the password of a URL
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	return u.Redacted()
+}
+
+// getKubeconfigInfo checks that the current context of the kubeconfig exists, that its authenticator can be
+// found and that its API server can be reached
+func getKubeconfigInfo() KubeconfigInfo {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	info := KubeconfigInfo{
+		Paths: loadingRules.GetLoadingPrecedence(),
+	}
+	config, err := loadingRules.Load()
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.CurrentContext = config.CurrentContext
+	if info.CurrentContext == "" {
+		info.Error = "no current context is set"
+		return info
+	}
+	kubeContext, ok := config.Contexts[info.CurrentContext]
+	if !ok {
+		info.Error = "the current context does not exist"
+		return info
+	}
+	info.Cluster = kubeContext.Cluster
+	cluster, ok := config.Clusters[kubeContext.Cluster]
+	if !ok {
+		info.Error = "the cluster of the current context does not exist"
+		return info
+	}
+	info.Server = cluster.Server
+	if authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]; ok && authInfo.Exec != nil {
+		info.Authenticator = authInfo.Exec.Command
+		if _, err := exec.LookPath(authInfo.Exec.Command); err != nil {
+			info.Error = err.Error()
+			return info
+		}
+	}
+
+	restConfig, err := clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	restConfig.Timeout = kubeconfigTimeout
+	clientSet, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	version, err := clientSet.Discovery().ServerVersion()
+	if err != nil {
+		info.Error = err.Error()
+		return info
+	}
+	info.ServerVersion = version.GitVersion
+	info.Healthy = true
+	return info
+}
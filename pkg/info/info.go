@@ -1,6 +1,7 @@
 package info
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
@@ -11,11 +12,14 @@ import (
 
 //go:generate go run ./release_generate.go
 
-// Info holds versions info
+// Info holds versions info, and what eksctl resolves from the environment to diagnose issues
 type Info struct {
 	EksctlVersion  string
 	KubectlVersion string
 	OS             string
+	AWS            AWSInfo
+	Proxy          ProxyInfo
+	Kubeconfig     KubeconfigInfo
 }
 
 // clientVersion holds git version info of kubectl client
@@ -28,12 +32,22 @@ type kubectlInfo struct {
 	ClientVersion clientVersion `json:"clientVersion"`
 }
 
-// GetInfo returns versions info
+// GetInfo returns versions info, the AWS identity, region and configuration files, the proxy settings and the
+// health of the current kubeconfig context
 func GetInfo() Info {
+	return getInfo(getAWSInfo, getKubeconfigInfo)
+}
+
+// getInfo returns the info, with the AWS and kubeconfig info returned by awsInfo and kubeconfigInfo, as they call
+// AWS and the API server
+func getInfo(awsInfo func(ctx context.Context) AWSInfo, kubeconfigInfo func() KubeconfigInfo) Info {
 	return Info{
 		EksctlVersion:  getEksctlVersion(),
 		KubectlVersion: getKubectlVersion(),
 		OS:             runtime.GOOS,
+		AWS:            awsInfo(context.Background()),
+		Proxy:          getProxyInfo(),
+		Kubeconfig:     kubeconfigInfo(),
 	}
 }
 
@@ -65,7 +79,11 @@ func getKubectlVersion() string {
 
 // String return info as JSON
 func String() string {
-	data, err := json.Marshal(GetInfo())
+	return toJSON(GetInfo())
+}
+
+func toJSON(info Info) string {
+	data, err := json.Marshal(info)
 	if err != nil {
 		return fmt.Sprintf("failed to marshal info into json: %q", err)
 	}
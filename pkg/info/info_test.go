@@ -1,6 +1,7 @@
 package info
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 
@@ -10,9 +11,18 @@ import (
 )
 
 var _ = Describe("Info", func() {
+	// the AWS and kubeconfig info are faked not to call AWS and the API server
+	getFakeInfo := func() Info {
+		return getInfo(func(_ context.Context) AWSInfo {
+			return AWSInfo{Profile: "default"}
+		}, func() KubeconfigInfo {
+			return KubeconfigInfo{CurrentContext: "dev"}
+		})
+	}
+
 	Context("GetInfo", func() {
 		It("returns the eksctl and kubectl versions, and the host os", func() {
-			result := GetInfo()
+			result := getFakeInfo()
 
 			Expect(result.EksctlVersion).NotTo(Equal(""))
 			Expect(result.KubectlVersion).NotTo(Equal(""))
@@ -26,12 +36,14 @@ var _ = Describe("Info", func() {
 
 			oses := []string{"aix", "android", "darwin", "dragonfly", "freebsd", "hurd", "illumos", "ios", "js", "linux", "nacl", "netbsd", "openbsd", "plan9", "solaris", "windows", "zos"}
 			Expect(result.OS).To(BeElementOf(oses))
+			Expect(result.AWS.Profile).To(Equal("default"))
+			Expect(result.Kubeconfig.CurrentContext).To(Equal("dev"))
 		})
 	})
 
 	Context("String", func() {
 		It("returns Info in json", func() {
-			result := String()
+			result := toJSON(getFakeInfo())
 
 			infos := Info{}
 			err := json.Unmarshal([]byte(result), &infos)
@@ -70,6 +70,16 @@ func (k *Installer) Install(ctx context.Context, serviceAccountRoleARN string, i
 		serviceAccountName: DefaultServiceAccountName,
 	}
 
+	awsSettings := map[string]interface{}{
+		defaultInstanceProfile: instanceProfileName,
+		clusterName:            k.ClusterConfig.Metadata.Name,
+		clusterEndpoint:        k.ClusterConfig.Status.Endpoint,
+	}
+	// the queue only exists when eksctl created it, Karpenter fails to poll a missing queue
+	if api.IsEnabled(k.ClusterConfig.Karpenter.WithSpotInterruptionQueue) {
+		awsSettings[interruptionQueueName] = k.ClusterConfig.Metadata.Name
+	}
+
 	values := map[string]interface{}{
 		clusterName:     k.ClusterConfig.Metadata.Name,
 		clusterEndpoint: k.ClusterConfig.Status.Endpoint,
@@ -77,12 +87,7 @@ func (k *Installer) Install(ctx context.Context, serviceAccountRoleARN string, i
 			defaultInstanceProfile: instanceProfileName,
 		},
 		settings: map[string]interface{}{
-			aws: map[string]interface{}{
-				defaultInstanceProfile: instanceProfileName,
-				clusterName:            k.ClusterConfig.Metadata.Name,
-				clusterEndpoint:        k.ClusterConfig.Status.Endpoint,
-				interruptionQueueName:  k.ClusterConfig.Metadata.Name,
-			},
+			aws: awsSettings,
 		},
 		serviceAccount: serviceAccountMap,
 	}
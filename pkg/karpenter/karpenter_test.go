@@ -66,7 +66,6 @@ var _ = Describe("Install", func() {
 						defaultInstanceProfile: "role/profile",
 						clusterName:            cfg.Metadata.Name,
 						clusterEndpoint:        cfg.Status.Endpoint,
-						interruptionQueueName:  cfg.Metadata.Name,
 					},
 				},
 			}
@@ -81,6 +80,25 @@ var _ = Describe("Install", func() {
 			}))
 		})
 
+		When("the spot interruption queue is enabled", func() {
+			BeforeEach(func() {
+				cfg.Karpenter.WithSpotInterruptionQueue = api.Enabled()
+			})
+
+			It("configures Karpenter to use the queue", func() {
+				Expect(installerUnderTest.Install(context.Background(), "role-arn", "role/profile")).To(Succeed())
+				_, opts := fakeHelmInstaller.InstallChartArgsForCall(0)
+				Expect(opts.Values[settings]).To(Equal(map[string]interface{}{
+					aws: map[string]interface{}{
+						defaultInstanceProfile: "role/profile",
+						clusterName:            cfg.Metadata.Name,
+						clusterEndpoint:        cfg.Status.Endpoint,
+						interruptionQueueName:  cfg.Metadata.Name,
+					},
+				}))
+			})
+		})
+
 		When("install chart fails", func() {
 
 			BeforeEach(func() {
@@ -116,7 +134,6 @@ var _ = Describe("Install", func() {
 							defaultInstanceProfile: "role/profile",
 							clusterName:            cfg.Metadata.Name,
 							clusterEndpoint:        cfg.Status.Endpoint,
-							interruptionQueueName:  cfg.Metadata.Name,
 						},
 					},
 				}
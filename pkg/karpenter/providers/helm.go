@@ -3,6 +3,7 @@ package providers
 import (
 	"bytes"
 	"context"
+	"time"
 
 	"helm.sh/helm/v3/pkg/getter"
 	"helm.sh/helm/v3/pkg/registry"
@@ -18,13 +19,21 @@ type URLGetter interface {
 
 // InstallChartOpts defines parameters for InstallChart.
 type InstallChartOpts struct {
-	ChartName       string
+	ChartName string
+	// RepoURL is the URL of the chart repository, when the chart is not
+	// referenced by an OCI URL
+	RepoURL         string
 	CreateNamespace bool
 	Namespace       string
 	ReleaseName     string
 	Values          map[string]interface{}
 	Version         string
 	RegistryClient  *registry.Client
+	// SkipWait returns as soon as the resources of the release are
+	// created, instead of waiting for them to be ready
+	SkipWait bool
+	// Timeout defaults to 10 minutes
+	Timeout time.Duration
 }
 
 // HelmInstaller deals with setting up Helm related resources.
@@ -21,6 +21,9 @@ type Options struct {
 	RESTClientGetter genericclioptions.RESTClientGetter
 }
 
+// defaultTimeout is the timeout for installing a release unless InstallChartOpts.Timeout is set
+const defaultTimeout = 10 * time.Minute
+
 // Installer implement the HelmInstaller interface.
 type Installer struct {
 	Settings     *cli.EnvSettings
@@ -49,12 +52,16 @@ var _ providers.HelmInstaller = &Installer{}
 func (i *Installer) InstallChart(ctx context.Context, opts providers.InstallChartOpts) error {
 	i.ActionConfig.RegistryClient = opts.RegistryClient
 	client := action.NewInstall(i.ActionConfig)
-	client.Wait = true
+	client.Wait = !opts.SkipWait
 	client.Namespace = opts.Namespace
 	client.ReleaseName = opts.ReleaseName
 	client.Version = opts.Version
 	client.CreateNamespace = opts.CreateNamespace
-	client.Timeout = 10 * time.Minute
+	client.RepoURL = opts.RepoURL
+	client.Timeout = defaultTimeout
+	if opts.Timeout > 0 {
+		client.Timeout = opts.Timeout
+	}
 
 	chartPath, err := client.ChartPathOptions.LocateChart(opts.ChartName, i.Settings)
 	if err != nil {
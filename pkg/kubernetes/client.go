@@ -188,6 +188,34 @@ func (c *RawClient) NewHelperFor(gvk schema.GroupVersionKind) (*resource.Helper,
 	return resource.NewHelper(client, mapping), nil
 }
 
+// NewUnstructuredHelperFor constructs a raw client helper instance for a given gvk
+// that decodes responses into unstructured objects, so that it can be used for
+// custom resources, whose types are not registered in the client scheme
+func (c *RawClient) NewUnstructuredHelperFor(gvk schema.GroupVersionKind) (*resource.Helper, error) {
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "constructing REST client mapping for %s", gvk.String())
+	}
+
+	config := restclient.CopyConfig(c.config)
+	config.ContentConfig = resource.UnstructuredPlusDefaultContentConfig()
+	switch gvk.Group {
+	case corev1.GroupName:
+		config.APIPath = "/api"
+	default:
+		config.APIPath = "/apis"
+	}
+	gv := gvk.GroupVersion()
+	config.GroupVersion = &gv
+
+	client, err := restclient.RESTClientFor(config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "constructing REST client for %s", gvk.String())
+	}
+
+	return resource.NewHelper(client, mapping), nil
+}
+
 // NewRawResource constructs a type-specific instance or RawClient for object
 func (c *RawClient) NewRawResource(object runtime.Object) (*RawResource, error) {
 	gvk := object.GetObjectKind().GroupVersionKind()
@@ -203,7 +231,15 @@ func (c *RawClient) NewRawResource(object runtime.Object) (*RawResource, error)
 		Object:    object,
 	}
 
-	helper, err := c.NewHelperFor(gvk)
+	var (
+		helper *resource.Helper
+		err    error
+	)
+	if _, ok := object.(*unstructured.Unstructured); ok {
+		helper, err = c.NewUnstructuredHelperFor(gvk)
+	} else {
+		helper, err = c.NewHelperFor(gvk)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -339,11 +375,14 @@ func (r *RawResource) CreateOrReplace(plan bool) (string, error) {
 		return r.LogAction(plan, "created"), nil
 	}
 
-	convertedObj, err := scheme.Scheme.ConvertToVersion(r.Info.Object, r.GVK.GroupVersion())
-	if err != nil {
-		return "", errors.Wrapf(err, "converting object")
+	// custom resources are not registered in the scheme and cannot be converted
+	if _, ok := r.Info.Object.(*unstructured.Unstructured); !ok {
+		convertedObj, err := scheme.Scheme.ConvertToVersion(r.Info.Object, r.GVK.GroupVersion())
+		if err != nil {
+			return "", errors.Wrapf(err, "converting object")
+		}
+		scheme.Scheme.Default(convertedObj)
 	}
-	scheme.Scheme.Default(convertedObj)
 	if !plan {
 		if _, err := r.Helper.Replace(r.Info.Namespace, r.Info.Name, true, r.Info.Object); err != nil {
 			return "", err
@@ -19,31 +19,90 @@ const kmsAnnotation = "eksctl.io/kms-encryption-timestamp"
 
 // RefreshSecrets updates all secrets to apply KMS encryption
 func RefreshSecrets(ctx context.Context, c v1.CoreV1Interface) error {
-	var cont string
+	_, err := RefreshSecretsWithOptions(ctx, c, RefreshSecretsOptions{})
+	return err
+}
+
+// RefreshSecretsOptions defines options for RefreshSecretsWithOptions
+type RefreshSecretsOptions struct {
+	// BatchSize is the number of secrets listed and updated at a time, all secrets are listed at once if it is 0
+	BatchSize int64
+	// BatchInterval is the time to wait between batches
+	BatchInterval time.Duration
+	// Since skips the secrets that have already been refreshed at or after this time, to resume an interrupted refresh
+	Since time.Time
+	// Progress is called after each batch
+	Progress func(RefreshSecretsProgress)
+}
+
+// RefreshSecretsProgress is the progress of a refresh of secrets
+type RefreshSecretsProgress struct {
+	Refreshed int
+	Skipped   int
+	// Total is an estimate of the number of secrets, as secrets can be created or deleted during the refresh
+	Total int
+}
+
+// Processed returns the number of secrets that have been refreshed or skipped
+func (p RefreshSecretsProgress) Processed() int {
+	return p.Refreshed + p.Skipped
+}
+
+// RefreshSecretsWithOptions updates all secrets in batches to apply KMS encryption, reporting the progress after each batch
+func RefreshSecretsWithOptions(ctx context.Context, c v1.CoreV1Interface, opts RefreshSecretsOptions) (RefreshSecretsProgress, error) {
+	var (
+		progress RefreshSecretsProgress
+		cont     string
+	)
 	for {
 		list, err := c.Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			Limit:    opts.BatchSize,
 			Continue: cont,
 		})
 		if err != nil {
-			return errors.Wrap(err, "error listing resources")
+			return progress, errors.Wrap(err, "error listing resources")
+		}
+		progress.Total = progress.Processed() + len(list.Items)
+		if list.RemainingItemCount != nil {
+			progress.Total += int(*list.RemainingItemCount)
 		}
 		for _, secret := range list.Items {
+			if refreshedSince(secret, opts.Since) {
+				progress.Skipped++
+				continue
+			}
 			if err := refreshSecret(ctx, c, secret); err != nil {
-				return errors.Wrapf(err, "error updating secret %q", secret.Name)
+				return progress, errors.Wrapf(err, "error updating secret %q", secret.Name)
 			}
+			progress.Refreshed++
+		}
+		if opts.Progress != nil {
+			opts.Progress(progress)
 		}
 		if cont = list.Continue; cont == "" {
 			break
 		}
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
-		default:
-
+			return progress, ctx.Err()
+		case <-time.After(opts.BatchInterval):
 		}
 	}
-	return nil
+	return progress, nil
 }
+
+func refreshedSince(s corev1.Secret, since time.Time) bool {
+	if since.IsZero() {
+		return false
+	}
+	refreshed, err := time.Parse(time.RFC3339, s.Annotations[kmsAnnotation])
+	if err != nil {
+		return false
+	}
+	// the annotation has a precision of seconds
+	return !refreshed.Before(since.Truncate(time.Second))
+}
+
 func createPatch(o runtime.Object, annotationName string) ([]byte, error) {
 	metaAccessor := meta.NewAccessor()
 	oldData, err := json.Marshal(o)
@@ -0,0 +1,131 @@
+package kubernetes_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	. "github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// paginatingCoreV1 honours the limit of lists of secrets, which the fake clientset ignores
+type paginatingCoreV1 struct {
+	v1.CoreV1Interface
+}
+
+func (c paginatingCoreV1) Secrets(namespace string) v1.SecretInterface {
+	return paginatingSecrets{c.CoreV1Interface.Secrets(namespace)}
+}
+
+type paginatingSecrets struct {
+	v1.SecretInterface
+}
+
+func (s paginatingSecrets) List(ctx context.Context, opts metav1.ListOptions) (*corev1.SecretList, error) {
+	all, err := s.SecretInterface.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(all.Items, func(i, j int) bool {
+		return all.Items[i].Name < all.Items[j].Name
+	})
+	start := 0
+	if opts.Continue != "" {
+		start, _ = strconv.Atoi(opts.Continue)
+	}
+	end := start + int(opts.Limit)
+	list := &corev1.SecretList{}
+	if end < len(all.Items) {
+		list.Continue = strconv.Itoa(end)
+		remaining := int64(len(all.Items) - end)
+		list.RemainingItemCount = &remaining
+	} else {
+		end = len(all.Items)
+	}
+	list.Items = all.Items[start:end]
+	return list, nil
+}
+
+var _ = Describe("Refreshing secrets", func() {
+	const kmsAnnotation = "eksctl.io/kms-encryption-timestamp"
+
+	var (
+		clientSet *fake.Clientset
+		secrets   []corev1.Secret
+	)
+
+	BeforeEach(func() {
+		secrets = nil
+		var objects []runtime.Object
+		for i := 0; i < 5; i++ {
+			secret := corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("secret-%d", i),
+					Namespace: "default",
+				},
+			}
+			secrets = append(secrets, secret)
+			objects = append(objects, secret.DeepCopy())
+		}
+		clientSet = fake.NewSimpleClientset(objects...)
+	})
+
+	getSecret := func(name string) *corev1.Secret {
+		secret, err := clientSet.CoreV1().Secrets("default").Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return secret
+	}
+
+	It("refreshes all secrets", func() {
+		Expect(RefreshSecrets(context.Background(), clientSet.CoreV1())).To(Succeed())
+		for _, s := range secrets {
+			Expect(getSecret(s.Name).Annotations).To(HaveKey(kmsAnnotation))
+		}
+	})
+
+	It("refreshes secrets in batches and reports the progress", func() {
+		var reported []RefreshSecretsProgress
+		progress, err := RefreshSecretsWithOptions(context.Background(), paginatingCoreV1{clientSet.CoreV1()}, RefreshSecretsOptions{
+			BatchSize: 2,
+			Progress: func(p RefreshSecretsProgress) {
+				reported = append(reported, p)
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(progress).To(Equal(RefreshSecretsProgress{Refreshed: 5, Total: 5}))
+		Expect(reported).To(Equal([]RefreshSecretsProgress{
+			{Refreshed: 2, Total: 5},
+			{Refreshed: 4, Total: 5},
+			{Refreshed: 5, Total: 5},
+		}))
+	})
+
+	It("skips the secrets refreshed since the given time", func() {
+		since := time.Now().Add(-time.Hour)
+		refreshed := getSecret("secret-1")
+		refreshed.Annotations = map[string]string{kmsAnnotation: time.Now().Format(time.RFC3339)}
+		_, err := clientSet.CoreV1().Secrets("default").Update(context.Background(), refreshed, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		stale := getSecret("secret-2")
+		stale.Annotations = map[string]string{kmsAnnotation: since.Add(-time.Hour).Format(time.RFC3339)}
+		_, err = clientSet.CoreV1().Secrets("default").Update(context.Background(), stale, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		progress, err := RefreshSecretsWithOptions(context.Background(), clientSet.CoreV1(), RefreshSecretsOptions{
+			Since: since,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(progress).To(Equal(RefreshSecretsProgress{Refreshed: 4, Skipped: 1, Total: 5}))
+	})
+})
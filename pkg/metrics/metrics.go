@@ -0,0 +1,164 @@
+// Package metrics records metrics of the commands run by eksctl: their duration, the AWS API calls they make,
+// how many of them were throttled and the time spent waiting for CloudFormation stacks. The metrics are
+// pushed to a Prometheus Pushgateway when EKSCTL_METRICS_PUSHGATEWAY is set, and sent to a StatsD server
+// when EKSCTL_METRICS_STATSD is set
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+const namespace = "eksctl"
+
+var (
+	registry = prometheus.NewRegistry()
+
+	commandDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "command_duration_seconds",
+		Help:      "Duration of the commands by exit code.",
+		Buckets:   []float64{10, 30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600},
+	}, []string{"command", "exit_code"})
+
+	awsAPICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "aws_api_calls_total",
+		Help:      "Number of AWS API calls, including retries.",
+	}, []string{"service", "operation"})
+
+	awsAPIThrottles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "aws_api_throttles_total",
+		Help:      "Number of AWS API calls that were throttled.",
+	}, []string{"service", "operation"})
+
+	stackWaitDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "cloudformation_stack_wait_seconds",
+		Help:      "Time spent waiting for CloudFormation stacks to be created, updated or deleted.",
+		Buckets:   []float64{30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600},
+	}, []string{"operation", "result"})
+
+	throttles = retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	// runID identifies the metrics of this run in the Pushgateway, where pushing to the same group replaces the
+	// metrics of the group, so that the runs on the same host do not overwrite each other
+	runID = uuid.NewString()
+
+	mu     sync.RWMutex
+	statsd *statsdClient
+)
+
+func init() {
+	registry.MustRegister(commandDuration, awsAPICalls, awsAPIThrottles, stackWaitDuration)
+}
+
+// Setup sends the metrics to the StatsD server set in the environment, if any. It returns a function that
+// pushes the metrics to the Prometheus Pushgateway set in the environment, if any, and stops sending them
+func Setup() (func() error, error) {
+	if address := os.Getenv("EKSCTL_METRICS_STATSD"); address != "" {
+		client, err := newStatsdClient(address)
+		if err != nil {
+			return nil, err
+		}
+		mu.Lock()
+		statsd = client
+		mu.Unlock()
+	}
+
+	return func() error {
+		mu.Lock()
+		if statsd != nil {
+			statsd.close()
+			statsd = nil
+		}
+		mu.Unlock()
+
+		url := os.Getenv("EKSCTL_METRICS_PUSHGATEWAY")
+		if url == "" {
+			return nil
+		}
+		return pushMetrics(url)
+	}, nil
+}
+
+func pushMetrics(url string) error {
+	pusher := push.New(url, namespace).Gatherer(registry).Grouping("run_id", runID)
+	if hostname, err := os.Hostname(); err == nil {
+		pusher = pusher.Grouping("instance", hostname)
+	}
+	if err := pusher.Push(); err != nil {
+		return fmt.Errorf("pushing metrics to %s: %w", url, err)
+	}
+	return nil
+}
+
+// ObserveCommand records the duration and the exit code of a command
+func ObserveCommand(command string, duration time.Duration, exitCode int) {
+	code := strconv.Itoa(exitCode)
+	commandDuration.WithLabelValues(command, code).Observe(duration.Seconds())
+	sendStatsd(func(c *statsdClient) {
+		c.timing("command_duration", duration, "command", command, "exit_code", code)
+	})
+}
+
+// ObserveStackWait records the time spent waiting for a CloudFormation stack, operation is one of create,
+// update or delete
+func ObserveStackWait(operation string, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	stackWaitDuration.WithLabelValues(operation, result).Observe(duration.Seconds())
+	sendStatsd(func(c *statsdClient) {
+		c.timing("cloudformation_stack_wait", duration, "operation", operation, "result", result)
+	})
+}
+
+// AddAWSMiddleware adds a middleware to an AWS SDK client that counts each attempt of an API call and those
+// that were throttled, it is meant to be added to the APIOptions of the client
+func AddAWSMiddleware(stack *middleware.Stack) error {
+	// added after the retry middleware, so that it is called for each attempt
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("eksctl/Metrics", func(
+		ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler,
+	) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleFinalize(ctx, in)
+		observeAWSAPICall(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), err)
+		return out, metadata, err
+	}), middleware.After)
+}
+
+func observeAWSAPICall(service, operation string, err error) {
+	awsAPICalls.WithLabelValues(service, operation).Inc()
+	throttled := err != nil && throttles.IsErrorThrottle(err) == aws.TrueTernary
+	if throttled {
+		awsAPIThrottles.WithLabelValues(service, operation).Inc()
+	}
+	sendStatsd(func(c *statsdClient) {
+		c.count("aws_api_calls", "service", service, "operation", operation)
+		if throttled {
+			c.count("aws_api_throttles", "service", service, "operation", operation)
+		}
+	})
+}
+
+func sendStatsd(f func(*statsdClient)) {
+	mu.RLock()
+	defer mu.RUnlock()
+	if statsd != nil {
+		f(statsd)
+	}
+}
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	"github.com/aws/smithy-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Metrics", func() {
+	It("counts the AWS API calls and those that were throttled", func() {
+		calls := testutil.ToFloat64(awsAPICalls.WithLabelValues("EKS", "DescribeCluster"))
+		throttled := testutil.ToFloat64(awsAPIThrottles.WithLabelValues("EKS", "DescribeCluster"))
+
+		observeAWSAPICall("EKS", "DescribeCluster", nil)
+		observeAWSAPICall("EKS", "DescribeCluster", &smithy.GenericAPIError{Code: "ThrottlingException"})
+		observeAWSAPICall("EKS", "DescribeCluster", &smithy.GenericAPIError{Code: "ResourceNotFoundException"})
+
+		Expect(testutil.ToFloat64(awsAPICalls.WithLabelValues("EKS", "DescribeCluster"))).To(Equal(calls + 3))
+		Expect(testutil.ToFloat64(awsAPIThrottles.WithLabelValues("EKS", "DescribeCluster"))).To(Equal(throttled + 1))
+	})
+
+	It("records the time spent waiting for stacks by result", func() {
+		ObserveStackWait("create", 5*time.Minute, errors.New("failed"))
+		Expect(testutil.CollectAndCount(stackWaitDuration, "eksctl_cloudformation_stack_wait_seconds")).To(BeNumerically(">=", 1))
+	})
+
+	It("sends the metrics to StatsD with their tags", func() {
+		server, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		defer server.Close()
+
+		client, err := newStatsdClient(server.LocalAddr().String())
+		Expect(err).NotTo(HaveOccurred())
+		mu.Lock()
+		statsd = client
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			statsd = nil
+			mu.Unlock()
+			client.close()
+		}()
+
+		ObserveCommand("eksctl create cluster", 90*time.Second, 4)
+		observeAWSAPICall("CloudFormation", "CreateStack", &smithy.GenericAPIError{Code: "Throttling"})
+
+		var received []string
+		buf := make([]byte, 1024)
+		Expect(server.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+		for i := 0; i < 3; i++ {
+			n, _, err := server.ReadFrom(buf)
+			Expect(err).NotTo(HaveOccurred())
+			received = append(received, string(buf[:n]))
+		}
+		Expect(received).To(Equal([]string{
+			"eksctl.command_duration:90000|ms|#command:eksctl create cluster,exit_code:4",
+			"eksctl.aws_api_calls:1|c|#service:CloudFormation,operation:CreateStack",
+			"eksctl.aws_api_throttles:1|c|#service:CloudFormation,operation:CreateStack",
+		}))
+	})
+
+	It("pushes the metrics to a group of the run", func() {
+		var paths []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		Expect(pushMetrics(server.URL)).To(Succeed())
+		hostname, err := os.Hostname()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(ConsistOf("/metrics/job/eksctl/run_id/" + runID + "/instance/" + hostname))
+	})
+})
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// statsdClient sends metrics to a StatsD server over UDP, with tags in the DogStatsD format
+type statsdClient struct {
+	conn net.Conn
+}
+
+func newStatsdClient(address string) (*statsdClient, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to StatsD server %s: %w", address, err)
+	}
+	return &statsdClient{conn: conn}, nil
+}
+
+func (c *statsdClient) count(name string, tags ...string) {
+	c.send(fmt.Sprintf("%s.%s:1|c", namespace, name), tags)
+}
+
+func (c *statsdClient) timing(name string, d time.Duration, tags ...string) {
+	c.send(fmt.Sprintf("%s.%s:%d|ms", namespace, name, d.Milliseconds()), tags)
+}
+
+// send writes a metric with tags, given as pairs of names and values. Metrics are sent on a best-effort
+// basis, errors are ignored
+func (c *statsdClient) send(metric string, tags []string) {
+	var pairs []string
+	for i := 0; i+1 < len(tags); i += 2 {
+		pairs = append(pairs, tags[i]+":"+tags[i+1])
+	}
+	if len(pairs) > 0 {
+		metric += "|#" + strings.Join(pairs, ",")
+	}
+	_, _ = c.conn.Write([]byte(metric))
+}
+
+func (c *statsdClient) close() {
+	_ = c.conn.Close()
+}
@@ -0,0 +1,23 @@
+package printers
+
+import (
+	"io"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// PrintDryRunConfig prints ClusterConfig for dry-run
+func PrintDryRunConfig(clusterConfig *api.ClusterConfig, writer io.Writer) error {
+	return NewYAMLPrinter().PrintObj(clusterConfig, writer)
+}
+
+// PrintNodeGroupDryRunConfig prints the dry-run config for nodegroups, omitting any cluster-wide defaults
+func PrintNodeGroupDryRunConfig(clusterConfig *api.ClusterConfig, writer io.Writer) error {
+	output := &api.ClusterConfig{
+		TypeMeta:          clusterConfig.TypeMeta,
+		Metadata:          clusterConfig.Metadata,
+		NodeGroups:        clusterConfig.NodeGroups,
+		ManagedNodeGroups: clusterConfig.ManagedNodeGroups,
+	}
+	return PrintDryRunConfig(output, writer)
+}
@@ -3,6 +3,7 @@ package printers
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/kris-nova/logger"
 )
@@ -17,6 +18,12 @@ const (
 	JSONType = Type("json")
 	// TableType represents a printer of Table type.
 	TableType = Type("table")
+	// JSONPathType represents a printer of the fields selected by a JSONPath
+	// expression, given as jsonpath=<expression>.
+	JSONPathType = Type("jsonpath")
+	// GoTemplateType represents a printer of the fields selected by a Go
+	// template, given as go-template=<template>.
+	GoTemplateType = Type("go-template")
 )
 
 // OutputPrinter is the interface that printer must implement. This allows
@@ -39,6 +46,15 @@ func NewPrinter(printerType Type) (OutputPrinter, error) {
 	case TableType:
 		printer = NewTablePrinter()
 	default:
+		name, text, ok := strings.Cut(printerType, "=")
+		switch {
+		case name == JSONPathType && ok:
+			return NewJSONPathPrinter(text)
+		case name == GoTemplateType && ok:
+			return NewGoTemplatePrinter(text)
+		case name == JSONPathType || name == GoTemplateType:
+			return nil, fmt.Errorf("a template must be given with %s=<template>", name)
+		}
 		return nil, errInvalidPrinterType(printerType)
 	}
 
@@ -46,5 +62,5 @@ func NewPrinter(printerType Type) (OutputPrinter, error) {
 }
 
 func errInvalidPrinterType(printerType Type) error {
-	return fmt.Errorf("unknown output printer type: expected {%q,%q,%q} but got %q", YAMLType, JSONType, TableType, printerType)
+	return fmt.Errorf("unknown output printer type: expected {%q,%q,%q,%q,%q} but got %q", YAMLType, JSONType, TableType, JSONPathType+"=...", GoTemplateType+"=...", printerType)
 }
@@ -0,0 +1,74 @@
+package printers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/kris-nova/logger"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// TemplatePrinter is a printer that outputs the fields of an object selected
+// by a JSONPath expression or a Go template. The fields have the same names
+// as in the JSON output
+type TemplatePrinter struct {
+	execute func(writer io.Writer, data interface{}) error
+}
+
+// NewJSONPathPrinter creates a new TemplatePrinter for a JSONPath expression,
+// e.g. {.Name}
+func NewJSONPathPrinter(expression string) (OutputPrinter, error) {
+	j := jsonpath.New("output")
+	j.AllowMissingKeys(true)
+	if err := j.Parse(expression); err != nil {
+		return nil, fmt.Errorf("parsing JSONPath expression %q: %w", expression, err)
+	}
+	return &TemplatePrinter{execute: j.Execute}, nil
+}
+
+// NewGoTemplatePrinter creates a new TemplatePrinter for a Go template,
+// e.g. {{.Name}}
+func NewGoTemplatePrinter(text string) (OutputPrinter, error) {
+	t, err := template.New("output").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Go template %q: %w", text, err)
+	}
+	return &TemplatePrinter{execute: t.Execute}, nil
+}
+
+// PrintObj will print the fields of the passed object selected by the
+// template to the supplied writer.
+func (t *TemplatePrinter) PrintObj(obj interface{}, writer io.Writer) error {
+	b := &bytes.Buffer{}
+	if err := NewJSONPrinter().PrintObj(obj, b); err != nil {
+		return err
+	}
+	var data interface{}
+	if err := json.Unmarshal(b.Bytes(), &data); err != nil {
+		return err
+	}
+	return t.execute(writer, data)
+}
+
+// PrintObjWithKind will print the fields of the passed object selected by
+// the template to the supplied writer. This printer ignores kind argument.
+func (t *TemplatePrinter) PrintObjWithKind(kind string, obj interface{}, writer io.Writer) error {
+	return t.PrintObj(obj, writer)
+}
+
+// LogObj will print the fields of the passed object selected by the
+// template to the logger.
+func (t *TemplatePrinter) LogObj(log logger.LoggerFunc, msgFmt string, obj interface{}) error {
+	b := &bytes.Buffer{}
+	if err := t.PrintObj(obj, b); err != nil {
+		return err
+	}
+
+	log(msgFmt, strings.ReplaceAll(b.String(), "%", "%%"))
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package printers_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/printers"
+)
+
+var _ = Describe("Template Printers", func() {
+	type summary struct {
+		Name   string
+		Status string
+		Labels map[string]string
+	}
+
+	summaries := []*summary{
+		{Name: "cluster-1", Status: "ACTIVE", Labels: map[string]string{"env": "dev"}},
+		{Name: "cluster-2", Status: "CREATING"},
+	}
+
+	DescribeTable("printing objects", func(printerType Type, expectedOutput string) {
+		printer, err := NewPrinter(printerType)
+		Expect(err).NotTo(HaveOccurred())
+
+		out := &bytes.Buffer{}
+		Expect(printer.PrintObjWithKind("clusters", summaries, out)).To(Succeed())
+		Expect(out.String()).To(Equal(expectedOutput))
+	},
+		Entry("JSONPath expression", "jsonpath={[*].Name}", "cluster-1 cluster-2"),
+		Entry("JSONPath expression with a range", `jsonpath={range [*]}{.Name}={.Status}{"\n"}{end}`, "cluster-1=ACTIVE\ncluster-2=CREATING\n"),
+		Entry("JSONPath expression with a missing key", "jsonpath={[*].Labels.env}", "dev"),
+		Entry("Go template", "go-template={{range .}}{{.Name}} {{.Status}}\n{{end}}", "cluster-1 ACTIVE\ncluster-2 CREATING\n"),
+	)
+
+	DescribeTable("invalid templates", func(printerType Type, expectedErr string) {
+		_, err := NewPrinter(printerType)
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("JSONPath without an expression", "jsonpath", "a template must be given with jsonpath=<template>"),
+		Entry("Go template without a template", "go-template", "a template must be given with go-template=<template>"),
+		Entry("malformed JSONPath expression", "jsonpath={.Name", `parsing JSONPath expression "{.Name"`),
+		Entry("malformed Go template", "go-template={{.Name", `parsing Go template "{{.Name"`),
+	)
+})
@@ -0,0 +1,91 @@
+package progress
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Types of the events written by EventWriter
+const (
+	EventTaskStarted     = "task_started"
+	EventTaskSucceeded   = "task_succeeded"
+	EventTaskFailed      = "task_failed"
+	EventStackStatus     = "stack_status"
+	EventResourceCreated = "resource_created"
+)
+
+// Event is a lifecycle event written by EventWriter, only the fields relevant to its type are set
+type Event struct {
+	Type         string `json:"type"`
+	Timestamp    string `json:"timestamp"`
+	Task         string `json:"task,omitempty"`
+	Error        string `json:"error,omitempty"`
+	Stack        string `json:"stack,omitempty"`
+	Status       string `json:"status,omitempty"`
+	ResourceType string `json:"resourceType,omitempty"`
+	Name         string `json:"name,omitempty"`
+	ARN          string `json:"arn,omitempty"`
+}
+
+// EventWriter is a Listener that writes each event as a line of JSON, so that tools wrapping eksctl
+// can follow the progress of an operation without parsing the logs
+type EventWriter struct {
+	mu  sync.Mutex
+	w   io.Writer
+	now func() time.Time
+	// stackStatuses holds the last status written for each stack, as it is reported every time it is polled
+	stackStatuses map[string]string
+}
+
+// NewEventWriter creates an EventWriter writing to w
+func NewEventWriter(w io.Writer) *EventWriter {
+	return &EventWriter{
+		w:             w,
+		now:           time.Now,
+		stackStatuses: map[string]string{},
+	}
+}
+
+// TaskStarted implements Listener
+func (e *EventWriter) TaskStarted(description string) {
+	e.write(Event{Type: EventTaskStarted, Task: firstLine(description)})
+}
+
+// TaskCompleted implements Listener
+func (e *EventWriter) TaskCompleted(description string, err error) {
+	if err != nil {
+		e.write(Event{Type: EventTaskFailed, Task: firstLine(description), Error: err.Error()})
+		return
+	}
+	e.write(Event{Type: EventTaskSucceeded, Task: firstLine(description)})
+}
+
+// StackStatus implements Listener, an event is only written when the status of the stack changes
+func (e *EventWriter) StackStatus(stackName, status string) {
+	e.mu.Lock()
+	changed := e.stackStatuses[stackName] != status
+	e.stackStatuses[stackName] = status
+	e.mu.Unlock()
+	if !changed {
+		return
+	}
+	e.write(Event{Type: EventStackStatus, Stack: stackName, Status: status})
+}
+
+// ResourceCreated implements Listener
+func (e *EventWriter) ResourceCreated(resourceType, name, arn string) {
+	e.write(Event{Type: EventResourceCreated, ResourceType: resourceType, Name: name, ARN: arn})
+}
+
+func (e *EventWriter) write(event Event) {
+	event.Timestamp = e.now().UTC().Format(time.RFC3339)
+	line, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, _ = e.w.Write(append(line, '\n'))
+}
@@ -0,0 +1,50 @@
+package progress
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventWriter", func() {
+	var (
+		out    *bytes.Buffer
+		events *EventWriter
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		events = NewEventWriter(out)
+		events.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
+	})
+
+	It("writes each event as a line of JSON", func() {
+		events.TaskStarted("create cluster control plane \"cluster-1\"\n    2 sequential sub-tasks: { ... }")
+		events.ResourceCreated("AWS::CloudFormation::Stack", "eksctl-cluster-1-cluster", "arn:aws:cloudformation:us-west-2:000000000000:stack/eksctl-cluster-1-cluster/1")
+		events.StackStatus("eksctl-cluster-1-cluster", "CREATE_IN_PROGRESS")
+		events.TaskCompleted("create cluster control plane \"cluster-1\"\n    2 sequential sub-tasks: { ... }", nil)
+		events.TaskStarted("create managed nodegroup \"ng-1\"")
+		events.TaskCompleted("create managed nodegroup \"ng-1\"", errors.New("failed"))
+
+		Expect(out.String()).To(Equal(
+			`{"type":"task_started","timestamp":"2024-01-01T00:00:00Z","task":"create cluster control plane \"cluster-1\""}` + "\n" +
+				`{"type":"resource_created","timestamp":"2024-01-01T00:00:00Z","resourceType":"AWS::CloudFormation::Stack","name":"eksctl-cluster-1-cluster","arn":"arn:aws:cloudformation:us-west-2:000000000000:stack/eksctl-cluster-1-cluster/1"}` + "\n" +
+				`{"type":"stack_status","timestamp":"2024-01-01T00:00:00Z","stack":"eksctl-cluster-1-cluster","status":"CREATE_IN_PROGRESS"}` + "\n" +
+				`{"type":"task_succeeded","timestamp":"2024-01-01T00:00:00Z","task":"create cluster control plane \"cluster-1\""}` + "\n" +
+				`{"type":"task_started","timestamp":"2024-01-01T00:00:00Z","task":"create managed nodegroup \"ng-1\""}` + "\n" +
+				`{"type":"task_failed","timestamp":"2024-01-01T00:00:00Z","task":"create managed nodegroup \"ng-1\"","error":"failed"}` + "\n"))
+	})
+
+	It("only writes the status of a stack when it changes", func() {
+		events.StackStatus("eksctl-cluster-1-cluster", "CREATE_IN_PROGRESS")
+		events.StackStatus("eksctl-cluster-1-cluster", "CREATE_IN_PROGRESS")
+		events.StackStatus("eksctl-cluster-1-cluster", "CREATE_COMPLETE")
+
+		Expect(out.String()).To(Equal(
+			`{"type":"stack_status","timestamp":"2024-01-01T00:00:00Z","stack":"eksctl-cluster-1-cluster","status":"CREATE_IN_PROGRESS"}` + "\n" +
+				`{"type":"stack_status","timestamp":"2024-01-01T00:00:00Z","stack":"eksctl-cluster-1-cluster","status":"CREATE_COMPLETE"}` + "\n"))
+	})
+})
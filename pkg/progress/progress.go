@@ -0,0 +1,72 @@
+// Package progress reports the progress of long running operations, such as the tasks creating a cluster
+// and the CloudFormation stacks they wait for, to the listeners registered by the CLI
+package progress
+
+import (
+	"sync"
+)
+
+// Listener is notified of the progress of long running operations, its methods may be called concurrently
+// by tasks running in parallel
+type Listener interface {
+	// TaskStarted is called when a task starts
+	TaskStarted(description string)
+	// TaskCompleted is called when a task completes, err is nil when it succeeded
+	TaskCompleted(description string, err error)
+	// StackStatus is called with the current status of a CloudFormation stack that is waited for
+	StackStatus(stackName, status string)
+	// ResourceCreated is called when an AWS resource has been created, resourceType is its CloudFormation
+	// type, e.g. AWS::CloudFormation::Stack
+	ResourceCreated(resourceType, name, arn string)
+}
+
+var (
+	mu        sync.RWMutex
+	listeners []Listener
+)
+
+// AddListener registers l to be notified of the progress of operations, it returns a function that
+// removes it
+func AddListener(l Listener) func() {
+	mu.Lock()
+	defer mu.Unlock()
+	listeners = append(listeners, l)
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for i, listener := range listeners {
+			if listener == l {
+				listeners = append(listeners[:i:i], listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// TaskStarted notifies the listeners that a task has started
+func TaskStarted(description string) {
+	notify(func(l Listener) { l.TaskStarted(description) })
+}
+
+// TaskCompleted notifies the listeners that a task has completed
+func TaskCompleted(description string, err error) {
+	notify(func(l Listener) { l.TaskCompleted(description, err) })
+}
+
+// StackStatus notifies the listeners of the current status of a CloudFormation stack
+func StackStatus(stackName, status string) {
+	notify(func(l Listener) { l.StackStatus(stackName, status) })
+}
+
+// ResourceCreated notifies the listeners that an AWS resource has been created
+func ResourceCreated(resourceType, name, arn string) {
+	notify(func(l Listener) { l.ResourceCreated(resourceType, name, arn) })
+}
+
+func notify(f func(Listener)) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, l := range listeners {
+		f(l)
+	}
+}
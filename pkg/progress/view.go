@@ -0,0 +1,187 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// refreshInterval is the interval at which the view is redrawn to update the elapsed times
+const refreshInterval = time.Second
+
+type taskState struct {
+	description string
+	started     time.Time
+	completed   time.Time
+	err         error
+}
+
+type stackState struct {
+	name   string
+	status string
+}
+
+// View is a Listener that draws the state and elapsed time of each task, and the status of the
+// CloudFormation stacks being waited for, at the bottom of a terminal, updating them in place.
+// It is also an io.Writer for the logs, which are printed above the tasks
+type View struct {
+	mu       sync.Mutex
+	terminal io.Writer
+	logs     io.Writer
+	width    int
+	now      func() time.Time
+
+	tasks  []*taskState
+	stacks []*stackState
+	// lines is the number of lines drawn by the last render, which are erased before the next one
+	lines int
+
+	stop    chan struct{}
+	stopped sync.WaitGroup
+}
+
+// NewView creates a View drawing to terminal, which must be attached to a TTY that is width columns wide,
+// lines are truncated to fit into it unless width is 0. The logs written to the View are written to logs,
+// e.g. to also keep a copy of them, which defaults to terminal if nil
+func NewView(terminal, logs io.Writer, width int) *View {
+	if logs == nil {
+		logs = terminal
+	}
+	return &View{
+		terminal: terminal,
+		logs:     logs,
+		width:    width,
+		now:      time.Now,
+	}
+}
+
+// Start redraws the view periodically until Stop is called
+func (v *View) Start() {
+	v.stop = make(chan struct{})
+	v.stopped.Add(1)
+	go func() {
+		defer v.stopped.Done()
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				v.update(func() {})
+			case <-v.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops redrawing the view, leaving the last state of the tasks on the terminal
+func (v *View) Stop() {
+	if v.stop != nil {
+		close(v.stop)
+		v.stopped.Wait()
+		v.stop = nil
+	}
+}
+
+// Write prints a log line above the tasks
+func (v *View) Write(p []byte) (int, error) {
+	var (
+		n   int
+		err error
+	)
+	v.update(func() {
+		n, err = v.logs.Write(p)
+	})
+	return n, err
+}
+
+// TaskStarted implements Listener
+func (v *View) TaskStarted(description string) {
+	v.update(func() {
+		v.tasks = append(v.tasks, &taskState{description: firstLine(description), started: v.now()})
+	})
+}
+
+// TaskCompleted implements Listener
+func (v *View) TaskCompleted(description string, err error) {
+	description = firstLine(description)
+	v.update(func() {
+		for _, t := range v.tasks {
+			if t.description == description && t.completed.IsZero() {
+				t.completed = v.now()
+				t.err = err
+				return
+			}
+		}
+	})
+}
+
+// StackStatus implements Listener
+func (v *View) StackStatus(stackName, status string) {
+	v.update(func() {
+		for _, s := range v.stacks {
+			if s.name == stackName {
+				s.status = status
+				return
+			}
+		}
+		v.stacks = append(v.stacks, &stackState{name: stackName, status: status})
+	})
+}
+
+// ResourceCreated implements Listener, the view does not show the resources created
+func (v *View) ResourceCreated(_, _, _ string) {}
+
+// update erases the view, applies f and draws the view again
+func (v *View) update(f func()) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.lines > 0 {
+		// move the cursor to the first line of the view and erase everything below it
+		fmt.Fprintf(v.terminal, "\x1b[%dA\x1b[J", v.lines)
+		v.lines = 0
+	}
+	f()
+	v.render()
+}
+
+func (v *View) render() {
+	if len(v.tasks) == 0 && len(v.stacks) == 0 {
+		return
+	}
+	now := v.now()
+	var b strings.Builder
+	for _, t := range v.tasks {
+		icon, end := "…", now
+		if !t.completed.IsZero() {
+			icon, end = "✔", t.completed
+			if t.err != nil {
+				icon = "✖"
+			}
+		}
+		v.writeLine(&b, fmt.Sprintf("  %s %s (%s)", icon, t.description, end.Sub(t.started).Round(time.Second)))
+	}
+	for _, s := range v.stacks {
+		v.writeLine(&b, fmt.Sprintf("    stack %s: %s", s.name, s.status))
+	}
+	fmt.Fprint(v.terminal, b.String())
+	v.lines = strings.Count(b.String(), "\n")
+}
+
+// writeLine writes line truncated to the width of the terminal, so that it takes a single line
+func (v *View) writeLine(b *strings.Builder, line string) {
+	if runes := []rune(line); v.width > 0 && len(runes) >= v.width {
+		line = string(runes[:v.width-2]) + "…"
+	}
+	b.WriteString(line + "\n")
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "\n"); i != -1 {
+		return s[:i]
+	}
+	return s
+}
@@ -0,0 +1,101 @@
+package progress
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("View", func() {
+	var (
+		terminal *bytes.Buffer
+		view     *View
+		now      time.Time
+	)
+
+	BeforeEach(func() {
+		terminal = &bytes.Buffer{}
+		view = NewView(terminal, nil, 0)
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		view.now = func() time.Time { return now }
+	})
+
+	It("draws nothing until a task starts", func() {
+		_, err := fmt.Fprint(view, "a log line\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(terminal.String()).To(Equal("a log line\n"))
+	})
+
+	It("draws the state and elapsed time of each task", func() {
+		view.TaskStarted("create cluster control plane \"cluster-1\"")
+		view.TaskStarted("create managed nodegroup \"ng-1\"\n    2 sequential sub-tasks: { ... }")
+		now = now.Add(90 * time.Second)
+		view.TaskCompleted("create cluster control plane \"cluster-1\"", nil)
+		view.StackStatus("eksctl-cluster-1-cluster", "CREATE_COMPLETE")
+		now = now.Add(30 * time.Second)
+		view.TaskCompleted("create managed nodegroup \"ng-1\"\n    2 sequential sub-tasks: { ... }", errors.New("failed"))
+
+		terminal.Reset()
+		view.update(func() {})
+		Expect(terminal.String()).To(Equal("\x1b[3A\x1b[J" +
+			"  ✔ create cluster control plane \"cluster-1\" (1m30s)\n" +
+			"  ✖ create managed nodegroup \"ng-1\" (2m0s)\n" +
+			"    stack eksctl-cluster-1-cluster: CREATE_COMPLETE\n"))
+	})
+
+	It("updates the elapsed time of running tasks and the status of stacks in place", func() {
+		view.TaskStarted("create cluster control plane \"cluster-1\"")
+		view.StackStatus("eksctl-cluster-1-cluster", "CREATE_IN_PROGRESS")
+		now = now.Add(5 * time.Second)
+		view.StackStatus("eksctl-cluster-1-cluster", "CREATE_COMPLETE")
+
+		terminal.Reset()
+		view.update(func() {})
+		Expect(terminal.String()).To(Equal("\x1b[2A\x1b[J" +
+			"  … create cluster control plane \"cluster-1\" (5s)\n" +
+			"    stack eksctl-cluster-1-cluster: CREATE_COMPLETE\n"))
+	})
+
+	It("prints the logs above the tasks", func() {
+		view.TaskStarted("create cluster control plane \"cluster-1\"")
+		terminal.Reset()
+		_, err := fmt.Fprint(view, "a log line\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(terminal.String()).To(Equal("\x1b[1A\x1b[J" +
+			"a log line\n" +
+			"  … create cluster control plane \"cluster-1\" (0s)\n"))
+	})
+
+	It("writes the logs to a separate writer", func() {
+		logs := &bytes.Buffer{}
+		view = NewView(terminal, logs, 0)
+		_, err := fmt.Fprint(view, "a log line\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(logs.String()).To(Equal("a log line\n"))
+		Expect(terminal.String()).To(BeEmpty())
+	})
+
+	It("truncates the lines to the width of the terminal", func() {
+		view = NewView(terminal, nil, 20)
+		view.now = func() time.Time { return now }
+		view.TaskStarted("create cluster control plane \"cluster-1\"")
+		Expect(terminal.String()).To(Equal("  … create cluster…\n"))
+	})
+})
+
+var _ = Describe("Listeners", func() {
+	It("notifies the listeners until they are removed", func() {
+		terminal := &bytes.Buffer{}
+		view := NewView(terminal, nil, 0)
+		remove := AddListener(view)
+		TaskStarted("task-1")
+		remove()
+		TaskStarted("task-2")
+		Expect(view.tasks).To(HaveLen(1))
+		Expect(view.tasks[0].description).To(Equal("task-1"))
+	})
+})
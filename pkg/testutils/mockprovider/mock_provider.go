@@ -61,6 +61,11 @@ type MockProvider struct {
 	iam          *mocksv2.IAM
 	ec2          *mocksv2.EC2
 	outposts     *mocksv2.Outposts
+	route53      *mocksv2.Route53
+	kms          *mocksv2.KMS
+	quotas       *mocksv2.ServiceQuotas
+	pricing      *mocksv2.Pricing
+	s3           *mocksv2.S3
 }
 
 // NewMockProvider returns a new MockProvider
@@ -83,6 +88,11 @@ func NewMockProvider() *MockProvider {
 		iam:          &mocksv2.IAM{},
 		ec2:          &mocksv2.EC2{},
 		outposts:     &mocksv2.Outposts{},
+		route53:      &mocksv2.Route53{},
+		kms:          &mocksv2.KMS{},
+		quotas:       &mocksv2.ServiceQuotas{},
+		pricing:      &mocksv2.Pricing{},
+		s3:           &mocksv2.S3{},
 	}
 }
 
@@ -193,9 +203,52 @@ func (m MockProvider) MockOutposts() *mocksv2.Outposts {
 	return m.outposts
 }
 
+// Route53 returns a representation of the Route53 API
+func (m MockProvider) Route53() awsapi.Route53 { return m.route53 }
+
+// MockRoute53 returns a mocked Route53 API
+func (m MockProvider) MockRoute53() *mocksv2.Route53 {
+	return m.route53
+}
+
+// KMS returns a representation of the KMS API
+func (m MockProvider) KMS() awsapi.KMS { return m.kms }
+
+// MockKMS returns a mocked KMS API
+func (m MockProvider) MockKMS() *mocksv2.KMS {
+	return m.kms
+}
+
+// ServiceQuotas returns a representation of the Service Quotas API
+func (m MockProvider) ServiceQuotas() awsapi.ServiceQuotas { return m.quotas }
+
+// MockServiceQuotas returns a mocked Service Quotas API
+func (m MockProvider) MockServiceQuotas() *mocksv2.ServiceQuotas {
+	return m.quotas
+}
+
+// Pricing returns a representation of the Price List API
+func (m MockProvider) Pricing() awsapi.Pricing { return m.pricing }
+
+// MockPricing returns a mocked Price List API
+func (m MockProvider) MockPricing() *mocksv2.Pricing {
+	return m.pricing
+}
+
+// S3 returns a representation of the S3 API
+func (m MockProvider) S3() awsapi.S3 { return m.s3 }
+
+// MockS3 returns a mocked S3 API
+func (m MockProvider) MockS3() *mocksv2.S3 {
+	return m.s3
+}
+
 // Profile returns current profile setting
 func (m MockProvider) Profile() api.Profile { return ProviderConfig.Profile }
 
+// RoleSession returns the session of the roles eksctl assumes
+func (m MockProvider) RoleSession() api.AWSRoleSessionConfig { return ProviderConfig.RoleSession }
+
 // Region returns current region setting
 func (m MockProvider) Region() string {
 	if m.region != "" {
@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddAWSMiddleware adds a middleware to an AWS SDK client that records a span for each API call,
+// it is meant to be added to the APIOptions of the client
+func AddAWSMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("eksctl/Tracing", func(
+		ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		service, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+		ctx, span := Start(ctx, fmt.Sprintf("%s.%s", service, operation),
+			attribute.String("aws.service", service),
+			attribute.String("aws.operation", operation),
+		)
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+			span.SetAttributes(attribute.String("aws.request_id", requestID))
+		}
+		End(span, err)
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// WrapTransport wraps the transport of a Kubernetes client to record a span for each request
+func WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		ctx, span := Start(req.Context(), fmt.Sprintf("Kubernetes %s", req.Method),
+			attribute.String("http.method", req.Method),
+			attribute.String("http.target", req.URL.Path),
+		)
+		resp, err := rt.RoundTrip(req.WithContext(ctx))
+		spanErr := err
+		if err == nil {
+			span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+			if resp.StatusCode >= http.StatusBadRequest {
+				spanErr = fmt.Errorf("%s %s: %s", req.Method, req.URL.Path, resp.Status)
+			}
+		}
+		End(span, spanErr)
+		return resp, err
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// taskSpans is a progress.Listener recording a span for each task
+type taskSpans struct {
+	mu    sync.Mutex
+	tasks map[string][]trace.Span
+}
+
+func newTaskSpans() *taskSpans {
+	return &taskSpans{
+		tasks: map[string][]trace.Span{},
+	}
+}
+
+func (t *taskSpans) TaskStarted(description string) {
+	description = firstLine(description)
+	_, span := Start(context.Background(), description, attribute.String("eksctl.task", description))
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tasks[description] = append(t.tasks[description], span)
+}
+
+func (t *taskSpans) TaskCompleted(description string, err error) {
+	description = firstLine(description)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	spans := t.tasks[description]
+	if len(spans) == 0 {
+		return
+	}
+	End(spans[0], err)
+	t.tasks[description] = spans[1:]
+}
+
+func (t *taskSpans) StackStatus(_, _ string) {}
+
+func (t *taskSpans) ResourceCreated(_, _, _ string) {}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "\n"); i != -1 {
+		return s[:i]
+	}
+	return s
+}
@@ -0,0 +1,119 @@
+// Package tracing records OpenTelemetry traces of the commands, with spans for their tasks, the CloudFormation
+// stacks they wait for and the AWS and Kubernetes API calls they make. The traces are exported with OTLP over
+// HTTP when OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/weaveworks/eksctl/pkg/progress"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+const tracerName = "github.com/weaveworks/eksctl"
+
+var (
+	mu sync.RWMutex
+	// root is the span of the command, it is the parent of the spans started without a span in their context,
+	// as most of the tasks are not given the context of the command
+	root trace.Span
+)
+
+// Setup exports the traces to the OTLP endpoint set in the environment, if any. It returns a function that
+// flushes the spans that have not been exported yet and stops exporting them
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	if endpoint == "" {
+		endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	}
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, exporterOptions(endpoint)...)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String("eksctl"),
+			semconv.ServiceVersionKey.String(version.GetVersion()),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	removeListener := progress.AddListener(newTaskSpans())
+
+	return func(ctx context.Context) error {
+		removeListener()
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+// exporterOptions returns the options of the OTLP exporter for endpoint, which is either a host and port or a
+// URL, in which case an http scheme disables TLS
+func exporterOptions(endpoint string) []otlptracehttp.Option {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
+	}
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(u.Host)}
+	if u.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if path := strings.TrimSuffix(u.Path, "/"); path != "" {
+		opts = append(opts, otlptracehttp.WithURLPath(path))
+	}
+	return opts
+}
+
+// StartCommand starts the span of a command, it returns a function that ends it with the error returned
+// by the command
+func StartCommand(name string) func(err error) {
+	_, span := otel.Tracer(tracerName).Start(context.Background(), name)
+	mu.Lock()
+	root = span
+	mu.Unlock()
+	return func(err error) {
+		mu.Lock()
+		root = nil
+		mu.Unlock()
+		End(span, err)
+	}
+}
+
+// Start starts a span as a child of the span in ctx, or of the span of the command if ctx has none
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		mu.RLock()
+		if root != nil {
+			ctx = trace.ContextWithSpan(ctx, root)
+		}
+		mu.RUnlock()
+	}
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End ends span, recording err if it is not nil
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
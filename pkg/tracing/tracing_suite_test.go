@@ -0,0 +1,11 @@
+package tracing
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestTracing(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
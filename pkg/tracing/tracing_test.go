@@ -0,0 +1,100 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var _ = Describe("Tracing", func() {
+	var exporter *tracetest.InMemoryExporter
+
+	BeforeEach(func() {
+		exporter = tracetest.NewInMemoryExporter()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		previous := otel.GetTracerProvider()
+		otel.SetTracerProvider(provider)
+		DeferCleanup(func() {
+			otel.SetTracerProvider(previous)
+		})
+	})
+
+	spanNamed := func(name string) tracetest.SpanStub {
+		for _, s := range exporter.GetSpans() {
+			if s.Name == name {
+				return s
+			}
+		}
+		Fail("no span named " + name)
+		return tracetest.SpanStub{}
+	}
+
+	It("parents the spans started without a span in their context to the span of the command", func() {
+		endCommand := StartCommand("eksctl create cluster")
+		_, span := Start(context.Background(), "task")
+		End(span, errors.New("failed"))
+		endCommand(nil)
+
+		command, task := spanNamed("eksctl create cluster"), spanNamed("task")
+		Expect(task.Parent.SpanID()).To(Equal(command.SpanContext.SpanID()))
+		Expect(task.Status.Code).To(Equal(codes.Error))
+		Expect(task.Status.Description).To(Equal("failed"))
+	})
+
+	It("keeps the parent of spans started with a span in their context", func() {
+		endCommand := StartCommand("eksctl create cluster")
+		ctx, parent := Start(context.Background(), "parent")
+		_, child := Start(ctx, "child")
+		child.End()
+		parent.End()
+		endCommand(nil)
+
+		Expect(spanNamed("child").Parent.SpanID()).To(Equal(spanNamed("parent").SpanContext.SpanID()))
+	})
+
+	It("records a span for each task", func() {
+		tasks := newTaskSpans()
+		tasks.TaskStarted("create cluster control plane \"cluster-1\"\n    2 sequential sub-tasks: { ... }")
+		tasks.TaskStarted("create managed nodegroup \"ng-1\"")
+		tasks.TaskCompleted("create managed nodegroup \"ng-1\"", errors.New("failed"))
+		tasks.TaskCompleted("create cluster control plane \"cluster-1\"\n    2 sequential sub-tasks: { ... }", nil)
+
+		Expect(exporter.GetSpans()).To(HaveLen(2))
+		Expect(spanNamed("create cluster control plane \"cluster-1\"").Status.Code).To(Equal(codes.Unset))
+		Expect(spanNamed("create managed nodegroup \"ng-1\"").Status.Code).To(Equal(codes.Error))
+	})
+
+	It("records a span for each Kubernetes request", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: WrapTransport(http.DefaultTransport)}
+		resp, err := client.Get(server.URL + "/api/v1/namespaces/kube-system")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		Expect(resp.Body.Close()).To(Succeed())
+
+		span := spanNamed("Kubernetes GET")
+		Expect(span.Status.Code).To(Equal(codes.Error))
+		Expect(span.Attributes).To(ContainElement(HaveField("Key", BeEquivalentTo("http.target"))))
+	})
+
+	DescribeTable("exporterOptions", func(endpoint string, expectedOptions int) {
+		Expect(exporterOptions(endpoint)).To(HaveLen(expectedOptions))
+	},
+		Entry("a host and port", "collector:4318", 1),
+		Entry("an HTTPS URL", "https://collector:4318", 1),
+		Entry("an HTTP URL", "http://collector:4318", 2),
+		Entry("a URL with a path", "http://collector:4318/custom/traces", 3),
+	)
+})
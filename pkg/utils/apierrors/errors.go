@@ -74,3 +74,19 @@ func IsAccessDeniedError(err error) bool {
 func isAccessDeniedErrorCode(apiErr smithy.APIError) bool {
 	return apiErr.ErrorCode() == "AccessDenied" || apiErr.ErrorCode() == "AccessDeniedException"
 }
+
+// IsAuthError reports whether err is caused by missing, invalid or expired AWS credentials
+func IsAuthError(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && isAuthErrorCode(apiErr)
+}
+
+func isAuthErrorCode(apiErr smithy.APIError) bool {
+	switch apiErr.ErrorCode() {
+	case "ExpiredToken", "ExpiredTokenException", "InvalidClientTokenId", "UnrecognizedClientException",
+		"SignatureDoesNotMatch", "AuthFailure", "InvalidSignatureException":
+		return true
+	default:
+		return false
+	}
+}
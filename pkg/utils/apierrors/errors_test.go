@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/weaveworks/eksctl/pkg/testutils"
 	"github.com/weaveworks/eksctl/pkg/utils/apierrors"
 
@@ -58,4 +59,12 @@ var _ = Describe("APIErrors", func() {
 			shouldBeRetried: false,
 		}),
 	)
+
+	DescribeTable("IsAuthError", func(err error, isAuthError bool) {
+		Expect(apierrors.IsAuthError(err)).To(Equal(isAuthError))
+	},
+		Entry("Non API Error", fmt.Errorf("Non API Error"), false),
+		Entry("ExpiredTokenException", &ststypes.ExpiredTokenException{}, true),
+		Entry("AccessDeniedException", &ekstypes.AccessDeniedException{}, false),
+	)
 })
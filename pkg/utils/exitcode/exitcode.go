@@ -0,0 +1,66 @@
+// Package exitcode defines the exit codes of eksctl for each class of failure, so that tools wrapping it
+// can tell them apart without parsing its output
+package exitcode
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weaveworks/eksctl/pkg/utils/apierrors"
+)
+
+// Exit codes of eksctl
+const (
+	// Success is returned when the command succeeded
+	Success = 0
+	// Failure is returned when the command failed for any other reason than those below
+	Failure = 1
+	// Validation is returned when the flags or the config file are invalid
+	Validation = 2
+	// Auth is returned when the AWS credentials are missing, invalid or expired
+	Auth = 3
+	// StackRollback is returned when a CloudFormation stack was rolled back or failed to be created, updated
+	// or deleted
+	StackRollback = 4
+	// Timeout is returned when waiting for an operation to complete timed out
+	Timeout = 5
+)
+
+// Error is an error with the exit code of the class of failure it belongs to
+type Error struct {
+	Code int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Wrap annotates err with the exit code for its class of failure, it returns nil if err is nil
+func Wrap(code int, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Err: err}
+}
+
+// FromError returns the exit code for err, using the code err was annotated with by Wrap if any
+func FromError(err error) int {
+	var exitErr *Error
+	switch {
+	case err == nil:
+		return Success
+	case errors.As(err, &exitErr):
+		return exitErr.Code
+	case apierrors.IsAuthError(err):
+		return Auth
+	case errors.Is(err, context.DeadlineExceeded):
+		return Timeout
+	default:
+		return Failure
+	}
+}
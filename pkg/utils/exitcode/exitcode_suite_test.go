@@ -0,0 +1,11 @@
+package exitcode_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestExitCode(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
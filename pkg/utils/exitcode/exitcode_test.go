@@ -0,0 +1,36 @@
+package exitcode_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/utils/exitcode"
+)
+
+var _ = Describe("Exit codes", func() {
+	DescribeTable("FromError", func(err error, expectedCode int) {
+		Expect(exitcode.FromError(err)).To(Equal(expectedCode))
+	},
+		Entry("no error", nil, exitcode.Success),
+		Entry("an error of no known class", errors.New("failed"), exitcode.Failure),
+		Entry("a wrapped error", fmt.Errorf("creating cluster: %w", exitcode.Wrap(exitcode.StackRollback, errors.New("failed"))), exitcode.StackRollback),
+		Entry("an expired token", fmt.Errorf("describing cluster: %w", &ststypes.ExpiredTokenException{}), exitcode.Auth),
+		Entry("a deadline exceeded", fmt.Errorf("waiting for nodes: %w", context.DeadlineExceeded), exitcode.Timeout),
+	)
+
+	It("does not wrap nil errors", func() {
+		Expect(exitcode.Wrap(exitcode.Validation, nil)).To(BeNil())
+	})
+
+	It("keeps the message of wrapped errors", func() {
+		err := errors.New("invalid version")
+		wrapped := exitcode.Wrap(exitcode.Validation, err)
+		Expect(wrapped).To(MatchError("invalid version"))
+		Expect(errors.Is(wrapped, err)).To(BeTrue())
+	})
+})
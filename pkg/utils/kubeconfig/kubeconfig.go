@@ -26,6 +26,8 @@ const (
 	AWSIAMAuthenticator = "aws-iam-authenticator"
 	// AWSEKSAuthenticator defines the recently added `aws eks get-token` command
 	AWSEKSAuthenticator = "aws"
+	// EksctlAuthenticator defines the `eksctl utils get-token` command
+	EksctlAuthenticator = "eksctl"
 	// AWSIAMAuthenticatorMinimumBetaVersion this is the minimum version at which aws-iam-authenticator uses v1beta1 as APIVersion
 	AWSIAMAuthenticatorMinimumBetaVersion = "0.5.3"
 	// AWSCLIv1MinimumBetaVersion this is the minimum version at which aws-cli v1 uses v1beta1 as APIVersion
@@ -113,6 +115,58 @@ func (cb *ConfigBuilder) UseSystemCA() *ConfigBuilder {
 	return cb
 }
 
+// contextNamePlaceholder matches the placeholders of a context name template, e.g. {cluster}
+var contextNamePlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// ValidateContextNameTemplate returns an error if template has placeholders other than {cluster}, {region},
+// {account} and {user}
+func ValidateContextNameTemplate(template string) error {
+	for _, placeholder := range contextNamePlaceholder.FindAllString(template, -1) {
+		switch placeholder {
+		case "{cluster}", "{region}", "{account}", "{user}":
+		default:
+			return fmt.Errorf("invalid kubeconfig context name %q: unknown placeholder %s, valid placeholders: {cluster}, {region}, {account}, {user}", template, placeholder)
+		}
+	}
+	return nil
+}
+
+// ContextName returns the context name of the cluster described by meta for username, by replacing the
+// placeholders of template
+func ContextName(template string, meta *api.ClusterMeta, username string) (string, error) {
+	if err := ValidateContextNameTemplate(template); err != nil {
+		return "", err
+	}
+	name := strings.NewReplacer(
+		"{cluster}", meta.Name,
+		"{region}", meta.Region,
+		"{account}", meta.AccountID,
+		"{user}", username,
+	).Replace(template)
+	if name == "" {
+		return "", fmt.Errorf("kubeconfig context name %q is empty for cluster %s", template, meta.LogString())
+	}
+	return name, nil
+}
+
+// RenameContext renames the current context of config, and the user it refers to, to name
+func RenameContext(config *clientcmdapi.Config, name string) {
+	current := config.CurrentContext
+	if current == name {
+		return
+	}
+	if context, ok := config.Contexts[current]; ok {
+		delete(config.Contexts, current)
+		if authInfo, ok := config.AuthInfos[context.AuthInfo]; ok && context.AuthInfo == current {
+			delete(config.AuthInfos, current)
+			config.AuthInfos[name] = authInfo
+			context.AuthInfo = name
+		}
+		config.Contexts[name] = context
+	}
+	config.CurrentContext = name
+}
+
 // ClusterInfo holds the cluster info.
 type ClusterInfo interface {
 	// ID returns the cluster ID.
@@ -134,16 +188,69 @@ func NewForUser(cluster ClusterInfo, username string) *clientcmdapi.Config {
 	return configBuilder.Build()
 }
 
-// NewForKubectl creates configuration for a user with kubectl by configuring
-// a suitable authenticator and respecting provider settings
-func NewForKubectl(cluster ClusterInfo, username, roleARN, profile string) *clientcmdapi.Config {
-	config := NewForUser(cluster, username)
+// AuthenticatorOptions configures the exec credential plugin of a kubeconfig
+type AuthenticatorOptions struct {
+	// Command is the authenticator, one of aws-iam-authenticator, aws or eksctl,
+	// the first one of aws-iam-authenticator and aws found is used when empty
+	Command string
+	// RoleARN is the role assumed to get the token
+	RoleARN string
+	// SessionName is the session name of RoleARN
+	SessionName string
+	// Profile is the AWS credentials profile used to get the token
+	Profile string
+	// Cache caches the credentials or the token on disk until they expire
+	Cache bool
+}
+
+// SetDefaults looks up the authenticator if it is not set
+func (o *AuthenticatorOptions) SetDefaults() {
+	if o.Command != "" {
+		return
+	}
 	authenticator, found := LookupAuthenticator()
 	if !found {
 		// fall back to aws-iam-authenticator
 		authenticator = AWSIAMAuthenticator
 	}
-	AppendAuthenticator(config, cluster, authenticator, roleARN, profile)
+	o.Command = authenticator
+}
+
+// Validate returns an error if the authenticator does not support the options
+func (o AuthenticatorOptions) Validate() error {
+	switch o.Command {
+	case AWSIAMAuthenticator, EksctlAuthenticator:
+	case AWSEKSAuthenticator:
+		if o.SessionName != "" {
+			return fmt.Errorf("a role session name is not supported by the %s authenticator", o.Command)
+		}
+		if o.Cache {
+			return fmt.Errorf("caching is not supported by the %s authenticator", o.Command)
+		}
+	default:
+		return fmt.Errorf("invalid authenticator %q, valid options: %s, %s, %s", o.Command, AWSIAMAuthenticator, AWSEKSAuthenticator, EksctlAuthenticator)
+	}
+	if o.SessionName != "" && o.RoleARN == "" {
+		return errors.New("a role session name can only be set with a role ARN")
+	}
+	return nil
+}
+
+// NewForKubectl creates configuration for a user with kubectl by configuring
+// a suitable authenticator and respecting provider settings
+func NewForKubectl(cluster ClusterInfo, username, roleARN, profile string) *clientcmdapi.Config {
+	return NewForKubectlWithOptions(cluster, username, AuthenticatorOptions{
+		RoleARN: roleARN,
+		Profile: profile,
+	})
+}
+
+// NewForKubectlWithOptions creates configuration for a user with kubectl by configuring
+// the authenticator set by options, which must be valid
+func NewForKubectlWithOptions(cluster ClusterInfo, username string, options AuthenticatorOptions) *clientcmdapi.Config {
+	config := NewForUser(cluster, username)
+	options.SetDefaults()
+	AppendAuthenticatorWithOptions(config, cluster, options)
 	return config
 }
 
@@ -151,6 +258,15 @@ func NewForKubectl(cluster ClusterInfo, username, roleARN, profile string) *clie
 // if profile is non-empty string it sets AWS_PROFILE environment
 // variable also
 func AppendAuthenticator(config *clientcmdapi.Config, cluster ClusterInfo, authenticatorCMD, roleARN, profile string) {
+	AppendAuthenticatorWithOptions(config, cluster, AuthenticatorOptions{
+		Command: authenticatorCMD,
+		RoleARN: roleARN,
+		Profile: profile,
+	})
+}
+
+// AppendAuthenticatorWithOptions appends the authenticator set by options
+func AppendAuthenticatorWithOptions(config *clientcmdapi.Config, cluster ClusterInfo, options AuthenticatorOptions) {
 	var (
 		args        []string
 		roleARNFlag string
@@ -158,7 +274,7 @@ func AppendAuthenticator(config *clientcmdapi.Config, cluster ClusterInfo, authe
 
 	execConfig := &clientcmdapi.ExecConfig{
 		APIVersion: alphaAPIVersion,
-		Command:    authenticatorCMD,
+		Command:    options.Command,
 		Env: []clientcmdapi.ExecEnvVar{
 			{
 				Name:  "AWS_STS_REGIONAL_ENDPOINTS",
@@ -170,7 +286,7 @@ func AppendAuthenticator(config *clientcmdapi.Config, cluster ClusterInfo, authe
 
 	meta := cluster.Meta()
 
-	switch authenticatorCMD {
+	switch options.Command {
 	case AWSIAMAuthenticator:
 		// if version is above or equal to v0.5.3 we change the APIVersion to v1beta1.
 		if authenticatorIsBetaVersion, err := authenticatorIsAboveVersion(AWSIAMAuthenticatorMinimumBetaVersion); err != nil {
@@ -186,6 +302,12 @@ func AppendAuthenticator(config *clientcmdapi.Config, cluster ClusterInfo, authe
 				Value: meta.Region,
 			})
 		}
+		if options.SessionName != "" {
+			args = append(args, "--session-name", options.SessionName)
+		}
+		if options.Cache {
+			args = append(args, "--cache")
+		}
 
 	case AWSEKSAuthenticator:
 		// if [aws-cli v1/aws-cli v2] is above or equal to [v1.23.9/v2.6.3] respectively, we change the APIVersion to v1beta1.
@@ -199,6 +321,20 @@ func AppendAuthenticator(config *clientcmdapi.Config, cluster ClusterInfo, authe
 		if meta.Region != "" {
 			args = append(args, "--region", meta.Region)
 		}
+
+	case EksctlAuthenticator:
+		execConfig.APIVersion = betaAPIVersion
+		args = []string{"utils", "get-token", "--cluster", cluster.ID()}
+		roleARNFlag = "--role-arn"
+		if meta.Region != "" {
+			args = append(args, "--region", meta.Region)
+		}
+		if options.SessionName != "" {
+			args = append(args, "--role-session-name", options.SessionName)
+		}
+		if options.Cache {
+			args = append(args, "--cache")
+		}
 	}
 	// If the alpha API version is selected, check the kubectl version
 	// If kubectl 1.24.0 or above is detected, override with the beta API version
@@ -213,16 +349,16 @@ func AppendAuthenticator(config *clientcmdapi.Config, cluster ClusterInfo, authe
 			}
 		}
 	}
-	if roleARN != "" {
-		args = append(args, roleARNFlag, roleARN)
+	if options.RoleARN != "" {
+		args = append(args, roleARNFlag, options.RoleARN)
 	}
 
 	execConfig.Args = args
 
-	if profile != "" {
+	if options.Profile != "" {
 		execConfig.Env = append(execConfig.Env, clientcmdapi.ExecEnvVar{
 			Name:  "AWS_PROFILE",
-			Value: profile,
+			Value: options.Profile,
 		})
 	}
 
@@ -412,6 +548,15 @@ func Write(path string, newConfig clientcmdapi.Config, setContext bool) (string,
 	return configFileName, nil
 }
 
+// Merge returns a Config holding the clusters, contexts and users of configs, without a current context
+func Merge(configs ...*clientcmdapi.Config) *clientcmdapi.Config {
+	merged := clientcmdapi.NewConfig()
+	for _, config := range configs {
+		merge(merged, config)
+	}
+	return merged
+}
+
 func getConfigAccess(explicitPath string) clientcmd.ConfigAccess {
 	pathOptions := clientcmd.NewDefaultPathOptions()
 	if explicitPath != "" && explicitPath != DefaultPath() {
@@ -454,11 +599,15 @@ func isValidConfig(p, name string) error {
 	// as we don't want to delete any files by accident that didn't belong to us
 	ctxFmtErr := fmt.Errorf("unable to verify ownership of config %q, unexpected contex name %q", p, clientConfig.CurrentContext)
 
-	ctx := strings.Split(clientConfig.CurrentContext, "@")
-	if len(ctx) != 2 {
-		return ctxFmtErr
+	isOwned := func(clusterName string) bool {
+		return strings.HasPrefix(clusterName, name+".") && strings.HasSuffix(clusterName, ".eksctl.io")
+	}
+
+	if ctx := strings.Split(clientConfig.CurrentContext, "@"); len(ctx) == 2 && isOwned(ctx[1]) {
+		return nil
 	}
-	if strings.HasPrefix(ctx[1], name+".") && strings.HasSuffix(ctx[1], ".eksctl.io") {
+	// the context may have been named with --kubeconfig-context-name
+	if ctx, ok := clientConfig.Contexts[clientConfig.CurrentContext]; ok && isOwned(ctx.Cluster) {
 		return nil
 	}
 	return ctxFmtErr
@@ -132,6 +132,67 @@ var _ = Describe("Kubeconfig", func() {
 		Expect(readConfig.CurrentContext).To(Equal("test-context"))
 	})
 
+	Describe("context name templates", func() {
+		meta := &eksctlapi.ClusterMeta{
+			Name:      "dev",
+			Region:    "us-west-2",
+			AccountID: "123456789012",
+		}
+
+		DescribeTable("ContextName replaces the placeholders", func(template, expected string) {
+			Expect(kubeconfig.ContextName(template, meta, "alice")).To(Equal(expected))
+		},
+			Entry("cluster and region", "{cluster}-{region}", "dev-us-west-2"),
+			Entry("account", "{account}/{cluster}", "123456789012/dev"),
+			Entry("user", "{user}@{cluster}", "alice@dev"),
+			Entry("no placeholders", "development", "development"),
+		)
+
+		It("rejects unknown placeholders", func() {
+			_, err := kubeconfig.ContextName("{cluster}-{zone}", meta, "alice")
+			Expect(err).To(MatchError(ContainSubstring("unknown placeholder {zone}")))
+		})
+
+		It("rejects empty names", func() {
+			_, err := kubeconfig.ContextName("{account}", &eksctlapi.ClusterMeta{Name: "dev"}, "alice")
+			Expect(err).To(MatchError(ContainSubstring("is empty")))
+		})
+
+		It("renames the current context and its user", func() {
+			clusterConfig := eksctlapi.NewClusterConfig()
+			clusterConfig.Metadata = meta
+			clusterConfig.Status = &eksctlapi.ClusterStatus{Endpoint: "https://dev.eks.amazonaws.com"}
+			config := kubeconfig.NewForUser(clusterConfig, "alice")
+			kubeconfig.RenameContext(config, "dev-us-west-2")
+
+			Expect(config.CurrentContext).To(Equal("dev-us-west-2"))
+			Expect(config.Contexts).To(HaveLen(1))
+			Expect(config.Contexts["dev-us-west-2"].Cluster).To(Equal("dev.us-west-2.eksctl.io"))
+			Expect(config.Contexts["dev-us-west-2"].AuthInfo).To(Equal("dev-us-west-2"))
+			Expect(config.AuthInfos).To(HaveKey("dev-us-west-2"))
+			Expect(config.AuthInfos).To(HaveLen(1))
+		})
+	})
+
+	It("merges the configs of several clusters without a current context", func() {
+		var configs []*api.Config
+		for _, name := range []string{"dev", "prod"} {
+			clusterConfig := eksctlapi.NewClusterConfig()
+			clusterConfig.Metadata.Name = name
+			clusterConfig.Metadata.Region = "us-west-2"
+			clusterConfig.Status = &eksctlapi.ClusterStatus{Endpoint: "https://" + name + ".eks.amazonaws.com"}
+			configs = append(configs, kubeconfig.NewForUser(clusterConfig, "alice"))
+		}
+
+		merged := kubeconfig.Merge(configs...)
+		Expect(merged.CurrentContext).To(BeEmpty())
+		Expect(merged.Clusters).To(HaveKey("dev.us-west-2.eksctl.io"))
+		Expect(merged.Clusters).To(HaveKey("prod.us-west-2.eksctl.io"))
+		Expect(merged.Contexts).To(HaveKey("alice@dev.us-west-2.eksctl.io"))
+		Expect(merged.Contexts).To(HaveKey("alice@prod.us-west-2.eksctl.io"))
+		Expect(merged.AuthInfos).To(HaveLen(2))
+	})
+
 	It("merge does not sets context", func() {
 		err := writeConfig(configFile.Name())
 		Expect(err).To(BeNil())
@@ -460,5 +521,47 @@ var _ = Describe("Kubeconfig", func() {
 			kubeconfig.AppendAuthenticator(config, clusterInfo, kubeconfig.AWSEKSAuthenticator, "", "")
 			Expect(config.AuthInfos["test"].Exec.APIVersion).To(Equal("client.authentication.k8s.io/v1alpha1"))
 		})
+		It("writes the eksctl authenticator with the role and its session name", func() {
+			kubeconfig.AppendAuthenticatorWithOptions(config, clusterInfo, kubeconfig.AuthenticatorOptions{
+				Command:     kubeconfig.EksctlAuthenticator,
+				RoleARN:     "arn:aws:iam::123456789012:role/admin",
+				SessionName: "alice",
+				Profile:     "dev",
+				Cache:       true,
+			})
+			exec := config.AuthInfos["test"].Exec
+			Expect(exec.Command).To(Equal("eksctl"))
+			Expect(exec.APIVersion).To(Equal("client.authentication.k8s.io/v1beta1"))
+			Expect(exec.Args).To(Equal([]string{"utils", "get-token", "--cluster", "name", "--region", "us-west-2", "--role-session-name", "alice", "--cache", "--role-arn", "arn:aws:iam::123456789012:role/admin"}))
+			Expect(exec.Env).To(ContainElement(clientcmdapi.ExecEnvVar{Name: "AWS_PROFILE", Value: "dev"}))
+		})
+		It("writes the session name and cache options of aws-iam-authenticator", func() {
+			kubeconfig.SetExecCommand(func(name string, arg ...string) *exec.Cmd {
+				return exec.Command(filepath.Join("testdata", "fake-version"), `{"Version":"0.5.5","Commit":"85e50980d9d916ae95882176c18f14ae145f916f"}`)
+			})
+			kubeconfig.AppendAuthenticatorWithOptions(config, clusterInfo, kubeconfig.AuthenticatorOptions{
+				Command:     kubeconfig.AWSIAMAuthenticator,
+				RoleARN:     "arn:aws:iam::123456789012:role/admin",
+				SessionName: "alice",
+				Cache:       true,
+			})
+			Expect(config.AuthInfos["test"].Exec.Args).To(Equal([]string{"token", "-i", "name", "--session-name", "alice", "--cache", "-r", "arn:aws:iam::123456789012:role/admin"}))
+		})
 	})
+
+	DescribeTable("AuthenticatorOptions.Validate", func(options kubeconfig.AuthenticatorOptions, expectedErr string) {
+		err := options.Validate()
+		if expectedErr == "" {
+			Expect(err).NotTo(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		}
+	},
+		Entry("aws-iam-authenticator with every option", kubeconfig.AuthenticatorOptions{Command: kubeconfig.AWSIAMAuthenticator, RoleARN: "arn", SessionName: "alice", Cache: true}, ""),
+		Entry("eksctl with every option", kubeconfig.AuthenticatorOptions{Command: kubeconfig.EksctlAuthenticator, RoleARN: "arn", SessionName: "alice", Cache: true}, ""),
+		Entry("unknown authenticator", kubeconfig.AuthenticatorOptions{Command: "kubelogin"}, `invalid authenticator "kubelogin"`),
+		Entry("aws with a role session name", kubeconfig.AuthenticatorOptions{Command: kubeconfig.AWSEKSAuthenticator, RoleARN: "arn", SessionName: "alice"}, "not supported by the aws authenticator"),
+		Entry("aws with a cache", kubeconfig.AuthenticatorOptions{Command: kubeconfig.AWSEKSAuthenticator, Cache: true}, "caching is not supported"),
+		Entry("a role session name without a role", kubeconfig.AuthenticatorOptions{Command: kubeconfig.AWSIAMAuthenticator, SessionName: "alice"}, "only be set with a role ARN"),
+	)
 })
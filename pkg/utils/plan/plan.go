@@ -0,0 +1,30 @@
+package plan
+
+import (
+	"github.com/kris-nova/logger"
+)
+
+// LogIntendedAction calls logger.Info with appropriate prefix
+func LogIntendedAction(plan bool, msgFmt string, args ...interface{}) {
+	prefix := "will "
+	if plan {
+		prefix = "(plan) would "
+	}
+	logger.Info(prefix+msgFmt, args...)
+}
+
+// LogCompletedAction calls logger.Success with appropriate prefix
+func LogCompletedAction(plan bool, msgFmt string, args ...interface{}) {
+	prefix := ""
+	if plan {
+		prefix = "(plan) would have "
+	}
+	logger.Success(prefix+msgFmt, args...)
+}
+
+// LogPlanModeWarning will log a message to inform user that they are in plan-mode
+func LogPlanModeWarning(plan bool) {
+	if plan {
+		logger.Warning("no changes were applied, run again with '--approve' to apply the changes")
+	}
+}
@@ -0,0 +1,58 @@
+// Package proxy holds the HTTP(S) proxy of the clients of eksctl, set with the HTTPS_PROXY, HTTP_PROXY and NO_PROXY
+// environment variables or the --proxy-url flag
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+var (
+	mu        sync.RWMutex
+	proxyFunc = httpproxy.FromEnvironment().ProxyFunc()
+)
+
+// Set sets the URL of the proxy of the HTTP and HTTPS requests, overriding HTTPS_PROXY and HTTP_PROXY, while
+// NO_PROXY still applies. The commands run by eksctl, e.g. hooks and kubectl, are given it in their environment
+func Set(proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid proxy URL %q", proxyURL)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("invalid proxy URL %q: the scheme must be one of http, https, socks5", proxyURL)
+	}
+
+	for _, name := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy"} {
+		if err := os.Setenv(name, proxyURL); err != nil {
+			return err
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	proxyFunc = httpproxy.FromEnvironment().ProxyFunc()
+	return nil
+}
+
+// FromEnvironment returns the URL of the proxy of req, or nil if it is not proxied. It is meant to be the Proxy
+// of the transports of the HTTP clients and, unlike http.ProxyFromEnvironment, it takes into account the proxy
+// set after the first request was made
+func FromEnvironment(req *http.Request) (*url.URL, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	return proxyFunc(req.URL)
+}
+
+// NewTransport returns a transport with the defaults of http.DefaultTransport, proxying the requests
+func NewTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = FromEnvironment
+	return transport
+}
@@ -0,0 +1,11 @@
+package proxy_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestProxy(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,61 @@
+package proxy_test
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/utils/proxy"
+)
+
+var _ = Describe("proxy", func() {
+	BeforeEach(func() {
+		for _, name := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy", "NO_PROXY", "no_proxy"} {
+			if val, ok := os.LookupEnv(name); ok {
+				DeferCleanup(os.Setenv, name, val)
+			} else {
+				DeferCleanup(os.Unsetenv, name)
+			}
+			Expect(os.Unsetenv(name)).To(Succeed())
+		}
+	})
+
+	proxyOf := func(rawURL string) *url.URL {
+		req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+		Expect(err).NotTo(HaveOccurred())
+		proxyURL, err := proxy.FromEnvironment(req)
+		Expect(err).NotTo(HaveOccurred())
+		return proxyURL
+	}
+
+	It("proxies the requests through the proxy that is set, except for NO_PROXY", func() {
+		Expect(os.Setenv("NO_PROXY", "169.254.169.254,.internal.example.com,10.0.0.0/8")).To(Succeed())
+		Expect(proxy.Set("http://proxy.example.com:3128")).To(Succeed())
+
+		Expect(proxyOf("https://eks.us-west-2.amazonaws.com/clusters")).To(Equal(&url.URL{Scheme: "http", Host: "proxy.example.com:3128"}))
+		Expect(proxyOf("http://oidc.example.com/.well-known/openid-configuration")).To(Equal(&url.URL{Scheme: "http", Host: "proxy.example.com:3128"}))
+		Expect(proxyOf("https://api.internal.example.com")).To(BeNil())
+		Expect(proxyOf("https://10.1.2.3")).To(BeNil())
+		Expect(proxyOf("http://169.254.169.254/latest/meta-data")).To(BeNil())
+
+		Expect(os.Getenv("HTTPS_PROXY")).To(Equal("http://proxy.example.com:3128"))
+		Expect(os.Getenv("http_proxy")).To(Equal("http://proxy.example.com:3128"))
+	})
+
+	It("uses the proxy of the transports it returns", func() {
+		Expect(proxy.Set("socks5://localhost:1080")).To(Succeed())
+		req, err := http.NewRequest(http.MethodGet, "https://sts.amazonaws.com", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proxy.NewTransport().Proxy(req)).To(Equal(&url.URL{Scheme: "socks5", Host: "localhost:1080"}))
+	})
+
+	DescribeTable("rejects an invalid proxy URL", func(proxyURL, expectedErr string) {
+		Expect(proxy.Set(proxyURL)).To(MatchError(expectedErr))
+	},
+		Entry("no scheme", "proxy.example.com:3128", `invalid proxy URL "proxy.example.com:3128"`),
+		Entry("an unsupported scheme", "ftp://proxy.example.com", `invalid proxy URL "ftp://proxy.example.com": the scheme must be one of http, https, socks5`),
+	)
+})
@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/progress"
 )
 
 // Task is a common interface for the stack manager tasks
@@ -185,12 +187,16 @@ func (t *TaskTree) DoAllSync() []error {
 func doSingleTask(allErrs chan error, task Task) bool {
 	desc := task.Describe()
 	logger.Debug("started task: %s", desc)
-	errs := make(chan error)
-	if err := task.Do(errs); err != nil {
-		allErrs <- err
-		return false
+	// the progress of a task tree is that of its tasks
+	_, isTree := task.(*TaskTree)
+	if !isTree {
+		progress.TaskStarted(desc)
+	}
+	err := doTask(task)
+	if !isTree {
+		progress.TaskCompleted(desc, err)
 	}
-	if err := <-errs; err != nil {
+	if err != nil {
 		allErrs <- err
 		return false
 	}
@@ -198,6 +204,14 @@ func doSingleTask(allErrs chan error, task Task) bool {
 	return true
 }
 
+func doTask(task Task) error {
+	errs := make(chan error)
+	if err := task.Do(errs); err != nil {
+		return err
+	}
+	return <-errs
+}
+
 func doParallelTasks(allErrs chan error, tasks []Task) {
 	wg := &sync.WaitGroup{}
 	wg.Add(len(tasks))
@@ -0,0 +1,66 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/awsapi"
+)
+
+// ValidateExistingEndpoints ensures that the pre-existing VPC of a fully-private cluster has VPC endpoints
+// for all services the cluster requires, as eksctl does not create them when privateCluster.skipEndpointCreation is set
+func ValidateExistingEndpoints(ctx context.Context, ec2API awsapi.EC2, spec *api.ClusterConfig, region string) error {
+	additionalServices, err := api.MapOptionalEndpointServices(spec.PrivateCluster.AdditionalEndpointServices, spec.HasClusterCloudWatchLogging())
+	if err != nil {
+		return err
+	}
+	requiredServices := append(api.RequiredEndpointServices(spec.IsControlPlaneOnOutposts()), additionalServices...)
+
+	existing, err := describeVPCEndpointServiceNames(ctx, ec2API, spec.VPC.ID)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, es := range requiredServices {
+		if !existing[es.ServiceName(region)] {
+			missing = append(missing, es.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("VPC %q is missing endpoints for the following services required by a fully-private cluster: %s; "+
+			"either create them or unset privateCluster.skipEndpointCreation", spec.VPC.ID, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func describeVPCEndpointServiceNames(ctx context.Context, ec2API awsapi.EC2, vpcID string) (map[string]bool, error) {
+	serviceNames := map[string]bool{}
+	paginator := ec2.NewDescribeVpcEndpointsPaginator(ec2API, &ec2.DescribeVpcEndpointsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{vpcID},
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error describing VPC endpoints for VPC %q: %w", vpcID, err)
+		}
+		for _, endpoint := range output.VpcEndpoints {
+			switch endpoint.State {
+			case ec2types.StateAvailable, ec2types.StatePending, ec2types.StatePendingAcceptance:
+				serviceNames[aws.ToString(endpoint.ServiceName)] = true
+			}
+		}
+	}
+	return serviceNames, nil
+}
@@ -0,0 +1,68 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ValidateExistingEndpoints", func() {
+	type endpointsCase struct {
+		existingServices []string
+		cloudWatchLogs   bool
+		expectedErr      string
+	}
+
+	DescribeTable("checks endpoints in a pre-existing VPC", func(e endpointsCase) {
+		p := mockprovider.NewMockProvider()
+		var endpoints []ec2types.VpcEndpoint
+		for _, service := range e.existingServices {
+			endpoints = append(endpoints, ec2types.VpcEndpoint{
+				ServiceName: aws.String("com.amazonaws.us-west-2." + service),
+				State:       ec2types.StateAvailable,
+			})
+		}
+		p.MockEC2().On("DescribeVpcEndpoints", Anything, MatchedBy(func(input *ec2.DescribeVpcEndpointsInput) bool {
+			return len(input.Filters) == 1 && input.Filters[0].Values[0] == "vpc-1234"
+		}), Anything).Return(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: endpoints}, nil)
+
+		clusterConfig := api.NewClusterConfig()
+		clusterConfig.VPC.ID = "vpc-1234"
+		clusterConfig.PrivateCluster = &api.PrivateCluster{
+			Enabled:              true,
+			SkipEndpointCreation: true,
+		}
+		if e.cloudWatchLogs {
+			clusterConfig.CloudWatch.ClusterLogging.EnableTypes = []string{"api"}
+		}
+
+		err := ValidateExistingEndpoints(context.Background(), p.EC2(), clusterConfig, "us-west-2")
+		if e.expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(e.expectedErr)))
+			return
+		}
+		Expect(err).NotTo(HaveOccurred())
+	},
+		Entry("all required endpoints exist", endpointsCase{
+			existingServices: []string{"ec2", "ecr.api", "ecr.dkr", "s3", "sts"},
+		}),
+		Entry("required endpoints are missing", endpointsCase{
+			existingServices: []string{"ec2", "s3"},
+			expectedErr:      `VPC "vpc-1234" is missing endpoints for the following services required by a fully-private cluster: ecr.api, ecr.dkr, sts`,
+		}),
+		Entry("CloudWatch logging is enabled without a logs endpoint", endpointsCase{
+			existingServices: []string{"ec2", "ecr.api", "ecr.dkr", "s3", "sts"},
+			cloudWatchLogs:   true,
+			expectedErr:      "required by a fully-private cluster: logs",
+		}),
+	)
+})
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -84,6 +85,42 @@ func SetSubnets(vpc *api.ClusterVPC, availabilityZones, localZones []string) err
 	return nil
 }
 
+// UseSubnetCIDRPlan uses the subnet CIDRs declared in vpc.subnets for the VPC created by eksctl,
+// instead of splitting the VPC CIDR, and sets the availability zones to the zones of those subnets.
+func UseSubnetCIDRPlan(spec *api.ClusterConfig) error {
+	if err := validateVPCCIDR(spec.VPC); err != nil {
+		return err
+	}
+
+	var zones []string
+	for zone := range spec.VPC.Subnets.Private {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	for i, zone := range zones {
+		publicCIDRIndex := i
+		privateCIDRIndex := publicCIDRIndex + len(zones)
+
+		private := spec.VPC.Subnets.Private[zone]
+		private.AZ = zone
+		private.CIDRIndex = privateCIDRIndex
+		spec.VPC.Subnets.Private[zone] = private
+
+		if public, ok := spec.VPC.Subnets.Public[zone]; ok {
+			public.AZ = zone
+			public.CIDRIndex = publicCIDRIndex
+			spec.VPC.Subnets.Public[zone] = public
+			logger.Info("subnets for %s - public:%s private:%s", zone, public.CIDR, private.CIDR)
+		} else {
+			logger.Info("subnets for %s - private:%s", zone, private.CIDR)
+		}
+	}
+
+	spec.AvailabilityZones = zones
+	return nil
+}
+
 // A SubnetPair represents a pair of public and private subnets.
 type SubnetPair struct {
 	Public  []api.AZSubnetSpec
@@ -537,7 +574,7 @@ func ValidateLegacySubnetsForNodeGroups(ctx context.Context, spec *api.ClusterCo
 			return err
 		}
 	}
-	if err := ValidateExistingPublicSubnets(ctx, provider, spec.VPC.ID, subnetsToValidate.List()); err != nil {
+	if err := ValidateExistingPublicSubnets(ctx, provider, spec.VPC.ID, spec.Metadata.AccountID, subnetsToValidate.List()); err != nil {
 		// If the cluster endpoint is reachable from the VPC, nodes might still be able to join
 		if spec.HasPrivateEndpointAccess() {
 			logger.Warning("public subnets for one or more nodegroups have %q disabled. This means that nodes won't "+
@@ -555,7 +592,7 @@ func ValidateLegacySubnetsForNodeGroups(ctx context.Context, spec *api.ClusterCo
 }
 
 // ValidateExistingPublicSubnets makes sure that subnets have the property MapPublicIpOnLaunch enabled
-func ValidateExistingPublicSubnets(ctx context.Context, provider api.ClusterProvider, vpcID string, subnetIDs []string) error {
+func ValidateExistingPublicSubnets(ctx context.Context, provider api.ClusterProvider, vpcID, accountID string, subnetIDs []string) error {
 	if len(subnetIDs) == 0 {
 		return nil
 	}
@@ -563,7 +600,43 @@ func ValidateExistingPublicSubnets(ctx context.Context, provider api.ClusterProv
 	if err != nil {
 		return err
 	}
-	return validatePublicSubnet(subnets)
+	return validatePublicSubnet(subnets, accountID)
+}
+
+// ValidateControlPlaneSubnets makes sure that subnets are in the cluster's VPC and span enough availability zones
+// for the control plane
+func ValidateControlPlaneSubnets(ctx context.Context, ec2API awsapi.EC2, vpcID string, subnetIDs []string) error {
+	subnets, err := describeSubnets(ctx, ec2API, vpcID, subnetIDs, nil, nil)
+	if err != nil {
+		return err
+	}
+	azs := sets.NewString()
+	for _, sn := range subnets {
+		if *sn.VpcId != vpcID {
+			return fmt.Errorf("subnet %s is in %s, not in the cluster's VPC %s", *sn.SubnetId, *sn.VpcId, vpcID)
+		}
+		azs.Insert(*sn.AvailabilityZone)
+	}
+	if azs.Len() < api.MinRequiredAvailabilityZones {
+		return fmt.Errorf("control plane subnets must be in at least %d availability zones, found %v", api.MinRequiredAvailabilityZones, azs.List())
+	}
+	return nil
+}
+
+// ValidateControlPlaneSecurityGroups makes sure that security groups are in the cluster's VPC
+func ValidateControlPlaneSecurityGroups(ctx context.Context, ec2API awsapi.EC2, vpcID string, securityGroupIDs []string) error {
+	output, err := ec2API.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: securityGroupIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("error describing security groups: %w", err)
+	}
+	for _, sg := range output.SecurityGroups {
+		if *sg.VpcId != vpcID {
+			return fmt.Errorf("security group %s is in %s, not in the cluster's VPC %s", *sg.GroupId, *sg.VpcId, vpcID)
+		}
+	}
+	return nil
 }
 
 // EnsureMapPublicIPOnLaunchEnabled will enable MapPublicIpOnLaunch in EC2 for all given subnet IDs
@@ -649,13 +722,25 @@ func cleanupSubnets(spec *api.ClusterConfig) {
 	cleanup(&spec.VPC.Subnets.Public)
 }
 
-func validatePublicSubnet(subnets []ec2types.Subnet) error {
-	legacySubnets := make([]string, 0)
+func validatePublicSubnet(subnets []ec2types.Subnet, accountID string) error {
+	var (
+		legacySubnets = make([]string, 0)
+		sharedSubnets []string
+	)
 	for _, sn := range subnets {
 		if sn.MapPublicIpOnLaunch == nil || !*sn.MapPublicIpOnLaunch {
-			legacySubnets = append(legacySubnets, *sn.SubnetId)
+			if isSharedSubnet(sn, accountID) {
+				sharedSubnets = append(sharedSubnets, fmt.Sprintf("%s (owner: %s)", *sn.SubnetId, *sn.OwnerId))
+			} else {
+				legacySubnets = append(legacySubnets, *sn.SubnetId)
+			}
 		}
 	}
+	if len(sharedSubnets) > 0 {
+		return fmt.Errorf("found subnets %q shared via AWS RAM without property \"MapPublicIpOnLaunch\" enabled. "+
+			"eksctl cannot modify subnets owned by another account, the subnet owner must enable it. "+
+			"Without it new nodes won't get an IP assigned", sharedSubnets)
+	}
 	if len(legacySubnets) > 0 {
 		return fmt.Errorf("found mis-configured or non-public subnets %q. Expected public subnets with property "+
 			"\"MapPublicIpOnLaunch\" enabled. Without it new nodes won't get an IP assigned", legacySubnets)
@@ -664,6 +749,43 @@ func validatePublicSubnet(subnets []ec2types.Subnet) error {
 	return nil
 }
 
+func isSharedSubnet(subnet ec2types.Subnet, accountID string) bool {
+	return subnet.OwnerId != nil && accountID != "" && *subnet.OwnerId != accountID
+}
+
+// ValidateSharedSubnets checks that the cluster can be created in subnets shared from another account via AWS RAM.
+// Participants of a shared VPC cannot modify the subnets, their route tables or their tags, so features that require
+// doing so are rejected.
+func ValidateSharedSubnets(spec *api.ClusterConfig) error {
+	accountID := spec.Metadata.AccountID
+	sharedSubnetIDs := append(spec.VPC.Subnets.Private.SelectSharedSubnetIDs(accountID), spec.VPC.Subnets.Public.SelectSharedSubnetIDs(accountID)...)
+	if len(sharedSubnetIDs) == 0 {
+		return nil
+	}
+
+	owners := sets.NewString()
+	for _, subnets := range []api.AZSubnetMapping{spec.VPC.Subnets.Private, spec.VPC.Subnets.Public} {
+		for _, s := range subnets {
+			if s.OwnerID != "" {
+				owners.Insert(s.OwnerID)
+			}
+		}
+	}
+	if owners.Len() > 1 {
+		return fmt.Errorf("subnets must all be owned by the same account, found subnets owned by accounts %v", owners.List())
+	}
+	ownerID := owners.List()[0]
+
+	if spec.IsFullyPrivate() && !spec.PrivateCluster.SkipEndpointCreation {
+		return fmt.Errorf("privateCluster: eksctl cannot create VPC endpoints for subnets %v shared from account %s, "+
+			"as it requires modifying route tables owned by that account; create the endpoints from account %s "+
+			"and set privateCluster.skipEndpointCreation", sharedSubnetIDs, ownerID, ownerID)
+	}
+
+	logger.Info("subnets %v are shared from account %s via AWS RAM; eksctl will not tag or modify them", sharedSubnetIDs, ownerID)
+	return nil
+}
+
 // getSubnetByID returns a subnet based on an ID.
 func getSubnetByID(ctx context.Context, ec2API awsapi.EC2, id string) (ec2types.Subnet, error) {
 	input := &ec2.DescribeSubnetsInput{
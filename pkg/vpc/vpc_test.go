@@ -414,6 +414,36 @@ var _ = Describe("VPC", func() {
 		}),
 	)
 
+	Describe("UseSubnetCIDRPlan", func() {
+		It("should use the declared subnet CIDRs and set the availability zones", func() {
+			cfg := api.NewClusterConfig()
+			cfg.VPC.CIDR = ipnet.MustParseCIDR("10.20.0.0/16")
+			cfg.VPC.Subnets = &api.ClusterSubnets{
+				Public: api.AZSubnetMapping{
+					"us-west-2b": api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.1.0/24")},
+					"us-west-2a": api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.0.0/24")},
+				},
+				Private: api.AZSubnetMapping{
+					"us-west-2b": api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.128.0/18")},
+					"us-west-2a": api.AZSubnetSpec{CIDR: ipnet.MustParseCIDR("10.20.64.0/18")},
+				},
+			}
+
+			Expect(UseSubnetCIDRPlan(cfg)).To(Succeed())
+			Expect(cfg.AvailabilityZones).To(Equal([]string{"us-west-2a", "us-west-2b"}))
+			Expect(cfg.VPC.Subnets).To(Equal(&api.ClusterSubnets{
+				Public: api.AZSubnetMapping{
+					"us-west-2a": api.AZSubnetSpec{AZ: "us-west-2a", CIDR: ipnet.MustParseCIDR("10.20.0.0/24"), CIDRIndex: 0},
+					"us-west-2b": api.AZSubnetSpec{AZ: "us-west-2b", CIDR: ipnet.MustParseCIDR("10.20.1.0/24"), CIDRIndex: 1},
+				},
+				Private: api.AZSubnetMapping{
+					"us-west-2a": api.AZSubnetSpec{AZ: "us-west-2a", CIDR: ipnet.MustParseCIDR("10.20.64.0/18"), CIDRIndex: 2},
+					"us-west-2b": api.AZSubnetSpec{AZ: "us-west-2b", CIDR: ipnet.MustParseCIDR("10.20.128.0/18"), CIDRIndex: 3},
+				},
+			}))
+		})
+	})
+
 	DescribeTable("Use from Cluster",
 		func(clusterCase useFromClusterCase) {
 			p := mockprovider.NewMockProvider()
@@ -1430,4 +1460,119 @@ var _ = Describe("VPC", func() {
 		}),
 	)
 
+	DescribeTable("ValidateSharedSubnets",
+		func(privateCluster *api.PrivateCluster, privateOwner, publicOwner, expectedErr string) {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.AccountID = "111111111111"
+			cfg.PrivateCluster = privateCluster
+			cfg.VPC.Subnets = &api.ClusterSubnets{
+				Private: api.AZSubnetMapping{
+					"us-west-2a": api.AZSubnetSpec{ID: "subnet-private", AZ: "us-west-2a", OwnerID: privateOwner},
+				},
+				Public: api.AZSubnetMapping{
+					"us-west-2a": api.AZSubnetSpec{ID: "subnet-public", AZ: "us-west-2a", OwnerID: publicOwner},
+				},
+			}
+			err := ValidateSharedSubnets(cfg)
+			if expectedErr != "" {
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("subnets owned by the cluster's account", nil, "111111111111", "111111111111", ""),
+		Entry("subnets shared via AWS RAM", nil, "222222222222", "222222222222", ""),
+		Entry("subnets owned by different accounts", nil, "222222222222", "333333333333",
+			"subnets must all be owned by the same account, found subnets owned by accounts [222222222222 333333333333]"),
+		Entry("fully-private cluster with endpoint creation", &api.PrivateCluster{Enabled: true}, "222222222222", "222222222222",
+			"privateCluster: eksctl cannot create VPC endpoints for subnets [subnet-private subnet-public] shared from account 222222222222"),
+		Entry("fully-private cluster skipping endpoint creation", &api.PrivateCluster{Enabled: true, SkipEndpointCreation: true}, "222222222222", "222222222222", ""),
+	)
+
+	Describe("validatePublicSubnet", func() {
+		It("reports that subnets shared via AWS RAM must be updated by their owner", func() {
+			err := validatePublicSubnet([]ec2types.Subnet{
+				{
+					SubnetId:            aws.String("subnet-1"),
+					OwnerId:             aws.String("222222222222"),
+					MapPublicIpOnLaunch: aws.Bool(false),
+				},
+			}, "111111111111")
+			Expect(err).To(MatchError(ContainSubstring(`found subnets ["subnet-1 (owner: 222222222222)"] shared via AWS RAM`)))
+			Expect(err).To(MatchError(ContainSubstring("the subnet owner must enable it")))
+		})
+
+		It("reports subnets owned by the cluster's account as mis-configured", func() {
+			err := validatePublicSubnet([]ec2types.Subnet{
+				{
+					SubnetId:            aws.String("subnet-1"),
+					OwnerId:             aws.String("111111111111"),
+					MapPublicIpOnLaunch: aws.Bool(false),
+				},
+			}, "111111111111")
+			Expect(err).To(MatchError(ContainSubstring(`found mis-configured or non-public subnets ["subnet-1"]`)))
+		})
+	})
+
+	Describe("ValidateControlPlaneSubnets", func() {
+		var p *mockprovider.MockProvider
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			p.MockEC2().On("DescribeSubnets", Anything, &ec2.DescribeSubnetsInput{
+				SubnetIds: []string{"subnet-a", "subnet-b", "subnet-other"},
+			}).Return(&ec2.DescribeSubnetsOutput{
+				Subnets: []ec2types.Subnet{
+					{SubnetId: aws.String("subnet-a"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2a")},
+					{SubnetId: aws.String("subnet-b"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2b")},
+					{SubnetId: aws.String("subnet-other"), VpcId: aws.String("vpc-2"), AvailabilityZone: aws.String("us-west-2c")},
+				},
+			}, nil)
+			p.MockEC2().On("DescribeSubnets", Anything, &ec2.DescribeSubnetsInput{
+				SubnetIds: []string{"subnet-a", "subnet-b"},
+			}).Return(&ec2.DescribeSubnetsOutput{
+				Subnets: []ec2types.Subnet{
+					{SubnetId: aws.String("subnet-a"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2a")},
+					{SubnetId: aws.String("subnet-b"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2b")},
+				},
+			}, nil)
+			p.MockEC2().On("DescribeSubnets", Anything, &ec2.DescribeSubnetsInput{
+				SubnetIds: []string{"subnet-a"},
+			}).Return(&ec2.DescribeSubnetsOutput{
+				Subnets: []ec2types.Subnet{
+					{SubnetId: aws.String("subnet-a"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2a")},
+				},
+			}, nil)
+		})
+
+		It("accepts subnets in the cluster's VPC spanning two availability zones", func() {
+			Expect(ValidateControlPlaneSubnets(context.Background(), p.EC2(), "vpc-1", []string{"subnet-a", "subnet-b"})).To(Succeed())
+		})
+
+		It("rejects subnets in another VPC", func() {
+			err := ValidateControlPlaneSubnets(context.Background(), p.EC2(), "vpc-1", []string{"subnet-a", "subnet-b", "subnet-other"})
+			Expect(err).To(MatchError("subnet subnet-other is in vpc-2, not in the cluster's VPC vpc-1"))
+		})
+
+		It("rejects subnets in a single availability zone", func() {
+			err := ValidateControlPlaneSubnets(context.Background(), p.EC2(), "vpc-1", []string{"subnet-a"})
+			Expect(err).To(MatchError("control plane subnets must be in at least 2 availability zones, found [us-west-2a]"))
+		})
+	})
+
+	Describe("ValidateControlPlaneSecurityGroups", func() {
+		It("rejects security groups in another VPC", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockEC2().On("DescribeSecurityGroups", Anything, &ec2.DescribeSecurityGroupsInput{
+				GroupIds: []string{"sg-1", "sg-2"},
+			}).Return(&ec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []ec2types.SecurityGroup{
+					{GroupId: aws.String("sg-1"), VpcId: aws.String("vpc-1")},
+					{GroupId: aws.String("sg-2"), VpcId: aws.String("vpc-2")},
+				},
+			}, nil)
+			err := ValidateControlPlaneSecurityGroups(context.Background(), p.EC2(), "vpc-1", []string{"sg-1", "sg-2"})
+			Expect(err).To(MatchError("security group sg-2 is in vpc-2, not in the cluster's VPC vpc-1"))
+		})
+	})
 })